@@ -4,6 +4,7 @@
 package backends
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -188,6 +189,7 @@ func (b *IBMQuantumBackend) gateNameToQASM(name string) string {
 		"CNOT": "cx", "CZ": "cz", "SWAP": "swap",
 		"RX": "rx", "RY": "ry", "RZ": "rz",
 		"S": "s", "T": "t", "Sdg": "sdg", "Tdg": "tdg",
+		"CCX": "ccx",
 	}
 	if mapped, ok := mapping[name]; ok {
 		return mapped
@@ -277,6 +279,7 @@ func (b *RigettiBackend) gateNameToQuil(name string) string {
 		"H": "H", "X": "X", "Y": "Y", "Z": "Z",
 		"CNOT": "CNOT", "CZ": "CZ", "SWAP": "SWAP",
 		"RX": "RX", "RY": "RY", "RZ": "RZ",
+		"S": "S", "T": "T", "CCX": "CCNOT",
 	}
 	if mapped, ok := mapping[name]; ok {
 		return mapped
@@ -357,6 +360,7 @@ func (b *IonQBackend) gateNameToIonQ(name string) string {
 		"H": "h", "X": "x", "Y": "y", "Z": "z",
 		"CNOT": "cnot", "CZ": "zz", "SWAP": "swap",
 		"RX": "rx", "RY": "ry", "RZ": "rz",
+		"S": "s", "T": "t",
 	}
 	if mapped, ok := mapping[name]; ok {
 		return mapped
@@ -456,6 +460,3 @@ func (r *BackendRegistry) List() []string {
 	}
 	return names
 }
-
-// Import bytes package
-import "bytes"
@@ -432,15 +432,13 @@ void QuantumRegister::applyRotationZ(size_t target, double angle) {
 void QuantumRegister::applyDepolarizingNoise(double probability) {
   // Stochastic Noise Model
   // For each qubit, apply random Pauli error with probability p
-  std::random_device rd;
-  std::mt19937 gen(rd());
   std::uniform_real_distribution<> dis(0.0, 1.0);
 
   for (size_t i = 0; i < num_qubits; ++i) {
-    if (dis(gen) < probability) {
+    if (dis(rng) < probability) {
       // Error occurred!
       // Depolarizing channel: X, Y, or Z with equal prob (p/3)
-      double type = dis(gen);
+      double type = dis(rng);
       if (type < 0.333)
         applyX(i);
       else if (type < 0.666)
@@ -461,10 +459,9 @@ int QuantumRegister::measure(size_t target) {
       prob0 += std::norm(state[i]);
   }
 
-  // Thread-safe RNG for measurement
-  thread_local std::mt19937 gen(std::random_device{}());
+  // Per-register RNG (registers are never shared between requests)
   std::uniform_real_distribution<> dis(0.0, 1.0);
-  int outcome = (dis(gen) > prob0) ? 1 : 0;
+  int outcome = (dis(rng) > prob0) ? 1 : 0;
 
   // Collapse State (Projective)
   double norm = 0.0;
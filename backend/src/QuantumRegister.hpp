@@ -2,6 +2,8 @@
 
 #include <complex>
 #include <cstddef>
+#include <cstdint>
+#include <random>
 #include <string>
 #include <vector>
 
@@ -31,6 +33,10 @@ public:
   // --- Fix 2: Noise Simulation (Restored) ---
   void applyDepolarizingNoise(double probability);
 
+  // --- Deterministic Mode ---
+  // Re-seeds the RNG behind measurement and noise; same seed, same outcomes.
+  void setSeed(uint64_t seed) { rng.seed(seed); }
+
   // --- Measurement & Analysis ---
   int measure(size_t target);
   std::vector<double> getProbabilities();
@@ -129,6 +135,9 @@ private:
   size_t num_qubits;
   std::vector<Complex> state;
 
+  // Measurement / noise RNG (non-deterministic unless setSeed is called)
+  std::mt19937_64 rng{std::random_device{}()};
+
   // Recorder
   bool recording_enabled = false;
   std::vector<RecordedGate> tape;
@@ -106,7 +106,7 @@ void QubitEngineServiceImpl::serializeState(
 // Factory Helper
 std::unique_ptr<IQuantumBackend>
 createBackend(qubit_engine::CircuitRequest::ExecutionBackend type,
-              int num_qubits) {
+              int num_qubits, uint64_t seed) {
   switch (type) {
   case qubit_engine::CircuitRequest::MOCK_HARDWARE:
     std::cout << "Using Mock Hardware Backend" << std::endl;
@@ -120,7 +120,7 @@ createBackend(qubit_engine::CircuitRequest::ExecutionBackend type,
   case qubit_engine::CircuitRequest::SIMULATOR:
   default:
     std::cout << "Using Local Simulator Backend" << std::endl;
-    return std::make_unique<SimulatorBackend>(num_qubits);
+    return std::make_unique<SimulatorBackend>(num_qubits, seed);
   }
 }
 
@@ -148,7 +148,8 @@ QubitEngineServiceImpl::RunCircuit(grpc::ServerContext *context,
 
   try {
     // Instantiate Backend
-    auto backend = createBackend(request->execution_backend(), n);
+    auto backend =
+        createBackend(request->execution_backend(), n, request->seed());
 
     // Apply Gates
     for (const auto &op : request->operations()) {
@@ -368,3 +369,80 @@ grpc::Status QubitEngineServiceImpl::RunVQE(
 
   return grpc::Status::OK;
 }
+
+// -----------------------------------------------------------------
+// Resource Estimation (dry runs)
+// -----------------------------------------------------------------
+#include <algorithm>
+#include <vector>
+
+// Rough cost of one gate's pass over one amplitude on the CPU simulator
+constexpr double kNanosPerAmplitude = 1.5;
+
+grpc::Status QubitEngineServiceImpl::EstimateResources(
+    grpc::ServerContext *context, const qubit_engine::CircuitRequest *request,
+    qubit_engine::ResourceEstimate *response) {
+  int n = request->num_qubits();
+  if (n <= 0 || n > 30) {
+    return grpc::Status(grpc::StatusCode::INVALID_ARGUMENT,
+                        "Qubits must be between 1 and 30");
+  }
+
+  // Depth: each gate lands one layer after the latest gate on its qubits
+  std::vector<uint32_t> layer(n, 0);
+  uint32_t depth = 0;
+  int index = 0;
+  for (const auto &op : request->operations()) {
+    std::vector<uint32_t> qubits = {op.target_qubit()};
+    switch (op.type()) {
+    case qubit_engine::GateOperation::CNOT:
+      qubits.push_back(op.control_qubit());
+      break;
+    case qubit_engine::GateOperation::TOFFOLI:
+      qubits.push_back(op.control_qubit());
+      qubits.push_back(op.second_control_qubit());
+      break;
+    default:
+      break;
+    }
+    for (size_t i = 0; i < qubits.size(); ++i) {
+      if (qubits[i] >= static_cast<uint32_t>(n)) {
+        return grpc::Status(grpc::StatusCode::INVALID_ARGUMENT,
+                            "Operation " + std::to_string(index) +
+                                ": qubit " + std::to_string(qubits[i]) +
+                                " out of range for " + std::to_string(n) +
+                                " qubits");
+      }
+      for (size_t j = 0; j < i; ++j) {
+        if (qubits[i] == qubits[j]) {
+          return grpc::Status(grpc::StatusCode::INVALID_ARGUMENT,
+                              "Operation " + std::to_string(index) +
+                                  ": control and target must be distinct");
+        }
+      }
+    }
+    uint32_t at = 0;
+    for (uint32_t q : qubits)
+      at = std::max(at, layer[q]);
+    for (uint32_t q : qubits)
+      layer[q] = at + 1;
+    depth = std::max(depth, at + 1);
+    ++index;
+  }
+
+  size_t amplitudes = 1ULL << n;
+  response->set_num_qubits(n);
+  response->set_gate_count(request->operations_size());
+  response->set_depth(depth);
+  response->set_memory_bytes(amplitudes * sizeof(std::complex<double>));
+  response->set_estimated_runtime_ms(request->operations_size() *
+                                     static_cast<double>(amplitudes) *
+                                     kNanosPerAmplitude / 1e6);
+  response->set_fits_in_memory(hasEnoughMemory(n));
+
+  char hostname[1024];
+  if (gethostname(hostname, sizeof(hostname)) == 0) {
+    response->set_server_id(hostname);
+  }
+  return grpc::Status::OK;
+}
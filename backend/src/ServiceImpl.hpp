@@ -25,6 +25,11 @@ public:
   RunVQE(grpc::ServerContext *context, const qubit_engine::VQERequest *request,
          grpc::ServerWriter<qubit_engine::VQEResponse> *writer) override;
 
+  grpc::Status
+  EstimateResources(grpc::ServerContext *context,
+                    const qubit_engine::CircuitRequest *request,
+                    qubit_engine::ResourceEstimate *response) override;
+
 private:
   void applyGate(QuantumRegister &qreg, const qubit_engine::GateOperation &op,
                  qubit_engine::StateResponse *response);
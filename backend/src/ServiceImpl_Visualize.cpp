@@ -12,6 +12,9 @@ grpc::Status QubitEngineServiceImpl::VisualizeCircuit(
 
   // 1. Initialize Register
   QuantumRegister qreg(request->num_qubits());
+  if (request->seed() != 0) {
+    qreg.setSeed(request->seed());
+  }
 
   // 2. Iterate and Stream
   for (const auto &op : request->operations()) {
@@ -1,14 +1,21 @@
 #pragma once
 #include "../QuantumRegister.hpp"
 #include "IQuantumBackend.hpp"
+#include <cstdint>
+#include <map>
 #include <unistd.h> // for gethostname
 
 class SimulatorBackend : public IQuantumBackend {
 private:
   QuantumRegister qreg;
+  std::map<uint32_t, bool> classical_results; // Register -> measured bit
 
 public:
-  explicit SimulatorBackend(int num_qubits) : qreg(num_qubits) {}
+  explicit SimulatorBackend(int num_qubits, uint64_t seed = 0)
+      : qreg(num_qubits) {
+    if (seed != 0)
+      qreg.setSeed(seed);
+  }
 
   void applyGate(const qubit_engine::GateOperation &op) override {
     // Reuse the logic from ServiceImpl, or better, move that logic to a shared
@@ -27,15 +34,16 @@ public:
     case GateOperation::CNOT:
       qreg.applyCNOT(op.control_qubit(), op.target_qubit());
       break;
-    case GateOperation::MEASURE:
-      qreg.measure(op.target_qubit());
-      break; // We ignore result storage for now in this simple wrapper? No, we
-             // need it.
-    // Wait, measure returns bool. The interface ApplyGate is void.
-    // We should probably handle measurement results.
-    // For now, let's just run the side-effect.
+    case GateOperation::MEASURE: {
+      // Same register convention as ServiceImpl::applyGate
+      uint32_t reg_id = (op.classical_register() > 0) ? op.classical_register()
+                                                      : op.target_qubit();
+      classical_results[reg_id] = qreg.measure(op.target_qubit());
+      break;
+    }
     // Real hardware usually returns counts at the END.
-    // Simulator allows mid-circuit measurement.
+    // Simulator allows mid-circuit measurement; results are kept until
+    // getResult.
 
     // New Gates
     case GateOperation::TOFFOLI:
@@ -68,6 +76,10 @@ public:
       c->set_imag(amp.imag());
     }
 
+    for (const auto &[reg_id, bit] : classical_results) {
+      (*response->mutable_classical_results())[reg_id] = bit;
+    }
+
     char hostname[1024];
     if (gethostname(hostname, 1024) == 0) {
       response->set_server_id(std::string(hostname) + " (Simulator)");
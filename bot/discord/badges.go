@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	edu "github.com/perclft/QubitEngine/bot/discord/generated/education"
+)
+
+// ------------------------------------------------------------------
+// Education Client (badges live in the Education Module)
+// ------------------------------------------------------------------
+
+type EducationClient struct {
+	conn   *grpc.ClientConn
+	client edu.QuantumEducationClient
+}
+
+func NewEducationClient(educationAddr string) (*EducationClient, error) {
+	conn, err := grpc.Dial(educationAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to education module: %w", err)
+	}
+	return &EducationClient{conn: conn, client: edu.NewQuantumEducationClient(conn)}, nil
+}
+
+func (c *EducationClient) Close() error {
+	return c.conn.Close()
+}
+
+// Achievements fetches a user's badges; Discord user IDs are the learner
+// IDs, as they are for the Oracle
+func (c *EducationClient) Achievements(userID string) (*edu.AchievementList, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return c.client.GetAchievements(ctx, &edu.AchievementsRequest{UserId: userID})
+}
+
+var badgesCommand = &discordgo.ApplicationCommand{
+	Name:        "badges",
+	Description: "Show quantum badges earned in lessons, quizzes, and games",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionUser,
+			Name:        "user",
+			Description: "Whose badges to show (default: yours)",
+			Required:    false,
+		},
+	},
+}
+
+func (b *Bot) handleBadgesCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "user" {
+			user = opt.UserValue(s)
+		}
+	}
+
+	if b.educationClient == nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Badges are unavailable: no education module configured"),
+		})
+		return
+	}
+	list, err := b.educationClient.Achievements(user.ID)
+	if err != nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Badges are unavailable: " + err.Error()),
+		})
+		return
+	}
+
+	embed := b.createBadgesEmbed(list, user)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+}
+
+func (b *Bot) createBadgesEmbed(list *edu.AchievementList, user *discordgo.User) *discordgo.MessageEmbed {
+	var earned, progress []string
+	for _, badge := range list.Badges {
+		if badge.Unlocked {
+			earned = append(earned, fmt.Sprintf("%s **%s** — %s", badge.Emoji, badge.Name, badge.Description))
+		} else {
+			progress = append(progress, fmt.Sprintf("▫️ %s — %d/%d", badge.Name, badge.Progress, badge.Goal))
+		}
+	}
+	if len(earned) == 0 {
+		earned = []string{"None yet. Try /8ball, a quiz, or building a Bell state!"}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏅 %s's Quantum Badges", user.Username),
+		Description: fmt.Sprintf("**%d/%d** unlocked", list.UnlockedCount, len(list.Badges)),
+		Color:       0x9B59B6,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "✨ Earned", Value: strings.Join(earned, "\n")},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text:    "Badges are earned across lessons, quizzes, and games",
+			IconURL: user.AvatarURL("32"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	if len(progress) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "⏳ In Progress",
+			Value: strings.Join(progress, "\n"),
+		})
+	}
+	return embed
+}
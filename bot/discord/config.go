@@ -0,0 +1,363 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ------------------------------------------------------------------
+// Guild Settings (per-server configuration and rate limiting)
+// ------------------------------------------------------------------
+
+const (
+	// defaultCooldown spaces out a user's commands before any is configured
+	defaultCooldown = 3
+	maxCooldown     = 600
+	// maxCooldownUsers bounds the cooldown table between sweeps
+	maxCooldownUsers = 10000
+)
+
+// oracleMoods names the Oracle's moods, by mood number
+var oracleMoods = []string{"🔮 Mysterious", "🙄 Sarcastic", "🌌 Philosophical", "💥 Chaotic"}
+
+func oracleMoodChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(oracleMoods))
+	for mood, name := range oracleMoods {
+		choices[mood] = &discordgo.ApplicationCommandOptionChoice{Name: name, Value: mood}
+	}
+	return choices
+}
+
+type guildSettings struct {
+	Mood     int      `json:"mood"`               // Default Oracle mood
+	Channels []string `json:"channels,omitempty"` // Where commands are answered; empty: everywhere
+	Cooldown int      `json:"cooldown_seconds"`   // Between one user's commands; 0: off
+}
+
+func defaultGuildSettings() guildSettings {
+	return guildSettings{Cooldown: defaultCooldown}
+}
+
+// guildStore keeps every guild's settings, saved to a JSON file when one
+// is configured, and when each user last ran a command
+type guildStore struct {
+	path    string
+	mu      sync.Mutex
+	guilds  map[string]*guildSettings
+	lastUse map[string]time.Time // By guild and user ID
+}
+
+func newGuildStore(path string) (*guildStore, error) {
+	gs := &guildStore{
+		path:    path,
+		guilds:  make(map[string]*guildSettings),
+		lastUse: make(map[string]time.Time),
+	}
+	if path == "" {
+		return gs, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return gs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &gs.guilds); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return gs, nil
+}
+
+// get returns a guild's settings, or the defaults for guilds that have
+// none and for DMs
+func (gs *guildStore) get(guildID string) guildSettings {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	if g, ok := gs.guilds[guildID]; ok {
+		out := *g
+		out.Channels = slices.Clone(g.Channels)
+		return out
+	}
+	return defaultGuildSettings()
+}
+
+// update changes a guild's settings and saves them
+func (gs *guildStore) update(guildID string, change func(*guildSettings)) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	g, ok := gs.guilds[guildID]
+	if !ok {
+		d := defaultGuildSettings()
+		g = &d
+		gs.guilds[guildID] = g
+	}
+	change(g)
+	return gs.save()
+}
+
+// reset returns a guild to the defaults
+func (gs *guildStore) reset(guildID string) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	delete(gs.guilds, guildID)
+	return gs.save()
+}
+
+// channelAllowed reports whether commands are answered in a channel
+func (gs *guildStore) channelAllowed(guildID, channelID string) bool {
+	channels := gs.get(guildID).Channels
+	return len(channels) == 0 || slices.Contains(channels, channelID)
+}
+
+// take starts a user's cooldown, or returns how long is left of it
+func (gs *guildStore) take(guildID, userID string, now time.Time) time.Duration {
+	cooldown := time.Duration(gs.get(guildID).Cooldown) * time.Second
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	key := guildID + ":" + userID
+	if wait := gs.lastUse[key].Add(cooldown).Sub(now); wait > 0 {
+		return wait
+	}
+	if len(gs.lastUse) >= maxCooldownUsers {
+		for k, at := range gs.lastUse {
+			if now.Sub(at) > maxCooldown*time.Second {
+				delete(gs.lastUse, k)
+			}
+		}
+	}
+	gs.lastUse[key] = now
+	return 0
+}
+
+// save writes the store atomically; callers hold gs.mu
+func (gs *guildStore) save() error {
+	if gs.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(gs.guilds, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(gs.path), ".guilds-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), gs.path)
+}
+
+// admit checks a command against its guild's channels and the user's
+// cooldown before it reaches any module, answering privately if refused
+func (b *Bot) admit(s *discordgo.Session, i *discordgo.InteractionCreate) bool {
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+
+	var refusal string
+	if !b.guilds.channelAllowed(i.GuildID, i.ChannelID) {
+		channels := b.guilds.get(i.GuildID).Channels
+		for n, id := range channels {
+			channels[n] = "<#" + id + ">"
+		}
+		refusal = "🚫 Commands are answered in " + strings.Join(channels, ", ") + " on this server"
+	} else if wait := b.guilds.take(i.GuildID, user.ID, time.Now()); wait > 0 {
+		refusal = fmt.Sprintf("⏳ Slow down! Try again in %.0fs", wait.Seconds()+0.5)
+	}
+	if refusal == "" {
+		return true
+	}
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: refusal,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	return false
+}
+
+var (
+	manageGuild      = int64(discordgo.PermissionManageServer)
+	noDMs            = false
+	maxCooldownValue = float64(maxCooldown)
+)
+
+var configCommand = &discordgo.ApplicationCommand{
+	Name:                     "config",
+	Description:              "Configure the bot for this server",
+	DefaultMemberPermissions: &manageGuild,
+	DMPermission:             &noDMs,
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "show",
+			Description: "Show this server's settings",
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "mood",
+			Description: "Set the Oracle's default mood",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "mood",
+					Description: "The mood the Oracle answers in unless asked otherwise",
+					Required:    true,
+					Choices:     oracleMoodChoices(),
+				},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "channel",
+			Description: "Allow or disallow commands in a channel",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "channel",
+					Description:  "The channel",
+					Required:     true,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "allowed",
+					Description: "Answer commands there (once any channel is allowed, only allowed channels are)",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "cooldown",
+			Description: "Set how long each user waits between commands",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "seconds",
+					Description: "Seconds between one user's commands (0: no cooldown)",
+					Required:    true,
+					MinValue:    new(float64),
+					MaxValue:    maxCooldownValue,
+				},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "reset",
+			Description: "Return this server to the default settings",
+		},
+	},
+}
+
+func (b *Bot) handleConfigCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	reply := func(content string, embed *discordgo.MessageEmbed) {
+		data := &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral}
+		if embed != nil {
+			data.Embeds = []*discordgo.MessageEmbed{embed}
+		}
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: data,
+		})
+	}
+	// Server admins can widen who sees /config, so check here too
+	if i.GuildID == "" || i.Member == nil || i.Member.Permissions&discordgo.PermissionManageServer == 0 {
+		reply("❌ Configuring the bot needs the Manage Server permission", nil)
+		return
+	}
+
+	sub := i.ApplicationCommandData().Options[0]
+	opts := make(map[string]*discordgo.ApplicationCommandInteractionDataOption)
+	for _, opt := range sub.Options {
+		opts[opt.Name] = opt
+	}
+
+	var done string
+	var err error
+	switch sub.Name {
+	case "show":
+		reply("", b.createConfigEmbed(b.guilds.get(i.GuildID)))
+		return
+	case "mood":
+		mood := min(max(int(opts["mood"].IntValue()), 0), len(oracleMoods)-1)
+		err = b.guilds.update(i.GuildID, func(g *guildSettings) { g.Mood = mood })
+		done = "✅ The Oracle is now " + oracleMoods[mood] + " by default"
+	case "channel":
+		channel := opts["channel"].ChannelValue(nil).ID
+		allowed := opts["allowed"].BoolValue()
+		err = b.guilds.update(i.GuildID, func(g *guildSettings) {
+			g.Channels = slices.DeleteFunc(g.Channels, func(id string) bool { return id == channel })
+			if allowed {
+				g.Channels = append(g.Channels, channel)
+			}
+		})
+		done = fmt.Sprintf("✅ Commands are no longer answered in <#%s>", channel)
+		if allowed {
+			done = fmt.Sprintf("✅ Commands are answered in <#%s>", channel)
+		}
+		if len(b.guilds.get(i.GuildID).Channels) == 0 {
+			done += "; with no channels allowed, every channel is"
+		}
+	case "cooldown":
+		seconds := min(max(int(opts["seconds"].IntValue()), 0), maxCooldown)
+		err = b.guilds.update(i.GuildID, func(g *guildSettings) { g.Cooldown = seconds })
+		done = fmt.Sprintf("✅ Users now wait %ds between commands", seconds)
+	case "reset":
+		err = b.guilds.reset(i.GuildID)
+		done = "✅ This server is back to the default settings"
+	}
+	if err != nil {
+		reply("❌ Could not save the settings: "+err.Error(), nil)
+		return
+	}
+	reply(done, nil)
+}
+
+func (b *Bot) createConfigEmbed(g guildSettings) *discordgo.MessageEmbed {
+	channels := "Every channel"
+	if len(g.Channels) > 0 {
+		for n, id := range g.Channels {
+			g.Channels[n] = "<#" + id + ">"
+		}
+		channels = strings.Join(g.Channels, ", ")
+	}
+	cooldown := "Off"
+	if g.Cooldown > 0 {
+		cooldown = fmt.Sprintf("%ds per user", g.Cooldown)
+	}
+	mood := oracleMoods[0]
+	if g.Mood >= 0 && g.Mood < len(oracleMoods) {
+		mood = oracleMoods[g.Mood]
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: "⚙️ Server Settings",
+		Color: 0x95A5A6,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "🎱 Oracle Mood", Value: mood, Inline: true},
+			{Name: "⏳ Cooldown", Value: cooldown, Inline: true},
+			{Name: "💬 Channels", Value: channels},
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	gaming "github.com/perclft/QubitEngine/bot/discord/generated/gaming"
+)
+
+// ------------------------------------------------------------------
+// Dice (rolled by the Gaming Module)
+// ------------------------------------------------------------------
+
+// Limits on a roll, so its embed fits in Discord's
+const (
+	maxDiceTerms = 10
+	maxDice      = 100
+	maxSides     = 1000
+	maxModifier  = 1_000_000
+)
+
+// diceTerm is one term of a dice expression: NdS with an optional keep or
+// drop, or a constant modifier when sides is 0
+type diceTerm struct {
+	text        string
+	sign        int
+	count       int
+	sides       int
+	keepHighest int
+	keepLowest  int
+	constant    int
+}
+
+// parseDice parses dice notation such as 2d20kh1+5, 4d6dl1 or d8+d6-1.
+// A term keeps (kh, kl) or drops (dh, dl) its highest or lowest dice,
+// one when no count is given; d% is a d100.
+func parseDice(notation string) ([]diceTerm, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(notation), ""))
+	if s == "" {
+		return nil, fmt.Errorf("no dice given; try 2d20kh1+5")
+	}
+
+	var terms []diceTerm
+	for s != "" {
+		sign := 1
+		switch {
+		case s[0] == '+':
+			s = s[1:]
+		case s[0] == '-':
+			sign, s = -1, s[1:]
+		case len(terms) > 0:
+			return nil, fmt.Errorf("expected + or - before %q", s)
+		}
+		end := strings.IndexAny(s, "+-")
+		if end < 0 {
+			end = len(s)
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("expected dice or a modifier in %q", notation)
+		}
+		term, err := parseDiceTerm(s[:end])
+		if err != nil {
+			return nil, err
+		}
+		term.sign = sign
+		terms = append(terms, term)
+		s = s[end:]
+	}
+
+	dice := 0
+	for _, t := range terms {
+		if t.sides > 0 {
+			dice++
+		}
+	}
+	switch {
+	case dice == 0:
+		return nil, fmt.Errorf("%q rolls no dice", notation)
+	case len(terms) > maxDiceTerms:
+		return nil, fmt.Errorf("at most %d terms can be rolled at once", maxDiceTerms)
+	}
+	return terms, nil
+}
+
+func parseDiceTerm(text string) (diceTerm, error) {
+	t := diceTerm{text: text}
+	count, rest, isDice := strings.Cut(text, "d")
+	if !isDice {
+		n, err := strconv.Atoi(text)
+		if err != nil || n > maxModifier {
+			return t, fmt.Errorf("%q is neither dice nor a modifier", text)
+		}
+		t.constant = n
+		return t, nil
+	}
+
+	t.count = 1
+	if count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 1 || n > maxDice {
+			return t, fmt.Errorf("%q: roll 1 to %d dice", text, maxDice)
+		}
+		t.count = n
+	}
+
+	sides, keep := rest, ""
+	if i := strings.IndexAny(rest, "kd"); i >= 0 {
+		sides, keep = rest[:i], rest[i:]
+	}
+	if sides == "%" {
+		sides = "100"
+	}
+	n, err := strconv.Atoi(sides)
+	if err != nil || n < 2 || n > maxSides {
+		return t, fmt.Errorf("%q: dice have 2 to %d sides", text, maxSides)
+	}
+	t.sides = n
+
+	if keep == "" {
+		return t, nil
+	}
+	var op, k string
+	switch {
+	case len(keep) >= 2 && strings.Contains("kh kl dh dl", keep[:2]):
+		op, k = keep[:2], keep[2:]
+	case keep[0] == 'k':
+		op, k = "kh", keep[1:] // plain k keeps the highest
+	default:
+		return t, fmt.Errorf("%q: use kh, kl, dh or dl to keep or drop dice", text)
+	}
+	n = 1
+	if k != "" {
+		if n, err = strconv.Atoi(k); err != nil || n < 1 {
+			return t, fmt.Errorf("%q: %q needs a positive count", text, op)
+		}
+	}
+	n = min(n, t.count)
+	switch op {
+	case "kh":
+		t.keepHighest = n
+	case "kl":
+		t.keepLowest = n
+	case "dh":
+		t.keepLowest = t.count - n
+	case "dl":
+		t.keepHighest = t.count - n
+	}
+	if op[0] == 'd' && n == t.count {
+		return t, fmt.Errorf("%q drops every die", text)
+	}
+	return t, nil
+}
+
+// RollDice rolls a term's dice on the Gaming Module; rolls in a guild
+// count toward the roller's luck there
+func (c *OracleClient) RollDice(t diceTerm, userID, guildID string) (*gaming.DiceResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("no gaming module connected")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), oracleTimeout)
+	defer cancel()
+	resp, err := c.client.QuantumDiceRoll(ctx, &gaming.DiceRequest{
+		NumDice:     int32(t.count),
+		Sides:       int32(t.sides),
+		KeepHighest: int32(t.keepHighest),
+		KeepLowest:  int32(t.keepLowest),
+		UserId:      userID,
+		GroupId:     guildID,
+	})
+	if err != nil {
+		c.setHealthy(false, err)
+	}
+	return resp, err
+}
+
+var rollCommand = &discordgo.ApplicationCommand{
+	Name:        "roll",
+	Description: "Roll quantum dice, e.g. 2d20kh1+5",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "dice",
+			Description: "Dice notation: 1d20+5, 2d20kh1 (advantage), 4d6dl1, d%",
+			Required:    true,
+		},
+	},
+}
+
+func (b *Bot) handleRollCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	notation := i.ApplicationCommandData().Options[0].StringValue()
+
+	terms, err := parseDice(notation)
+	if err != nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ " + err.Error()),
+		})
+		return
+	}
+	results := make([]*gaming.DiceResult, len(terms))
+	for n, t := range terms {
+		if t.sides == 0 {
+			continue
+		}
+		if results[n], err = b.oracleClient.RollDice(t, user.ID, i.GuildID); err != nil {
+			s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+				Content: strPtr("❌ The dice are unavailable: " + err.Error()),
+			})
+			return
+		}
+	}
+
+	embed := b.createRollEmbed(notation, terms, results, user)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+}
+
+func (b *Bot) createRollEmbed(notation string, terms []diceTerm, results []*gaming.DiceResult, user *discordgo.User) *discordgo.MessageEmbed {
+	total, modifier := 0, 0
+	var fields []*discordgo.MessageEmbedField
+	var modifiers []string
+	for n, t := range terms {
+		sign := "+"
+		if t.sign < 0 {
+			sign = "-"
+		}
+		if t.sides == 0 {
+			modifier += t.sign * t.constant
+			modifiers = append(modifiers, sign+strconv.Itoa(t.constant))
+			continue
+		}
+
+		// Dropped dice are struck through
+		r := results[n]
+		rolls := make([]string, len(r.Rolls))
+		for k, roll := range r.Rolls {
+			rolls[k] = strconv.Itoa(int(roll))
+			if k < len(r.Kept) && !r.Kept[k] {
+				rolls[k] = "~~" + rolls[k] + "~~"
+			}
+		}
+		total += t.sign * int(r.Total)
+		name := t.text
+		if n > 0 || t.sign < 0 {
+			name = sign + " " + name
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "🎲 " + name,
+			Value:  fmt.Sprintf("%s = **%d**", strings.Join(rolls, ", "), r.Total),
+			Inline: true,
+		})
+	}
+	total += modifier
+	if len(modifiers) > 0 {
+		value := fmt.Sprintf("**%+d**", modifier)
+		if len(modifiers) > 1 {
+			value = strings.Join(modifiers, " ") + " = " + value
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "➕ Modifiers",
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	// A lone d20 that comes up 20 or 1 is a critical
+	description := fmt.Sprintf("Total: **%d**", total)
+	color := 0x3498DB
+	if roll, ok := loneD20(terms, results); ok {
+		switch roll {
+		case 20:
+			description += "\n✨ **Natural 20!**"
+			color = 0xF1C40F
+		case 1:
+			description += "\n💀 **Natural 1!**"
+			color = 0xE74C3C
+		}
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎲 %s rolls %s", user.Username, strings.Join(strings.Fields(notation), "")),
+		Description: description,
+		Color:       color,
+		Fields:      fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text:    "Rolled by qubit measurement",
+			IconURL: user.AvatarURL("32"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
+
+// loneD20 is the kept die of a roll whose only dice term keeps one d20
+func loneD20(terms []diceTerm, results []*gaming.DiceResult) (int, bool) {
+	roll, found := 0, false
+	for n, t := range terms {
+		if t.sides == 0 {
+			continue
+		}
+		if found || t.sides != 20 || t.sign < 0 {
+			return 0, false
+		}
+		for k, kept := range results[n].Kept {
+			if kept {
+				if found {
+					return 0, false
+				}
+				roll, found = int(results[n].Rolls[k]), true
+			}
+		}
+	}
+	return roll, found
+}
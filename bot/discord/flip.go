@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	gaming "github.com/perclft/QubitEngine/bot/discord/generated/gaming"
+)
+
+// ------------------------------------------------------------------
+// Coin Flips (flipped by the Gaming Module)
+// ------------------------------------------------------------------
+
+const (
+	maxCoins = 20
+	// flipChallengePrefix starts the custom IDs of challenge buttons, which
+	// carry the whole challenge so it survives a restart:
+	// flip:<accept|decline>:<challenger>:<opponent>:<unix time issued>
+	flipChallengePrefix = "flip:"
+	// flipChallengeTTL is how long a challenge can be accepted
+	flipChallengeTTL = 10 * time.Minute
+)
+
+// FlipCoins flips coins on the Gaming Module; entangled flips come in
+// anti-correlated pairs
+func (c *OracleClient) FlipCoins(n int, entangled bool) (*gaming.CoinFlipResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("no gaming module connected")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), oracleTimeout)
+	defer cancel()
+	resp, err := c.client.QuantumCoinFlip(ctx, &gaming.CoinFlipRequest{
+		NumFlips:  int32(n),
+		Entangled: entangled,
+	})
+	if err != nil {
+		c.setHealthy(false, err)
+	}
+	return resp, err
+}
+
+var minCoins = 1.0
+
+var flipCommand = &discordgo.ApplicationCommand{
+	Name:        "flip",
+	Description: "Flip quantum coins, or challenge someone to an entangled flip",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        "coins",
+			Description: "How many coins to flip (default: 1)",
+			Required:    false,
+			MinValue:    &minCoins,
+			MaxValue:    maxCoins,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionUser,
+			Name:        "opponent",
+			Description: "Challenge someone head-to-head: whoever's coin lands heads wins",
+			Required:    false,
+		},
+	},
+}
+
+func (b *Bot) handleFlipCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	coins := 1
+	var opponent *discordgo.User
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "coins":
+			coins = min(max(int(opt.IntValue()), 1), maxCoins)
+		case "opponent":
+			opponent = opt.UserValue(s)
+		}
+	}
+
+	if opponent != nil {
+		b.challengeFlip(s, i, user, opponent)
+		return
+	}
+
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	result, err := b.oracleClient.FlipCoins(coins, false)
+	if err != nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ The coins are unavailable: " + err.Error()),
+		})
+		return
+	}
+
+	embed := b.createFlipEmbed(result, user)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+}
+
+func coinFace(heads bool) string {
+	if heads {
+		return "🟡 Heads"
+	}
+	return "⚪ Tails"
+}
+
+func (b *Bot) createFlipEmbed(result *gaming.CoinFlipResult, user *discordgo.User) *discordgo.MessageEmbed {
+	faces := make([]string, len(result.Results))
+	for n, heads := range result.Results {
+		faces[n] = coinFace(heads)
+	}
+
+	title := fmt.Sprintf("🪙 %s flips %s", user.Username, faces[0])
+	description := ""
+	if len(faces) > 1 {
+		title = fmt.Sprintf("🪙 %s flips %d coins", user.Username, len(faces))
+		description = fmt.Sprintf("**%d** heads, **%d** tails\n\n%s",
+			result.HeadsCount, result.TailsCount, strings.Join(faces, " · "))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0xF1C40F,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text:    "Flipped by qubit measurement",
+			IconURL: user.AvatarURL("32"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
+
+// challengeFlip posts a challenge for opponent to accept or decline
+func (b *Bot) challengeFlip(s *discordgo.Session, i *discordgo.InteractionCreate, challenger, opponent *discordgo.User) {
+	if opponent.ID == challenger.ID || opponent.Bot {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "❌ Challenge someone else: a coin can't be entangled with itself",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		return
+	}
+
+	id := func(action string) string {
+		return fmt.Sprintf("%s%s:%s:%s:%d", flipChallengePrefix, action, challenger.ID, opponent.ID, time.Now().Unix())
+	}
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("🪙 %s, %s challenges you to an entangled coin flip! "+
+				"Your coins share a Bell pair, so exactly one lands heads, and heads wins.",
+				opponent.Mention(), challenger.Mention()),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					discordgo.Button{Label: "Accept", Style: discordgo.SuccessButton, CustomID: id("accept")},
+					discordgo.Button{Label: "Decline", Style: discordgo.DangerButton, CustomID: id("decline")},
+				}},
+			},
+		},
+	})
+}
+
+// handleFlipChallenge answers a challenge button. Only the opponent can
+// accept; either player can decline, which withdraws the challenge.
+func (b *Bot) handleFlipChallenge(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	clicker := i.User
+	if i.Member != nil {
+		clicker = i.Member.User
+	}
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, flipChallengePrefix), ":")
+	if len(parts) != 4 {
+		return
+	}
+	action, challengerID, opponentID := parts[0], parts[1], parts[2]
+	issued, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return
+	}
+
+	reply := func(content string) {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+	}
+	settle := func(content string, embeds ...*discordgo.MessageEmbed) {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    content,
+				Embeds:     embeds,
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+	}
+
+	switch {
+	case clicker.ID != opponentID && (action == "accept" || clicker.ID != challengerID):
+		reply(fmt.Sprintf("❌ This challenge is for <@%s>", opponentID))
+		return
+	case action == "decline" && clicker.ID == challengerID:
+		settle(fmt.Sprintf("🪙 <@%s> withdrew the challenge", challengerID))
+		return
+	case action == "decline":
+		settle(fmt.Sprintf("🪙 <@%s> declined the challenge", opponentID))
+		return
+	case time.Since(time.Unix(issued, 0)) > flipChallengeTTL:
+		settle("⌛ This challenge has expired; start another with /flip")
+		return
+	}
+
+	result, err := b.oracleClient.FlipCoins(1, true)
+	if err != nil || len(result.PartnerResults) == 0 {
+		if err == nil {
+			err = fmt.Errorf("the gaming module does not flip entangled coins")
+		}
+		reply("❌ The coins are unavailable: " + err.Error())
+		return
+	}
+	settle("", createChallengeEmbed(challengerID, opponentID, result.Results[0], result.PartnerResults[0]))
+}
+
+func createChallengeEmbed(challengerID, opponentID string, challengerHeads, opponentHeads bool) *discordgo.MessageEmbed {
+	winner := challengerID
+	if opponentHeads {
+		winner = opponentID
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       "🪙 Entangled Coin Flip",
+		Description: fmt.Sprintf("🏆 <@%s> wins!", winner),
+		Color:       0x9B59B6,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Challenger", Value: fmt.Sprintf("<@%s>\n%s", challengerID, coinFace(challengerHeads)), Inline: true},
+			{Name: "Opponent", Value: fmt.Sprintf("<@%s>\n%s", opponentID, coinFace(opponentHeads)), Inline: true},
+			{
+				Name:  "⚛️ Correlation",
+				Value: "Both coins were halves of the Bell state (|01⟩ + |10⟩)/√2: measuring one decided the other",
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Flipped by qubit measurement",
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
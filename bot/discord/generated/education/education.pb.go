@@ -0,0 +1,8186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: education.proto
+
+package generated
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Lesson Markdown is stored as canonical UTF-8, with math between $ or $$
+// written in TeX (\ket{0}, \frac{1}{\sqrt{2}}). Renderings write the math
+// out in Unicode.
+type RenderFormat int32
+
+const (
+	RenderFormat_RENDER_MARKDOWN RenderFormat = 0 // As stored
+	RenderFormat_RENDER_HTML     RenderFormat = 1 // Math in <span class="math" data-tex="…">
+	RenderFormat_RENDER_ANSI     RenderFormat = 2 // For terminals
+	RenderFormat_RENDER_DISCORD  RenderFormat = 3 // Discord's markdown subset
+)
+
+// Enum value maps for RenderFormat.
+var (
+	RenderFormat_name = map[int32]string{
+		0: "RENDER_MARKDOWN",
+		1: "RENDER_HTML",
+		2: "RENDER_ANSI",
+		3: "RENDER_DISCORD",
+	}
+	RenderFormat_value = map[string]int32{
+		"RENDER_MARKDOWN": 0,
+		"RENDER_HTML":     1,
+		"RENDER_ANSI":     2,
+		"RENDER_DISCORD":  3,
+	}
+)
+
+func (x RenderFormat) Enum() *RenderFormat {
+	p := new(RenderFormat)
+	*p = x
+	return p
+}
+
+func (x RenderFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RenderFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[0].Descriptor()
+}
+
+func (RenderFormat) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[0]
+}
+
+func (x RenderFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RenderFormat.Descriptor instead.
+func (RenderFormat) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{0}
+}
+
+type Topic int32
+
+const (
+	Topic_TOPIC_UNSPECIFIED   Topic = 0 // Any topic, in requests
+	Topic_TOPIC_SUPERPOSITION Topic = 1
+	Topic_TOPIC_ENTANGLEMENT  Topic = 2
+	Topic_TOPIC_GATES         Topic = 3
+	Topic_TOPIC_MEASUREMENT   Topic = 4
+	Topic_TOPIC_ALGORITHMS    Topic = 5
+	Topic_TOPIC_QFT           Topic = 6
+	Topic_TOPIC_GROVER        Topic = 7
+	Topic_TOPIC_SHOR          Topic = 8
+	Topic_TOPIC_VQE           Topic = 9
+	Topic_TOPIC_QAOA          Topic = 10
+)
+
+// Enum value maps for Topic.
+var (
+	Topic_name = map[int32]string{
+		0:  "TOPIC_UNSPECIFIED",
+		1:  "TOPIC_SUPERPOSITION",
+		2:  "TOPIC_ENTANGLEMENT",
+		3:  "TOPIC_GATES",
+		4:  "TOPIC_MEASUREMENT",
+		5:  "TOPIC_ALGORITHMS",
+		6:  "TOPIC_QFT",
+		7:  "TOPIC_GROVER",
+		8:  "TOPIC_SHOR",
+		9:  "TOPIC_VQE",
+		10: "TOPIC_QAOA",
+	}
+	Topic_value = map[string]int32{
+		"TOPIC_UNSPECIFIED":   0,
+		"TOPIC_SUPERPOSITION": 1,
+		"TOPIC_ENTANGLEMENT":  2,
+		"TOPIC_GATES":         3,
+		"TOPIC_MEASUREMENT":   4,
+		"TOPIC_ALGORITHMS":    5,
+		"TOPIC_QFT":           6,
+		"TOPIC_GROVER":        7,
+		"TOPIC_SHOR":          8,
+		"TOPIC_VQE":           9,
+		"TOPIC_QAOA":          10,
+	}
+)
+
+func (x Topic) Enum() *Topic {
+	p := new(Topic)
+	*p = x
+	return p
+}
+
+func (x Topic) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Topic) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[1].Descriptor()
+}
+
+func (Topic) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[1]
+}
+
+func (x Topic) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Topic.Descriptor instead.
+func (Topic) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{1}
+}
+
+type Difficulty int32
+
+const (
+	Difficulty_DIFFICULTY_UNSPECIFIED  Difficulty = 0 // Any difficulty, in requests
+	Difficulty_DIFFICULTY_BEGINNER     Difficulty = 1
+	Difficulty_DIFFICULTY_INTERMEDIATE Difficulty = 2
+	Difficulty_DIFFICULTY_ADVANCED     Difficulty = 3
+	Difficulty_DIFFICULTY_EXPERT       Difficulty = 4
+)
+
+// Enum value maps for Difficulty.
+var (
+	Difficulty_name = map[int32]string{
+		0: "DIFFICULTY_UNSPECIFIED",
+		1: "DIFFICULTY_BEGINNER",
+		2: "DIFFICULTY_INTERMEDIATE",
+		3: "DIFFICULTY_ADVANCED",
+		4: "DIFFICULTY_EXPERT",
+	}
+	Difficulty_value = map[string]int32{
+		"DIFFICULTY_UNSPECIFIED":  0,
+		"DIFFICULTY_BEGINNER":     1,
+		"DIFFICULTY_INTERMEDIATE": 2,
+		"DIFFICULTY_ADVANCED":     3,
+		"DIFFICULTY_EXPERT":       4,
+	}
+)
+
+func (x Difficulty) Enum() *Difficulty {
+	p := new(Difficulty)
+	*p = x
+	return p
+}
+
+func (x Difficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Difficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[2].Descriptor()
+}
+
+func (Difficulty) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[2]
+}
+
+func (x Difficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Difficulty.Descriptor instead.
+func (Difficulty) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{2}
+}
+
+type LessonStatus int32
+
+const (
+	LessonStatus_LESSON_STATUS_UNSPECIFIED LessonStatus = 0
+	LessonStatus_LESSON_LOCKED             LessonStatus = 1 // Prerequisites unfinished
+	LessonStatus_LESSON_AVAILABLE          LessonStatus = 2
+	LessonStatus_LESSON_COMPLETED          LessonStatus = 3
+)
+
+// Enum value maps for LessonStatus.
+var (
+	LessonStatus_name = map[int32]string{
+		0: "LESSON_STATUS_UNSPECIFIED",
+		1: "LESSON_LOCKED",
+		2: "LESSON_AVAILABLE",
+		3: "LESSON_COMPLETED",
+	}
+	LessonStatus_value = map[string]int32{
+		"LESSON_STATUS_UNSPECIFIED": 0,
+		"LESSON_LOCKED":             1,
+		"LESSON_AVAILABLE":          2,
+		"LESSON_COMPLETED":          3,
+	}
+)
+
+func (x LessonStatus) Enum() *LessonStatus {
+	p := new(LessonStatus)
+	*p = x
+	return p
+}
+
+func (x LessonStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LessonStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[3].Descriptor()
+}
+
+func (LessonStatus) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[3]
+}
+
+func (x LessonStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LessonStatus.Descriptor instead.
+func (LessonStatus) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{3}
+}
+
+type CertificateFormat int32
+
+const (
+	CertificateFormat_CERTIFICATE_PNG CertificateFormat = 0
+	CertificateFormat_CERTIFICATE_PDF CertificateFormat = 1
+)
+
+// Enum value maps for CertificateFormat.
+var (
+	CertificateFormat_name = map[int32]string{
+		0: "CERTIFICATE_PNG",
+		1: "CERTIFICATE_PDF",
+	}
+	CertificateFormat_value = map[string]int32{
+		"CERTIFICATE_PNG": 0,
+		"CERTIFICATE_PDF": 1,
+	}
+)
+
+func (x CertificateFormat) Enum() *CertificateFormat {
+	p := new(CertificateFormat)
+	*p = x
+	return p
+}
+
+func (x CertificateFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CertificateFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[4].Descriptor()
+}
+
+func (CertificateFormat) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[4]
+}
+
+func (x CertificateFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CertificateFormat.Descriptor instead.
+func (CertificateFormat) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{4}
+}
+
+type AssignmentKind int32
+
+const (
+	AssignmentKind_ASSIGNMENT_LESSON    AssignmentKind = 0
+	AssignmentKind_ASSIGNMENT_CHALLENGE AssignmentKind = 1
+)
+
+// Enum value maps for AssignmentKind.
+var (
+	AssignmentKind_name = map[int32]string{
+		0: "ASSIGNMENT_LESSON",
+		1: "ASSIGNMENT_CHALLENGE",
+	}
+	AssignmentKind_value = map[string]int32{
+		"ASSIGNMENT_LESSON":    0,
+		"ASSIGNMENT_CHALLENGE": 1,
+	}
+)
+
+func (x AssignmentKind) Enum() *AssignmentKind {
+	p := new(AssignmentKind)
+	*p = x
+	return p
+}
+
+func (x AssignmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[5].Descriptor()
+}
+
+func (AssignmentKind) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[5]
+}
+
+func (x AssignmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentKind.Descriptor instead.
+func (AssignmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{5}
+}
+
+type AssignmentStatus int32
+
+const (
+	AssignmentStatus_ASSIGNMENT_PENDING AssignmentStatus = 0
+	AssignmentStatus_ASSIGNMENT_DONE    AssignmentStatus = 1 // By the deadline, or with none
+	AssignmentStatus_ASSIGNMENT_LATE    AssignmentStatus = 2 // Done after the deadline
+	AssignmentStatus_ASSIGNMENT_OVERDUE AssignmentStatus = 3 // Not done, and the deadline has passed
+)
+
+// Enum value maps for AssignmentStatus.
+var (
+	AssignmentStatus_name = map[int32]string{
+		0: "ASSIGNMENT_PENDING",
+		1: "ASSIGNMENT_DONE",
+		2: "ASSIGNMENT_LATE",
+		3: "ASSIGNMENT_OVERDUE",
+	}
+	AssignmentStatus_value = map[string]int32{
+		"ASSIGNMENT_PENDING": 0,
+		"ASSIGNMENT_DONE":    1,
+		"ASSIGNMENT_LATE":    2,
+		"ASSIGNMENT_OVERDUE": 3,
+	}
+)
+
+func (x AssignmentStatus) Enum() *AssignmentStatus {
+	p := new(AssignmentStatus)
+	*p = x
+	return p
+}
+
+func (x AssignmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[6].Descriptor()
+}
+
+func (AssignmentStatus) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[6]
+}
+
+func (x AssignmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentStatus.Descriptor instead.
+func (AssignmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{6}
+}
+
+type QuestionType int32
+
+const (
+	QuestionType_QUESTION_MULTIPLE_CHOICE      QuestionType = 0
+	QuestionType_QUESTION_TRUE_FALSE           QuestionType = 1
+	QuestionType_QUESTION_CIRCUIT_OUTPUT       QuestionType = 2
+	QuestionType_QUESTION_FILL_BLANK           QuestionType = 3
+	QuestionType_QUESTION_CIRCUIT_CONSTRUCTION QuestionType = 4 // Answer with gates; graded on the engine
+)
+
+// Enum value maps for QuestionType.
+var (
+	QuestionType_name = map[int32]string{
+		0: "QUESTION_MULTIPLE_CHOICE",
+		1: "QUESTION_TRUE_FALSE",
+		2: "QUESTION_CIRCUIT_OUTPUT",
+		3: "QUESTION_FILL_BLANK",
+		4: "QUESTION_CIRCUIT_CONSTRUCTION",
+	}
+	QuestionType_value = map[string]int32{
+		"QUESTION_MULTIPLE_CHOICE":      0,
+		"QUESTION_TRUE_FALSE":           1,
+		"QUESTION_CIRCUIT_OUTPUT":       2,
+		"QUESTION_FILL_BLANK":           3,
+		"QUESTION_CIRCUIT_CONSTRUCTION": 4,
+	}
+)
+
+func (x QuestionType) Enum() *QuestionType {
+	p := new(QuestionType)
+	*p = x
+	return p
+}
+
+func (x QuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[7].Descriptor()
+}
+
+func (QuestionType) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[7]
+}
+
+func (x QuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionType.Descriptor instead.
+func (QuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{7}
+}
+
+// Quiz leaderboards run for a week, Monday 00:00 UTC to the next, and
+// start empty each week. Points are those earned on questions of the
+// topic. An answer's time runs from the quiz's start or the learner's
+// previous submission; answers submitted together share it. A daily
+// streak counts consecutive UTC days with a completed quiz.
+type QuizBoardOrder int32
+
+const (
+	QuizBoardOrder_QUIZ_BOARD_POINTS  QuizBoardOrder = 0
+	QuizBoardOrder_QUIZ_BOARD_FASTEST QuizBoardOrder = 1 // Fastest right answer
+	QuizBoardOrder_QUIZ_BOARD_STREAK  QuizBoardOrder = 2 // Current daily streak
+)
+
+// Enum value maps for QuizBoardOrder.
+var (
+	QuizBoardOrder_name = map[int32]string{
+		0: "QUIZ_BOARD_POINTS",
+		1: "QUIZ_BOARD_FASTEST",
+		2: "QUIZ_BOARD_STREAK",
+	}
+	QuizBoardOrder_value = map[string]int32{
+		"QUIZ_BOARD_POINTS":  0,
+		"QUIZ_BOARD_FASTEST": 1,
+		"QUIZ_BOARD_STREAK":  2,
+	}
+)
+
+func (x QuizBoardOrder) Enum() *QuizBoardOrder {
+	p := new(QuizBoardOrder)
+	*p = x
+	return p
+}
+
+func (x QuizBoardOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizBoardOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_education_proto_enumTypes[8].Descriptor()
+}
+
+func (QuizBoardOrder) Type() protoreflect.EnumType {
+	return &file_education_proto_enumTypes[8]
+}
+
+func (x QuizBoardOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizBoardOrder.Descriptor instead.
+func (QuizBoardOrder) EnumDescriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{8}
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_education_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{0}
+}
+
+type LessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         Topic                  `protobuf:"varint,1,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Difficulty    Difficulty             `protobuf:"varint,2,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	LessonId      string                 `protobuf:"bytes,3,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"` // Takes precedence over topic
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                  // With lesson_id: an earlier version (default current)
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Format        RenderFormat           `protobuf:"varint,6,opt,name=format,proto3,enum=qubit_engine.education.RenderFormat" json:"format,omitempty"` // For content_rendered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonRequest) Reset() {
+	*x = LessonRequest{}
+	mi := &file_education_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonRequest) ProtoMessage() {}
+
+func (x *LessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonRequest.ProtoReflect.Descriptor instead.
+func (*LessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LessonRequest) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *LessonRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *LessonRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *LessonRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LessonRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *LessonRequest) GetFormat() RenderFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RenderFormat_RENDER_MARKDOWN
+}
+
+type LessonListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonListRequest) Reset() {
+	*x = LessonListRequest{}
+	mi := &file_education_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonListRequest) ProtoMessage() {}
+
+func (x *LessonListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonListRequest.ProtoReflect.Descriptor instead.
+func (*LessonListRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LessonListRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type Lesson struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic               Topic                  `protobuf:"varint,2,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Title               string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ContentMarkdown     string                 `protobuf:"bytes,4,opt,name=content_markdown,json=contentMarkdown,proto3" json:"content_markdown,omitempty"`
+	KeyConcepts         []string               `protobuf:"bytes,5,rep,name=key_concepts,json=keyConcepts,proto3" json:"key_concepts,omitempty"`
+	CircuitExamples     []string               `protobuf:"bytes,6,rep,name=circuit_examples,json=circuitExamples,proto3" json:"circuit_examples,omitempty"` // Circuit IDs to demonstrate
+	NextLessonId        string                 `protobuf:"bytes,7,opt,name=next_lesson_id,json=nextLessonId,proto3" json:"next_lesson_id,omitempty"`        // Superseded by prerequisites and tracks
+	EstimatedMinutes    int32                  `protobuf:"varint,8,opt,name=estimated_minutes,json=estimatedMinutes,proto3" json:"estimated_minutes,omitempty"`
+	Difficulty          Difficulty             `protobuf:"varint,9,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	Version             int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	Author              string                 `protobuf:"bytes,11,opt,name=author,proto3" json:"author,omitempty"`
+	UpdatedAt           int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Prerequisites       []string               `protobuf:"bytes,13,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`                                         // Lesson IDs to finish first
+	Language            string                 `protobuf:"bytes,14,opt,name=language,proto3" json:"language,omitempty"`                                                   // Language served, "en" if untranslated
+	TranslationOutdated bool                   `protobuf:"varint,15,opt,name=translation_outdated,json=translationOutdated,proto3" json:"translation_outdated,omitempty"` // Translated from an earlier version
+	ContentRendered     string                 `protobuf:"bytes,16,opt,name=content_rendered,json=contentRendered,proto3" json:"content_rendered,omitempty"`              // content_markdown in the requested format
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Lesson) Reset() {
+	*x = Lesson{}
+	mi := &file_education_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lesson) ProtoMessage() {}
+
+func (x *Lesson) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lesson.ProtoReflect.Descriptor instead.
+func (*Lesson) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Lesson) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lesson) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *Lesson) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Lesson) GetContentMarkdown() string {
+	if x != nil {
+		return x.ContentMarkdown
+	}
+	return ""
+}
+
+func (x *Lesson) GetKeyConcepts() []string {
+	if x != nil {
+		return x.KeyConcepts
+	}
+	return nil
+}
+
+func (x *Lesson) GetCircuitExamples() []string {
+	if x != nil {
+		return x.CircuitExamples
+	}
+	return nil
+}
+
+func (x *Lesson) GetNextLessonId() string {
+	if x != nil {
+		return x.NextLessonId
+	}
+	return ""
+}
+
+func (x *Lesson) GetEstimatedMinutes() int32 {
+	if x != nil {
+		return x.EstimatedMinutes
+	}
+	return 0
+}
+
+func (x *Lesson) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *Lesson) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Lesson) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Lesson) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Lesson) GetPrerequisites() []string {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *Lesson) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Lesson) GetTranslationOutdated() bool {
+	if x != nil {
+		return x.TranslationOutdated
+	}
+	return false
+}
+
+func (x *Lesson) GetContentRendered() string {
+	if x != nil {
+		return x.ContentRendered
+	}
+	return ""
+}
+
+type LessonCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*LessonSummary       `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonCatalog) Reset() {
+	*x = LessonCatalog{}
+	mi := &file_education_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonCatalog) ProtoMessage() {}
+
+func (x *LessonCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonCatalog.ProtoReflect.Descriptor instead.
+func (*LessonCatalog) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LessonCatalog) GetLessons() []*LessonSummary {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+type LessonSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic            Topic                  `protobuf:"varint,2,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Difficulty       Difficulty             `protobuf:"varint,4,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	EstimatedMinutes int32                  `protobuf:"varint,5,opt,name=estimated_minutes,json=estimatedMinutes,proto3" json:"estimated_minutes,omitempty"`
+	Version          int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Prerequisites    []string               `protobuf:"bytes,7,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	Language         string                 `protobuf:"bytes,8,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LessonSummary) Reset() {
+	*x = LessonSummary{}
+	mi := &file_education_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonSummary) ProtoMessage() {}
+
+func (x *LessonSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonSummary.ProtoReflect.Descriptor instead.
+func (*LessonSummary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LessonSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LessonSummary) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *LessonSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LessonSummary) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *LessonSummary) GetEstimatedMinutes() int32 {
+	if x != nil {
+		return x.EstimatedMinutes
+	}
+	return 0
+}
+
+func (x *LessonSummary) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LessonSummary) GetPrerequisites() []string {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *LessonSummary) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Lessons are versioned: every save adds a version, and a save naming
+// anything but the current version is refused so edits are not lost.
+// A lesson with a language other than English saves its title, key
+// concepts and content as a translation of the current version.
+type PutLessonRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Lesson          *Lesson                `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`                                           // version and updated_at are ignored
+	ExpectedVersion int32                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version edited; 0 for a new lesson
+	AuthorToken     string                 `protobuf:"bytes,3,opt,name=author_token,json=authorToken,proto3" json:"author_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutLessonRequest) Reset() {
+	*x = PutLessonRequest{}
+	mi := &file_education_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutLessonRequest) ProtoMessage() {}
+
+func (x *PutLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutLessonRequest.ProtoReflect.Descriptor instead.
+func (*PutLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PutLessonRequest) GetLesson() *Lesson {
+	if x != nil {
+		return x.Lesson
+	}
+	return nil
+}
+
+func (x *PutLessonRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *PutLessonRequest) GetAuthorToken() string {
+	if x != nil {
+		return x.AuthorToken
+	}
+	return ""
+}
+
+type LessonHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonHistoryRequest) Reset() {
+	*x = LessonHistoryRequest{}
+	mi := &file_education_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonHistoryRequest) ProtoMessage() {}
+
+func (x *LessonHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonHistoryRequest.ProtoReflect.Descriptor instead.
+func (*LessonHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LessonHistoryRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+type LessonVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonVersion) Reset() {
+	*x = LessonVersion{}
+	mi := &file_education_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonVersion) ProtoMessage() {}
+
+func (x *LessonVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonVersion.ProtoReflect.Descriptor instead.
+func (*LessonVersion) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LessonVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LessonVersion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LessonVersion) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *LessonVersion) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type LessonHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Versions      []*LessonVersion       `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonHistory) Reset() {
+	*x = LessonHistory{}
+	mi := &file_education_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonHistory) ProtoMessage() {}
+
+func (x *LessonHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonHistory.ProtoReflect.Descriptor instead.
+func (*LessonHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LessonHistory) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *LessonHistory) GetVersions() []*LessonVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RenderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Markdown      string                 `protobuf:"bytes,1,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	Format        RenderFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=qubit_engine.education.RenderFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderRequest) Reset() {
+	*x = RenderRequest{}
+	mi := &file_education_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderRequest) ProtoMessage() {}
+
+func (x *RenderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderRequest.ProtoReflect.Descriptor instead.
+func (*RenderRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RenderRequest) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+func (x *RenderRequest) GetFormat() RenderFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RenderFormat_RENDER_MARKDOWN
+}
+
+type RenderedContent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CanonicalMarkdown string                 `protobuf:"bytes,1,opt,name=canonical_markdown,json=canonicalMarkdown,proto3" json:"canonical_markdown,omitempty"` // As it would be stored
+	Rendered          string                 `protobuf:"bytes,2,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	Repaired          bool                   `protobuf:"varint,3,opt,name=repaired,proto3" json:"repaired,omitempty"` // Canonicalizing changed the text
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RenderedContent) Reset() {
+	*x = RenderedContent{}
+	mi := &file_education_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderedContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderedContent) ProtoMessage() {}
+
+func (x *RenderedContent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderedContent.ProtoReflect.Descriptor instead.
+func (*RenderedContent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RenderedContent) GetCanonicalMarkdown() string {
+	if x != nil {
+		return x.CanonicalMarkdown
+	}
+	return ""
+}
+
+func (x *RenderedContent) GetRendered() string {
+	if x != nil {
+		return x.Rendered
+	}
+	return ""
+}
+
+func (x *RenderedContent) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type LanguageCoverage struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Language            string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	LessonsTranslated   int32                  `protobuf:"varint,2,opt,name=lessons_translated,json=lessonsTranslated,proto3" json:"lessons_translated,omitempty"`
+	LessonsOutdated     int32                  `protobuf:"varint,3,opt,name=lessons_outdated,json=lessonsOutdated,proto3" json:"lessons_outdated,omitempty"` // Translated from an earlier version
+	QuestionsTranslated int32                  `protobuf:"varint,4,opt,name=questions_translated,json=questionsTranslated,proto3" json:"questions_translated,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LanguageCoverage) Reset() {
+	*x = LanguageCoverage{}
+	mi := &file_education_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LanguageCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageCoverage) ProtoMessage() {}
+
+func (x *LanguageCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageCoverage.ProtoReflect.Descriptor instead.
+func (*LanguageCoverage) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LanguageCoverage) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *LanguageCoverage) GetLessonsTranslated() int32 {
+	if x != nil {
+		return x.LessonsTranslated
+	}
+	return 0
+}
+
+func (x *LanguageCoverage) GetLessonsOutdated() int32 {
+	if x != nil {
+		return x.LessonsOutdated
+	}
+	return 0
+}
+
+func (x *LanguageCoverage) GetQuestionsTranslated() int32 {
+	if x != nil {
+		return x.QuestionsTranslated
+	}
+	return 0
+}
+
+type LanguageCatalog struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Languages      []*LanguageCoverage    `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"` // English first, as the source
+	TotalLessons   int32                  `protobuf:"varint,2,opt,name=total_lessons,json=totalLessons,proto3" json:"total_lessons,omitempty"`
+	TotalQuestions int32                  `protobuf:"varint,3,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LanguageCatalog) Reset() {
+	*x = LanguageCatalog{}
+	mi := &file_education_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LanguageCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageCatalog) ProtoMessage() {}
+
+func (x *LanguageCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageCatalog.ProtoReflect.Descriptor instead.
+func (*LanguageCatalog) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LanguageCatalog) GetLanguages() []*LanguageCoverage {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *LanguageCatalog) GetTotalLessons() int32 {
+	if x != nil {
+		return x.TotalLessons
+	}
+	return 0
+}
+
+func (x *LanguageCatalog) GetTotalQuestions() int32 {
+	if x != nil {
+		return x.TotalQuestions
+	}
+	return 0
+}
+
+// A course bundle is a zip of course.json (the manifest), lessons/<id>.md
+// in the lesson file format, circuits/<id>.json and questions.json.
+type CourseManifest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        int32                  `protobuf:"varint,1,opt,name=format,proto3" json:"format,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExportedAt    int64                  `protobuf:"varint,4,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	LessonIds     []string               `protobuf:"bytes,5,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"` // Prerequisites first
+	CircuitIds    []string               `protobuf:"bytes,6,rep,name=circuit_ids,json=circuitIds,proto3" json:"circuit_ids,omitempty"`
+	QuestionIds   []string               `protobuf:"bytes,7,rep,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseManifest) Reset() {
+	*x = CourseManifest{}
+	mi := &file_education_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseManifest) ProtoMessage() {}
+
+func (x *CourseManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseManifest.ProtoReflect.Descriptor instead.
+func (*CourseManifest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CourseManifest) GetFormat() int32 {
+	if x != nil {
+		return x.Format
+	}
+	return 0
+}
+
+func (x *CourseManifest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseManifest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CourseManifest) GetExportedAt() int64 {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return 0
+}
+
+func (x *CourseManifest) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
+}
+
+func (x *CourseManifest) GetCircuitIds() []string {
+	if x != nil {
+		return x.CircuitIds
+	}
+	return nil
+}
+
+func (x *CourseManifest) GetQuestionIds() []string {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+// Exports a track, or the lessons named, with every lesson they build on,
+// the circuits they show and the questions on their topics
+type ExportCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrackId       string                 `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	LessonIds     []string               `protobuf:"bytes,2,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // Defaults to the track's name
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	AuthorToken   string                 `protobuf:"bytes,5,opt,name=author_token,json=authorToken,proto3" json:"author_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCourseRequest) Reset() {
+	*x = ExportCourseRequest{}
+	mi := &file_education_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCourseRequest) ProtoMessage() {}
+
+func (x *ExportCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCourseRequest.ProtoReflect.Descriptor instead.
+func (*ExportCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportCourseRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *ExportCourseRequest) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
+}
+
+func (x *ExportCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExportCourseRequest) GetAuthorToken() string {
+	if x != nil {
+		return x.AuthorToken
+	}
+	return ""
+}
+
+type CourseBundle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        []byte                 `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Manifest      *CourseManifest        `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseBundle) Reset() {
+	*x = CourseBundle{}
+	mi := &file_education_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseBundle) ProtoMessage() {}
+
+func (x *CourseBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseBundle.ProtoReflect.Descriptor instead.
+func (*CourseBundle) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CourseBundle) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *CourseBundle) GetManifest() *CourseManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+// Items that differ from ones already here are skipped unless replace is
+// set; replaced lessons get a new version, so their history is kept
+type ImportCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        []byte                 `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	AuthorToken   string                 `protobuf:"bytes,2,opt,name=author_token,json=authorToken,proto3" json:"author_token,omitempty"`
+	Replace       bool                   `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report what would change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCourseRequest) Reset() {
+	*x = ImportCourseRequest{}
+	mi := &file_education_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCourseRequest) ProtoMessage() {}
+
+func (x *ImportCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCourseRequest.ProtoReflect.Descriptor instead.
+func (*ImportCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportCourseRequest) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportCourseRequest) GetAuthorToken() string {
+	if x != nil {
+		return x.AuthorToken
+	}
+	return ""
+}
+
+func (x *ImportCourseRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *ImportCourseRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportCourseResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *CourseManifest        `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Imported      []string               `protobuf:"bytes,2,rep,name=imported,proto3" json:"imported,omitempty"` // "lesson:<id>", "circuit:<id>" or "question:<id>"
+	Unchanged     []string               `protobuf:"bytes,3,rep,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Skipped       []string               `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	Warnings      []string               `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCourseResult) Reset() {
+	*x = ImportCourseResult{}
+	mi := &file_education_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCourseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCourseResult) ProtoMessage() {}
+
+func (x *ImportCourseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCourseResult.ProtoReflect.Descriptor instead.
+func (*ImportCourseResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportCourseResult) GetManifest() *CourseManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ImportCourseResult) GetImported() []string {
+	if x != nil {
+		return x.Imported
+	}
+	return nil
+}
+
+func (x *ImportCourseResult) GetUnchanged() []string {
+	if x != nil {
+		return x.Unchanged
+	}
+	return nil
+}
+
+func (x *ImportCourseResult) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportCourseResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportCourseResult) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type Track struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // "beginner", "algorithms", "cryptography"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LessonIds     []string               `protobuf:"bytes,4,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"` // Goal lessons
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	mi := &file_education_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Track) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Track) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Track) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Track) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
+}
+
+type TrackCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tracks        []*Track               `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackCatalog) Reset() {
+	*x = TrackCatalog{}
+	mi := &file_education_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackCatalog) ProtoMessage() {}
+
+func (x *TrackCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackCatalog.ProtoReflect.Descriptor instead.
+func (*TrackCatalog) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TrackCatalog) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type LearningPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackId       string                 `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPathRequest) Reset() {
+	*x = LearningPathRequest{}
+	mi := &file_education_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathRequest) ProtoMessage() {}
+
+func (x *LearningPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathRequest.ProtoReflect.Descriptor instead.
+func (*LearningPathRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LearningPathRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LearningPathRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *LearningPathRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type PathStep struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Lesson               *LessonSummary         `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
+	Status               LessonStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=qubit_engine.education.LessonStatus" json:"status,omitempty"`
+	MissingPrerequisites []string               `protobuf:"bytes,3,rep,name=missing_prerequisites,json=missingPrerequisites,proto3" json:"missing_prerequisites,omitempty"`
+	CompletedAt          int64                  `protobuf:"varint,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PathStep) Reset() {
+	*x = PathStep{}
+	mi := &file_education_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathStep) ProtoMessage() {}
+
+func (x *PathStep) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathStep.ProtoReflect.Descriptor instead.
+func (*PathStep) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PathStep) GetLesson() *LessonSummary {
+	if x != nil {
+		return x.Lesson
+	}
+	return nil
+}
+
+func (x *PathStep) GetStatus() LessonStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LessonStatus_LESSON_STATUS_UNSPECIFIED
+}
+
+func (x *PathStep) GetMissingPrerequisites() []string {
+	if x != nil {
+		return x.MissingPrerequisites
+	}
+	return nil
+}
+
+func (x *PathStep) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type LearningPath struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TrackId          string                 `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Steps            []*PathStep            `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"` // Every prerequisite comes before its lesson
+	Completed        int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Total            int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	MinutesRemaining int32                  `protobuf:"varint,6,opt,name=minutes_remaining,json=minutesRemaining,proto3" json:"minutes_remaining,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LearningPath) Reset() {
+	*x = LearningPath{}
+	mi := &file_education_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPath) ProtoMessage() {}
+
+func (x *LearningPath) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPath.ProtoReflect.Descriptor instead.
+func (*LearningPath) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LearningPath) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *LearningPath) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LearningPath) GetSteps() []*PathStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *LearningPath) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *LearningPath) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LearningPath) GetMinutesRemaining() int32 {
+	if x != nil {
+		return x.MinutesRemaining
+	}
+	return 0
+}
+
+type RecommendationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackId       string                 `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"` // Empty tries each track in turn
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationRequest) Reset() {
+	*x = RecommendationRequest{}
+	mi := &file_education_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationRequest) ProtoMessage() {}
+
+func (x *RecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationRequest.ProtoReflect.Descriptor instead.
+func (*RecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecommendationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecommendationRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *RecommendationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lesson        *LessonSummary         `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"` // Unset once everything is finished
+	TrackId       string                 `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	AllCompleted  bool                   `protobuf:"varint,4,opt,name=all_completed,json=allCompleted,proto3" json:"all_completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_education_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Recommendation) GetLesson() *LessonSummary {
+	if x != nil {
+		return x.Lesson
+	}
+	return nil
+}
+
+func (x *Recommendation) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *Recommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Recommendation) GetAllCompleted() bool {
+	if x != nil {
+		return x.AllCompleted
+	}
+	return false
+}
+
+type CompleteLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LessonId      string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	LearnerName   string                 `protobuf:"bytes,4,opt,name=learner_name,json=learnerName,proto3" json:"learner_name,omitempty"` // Printed on certificates this completion earns
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteLessonRequest) Reset() {
+	*x = CompleteLessonRequest{}
+	mi := &file_education_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteLessonRequest) ProtoMessage() {}
+
+func (x *CompleteLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteLessonRequest.ProtoReflect.Descriptor instead.
+func (*CompleteLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CompleteLessonRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompleteLessonRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CompleteLessonRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CompleteLessonRequest) GetLearnerName() string {
+	if x != nil {
+		return x.LearnerName
+	}
+	return ""
+}
+
+type LessonCompletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Unlocked      []*Badge               `protobuf:"bytes,2,rep,name=unlocked,proto3" json:"unlocked,omitempty"`
+	Next          *Recommendation        `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	Certificates  []*Certificate         `protobuf:"bytes,4,rep,name=certificates,proto3" json:"certificates,omitempty"` // Tracks this lesson finished
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonCompletion) Reset() {
+	*x = LessonCompletion{}
+	mi := &file_education_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonCompletion) ProtoMessage() {}
+
+func (x *LessonCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonCompletion.ProtoReflect.Descriptor instead.
+func (*LessonCompletion) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LessonCompletion) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *LessonCompletion) GetUnlocked() []*Badge {
+	if x != nil {
+		return x.Unlocked
+	}
+	return nil
+}
+
+func (x *LessonCompletion) GetNext() *Recommendation {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *LessonCompletion) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type Certificate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // e.g. "QE-7K3M-Q2XA-9DLP-4RTE"
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LearnerName   string                 `protobuf:"bytes,3,opt,name=learner_name,json=learnerName,proto3" json:"learner_name,omitempty"`
+	TrackId       string                 `protobuf:"bytes,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	TrackName     string                 `protobuf:"bytes,5,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	LessonIds     []string               `protobuf:"bytes,6,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"`
+	Scores        []*TopicScore          `protobuf:"bytes,7,rep,name=scores,proto3" json:"scores,omitempty"`
+	Circuits      []*BuiltCircuit        `protobuf:"bytes,8,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	IssuedAt      int64                  `protobuf:"varint,9,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Signature     string                 `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"` // Base64 Ed25519 signature
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	mi := &file_education_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Certificate) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Certificate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Certificate) GetLearnerName() string {
+	if x != nil {
+		return x.LearnerName
+	}
+	return ""
+}
+
+func (x *Certificate) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *Certificate) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *Certificate) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
+}
+
+func (x *Certificate) GetScores() []*TopicScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Certificate) GetCircuits() []*BuiltCircuit {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+func (x *Certificate) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *Certificate) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type TopicScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         Topic                  `protobuf:"varint,1,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"` // Unspecified: quizzes across every topic
+	BestPercent   int32                  `protobuf:"varint,2,opt,name=best_percent,json=bestPercent,proto3" json:"best_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicScore) Reset() {
+	*x = TopicScore{}
+	mi := &file_education_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicScore) ProtoMessage() {}
+
+func (x *TopicScore) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicScore.ProtoReflect.Descriptor instead.
+func (*TopicScore) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TopicScore) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *TopicScore) GetBestPercent() int32 {
+	if x != nil {
+		return x.BestPercent
+	}
+	return 0
+}
+
+// A circuit the learner built to solve a question or challenge
+type BuiltCircuit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // "question:q6" or "challenge:ghz_four"
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Gates         string                 `protobuf:"bytes,3,opt,name=gates,proto3" json:"gates,omitempty"`  // e.g. "H q0; CNOT q0 q1"
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"` // Challenge points; 0 for questions
+	BuiltAt       int64                  `protobuf:"varint,5,opt,name=built_at,json=builtAt,proto3" json:"built_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuiltCircuit) Reset() {
+	*x = BuiltCircuit{}
+	mi := &file_education_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuiltCircuit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltCircuit) ProtoMessage() {}
+
+func (x *BuiltCircuit) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltCircuit.ProtoReflect.Descriptor instead.
+func (*BuiltCircuit) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BuiltCircuit) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *BuiltCircuit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BuiltCircuit) GetGates() string {
+	if x != nil {
+		return x.Gates
+	}
+	return ""
+}
+
+func (x *BuiltCircuit) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *BuiltCircuit) GetBuiltAt() int64 {
+	if x != nil {
+		return x.BuiltAt
+	}
+	return 0
+}
+
+type CertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackId       string                 `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Format        CertificateFormat      `protobuf:"varint,3,opt,name=format,proto3,enum=qubit_engine.education.CertificateFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateRequest) Reset() {
+	*x = CertificateRequest{}
+	mi := &file_education_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRequest) ProtoMessage() {}
+
+func (x *CertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRequest.ProtoReflect.Descriptor instead.
+func (*CertificateRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CertificateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CertificateRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *CertificateRequest) GetFormat() CertificateFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CertificateFormat_CERTIFICATE_PNG
+}
+
+type CertificateDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *Certificate           `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Document      []byte                 `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // "image/png" or "application/pdf"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateDocument) Reset() {
+	*x = CertificateDocument{}
+	mi := &file_education_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateDocument) ProtoMessage() {}
+
+func (x *CertificateDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateDocument.ProtoReflect.Descriptor instead.
+func (*CertificateDocument) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CertificateDocument) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *CertificateDocument) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *CertificateDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type VerifyCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Case and dashes are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCertificateRequest) Reset() {
+	*x = VerifyCertificateRequest{}
+	mi := &file_education_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCertificateRequest) ProtoMessage() {}
+
+func (x *VerifyCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCertificateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyCertificateRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CertificateVerification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Certificate   *Certificate           `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`              // Set when the code is known
+	PublicKey     string                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // Base64 Ed25519 key that signs certificates
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                        // Why a certificate is not valid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateVerification) Reset() {
+	*x = CertificateVerification{}
+	mi := &file_education_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateVerification) ProtoMessage() {}
+
+func (x *CertificateVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateVerification.ProtoReflect.Descriptor instead.
+func (*CertificateVerification) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CertificateVerification) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *CertificateVerification) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *CertificateVerification) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *CertificateVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Assignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // "a1", "a2"… within the class
+	Kind          AssignmentKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=qubit_engine.education.AssignmentKind" json:"kind,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // Lesson or challenge ID
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	DueAt         int64                  `protobuf:"varint,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"` // 0 for no deadline
+	AssignedAt    int64                  `protobuf:"varint,6,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	mi := &file_education_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Assignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assignment) GetKind() AssignmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AssignmentKind_ASSIGNMENT_LESSON
+}
+
+func (x *Assignment) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *Assignment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *Assignment) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
+type AssignmentProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Status        AssignmentStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=qubit_engine.education.AssignmentStatus" json:"status,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"` // Challenges: best score
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentProgress) Reset() {
+	*x = AssignmentProgress{}
+	mi := &file_education_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentProgress) ProtoMessage() {}
+
+func (x *AssignmentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentProgress.ProtoReflect.Descriptor instead.
+func (*AssignmentProgress) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AssignmentProgress) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *AssignmentProgress) GetStatus() AssignmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AssignmentStatus_ASSIGNMENT_PENDING
+}
+
+func (x *AssignmentProgress) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *AssignmentProgress) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type Class struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	InstructorIds []string               `protobuf:"bytes,3,rep,name=instructor_ids,json=instructorIds,proto3" json:"instructor_ids,omitempty"`
+	Assignments   []*Assignment          `protobuf:"bytes,4,rep,name=assignments,proto3" json:"assignments,omitempty"` // Soonest deadline first
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StudentCount  int32                  `protobuf:"varint,6,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	JoinCode      string                 `protobuf:"bytes,7,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`       // Instructors only
+	StudentIds    []string               `protobuf:"bytes,8,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"` // Instructors only
+	Progress      []*AssignmentProgress  `protobuf:"bytes,9,rep,name=progress,proto3" json:"progress,omitempty"`                       // A student's own, in ListClasses
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	mi := &file_education_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Class) GetInstructorIds() []string {
+	if x != nil {
+		return x.InstructorIds
+	}
+	return nil
+}
+
+func (x *Class) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *Class) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Class) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *Class) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *Class) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *Class) GetProgress() []*AssignmentProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type CreateClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  string                 `protobuf:"bytes,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClassRequest) Reset() {
+	*x = CreateClassRequest{}
+	mi := &file_education_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassRequest) ProtoMessage() {}
+
+func (x *CreateClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassRequest.ProtoReflect.Descriptor instead.
+func (*CreateClassRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateClassRequest) GetInstructorId() string {
+	if x != nil {
+		return x.InstructorId
+	}
+	return ""
+}
+
+func (x *CreateClassRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	InstructorId  string                 `protobuf:"bytes,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // Must teach the class
+	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	InstructorIds []string               `protobuf:"bytes,4,rep,name=instructor_ids,json=instructorIds,proto3" json:"instructor_ids,omitempty"` // Co-instructors
+	Remove        bool                   `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`                                   // Remove the users listed instead
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	mi := &file_education_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentRequest) ProtoMessage() {}
+
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EnrollmentRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetInstructorId() string {
+	if x != nil {
+		return x.InstructorId
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *EnrollmentRequest) GetInstructorIds() []string {
+	if x != nil {
+		return x.InstructorIds
+	}
+	return nil
+}
+
+func (x *EnrollmentRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type JoinClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinCode      string                 `protobuf:"bytes,1,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"` // Case is ignored
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinClassRequest) Reset() {
+	*x = JoinClassRequest{}
+	mi := &file_education_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClassRequest) ProtoMessage() {}
+
+func (x *JoinClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClassRequest.ProtoReflect.Descriptor instead.
+func (*JoinClassRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *JoinClassRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *JoinClassRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	InstructorId  string                 `protobuf:"bytes,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Kind          AssignmentKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=qubit_engine.education.AssignmentKind" json:"kind,omitempty"`
+	ItemId        string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	DueAt         int64                  `protobuf:"varint,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                     // 0 for no deadline
+	AssignmentId  string                 `protobuf:"bytes,6,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"` // Replace this assignment, e.g. to move its deadline
+	Remove        bool                   `protobuf:"varint,7,opt,name=remove,proto3" json:"remove,omitempty"`                                // With assignment_id: withdraw it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentRequest) Reset() {
+	*x = AssignmentRequest{}
+	mi := &file_education_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentRequest) ProtoMessage() {}
+
+func (x *AssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AssignmentRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *AssignmentRequest) GetInstructorId() string {
+	if x != nil {
+		return x.InstructorId
+	}
+	return ""
+}
+
+func (x *AssignmentRequest) GetKind() AssignmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AssignmentKind_ASSIGNMENT_LESSON
+}
+
+func (x *AssignmentRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *AssignmentRequest) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *AssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *AssignmentRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type ListClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassesRequest) Reset() {
+	*x = ListClassesRequest{}
+	mi := &file_education_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassesRequest) ProtoMessage() {}
+
+func (x *ListClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassesRequest.ProtoReflect.Descriptor instead.
+func (*ListClassesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListClassesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ClassList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teaching      []*Class               `protobuf:"bytes,1,rep,name=teaching,proto3" json:"teaching,omitempty"`
+	Enrolled      []*Class               `protobuf:"bytes,2,rep,name=enrolled,proto3" json:"enrolled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassList) Reset() {
+	*x = ClassList{}
+	mi := &file_education_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassList) ProtoMessage() {}
+
+func (x *ClassList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassList.ProtoReflect.Descriptor instead.
+func (*ClassList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ClassList) GetTeaching() []*Class {
+	if x != nil {
+		return x.Teaching
+	}
+	return nil
+}
+
+func (x *ClassList) GetEnrolled() []*Class {
+	if x != nil {
+		return x.Enrolled
+	}
+	return nil
+}
+
+type ClassProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	InstructorId  string                 `protobuf:"bytes,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassProgressRequest) Reset() {
+	*x = ClassProgressRequest{}
+	mi := &file_education_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassProgressRequest) ProtoMessage() {}
+
+func (x *ClassProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassProgressRequest.ProtoReflect.Descriptor instead.
+func (*ClassProgressRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ClassProgressRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassProgressRequest) GetInstructorId() string {
+	if x != nil {
+		return x.InstructorId
+	}
+	return ""
+}
+
+type StudentProgress struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Assignments      []*AssignmentProgress  `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"` // In the class's order
+	Done             int32                  `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`              // Including late
+	Late             int32                  `protobuf:"varint,4,opt,name=late,proto3" json:"late,omitempty"`
+	Overdue          int32                  `protobuf:"varint,5,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	LessonsCompleted int32                  `protobuf:"varint,6,opt,name=lessons_completed,json=lessonsCompleted,proto3" json:"lessons_completed,omitempty"` // Anywhere in the module
+	BadgesUnlocked   int32                  `protobuf:"varint,7,opt,name=badges_unlocked,json=badgesUnlocked,proto3" json:"badges_unlocked,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StudentProgress) Reset() {
+	*x = StudentProgress{}
+	mi := &file_education_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentProgress) ProtoMessage() {}
+
+func (x *StudentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentProgress.ProtoReflect.Descriptor instead.
+func (*StudentProgress) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StudentProgress) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StudentProgress) GetAssignments() []*AssignmentProgress {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *StudentProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetLessonsCompleted() int32 {
+	if x != nil {
+		return x.LessonsCompleted
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetBadgesUnlocked() int32 {
+	if x != nil {
+		return x.BadgesUnlocked
+	}
+	return 0
+}
+
+type AssignmentSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *Assignment            `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Done          int32                  `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"` // Including late
+	Late          int32                  `protobuf:"varint,3,opt,name=late,proto3" json:"late,omitempty"`
+	Overdue       int32                  `protobuf:"varint,4,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	AverageScore  int32                  `protobuf:"varint,5,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"` // Challenges: over students who solved it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentSummary) Reset() {
+	*x = AssignmentSummary{}
+	mi := &file_education_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentSummary) ProtoMessage() {}
+
+func (x *AssignmentSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentSummary.ProtoReflect.Descriptor instead.
+func (*AssignmentSummary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AssignmentSummary) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *AssignmentSummary) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *AssignmentSummary) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *AssignmentSummary) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *AssignmentSummary) GetAverageScore() int32 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+type ClassProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Class          *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	Students       []*StudentProgress     `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"` // Most overdue first
+	Assignments    []*AssignmentSummary   `protobuf:"bytes,3,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	CompletionRate float64                `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // Done over students × assignments
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClassProgress) Reset() {
+	*x = ClassProgress{}
+	mi := &file_education_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassProgress) ProtoMessage() {}
+
+func (x *ClassProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassProgress.ProtoReflect.Descriptor instead.
+func (*ClassProgress) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ClassProgress) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *ClassProgress) GetStudents() []*StudentProgress {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *ClassProgress) GetAssignments() []*AssignmentSummary {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *ClassProgress) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type QuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         Topic                  `protobuf:"varint,1,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"` // Unspecified draws from every topic
+	Difficulty    Difficulty             `protobuf:"varint,2,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	NumQuestions  int32                  `protobuf:"varint,3,opt,name=num_questions,json=numQuestions,proto3" json:"num_questions,omitempty"` // Default 5, capped at the bank size
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                    // Records the attempt against a learner
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Types         []QuestionType         `protobuf:"varint,6,rep,packed,name=types,proto3,enum=qubit_engine.education.QuestionType" json:"types,omitempty"` // Only questions of these types; empty = any
+	GroupId       string                 `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                               // Guild, server or room quizzed in, for group leaderboards
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizRequest) Reset() {
+	*x = QuizRequest{}
+	mi := &file_education_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizRequest) ProtoMessage() {}
+
+func (x *QuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizRequest.ProtoReflect.Descriptor instead.
+func (*QuizRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *QuizRequest) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *QuizRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *QuizRequest) GetNumQuestions() int32 {
+	if x != nil {
+		return x.NumQuestions
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *QuizRequest) GetTypes() []QuestionType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *QuizRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Quiz struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuizId           string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Questions        []*Question            `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	TimeLimitSeconds int32                  `protobuf:"varint,3,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	ExpiresAt        int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Quiz) Reset() {
+	*x = Quiz{}
+	mi := &file_education_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quiz) ProtoMessage() {}
+
+func (x *Quiz) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
+func (*Quiz) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Quiz) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *Quiz) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *Quiz) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *Quiz) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type Question struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Type          QuestionType           `protobuf:"varint,2,opt,name=type,proto3,enum=qubit_engine.education.QuestionType" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`                      // For multiple choice
+	CircuitId     string                 `protobuf:"bytes,5,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"` // For circuit-based questions
+	Points        int32                  `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`
+	Topic         Topic                  `protobuf:"varint,7,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,10,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"` // Circuit construction: qubits available
+	Concept       string                 `protobuf:"bytes,11,opt,name=concept,proto3" json:"concept,omitempty"`                       // What the question tests, e.g. "Bell States"
+	Language      string                 `protobuf:"bytes,12,opt,name=language,proto3" json:"language,omitempty"`                     // Language served, "en" if untranslated
+	Hints         int32                  `protobuf:"varint,13,opt,name=hints,proto3" json:"hints,omitempty"`                          // Hints GetHint can give
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	mi := &file_education_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Question) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *Question) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_QUESTION_MULTIPLE_CHOICE
+}
+
+func (x *Question) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Question) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Question) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+func (x *Question) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Question) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *Question) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *Question) GetConcept() string {
+	if x != nil {
+		return x.Concept
+	}
+	return ""
+}
+
+func (x *Question) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Question) GetHints() int32 {
+	if x != nil {
+		return x.Hints
+	}
+	return 0
+}
+
+type QuizSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Answers       []*AnswerSubmission    `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // Default the quiz's language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizSubmission) Reset() {
+	*x = QuizSubmission{}
+	mi := &file_education_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizSubmission) ProtoMessage() {}
+
+func (x *QuizSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizSubmission.ProtoReflect.Descriptor instead.
+func (*QuizSubmission) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *QuizSubmission) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizSubmission) GetAnswers() []*AnswerSubmission {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *QuizSubmission) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type AnswerSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"` // Index for MC, "true"/"false", etc.
+	Gates         []*GateStep            `protobuf:"bytes,3,rep,name=gates,proto3" json:"gates,omitempty"`   // Circuit construction, from |0…0⟩; no measurements
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerSubmission) Reset() {
+	*x = AnswerSubmission{}
+	mi := &file_education_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerSubmission) ProtoMessage() {}
+
+func (x *AnswerSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerSubmission.ProtoReflect.Descriptor instead.
+func (*AnswerSubmission) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AnswerSubmission) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerSubmission) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *AnswerSubmission) GetGates() []*GateStep {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+type QuizResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	QuizId             string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	Results            []*AnswerResult        `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // This submission's answers
+	Score              int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`    // Running total for the quiz
+	MaxScore           int32                  `protobuf:"varint,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	QuestionsRemaining int32                  `protobuf:"varint,5,opt,name=questions_remaining,json=questionsRemaining,proto3" json:"questions_remaining,omitempty"`
+	Completed          bool                   `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`
+	Unlocked           []*Badge               `protobuf:"bytes,7,rep,name=unlocked,proto3" json:"unlocked,omitempty"` // Badges this submission earned
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *QuizResult) Reset() {
+	*x = QuizResult{}
+	mi := &file_education_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizResult) ProtoMessage() {}
+
+func (x *QuizResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizResult.ProtoReflect.Descriptor instead.
+func (*QuizResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *QuizResult) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizResult) GetResults() []*AnswerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizResult) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *QuizResult) GetQuestionsRemaining() int32 {
+	if x != nil {
+		return x.QuestionsRemaining
+	}
+	return 0
+}
+
+func (x *QuizResult) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *QuizResult) GetUnlocked() []*Badge {
+	if x != nil {
+		return x.Unlocked
+	}
+	return nil
+}
+
+type AnswerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Correct       bool                   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	CorrectAnswer string                 `protobuf:"bytes,3,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	Explanation   string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	PointsEarned  int32                  `protobuf:"varint,5,opt,name=points_earned,json=pointsEarned,proto3" json:"points_earned,omitempty"`
+	Fidelity      float64                `protobuf:"fixed64,6,opt,name=fidelity,proto3" json:"fidelity,omitempty"` // Circuit construction: |⟨target|submitted⟩|²
+	HintsUsed     int32                  `protobuf:"varint,7,opt,name=hints_used,json=hintsUsed,proto3" json:"hints_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerResult) Reset() {
+	*x = AnswerResult{}
+	mi := &file_education_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerResult) ProtoMessage() {}
+
+func (x *AnswerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerResult.ProtoReflect.Descriptor instead.
+func (*AnswerResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AnswerResult) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *AnswerResult) GetCorrectAnswer() string {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return ""
+}
+
+func (x *AnswerResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *AnswerResult) GetPointsEarned() int32 {
+	if x != nil {
+		return x.PointsEarned
+	}
+	return 0
+}
+
+func (x *AnswerResult) GetFidelity() float64 {
+	if x != nil {
+		return x.Fidelity
+	}
+	return 0
+}
+
+func (x *AnswerResult) GetHintsUsed() int32 {
+	if x != nil {
+		return x.HintsUsed
+	}
+	return 0
+}
+
+type QuizLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         Topic                  `protobuf:"varint,1,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"` // Unspecified: every topic
+	Order         QuizBoardOrder         `protobuf:"varint,2,opt,name=order,proto3,enum=qubit_engine.education.QuizBoardOrder" json:"order,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                   // Default 10
+	PreviousWeek  bool                   `protobuf:"varint,4,opt,name=previous_week,json=previousWeek,proto3" json:"previous_week,omitempty"` // Last week's final standings
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                    // Also report this learner's place
+	GroupId       string                 `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                 // Only learners who have quizzed in this group
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizLeaderboardRequest) Reset() {
+	*x = QuizLeaderboardRequest{}
+	mi := &file_education_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizLeaderboardRequest) ProtoMessage() {}
+
+func (x *QuizLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*QuizLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *QuizLeaderboardRequest) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *QuizLeaderboardRequest) GetOrder() QuizBoardOrder {
+	if x != nil {
+		return x.Order
+	}
+	return QuizBoardOrder_QUIZ_BOARD_POINTS
+}
+
+func (x *QuizLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QuizLeaderboardRequest) GetPreviousWeek() bool {
+	if x != nil {
+		return x.PreviousWeek
+	}
+	return false
+}
+
+func (x *QuizLeaderboardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizLeaderboardRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type QuizLeaderboardEntry struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Rank              int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Points            int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	QuizzesCompleted  int32                  `protobuf:"varint,4,opt,name=quizzes_completed,json=quizzesCompleted,proto3" json:"quizzes_completed,omitempty"`
+	FastestAnswerMs   int64                  `protobuf:"varint,5,opt,name=fastest_answer_ms,json=fastestAnswerMs,proto3" json:"fastest_answer_ms,omitempty"` // 0 without a right answer
+	StreakDays        int32                  `protobuf:"varint,6,opt,name=streak_days,json=streakDays,proto3" json:"streak_days,omitempty"`
+	LongestStreakDays int32                  `protobuf:"varint,7,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QuizLeaderboardEntry) Reset() {
+	*x = QuizLeaderboardEntry{}
+	mi := &file_education_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizLeaderboardEntry) ProtoMessage() {}
+
+func (x *QuizLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*QuizLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *QuizLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *QuizLeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizLeaderboardEntry) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuizLeaderboardEntry) GetQuizzesCompleted() int32 {
+	if x != nil {
+		return x.QuizzesCompleted
+	}
+	return 0
+}
+
+func (x *QuizLeaderboardEntry) GetFastestAnswerMs() int64 {
+	if x != nil {
+		return x.FastestAnswerMs
+	}
+	return 0
+}
+
+func (x *QuizLeaderboardEntry) GetStreakDays() int32 {
+	if x != nil {
+		return x.StreakDays
+	}
+	return 0
+}
+
+func (x *QuizLeaderboardEntry) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+type QuizLeaderboard struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Week          string                  `protobuf:"bytes,1,opt,name=week,proto3" json:"week,omitempty"` // ISO week, e.g. "2026-W42"
+	StartsAt      int64                   `protobuf:"varint,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ResetsAt      int64                   `protobuf:"varint,3,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	Topic         Topic                   `protobuf:"varint,4,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Order         QuizBoardOrder          `protobuf:"varint,5,opt,name=order,proto3,enum=qubit_engine.education.QuizBoardOrder" json:"order,omitempty"`
+	Entries       []*QuizLeaderboardEntry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"`
+	Players       int32                   `protobuf:"varint,7,opt,name=players,proto3" json:"players,omitempty"` // Learners on the full board
+	You           *QuizLeaderboardEntry   `protobuf:"bytes,8,opt,name=you,proto3" json:"you,omitempty"`          // user_id's entry, if on the board
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizLeaderboard) Reset() {
+	*x = QuizLeaderboard{}
+	mi := &file_education_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizLeaderboard) ProtoMessage() {}
+
+func (x *QuizLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizLeaderboard.ProtoReflect.Descriptor instead.
+func (*QuizLeaderboard) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *QuizLeaderboard) GetWeek() string {
+	if x != nil {
+		return x.Week
+	}
+	return ""
+}
+
+func (x *QuizLeaderboard) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *QuizLeaderboard) GetResetsAt() int64 {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return 0
+}
+
+func (x *QuizLeaderboard) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *QuizLeaderboard) GetOrder() QuizBoardOrder {
+	if x != nil {
+		return x.Order
+	}
+	return QuizBoardOrder_QUIZ_BOARD_POINTS
+}
+
+func (x *QuizLeaderboard) GetEntries() []*QuizLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QuizLeaderboard) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *QuizLeaderboard) GetYou() *QuizLeaderboardEntry {
+	if x != nil {
+		return x.You
+	}
+	return nil
+}
+
+// Questions and challenges each have a chain of hints, from a nudge to
+// nearly the answer. Every hint taken knocks 20% off the points a right
+// answer earns: for a quiz question, on that quiz; for a challenge, on
+// every later submission by the learner.
+type HintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"` // With question_id
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,3,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // Or a challenge, with user_id
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintRequest) Reset() {
+	*x = HintRequest{}
+	mi := &file_education_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintRequest) ProtoMessage() {}
+
+func (x *HintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintRequest.ProtoReflect.Descriptor instead.
+func (*HintRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HintRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *HintRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *HintRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *HintRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HintRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type Hint struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Text            string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Level           int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"` // 1 for the first; asking past the last repeats it
+	Total           int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	DiscountPercent int32                  `protobuf:"varint,4,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"` // Now off the points a right answer earns
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Hint) Reset() {
+	*x = Hint{}
+	mi := &file_education_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hint) ProtoMessage() {}
+
+func (x *Hint) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hint.ProtoReflect.Descriptor instead.
+func (*Hint) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Hint) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Hint) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Hint) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Hint) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+type AttemptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttemptsRequest) Reset() {
+	*x = AttemptsRequest{}
+	mi := &file_education_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttemptsRequest) ProtoMessage() {}
+
+func (x *AttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttemptsRequest.ProtoReflect.Descriptor instead.
+func (*AttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AttemptsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type QuizAttempt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuizId         string                 `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Topic          Topic                  `protobuf:"varint,3,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Score          int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore       int32                  `protobuf:"varint,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Answered       int32                  `protobuf:"varint,6,opt,name=answered,proto3" json:"answered,omitempty"`
+	TotalQuestions int32                  `protobuf:"varint,7,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
+	Completed      bool                   `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	StartedAt      int64                  `protobuf:"varint,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt    int64                  `protobuf:"varint,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuizAttempt) Reset() {
+	*x = QuizAttempt{}
+	mi := &file_education_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttempt) ProtoMessage() {}
+
+func (x *QuizAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttempt.ProtoReflect.Descriptor instead.
+func (*QuizAttempt) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QuizAttempt) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizAttempt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizAttempt) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *QuizAttempt) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetAnswered() int32 {
+	if x != nil {
+		return x.Answered
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetTotalQuestions() int32 {
+	if x != nil {
+		return x.TotalQuestions
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *QuizAttempt) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type AttemptHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempts      []*QuizAttempt         `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	BestScore     int32                  `protobuf:"varint,2,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"` // Best completed score, in percent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttemptHistory) Reset() {
+	*x = AttemptHistory{}
+	mi := &file_education_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttemptHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttemptHistory) ProtoMessage() {}
+
+func (x *AttemptHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttemptHistory.ProtoReflect.Descriptor instead.
+func (*AttemptHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AttemptHistory) GetAttempts() []*QuizAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *AttemptHistory) GetBestScore() int32 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+type DueReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty lists every learner with reviews due
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                // Default 10
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DueReviewsRequest) Reset() {
+	*x = DueReviewsRequest{}
+	mi := &file_education_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueReviewsRequest) ProtoMessage() {}
+
+func (x *DueReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueReviewsRequest.ProtoReflect.Descriptor instead.
+func (*DueReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DueReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DueReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DueReviewsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ReviewCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      *Question              `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	DueAt         int64                  `protobuf:"varint,2,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	IntervalDays  int32                  `protobuf:"varint,3,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`
+	EaseFactor    float64                `protobuf:"fixed64,4,opt,name=ease_factor,json=easeFactor,proto3" json:"ease_factor,omitempty"`
+	Repetitions   int32                  `protobuf:"varint,5,opt,name=repetitions,proto3" json:"repetitions,omitempty"` // Right answers in a row
+	Lapses        int32                  `protobuf:"varint,6,opt,name=lapses,proto3" json:"lapses,omitempty"`           // Times forgotten
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCard) Reset() {
+	*x = ReviewCard{}
+	mi := &file_education_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCard) ProtoMessage() {}
+
+func (x *ReviewCard) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCard.ProtoReflect.Descriptor instead.
+func (*ReviewCard) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReviewCard) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *ReviewCard) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetEaseFactor() float64 {
+	if x != nil {
+		return x.EaseFactor
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+type LearnerDue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DueCount      int32                  `protobuf:"varint,2,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`
+	OldestDueAt   int64                  `protobuf:"varint,3,opt,name=oldest_due_at,json=oldestDueAt,proto3" json:"oldest_due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearnerDue) Reset() {
+	*x = LearnerDue{}
+	mi := &file_education_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearnerDue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearnerDue) ProtoMessage() {}
+
+func (x *LearnerDue) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearnerDue.ProtoReflect.Descriptor instead.
+func (*LearnerDue) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LearnerDue) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LearnerDue) GetDueCount() int32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *LearnerDue) GetOldestDueAt() int64 {
+	if x != nil {
+		return x.OldestDueAt
+	}
+	return 0
+}
+
+type DueReviews struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*ReviewCard          `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // Most overdue first
+	DueCount      int32                  `protobuf:"varint,2,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`
+	NextDueAt     int64                  `protobuf:"varint,3,opt,name=next_due_at,json=nextDueAt,proto3" json:"next_due_at,omitempty"` // Earliest card not yet due; 0 if none
+	Learners      []*LearnerDue          `protobuf:"bytes,4,rep,name=learners,proto3" json:"learners,omitempty"`                       // Without user_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DueReviews) Reset() {
+	*x = DueReviews{}
+	mi := &file_education_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueReviews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueReviews) ProtoMessage() {}
+
+func (x *DueReviews) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueReviews.ProtoReflect.Descriptor instead.
+func (*DueReviews) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DueReviews) GetReviews() []*ReviewCard {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *DueReviews) GetDueCount() int32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *DueReviews) GetNextDueAt() int64 {
+	if x != nil {
+		return x.NextDueAt
+	}
+	return 0
+}
+
+func (x *DueReviews) GetLearners() []*LearnerDue {
+	if x != nil {
+		return x.Learners
+	}
+	return nil
+}
+
+type ReviewSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Gates         []*GateStep            `protobuf:"bytes,4,rep,name=gates,proto3" json:"gates,omitempty"`      // Circuit construction
+	Quality       int32                  `protobuf:"varint,5,opt,name=quality,proto3" json:"quality,omitempty"` // For a right answer: 3 hard, 4 good (default), 5 easy
+	Language      string                 `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewSubmission) Reset() {
+	*x = ReviewSubmission{}
+	mi := &file_education_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSubmission) ProtoMessage() {}
+
+func (x *ReviewSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSubmission.ProtoReflect.Descriptor instead.
+func (*ReviewSubmission) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReviewSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewSubmission) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *ReviewSubmission) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *ReviewSubmission) GetGates() []*GateStep {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+func (x *ReviewSubmission) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *ReviewSubmission) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ReviewResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *AnswerResult          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Card          *ReviewCard            `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"` // The rescheduled card
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewResult) Reset() {
+	*x = ReviewResult{}
+	mi := &file_education_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResult) ProtoMessage() {}
+
+func (x *ReviewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResult.ProtoReflect.Descriptor instead.
+func (*ReviewResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReviewResult) GetResult() *AnswerResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ReviewResult) GetCard() *ReviewCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type CircuitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CircuitId     string                 `protobuf:"bytes,1,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitRequest) Reset() {
+	*x = CircuitRequest{}
+	mi := &file_education_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitRequest) ProtoMessage() {}
+
+func (x *CircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitRequest.ProtoReflect.Descriptor instead.
+func (*CircuitRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CircuitRequest) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+type CircuitFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         Topic                  `protobuf:"varint,1,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"` // Unspecified matches every topic
+	Difficulty    Difficulty             `protobuf:"varint,2,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	MaxQubits     int32                  `protobuf:"varint,3,opt,name=max_qubits,json=maxQubits,proto3" json:"max_qubits,omitempty"` // 0 for no limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitFilter) Reset() {
+	*x = CircuitFilter{}
+	mi := &file_education_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitFilter) ProtoMessage() {}
+
+func (x *CircuitFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitFilter.ProtoReflect.Descriptor instead.
+func (*CircuitFilter) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CircuitFilter) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *CircuitFilter) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *CircuitFilter) GetMaxQubits() int32 {
+	if x != nil {
+		return x.MaxQubits
+	}
+	return 0
+}
+
+type LibraryCircuit struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Topic          Topic                  `protobuf:"varint,4,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Difficulty     Difficulty             `protobuf:"varint,5,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	NumQubits      int32                  `protobuf:"varint,6,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	Gates          []*GateStep            `protobuf:"bytes,7,rep,name=gates,proto3" json:"gates,omitempty"`
+	ExpectedOutput string                 `protobuf:"bytes,8,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"` // e.g., "Bell state |Φ+⟩"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LibraryCircuit) Reset() {
+	*x = LibraryCircuit{}
+	mi := &file_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryCircuit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryCircuit) ProtoMessage() {}
+
+func (x *LibraryCircuit) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryCircuit.ProtoReflect.Descriptor instead.
+func (*LibraryCircuit) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LibraryCircuit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LibraryCircuit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryCircuit) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LibraryCircuit) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *LibraryCircuit) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *LibraryCircuit) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *LibraryCircuit) GetGates() []*GateStep {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+func (x *LibraryCircuit) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
+	}
+	return ""
+}
+
+type GateStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gate          string                 `protobuf:"bytes,1,opt,name=gate,proto3" json:"gate,omitempty"`             // "H", "CNOT", "RZ", etc.
+	Qubits        []int32                `protobuf:"varint,2,rep,packed,name=qubits,proto3" json:"qubits,omitempty"` // Target qubits
+	Parameter     float64                `protobuf:"fixed64,3,opt,name=parameter,proto3" json:"parameter,omitempty"` // For parametric gates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GateStep) Reset() {
+	*x = GateStep{}
+	mi := &file_education_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GateStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GateStep) ProtoMessage() {}
+
+func (x *GateStep) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GateStep.ProtoReflect.Descriptor instead.
+func (*GateStep) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GateStep) GetGate() string {
+	if x != nil {
+		return x.Gate
+	}
+	return ""
+}
+
+func (x *GateStep) GetQubits() []int32 {
+	if x != nil {
+		return x.Qubits
+	}
+	return nil
+}
+
+func (x *GateStep) GetParameter() float64 {
+	if x != nil {
+		return x.Parameter
+	}
+	return 0
+}
+
+type CircuitCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Circuits      []*CircuitSummary      `protobuf:"bytes,1,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitCatalog) Reset() {
+	*x = CircuitCatalog{}
+	mi := &file_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitCatalog) ProtoMessage() {}
+
+func (x *CircuitCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitCatalog.ProtoReflect.Descriptor instead.
+func (*CircuitCatalog) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CircuitCatalog) GetCircuits() []*CircuitSummary {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+type CircuitSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Topic         Topic                  `protobuf:"varint,3,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,4,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	NumGates      int32                  `protobuf:"varint,5,opt,name=num_gates,json=numGates,proto3" json:"num_gates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitSummary) Reset() {
+	*x = CircuitSummary{}
+	mi := &file_education_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitSummary) ProtoMessage() {}
+
+func (x *CircuitSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitSummary.ProtoReflect.Descriptor instead.
+func (*CircuitSummary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CircuitSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CircuitSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CircuitSummary) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *CircuitSummary) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *CircuitSummary) GetNumGates() int32 {
+	if x != nil {
+		return x.NumGates
+	}
+	return 0
+}
+
+type SandboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumQubits     int32                  `protobuf:"varint,1,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"` // At most 6
+	Gates         []*GateStep            `protobuf:"bytes,2,rep,name=gates,proto3" json:"gates,omitempty"`                           // H X Z S T RY RZ CNOT TOFFOLI MEASURE; at most 64, depth 32
+	CircuitId     string                 `protobuf:"bytes,3,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`  // Run a library circuit instead
+	Seed          uint64                 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`                            // Fixes measurement outcomes when non-zero
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`           // Credits the run toward the learner's badges
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxRequest) Reset() {
+	*x = SandboxRequest{}
+	mi := &file_education_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxRequest) ProtoMessage() {}
+
+func (x *SandboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxRequest.ProtoReflect.Descriptor instead.
+func (*SandboxRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SandboxRequest) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *SandboxRequest) GetGates() []*GateStep {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+func (x *SandboxRequest) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+func (x *SandboxRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SandboxRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Amplitude struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Real          float64                `protobuf:"fixed64,1,opt,name=real,proto3" json:"real,omitempty"`
+	Imag          float64                `protobuf:"fixed64,2,opt,name=imag,proto3" json:"imag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Amplitude) Reset() {
+	*x = Amplitude{}
+	mi := &file_education_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Amplitude) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amplitude) ProtoMessage() {}
+
+func (x *Amplitude) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amplitude.ProtoReflect.Descriptor instead.
+func (*Amplitude) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Amplitude) GetReal() float64 {
+	if x != nil {
+		return x.Real
+	}
+	return 0
+}
+
+func (x *Amplitude) GetImag() float64 {
+	if x != nil {
+		return x.Imag
+	}
+	return 0
+}
+
+type TraceStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Gate          *GateStep              `protobuf:"bytes,2,opt,name=gate,proto3" json:"gate,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // What the gate did, in words
+	StateVector   []*Amplitude           `protobuf:"bytes,4,rep,name=state_vector,json=stateVector,proto3" json:"state_vector,omitempty"`
+	Probabilities []float64              `protobuf:"fixed64,5,rep,packed,name=probabilities,proto3" json:"probabilities,omitempty"`
+	StateKet      string                 `protobuf:"bytes,6,opt,name=state_ket,json=stateKet,proto3" json:"state_ket,omitempty"` // e.g. "0.707|00⟩ + 0.707|11⟩"
+	Outcome       int32                  `protobuf:"varint,7,opt,name=outcome,proto3" json:"outcome,omitempty"`                  // Measurement result, -1 for other gates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceStep) Reset() {
+	*x = TraceStep{}
+	mi := &file_education_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceStep) ProtoMessage() {}
+
+func (x *TraceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceStep.ProtoReflect.Descriptor instead.
+func (*TraceStep) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TraceStep) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *TraceStep) GetGate() *GateStep {
+	if x != nil {
+		return x.Gate
+	}
+	return nil
+}
+
+func (x *TraceStep) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TraceStep) GetStateVector() []*Amplitude {
+	if x != nil {
+		return x.StateVector
+	}
+	return nil
+}
+
+func (x *TraceStep) GetProbabilities() []float64 {
+	if x != nil {
+		return x.Probabilities
+	}
+	return nil
+}
+
+func (x *TraceStep) GetStateKet() string {
+	if x != nil {
+		return x.StateKet
+	}
+	return ""
+}
+
+func (x *TraceStep) GetOutcome() int32 {
+	if x != nil {
+		return x.Outcome
+	}
+	return 0
+}
+
+type SandboxResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumQubits     int32                  `protobuf:"varint,1,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	StateVector   []*Amplitude           `protobuf:"bytes,2,rep,name=state_vector,json=stateVector,proto3" json:"state_vector,omitempty"`
+	Probabilities []float64              `protobuf:"fixed64,3,rep,packed,name=probabilities,proto3" json:"probabilities,omitempty"`
+	FinalState    string                 `protobuf:"bytes,4,opt,name=final_state,json=finalState,proto3" json:"final_state,omitempty"`
+	Trace         []*TraceStep           `protobuf:"bytes,5,rep,name=trace,proto3" json:"trace,omitempty"`
+	GateCount     int32                  `protobuf:"varint,6,opt,name=gate_count,json=gateCount,proto3" json:"gate_count,omitempty"`
+	Depth         int32                  `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	Unlocked      []*Badge               `protobuf:"bytes,8,rep,name=unlocked,proto3" json:"unlocked,omitempty"` // Badges this run earned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SandboxResult) Reset() {
+	*x = SandboxResult{}
+	mi := &file_education_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxResult) ProtoMessage() {}
+
+func (x *SandboxResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxResult.ProtoReflect.Descriptor instead.
+func (*SandboxResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SandboxResult) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *SandboxResult) GetStateVector() []*Amplitude {
+	if x != nil {
+		return x.StateVector
+	}
+	return nil
+}
+
+func (x *SandboxResult) GetProbabilities() []float64 {
+	if x != nil {
+		return x.Probabilities
+	}
+	return nil
+}
+
+func (x *SandboxResult) GetFinalState() string {
+	if x != nil {
+		return x.FinalState
+	}
+	return ""
+}
+
+func (x *SandboxResult) GetTrace() []*TraceStep {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *SandboxResult) GetGateCount() int32 {
+	if x != nil {
+		return x.GateCount
+	}
+	return 0
+}
+
+func (x *SandboxResult) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *SandboxResult) GetUnlocked() []*Badge {
+	if x != nil {
+		return x.Unlocked
+	}
+	return nil
+}
+
+type ChallengeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         Topic                  `protobuf:"varint,1,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Difficulty    Difficulty             `protobuf:"varint,2,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeFilter) Reset() {
+	*x = ChallengeFilter{}
+	mi := &file_education_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeFilter) ProtoMessage() {}
+
+func (x *ChallengeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeFilter.ProtoReflect.Descriptor instead.
+func (*ChallengeFilter) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ChallengeFilter) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *ChallengeFilter) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+type ChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeRequest) Reset() {
+	*x = ChallengeRequest{}
+	mi := &file_education_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeRequest) ProtoMessage() {}
+
+func (x *ChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeRequest.ProtoReflect.Descriptor instead.
+func (*ChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type Challenge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Topic         Topic                  `protobuf:"varint,4,opt,name=topic,proto3,enum=qubit_engine.education.Topic" json:"topic,omitempty"`
+	Difficulty    Difficulty             `protobuf:"varint,5,opt,name=difficulty,proto3,enum=qubit_engine.education.Difficulty" json:"difficulty,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,6,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`                                                     // Qubits the target covers
+	InputState    string                 `protobuf:"bytes,7,opt,name=input_state,json=inputState,proto3" json:"input_state,omitempty"`                                                   // e.g. "cos(π/8)|00⟩ + sin(π/8)|01⟩"
+	Target        map[string]float64     `protobuf:"bytes,8,rep,name=target,proto3" json:"target,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // Bitstring |q(n-1)…q0⟩ -> probability
+	MaxGates      int32                  `protobuf:"varint,9,opt,name=max_gates,json=maxGates,proto3" json:"max_gates,omitempty"`
+	MaxQubits     int32                  `protobuf:"varint,10,opt,name=max_qubits,json=maxQubits,proto3" json:"max_qubits,omitempty"` // Extra qubits are ancillas starting in |0⟩
+	ParGates      int32                  `protobuf:"varint,11,opt,name=par_gates,json=parGates,proto3" json:"par_gates,omitempty"`    // Reference solution's size
+	ParDepth      int32                  `protobuf:"varint,12,opt,name=par_depth,json=parDepth,proto3" json:"par_depth,omitempty"`
+	Hints         int32                  `protobuf:"varint,13,opt,name=hints,proto3" json:"hints,omitempty"` // Hints GetHint can give
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	mi := &file_education_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Challenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Challenge) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Challenge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Challenge) GetTopic() Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return Topic_TOPIC_UNSPECIFIED
+}
+
+func (x *Challenge) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *Challenge) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *Challenge) GetInputState() string {
+	if x != nil {
+		return x.InputState
+	}
+	return ""
+}
+
+func (x *Challenge) GetTarget() map[string]float64 {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Challenge) GetMaxGates() int32 {
+	if x != nil {
+		return x.MaxGates
+	}
+	return 0
+}
+
+func (x *Challenge) GetMaxQubits() int32 {
+	if x != nil {
+		return x.MaxQubits
+	}
+	return 0
+}
+
+func (x *Challenge) GetParGates() int32 {
+	if x != nil {
+		return x.ParGates
+	}
+	return 0
+}
+
+func (x *Challenge) GetParDepth() int32 {
+	if x != nil {
+		return x.ParDepth
+	}
+	return 0
+}
+
+func (x *Challenge) GetHints() int32 {
+	if x != nil {
+		return x.Hints
+	}
+	return 0
+}
+
+type ChallengeCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenges    []*Challenge           `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeCatalog) Reset() {
+	*x = ChallengeCatalog{}
+	mi := &file_education_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeCatalog) ProtoMessage() {}
+
+func (x *ChallengeCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeCatalog.ProtoReflect.Descriptor instead.
+func (*ChallengeCatalog) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ChallengeCatalog) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+type ChallengeSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`           // Required to be ranked
+	NumQubits     int32                  `protobuf:"varint,3,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"` // Default the challenge's; at most max_qubits
+	Gates         []*GateStep            `protobuf:"bytes,4,rep,name=gates,proto3" json:"gates,omitempty"`                           // Applied after the input is prepared; no measurements
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeSubmission) Reset() {
+	*x = ChallengeSubmission{}
+	mi := &file_education_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeSubmission) ProtoMessage() {}
+
+func (x *ChallengeSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeSubmission.ProtoReflect.Descriptor instead.
+func (*ChallengeSubmission) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ChallengeSubmission) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ChallengeSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChallengeSubmission) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *ChallengeSubmission) GetGates() []*GateStep {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+type ChallengeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Correct       bool                   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	Fidelity      float64                `protobuf:"fixed64,3,opt,name=fidelity,proto3" json:"fidelity,omitempty"` // (Σ √(p·q))² between the distributions
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`        // Out of 1000
+	GateCount     int32                  `protobuf:"varint,5,opt,name=gate_count,json=gateCount,proto3" json:"gate_count,omitempty"`
+	Depth         int32                  `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	Distribution  map[string]float64     `protobuf:"bytes,7,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // What the solution measures
+	Rank          int32                  `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"`                                                                                            // Learner's place on the leaderboard; 0 if unranked
+	PersonalBest  bool                   `protobuf:"varint,9,opt,name=personal_best,json=personalBest,proto3" json:"personal_best,omitempty"`
+	Feedback      string                 `protobuf:"bytes,10,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Unlocked      []*Badge               `protobuf:"bytes,11,rep,name=unlocked,proto3" json:"unlocked,omitempty"`
+	HintsUsed     int32                  `protobuf:"varint,12,opt,name=hints_used,json=hintsUsed,proto3" json:"hints_used,omitempty"` // The score is discounted for them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeResult) Reset() {
+	*x = ChallengeResult{}
+	mi := &file_education_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeResult) ProtoMessage() {}
+
+func (x *ChallengeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeResult.ProtoReflect.Descriptor instead.
+func (*ChallengeResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ChallengeResult) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ChallengeResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *ChallengeResult) GetFidelity() float64 {
+	if x != nil {
+		return x.Fidelity
+	}
+	return 0
+}
+
+func (x *ChallengeResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ChallengeResult) GetGateCount() int32 {
+	if x != nil {
+		return x.GateCount
+	}
+	return 0
+}
+
+func (x *ChallengeResult) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ChallengeResult) GetDistribution() map[string]float64 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *ChallengeResult) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ChallengeResult) GetPersonalBest() bool {
+	if x != nil {
+		return x.PersonalBest
+	}
+	return false
+}
+
+func (x *ChallengeResult) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *ChallengeResult) GetUnlocked() []*Badge {
+	if x != nil {
+		return x.Unlocked
+	}
+	return nil
+}
+
+func (x *ChallengeResult) GetHintsUsed() int32 {
+	if x != nil {
+		return x.HintsUsed
+	}
+	return 0
+}
+
+type ChallengeLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeLeaderboardRequest) Reset() {
+	*x = ChallengeLeaderboardRequest{}
+	mi := &file_education_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeLeaderboardRequest) ProtoMessage() {}
+
+func (x *ChallengeLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*ChallengeLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ChallengeLeaderboardRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ChallengeLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ChallengeLeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	GateCount     int32                  `protobuf:"varint,4,opt,name=gate_count,json=gateCount,proto3" json:"gate_count,omitempty"`
+	Depth         int32                  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	SubmittedAt   int64                  `protobuf:"varint,6,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeLeaderboardEntry) Reset() {
+	*x = ChallengeLeaderboardEntry{}
+	mi := &file_education_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeLeaderboardEntry) ProtoMessage() {}
+
+func (x *ChallengeLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*ChallengeLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ChallengeLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ChallengeLeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChallengeLeaderboardEntry) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ChallengeLeaderboardEntry) GetGateCount() int32 {
+	if x != nil {
+		return x.GateCount
+	}
+	return 0
+}
+
+func (x *ChallengeLeaderboardEntry) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ChallengeLeaderboardEntry) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+type ChallengeLeaderboard struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ChallengeId   string                       `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Entries       []*ChallengeLeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Solvers       int32                        `protobuf:"varint,3,opt,name=solvers,proto3" json:"solvers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeLeaderboard) Reset() {
+	*x = ChallengeLeaderboard{}
+	mi := &file_education_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeLeaderboard) ProtoMessage() {}
+
+func (x *ChallengeLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeLeaderboard.ProtoReflect.Descriptor instead.
+func (*ChallengeLeaderboard) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ChallengeLeaderboard) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ChallengeLeaderboard) GetEntries() []*ChallengeLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ChallengeLeaderboard) GetSolvers() int32 {
+	if x != nil {
+		return x.Solvers
+	}
+	return 0
+}
+
+type AchievementEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`     // "superposition_collapsed", "oracle_consulted"
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`  // Occurrences being reported; default 1
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // Reporting module, for the logs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementEvent) Reset() {
+	*x = AchievementEvent{}
+	mi := &file_education_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementEvent) ProtoMessage() {}
+
+func (x *AchievementEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementEvent.ProtoReflect.Descriptor instead.
+func (*AchievementEvent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AchievementEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AchievementEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AchievementEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AchievementEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type EventAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unlocked      []*Badge               `protobuf:"bytes,1,rep,name=unlocked,proto3" json:"unlocked,omitempty"` // Badges this event earned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAck) Reset() {
+	*x = EventAck{}
+	mi := &file_education_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAck) ProtoMessage() {}
+
+func (x *EventAck) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAck.ProtoReflect.Descriptor instead.
+func (*EventAck) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *EventAck) GetUnlocked() []*Badge {
+	if x != nil {
+		return x.Unlocked
+	}
+	return nil
+}
+
+type AchievementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementsRequest) Reset() {
+	*x = AchievementsRequest{}
+	mi := &file_education_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementsRequest) ProtoMessage() {}
+
+func (x *AchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementsRequest.ProtoReflect.Descriptor instead.
+func (*AchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AchievementsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Badge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Progress      int32                  `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"` // Toward goal, capped at goal
+	Goal          int32                  `protobuf:"varint,6,opt,name=goal,proto3" json:"goal,omitempty"`
+	Unlocked      bool                   `protobuf:"varint,7,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt    int64                  `protobuf:"varint,8,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Badge) Reset() {
+	*x = Badge{}
+	mi := &file_education_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Badge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Badge) ProtoMessage() {}
+
+func (x *Badge) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Badge.ProtoReflect.Descriptor instead.
+func (*Badge) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Badge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Badge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Badge) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Badge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Badge) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Badge) GetGoal() int32 {
+	if x != nil {
+		return x.Goal
+	}
+	return 0
+}
+
+func (x *Badge) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Badge) GetUnlockedAt() int64 {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return 0
+}
+
+type AchievementList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Badges        []*Badge               `protobuf:"bytes,2,rep,name=badges,proto3" json:"badges,omitempty"` // Unlocked first, most recent first
+	UnlockedCount int32                  `protobuf:"varint,3,opt,name=unlocked_count,json=unlockedCount,proto3" json:"unlocked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementList) Reset() {
+	*x = AchievementList{}
+	mi := &file_education_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementList) ProtoMessage() {}
+
+func (x *AchievementList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementList.ProtoReflect.Descriptor instead.
+func (*AchievementList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AchievementList) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AchievementList) GetBadges() []*Badge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *AchievementList) GetUnlockedCount() int32 {
+	if x != nil {
+		return x.UnlockedCount
+	}
+	return 0
+}
+
+type BadgeCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Badges        []*Badge               `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BadgeCatalog) Reset() {
+	*x = BadgeCatalog{}
+	mi := &file_education_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BadgeCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeCatalog) ProtoMessage() {}
+
+func (x *BadgeCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeCatalog.ProtoReflect.Descriptor instead.
+func (*BadgeCatalog) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *BadgeCatalog) GetBadges() []*Badge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+var File_education_proto protoreflect.FileDescriptor
+
+const file_education_proto_rawDesc = "" +
+	"\n" +
+	"\x0feducation.proto\x12\x16qubit_engine.education\"\a\n" +
+	"\x05Empty\"\x99\x02\n" +
+	"\rLessonRequest\x123\n" +
+	"\x05topic\x18\x01 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12B\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12\x1b\n" +
+	"\tlesson_id\x18\x03 \x01(\tR\blessonId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12<\n" +
+	"\x06format\x18\x06 \x01(\x0e2$.qubit_engine.education.RenderFormatR\x06format\"/\n" +
+	"\x11LessonListRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\"\xe4\x04\n" +
+	"\x06Lesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x05topic\x18\x02 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12)\n" +
+	"\x10content_markdown\x18\x04 \x01(\tR\x0fcontentMarkdown\x12!\n" +
+	"\fkey_concepts\x18\x05 \x03(\tR\vkeyConcepts\x12)\n" +
+	"\x10circuit_examples\x18\x06 \x03(\tR\x0fcircuitExamples\x12$\n" +
+	"\x0enext_lesson_id\x18\a \x01(\tR\fnextLessonId\x12+\n" +
+	"\x11estimated_minutes\x18\b \x01(\x05R\x10estimatedMinutes\x12B\n" +
+	"\n" +
+	"difficulty\x18\t \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x12\x16\n" +
+	"\x06author\x18\v \x01(\tR\x06author\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12$\n" +
+	"\rprerequisites\x18\r \x03(\tR\rprerequisites\x12\x1a\n" +
+	"\blanguage\x18\x0e \x01(\tR\blanguage\x121\n" +
+	"\x14translation_outdated\x18\x0f \x01(\bR\x13translationOutdated\x12)\n" +
+	"\x10content_rendered\x18\x10 \x01(\tR\x0fcontentRendered\"P\n" +
+	"\rLessonCatalog\x12?\n" +
+	"\alessons\x18\x01 \x03(\v2%.qubit_engine.education.LessonSummaryR\alessons\"\xb7\x02\n" +
+	"\rLessonSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x05topic\x18\x02 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12B\n" +
+	"\n" +
+	"difficulty\x18\x04 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12+\n" +
+	"\x11estimated_minutes\x18\x05 \x01(\x05R\x10estimatedMinutes\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\x12$\n" +
+	"\rprerequisites\x18\a \x03(\tR\rprerequisites\x12\x1a\n" +
+	"\blanguage\x18\b \x01(\tR\blanguage\"\x98\x01\n" +
+	"\x10PutLessonRequest\x126\n" +
+	"\x06lesson\x18\x01 \x01(\v2\x1e.qubit_engine.education.LessonR\x06lesson\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x05R\x0fexpectedVersion\x12!\n" +
+	"\fauthor_token\x18\x03 \x01(\tR\vauthorToken\"3\n" +
+	"\x14LessonHistoryRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"v\n" +
+	"\rLessonVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\"o\n" +
+	"\rLessonHistory\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12A\n" +
+	"\bversions\x18\x02 \x03(\v2%.qubit_engine.education.LessonVersionR\bversions\"i\n" +
+	"\rRenderRequest\x12\x1a\n" +
+	"\bmarkdown\x18\x01 \x01(\tR\bmarkdown\x12<\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.qubit_engine.education.RenderFormatR\x06format\"x\n" +
+	"\x0fRenderedContent\x12-\n" +
+	"\x12canonical_markdown\x18\x01 \x01(\tR\x11canonicalMarkdown\x12\x1a\n" +
+	"\brendered\x18\x02 \x01(\tR\brendered\x12\x1a\n" +
+	"\brepaired\x18\x03 \x01(\bR\brepaired\"\xbb\x01\n" +
+	"\x10LanguageCoverage\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12-\n" +
+	"\x12lessons_translated\x18\x02 \x01(\x05R\x11lessonsTranslated\x12)\n" +
+	"\x10lessons_outdated\x18\x03 \x01(\x05R\x0flessonsOutdated\x121\n" +
+	"\x14questions_translated\x18\x04 \x01(\x05R\x13questionsTranslated\"\xa7\x01\n" +
+	"\x0fLanguageCatalog\x12F\n" +
+	"\tlanguages\x18\x01 \x03(\v2(.qubit_engine.education.LanguageCoverageR\tlanguages\x12#\n" +
+	"\rtotal_lessons\x18\x02 \x01(\x05R\ftotalLessons\x12'\n" +
+	"\x0ftotal_questions\x18\x03 \x01(\x05R\x0etotalQuestions\"\xe2\x01\n" +
+	"\x0eCourseManifest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\x05R\x06format\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vexported_at\x18\x04 \x01(\x03R\n" +
+	"exportedAt\x12\x1d\n" +
+	"\n" +
+	"lesson_ids\x18\x05 \x03(\tR\tlessonIds\x12\x1f\n" +
+	"\vcircuit_ids\x18\x06 \x03(\tR\n" +
+	"circuitIds\x12!\n" +
+	"\fquestion_ids\x18\a \x03(\tR\vquestionIds\"\xa8\x01\n" +
+	"\x13ExportCourseRequest\x12\x19\n" +
+	"\btrack_id\x18\x01 \x01(\tR\atrackId\x12\x1d\n" +
+	"\n" +
+	"lesson_ids\x18\x02 \x03(\tR\tlessonIds\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12!\n" +
+	"\fauthor_token\x18\x05 \x01(\tR\vauthorToken\"j\n" +
+	"\fCourseBundle\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\x12B\n" +
+	"\bmanifest\x18\x02 \x01(\v2&.qubit_engine.education.CourseManifestR\bmanifest\"\x83\x01\n" +
+	"\x13ImportCourseRequest\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\x12!\n" +
+	"\fauthor_token\x18\x02 \x01(\tR\vauthorToken\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xe1\x01\n" +
+	"\x12ImportCourseResult\x12B\n" +
+	"\bmanifest\x18\x01 \x01(\v2&.qubit_engine.education.CourseManifestR\bmanifest\x12\x1a\n" +
+	"\bimported\x18\x02 \x03(\tR\bimported\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x03(\tR\tunchanged\x12\x18\n" +
+	"\askipped\x18\x04 \x03(\tR\askipped\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"l\n" +
+	"\x05Track\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"lesson_ids\x18\x04 \x03(\tR\tlessonIds\"E\n" +
+	"\fTrackCatalog\x125\n" +
+	"\x06tracks\x18\x01 \x03(\v2\x1d.qubit_engine.education.TrackR\x06tracks\"e\n" +
+	"\x13LearningPathRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\tR\atrackId\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"\xdf\x01\n" +
+	"\bPathStep\x12=\n" +
+	"\x06lesson\x18\x01 \x01(\v2%.qubit_engine.education.LessonSummaryR\x06lesson\x12<\n" +
+	"\x06status\x18\x02 \x01(\x0e2$.qubit_engine.education.LessonStatusR\x06status\x123\n" +
+	"\x15missing_prerequisites\x18\x03 \x03(\tR\x14missingPrerequisites\x12!\n" +
+	"\fcompleted_at\x18\x04 \x01(\x03R\vcompletedAt\"\xdb\x01\n" +
+	"\fLearningPath\x12\x19\n" +
+	"\btrack_id\x18\x01 \x01(\tR\atrackId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x126\n" +
+	"\x05steps\x18\x03 \x03(\v2 .qubit_engine.education.PathStepR\x05steps\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x05R\tcompleted\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12+\n" +
+	"\x11minutes_remaining\x18\x06 \x01(\x05R\x10minutesRemaining\"g\n" +
+	"\x15RecommendationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\tR\atrackId\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"\xa7\x01\n" +
+	"\x0eRecommendation\x12=\n" +
+	"\x06lesson\x18\x01 \x01(\v2%.qubit_engine.education.LessonSummaryR\x06lesson\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\tR\atrackId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12#\n" +
+	"\rall_completed\x18\x04 \x01(\bR\fallCompleted\"\x8c\x01\n" +
+	"\x15CompleteLessonRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12!\n" +
+	"\flearner_name\x18\x04 \x01(\tR\vlearnerName\"\xef\x01\n" +
+	"\x10LessonCompletion\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x129\n" +
+	"\bunlocked\x18\x02 \x03(\v2\x1d.qubit_engine.education.BadgeR\bunlocked\x12:\n" +
+	"\x04next\x18\x03 \x01(\v2&.qubit_engine.education.RecommendationR\x04next\x12G\n" +
+	"\fcertificates\x18\x04 \x03(\v2#.qubit_engine.education.CertificateR\fcertificates\"\xef\x02\n" +
+	"\vCertificate\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\flearner_name\x18\x03 \x01(\tR\vlearnerName\x12\x19\n" +
+	"\btrack_id\x18\x04 \x01(\tR\atrackId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x05 \x01(\tR\ttrackName\x12\x1d\n" +
+	"\n" +
+	"lesson_ids\x18\x06 \x03(\tR\tlessonIds\x12:\n" +
+	"\x06scores\x18\a \x03(\v2\".qubit_engine.education.TopicScoreR\x06scores\x12@\n" +
+	"\bcircuits\x18\b \x03(\v2$.qubit_engine.education.BuiltCircuitR\bcircuits\x12\x1b\n" +
+	"\tissued_at\x18\t \x01(\x03R\bissuedAt\x12\x1c\n" +
+	"\tsignature\x18\n" +
+	" \x01(\tR\tsignature\"d\n" +
+	"\n" +
+	"TopicScore\x123\n" +
+	"\x05topic\x18\x01 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12!\n" +
+	"\fbest_percent\x18\x02 \x01(\x05R\vbestPercent\"\x88\x01\n" +
+	"\fBuiltCircuit\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05gates\x18\x03 \x01(\tR\x05gates\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x19\n" +
+	"\bbuilt_at\x18\x05 \x01(\x03R\abuiltAt\"\x8b\x01\n" +
+	"\x12CertificateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\tR\atrackId\x12A\n" +
+	"\x06format\x18\x03 \x01(\x0e2).qubit_engine.education.CertificateFormatR\x06format\"\x9b\x01\n" +
+	"\x13CertificateDocument\x12E\n" +
+	"\vcertificate\x18\x01 \x01(\v2#.qubit_engine.education.CertificateR\vcertificate\x12\x1a\n" +
+	"\bdocument\x18\x02 \x01(\fR\bdocument\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\".\n" +
+	"\x18VerifyCertificateRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xad\x01\n" +
+	"\x17CertificateVerification\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12E\n" +
+	"\vcertificate\x18\x02 \x01(\v2#.qubit_engine.education.CertificateR\vcertificate\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tR\tpublicKey\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xbf\x01\n" +
+	"\n" +
+	"Assignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\x04kind\x18\x02 \x01(\x0e2&.qubit_engine.education.AssignmentKindR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x15\n" +
+	"\x06due_at\x18\x05 \x01(\x03R\x05dueAt\x12\x1f\n" +
+	"\vassigned_at\x18\x06 \x01(\x03R\n" +
+	"assignedAt\"\xb4\x01\n" +
+	"\x12AssignmentProgress\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12@\n" +
+	"\x06status\x18\x02 \x01(\x0e2(.qubit_engine.education.AssignmentStatusR\x06status\x12!\n" +
+	"\fcompleted_at\x18\x03 \x01(\x03R\vcompletedAt\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\"\xe2\x02\n" +
+	"\x05Class\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0einstructor_ids\x18\x03 \x03(\tR\rinstructorIds\x12D\n" +
+	"\vassignments\x18\x04 \x03(\v2\".qubit_engine.education.AssignmentR\vassignments\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12#\n" +
+	"\rstudent_count\x18\x06 \x01(\x05R\fstudentCount\x12\x1b\n" +
+	"\tjoin_code\x18\a \x01(\tR\bjoinCode\x12\x1f\n" +
+	"\vstudent_ids\x18\b \x03(\tR\n" +
+	"studentIds\x12F\n" +
+	"\bprogress\x18\t \x03(\v2*.qubit_engine.education.AssignmentProgressR\bprogress\"M\n" +
+	"\x12CreateClassRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\tR\finstructorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xb3\x01\n" +
+	"\x11EnrollmentRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\tR\finstructorId\x12\x1f\n" +
+	"\vstudent_ids\x18\x03 \x03(\tR\n" +
+	"studentIds\x12%\n" +
+	"\x0einstructor_ids\x18\x04 \x03(\tR\rinstructorIds\x12\x16\n" +
+	"\x06remove\x18\x05 \x01(\bR\x06remove\"H\n" +
+	"\x10JoinClassRequest\x12\x1b\n" +
+	"\tjoin_code\x18\x01 \x01(\tR\bjoinCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xfc\x01\n" +
+	"\x11AssignmentRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\tR\finstructorId\x12:\n" +
+	"\x04kind\x18\x03 \x01(\x0e2&.qubit_engine.education.AssignmentKindR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x04 \x01(\tR\x06itemId\x12\x15\n" +
+	"\x06due_at\x18\x05 \x01(\x03R\x05dueAt\x12#\n" +
+	"\rassignment_id\x18\x06 \x01(\tR\fassignmentId\x12\x16\n" +
+	"\x06remove\x18\a \x01(\bR\x06remove\"-\n" +
+	"\x12ListClassesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x81\x01\n" +
+	"\tClassList\x129\n" +
+	"\bteaching\x18\x01 \x03(\v2\x1d.qubit_engine.education.ClassR\bteaching\x129\n" +
+	"\benrolled\x18\x02 \x03(\v2\x1d.qubit_engine.education.ClassR\benrolled\"V\n" +
+	"\x14ClassProgressRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\tR\finstructorId\"\x90\x02\n" +
+	"\x0fStudentProgress\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12L\n" +
+	"\vassignments\x18\x02 \x03(\v2*.qubit_engine.education.AssignmentProgressR\vassignments\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\x05R\x04done\x12\x12\n" +
+	"\x04late\x18\x04 \x01(\x05R\x04late\x12\x18\n" +
+	"\aoverdue\x18\x05 \x01(\x05R\aoverdue\x12+\n" +
+	"\x11lessons_completed\x18\x06 \x01(\x05R\x10lessonsCompleted\x12'\n" +
+	"\x0fbadges_unlocked\x18\a \x01(\x05R\x0ebadgesUnlocked\"\xbe\x01\n" +
+	"\x11AssignmentSummary\x12B\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\".qubit_engine.education.AssignmentR\n" +
+	"assignment\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\x05R\x04done\x12\x12\n" +
+	"\x04late\x18\x03 \x01(\x05R\x04late\x12\x18\n" +
+	"\aoverdue\x18\x04 \x01(\x05R\aoverdue\x12#\n" +
+	"\raverage_score\x18\x05 \x01(\x05R\faverageScore\"\xff\x01\n" +
+	"\rClassProgress\x123\n" +
+	"\x05class\x18\x01 \x01(\v2\x1d.qubit_engine.education.ClassR\x05class\x12C\n" +
+	"\bstudents\x18\x02 \x03(\v2'.qubit_engine.education.StudentProgressR\bstudents\x12K\n" +
+	"\vassignments\x18\x03 \x03(\v2).qubit_engine.education.AssignmentSummaryR\vassignments\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\"\xb7\x02\n" +
+	"\vQuizRequest\x123\n" +
+	"\x05topic\x18\x01 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12B\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12#\n" +
+	"\rnum_questions\x18\x03 \x01(\x05R\fnumQuestions\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12:\n" +
+	"\x05types\x18\x06 \x03(\x0e2$.qubit_engine.education.QuestionTypeR\x05types\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\tR\agroupId\"\xac\x01\n" +
+	"\x04Quiz\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12>\n" +
+	"\tquestions\x18\x02 \x03(\v2 .qubit_engine.education.QuestionR\tquestions\x12,\n" +
+	"\x12time_limit_seconds\x18\x03 \x01(\x05R\x10timeLimitSeconds\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"\x8b\x03\n" +
+	"\bQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x128\n" +
+	"\x04type\x18\x02 \x01(\x0e2$.qubit_engine.education.QuestionTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x18\n" +
+	"\aoptions\x18\x04 \x03(\tR\aoptions\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\x05 \x01(\tR\tcircuitId\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x05R\x06points\x123\n" +
+	"\x05topic\x18\a \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\n" +
+	" \x01(\x05R\tnumQubits\x12\x18\n" +
+	"\aconcept\x18\v \x01(\tR\aconcept\x12\x1a\n" +
+	"\blanguage\x18\f \x01(\tR\blanguage\x12\x14\n" +
+	"\x05hints\x18\r \x01(\x05R\x05hintsJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"R\x06answerR\vexplanation\"\x89\x01\n" +
+	"\x0eQuizSubmission\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12B\n" +
+	"\aanswers\x18\x02 \x03(\v2(.qubit_engine.education.AnswerSubmissionR\aanswers\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"\x83\x01\n" +
+	"\x10AnswerSubmission\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x126\n" +
+	"\x05gates\x18\x03 \x03(\v2 .qubit_engine.education.GateStepR\x05gates\"\xa2\x02\n" +
+	"\n" +
+	"QuizResult\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12>\n" +
+	"\aresults\x18\x02 \x03(\v2$.qubit_engine.education.AnswerResultR\aresults\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1b\n" +
+	"\tmax_score\x18\x04 \x01(\x05R\bmaxScore\x12/\n" +
+	"\x13questions_remaining\x18\x05 \x01(\x05R\x12questionsRemaining\x12\x1c\n" +
+	"\tcompleted\x18\x06 \x01(\bR\tcompleted\x129\n" +
+	"\bunlocked\x18\a \x03(\v2\x1d.qubit_engine.education.BadgeR\bunlocked\"\xf2\x01\n" +
+	"\fAnswerResult\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x18\n" +
+	"\acorrect\x18\x02 \x01(\bR\acorrect\x12%\n" +
+	"\x0ecorrect_answer\x18\x03 \x01(\tR\rcorrectAnswer\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x12#\n" +
+	"\rpoints_earned\x18\x05 \x01(\x05R\fpointsEarned\x12\x1a\n" +
+	"\bfidelity\x18\x06 \x01(\x01R\bfidelity\x12\x1d\n" +
+	"\n" +
+	"hints_used\x18\a \x01(\x05R\thintsUsed\"\xfa\x01\n" +
+	"\x16QuizLeaderboardRequest\x123\n" +
+	"\x05topic\x18\x01 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12<\n" +
+	"\x05order\x18\x02 \x01(\x0e2&.qubit_engine.education.QuizBoardOrderR\x05order\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12#\n" +
+	"\rprevious_week\x18\x04 \x01(\bR\fpreviousWeek\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x19\n" +
+	"\bgroup_id\x18\x06 \x01(\tR\agroupId\"\x85\x02\n" +
+	"\x14QuizLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12+\n" +
+	"\x11quizzes_completed\x18\x04 \x01(\x05R\x10quizzesCompleted\x12*\n" +
+	"\x11fastest_answer_ms\x18\x05 \x01(\x03R\x0ffastestAnswerMs\x12\x1f\n" +
+	"\vstreak_days\x18\x06 \x01(\x05R\n" +
+	"streakDays\x12.\n" +
+	"\x13longest_streak_days\x18\a \x01(\x05R\x11longestStreakDays\"\xf4\x02\n" +
+	"\x0fQuizLeaderboard\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\tR\x04week\x12\x1b\n" +
+	"\tstarts_at\x18\x02 \x01(\x03R\bstartsAt\x12\x1b\n" +
+	"\tresets_at\x18\x03 \x01(\x03R\bresetsAt\x123\n" +
+	"\x05topic\x18\x04 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12<\n" +
+	"\x05order\x18\x05 \x01(\x0e2&.qubit_engine.education.QuizBoardOrderR\x05order\x12F\n" +
+	"\aentries\x18\x06 \x03(\v2,.qubit_engine.education.QuizLeaderboardEntryR\aentries\x12\x18\n" +
+	"\aplayers\x18\a \x01(\x05R\aplayers\x12>\n" +
+	"\x03you\x18\b \x01(\v2,.qubit_engine.education.QuizLeaderboardEntryR\x03you\"\x9f\x01\n" +
+	"\vHintRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12!\n" +
+	"\fchallenge_id\x18\x03 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\"q\n" +
+	"\x04Hint\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12)\n" +
+	"\x10discount_percent\x18\x04 \x01(\x05R\x0fdiscountPercent\"*\n" +
+	"\x0fAttemptsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xcc\x02\n" +
+	"\vQuizAttempt\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\tR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x123\n" +
+	"\x05topic\x18\x03 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x1b\n" +
+	"\tmax_score\x18\x05 \x01(\x05R\bmaxScore\x12\x1a\n" +
+	"\banswered\x18\x06 \x01(\x05R\banswered\x12'\n" +
+	"\x0ftotal_questions\x18\a \x01(\x05R\x0etotalQuestions\x12\x1c\n" +
+	"\tcompleted\x18\b \x01(\bR\tcompleted\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\x03R\vcompletedAt\"p\n" +
+	"\x0eAttemptHistory\x12?\n" +
+	"\battempts\x18\x01 \x03(\v2#.qubit_engine.education.QuizAttemptR\battempts\x12\x1d\n" +
+	"\n" +
+	"best_score\x18\x02 \x01(\x05R\tbestScore\"^\n" +
+	"\x11DueReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"\xe1\x01\n" +
+	"\n" +
+	"ReviewCard\x12<\n" +
+	"\bquestion\x18\x01 \x01(\v2 .qubit_engine.education.QuestionR\bquestion\x12\x15\n" +
+	"\x06due_at\x18\x02 \x01(\x03R\x05dueAt\x12#\n" +
+	"\rinterval_days\x18\x03 \x01(\x05R\fintervalDays\x12\x1f\n" +
+	"\vease_factor\x18\x04 \x01(\x01R\n" +
+	"easeFactor\x12 \n" +
+	"\vrepetitions\x18\x05 \x01(\x05R\vrepetitions\x12\x16\n" +
+	"\x06lapses\x18\x06 \x01(\x05R\x06lapses\"f\n" +
+	"\n" +
+	"LearnerDue\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdue_count\x18\x02 \x01(\x05R\bdueCount\x12\"\n" +
+	"\roldest_due_at\x18\x03 \x01(\x03R\voldestDueAt\"\xc7\x01\n" +
+	"\n" +
+	"DueReviews\x12<\n" +
+	"\areviews\x18\x01 \x03(\v2\".qubit_engine.education.ReviewCardR\areviews\x12\x1b\n" +
+	"\tdue_count\x18\x02 \x01(\x05R\bdueCount\x12\x1e\n" +
+	"\vnext_due_at\x18\x03 \x01(\x03R\tnextDueAt\x12>\n" +
+	"\blearners\x18\x04 \x03(\v2\".qubit_engine.education.LearnerDueR\blearners\"\xd2\x01\n" +
+	"\x10ReviewSubmission\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x126\n" +
+	"\x05gates\x18\x04 \x03(\v2 .qubit_engine.education.GateStepR\x05gates\x12\x18\n" +
+	"\aquality\x18\x05 \x01(\x05R\aquality\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\"\x84\x01\n" +
+	"\fReviewResult\x12<\n" +
+	"\x06result\x18\x01 \x01(\v2$.qubit_engine.education.AnswerResultR\x06result\x126\n" +
+	"\x04card\x18\x02 \x01(\v2\".qubit_engine.education.ReviewCardR\x04card\"/\n" +
+	"\x0eCircuitRequest\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\x01 \x01(\tR\tcircuitId\"\xa7\x01\n" +
+	"\rCircuitFilter\x123\n" +
+	"\x05topic\x18\x01 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12B\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12\x1d\n" +
+	"\n" +
+	"max_qubits\x18\x03 \x01(\x05R\tmaxQubits\"\xcf\x02\n" +
+	"\x0eLibraryCircuit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x123\n" +
+	"\x05topic\x18\x04 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12B\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x06 \x01(\x05R\tnumQubits\x126\n" +
+	"\x05gates\x18\a \x03(\v2 .qubit_engine.education.GateStepR\x05gates\x12'\n" +
+	"\x0fexpected_output\x18\b \x01(\tR\x0eexpectedOutput\"T\n" +
+	"\bGateStep\x12\x12\n" +
+	"\x04gate\x18\x01 \x01(\tR\x04gate\x12\x16\n" +
+	"\x06qubits\x18\x02 \x03(\x05R\x06qubits\x12\x1c\n" +
+	"\tparameter\x18\x03 \x01(\x01R\tparameter\"T\n" +
+	"\x0eCircuitCatalog\x12B\n" +
+	"\bcircuits\x18\x01 \x03(\v2&.qubit_engine.education.CircuitSummaryR\bcircuits\"\xa5\x01\n" +
+	"\x0eCircuitSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\x05topic\x18\x03 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x04 \x01(\x05R\tnumQubits\x12\x1b\n" +
+	"\tnum_gates\x18\x05 \x01(\x05R\bnumGates\"\xb3\x01\n" +
+	"\x0eSandboxRequest\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x126\n" +
+	"\x05gates\x18\x02 \x03(\v2 .qubit_engine.education.GateStepR\x05gates\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\x03 \x01(\tR\tcircuitId\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x04R\x04seed\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\"3\n" +
+	"\tAmplitude\x12\x12\n" +
+	"\x04real\x18\x01 \x01(\x01R\x04real\x12\x12\n" +
+	"\x04imag\x18\x02 \x01(\x01R\x04imag\"\x9a\x02\n" +
+	"\tTraceStep\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x124\n" +
+	"\x04gate\x18\x02 \x01(\v2 .qubit_engine.education.GateStepR\x04gate\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12D\n" +
+	"\fstate_vector\x18\x04 \x03(\v2!.qubit_engine.education.AmplitudeR\vstateVector\x12$\n" +
+	"\rprobabilities\x18\x05 \x03(\x01R\rprobabilities\x12\x1b\n" +
+	"\tstate_ket\x18\x06 \x01(\tR\bstateKet\x12\x18\n" +
+	"\aoutcome\x18\a \x01(\x05R\aoutcome\"\xe4\x02\n" +
+	"\rSandboxResult\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x12D\n" +
+	"\fstate_vector\x18\x02 \x03(\v2!.qubit_engine.education.AmplitudeR\vstateVector\x12$\n" +
+	"\rprobabilities\x18\x03 \x03(\x01R\rprobabilities\x12\x1f\n" +
+	"\vfinal_state\x18\x04 \x01(\tR\n" +
+	"finalState\x127\n" +
+	"\x05trace\x18\x05 \x03(\v2!.qubit_engine.education.TraceStepR\x05trace\x12\x1d\n" +
+	"\n" +
+	"gate_count\x18\x06 \x01(\x05R\tgateCount\x12\x14\n" +
+	"\x05depth\x18\a \x01(\x05R\x05depth\x129\n" +
+	"\bunlocked\x18\b \x03(\v2\x1d.qubit_engine.education.BadgeR\bunlocked\"\x8a\x01\n" +
+	"\x0fChallengeFilter\x123\n" +
+	"\x05topic\x18\x01 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12B\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\"5\n" +
+	"\x10ChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"\x9a\x04\n" +
+	"\tChallenge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x123\n" +
+	"\x05topic\x18\x04 \x01(\x0e2\x1d.qubit_engine.education.TopicR\x05topic\x12B\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\x0e2\".qubit_engine.education.DifficultyR\n" +
+	"difficulty\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x06 \x01(\x05R\tnumQubits\x12\x1f\n" +
+	"\vinput_state\x18\a \x01(\tR\n" +
+	"inputState\x12E\n" +
+	"\x06target\x18\b \x03(\v2-.qubit_engine.education.Challenge.TargetEntryR\x06target\x12\x1b\n" +
+	"\tmax_gates\x18\t \x01(\x05R\bmaxGates\x12\x1d\n" +
+	"\n" +
+	"max_qubits\x18\n" +
+	" \x01(\x05R\tmaxQubits\x12\x1b\n" +
+	"\tpar_gates\x18\v \x01(\x05R\bparGates\x12\x1b\n" +
+	"\tpar_depth\x18\f \x01(\x05R\bparDepth\x12\x14\n" +
+	"\x05hints\x18\r \x01(\x05R\x05hints\x1a9\n" +
+	"\vTargetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"U\n" +
+	"\x10ChallengeCatalog\x12A\n" +
+	"\n" +
+	"challenges\x18\x01 \x03(\v2!.qubit_engine.education.ChallengeR\n" +
+	"challenges\"\xa8\x01\n" +
+	"\x13ChallengeSubmission\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x03 \x01(\x05R\tnumQubits\x126\n" +
+	"\x05gates\x18\x04 \x03(\v2 .qubit_engine.education.GateStepR\x05gates\"\x84\x04\n" +
+	"\x0fChallengeResult\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x18\n" +
+	"\acorrect\x18\x02 \x01(\bR\acorrect\x12\x1a\n" +
+	"\bfidelity\x18\x03 \x01(\x01R\bfidelity\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x1d\n" +
+	"\n" +
+	"gate_count\x18\x05 \x01(\x05R\tgateCount\x12\x14\n" +
+	"\x05depth\x18\x06 \x01(\x05R\x05depth\x12]\n" +
+	"\fdistribution\x18\a \x03(\v29.qubit_engine.education.ChallengeResult.DistributionEntryR\fdistribution\x12\x12\n" +
+	"\x04rank\x18\b \x01(\x05R\x04rank\x12#\n" +
+	"\rpersonal_best\x18\t \x01(\bR\fpersonalBest\x12\x1a\n" +
+	"\bfeedback\x18\n" +
+	" \x01(\tR\bfeedback\x129\n" +
+	"\bunlocked\x18\v \x03(\v2\x1d.qubit_engine.education.BadgeR\bunlocked\x12\x1d\n" +
+	"\n" +
+	"hints_used\x18\f \x01(\x05R\thintsUsed\x1a?\n" +
+	"\x11DistributionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"V\n" +
+	"\x1bChallengeLeaderboardRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xb6\x01\n" +
+	"\x19ChallengeLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1d\n" +
+	"\n" +
+	"gate_count\x18\x04 \x01(\x05R\tgateCount\x12\x14\n" +
+	"\x05depth\x18\x05 \x01(\x05R\x05depth\x12!\n" +
+	"\fsubmitted_at\x18\x06 \x01(\x03R\vsubmittedAt\"\xa0\x01\n" +
+	"\x14ChallengeLeaderboard\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12K\n" +
+	"\aentries\x18\x02 \x03(\v21.qubit_engine.education.ChallengeLeaderboardEntryR\aentries\x12\x18\n" +
+	"\asolvers\x18\x03 \x01(\x05R\asolvers\"m\n" +
+	"\x10AchievementEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"E\n" +
+	"\bEventAck\x129\n" +
+	"\bunlocked\x18\x01 \x03(\v2\x1d.qubit_engine.education.BadgeR\bunlocked\".\n" +
+	"\x13AchievementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd0\x01\n" +
+	"\x05Badge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bprogress\x18\x05 \x01(\x05R\bprogress\x12\x12\n" +
+	"\x04goal\x18\x06 \x01(\x05R\x04goal\x12\x1a\n" +
+	"\bunlocked\x18\a \x01(\bR\bunlocked\x12\x1f\n" +
+	"\vunlocked_at\x18\b \x01(\x03R\n" +
+	"unlockedAt\"\x88\x01\n" +
+	"\x0fAchievementList\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
+	"\x06badges\x18\x02 \x03(\v2\x1d.qubit_engine.education.BadgeR\x06badges\x12%\n" +
+	"\x0eunlocked_count\x18\x03 \x01(\x05R\runlockedCount\"E\n" +
+	"\fBadgeCatalog\x125\n" +
+	"\x06badges\x18\x01 \x03(\v2\x1d.qubit_engine.education.BadgeR\x06badges*Y\n" +
+	"\fRenderFormat\x12\x13\n" +
+	"\x0fRENDER_MARKDOWN\x10\x00\x12\x0f\n" +
+	"\vRENDER_HTML\x10\x01\x12\x0f\n" +
+	"\vRENDER_ANSI\x10\x02\x12\x12\n" +
+	"\x0eRENDER_DISCORD\x10\x03*\xdd\x01\n" +
+	"\x05Topic\x12\x15\n" +
+	"\x11TOPIC_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TOPIC_SUPERPOSITION\x10\x01\x12\x16\n" +
+	"\x12TOPIC_ENTANGLEMENT\x10\x02\x12\x0f\n" +
+	"\vTOPIC_GATES\x10\x03\x12\x15\n" +
+	"\x11TOPIC_MEASUREMENT\x10\x04\x12\x14\n" +
+	"\x10TOPIC_ALGORITHMS\x10\x05\x12\r\n" +
+	"\tTOPIC_QFT\x10\x06\x12\x10\n" +
+	"\fTOPIC_GROVER\x10\a\x12\x0e\n" +
+	"\n" +
+	"TOPIC_SHOR\x10\b\x12\r\n" +
+	"\tTOPIC_VQE\x10\t\x12\x0e\n" +
+	"\n" +
+	"TOPIC_QAOA\x10\n" +
+	"*\x8e\x01\n" +
+	"\n" +
+	"Difficulty\x12\x1a\n" +
+	"\x16DIFFICULTY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13DIFFICULTY_BEGINNER\x10\x01\x12\x1b\n" +
+	"\x17DIFFICULTY_INTERMEDIATE\x10\x02\x12\x17\n" +
+	"\x13DIFFICULTY_ADVANCED\x10\x03\x12\x15\n" +
+	"\x11DIFFICULTY_EXPERT\x10\x04*l\n" +
+	"\fLessonStatus\x12\x1d\n" +
+	"\x19LESSON_STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rLESSON_LOCKED\x10\x01\x12\x14\n" +
+	"\x10LESSON_AVAILABLE\x10\x02\x12\x14\n" +
+	"\x10LESSON_COMPLETED\x10\x03*=\n" +
+	"\x11CertificateFormat\x12\x13\n" +
+	"\x0fCERTIFICATE_PNG\x10\x00\x12\x13\n" +
+	"\x0fCERTIFICATE_PDF\x10\x01*A\n" +
+	"\x0eAssignmentKind\x12\x15\n" +
+	"\x11ASSIGNMENT_LESSON\x10\x00\x12\x18\n" +
+	"\x14ASSIGNMENT_CHALLENGE\x10\x01*l\n" +
+	"\x10AssignmentStatus\x12\x16\n" +
+	"\x12ASSIGNMENT_PENDING\x10\x00\x12\x13\n" +
+	"\x0fASSIGNMENT_DONE\x10\x01\x12\x13\n" +
+	"\x0fASSIGNMENT_LATE\x10\x02\x12\x16\n" +
+	"\x12ASSIGNMENT_OVERDUE\x10\x03*\x9e\x01\n" +
+	"\fQuestionType\x12\x1c\n" +
+	"\x18QUESTION_MULTIPLE_CHOICE\x10\x00\x12\x17\n" +
+	"\x13QUESTION_TRUE_FALSE\x10\x01\x12\x1b\n" +
+	"\x17QUESTION_CIRCUIT_OUTPUT\x10\x02\x12\x17\n" +
+	"\x13QUESTION_FILL_BLANK\x10\x03\x12!\n" +
+	"\x1dQUESTION_CIRCUIT_CONSTRUCTION\x10\x04*V\n" +
+	"\x0eQuizBoardOrder\x12\x15\n" +
+	"\x11QUIZ_BOARD_POINTS\x10\x00\x12\x16\n" +
+	"\x12QUIZ_BOARD_FASTEST\x10\x01\x12\x15\n" +
+	"\x11QUIZ_BOARD_STREAK\x10\x022\x9f\x1c\n" +
+	"\x10QuantumEducation\x12R\n" +
+	"\tGetLesson\x12%.qubit_engine.education.LessonRequest\x1a\x1e.qubit_engine.education.Lesson\x12_\n" +
+	"\vListLessons\x12).qubit_engine.education.LessonListRequest\x1a%.qubit_engine.education.LessonCatalog\x12U\n" +
+	"\tPutLesson\x12(.qubit_engine.education.PutLessonRequest\x1a\x1e.qubit_engine.education.Lesson\x12g\n" +
+	"\x10GetLessonHistory\x12,.qubit_engine.education.LessonHistoryRequest\x1a%.qubit_engine.education.LessonHistory\x12W\n" +
+	"\rListLanguages\x12\x1d.qubit_engine.education.Empty\x1a'.qubit_engine.education.LanguageCatalog\x12_\n" +
+	"\rRenderContent\x12%.qubit_engine.education.RenderRequest\x1a'.qubit_engine.education.RenderedContent\x12a\n" +
+	"\fExportCourse\x12+.qubit_engine.education.ExportCourseRequest\x1a$.qubit_engine.education.CourseBundle\x12g\n" +
+	"\fImportCourse\x12+.qubit_engine.education.ImportCourseRequest\x1a*.qubit_engine.education.ImportCourseResult\x12Q\n" +
+	"\n" +
+	"ListTracks\x12\x1d.qubit_engine.education.Empty\x1a$.qubit_engine.education.TrackCatalog\x12d\n" +
+	"\x0fGetLearningPath\x12+.qubit_engine.education.LearningPathRequest\x1a$.qubit_engine.education.LearningPath\x12k\n" +
+	"\x12GetNextRecommended\x12-.qubit_engine.education.RecommendationRequest\x1a&.qubit_engine.education.Recommendation\x12i\n" +
+	"\x0eCompleteLesson\x12-.qubit_engine.education.CompleteLessonRequest\x1a(.qubit_engine.education.LessonCompletion\x12i\n" +
+	"\x0eGetCertificate\x12*.qubit_engine.education.CertificateRequest\x1a+.qubit_engine.education.CertificateDocument\x12v\n" +
+	"\x11VerifyCertificate\x120.qubit_engine.education.VerifyCertificateRequest\x1a/.qubit_engine.education.CertificateVerification\x12X\n" +
+	"\vCreateClass\x12*.qubit_engine.education.CreateClassRequest\x1a\x1d.qubit_engine.education.Class\x12Z\n" +
+	"\x0eEnrollStudents\x12).qubit_engine.education.EnrollmentRequest\x1a\x1d.qubit_engine.education.Class\x12T\n" +
+	"\tJoinClass\x12(.qubit_engine.education.JoinClassRequest\x1a\x1d.qubit_engine.education.Class\x12V\n" +
+	"\n" +
+	"AssignWork\x12).qubit_engine.education.AssignmentRequest\x1a\x1d.qubit_engine.education.Class\x12\\\n" +
+	"\vListClasses\x12*.qubit_engine.education.ListClassesRequest\x1a!.qubit_engine.education.ClassList\x12h\n" +
+	"\x11ListClassProgress\x12,.qubit_engine.education.ClassProgressRequest\x1a%.qubit_engine.education.ClassProgress\x12\\\n" +
+	"\n" +
+	"GetCircuit\x12&.qubit_engine.education.CircuitRequest\x1a&.qubit_engine.education.LibraryCircuit\x12]\n" +
+	"\fListCircuits\x12%.qubit_engine.education.CircuitFilter\x1a&.qubit_engine.education.CircuitCatalog\x12Q\n" +
+	"\fGenerateQuiz\x12#.qubit_engine.education.QuizRequest\x1a\x1c.qubit_engine.education.Quiz\x12[\n" +
+	"\rSubmitAnswers\x12&.qubit_engine.education.QuizSubmission\x1a\".qubit_engine.education.QuizResult\x12b\n" +
+	"\x0fGetQuizAttempts\x12'.qubit_engine.education.AttemptsRequest\x1a&.qubit_engine.education.AttemptHistory\x12m\n" +
+	"\x12GetQuizLeaderboard\x12..qubit_engine.education.QuizLeaderboardRequest\x1a'.qubit_engine.education.QuizLeaderboard\x12L\n" +
+	"\aGetHint\x12#.qubit_engine.education.HintRequest\x1a\x1c.qubit_engine.education.Hint\x12^\n" +
+	"\rGetDueReviews\x12).qubit_engine.education.DueReviewsRequest\x1a\".qubit_engine.education.DueReviews\x12^\n" +
+	"\fSubmitReview\x12(.qubit_engine.education.ReviewSubmission\x1a$.qubit_engine.education.ReviewResult\x12b\n" +
+	"\x11RunSandboxCircuit\x12&.qubit_engine.education.SandboxRequest\x1a%.qubit_engine.education.SandboxResult\x12c\n" +
+	"\x0eListChallenges\x12'.qubit_engine.education.ChallengeFilter\x1a(.qubit_engine.education.ChallengeCatalog\x12[\n" +
+	"\fGetChallenge\x12(.qubit_engine.education.ChallengeRequest\x1a!.qubit_engine.education.Challenge\x12g\n" +
+	"\x0fSubmitChallenge\x12+.qubit_engine.education.ChallengeSubmission\x1a'.qubit_engine.education.ChallengeResult\x12|\n" +
+	"\x17GetChallengeLeaderboard\x123.qubit_engine.education.ChallengeLeaderboardRequest\x1a,.qubit_engine.education.ChallengeLeaderboard\x12Y\n" +
+	"\vRecordEvent\x12(.qubit_engine.education.AchievementEvent\x1a .qubit_engine.education.EventAck\x12g\n" +
+	"\x0fGetAchievements\x12+.qubit_engine.education.AchievementsRequest\x1a'.qubit_engine.education.AchievementList\x12Q\n" +
+	"\n" +
+	"ListBadges\x12\x1d.qubit_engine.education.Empty\x1a$.qubit_engine.education.BadgeCatalogB<Z:github.com/perclft/QubitEngine/modules/education/generatedb\x06proto3"
+
+var (
+	file_education_proto_rawDescOnce sync.Once
+	file_education_proto_rawDescData []byte
+)
+
+func file_education_proto_rawDescGZIP() []byte {
+	file_education_proto_rawDescOnce.Do(func() {
+		file_education_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)))
+	})
+	return file_education_proto_rawDescData
+}
+
+var file_education_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
+var file_education_proto_goTypes = []any{
+	(RenderFormat)(0),                   // 0: qubit_engine.education.RenderFormat
+	(Topic)(0),                          // 1: qubit_engine.education.Topic
+	(Difficulty)(0),                     // 2: qubit_engine.education.Difficulty
+	(LessonStatus)(0),                   // 3: qubit_engine.education.LessonStatus
+	(CertificateFormat)(0),              // 4: qubit_engine.education.CertificateFormat
+	(AssignmentKind)(0),                 // 5: qubit_engine.education.AssignmentKind
+	(AssignmentStatus)(0),               // 6: qubit_engine.education.AssignmentStatus
+	(QuestionType)(0),                   // 7: qubit_engine.education.QuestionType
+	(QuizBoardOrder)(0),                 // 8: qubit_engine.education.QuizBoardOrder
+	(*Empty)(nil),                       // 9: qubit_engine.education.Empty
+	(*LessonRequest)(nil),               // 10: qubit_engine.education.LessonRequest
+	(*LessonListRequest)(nil),           // 11: qubit_engine.education.LessonListRequest
+	(*Lesson)(nil),                      // 12: qubit_engine.education.Lesson
+	(*LessonCatalog)(nil),               // 13: qubit_engine.education.LessonCatalog
+	(*LessonSummary)(nil),               // 14: qubit_engine.education.LessonSummary
+	(*PutLessonRequest)(nil),            // 15: qubit_engine.education.PutLessonRequest
+	(*LessonHistoryRequest)(nil),        // 16: qubit_engine.education.LessonHistoryRequest
+	(*LessonVersion)(nil),               // 17: qubit_engine.education.LessonVersion
+	(*LessonHistory)(nil),               // 18: qubit_engine.education.LessonHistory
+	(*RenderRequest)(nil),               // 19: qubit_engine.education.RenderRequest
+	(*RenderedContent)(nil),             // 20: qubit_engine.education.RenderedContent
+	(*LanguageCoverage)(nil),            // 21: qubit_engine.education.LanguageCoverage
+	(*LanguageCatalog)(nil),             // 22: qubit_engine.education.LanguageCatalog
+	(*CourseManifest)(nil),              // 23: qubit_engine.education.CourseManifest
+	(*ExportCourseRequest)(nil),         // 24: qubit_engine.education.ExportCourseRequest
+	(*CourseBundle)(nil),                // 25: qubit_engine.education.CourseBundle
+	(*ImportCourseRequest)(nil),         // 26: qubit_engine.education.ImportCourseRequest
+	(*ImportCourseResult)(nil),          // 27: qubit_engine.education.ImportCourseResult
+	(*Track)(nil),                       // 28: qubit_engine.education.Track
+	(*TrackCatalog)(nil),                // 29: qubit_engine.education.TrackCatalog
+	(*LearningPathRequest)(nil),         // 30: qubit_engine.education.LearningPathRequest
+	(*PathStep)(nil),                    // 31: qubit_engine.education.PathStep
+	(*LearningPath)(nil),                // 32: qubit_engine.education.LearningPath
+	(*RecommendationRequest)(nil),       // 33: qubit_engine.education.RecommendationRequest
+	(*Recommendation)(nil),              // 34: qubit_engine.education.Recommendation
+	(*CompleteLessonRequest)(nil),       // 35: qubit_engine.education.CompleteLessonRequest
+	(*LessonCompletion)(nil),            // 36: qubit_engine.education.LessonCompletion
+	(*Certificate)(nil),                 // 37: qubit_engine.education.Certificate
+	(*TopicScore)(nil),                  // 38: qubit_engine.education.TopicScore
+	(*BuiltCircuit)(nil),                // 39: qubit_engine.education.BuiltCircuit
+	(*CertificateRequest)(nil),          // 40: qubit_engine.education.CertificateRequest
+	(*CertificateDocument)(nil),         // 41: qubit_engine.education.CertificateDocument
+	(*VerifyCertificateRequest)(nil),    // 42: qubit_engine.education.VerifyCertificateRequest
+	(*CertificateVerification)(nil),     // 43: qubit_engine.education.CertificateVerification
+	(*Assignment)(nil),                  // 44: qubit_engine.education.Assignment
+	(*AssignmentProgress)(nil),          // 45: qubit_engine.education.AssignmentProgress
+	(*Class)(nil),                       // 46: qubit_engine.education.Class
+	(*CreateClassRequest)(nil),          // 47: qubit_engine.education.CreateClassRequest
+	(*EnrollmentRequest)(nil),           // 48: qubit_engine.education.EnrollmentRequest
+	(*JoinClassRequest)(nil),            // 49: qubit_engine.education.JoinClassRequest
+	(*AssignmentRequest)(nil),           // 50: qubit_engine.education.AssignmentRequest
+	(*ListClassesRequest)(nil),          // 51: qubit_engine.education.ListClassesRequest
+	(*ClassList)(nil),                   // 52: qubit_engine.education.ClassList
+	(*ClassProgressRequest)(nil),        // 53: qubit_engine.education.ClassProgressRequest
+	(*StudentProgress)(nil),             // 54: qubit_engine.education.StudentProgress
+	(*AssignmentSummary)(nil),           // 55: qubit_engine.education.AssignmentSummary
+	(*ClassProgress)(nil),               // 56: qubit_engine.education.ClassProgress
+	(*QuizRequest)(nil),                 // 57: qubit_engine.education.QuizRequest
+	(*Quiz)(nil),                        // 58: qubit_engine.education.Quiz
+	(*Question)(nil),                    // 59: qubit_engine.education.Question
+	(*QuizSubmission)(nil),              // 60: qubit_engine.education.QuizSubmission
+	(*AnswerSubmission)(nil),            // 61: qubit_engine.education.AnswerSubmission
+	(*QuizResult)(nil),                  // 62: qubit_engine.education.QuizResult
+	(*AnswerResult)(nil),                // 63: qubit_engine.education.AnswerResult
+	(*QuizLeaderboardRequest)(nil),      // 64: qubit_engine.education.QuizLeaderboardRequest
+	(*QuizLeaderboardEntry)(nil),        // 65: qubit_engine.education.QuizLeaderboardEntry
+	(*QuizLeaderboard)(nil),             // 66: qubit_engine.education.QuizLeaderboard
+	(*HintRequest)(nil),                 // 67: qubit_engine.education.HintRequest
+	(*Hint)(nil),                        // 68: qubit_engine.education.Hint
+	(*AttemptsRequest)(nil),             // 69: qubit_engine.education.AttemptsRequest
+	(*QuizAttempt)(nil),                 // 70: qubit_engine.education.QuizAttempt
+	(*AttemptHistory)(nil),              // 71: qubit_engine.education.AttemptHistory
+	(*DueReviewsRequest)(nil),           // 72: qubit_engine.education.DueReviewsRequest
+	(*ReviewCard)(nil),                  // 73: qubit_engine.education.ReviewCard
+	(*LearnerDue)(nil),                  // 74: qubit_engine.education.LearnerDue
+	(*DueReviews)(nil),                  // 75: qubit_engine.education.DueReviews
+	(*ReviewSubmission)(nil),            // 76: qubit_engine.education.ReviewSubmission
+	(*ReviewResult)(nil),                // 77: qubit_engine.education.ReviewResult
+	(*CircuitRequest)(nil),              // 78: qubit_engine.education.CircuitRequest
+	(*CircuitFilter)(nil),               // 79: qubit_engine.education.CircuitFilter
+	(*LibraryCircuit)(nil),              // 80: qubit_engine.education.LibraryCircuit
+	(*GateStep)(nil),                    // 81: qubit_engine.education.GateStep
+	(*CircuitCatalog)(nil),              // 82: qubit_engine.education.CircuitCatalog
+	(*CircuitSummary)(nil),              // 83: qubit_engine.education.CircuitSummary
+	(*SandboxRequest)(nil),              // 84: qubit_engine.education.SandboxRequest
+	(*Amplitude)(nil),                   // 85: qubit_engine.education.Amplitude
+	(*TraceStep)(nil),                   // 86: qubit_engine.education.TraceStep
+	(*SandboxResult)(nil),               // 87: qubit_engine.education.SandboxResult
+	(*ChallengeFilter)(nil),             // 88: qubit_engine.education.ChallengeFilter
+	(*ChallengeRequest)(nil),            // 89: qubit_engine.education.ChallengeRequest
+	(*Challenge)(nil),                   // 90: qubit_engine.education.Challenge
+	(*ChallengeCatalog)(nil),            // 91: qubit_engine.education.ChallengeCatalog
+	(*ChallengeSubmission)(nil),         // 92: qubit_engine.education.ChallengeSubmission
+	(*ChallengeResult)(nil),             // 93: qubit_engine.education.ChallengeResult
+	(*ChallengeLeaderboardRequest)(nil), // 94: qubit_engine.education.ChallengeLeaderboardRequest
+	(*ChallengeLeaderboardEntry)(nil),   // 95: qubit_engine.education.ChallengeLeaderboardEntry
+	(*ChallengeLeaderboard)(nil),        // 96: qubit_engine.education.ChallengeLeaderboard
+	(*AchievementEvent)(nil),            // 97: qubit_engine.education.AchievementEvent
+	(*EventAck)(nil),                    // 98: qubit_engine.education.EventAck
+	(*AchievementsRequest)(nil),         // 99: qubit_engine.education.AchievementsRequest
+	(*Badge)(nil),                       // 100: qubit_engine.education.Badge
+	(*AchievementList)(nil),             // 101: qubit_engine.education.AchievementList
+	(*BadgeCatalog)(nil),                // 102: qubit_engine.education.BadgeCatalog
+	nil,                                 // 103: qubit_engine.education.Challenge.TargetEntry
+	nil,                                 // 104: qubit_engine.education.ChallengeResult.DistributionEntry
+}
+var file_education_proto_depIdxs = []int32{
+	1,   // 0: qubit_engine.education.LessonRequest.topic:type_name -> qubit_engine.education.Topic
+	2,   // 1: qubit_engine.education.LessonRequest.difficulty:type_name -> qubit_engine.education.Difficulty
+	0,   // 2: qubit_engine.education.LessonRequest.format:type_name -> qubit_engine.education.RenderFormat
+	1,   // 3: qubit_engine.education.Lesson.topic:type_name -> qubit_engine.education.Topic
+	2,   // 4: qubit_engine.education.Lesson.difficulty:type_name -> qubit_engine.education.Difficulty
+	14,  // 5: qubit_engine.education.LessonCatalog.lessons:type_name -> qubit_engine.education.LessonSummary
+	1,   // 6: qubit_engine.education.LessonSummary.topic:type_name -> qubit_engine.education.Topic
+	2,   // 7: qubit_engine.education.LessonSummary.difficulty:type_name -> qubit_engine.education.Difficulty
+	12,  // 8: qubit_engine.education.PutLessonRequest.lesson:type_name -> qubit_engine.education.Lesson
+	17,  // 9: qubit_engine.education.LessonHistory.versions:type_name -> qubit_engine.education.LessonVersion
+	0,   // 10: qubit_engine.education.RenderRequest.format:type_name -> qubit_engine.education.RenderFormat
+	21,  // 11: qubit_engine.education.LanguageCatalog.languages:type_name -> qubit_engine.education.LanguageCoverage
+	23,  // 12: qubit_engine.education.CourseBundle.manifest:type_name -> qubit_engine.education.CourseManifest
+	23,  // 13: qubit_engine.education.ImportCourseResult.manifest:type_name -> qubit_engine.education.CourseManifest
+	28,  // 14: qubit_engine.education.TrackCatalog.tracks:type_name -> qubit_engine.education.Track
+	14,  // 15: qubit_engine.education.PathStep.lesson:type_name -> qubit_engine.education.LessonSummary
+	3,   // 16: qubit_engine.education.PathStep.status:type_name -> qubit_engine.education.LessonStatus
+	31,  // 17: qubit_engine.education.LearningPath.steps:type_name -> qubit_engine.education.PathStep
+	14,  // 18: qubit_engine.education.Recommendation.lesson:type_name -> qubit_engine.education.LessonSummary
+	100, // 19: qubit_engine.education.LessonCompletion.unlocked:type_name -> qubit_engine.education.Badge
+	34,  // 20: qubit_engine.education.LessonCompletion.next:type_name -> qubit_engine.education.Recommendation
+	37,  // 21: qubit_engine.education.LessonCompletion.certificates:type_name -> qubit_engine.education.Certificate
+	38,  // 22: qubit_engine.education.Certificate.scores:type_name -> qubit_engine.education.TopicScore
+	39,  // 23: qubit_engine.education.Certificate.circuits:type_name -> qubit_engine.education.BuiltCircuit
+	1,   // 24: qubit_engine.education.TopicScore.topic:type_name -> qubit_engine.education.Topic
+	4,   // 25: qubit_engine.education.CertificateRequest.format:type_name -> qubit_engine.education.CertificateFormat
+	37,  // 26: qubit_engine.education.CertificateDocument.certificate:type_name -> qubit_engine.education.Certificate
+	37,  // 27: qubit_engine.education.CertificateVerification.certificate:type_name -> qubit_engine.education.Certificate
+	5,   // 28: qubit_engine.education.Assignment.kind:type_name -> qubit_engine.education.AssignmentKind
+	6,   // 29: qubit_engine.education.AssignmentProgress.status:type_name -> qubit_engine.education.AssignmentStatus
+	44,  // 30: qubit_engine.education.Class.assignments:type_name -> qubit_engine.education.Assignment
+	45,  // 31: qubit_engine.education.Class.progress:type_name -> qubit_engine.education.AssignmentProgress
+	5,   // 32: qubit_engine.education.AssignmentRequest.kind:type_name -> qubit_engine.education.AssignmentKind
+	46,  // 33: qubit_engine.education.ClassList.teaching:type_name -> qubit_engine.education.Class
+	46,  // 34: qubit_engine.education.ClassList.enrolled:type_name -> qubit_engine.education.Class
+	45,  // 35: qubit_engine.education.StudentProgress.assignments:type_name -> qubit_engine.education.AssignmentProgress
+	44,  // 36: qubit_engine.education.AssignmentSummary.assignment:type_name -> qubit_engine.education.Assignment
+	46,  // 37: qubit_engine.education.ClassProgress.class:type_name -> qubit_engine.education.Class
+	54,  // 38: qubit_engine.education.ClassProgress.students:type_name -> qubit_engine.education.StudentProgress
+	55,  // 39: qubit_engine.education.ClassProgress.assignments:type_name -> qubit_engine.education.AssignmentSummary
+	1,   // 40: qubit_engine.education.QuizRequest.topic:type_name -> qubit_engine.education.Topic
+	2,   // 41: qubit_engine.education.QuizRequest.difficulty:type_name -> qubit_engine.education.Difficulty
+	7,   // 42: qubit_engine.education.QuizRequest.types:type_name -> qubit_engine.education.QuestionType
+	59,  // 43: qubit_engine.education.Quiz.questions:type_name -> qubit_engine.education.Question
+	7,   // 44: qubit_engine.education.Question.type:type_name -> qubit_engine.education.QuestionType
+	1,   // 45: qubit_engine.education.Question.topic:type_name -> qubit_engine.education.Topic
+	61,  // 46: qubit_engine.education.QuizSubmission.answers:type_name -> qubit_engine.education.AnswerSubmission
+	81,  // 47: qubit_engine.education.AnswerSubmission.gates:type_name -> qubit_engine.education.GateStep
+	63,  // 48: qubit_engine.education.QuizResult.results:type_name -> qubit_engine.education.AnswerResult
+	100, // 49: qubit_engine.education.QuizResult.unlocked:type_name -> qubit_engine.education.Badge
+	1,   // 50: qubit_engine.education.QuizLeaderboardRequest.topic:type_name -> qubit_engine.education.Topic
+	8,   // 51: qubit_engine.education.QuizLeaderboardRequest.order:type_name -> qubit_engine.education.QuizBoardOrder
+	1,   // 52: qubit_engine.education.QuizLeaderboard.topic:type_name -> qubit_engine.education.Topic
+	8,   // 53: qubit_engine.education.QuizLeaderboard.order:type_name -> qubit_engine.education.QuizBoardOrder
+	65,  // 54: qubit_engine.education.QuizLeaderboard.entries:type_name -> qubit_engine.education.QuizLeaderboardEntry
+	65,  // 55: qubit_engine.education.QuizLeaderboard.you:type_name -> qubit_engine.education.QuizLeaderboardEntry
+	1,   // 56: qubit_engine.education.QuizAttempt.topic:type_name -> qubit_engine.education.Topic
+	70,  // 57: qubit_engine.education.AttemptHistory.attempts:type_name -> qubit_engine.education.QuizAttempt
+	59,  // 58: qubit_engine.education.ReviewCard.question:type_name -> qubit_engine.education.Question
+	73,  // 59: qubit_engine.education.DueReviews.reviews:type_name -> qubit_engine.education.ReviewCard
+	74,  // 60: qubit_engine.education.DueReviews.learners:type_name -> qubit_engine.education.LearnerDue
+	81,  // 61: qubit_engine.education.ReviewSubmission.gates:type_name -> qubit_engine.education.GateStep
+	63,  // 62: qubit_engine.education.ReviewResult.result:type_name -> qubit_engine.education.AnswerResult
+	73,  // 63: qubit_engine.education.ReviewResult.card:type_name -> qubit_engine.education.ReviewCard
+	1,   // 64: qubit_engine.education.CircuitFilter.topic:type_name -> qubit_engine.education.Topic
+	2,   // 65: qubit_engine.education.CircuitFilter.difficulty:type_name -> qubit_engine.education.Difficulty
+	1,   // 66: qubit_engine.education.LibraryCircuit.topic:type_name -> qubit_engine.education.Topic
+	2,   // 67: qubit_engine.education.LibraryCircuit.difficulty:type_name -> qubit_engine.education.Difficulty
+	81,  // 68: qubit_engine.education.LibraryCircuit.gates:type_name -> qubit_engine.education.GateStep
+	83,  // 69: qubit_engine.education.CircuitCatalog.circuits:type_name -> qubit_engine.education.CircuitSummary
+	1,   // 70: qubit_engine.education.CircuitSummary.topic:type_name -> qubit_engine.education.Topic
+	81,  // 71: qubit_engine.education.SandboxRequest.gates:type_name -> qubit_engine.education.GateStep
+	81,  // 72: qubit_engine.education.TraceStep.gate:type_name -> qubit_engine.education.GateStep
+	85,  // 73: qubit_engine.education.TraceStep.state_vector:type_name -> qubit_engine.education.Amplitude
+	85,  // 74: qubit_engine.education.SandboxResult.state_vector:type_name -> qubit_engine.education.Amplitude
+	86,  // 75: qubit_engine.education.SandboxResult.trace:type_name -> qubit_engine.education.TraceStep
+	100, // 76: qubit_engine.education.SandboxResult.unlocked:type_name -> qubit_engine.education.Badge
+	1,   // 77: qubit_engine.education.ChallengeFilter.topic:type_name -> qubit_engine.education.Topic
+	2,   // 78: qubit_engine.education.ChallengeFilter.difficulty:type_name -> qubit_engine.education.Difficulty
+	1,   // 79: qubit_engine.education.Challenge.topic:type_name -> qubit_engine.education.Topic
+	2,   // 80: qubit_engine.education.Challenge.difficulty:type_name -> qubit_engine.education.Difficulty
+	103, // 81: qubit_engine.education.Challenge.target:type_name -> qubit_engine.education.Challenge.TargetEntry
+	90,  // 82: qubit_engine.education.ChallengeCatalog.challenges:type_name -> qubit_engine.education.Challenge
+	81,  // 83: qubit_engine.education.ChallengeSubmission.gates:type_name -> qubit_engine.education.GateStep
+	104, // 84: qubit_engine.education.ChallengeResult.distribution:type_name -> qubit_engine.education.ChallengeResult.DistributionEntry
+	100, // 85: qubit_engine.education.ChallengeResult.unlocked:type_name -> qubit_engine.education.Badge
+	95,  // 86: qubit_engine.education.ChallengeLeaderboard.entries:type_name -> qubit_engine.education.ChallengeLeaderboardEntry
+	100, // 87: qubit_engine.education.EventAck.unlocked:type_name -> qubit_engine.education.Badge
+	100, // 88: qubit_engine.education.AchievementList.badges:type_name -> qubit_engine.education.Badge
+	100, // 89: qubit_engine.education.BadgeCatalog.badges:type_name -> qubit_engine.education.Badge
+	10,  // 90: qubit_engine.education.QuantumEducation.GetLesson:input_type -> qubit_engine.education.LessonRequest
+	11,  // 91: qubit_engine.education.QuantumEducation.ListLessons:input_type -> qubit_engine.education.LessonListRequest
+	15,  // 92: qubit_engine.education.QuantumEducation.PutLesson:input_type -> qubit_engine.education.PutLessonRequest
+	16,  // 93: qubit_engine.education.QuantumEducation.GetLessonHistory:input_type -> qubit_engine.education.LessonHistoryRequest
+	9,   // 94: qubit_engine.education.QuantumEducation.ListLanguages:input_type -> qubit_engine.education.Empty
+	19,  // 95: qubit_engine.education.QuantumEducation.RenderContent:input_type -> qubit_engine.education.RenderRequest
+	24,  // 96: qubit_engine.education.QuantumEducation.ExportCourse:input_type -> qubit_engine.education.ExportCourseRequest
+	26,  // 97: qubit_engine.education.QuantumEducation.ImportCourse:input_type -> qubit_engine.education.ImportCourseRequest
+	9,   // 98: qubit_engine.education.QuantumEducation.ListTracks:input_type -> qubit_engine.education.Empty
+	30,  // 99: qubit_engine.education.QuantumEducation.GetLearningPath:input_type -> qubit_engine.education.LearningPathRequest
+	33,  // 100: qubit_engine.education.QuantumEducation.GetNextRecommended:input_type -> qubit_engine.education.RecommendationRequest
+	35,  // 101: qubit_engine.education.QuantumEducation.CompleteLesson:input_type -> qubit_engine.education.CompleteLessonRequest
+	40,  // 102: qubit_engine.education.QuantumEducation.GetCertificate:input_type -> qubit_engine.education.CertificateRequest
+	42,  // 103: qubit_engine.education.QuantumEducation.VerifyCertificate:input_type -> qubit_engine.education.VerifyCertificateRequest
+	47,  // 104: qubit_engine.education.QuantumEducation.CreateClass:input_type -> qubit_engine.education.CreateClassRequest
+	48,  // 105: qubit_engine.education.QuantumEducation.EnrollStudents:input_type -> qubit_engine.education.EnrollmentRequest
+	49,  // 106: qubit_engine.education.QuantumEducation.JoinClass:input_type -> qubit_engine.education.JoinClassRequest
+	50,  // 107: qubit_engine.education.QuantumEducation.AssignWork:input_type -> qubit_engine.education.AssignmentRequest
+	51,  // 108: qubit_engine.education.QuantumEducation.ListClasses:input_type -> qubit_engine.education.ListClassesRequest
+	53,  // 109: qubit_engine.education.QuantumEducation.ListClassProgress:input_type -> qubit_engine.education.ClassProgressRequest
+	78,  // 110: qubit_engine.education.QuantumEducation.GetCircuit:input_type -> qubit_engine.education.CircuitRequest
+	79,  // 111: qubit_engine.education.QuantumEducation.ListCircuits:input_type -> qubit_engine.education.CircuitFilter
+	57,  // 112: qubit_engine.education.QuantumEducation.GenerateQuiz:input_type -> qubit_engine.education.QuizRequest
+	60,  // 113: qubit_engine.education.QuantumEducation.SubmitAnswers:input_type -> qubit_engine.education.QuizSubmission
+	69,  // 114: qubit_engine.education.QuantumEducation.GetQuizAttempts:input_type -> qubit_engine.education.AttemptsRequest
+	64,  // 115: qubit_engine.education.QuantumEducation.GetQuizLeaderboard:input_type -> qubit_engine.education.QuizLeaderboardRequest
+	67,  // 116: qubit_engine.education.QuantumEducation.GetHint:input_type -> qubit_engine.education.HintRequest
+	72,  // 117: qubit_engine.education.QuantumEducation.GetDueReviews:input_type -> qubit_engine.education.DueReviewsRequest
+	76,  // 118: qubit_engine.education.QuantumEducation.SubmitReview:input_type -> qubit_engine.education.ReviewSubmission
+	84,  // 119: qubit_engine.education.QuantumEducation.RunSandboxCircuit:input_type -> qubit_engine.education.SandboxRequest
+	88,  // 120: qubit_engine.education.QuantumEducation.ListChallenges:input_type -> qubit_engine.education.ChallengeFilter
+	89,  // 121: qubit_engine.education.QuantumEducation.GetChallenge:input_type -> qubit_engine.education.ChallengeRequest
+	92,  // 122: qubit_engine.education.QuantumEducation.SubmitChallenge:input_type -> qubit_engine.education.ChallengeSubmission
+	94,  // 123: qubit_engine.education.QuantumEducation.GetChallengeLeaderboard:input_type -> qubit_engine.education.ChallengeLeaderboardRequest
+	97,  // 124: qubit_engine.education.QuantumEducation.RecordEvent:input_type -> qubit_engine.education.AchievementEvent
+	99,  // 125: qubit_engine.education.QuantumEducation.GetAchievements:input_type -> qubit_engine.education.AchievementsRequest
+	9,   // 126: qubit_engine.education.QuantumEducation.ListBadges:input_type -> qubit_engine.education.Empty
+	12,  // 127: qubit_engine.education.QuantumEducation.GetLesson:output_type -> qubit_engine.education.Lesson
+	13,  // 128: qubit_engine.education.QuantumEducation.ListLessons:output_type -> qubit_engine.education.LessonCatalog
+	12,  // 129: qubit_engine.education.QuantumEducation.PutLesson:output_type -> qubit_engine.education.Lesson
+	18,  // 130: qubit_engine.education.QuantumEducation.GetLessonHistory:output_type -> qubit_engine.education.LessonHistory
+	22,  // 131: qubit_engine.education.QuantumEducation.ListLanguages:output_type -> qubit_engine.education.LanguageCatalog
+	20,  // 132: qubit_engine.education.QuantumEducation.RenderContent:output_type -> qubit_engine.education.RenderedContent
+	25,  // 133: qubit_engine.education.QuantumEducation.ExportCourse:output_type -> qubit_engine.education.CourseBundle
+	27,  // 134: qubit_engine.education.QuantumEducation.ImportCourse:output_type -> qubit_engine.education.ImportCourseResult
+	29,  // 135: qubit_engine.education.QuantumEducation.ListTracks:output_type -> qubit_engine.education.TrackCatalog
+	32,  // 136: qubit_engine.education.QuantumEducation.GetLearningPath:output_type -> qubit_engine.education.LearningPath
+	34,  // 137: qubit_engine.education.QuantumEducation.GetNextRecommended:output_type -> qubit_engine.education.Recommendation
+	36,  // 138: qubit_engine.education.QuantumEducation.CompleteLesson:output_type -> qubit_engine.education.LessonCompletion
+	41,  // 139: qubit_engine.education.QuantumEducation.GetCertificate:output_type -> qubit_engine.education.CertificateDocument
+	43,  // 140: qubit_engine.education.QuantumEducation.VerifyCertificate:output_type -> qubit_engine.education.CertificateVerification
+	46,  // 141: qubit_engine.education.QuantumEducation.CreateClass:output_type -> qubit_engine.education.Class
+	46,  // 142: qubit_engine.education.QuantumEducation.EnrollStudents:output_type -> qubit_engine.education.Class
+	46,  // 143: qubit_engine.education.QuantumEducation.JoinClass:output_type -> qubit_engine.education.Class
+	46,  // 144: qubit_engine.education.QuantumEducation.AssignWork:output_type -> qubit_engine.education.Class
+	52,  // 145: qubit_engine.education.QuantumEducation.ListClasses:output_type -> qubit_engine.education.ClassList
+	56,  // 146: qubit_engine.education.QuantumEducation.ListClassProgress:output_type -> qubit_engine.education.ClassProgress
+	80,  // 147: qubit_engine.education.QuantumEducation.GetCircuit:output_type -> qubit_engine.education.LibraryCircuit
+	82,  // 148: qubit_engine.education.QuantumEducation.ListCircuits:output_type -> qubit_engine.education.CircuitCatalog
+	58,  // 149: qubit_engine.education.QuantumEducation.GenerateQuiz:output_type -> qubit_engine.education.Quiz
+	62,  // 150: qubit_engine.education.QuantumEducation.SubmitAnswers:output_type -> qubit_engine.education.QuizResult
+	71,  // 151: qubit_engine.education.QuantumEducation.GetQuizAttempts:output_type -> qubit_engine.education.AttemptHistory
+	66,  // 152: qubit_engine.education.QuantumEducation.GetQuizLeaderboard:output_type -> qubit_engine.education.QuizLeaderboard
+	68,  // 153: qubit_engine.education.QuantumEducation.GetHint:output_type -> qubit_engine.education.Hint
+	75,  // 154: qubit_engine.education.QuantumEducation.GetDueReviews:output_type -> qubit_engine.education.DueReviews
+	77,  // 155: qubit_engine.education.QuantumEducation.SubmitReview:output_type -> qubit_engine.education.ReviewResult
+	87,  // 156: qubit_engine.education.QuantumEducation.RunSandboxCircuit:output_type -> qubit_engine.education.SandboxResult
+	91,  // 157: qubit_engine.education.QuantumEducation.ListChallenges:output_type -> qubit_engine.education.ChallengeCatalog
+	90,  // 158: qubit_engine.education.QuantumEducation.GetChallenge:output_type -> qubit_engine.education.Challenge
+	93,  // 159: qubit_engine.education.QuantumEducation.SubmitChallenge:output_type -> qubit_engine.education.ChallengeResult
+	96,  // 160: qubit_engine.education.QuantumEducation.GetChallengeLeaderboard:output_type -> qubit_engine.education.ChallengeLeaderboard
+	98,  // 161: qubit_engine.education.QuantumEducation.RecordEvent:output_type -> qubit_engine.education.EventAck
+	101, // 162: qubit_engine.education.QuantumEducation.GetAchievements:output_type -> qubit_engine.education.AchievementList
+	102, // 163: qubit_engine.education.QuantumEducation.ListBadges:output_type -> qubit_engine.education.BadgeCatalog
+	127, // [127:164] is the sub-list for method output_type
+	90,  // [90:127] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
+}
+
+func init() { file_education_proto_init() }
+func file_education_proto_init() {
+	if File_education_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
+			NumEnums:      9,
+			NumMessages:   96,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_education_proto_goTypes,
+		DependencyIndexes: file_education_proto_depIdxs,
+		EnumInfos:         file_education_proto_enumTypes,
+		MessageInfos:      file_education_proto_msgTypes,
+	}.Build()
+	File_education_proto = out.File
+	file_education_proto_goTypes = nil
+	file_education_proto_depIdxs = nil
+}
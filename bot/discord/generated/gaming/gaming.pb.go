@@ -0,0 +1,2350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: gaming.proto
+
+package generated
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameOutcome int32
+
+const (
+	GameOutcome_OUTCOME_UNKNOWN GameOutcome = 0
+	GameOutcome_OUTCOME_WIN     GameOutcome = 1
+	GameOutcome_OUTCOME_LOSE    GameOutcome = 2
+	GameOutcome_OUTCOME_DRAW    GameOutcome = 3
+	GameOutcome_OUTCOME_BONUS   GameOutcome = 4
+	GameOutcome_OUTCOME_JACKPOT GameOutcome = 5
+)
+
+// Enum value maps for GameOutcome.
+var (
+	GameOutcome_name = map[int32]string{
+		0: "OUTCOME_UNKNOWN",
+		1: "OUTCOME_WIN",
+		2: "OUTCOME_LOSE",
+		3: "OUTCOME_DRAW",
+		4: "OUTCOME_BONUS",
+		5: "OUTCOME_JACKPOT",
+	}
+	GameOutcome_value = map[string]int32{
+		"OUTCOME_UNKNOWN": 0,
+		"OUTCOME_WIN":     1,
+		"OUTCOME_LOSE":    2,
+		"OUTCOME_DRAW":    3,
+		"OUTCOME_BONUS":   4,
+		"OUTCOME_JACKPOT": 5,
+	}
+)
+
+func (x GameOutcome) Enum() *GameOutcome {
+	p := new(GameOutcome)
+	*p = x
+	return p
+}
+
+func (x GameOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_gaming_proto_enumTypes[0].Descriptor()
+}
+
+func (GameOutcome) Type() protoreflect.EnumType {
+	return &file_gaming_proto_enumTypes[0]
+}
+
+func (x GameOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameOutcome.Descriptor instead.
+func (GameOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{0}
+}
+
+type OracleMood int32
+
+const (
+	OracleMood_MOOD_MYSTERIOUS    OracleMood = 0 // Cryptic, mystical responses
+	OracleMood_MOOD_SARCASTIC     OracleMood = 1 // Snarky, eye-roll worthy
+	OracleMood_MOOD_PHILOSOPHICAL OracleMood = 2 // Deep, physics-inspired
+	OracleMood_MOOD_CHAOTIC       OracleMood = 3 // Unhinged, chaotic energy
+)
+
+// Enum value maps for OracleMood.
+var (
+	OracleMood_name = map[int32]string{
+		0: "MOOD_MYSTERIOUS",
+		1: "MOOD_SARCASTIC",
+		2: "MOOD_PHILOSOPHICAL",
+		3: "MOOD_CHAOTIC",
+	}
+	OracleMood_value = map[string]int32{
+		"MOOD_MYSTERIOUS":    0,
+		"MOOD_SARCASTIC":     1,
+		"MOOD_PHILOSOPHICAL": 2,
+		"MOOD_CHAOTIC":       3,
+	}
+)
+
+func (x OracleMood) Enum() *OracleMood {
+	p := new(OracleMood)
+	*p = x
+	return p
+}
+
+func (x OracleMood) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OracleMood) Descriptor() protoreflect.EnumDescriptor {
+	return file_gaming_proto_enumTypes[1].Descriptor()
+}
+
+func (OracleMood) Type() protoreflect.EnumType {
+	return &file_gaming_proto_enumTypes[1]
+}
+
+func (x OracleMood) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OracleMood.Descriptor instead.
+func (OracleMood) EnumDescriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{1}
+}
+
+type BoardMetric int32
+
+const (
+	BoardMetric_BOARD_ORACLE    BoardMetric = 0 // Most oracle consultations
+	BoardMetric_BOARD_DICE_LUCK BoardMetric = 1 // Highest luck index, over at least min_luck_dice dice
+)
+
+// Enum value maps for BoardMetric.
+var (
+	BoardMetric_name = map[int32]string{
+		0: "BOARD_ORACLE",
+		1: "BOARD_DICE_LUCK",
+	}
+	BoardMetric_value = map[string]int32{
+		"BOARD_ORACLE":    0,
+		"BOARD_DICE_LUCK": 1,
+	}
+)
+
+func (x BoardMetric) Enum() *BoardMetric {
+	p := new(BoardMetric)
+	*p = x
+	return p
+}
+
+func (x BoardMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoardMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_gaming_proto_enumTypes[2].Descriptor()
+}
+
+func (BoardMetric) Type() protoreflect.EnumType {
+	return &file_gaming_proto_enumTypes[2]
+}
+
+func (x BoardMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoardMetric.Descriptor instead.
+func (BoardMetric) EnumDescriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{2}
+}
+
+type RandomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`                                   // How many random numbers
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`                                      // Minimum value (inclusive)
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`                                      // Maximum value (inclusive)
+	IntegersOnly  bool                   `protobuf:"varint,4,opt,name=integers_only,json=integersOnly,proto3" json:"integers_only,omitempty"` // If true, return integers only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomRequest) Reset() {
+	*x = RandomRequest{}
+	mi := &file_gaming_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomRequest) ProtoMessage() {}
+
+func (x *RandomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomRequest.ProtoReflect.Descriptor instead.
+func (*RandomRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RandomRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RandomRequest) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RandomRequest) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RandomRequest) GetIntegersOnly() bool {
+	if x != nil {
+		return x.IntegersOnly
+	}
+	return false
+}
+
+type RandomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	QuantumSource string                 `protobuf:"bytes,2,opt,name=quantum_source,json=quantumSource,proto3" json:"quantum_source,omitempty"` // Which quantum circuit generated this
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomResponse) Reset() {
+	*x = RandomResponse{}
+	mi := &file_gaming_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomResponse) ProtoMessage() {}
+
+func (x *RandomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomResponse.ProtoReflect.Descriptor instead.
+func (*RandomResponse) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RandomResponse) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *RandomResponse) GetQuantumSource() string {
+	if x != nil {
+		return x.QuantumSource
+	}
+	return ""
+}
+
+func (x *RandomResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RandomBytesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumBytes      int32                  `protobuf:"varint,1,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"` // Number of random bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomBytesRequest) Reset() {
+	*x = RandomBytesRequest{}
+	mi := &file_gaming_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomBytesRequest) ProtoMessage() {}
+
+func (x *RandomBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomBytesRequest.ProtoReflect.Descriptor instead.
+func (*RandomBytesRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RandomBytesRequest) GetNumBytes() int32 {
+	if x != nil {
+		return x.NumBytes
+	}
+	return 0
+}
+
+type RandomBytesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	EntropySource string                 `protobuf:"bytes,2,opt,name=entropy_source,json=entropySource,proto3" json:"entropy_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomBytesResponse) Reset() {
+	*x = RandomBytesResponse{}
+	mi := &file_gaming_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomBytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomBytesResponse) ProtoMessage() {}
+
+func (x *RandomBytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomBytesResponse.ProtoReflect.Descriptor instead.
+func (*RandomBytesResponse) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RandomBytesResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RandomBytesResponse) GetEntropySource() string {
+	if x != nil {
+		return x.EntropySource
+	}
+	return ""
+}
+
+type SuperpositionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StateId           string                 `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"` // Unique identifier for this superposition
+	Outcomes          []*OutcomeProbability  `protobuf:"bytes,2,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	ObservationQubits int32                  `protobuf:"varint,3,opt,name=observation_qubits,json=observationQubits,proto3" json:"observation_qubits,omitempty"` // Number of qubits to use
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SuperpositionRequest) Reset() {
+	*x = SuperpositionRequest{}
+	mi := &file_gaming_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperpositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperpositionRequest) ProtoMessage() {}
+
+func (x *SuperpositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperpositionRequest.ProtoReflect.Descriptor instead.
+func (*SuperpositionRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SuperpositionRequest) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *SuperpositionRequest) GetOutcomes() []*OutcomeProbability {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *SuperpositionRequest) GetObservationQubits() int32 {
+	if x != nil {
+		return x.ObservationQubits
+	}
+	return 0
+}
+
+type OutcomeProbability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       GameOutcome            `protobuf:"varint,1,opt,name=outcome,proto3,enum=qubit_engine.gaming.GameOutcome" json:"outcome,omitempty"`
+	Probability   float64                `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"` // 0.0 to 1.0 (normalized automatically)
+	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`              // Optional numeric value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutcomeProbability) Reset() {
+	*x = OutcomeProbability{}
+	mi := &file_gaming_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutcomeProbability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutcomeProbability) ProtoMessage() {}
+
+func (x *OutcomeProbability) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutcomeProbability.ProtoReflect.Descriptor instead.
+func (*OutcomeProbability) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OutcomeProbability) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_OUTCOME_UNKNOWN
+}
+
+func (x *OutcomeProbability) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *OutcomeProbability) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SuperpositionState struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StateId          string                 `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	PossibleOutcomes []*OutcomeProbability  `protobuf:"bytes,2,rep,name=possible_outcomes,json=possibleOutcomes,proto3" json:"possible_outcomes,omitempty"`
+	IsCollapsed      bool                   `protobuf:"varint,3,opt,name=is_collapsed,json=isCollapsed,proto3" json:"is_collapsed,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt        int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Auto-collapse time
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuperpositionState) Reset() {
+	*x = SuperpositionState{}
+	mi := &file_gaming_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperpositionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperpositionState) ProtoMessage() {}
+
+func (x *SuperpositionState) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperpositionState.ProtoReflect.Descriptor instead.
+func (*SuperpositionState) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuperpositionState) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *SuperpositionState) GetPossibleOutcomes() []*OutcomeProbability {
+	if x != nil {
+		return x.PossibleOutcomes
+	}
+	return nil
+}
+
+func (x *SuperpositionState) GetIsCollapsed() bool {
+	if x != nil {
+		return x.IsCollapsed
+	}
+	return false
+}
+
+func (x *SuperpositionState) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SuperpositionState) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CollapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateId       string                 `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	ObserverId    string                 `protobuf:"bytes,2,opt,name=observer_id,json=observerId,proto3" json:"observer_id,omitempty"` // Who is observing (for audit)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollapsRequest) Reset() {
+	*x = CollapsRequest{}
+	mi := &file_gaming_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollapsRequest) ProtoMessage() {}
+
+func (x *CollapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollapsRequest.ProtoReflect.Descriptor instead.
+func (*CollapsRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CollapsRequest) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *CollapsRequest) GetObserverId() string {
+	if x != nil {
+		return x.ObserverId
+	}
+	return ""
+}
+
+type CollapseResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StateId        string                 `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	Outcome        GameOutcome            `protobuf:"varint,2,opt,name=outcome,proto3,enum=qubit_engine.gaming.GameOutcome" json:"outcome,omitempty"`
+	OutcomeValue   int32                  `protobuf:"varint,3,opt,name=outcome_value,json=outcomeValue,proto3" json:"outcome_value,omitempty"`
+	ProbabilityWas float64                `protobuf:"fixed64,4,opt,name=probability_was,json=probabilityWas,proto3" json:"probability_was,omitempty"` // What was the probability of this outcome
+	CollapsedAt    int64                  `protobuf:"varint,5,opt,name=collapsed_at,json=collapsedAt,proto3" json:"collapsed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CollapseResult) Reset() {
+	*x = CollapseResult{}
+	mi := &file_gaming_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollapseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollapseResult) ProtoMessage() {}
+
+func (x *CollapseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollapseResult.ProtoReflect.Descriptor instead.
+func (*CollapseResult) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CollapseResult) GetStateId() string {
+	if x != nil {
+		return x.StateId
+	}
+	return ""
+}
+
+func (x *CollapseResult) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_OUTCOME_UNKNOWN
+}
+
+func (x *CollapseResult) GetOutcomeValue() int32 {
+	if x != nil {
+		return x.OutcomeValue
+	}
+	return 0
+}
+
+func (x *CollapseResult) GetProbabilityWas() float64 {
+	if x != nil {
+		return x.ProbabilityWas
+	}
+	return 0
+}
+
+func (x *CollapseResult) GetCollapsedAt() int64 {
+	if x != nil {
+		return x.CollapsedAt
+	}
+	return 0
+}
+
+type CoinFlipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumFlips      int32                  `protobuf:"varint,1,opt,name=num_flips,json=numFlips,proto3" json:"num_flips,omitempty"` // Number of coins
+	Bias          float64                `protobuf:"fixed64,2,opt,name=bias,proto3" json:"bias,omitempty"`                        // 0.5 = fair, 0.0-1.0 = probability of heads
+	Entangled     bool                   `protobuf:"varint,3,opt,name=entangled,proto3" json:"entangled,omitempty"`               // Flip Bell pairs: each coin gets an anti-correlated partner (bias ignored)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinFlipRequest) Reset() {
+	*x = CoinFlipRequest{}
+	mi := &file_gaming_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinFlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinFlipRequest) ProtoMessage() {}
+
+func (x *CoinFlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinFlipRequest.ProtoReflect.Descriptor instead.
+func (*CoinFlipRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CoinFlipRequest) GetNumFlips() int32 {
+	if x != nil {
+		return x.NumFlips
+	}
+	return 0
+}
+
+func (x *CoinFlipRequest) GetBias() float64 {
+	if x != nil {
+		return x.Bias
+	}
+	return 0
+}
+
+func (x *CoinFlipRequest) GetEntangled() bool {
+	if x != nil {
+		return x.Entangled
+	}
+	return false
+}
+
+type CoinFlipResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []bool                 `protobuf:"varint,1,rep,packed,name=results,proto3" json:"results,omitempty"` // true = heads, false = tails
+	HeadsCount     int32                  `protobuf:"varint,2,opt,name=heads_count,json=headsCount,proto3" json:"heads_count,omitempty"`
+	TailsCount     int32                  `protobuf:"varint,3,opt,name=tails_count,json=tailsCount,proto3" json:"tails_count,omitempty"`
+	PartnerResults []bool                 `protobuf:"varint,4,rep,packed,name=partner_results,json=partnerResults,proto3" json:"partner_results,omitempty"` // Entangled flips only: each coin's partner, always its opposite
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CoinFlipResult) Reset() {
+	*x = CoinFlipResult{}
+	mi := &file_gaming_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinFlipResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinFlipResult) ProtoMessage() {}
+
+func (x *CoinFlipResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinFlipResult.ProtoReflect.Descriptor instead.
+func (*CoinFlipResult) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CoinFlipResult) GetResults() []bool {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CoinFlipResult) GetHeadsCount() int32 {
+	if x != nil {
+		return x.HeadsCount
+	}
+	return 0
+}
+
+func (x *CoinFlipResult) GetTailsCount() int32 {
+	if x != nil {
+		return x.TailsCount
+	}
+	return 0
+}
+
+func (x *CoinFlipResult) GetPartnerResults() []bool {
+	if x != nil {
+		return x.PartnerResults
+	}
+	return nil
+}
+
+type DiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumDice       int32                  `protobuf:"varint,1,opt,name=num_dice,json=numDice,proto3" json:"num_dice,omitempty"`
+	Sides         int32                  `protobuf:"varint,2,opt,name=sides,proto3" json:"sides,omitempty"`                                // 6 for d6, 20 for d20, etc.
+	KeepHighest   int32                  `protobuf:"varint,3,opt,name=keep_highest,json=keepHighest,proto3" json:"keep_highest,omitempty"` // Keep only the N highest rolls (2d20kh1: advantage)
+	KeepLowest    int32                  `protobuf:"varint,4,opt,name=keep_lowest,json=keepLowest,proto3" json:"keep_lowest,omitempty"`    // Keep only the N lowest rolls (2d20kl1: disadvantage)
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // With group_id, counts toward the roller's dice luck
+	GroupId       string                 `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`              // Guild, server or room rolled in, for group leaderboards
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiceRequest) Reset() {
+	*x = DiceRequest{}
+	mi := &file_gaming_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiceRequest) ProtoMessage() {}
+
+func (x *DiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiceRequest.ProtoReflect.Descriptor instead.
+func (*DiceRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiceRequest) GetNumDice() int32 {
+	if x != nil {
+		return x.NumDice
+	}
+	return 0
+}
+
+func (x *DiceRequest) GetSides() int32 {
+	if x != nil {
+		return x.Sides
+	}
+	return 0
+}
+
+func (x *DiceRequest) GetKeepHighest() int32 {
+	if x != nil {
+		return x.KeepHighest
+	}
+	return 0
+}
+
+func (x *DiceRequest) GetKeepLowest() int32 {
+	if x != nil {
+		return x.KeepLowest
+	}
+	return 0
+}
+
+func (x *DiceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DiceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DiceResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rolls         []int32                `protobuf:"varint,1,rep,packed,name=rolls,proto3" json:"rolls,omitempty"`
+	Sum           int32                  `protobuf:"varint,2,opt,name=sum,proto3" json:"sum,omitempty"` // Sum of all rolls, kept or not
+	MinRoll       int32                  `protobuf:"varint,3,opt,name=min_roll,json=minRoll,proto3" json:"min_roll,omitempty"`
+	MaxRoll       int32                  `protobuf:"varint,4,opt,name=max_roll,json=maxRoll,proto3" json:"max_roll,omitempty"`
+	Kept          []bool                 `protobuf:"varint,5,rep,packed,name=kept,proto3" json:"kept,omitempty"` // Whether each roll counts toward the total
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`      // Sum of the kept rolls
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiceResult) Reset() {
+	*x = DiceResult{}
+	mi := &file_gaming_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiceResult) ProtoMessage() {}
+
+func (x *DiceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiceResult.ProtoReflect.Descriptor instead.
+func (*DiceResult) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DiceResult) GetRolls() []int32 {
+	if x != nil {
+		return x.Rolls
+	}
+	return nil
+}
+
+func (x *DiceResult) GetSum() int32 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *DiceResult) GetMinRoll() int32 {
+	if x != nil {
+		return x.MinRoll
+	}
+	return 0
+}
+
+func (x *DiceResult) GetMaxRoll() int32 {
+	if x != nil {
+		return x.MaxRoll
+	}
+	return 0
+}
+
+func (x *DiceResult) GetKept() []bool {
+	if x != nil {
+		return x.Kept
+	}
+	return nil
+}
+
+func (x *DiceResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ShuffleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckSize      int32                  `protobuf:"varint,1,opt,name=deck_size,json=deckSize,proto3" json:"deck_size,omitempty"` // 52 for standard deck
+	DeckType      string                 `protobuf:"bytes,2,opt,name=deck_type,json=deckType,proto3" json:"deck_type,omitempty"`  // "standard", "tarot", "custom"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleRequest) Reset() {
+	*x = ShuffleRequest{}
+	mi := &file_gaming_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleRequest) ProtoMessage() {}
+
+func (x *ShuffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleRequest.ProtoReflect.Descriptor instead.
+func (*ShuffleRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ShuffleRequest) GetDeckSize() int32 {
+	if x != nil {
+		return x.DeckSize
+	}
+	return 0
+}
+
+func (x *ShuffleRequest) GetDeckType() string {
+	if x != nil {
+		return x.DeckType
+	}
+	return ""
+}
+
+type ShuffledDeck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardOrder     []int32                `protobuf:"varint,1,rep,packed,name=card_order,json=cardOrder,proto3" json:"card_order,omitempty"`  // Indices in shuffled order
+	ShuffleProof  string                 `protobuf:"bytes,2,opt,name=shuffle_proof,json=shuffleProof,proto3" json:"shuffle_proof,omitempty"` // Hash for verification
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffledDeck) Reset() {
+	*x = ShuffledDeck{}
+	mi := &file_gaming_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffledDeck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffledDeck) ProtoMessage() {}
+
+func (x *ShuffledDeck) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffledDeck.ProtoReflect.Descriptor instead.
+func (*ShuffledDeck) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ShuffledDeck) GetCardOrder() []int32 {
+	if x != nil {
+		return x.CardOrder
+	}
+	return nil
+}
+
+func (x *ShuffledDeck) GetShuffleProof() string {
+	if x != nil {
+		return x.ShuffleProof
+	}
+	return ""
+}
+
+type OracleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`                              // The question being asked
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                    // For rate limiting / caching
+	Mood          OracleMood             `protobuf:"varint,3,opt,name=mood,proto3,enum=qubit_engine.gaming.OracleMood" json:"mood,omitempty"` // Affects response style
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // Optional session tracking
+	GroupId       string                 `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                 // Guild, server or room asked in, for group leaderboards
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OracleRequest) Reset() {
+	*x = OracleRequest{}
+	mi := &file_gaming_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OracleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleRequest) ProtoMessage() {}
+
+func (x *OracleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleRequest.ProtoReflect.Descriptor instead.
+func (*OracleRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OracleRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *OracleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OracleRequest) GetMood() OracleMood {
+	if x != nil {
+		return x.Mood
+	}
+	return OracleMood_MOOD_MYSTERIOUS
+}
+
+func (x *OracleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *OracleRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type OracleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prophecy      string                 `protobuf:"bytes,1,opt,name=prophecy,proto3" json:"prophecy,omitempty"`                              // The 8-ball response text
+	OutcomeIndex  int32                  `protobuf:"varint,2,opt,name=outcome_index,json=outcomeIndex,proto3" json:"outcome_index,omitempty"` // 0-7 quantum outcome
+	Confidence    float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`                        // How sure the Oracle is (0.0-1.0)
+	QuantumState  string                 `protobuf:"bytes,4,opt,name=quantum_state,json=quantumState,proto3" json:"quantum_state,omitempty"`  // Bloch sphere coordinates
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FromCache     bool                   `protobuf:"varint,6,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"`    // True if cached response
+	CircuitId     string                 `protobuf:"bytes,7,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`     // ID of the quantum circuit used
+	QubitsUsed    int32                  `protobuf:"varint,8,opt,name=qubits_used,json=qubitsUsed,proto3" json:"qubits_used,omitempty"` // Number of qubits (always 3 for 8-ball)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OracleResponse) Reset() {
+	*x = OracleResponse{}
+	mi := &file_gaming_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OracleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleResponse) ProtoMessage() {}
+
+func (x *OracleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleResponse.ProtoReflect.Descriptor instead.
+func (*OracleResponse) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OracleResponse) GetProphecy() string {
+	if x != nil {
+		return x.Prophecy
+	}
+	return ""
+}
+
+func (x *OracleResponse) GetOutcomeIndex() int32 {
+	if x != nil {
+		return x.OutcomeIndex
+	}
+	return 0
+}
+
+func (x *OracleResponse) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *OracleResponse) GetQuantumState() string {
+	if x != nil {
+		return x.QuantumState
+	}
+	return ""
+}
+
+func (x *OracleResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *OracleResponse) GetFromCache() bool {
+	if x != nil {
+		return x.FromCache
+	}
+	return false
+}
+
+func (x *OracleResponse) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+func (x *OracleResponse) GetQubitsUsed() int32 {
+	if x != nil {
+		return x.QubitsUsed
+	}
+	return 0
+}
+
+type OracleHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Required
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                // Default 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OracleHistoryRequest) Reset() {
+	*x = OracleHistoryRequest{}
+	mi := &file_gaming_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OracleHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleHistoryRequest) ProtoMessage() {}
+
+func (x *OracleHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleHistoryRequest.ProtoReflect.Descriptor instead.
+func (*OracleHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OracleHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OracleHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type OracleConsultation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Mood          OracleMood             `protobuf:"varint,2,opt,name=mood,proto3,enum=qubit_engine.gaming.OracleMood" json:"mood,omitempty"`
+	Response      *OracleResponse        `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	GroupId       string                 `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // Where it was asked, if anywhere
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OracleConsultation) Reset() {
+	*x = OracleConsultation{}
+	mi := &file_gaming_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OracleConsultation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleConsultation) ProtoMessage() {}
+
+func (x *OracleConsultation) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleConsultation.ProtoReflect.Descriptor instead.
+func (*OracleConsultation) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OracleConsultation) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *OracleConsultation) GetMood() OracleMood {
+	if x != nil {
+		return x.Mood
+	}
+	return OracleMood_MOOD_MYSTERIOUS
+}
+
+func (x *OracleConsultation) GetResponse() *OracleResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *OracleConsultation) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type OracleHistory struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Consultations     []*OracleConsultation  `protobuf:"bytes,1,rep,name=consultations,proto3" json:"consultations,omitempty"`
+	Total             int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                                   // Consultations remembered
+	AverageConfidence float64                `protobuf:"fixed64,3,opt,name=average_confidence,json=averageConfidence,proto3" json:"average_confidence,omitempty"` // Over all remembered consultations
+	Trend             float64                `protobuf:"fixed64,4,opt,name=trend,proto3" json:"trend,omitempty"`                                                  // -1 to 1; positive when fate is improving
+	Favorable         int32                  `protobuf:"varint,5,opt,name=favorable,proto3" json:"favorable,omitempty"`
+	Uncertain         int32                  `protobuf:"varint,6,opt,name=uncertain,proto3" json:"uncertain,omitempty"`
+	Unfavorable       int32                  `protobuf:"varint,7,opt,name=unfavorable,proto3" json:"unfavorable,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OracleHistory) Reset() {
+	*x = OracleHistory{}
+	mi := &file_gaming_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OracleHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleHistory) ProtoMessage() {}
+
+func (x *OracleHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleHistory.ProtoReflect.Descriptor instead.
+func (*OracleHistory) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OracleHistory) GetConsultations() []*OracleConsultation {
+	if x != nil {
+		return x.Consultations
+	}
+	return nil
+}
+
+func (x *OracleHistory) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OracleHistory) GetAverageConfidence() float64 {
+	if x != nil {
+		return x.AverageConfidence
+	}
+	return 0
+}
+
+func (x *OracleHistory) GetTrend() float64 {
+	if x != nil {
+		return x.Trend
+	}
+	return 0
+}
+
+func (x *OracleHistory) GetFavorable() int32 {
+	if x != nil {
+		return x.Favorable
+	}
+	return 0
+}
+
+func (x *OracleHistory) GetUncertain() int32 {
+	if x != nil {
+		return x.Uncertain
+	}
+	return 0
+}
+
+func (x *OracleHistory) GetUnfavorable() int32 {
+	if x != nil {
+		return x.Unfavorable
+	}
+	return 0
+}
+
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"` // Required
+	Metric        BoardMetric            `protobuf:"varint,2,opt,name=metric,proto3,enum=qubit_engine.gaming.BoardMetric" json:"metric,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                   // Default 10
+	PreviousWeek  bool                   `protobuf:"varint,4,opt,name=previous_week,json=previousWeek,proto3" json:"previous_week,omitempty"` // Last week's final standings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_gaming_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LeaderboardRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetMetric() BoardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return BoardMetric_BOARD_ORACLE
+}
+
+func (x *LeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetPreviousWeek() bool {
+	if x != nil {
+		return x.PreviousWeek
+	}
+	return false
+}
+
+type LeaderboardEntry struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Rank                int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OracleConsultations int32                  `protobuf:"varint,3,opt,name=oracle_consultations,json=oracleConsultations,proto3" json:"oracle_consultations,omitempty"`
+	DiceRolled          int32                  `protobuf:"varint,4,opt,name=dice_rolled,json=diceRolled,proto3" json:"dice_rolled,omitempty"`
+	LuckIndex           float64                `protobuf:"fixed64,5,opt,name=luck_index,json=luckIndex,proto3" json:"luck_index,omitempty"` // 0-100; 0 without dice
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_gaming_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetOracleConsultations() int32 {
+	if x != nil {
+		return x.OracleConsultations
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDiceRolled() int32 {
+	if x != nil {
+		return x.DiceRolled
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetLuckIndex() float64 {
+	if x != nil {
+		return x.LuckIndex
+	}
+	return 0
+}
+
+type Leaderboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Week          string                 `protobuf:"bytes,1,opt,name=week,proto3" json:"week,omitempty"` // ISO week, e.g. "2026-W42"
+	StartsAt      int64                  `protobuf:"varint,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ResetsAt      int64                  `protobuf:"varint,3,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	Metric        BoardMetric            `protobuf:"varint,4,opt,name=metric,proto3,enum=qubit_engine.gaming.BoardMetric" json:"metric,omitempty"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	Players       int32                  `protobuf:"varint,6,opt,name=players,proto3" json:"players,omitempty"`                              // Players on the full board
+	MinLuckDice   int32                  `protobuf:"varint,7,opt,name=min_luck_dice,json=minLuckDice,proto3" json:"min_luck_dice,omitempty"` // Dice a player must roll to rank by luck
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Leaderboard) Reset() {
+	*x = Leaderboard{}
+	mi := &file_gaming_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Leaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard) ProtoMessage() {}
+
+func (x *Leaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard.ProtoReflect.Descriptor instead.
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Leaderboard) GetWeek() string {
+	if x != nil {
+		return x.Week
+	}
+	return ""
+}
+
+func (x *Leaderboard) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *Leaderboard) GetResetsAt() int64 {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return 0
+}
+
+func (x *Leaderboard) GetMetric() BoardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return BoardMetric_BOARD_ORACLE
+}
+
+func (x *Leaderboard) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Leaderboard) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *Leaderboard) GetMinLuckDice() int32 {
+	if x != nil {
+		return x.MinLuckDice
+	}
+	return 0
+}
+
+type FlipChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenger    string                 `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Opponent      string                 `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,3,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"` // Default 64, max 1024
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlipChallengeRequest) Reset() {
+	*x = FlipChallengeRequest{}
+	mi := &file_gaming_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlipChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlipChallengeRequest) ProtoMessage() {}
+
+func (x *FlipChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlipChallengeRequest.ProtoReflect.Descriptor instead.
+func (*FlipChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FlipChallengeRequest) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *FlipChallengeRequest) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *FlipChallengeRequest) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+type FlipChallenge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlipId        string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	Challenger    string                 `protobuf:"bytes,2,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	Opponent      string                 `protobuf:"bytes,3,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,4,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlipChallenge) Reset() {
+	*x = FlipChallenge{}
+	mi := &file_gaming_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlipChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlipChallenge) ProtoMessage() {}
+
+func (x *FlipChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlipChallenge.ProtoReflect.Descriptor instead.
+func (*FlipChallenge) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FlipChallenge) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *FlipChallenge) GetChallenger() string {
+	if x != nil {
+		return x.Challenger
+	}
+	return ""
+}
+
+func (x *FlipChallenge) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *FlipChallenge) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *FlipChallenge) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type FlipCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlipId        string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	Opponent      string                 `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"` // Must be the challenged player
+	Heads         bool                   `protobuf:"varint,3,opt,name=heads,proto3" json:"heads,omitempty"`      // The opponent's call
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlipCallRequest) Reset() {
+	*x = FlipCallRequest{}
+	mi := &file_gaming_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlipCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlipCallRequest) ProtoMessage() {}
+
+func (x *FlipCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlipCallRequest.ProtoReflect.Descriptor instead.
+func (*FlipCallRequest) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FlipCallRequest) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *FlipCallRequest) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *FlipCallRequest) GetHeads() bool {
+	if x != nil {
+		return x.Heads
+	}
+	return false
+}
+
+type FlipDuelResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlipId        string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	Heads         bool                   `protobuf:"varint,2,opt,name=heads,proto3" json:"heads,omitempty"` // How the coin landed
+	CalledHeads   bool                   `protobuf:"varint,3,opt,name=called_heads,json=calledHeads,proto3" json:"called_heads,omitempty"`
+	Winner        string                 `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"`
+	Verified      bool                   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"` // The challenger's reveal matched the opponent's qubits
+	CheckedQubits int32                  `protobuf:"varint,6,opt,name=checked_qubits,json=checkedQubits,proto3" json:"checked_qubits,omitempty"`
+	Mismatches    int32                  `protobuf:"varint,7,opt,name=mismatches,proto3" json:"mismatches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlipDuelResult) Reset() {
+	*x = FlipDuelResult{}
+	mi := &file_gaming_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlipDuelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlipDuelResult) ProtoMessage() {}
+
+func (x *FlipDuelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gaming_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlipDuelResult.ProtoReflect.Descriptor instead.
+func (*FlipDuelResult) Descriptor() ([]byte, []int) {
+	return file_gaming_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FlipDuelResult) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *FlipDuelResult) GetHeads() bool {
+	if x != nil {
+		return x.Heads
+	}
+	return false
+}
+
+func (x *FlipDuelResult) GetCalledHeads() bool {
+	if x != nil {
+		return x.CalledHeads
+	}
+	return false
+}
+
+func (x *FlipDuelResult) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *FlipDuelResult) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *FlipDuelResult) GetCheckedQubits() int32 {
+	if x != nil {
+		return x.CheckedQubits
+	}
+	return 0
+}
+
+func (x *FlipDuelResult) GetMismatches() int32 {
+	if x != nil {
+		return x.Mismatches
+	}
+	return 0
+}
+
+var File_gaming_proto protoreflect.FileDescriptor
+
+const file_gaming_proto_rawDesc = "" +
+	"\n" +
+	"\fgaming.proto\x12\x13qubit_engine.gaming\"n\n" +
+	"\rRandomRequest\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x12#\n" +
+	"\rintegers_only\x18\x04 \x01(\bR\fintegersOnly\"m\n" +
+	"\x0eRandomResponse\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x01R\x06values\x12%\n" +
+	"\x0equantum_source\x18\x02 \x01(\tR\rquantumSource\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"1\n" +
+	"\x12RandomBytesRequest\x12\x1b\n" +
+	"\tnum_bytes\x18\x01 \x01(\x05R\bnumBytes\"P\n" +
+	"\x13RandomBytesResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12%\n" +
+	"\x0eentropy_source\x18\x02 \x01(\tR\rentropySource\"\xa5\x01\n" +
+	"\x14SuperpositionRequest\x12\x19\n" +
+	"\bstate_id\x18\x01 \x01(\tR\astateId\x12C\n" +
+	"\boutcomes\x18\x02 \x03(\v2'.qubit_engine.gaming.OutcomeProbabilityR\boutcomes\x12-\n" +
+	"\x12observation_qubits\x18\x03 \x01(\x05R\x11observationQubits\"\x88\x01\n" +
+	"\x12OutcomeProbability\x12:\n" +
+	"\aoutcome\x18\x01 \x01(\x0e2 .qubit_engine.gaming.GameOutcomeR\aoutcome\x12 \n" +
+	"\vprobability\x18\x02 \x01(\x01R\vprobability\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x05R\x05value\"\xe6\x01\n" +
+	"\x12SuperpositionState\x12\x19\n" +
+	"\bstate_id\x18\x01 \x01(\tR\astateId\x12T\n" +
+	"\x11possible_outcomes\x18\x02 \x03(\v2'.qubit_engine.gaming.OutcomeProbabilityR\x10possibleOutcomes\x12!\n" +
+	"\fis_collapsed\x18\x03 \x01(\bR\visCollapsed\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"L\n" +
+	"\x0eCollapsRequest\x12\x19\n" +
+	"\bstate_id\x18\x01 \x01(\tR\astateId\x12\x1f\n" +
+	"\vobserver_id\x18\x02 \x01(\tR\n" +
+	"observerId\"\xd8\x01\n" +
+	"\x0eCollapseResult\x12\x19\n" +
+	"\bstate_id\x18\x01 \x01(\tR\astateId\x12:\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2 .qubit_engine.gaming.GameOutcomeR\aoutcome\x12#\n" +
+	"\routcome_value\x18\x03 \x01(\x05R\foutcomeValue\x12'\n" +
+	"\x0fprobability_was\x18\x04 \x01(\x01R\x0eprobabilityWas\x12!\n" +
+	"\fcollapsed_at\x18\x05 \x01(\x03R\vcollapsedAt\"`\n" +
+	"\x0fCoinFlipRequest\x12\x1b\n" +
+	"\tnum_flips\x18\x01 \x01(\x05R\bnumFlips\x12\x12\n" +
+	"\x04bias\x18\x02 \x01(\x01R\x04bias\x12\x1c\n" +
+	"\tentangled\x18\x03 \x01(\bR\tentangled\"\x95\x01\n" +
+	"\x0eCoinFlipResult\x12\x18\n" +
+	"\aresults\x18\x01 \x03(\bR\aresults\x12\x1f\n" +
+	"\vheads_count\x18\x02 \x01(\x05R\n" +
+	"headsCount\x12\x1f\n" +
+	"\vtails_count\x18\x03 \x01(\x05R\n" +
+	"tailsCount\x12'\n" +
+	"\x0fpartner_results\x18\x04 \x03(\bR\x0epartnerResults\"\xb6\x01\n" +
+	"\vDiceRequest\x12\x19\n" +
+	"\bnum_dice\x18\x01 \x01(\x05R\anumDice\x12\x14\n" +
+	"\x05sides\x18\x02 \x01(\x05R\x05sides\x12!\n" +
+	"\fkeep_highest\x18\x03 \x01(\x05R\vkeepHighest\x12\x1f\n" +
+	"\vkeep_lowest\x18\x04 \x01(\x05R\n" +
+	"keepLowest\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x19\n" +
+	"\bgroup_id\x18\x06 \x01(\tR\agroupId\"\x94\x01\n" +
+	"\n" +
+	"DiceResult\x12\x14\n" +
+	"\x05rolls\x18\x01 \x03(\x05R\x05rolls\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x05R\x03sum\x12\x19\n" +
+	"\bmin_roll\x18\x03 \x01(\x05R\aminRoll\x12\x19\n" +
+	"\bmax_roll\x18\x04 \x01(\x05R\amaxRoll\x12\x12\n" +
+	"\x04kept\x18\x05 \x03(\bR\x04kept\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\"J\n" +
+	"\x0eShuffleRequest\x12\x1b\n" +
+	"\tdeck_size\x18\x01 \x01(\x05R\bdeckSize\x12\x1b\n" +
+	"\tdeck_type\x18\x02 \x01(\tR\bdeckType\"R\n" +
+	"\fShuffledDeck\x12\x1d\n" +
+	"\n" +
+	"card_order\x18\x01 \x03(\x05R\tcardOrder\x12#\n" +
+	"\rshuffle_proof\x18\x02 \x01(\tR\fshuffleProof\"\xb3\x01\n" +
+	"\rOracleRequest\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x123\n" +
+	"\x04mood\x18\x03 \x01(\x0e2\x1f.qubit_engine.gaming.OracleMoodR\x04mood\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\tR\agroupId\"\x93\x02\n" +
+	"\x0eOracleResponse\x12\x1a\n" +
+	"\bprophecy\x18\x01 \x01(\tR\bprophecy\x12#\n" +
+	"\routcome_index\x18\x02 \x01(\x05R\foutcomeIndex\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\x12#\n" +
+	"\rquantum_state\x18\x04 \x01(\tR\fquantumState\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"from_cache\x18\x06 \x01(\bR\tfromCache\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\a \x01(\tR\tcircuitId\x12\x1f\n" +
+	"\vqubits_used\x18\b \x01(\x05R\n" +
+	"qubitsUsed\"E\n" +
+	"\x14OracleHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xc1\x01\n" +
+	"\x12OracleConsultation\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x123\n" +
+	"\x04mood\x18\x02 \x01(\x0e2\x1f.qubit_engine.gaming.OracleMoodR\x04mood\x12?\n" +
+	"\bresponse\x18\x03 \x01(\v2#.qubit_engine.gaming.OracleResponseR\bresponse\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\"\x97\x02\n" +
+	"\rOracleHistory\x12M\n" +
+	"\rconsultations\x18\x01 \x03(\v2'.qubit_engine.gaming.OracleConsultationR\rconsultations\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12-\n" +
+	"\x12average_confidence\x18\x03 \x01(\x01R\x11averageConfidence\x12\x14\n" +
+	"\x05trend\x18\x04 \x01(\x01R\x05trend\x12\x1c\n" +
+	"\tfavorable\x18\x05 \x01(\x05R\tfavorable\x12\x1c\n" +
+	"\tuncertain\x18\x06 \x01(\x05R\tuncertain\x12 \n" +
+	"\vunfavorable\x18\a \x01(\x05R\vunfavorable\"\xa4\x01\n" +
+	"\x12LeaderboardRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x128\n" +
+	"\x06metric\x18\x02 \x01(\x0e2 .qubit_engine.gaming.BoardMetricR\x06metric\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12#\n" +
+	"\rprevious_week\x18\x04 \x01(\bR\fpreviousWeek\"\xb2\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x121\n" +
+	"\x14oracle_consultations\x18\x03 \x01(\x05R\x13oracleConsultations\x12\x1f\n" +
+	"\vdice_rolled\x18\x04 \x01(\x05R\n" +
+	"diceRolled\x12\x1d\n" +
+	"\n" +
+	"luck_index\x18\x05 \x01(\x01R\tluckIndex\"\x94\x02\n" +
+	"\vLeaderboard\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\tR\x04week\x12\x1b\n" +
+	"\tstarts_at\x18\x02 \x01(\x03R\bstartsAt\x12\x1b\n" +
+	"\tresets_at\x18\x03 \x01(\x03R\bresetsAt\x128\n" +
+	"\x06metric\x18\x04 \x01(\x0e2 .qubit_engine.gaming.BoardMetricR\x06metric\x12?\n" +
+	"\aentries\x18\x05 \x03(\v2%.qubit_engine.gaming.LeaderboardEntryR\aentries\x12\x18\n" +
+	"\aplayers\x18\x06 \x01(\x05R\aplayers\x12\"\n" +
+	"\rmin_luck_dice\x18\a \x01(\x05R\vminLuckDice\"q\n" +
+	"\x14FlipChallengeRequest\x12\x1e\n" +
+	"\n" +
+	"challenger\x18\x01 \x01(\tR\n" +
+	"challenger\x12\x1a\n" +
+	"\bopponent\x18\x02 \x01(\tR\bopponent\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x03 \x01(\x05R\tnumQubits\"\xa2\x01\n" +
+	"\rFlipChallenge\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12\x1e\n" +
+	"\n" +
+	"challenger\x18\x02 \x01(\tR\n" +
+	"challenger\x12\x1a\n" +
+	"\bopponent\x18\x03 \x01(\tR\bopponent\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x04 \x01(\x05R\tnumQubits\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"\\\n" +
+	"\x0fFlipCallRequest\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12\x1a\n" +
+	"\bopponent\x18\x02 \x01(\tR\bopponent\x12\x14\n" +
+	"\x05heads\x18\x03 \x01(\bR\x05heads\"\xdd\x01\n" +
+	"\x0eFlipDuelResult\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12\x14\n" +
+	"\x05heads\x18\x02 \x01(\bR\x05heads\x12!\n" +
+	"\fcalled_heads\x18\x03 \x01(\bR\vcalledHeads\x12\x16\n" +
+	"\x06winner\x18\x04 \x01(\tR\x06winner\x12\x1a\n" +
+	"\bverified\x18\x05 \x01(\bR\bverified\x12%\n" +
+	"\x0echecked_qubits\x18\x06 \x01(\x05R\rcheckedQubits\x12\x1e\n" +
+	"\n" +
+	"mismatches\x18\a \x01(\x05R\n" +
+	"mismatches*\x7f\n" +
+	"\vGameOutcome\x12\x13\n" +
+	"\x0fOUTCOME_UNKNOWN\x10\x00\x12\x0f\n" +
+	"\vOUTCOME_WIN\x10\x01\x12\x10\n" +
+	"\fOUTCOME_LOSE\x10\x02\x12\x10\n" +
+	"\fOUTCOME_DRAW\x10\x03\x12\x11\n" +
+	"\rOUTCOME_BONUS\x10\x04\x12\x13\n" +
+	"\x0fOUTCOME_JACKPOT\x10\x05*_\n" +
+	"\n" +
+	"OracleMood\x12\x13\n" +
+	"\x0fMOOD_MYSTERIOUS\x10\x00\x12\x12\n" +
+	"\x0eMOOD_SARCASTIC\x10\x01\x12\x16\n" +
+	"\x12MOOD_PHILOSOPHICAL\x10\x02\x12\x10\n" +
+	"\fMOOD_CHAOTIC\x10\x03*4\n" +
+	"\vBoardMetric\x12\x10\n" +
+	"\fBOARD_ORACLE\x10\x00\x12\x13\n" +
+	"\x0fBOARD_DICE_LUCK\x10\x012\xf2\b\n" +
+	"\rQuantumGaming\x12Y\n" +
+	"\x0eGenerateRandom\x12\".qubit_engine.gaming.RandomRequest\x1a#.qubit_engine.gaming.RandomResponse\x12h\n" +
+	"\x13GenerateRandomBytes\x12'.qubit_engine.gaming.RandomBytesRequest\x1a(.qubit_engine.gaming.RandomBytesResponse\x12i\n" +
+	"\x13CreateSuperposition\x12).qubit_engine.gaming.SuperpositionRequest\x1a'.qubit_engine.gaming.SuperpositionState\x12Y\n" +
+	"\rCollapseState\x12#.qubit_engine.gaming.CollapsRequest\x1a#.qubit_engine.gaming.CollapseResult\x12\\\n" +
+	"\x0fQuantumCoinFlip\x12$.qubit_engine.gaming.CoinFlipRequest\x1a#.qubit_engine.gaming.CoinFlipResult\x12T\n" +
+	"\x0fQuantumDiceRoll\x12 .qubit_engine.gaming.DiceRequest\x1a\x1f.qubit_engine.gaming.DiceResult\x12U\n" +
+	"\vShuffleDeck\x12#.qubit_engine.gaming.ShuffleRequest\x1a!.qubit_engine.gaming.ShuffledDeck\x12T\n" +
+	"\tAskOracle\x12\".qubit_engine.gaming.OracleRequest\x1a#.qubit_engine.gaming.OracleResponse\x12a\n" +
+	"\x10GetOracleHistory\x12).qubit_engine.gaming.OracleHistoryRequest\x1a\".qubit_engine.gaming.OracleHistory\x12[\n" +
+	"\x0eGetLeaderboard\x12'.qubit_engine.gaming.LeaderboardRequest\x1a .qubit_engine.gaming.Leaderboard\x12^\n" +
+	"\rChallengeFlip\x12).qubit_engine.gaming.FlipChallengeRequest\x1a\".qubit_engine.gaming.FlipChallenge\x12U\n" +
+	"\bCallFlip\x12$.qubit_engine.gaming.FlipCallRequest\x1a#.qubit_engine.gaming.FlipDuelResultB9Z7github.com/perclft/QubitEngine/modules/gaming/generatedb\x06proto3"
+
+var (
+	file_gaming_proto_rawDescOnce sync.Once
+	file_gaming_proto_rawDescData []byte
+)
+
+func file_gaming_proto_rawDescGZIP() []byte {
+	file_gaming_proto_rawDescOnce.Do(func() {
+		file_gaming_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gaming_proto_rawDesc), len(file_gaming_proto_rawDesc)))
+	})
+	return file_gaming_proto_rawDescData
+}
+
+var file_gaming_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_gaming_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_gaming_proto_goTypes = []any{
+	(GameOutcome)(0),             // 0: qubit_engine.gaming.GameOutcome
+	(OracleMood)(0),              // 1: qubit_engine.gaming.OracleMood
+	(BoardMetric)(0),             // 2: qubit_engine.gaming.BoardMetric
+	(*RandomRequest)(nil),        // 3: qubit_engine.gaming.RandomRequest
+	(*RandomResponse)(nil),       // 4: qubit_engine.gaming.RandomResponse
+	(*RandomBytesRequest)(nil),   // 5: qubit_engine.gaming.RandomBytesRequest
+	(*RandomBytesResponse)(nil),  // 6: qubit_engine.gaming.RandomBytesResponse
+	(*SuperpositionRequest)(nil), // 7: qubit_engine.gaming.SuperpositionRequest
+	(*OutcomeProbability)(nil),   // 8: qubit_engine.gaming.OutcomeProbability
+	(*SuperpositionState)(nil),   // 9: qubit_engine.gaming.SuperpositionState
+	(*CollapsRequest)(nil),       // 10: qubit_engine.gaming.CollapsRequest
+	(*CollapseResult)(nil),       // 11: qubit_engine.gaming.CollapseResult
+	(*CoinFlipRequest)(nil),      // 12: qubit_engine.gaming.CoinFlipRequest
+	(*CoinFlipResult)(nil),       // 13: qubit_engine.gaming.CoinFlipResult
+	(*DiceRequest)(nil),          // 14: qubit_engine.gaming.DiceRequest
+	(*DiceResult)(nil),           // 15: qubit_engine.gaming.DiceResult
+	(*ShuffleRequest)(nil),       // 16: qubit_engine.gaming.ShuffleRequest
+	(*ShuffledDeck)(nil),         // 17: qubit_engine.gaming.ShuffledDeck
+	(*OracleRequest)(nil),        // 18: qubit_engine.gaming.OracleRequest
+	(*OracleResponse)(nil),       // 19: qubit_engine.gaming.OracleResponse
+	(*OracleHistoryRequest)(nil), // 20: qubit_engine.gaming.OracleHistoryRequest
+	(*OracleConsultation)(nil),   // 21: qubit_engine.gaming.OracleConsultation
+	(*OracleHistory)(nil),        // 22: qubit_engine.gaming.OracleHistory
+	(*LeaderboardRequest)(nil),   // 23: qubit_engine.gaming.LeaderboardRequest
+	(*LeaderboardEntry)(nil),     // 24: qubit_engine.gaming.LeaderboardEntry
+	(*Leaderboard)(nil),          // 25: qubit_engine.gaming.Leaderboard
+	(*FlipChallengeRequest)(nil), // 26: qubit_engine.gaming.FlipChallengeRequest
+	(*FlipChallenge)(nil),        // 27: qubit_engine.gaming.FlipChallenge
+	(*FlipCallRequest)(nil),      // 28: qubit_engine.gaming.FlipCallRequest
+	(*FlipDuelResult)(nil),       // 29: qubit_engine.gaming.FlipDuelResult
+}
+var file_gaming_proto_depIdxs = []int32{
+	8,  // 0: qubit_engine.gaming.SuperpositionRequest.outcomes:type_name -> qubit_engine.gaming.OutcomeProbability
+	0,  // 1: qubit_engine.gaming.OutcomeProbability.outcome:type_name -> qubit_engine.gaming.GameOutcome
+	8,  // 2: qubit_engine.gaming.SuperpositionState.possible_outcomes:type_name -> qubit_engine.gaming.OutcomeProbability
+	0,  // 3: qubit_engine.gaming.CollapseResult.outcome:type_name -> qubit_engine.gaming.GameOutcome
+	1,  // 4: qubit_engine.gaming.OracleRequest.mood:type_name -> qubit_engine.gaming.OracleMood
+	1,  // 5: qubit_engine.gaming.OracleConsultation.mood:type_name -> qubit_engine.gaming.OracleMood
+	19, // 6: qubit_engine.gaming.OracleConsultation.response:type_name -> qubit_engine.gaming.OracleResponse
+	21, // 7: qubit_engine.gaming.OracleHistory.consultations:type_name -> qubit_engine.gaming.OracleConsultation
+	2,  // 8: qubit_engine.gaming.LeaderboardRequest.metric:type_name -> qubit_engine.gaming.BoardMetric
+	2,  // 9: qubit_engine.gaming.Leaderboard.metric:type_name -> qubit_engine.gaming.BoardMetric
+	24, // 10: qubit_engine.gaming.Leaderboard.entries:type_name -> qubit_engine.gaming.LeaderboardEntry
+	3,  // 11: qubit_engine.gaming.QuantumGaming.GenerateRandom:input_type -> qubit_engine.gaming.RandomRequest
+	5,  // 12: qubit_engine.gaming.QuantumGaming.GenerateRandomBytes:input_type -> qubit_engine.gaming.RandomBytesRequest
+	7,  // 13: qubit_engine.gaming.QuantumGaming.CreateSuperposition:input_type -> qubit_engine.gaming.SuperpositionRequest
+	10, // 14: qubit_engine.gaming.QuantumGaming.CollapseState:input_type -> qubit_engine.gaming.CollapsRequest
+	12, // 15: qubit_engine.gaming.QuantumGaming.QuantumCoinFlip:input_type -> qubit_engine.gaming.CoinFlipRequest
+	14, // 16: qubit_engine.gaming.QuantumGaming.QuantumDiceRoll:input_type -> qubit_engine.gaming.DiceRequest
+	16, // 17: qubit_engine.gaming.QuantumGaming.ShuffleDeck:input_type -> qubit_engine.gaming.ShuffleRequest
+	18, // 18: qubit_engine.gaming.QuantumGaming.AskOracle:input_type -> qubit_engine.gaming.OracleRequest
+	20, // 19: qubit_engine.gaming.QuantumGaming.GetOracleHistory:input_type -> qubit_engine.gaming.OracleHistoryRequest
+	23, // 20: qubit_engine.gaming.QuantumGaming.GetLeaderboard:input_type -> qubit_engine.gaming.LeaderboardRequest
+	26, // 21: qubit_engine.gaming.QuantumGaming.ChallengeFlip:input_type -> qubit_engine.gaming.FlipChallengeRequest
+	28, // 22: qubit_engine.gaming.QuantumGaming.CallFlip:input_type -> qubit_engine.gaming.FlipCallRequest
+	4,  // 23: qubit_engine.gaming.QuantumGaming.GenerateRandom:output_type -> qubit_engine.gaming.RandomResponse
+	6,  // 24: qubit_engine.gaming.QuantumGaming.GenerateRandomBytes:output_type -> qubit_engine.gaming.RandomBytesResponse
+	9,  // 25: qubit_engine.gaming.QuantumGaming.CreateSuperposition:output_type -> qubit_engine.gaming.SuperpositionState
+	11, // 26: qubit_engine.gaming.QuantumGaming.CollapseState:output_type -> qubit_engine.gaming.CollapseResult
+	13, // 27: qubit_engine.gaming.QuantumGaming.QuantumCoinFlip:output_type -> qubit_engine.gaming.CoinFlipResult
+	15, // 28: qubit_engine.gaming.QuantumGaming.QuantumDiceRoll:output_type -> qubit_engine.gaming.DiceResult
+	17, // 29: qubit_engine.gaming.QuantumGaming.ShuffleDeck:output_type -> qubit_engine.gaming.ShuffledDeck
+	19, // 30: qubit_engine.gaming.QuantumGaming.AskOracle:output_type -> qubit_engine.gaming.OracleResponse
+	22, // 31: qubit_engine.gaming.QuantumGaming.GetOracleHistory:output_type -> qubit_engine.gaming.OracleHistory
+	25, // 32: qubit_engine.gaming.QuantumGaming.GetLeaderboard:output_type -> qubit_engine.gaming.Leaderboard
+	27, // 33: qubit_engine.gaming.QuantumGaming.ChallengeFlip:output_type -> qubit_engine.gaming.FlipChallenge
+	29, // 34: qubit_engine.gaming.QuantumGaming.CallFlip:output_type -> qubit_engine.gaming.FlipDuelResult
+	23, // [23:35] is the sub-list for method output_type
+	11, // [11:23] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_gaming_proto_init() }
+func file_gaming_proto_init() {
+	if File_gaming_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gaming_proto_rawDesc), len(file_gaming_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gaming_proto_goTypes,
+		DependencyIndexes: file_gaming_proto_depIdxs,
+		EnumInfos:         file_gaming_proto_enumTypes,
+		MessageInfos:      file_gaming_proto_msgTypes,
+	}.Build()
+	File_gaming_proto = out.File
+	file_gaming_proto_goTypes = nil
+	file_gaming_proto_depIdxs = nil
+}
@@ -0,0 +1,3750 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: music.proto
+
+package music
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Scale int32
+
+const (
+	Scale_SCALE_MAJOR      Scale = 0
+	Scale_SCALE_MINOR      Scale = 1
+	Scale_SCALE_DORIAN     Scale = 2
+	Scale_SCALE_PHRYGIAN   Scale = 3
+	Scale_SCALE_LYDIAN     Scale = 4
+	Scale_SCALE_MIXOLYDIAN Scale = 5
+	Scale_SCALE_AEOLIAN    Scale = 6
+	Scale_SCALE_LOCRIAN    Scale = 7
+	Scale_SCALE_PENTATONIC Scale = 8
+	Scale_SCALE_BLUES      Scale = 9
+	Scale_SCALE_CHROMATIC  Scale = 10
+)
+
+// Enum value maps for Scale.
+var (
+	Scale_name = map[int32]string{
+		0:  "SCALE_MAJOR",
+		1:  "SCALE_MINOR",
+		2:  "SCALE_DORIAN",
+		3:  "SCALE_PHRYGIAN",
+		4:  "SCALE_LYDIAN",
+		5:  "SCALE_MIXOLYDIAN",
+		6:  "SCALE_AEOLIAN",
+		7:  "SCALE_LOCRIAN",
+		8:  "SCALE_PENTATONIC",
+		9:  "SCALE_BLUES",
+		10: "SCALE_CHROMATIC",
+	}
+	Scale_value = map[string]int32{
+		"SCALE_MAJOR":      0,
+		"SCALE_MINOR":      1,
+		"SCALE_DORIAN":     2,
+		"SCALE_PHRYGIAN":   3,
+		"SCALE_LYDIAN":     4,
+		"SCALE_MIXOLYDIAN": 5,
+		"SCALE_AEOLIAN":    6,
+		"SCALE_LOCRIAN":    7,
+		"SCALE_PENTATONIC": 8,
+		"SCALE_BLUES":      9,
+		"SCALE_CHROMATIC":  10,
+	}
+)
+
+func (x Scale) Enum() *Scale {
+	p := new(Scale)
+	*p = x
+	return p
+}
+
+func (x Scale) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Scale) Descriptor() protoreflect.EnumDescriptor {
+	return file_music_proto_enumTypes[0].Descriptor()
+}
+
+func (Scale) Type() protoreflect.EnumType {
+	return &file_music_proto_enumTypes[0]
+}
+
+func (x Scale) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Scale.Descriptor instead.
+func (Scale) EnumDescriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{0}
+}
+
+type MoodType int32
+
+const (
+	MoodType_MOOD_HAPPY      MoodType = 0
+	MoodType_MOOD_SAD        MoodType = 1
+	MoodType_MOOD_MYSTERIOUS MoodType = 2
+	MoodType_MOOD_ENERGETIC  MoodType = 3
+	MoodType_MOOD_CALM       MoodType = 4
+	MoodType_MOOD_EPIC       MoodType = 5
+	MoodType_MOOD_DARK       MoodType = 6
+)
+
+// Enum value maps for MoodType.
+var (
+	MoodType_name = map[int32]string{
+		0: "MOOD_HAPPY",
+		1: "MOOD_SAD",
+		2: "MOOD_MYSTERIOUS",
+		3: "MOOD_ENERGETIC",
+		4: "MOOD_CALM",
+		5: "MOOD_EPIC",
+		6: "MOOD_DARK",
+	}
+	MoodType_value = map[string]int32{
+		"MOOD_HAPPY":      0,
+		"MOOD_SAD":        1,
+		"MOOD_MYSTERIOUS": 2,
+		"MOOD_ENERGETIC":  3,
+		"MOOD_CALM":       4,
+		"MOOD_EPIC":       5,
+		"MOOD_DARK":       6,
+	}
+)
+
+func (x MoodType) Enum() *MoodType {
+	p := new(MoodType)
+	*p = x
+	return p
+}
+
+func (x MoodType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MoodType) Descriptor() protoreflect.EnumDescriptor {
+	return file_music_proto_enumTypes[1].Descriptor()
+}
+
+func (MoodType) Type() protoreflect.EnumType {
+	return &file_music_proto_enumTypes[1]
+}
+
+func (x MoodType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoodType.Descriptor instead.
+func (MoodType) EnumDescriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{1}
+}
+
+type TrackRole int32
+
+const (
+	TrackRole_TRACK_MELODY         TrackRole = 0
+	TrackRole_TRACK_COUNTER_MELODY TrackRole = 1
+	TrackRole_TRACK_BASS           TrackRole = 2
+	TrackRole_TRACK_PERCUSSION     TrackRole = 3
+	TrackRole_TRACK_HARMONY        TrackRole = 4 // Chord pads (HarmonizeMelody)
+)
+
+// Enum value maps for TrackRole.
+var (
+	TrackRole_name = map[int32]string{
+		0: "TRACK_MELODY",
+		1: "TRACK_COUNTER_MELODY",
+		2: "TRACK_BASS",
+		3: "TRACK_PERCUSSION",
+		4: "TRACK_HARMONY",
+	}
+	TrackRole_value = map[string]int32{
+		"TRACK_MELODY":         0,
+		"TRACK_COUNTER_MELODY": 1,
+		"TRACK_BASS":           2,
+		"TRACK_PERCUSSION":     3,
+		"TRACK_HARMONY":        4,
+	}
+)
+
+func (x TrackRole) Enum() *TrackRole {
+	p := new(TrackRole)
+	*p = x
+	return p
+}
+
+func (x TrackRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_music_proto_enumTypes[2].Descriptor()
+}
+
+func (TrackRole) Type() protoreflect.EnumType {
+	return &file_music_proto_enumTypes[2]
+}
+
+func (x TrackRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackRole.Descriptor instead.
+func (TrackRole) EnumDescriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{2}
+}
+
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pitch         int32                  `protobuf:"varint,1,opt,name=pitch,proto3" json:"pitch,omitempty"`                           // MIDI note number (0-127)
+	Duration      float64                `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`                    // In beats
+	Velocity      float64                `protobuf:"fixed64,3,opt,name=velocity,proto3" json:"velocity,omitempty"`                    // Volume (0.0-1.0)
+	StartTime     float64                `protobuf:"fixed64,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Start time in beats
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_music_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Note) GetPitch() int32 {
+	if x != nil {
+		return x.Pitch
+	}
+	return 0
+}
+
+func (x *Note) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Note) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Note) GetStartTime() float64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+type MelodyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scale         Scale                  `protobuf:"varint,1,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,2,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"` // MIDI note for root (e.g., 60 = C4)
+	NumNotes      int32                  `protobuf:"varint,3,opt,name=num_notes,json=numNotes,proto3" json:"num_notes,omitempty"`
+	Tempo         float64                `protobuf:"fixed64,4,opt,name=tempo,proto3" json:"tempo,omitempty"` // BPM
+	Mood          MoodType               `protobuf:"varint,5,opt,name=mood,proto3,enum=qubit_engine.music.MoodType" json:"mood,omitempty"`
+	OctaveRange   int32                  `protobuf:"varint,6,opt,name=octave_range,json=octaveRange,proto3" json:"octave_range,omitempty"` // How many octaves to span
+	Realtime      bool                   `protobuf:"varint,7,opt,name=realtime,proto3" json:"realtime,omitempty"`                          // Stream only: pace notes at the requested tempo
+	MarkovModel   string                 `protobuf:"bytes,8,opt,name=markov_model,json=markovModel,proto3" json:"markov_model,omitempty"`  // Name of a model from TrainMarkov (optional)
+	MarkovMix     float64                `protobuf:"fixed64,9,opt,name=markov_mix,json=markovMix,proto3" json:"markov_mix,omitempty"`      // 0-1 weight of the Markov row vs. quantum interference; unset = 0.5
+	CustomScale   string                 `protobuf:"bytes,10,opt,name=custom_scale,json=customScale,proto3" json:"custom_scale,omitempty"` // Scale name from RegisterScale; overrides scale
+	Seed          uint64                 `protobuf:"varint,11,opt,name=seed,proto3" json:"seed,omitempty"`                                 // Non-zero: same seed + parameters reproduce the same melody
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MelodyRequest) Reset() {
+	*x = MelodyRequest{}
+	mi := &file_music_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MelodyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MelodyRequest) ProtoMessage() {}
+
+func (x *MelodyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MelodyRequest.ProtoReflect.Descriptor instead.
+func (*MelodyRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MelodyRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *MelodyRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *MelodyRequest) GetNumNotes() int32 {
+	if x != nil {
+		return x.NumNotes
+	}
+	return 0
+}
+
+func (x *MelodyRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *MelodyRequest) GetMood() MoodType {
+	if x != nil {
+		return x.Mood
+	}
+	return MoodType_MOOD_HAPPY
+}
+
+func (x *MelodyRequest) GetOctaveRange() int32 {
+	if x != nil {
+		return x.OctaveRange
+	}
+	return 0
+}
+
+func (x *MelodyRequest) GetRealtime() bool {
+	if x != nil {
+		return x.Realtime
+	}
+	return false
+}
+
+func (x *MelodyRequest) GetMarkovModel() string {
+	if x != nil {
+		return x.MarkovModel
+	}
+	return ""
+}
+
+func (x *MelodyRequest) GetMarkovMix() float64 {
+	if x != nil {
+		return x.MarkovMix
+	}
+	return 0
+}
+
+func (x *MelodyRequest) GetCustomScale() string {
+	if x != nil {
+		return x.CustomScale
+	}
+	return ""
+}
+
+func (x *MelodyRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// A note chosen by collapsing the composer's state vector
+type QuantumNote struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Pitch            int32                  `protobuf:"varint,1,opt,name=pitch,proto3" json:"pitch,omitempty"`                                                         // MIDI note number (0 = rest)
+	NoteName         string                 `protobuf:"bytes,2,opt,name=note_name,json=noteName,proto3" json:"note_name,omitempty"`                                    // C, D, E, F, G, A, B, REST
+	Duration         float64                `protobuf:"fixed64,3,opt,name=duration,proto3" json:"duration,omitempty"`                                                  // In beats
+	Velocity         float64                `protobuf:"fixed64,4,opt,name=velocity,proto3" json:"velocity,omitempty"`                                                  // Volume (0.0-1.0)
+	StartTime        float64                `protobuf:"fixed64,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                               // Start time in beats
+	QuantumOutcome   int32                  `protobuf:"varint,6,opt,name=quantum_outcome,json=quantumOutcome,proto3" json:"quantum_outcome,omitempty"`                 // Measured basis state |0⟩-|7⟩
+	StateProbsBefore []float64              `protobuf:"fixed64,7,rep,packed,name=state_probs_before,json=stateProbsBefore,proto3" json:"state_probs_before,omitempty"` // |a_i|² before collapse
+	Frequency        float64                `protobuf:"fixed64,8,opt,name=frequency,proto3" json:"frequency,omitempty"`                                                // Hz
+	Cents            float64                `protobuf:"fixed64,9,opt,name=cents,proto3" json:"cents,omitempty"`                                                        // Detune from pitch (microtonal scales; exported as pitch bend)
+	Phase            float64                `protobuf:"fixed64,10,opt,name=phase,proto3" json:"phase,omitempty"`                                                       // arg(a_k) of the collapsed amplitude
+	Articulation     float64                `protobuf:"fixed64,11,opt,name=articulation,proto3" json:"articulation,omitempty"`                                         // Sounding fraction of duration (0.5 staccato - 1.0 legato)
+	TimingOffset     float64                `protobuf:"fixed64,12,opt,name=timing_offset,json=timingOffset,proto3" json:"timing_offset,omitempty"`                     // Micro-timing humanization in beats
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuantumNote) Reset() {
+	*x = QuantumNote{}
+	mi := &file_music_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuantumNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantumNote) ProtoMessage() {}
+
+func (x *QuantumNote) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantumNote.ProtoReflect.Descriptor instead.
+func (*QuantumNote) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuantumNote) GetPitch() int32 {
+	if x != nil {
+		return x.Pitch
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetNoteName() string {
+	if x != nil {
+		return x.NoteName
+	}
+	return ""
+}
+
+func (x *QuantumNote) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetStartTime() float64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetQuantumOutcome() int32 {
+	if x != nil {
+		return x.QuantumOutcome
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetStateProbsBefore() []float64 {
+	if x != nil {
+		return x.StateProbsBefore
+	}
+	return nil
+}
+
+func (x *QuantumNote) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetCents() float64 {
+	if x != nil {
+		return x.Cents
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetPhase() float64 {
+	if x != nil {
+		return x.Phase
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetArticulation() float64 {
+	if x != nil {
+		return x.Articulation
+	}
+	return 0
+}
+
+func (x *QuantumNote) GetTimingOffset() float64 {
+	if x != nil {
+		return x.TimingOffset
+	}
+	return 0
+}
+
+type Melody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*QuantumNote         `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	Scale         Scale                  `protobuf:"varint,2,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,3,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	DurationBeats float64                `protobuf:"fixed64,4,opt,name=duration_beats,json=durationBeats,proto3" json:"duration_beats,omitempty"`
+	Tempo         float64                `protobuf:"fixed64,5,opt,name=tempo,proto3" json:"tempo,omitempty"` // BPM the melody was generated for
+	Seed          uint64                 `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`    // Seed it was generated with (0 = unseeded); share to replay
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Melody) Reset() {
+	*x = Melody{}
+	mi := &file_music_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Melody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Melody) ProtoMessage() {}
+
+func (x *Melody) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Melody.ProtoReflect.Descriptor instead.
+func (*Melody) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Melody) GetNotes() []*QuantumNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Melody) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *Melody) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *Melody) GetDurationBeats() float64 {
+	if x != nil {
+		return x.DurationBeats
+	}
+	return 0
+}
+
+func (x *Melody) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *Melody) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type Ratio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Numerator     int32                  `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator   int32                  `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ratio) Reset() {
+	*x = Ratio{}
+	mi := &file_music_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ratio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ratio) ProtoMessage() {}
+
+func (x *Ratio) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ratio.ProtoReflect.Descriptor instead.
+func (*Ratio) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Ratio) GetNumerator() int32 {
+	if x != nil {
+		return x.Numerator
+	}
+	return 0
+}
+
+func (x *Ratio) GetDenominator() int32 {
+	if x != nil {
+		return x.Denominator
+	}
+	return 0
+}
+
+// Exactly one way of giving the degrees should be used
+type ScaleDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cents         []float64              `protobuf:"fixed64,2,rep,packed,name=cents,proto3" json:"cents,omitempty"`                      // Degree offsets above the root
+	Edo           int32                  `protobuf:"varint,3,opt,name=edo,proto3" json:"edo,omitempty"`                                  // Equal division of the octave (e.g. 19)
+	EdoSteps      []int32                `protobuf:"varint,4,rep,packed,name=edo_steps,json=edoSteps,proto3" json:"edo_steps,omitempty"` // Degrees as EDO steps; empty = every step
+	Ratios        []*Ratio               `protobuf:"bytes,5,rep,name=ratios,proto3" json:"ratios,omitempty"`                             // Just intonation (e.g. 1/1, 9/8, 5/4)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleDefinition) Reset() {
+	*x = ScaleDefinition{}
+	mi := &file_music_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleDefinition) ProtoMessage() {}
+
+func (x *ScaleDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleDefinition.ProtoReflect.Descriptor instead.
+func (*ScaleDefinition) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScaleDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScaleDefinition) GetCents() []float64 {
+	if x != nil {
+		return x.Cents
+	}
+	return nil
+}
+
+func (x *ScaleDefinition) GetEdo() int32 {
+	if x != nil {
+		return x.Edo
+	}
+	return 0
+}
+
+func (x *ScaleDefinition) GetEdoSteps() []int32 {
+	if x != nil {
+		return x.EdoSteps
+	}
+	return nil
+}
+
+func (x *ScaleDefinition) GetRatios() []*Ratio {
+	if x != nil {
+		return x.Ratios
+	}
+	return nil
+}
+
+type ScaleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tuning        string                 `protobuf:"bytes,2,opt,name=tuning,proto3" json:"tuning,omitempty"` // "12-TET", "19-TET", "just", "cents"
+	Cents         []float64              `protobuf:"fixed64,3,rep,packed,name=cents,proto3" json:"cents,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,4,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"` // Register size: 2^k >= degrees + rest
+	Builtin       bool                   `protobuf:"varint,5,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleInfo) Reset() {
+	*x = ScaleInfo{}
+	mi := &file_music_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleInfo) ProtoMessage() {}
+
+func (x *ScaleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleInfo.ProtoReflect.Descriptor instead.
+func (*ScaleInfo) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScaleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScaleInfo) GetTuning() string {
+	if x != nil {
+		return x.Tuning
+	}
+	return ""
+}
+
+func (x *ScaleInfo) GetCents() []float64 {
+	if x != nil {
+		return x.Cents
+	}
+	return nil
+}
+
+func (x *ScaleInfo) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *ScaleInfo) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+type ListScalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScalesRequest) Reset() {
+	*x = ListScalesRequest{}
+	mi := &file_music_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScalesRequest) ProtoMessage() {}
+
+func (x *ListScalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScalesRequest.ProtoReflect.Descriptor instead.
+func (*ListScalesRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{7}
+}
+
+type ScaleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scales        []*ScaleInfo           `protobuf:"bytes,1,rep,name=scales,proto3" json:"scales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleList) Reset() {
+	*x = ScaleList{}
+	mi := &file_music_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleList) ProtoMessage() {}
+
+func (x *ScaleList) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleList.ProtoReflect.Descriptor instead.
+func (*ScaleList) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScaleList) GetScales() []*ScaleInfo {
+	if x != nil {
+		return x.Scales
+	}
+	return nil
+}
+
+type MarkovTrainingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MidiFiles     [][]byte               `protobuf:"bytes,2,rep,name=midi_files,json=midiFiles,proto3" json:"midi_files,omitempty"`       // Standard MIDI Files (format 0 or 1)
+	Scale         Scale                  `protobuf:"varint,3,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"` // Key the corpus is in; notes map to its degrees
+	RootNote      int32                  `protobuf:"varint,4,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkovTrainingRequest) Reset() {
+	*x = MarkovTrainingRequest{}
+	mi := &file_music_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkovTrainingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkovTrainingRequest) ProtoMessage() {}
+
+func (x *MarkovTrainingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkovTrainingRequest.ProtoReflect.Descriptor instead.
+func (*MarkovTrainingRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarkovTrainingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarkovTrainingRequest) GetMidiFiles() [][]byte {
+	if x != nil {
+		return x.MidiFiles
+	}
+	return nil
+}
+
+func (x *MarkovTrainingRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *MarkovTrainingRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+type MarkovModel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scale           Scale                  `protobuf:"varint,2,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	NumTransitions  int32                  `protobuf:"varint,3,opt,name=num_transitions,json=numTransitions,proto3" json:"num_transitions,omitempty"`
+	TransitionProbs []float64              `protobuf:"fixed64,4,rep,packed,name=transition_probs,json=transitionProbs,proto3" json:"transition_probs,omitempty"` // Row-major 8x8, P(to | from), state 7 = rest
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkovModel) Reset() {
+	*x = MarkovModel{}
+	mi := &file_music_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkovModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkovModel) ProtoMessage() {}
+
+func (x *MarkovModel) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkovModel.ProtoReflect.Descriptor instead.
+func (*MarkovModel) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarkovModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarkovModel) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *MarkovModel) GetNumTransitions() int32 {
+	if x != nil {
+		return x.NumTransitions
+	}
+	return 0
+}
+
+func (x *MarkovModel) GetTransitionProbs() []float64 {
+	if x != nil {
+		return x.TransitionProbs
+	}
+	return nil
+}
+
+type StateVectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateVectorRequest) Reset() {
+	*x = StateVectorRequest{}
+	mi := &file_music_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateVectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateVectorRequest) ProtoMessage() {}
+
+func (x *StateVectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateVectorRequest.ProtoReflect.Descriptor instead.
+func (*StateVectorRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{11}
+}
+
+type Amplitude struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Real          float64                `protobuf:"fixed64,1,opt,name=real,proto3" json:"real,omitempty"`
+	Imag          float64                `protobuf:"fixed64,2,opt,name=imag,proto3" json:"imag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Amplitude) Reset() {
+	*x = Amplitude{}
+	mi := &file_music_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Amplitude) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amplitude) ProtoMessage() {}
+
+func (x *Amplitude) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amplitude.ProtoReflect.Descriptor instead.
+func (*Amplitude) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Amplitude) GetReal() float64 {
+	if x != nil {
+		return x.Real
+	}
+	return 0
+}
+
+func (x *Amplitude) GetImag() float64 {
+	if x != nil {
+		return x.Imag
+	}
+	return 0
+}
+
+type StateVector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amplitudes    []*Amplitude           `protobuf:"bytes,1,rep,name=amplitudes,proto3" json:"amplitudes,omitempty"` // |000⟩ to |111⟩
+	Probabilities []float64              `protobuf:"fixed64,2,rep,packed,name=probabilities,proto3" json:"probabilities,omitempty"`
+	LastOutcome   int32                  `protobuf:"varint,3,opt,name=last_outcome,json=lastOutcome,proto3" json:"last_outcome,omitempty"` // -1 before the first measurement
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateVector) Reset() {
+	*x = StateVector{}
+	mi := &file_music_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateVector) ProtoMessage() {}
+
+func (x *StateVector) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateVector.ProtoReflect.Descriptor instead.
+func (*StateVector) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StateVector) GetAmplitudes() []*Amplitude {
+	if x != nil {
+		return x.Amplitudes
+	}
+	return nil
+}
+
+func (x *StateVector) GetProbabilities() []float64 {
+	if x != nil {
+		return x.Probabilities
+	}
+	return nil
+}
+
+func (x *StateVector) GetLastOutcome() int32 {
+	if x != nil {
+		return x.LastOutcome
+	}
+	return 0
+}
+
+type ChordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scale         Scale                  `protobuf:"varint,1,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,2,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	NumChords     int32                  `protobuf:"varint,3,opt,name=num_chords,json=numChords,proto3" json:"num_chords,omitempty"`
+	Mood          MoodType               `protobuf:"varint,4,opt,name=mood,proto3,enum=qubit_engine.music.MoodType" json:"mood,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChordRequest) Reset() {
+	*x = ChordRequest{}
+	mi := &file_music_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChordRequest) ProtoMessage() {}
+
+func (x *ChordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChordRequest.ProtoReflect.Descriptor instead.
+func (*ChordRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChordRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *ChordRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *ChordRequest) GetNumChords() int32 {
+	if x != nil {
+		return x.NumChords
+	}
+	return 0
+}
+
+func (x *ChordRequest) GetMood() MoodType {
+	if x != nil {
+		return x.Mood
+	}
+	return MoodType_MOOD_HAPPY
+}
+
+type Chord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []int32                `protobuf:"varint,1,rep,packed,name=notes,proto3" json:"notes,omitempty"` // MIDI note numbers
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // e.g., "Cmaj7", "Am"
+	Duration      float64                `protobuf:"fixed64,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chord) Reset() {
+	*x = Chord{}
+	mi := &file_music_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chord) ProtoMessage() {}
+
+func (x *Chord) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chord.ProtoReflect.Descriptor instead.
+func (*Chord) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Chord) GetNotes() []int32 {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Chord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Chord) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type ChordProgression struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Chords          []*Chord               `protobuf:"bytes,1,rep,name=chords,proto3" json:"chords,omitempty"`
+	ProgressionName string                 `protobuf:"bytes,2,opt,name=progression_name,json=progressionName,proto3" json:"progression_name,omitempty"` // e.g., "I-V-vi-IV"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChordProgression) Reset() {
+	*x = ChordProgression{}
+	mi := &file_music_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChordProgression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChordProgression) ProtoMessage() {}
+
+func (x *ChordProgression) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChordProgression.ProtoReflect.Descriptor instead.
+func (*ChordProgression) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChordProgression) GetChords() []*Chord {
+	if x != nil {
+		return x.Chords
+	}
+	return nil
+}
+
+func (x *ChordProgression) GetProgressionName() string {
+	if x != nil {
+		return x.ProgressionName
+	}
+	return ""
+}
+
+type CompositionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Style           string                 `protobuf:"bytes,1,opt,name=style,proto3" json:"style,omitempty"` // "ambient", "classical", "electronic"
+	DurationSeconds float64                `protobuf:"fixed64,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Mood            MoodType               `protobuf:"varint,3,opt,name=mood,proto3,enum=qubit_engine.music.MoodType" json:"mood,omitempty"`
+	Tracks          int32                  `protobuf:"varint,4,opt,name=tracks,proto3" json:"tracks,omitempty"` // Number of parallel tracks
+	Tempo           float64                `protobuf:"fixed64,5,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompositionRequest) Reset() {
+	*x = CompositionRequest{}
+	mi := &file_music_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositionRequest) ProtoMessage() {}
+
+func (x *CompositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositionRequest.ProtoReflect.Descriptor instead.
+func (*CompositionRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompositionRequest) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *CompositionRequest) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CompositionRequest) GetMood() MoodType {
+	if x != nil {
+		return x.Mood
+	}
+	return MoodType_MOOD_HAPPY
+}
+
+func (x *CompositionRequest) GetTracks() int32 {
+	if x != nil {
+		return x.Tracks
+	}
+	return 0
+}
+
+func (x *CompositionRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+type CompositionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Note          *Note                  `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // "note_on", "note_off", "tempo_change"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompositionEvent) Reset() {
+	*x = CompositionEvent{}
+	mi := &file_music_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositionEvent) ProtoMessage() {}
+
+func (x *CompositionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositionEvent.ProtoReflect.Descriptor instead.
+func (*CompositionEvent) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompositionEvent) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *CompositionEvent) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *CompositionEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type ScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scale         Scale                  `protobuf:"varint,1,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,2,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"` // Shared key (MIDI note of the root)
+	Tempo         float64                `protobuf:"fixed64,3,opt,name=tempo,proto3" json:"tempo,omitempty"`                      // Shared BPM
+	BeatsPerBar   int32                  `protobuf:"varint,4,opt,name=beats_per_bar,json=beatsPerBar,proto3" json:"beats_per_bar,omitempty"`
+	NumBars       int32                  `protobuf:"varint,5,opt,name=num_bars,json=numBars,proto3" json:"num_bars,omitempty"`
+	Tracks        []TrackRole            `protobuf:"varint,6,rep,packed,name=tracks,proto3,enum=qubit_engine.music.TrackRole" json:"tracks,omitempty"` // Empty = all four roles
+	GrooveStyle   string                 `protobuf:"bytes,7,opt,name=groove_style,json=grooveStyle,proto3" json:"groove_style,omitempty"`              // Percussion style: "rock", "jazz", "electronic"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreRequest) Reset() {
+	*x = ScoreRequest{}
+	mi := &file_music_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreRequest) ProtoMessage() {}
+
+func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRequest.ProtoReflect.Descriptor instead.
+func (*ScoreRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ScoreRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *ScoreRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetBeatsPerBar() int32 {
+	if x != nil {
+		return x.BeatsPerBar
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetNumBars() int32 {
+	if x != nil {
+		return x.NumBars
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetTracks() []TrackRole {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *ScoreRequest) GetGrooveStyle() string {
+	if x != nil {
+		return x.GrooveStyle
+	}
+	return ""
+}
+
+type Track struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role          TrackRole              `protobuf:"varint,2,opt,name=role,proto3,enum=qubit_engine.music.TrackRole" json:"role,omitempty"`
+	Channel       int32                  `protobuf:"varint,3,opt,name=channel,proto3" json:"channel,omitempty"` // MIDI channel (0-based, 9 = drums)
+	Program       int32                  `protobuf:"varint,4,opt,name=program,proto3" json:"program,omitempty"` // General MIDI program
+	Notes         []*QuantumNote         `protobuf:"bytes,5,rep,name=notes,proto3" json:"notes,omitempty"`      // Collapsed from the track's own state vector
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	mi := &file_music_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Track) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Track) GetRole() TrackRole {
+	if x != nil {
+		return x.Role
+	}
+	return TrackRole_TRACK_MELODY
+}
+
+func (x *Track) GetChannel() int32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *Track) GetProgram() int32 {
+	if x != nil {
+		return x.Program
+	}
+	return 0
+}
+
+func (x *Track) GetNotes() []*QuantumNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Score struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tracks        []*Track               `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	Scale         Scale                  `protobuf:"varint,2,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,3,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	Tempo         float64                `protobuf:"fixed64,4,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	BeatsPerBar   int32                  `protobuf:"varint,5,opt,name=beats_per_bar,json=beatsPerBar,proto3" json:"beats_per_bar,omitempty"`
+	NumBars       int32                  `protobuf:"varint,6,opt,name=num_bars,json=numBars,proto3" json:"num_bars,omitempty"`
+	DurationBeats float64                `protobuf:"fixed64,7,opt,name=duration_beats,json=durationBeats,proto3" json:"duration_beats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_music_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Score) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *Score) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *Score) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *Score) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *Score) GetBeatsPerBar() int32 {
+	if x != nil {
+		return x.BeatsPerBar
+	}
+	return 0
+}
+
+func (x *Score) GetNumBars() int32 {
+	if x != nil {
+		return x.NumBars
+	}
+	return 0
+}
+
+func (x *Score) GetDurationBeats() float64 {
+	if x != nil {
+		return x.DurationBeats
+	}
+	return 0
+}
+
+type DuetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scale         Scale                  `protobuf:"varint,1,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,2,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"` // Melody root; bass sounds two octaves lower
+	NumNotes      int32                  `protobuf:"varint,3,opt,name=num_notes,json=numNotes,proto3" json:"num_notes,omitempty"` // Note-against-note steps
+	Tempo         float64                `protobuf:"fixed64,4,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	Coupling      float64                `protobuf:"fixed64,5,opt,name=coupling,proto3" json:"coupling,omitempty"` // Joint amplitude boost for consonant pairs; unset = 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuetRequest) Reset() {
+	*x = DuetRequest{}
+	mi := &file_music_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuetRequest) ProtoMessage() {}
+
+func (x *DuetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuetRequest.ProtoReflect.Descriptor instead.
+func (*DuetRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DuetRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *DuetRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *DuetRequest) GetNumNotes() int32 {
+	if x != nil {
+		return x.NumNotes
+	}
+	return 0
+}
+
+func (x *DuetRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *DuetRequest) GetCoupling() float64 {
+	if x != nil {
+		return x.Coupling
+	}
+	return 0
+}
+
+type Duet struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Score             *Score                 `protobuf:"bytes,1,opt,name=score,proto3" json:"score,omitempty"`                                                    // Melody and bass tracks, exportable via ExportMIDI
+	Consonance        float64                `protobuf:"fixed64,2,opt,name=consonance,proto3" json:"consonance,omitempty"`                                        // Fraction of sounding pairs on consonant intervals
+	MutualInformation float64                `protobuf:"fixed64,3,opt,name=mutual_information,json=mutualInformation,proto3" json:"mutual_information,omitempty"` // Mean I(melody;bass) per step in bits
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Duet) Reset() {
+	*x = Duet{}
+	mi := &file_music_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Duet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Duet) ProtoMessage() {}
+
+func (x *Duet) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Duet.ProtoReflect.Descriptor instead.
+func (*Duet) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Duet) GetScore() *Score {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *Duet) GetConsonance() float64 {
+	if x != nil {
+		return x.Consonance
+	}
+	return 0
+}
+
+func (x *Duet) GetMutualInformation() float64 {
+	if x != nil {
+		return x.MutualInformation
+	}
+	return 0
+}
+
+type ExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ExportRequest_Melody
+	//	*ExportRequest_Chords
+	//	*ExportRequest_Score
+	//	*ExportRequest_Rhythm
+	Source        isExportRequest_Source `protobuf_oneof:"source"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_music_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportRequest) GetSource() isExportRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetMelody() *Melody {
+	if x != nil {
+		if x, ok := x.Source.(*ExportRequest_Melody); ok {
+			return x.Melody
+		}
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetChords() *ChordProgression {
+	if x != nil {
+		if x, ok := x.Source.(*ExportRequest_Chords); ok {
+			return x.Chords
+		}
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetScore() *Score {
+	if x != nil {
+		if x, ok := x.Source.(*ExportRequest_Score); ok {
+			return x.Score
+		}
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetRhythm() *RhythmPattern {
+	if x != nil {
+		if x, ok := x.Source.(*ExportRequest_Rhythm); ok {
+			return x.Rhythm
+		}
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type isExportRequest_Source interface {
+	isExportRequest_Source()
+}
+
+type ExportRequest_Melody struct {
+	Melody *Melody `protobuf:"bytes,1,opt,name=melody,proto3,oneof"`
+}
+
+type ExportRequest_Chords struct {
+	Chords *ChordProgression `protobuf:"bytes,2,opt,name=chords,proto3,oneof"`
+}
+
+type ExportRequest_Score struct {
+	Score *Score `protobuf:"bytes,4,opt,name=score,proto3,oneof"` // Exported as a type-1 (multi-track) file
+}
+
+type ExportRequest_Rhythm struct {
+	Rhythm *RhythmPattern `protobuf:"bytes,5,opt,name=rhythm,proto3,oneof"` // Drums on MIDI channel 10
+}
+
+func (*ExportRequest_Melody) isExportRequest_Source() {}
+
+func (*ExportRequest_Chords) isExportRequest_Source() {}
+
+func (*ExportRequest_Score) isExportRequest_Source() {}
+
+func (*ExportRequest_Rhythm) isExportRequest_Source() {}
+
+type MIDIFile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Data            []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Filename        string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	NumTracks       int32                  `protobuf:"varint,3,opt,name=num_tracks,json=numTracks,proto3" json:"num_tracks,omitempty"`
+	DurationSeconds float64                `protobuf:"fixed64,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MIDIFile) Reset() {
+	*x = MIDIFile{}
+	mi := &file_music_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MIDIFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MIDIFile) ProtoMessage() {}
+
+func (x *MIDIFile) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MIDIFile.ProtoReflect.Descriptor instead.
+func (*MIDIFile) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MIDIFile) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MIDIFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MIDIFile) GetNumTracks() int32 {
+	if x != nil {
+		return x.NumTracks
+	}
+	return 0
+}
+
+func (x *MIDIFile) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// Mono 16-bit PCM WAV
+type AudioFile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Data            []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Filename        string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	SampleRate      int32                  `protobuf:"varint,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	DurationSeconds float64                `protobuf:"fixed64,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AudioFile) Reset() {
+	*x = AudioFile{}
+	mi := &file_music_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioFile) ProtoMessage() {}
+
+func (x *AudioFile) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioFile.ProtoReflect.Descriptor instead.
+func (*AudioFile) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AudioFile) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AudioFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AudioFile) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *AudioFile) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type RhythmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeatsPerBar   int32                  `protobuf:"varint,1,opt,name=beats_per_bar,json=beatsPerBar,proto3" json:"beats_per_bar,omitempty"` // 4 for 4/4 time
+	NumBars       int32                  `protobuf:"varint,2,opt,name=num_bars,json=numBars,proto3" json:"num_bars,omitempty"`
+	Style         string                 `protobuf:"bytes,3,opt,name=style,proto3" json:"style,omitempty"` // "rock", "jazz", "electronic"
+	Tempo         float64                `protobuf:"fixed64,4,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	Swing         float64                `protobuf:"fixed64,5,opt,name=swing,proto3" json:"swing,omitempty"`     // 0 = straight, 1 = full triplet shuffle
+	Density       float64                `protobuf:"fixed64,6,opt,name=density,proto3" json:"density,omitempty"` // 0-1 (sparse to busy); unset uses the template (0.5)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RhythmRequest) Reset() {
+	*x = RhythmRequest{}
+	mi := &file_music_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RhythmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RhythmRequest) ProtoMessage() {}
+
+func (x *RhythmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RhythmRequest.ProtoReflect.Descriptor instead.
+func (*RhythmRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RhythmRequest) GetBeatsPerBar() int32 {
+	if x != nil {
+		return x.BeatsPerBar
+	}
+	return 0
+}
+
+func (x *RhythmRequest) GetNumBars() int32 {
+	if x != nil {
+		return x.NumBars
+	}
+	return 0
+}
+
+func (x *RhythmRequest) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *RhythmRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *RhythmRequest) GetSwing() float64 {
+	if x != nil {
+		return x.Swing
+	}
+	return 0
+}
+
+func (x *RhythmRequest) GetDensity() float64 {
+	if x != nil {
+		return x.Density
+	}
+	return 0
+}
+
+type RhythmPattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*BeatEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	BeatsPerBar   int32                  `protobuf:"varint,2,opt,name=beats_per_bar,json=beatsPerBar,proto3" json:"beats_per_bar,omitempty"`
+	NumBars       int32                  `protobuf:"varint,3,opt,name=num_bars,json=numBars,proto3" json:"num_bars,omitempty"`
+	Style         string                 `protobuf:"bytes,4,opt,name=style,proto3" json:"style,omitempty"`
+	Tempo         float64                `protobuf:"fixed64,5,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RhythmPattern) Reset() {
+	*x = RhythmPattern{}
+	mi := &file_music_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RhythmPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RhythmPattern) ProtoMessage() {}
+
+func (x *RhythmPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RhythmPattern.ProtoReflect.Descriptor instead.
+func (*RhythmPattern) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RhythmPattern) GetEvents() []*BeatEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *RhythmPattern) GetBeatsPerBar() int32 {
+	if x != nil {
+		return x.BeatsPerBar
+	}
+	return 0
+}
+
+func (x *RhythmPattern) GetNumBars() int32 {
+	if x != nil {
+		return x.NumBars
+	}
+	return 0
+}
+
+func (x *RhythmPattern) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *RhythmPattern) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+type BeatEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Time           float64                `protobuf:"fixed64,1,opt,name=time,proto3" json:"time,omitempty"`
+	Instrument     int32                  `protobuf:"varint,2,opt,name=instrument,proto3" json:"instrument,omitempty"` // 0=kick, 1=snare, 2=hihat, etc.
+	Velocity       float64                `protobuf:"fixed64,3,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	HitProbability float64                `protobuf:"fixed64,4,opt,name=hit_probability,json=hitProbability,proto3" json:"hit_probability,omitempty"` // Quantum walk probability before measurement
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BeatEvent) Reset() {
+	*x = BeatEvent{}
+	mi := &file_music_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeatEvent) ProtoMessage() {}
+
+func (x *BeatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeatEvent.ProtoReflect.Descriptor instead.
+func (*BeatEvent) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BeatEvent) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *BeatEvent) GetInstrument() int32 {
+	if x != nil {
+		return x.Instrument
+	}
+	return 0
+}
+
+func (x *BeatEvent) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *BeatEvent) GetHitProbability() float64 {
+	if x != nil {
+		return x.HitProbability
+	}
+	return 0
+}
+
+type MotifRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contour       string                 `protobuf:"bytes,1,opt,name=contour,proto3" json:"contour,omitempty"` // Parsons code: U up, D down, R repeat, * any (e.g. "*UUDR")
+	Scale         Scale                  `protobuf:"varint,2,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,3,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	Tempo         float64                `protobuf:"fixed64,4,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	CustomScale   string                 `protobuf:"bytes,5,opt,name=custom_scale,json=customScale,proto3" json:"custom_scale,omitempty"` // Scale name from RegisterScale; overrides scale
+	Seed          uint64                 `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`                                 // Non-zero: reproducible motif
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MotifRequest) Reset() {
+	*x = MotifRequest{}
+	mi := &file_music_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MotifRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MotifRequest) ProtoMessage() {}
+
+func (x *MotifRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MotifRequest.ProtoReflect.Descriptor instead.
+func (*MotifRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MotifRequest) GetContour() string {
+	if x != nil {
+		return x.Contour
+	}
+	return ""
+}
+
+func (x *MotifRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *MotifRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *MotifRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *MotifRequest) GetCustomScale() string {
+	if x != nil {
+		return x.CustomScale
+	}
+	return ""
+}
+
+func (x *MotifRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type MotifStep struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Direction            string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`                                                     // U, D, R or *
+	PriorProbability     float64                `protobuf:"fixed64,2,opt,name=prior_probability,json=priorProbability,proto3" json:"prior_probability,omitempty"`             // Mass of matching states before amplification
+	Iterations           int32                  `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`                                                  // Grover iterations applied
+	AmplifiedProbability float64                `protobuf:"fixed64,4,opt,name=amplified_probability,json=amplifiedProbability,proto3" json:"amplified_probability,omitempty"` // Mass of matching states at measurement
+	Matched              bool                   `protobuf:"varint,5,opt,name=matched,proto3" json:"matched,omitempty"`                                                        // Whether the measured note followed the step
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MotifStep) Reset() {
+	*x = MotifStep{}
+	mi := &file_music_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MotifStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MotifStep) ProtoMessage() {}
+
+func (x *MotifStep) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MotifStep.ProtoReflect.Descriptor instead.
+func (*MotifStep) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MotifStep) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *MotifStep) GetPriorProbability() float64 {
+	if x != nil {
+		return x.PriorProbability
+	}
+	return 0
+}
+
+func (x *MotifStep) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *MotifStep) GetAmplifiedProbability() float64 {
+	if x != nil {
+		return x.AmplifiedProbability
+	}
+	return 0
+}
+
+func (x *MotifStep) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+type Motif struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Melody        *Melody                `protobuf:"bytes,1,opt,name=melody,proto3" json:"melody,omitempty"`   // len(contour) + 1 notes
+	Contour       string                 `protobuf:"bytes,2,opt,name=contour,proto3" json:"contour,omitempty"` // Normalized contour
+	Steps         []*MotifStep           `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	MatchRate     float64                `protobuf:"fixed64,4,opt,name=match_rate,json=matchRate,proto3" json:"match_rate,omitempty"` // Fraction of steps followed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Motif) Reset() {
+	*x = Motif{}
+	mi := &file_music_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Motif) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Motif) ProtoMessage() {}
+
+func (x *Motif) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Motif.ProtoReflect.Descriptor instead.
+func (*Motif) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Motif) GetMelody() *Melody {
+	if x != nil {
+		return x.Melody
+	}
+	return nil
+}
+
+func (x *Motif) GetContour() string {
+	if x != nil {
+		return x.Contour
+	}
+	return ""
+}
+
+func (x *Motif) GetSteps() []*MotifStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Motif) GetMatchRate() float64 {
+	if x != nil {
+		return x.MatchRate
+	}
+	return 0
+}
+
+type HarmonizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*HarmonizeRequest_Melody
+	//	*HarmonizeRequest_MidiData
+	Source        isHarmonizeRequest_Source `protobuf_oneof:"source"`
+	Scale         Scale                     `protobuf:"varint,3,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"` // Key the chords are drawn from
+	RootNote      int32                     `protobuf:"varint,4,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	Tempo         float64                   `protobuf:"fixed64,5,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	ChordBeats    float64                   `protobuf:"fixed64,6,opt,name=chord_beats,json=chordBeats,proto3" json:"chord_beats,omitempty"`     // Beats per chord; unset = one per bar
+	BeatsPerBar   int32                     `protobuf:"varint,7,opt,name=beats_per_bar,json=beatsPerBar,proto3" json:"beats_per_bar,omitempty"` // Unset = 4
+	Seed          uint64                    `protobuf:"varint,8,opt,name=seed,proto3" json:"seed,omitempty"`                                    // Non-zero: reproducible chord choices
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HarmonizeRequest) Reset() {
+	*x = HarmonizeRequest{}
+	mi := &file_music_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HarmonizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HarmonizeRequest) ProtoMessage() {}
+
+func (x *HarmonizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HarmonizeRequest.ProtoReflect.Descriptor instead.
+func (*HarmonizeRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HarmonizeRequest) GetSource() isHarmonizeRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *HarmonizeRequest) GetMelody() *Melody {
+	if x != nil {
+		if x, ok := x.Source.(*HarmonizeRequest_Melody); ok {
+			return x.Melody
+		}
+	}
+	return nil
+}
+
+func (x *HarmonizeRequest) GetMidiData() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*HarmonizeRequest_MidiData); ok {
+			return x.MidiData
+		}
+	}
+	return nil
+}
+
+func (x *HarmonizeRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *HarmonizeRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *HarmonizeRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *HarmonizeRequest) GetChordBeats() float64 {
+	if x != nil {
+		return x.ChordBeats
+	}
+	return 0
+}
+
+func (x *HarmonizeRequest) GetBeatsPerBar() int32 {
+	if x != nil {
+		return x.BeatsPerBar
+	}
+	return 0
+}
+
+func (x *HarmonizeRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type isHarmonizeRequest_Source interface {
+	isHarmonizeRequest_Source()
+}
+
+type HarmonizeRequest_Melody struct {
+	Melody *Melody `protobuf:"bytes,1,opt,name=melody,proto3,oneof"`
+}
+
+type HarmonizeRequest_MidiData struct {
+	MidiData []byte `protobuf:"bytes,2,opt,name=midi_data,json=midiData,proto3,oneof"` // Standard MIDI File; the top line of the first melodic track is used
+}
+
+func (*HarmonizeRequest_Melody) isHarmonizeRequest_Source() {}
+
+func (*HarmonizeRequest_MidiData) isHarmonizeRequest_Source() {}
+
+type Harmonization struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Score             *Score                 `protobuf:"bytes,1,opt,name=score,proto3" json:"score,omitempty"` // Melody, harmony and bass tracks
+	Progression       *ChordProgression      `protobuf:"bytes,2,opt,name=progression,proto3" json:"progression,omitempty"`
+	ChordToneCoverage float64                `protobuf:"fixed64,3,opt,name=chord_tone_coverage,json=chordToneCoverage,proto3" json:"chord_tone_coverage,omitempty"` // Fraction of melody duration on chord tones
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Harmonization) Reset() {
+	*x = Harmonization{}
+	mi := &file_music_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Harmonization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Harmonization) ProtoMessage() {}
+
+func (x *Harmonization) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Harmonization.ProtoReflect.Descriptor instead.
+func (*Harmonization) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Harmonization) GetScore() *Score {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *Harmonization) GetProgression() *ChordProgression {
+	if x != nil {
+		return x.Progression
+	}
+	return nil
+}
+
+func (x *Harmonization) GetChordToneCoverage() float64 {
+	if x != nil {
+		return x.ChordToneCoverage
+	}
+	return 0
+}
+
+type LiveRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Output string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // "osc" or "midi" (enabled with the server's -osc / -midi-out flags)
+	// Types that are valid to be assigned to Source:
+	//
+	//	*LiveRequest_Generate
+	//	*LiveRequest_Melody
+	//	*LiveRequest_Score
+	Source        isLiveRequest_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveRequest) Reset() {
+	*x = LiveRequest{}
+	mi := &file_music_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveRequest) ProtoMessage() {}
+
+func (x *LiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveRequest.ProtoReflect.Descriptor instead.
+func (*LiveRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LiveRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *LiveRequest) GetSource() isLiveRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *LiveRequest) GetGenerate() *MelodyRequest {
+	if x != nil {
+		if x, ok := x.Source.(*LiveRequest_Generate); ok {
+			return x.Generate
+		}
+	}
+	return nil
+}
+
+func (x *LiveRequest) GetMelody() *Melody {
+	if x != nil {
+		if x, ok := x.Source.(*LiveRequest_Melody); ok {
+			return x.Melody
+		}
+	}
+	return nil
+}
+
+func (x *LiveRequest) GetScore() *Score {
+	if x != nil {
+		if x, ok := x.Source.(*LiveRequest_Score); ok {
+			return x.Score
+		}
+	}
+	return nil
+}
+
+type isLiveRequest_Source interface {
+	isLiveRequest_Source()
+}
+
+type LiveRequest_Generate struct {
+	Generate *MelodyRequest `protobuf:"bytes,2,opt,name=generate,proto3,oneof"` // Compose a new melody and play it
+}
+
+type LiveRequest_Melody struct {
+	Melody *Melody `protobuf:"bytes,3,opt,name=melody,proto3,oneof"`
+}
+
+type LiveRequest_Score struct {
+	Score *Score `protobuf:"bytes,4,opt,name=score,proto3,oneof"`
+}
+
+func (*LiveRequest_Generate) isLiveRequest_Source() {}
+
+func (*LiveRequest_Melody) isLiveRequest_Source() {}
+
+func (*LiveRequest_Score) isLiveRequest_Source() {}
+
+type MelodyRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId   string                 `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"` // Candidate.id from the previous Evolution
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`                             // 1 (dislike) - 5 (love)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MelodyRating) Reset() {
+	*x = MelodyRating{}
+	mi := &file_music_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MelodyRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MelodyRating) ProtoMessage() {}
+
+func (x *MelodyRating) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MelodyRating.ProtoReflect.Descriptor instead.
+func (*MelodyRating) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MelodyRating) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *MelodyRating) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type EvolveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                       // Populations are persisted per user
+	Ratings       []*MelodyRating        `protobuf:"bytes,2,rep,name=ratings,proto3" json:"ratings,omitempty"`                                   // Empty: just draw new candidates
+	NumCandidates int32                  `protobuf:"varint,3,opt,name=num_candidates,json=numCandidates,proto3" json:"num_candidates,omitempty"` // Unset = 4, at most 8
+	Scale         Scale                  `protobuf:"varint,4,opt,name=scale,proto3,enum=qubit_engine.music.Scale" json:"scale,omitempty"`
+	RootNote      int32                  `protobuf:"varint,5,opt,name=root_note,json=rootNote,proto3" json:"root_note,omitempty"`
+	NumNotes      int32                  `protobuf:"varint,6,opt,name=num_notes,json=numNotes,proto3" json:"num_notes,omitempty"`
+	Tempo         float64                `protobuf:"fixed64,7,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	CustomScale   string                 `protobuf:"bytes,8,opt,name=custom_scale,json=customScale,proto3" json:"custom_scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvolveRequest) Reset() {
+	*x = EvolveRequest{}
+	mi := &file_music_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvolveRequest) ProtoMessage() {}
+
+func (x *EvolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvolveRequest.ProtoReflect.Descriptor instead.
+func (*EvolveRequest) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EvolveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EvolveRequest) GetRatings() []*MelodyRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *EvolveRequest) GetNumCandidates() int32 {
+	if x != nil {
+		return x.NumCandidates
+	}
+	return 0
+}
+
+func (x *EvolveRequest) GetScale() Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return Scale_SCALE_MAJOR
+}
+
+func (x *EvolveRequest) GetRootNote() int32 {
+	if x != nil {
+		return x.RootNote
+	}
+	return 0
+}
+
+func (x *EvolveRequest) GetNumNotes() int32 {
+	if x != nil {
+		return x.NumNotes
+	}
+	return 0
+}
+
+func (x *EvolveRequest) GetTempo() float64 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *EvolveRequest) GetCustomScale() string {
+	if x != nil {
+		return x.CustomScale
+	}
+	return ""
+}
+
+// Interference bias evolved from ratings
+type Genome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DegreeBias    []float64              `protobuf:"fixed64,1,rep,packed,name=degree_bias,json=degreeBias,proto3" json:"degree_bias,omitempty"` // Amplitude factor per basis state (mod 8)
+	StepBoost     float64                `protobuf:"fixed64,2,opt,name=step_boost,json=stepBoost,proto3" json:"step_boost,omitempty"`           // Amplitude factor for stepwise motion
+	PhaseShift    float64                `protobuf:"fixed64,3,opt,name=phase_shift,json=phaseShift,proto3" json:"phase_shift,omitempty"`        // Extra phase per basis index (radians)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genome) Reset() {
+	*x = Genome{}
+	mi := &file_music_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genome) ProtoMessage() {}
+
+func (x *Genome) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genome.ProtoReflect.Descriptor instead.
+func (*Genome) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Genome) GetDegreeBias() []float64 {
+	if x != nil {
+		return x.DegreeBias
+	}
+	return nil
+}
+
+func (x *Genome) GetStepBoost() float64 {
+	if x != nil {
+		return x.StepBoost
+	}
+	return 0
+}
+
+func (x *Genome) GetPhaseShift() float64 {
+	if x != nil {
+		return x.PhaseShift
+	}
+	return 0
+}
+
+type Candidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Melody        *Melody                `protobuf:"bytes,2,opt,name=melody,proto3" json:"melody,omitempty"`
+	Genome        *Genome                `protobuf:"bytes,3,opt,name=genome,proto3" json:"genome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Candidate) Reset() {
+	*x = Candidate{}
+	mi := &file_music_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Candidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candidate) ProtoMessage() {}
+
+func (x *Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candidate.ProtoReflect.Descriptor instead.
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Candidate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Candidate) GetMelody() *Melody {
+	if x != nil {
+		return x.Melody
+	}
+	return nil
+}
+
+func (x *Candidate) GetGenome() *Genome {
+	if x != nil {
+		return x.Genome
+	}
+	return nil
+}
+
+type Evolution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Generation    int32                  `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	Candidates    []*Candidate           `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	LearnedBias   *Genome                `protobuf:"bytes,3,opt,name=learned_bias,json=learnedBias,proto3" json:"learned_bias,omitempty"` // Mean genome of the user's population
+	MeanRating    float64                `protobuf:"fixed64,4,opt,name=mean_rating,json=meanRating,proto3" json:"mean_rating,omitempty"`  // Mean of the ratings that bred this generation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Evolution) Reset() {
+	*x = Evolution{}
+	mi := &file_music_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Evolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evolution) ProtoMessage() {}
+
+func (x *Evolution) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evolution.ProtoReflect.Descriptor instead.
+func (*Evolution) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Evolution) GetGeneration() int32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *Evolution) GetCandidates() []*Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Evolution) GetLearnedBias() *Genome {
+	if x != nil {
+		return x.LearnedBias
+	}
+	return nil
+}
+
+func (x *Evolution) GetMeanRating() float64 {
+	if x != nil {
+		return x.MeanRating
+	}
+	return 0
+}
+
+var File_music_proto protoreflect.FileDescriptor
+
+const file_music_proto_rawDesc = "" +
+	"\n" +
+	"\vmusic.proto\x12\x12qubit_engine.music\"s\n" +
+	"\x04Note\x12\x14\n" +
+	"\x05pitch\x18\x01 \x01(\x05R\x05pitch\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x01R\bduration\x12\x1a\n" +
+	"\bvelocity\x18\x03 \x01(\x01R\bvelocity\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x01R\tstartTime\"\xfa\x02\n" +
+	"\rMelodyRequest\x12/\n" +
+	"\x05scale\x18\x01 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x02 \x01(\x05R\brootNote\x12\x1b\n" +
+	"\tnum_notes\x18\x03 \x01(\x05R\bnumNotes\x12\x14\n" +
+	"\x05tempo\x18\x04 \x01(\x01R\x05tempo\x120\n" +
+	"\x04mood\x18\x05 \x01(\x0e2\x1c.qubit_engine.music.MoodTypeR\x04mood\x12!\n" +
+	"\foctave_range\x18\x06 \x01(\x05R\voctaveRange\x12\x1a\n" +
+	"\brealtime\x18\a \x01(\bR\brealtime\x12!\n" +
+	"\fmarkov_model\x18\b \x01(\tR\vmarkovModel\x12\x1d\n" +
+	"\n" +
+	"markov_mix\x18\t \x01(\x01R\tmarkovMix\x12!\n" +
+	"\fcustom_scale\x18\n" +
+	" \x01(\tR\vcustomScale\x12\x12\n" +
+	"\x04seed\x18\v \x01(\x04R\x04seed\"\x81\x03\n" +
+	"\vQuantumNote\x12\x14\n" +
+	"\x05pitch\x18\x01 \x01(\x05R\x05pitch\x12\x1b\n" +
+	"\tnote_name\x18\x02 \x01(\tR\bnoteName\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x01R\bduration\x12\x1a\n" +
+	"\bvelocity\x18\x04 \x01(\x01R\bvelocity\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x01R\tstartTime\x12'\n" +
+	"\x0fquantum_outcome\x18\x06 \x01(\x05R\x0equantumOutcome\x12,\n" +
+	"\x12state_probs_before\x18\a \x03(\x01R\x10stateProbsBefore\x12\x1c\n" +
+	"\tfrequency\x18\b \x01(\x01R\tfrequency\x12\x14\n" +
+	"\x05cents\x18\t \x01(\x01R\x05cents\x12\x14\n" +
+	"\x05phase\x18\n" +
+	" \x01(\x01R\x05phase\x12\"\n" +
+	"\farticulation\x18\v \x01(\x01R\farticulation\x12#\n" +
+	"\rtiming_offset\x18\f \x01(\x01R\ftimingOffset\"\xde\x01\n" +
+	"\x06Melody\x125\n" +
+	"\x05notes\x18\x01 \x03(\v2\x1f.qubit_engine.music.QuantumNoteR\x05notes\x12/\n" +
+	"\x05scale\x18\x02 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x03 \x01(\x05R\brootNote\x12%\n" +
+	"\x0eduration_beats\x18\x04 \x01(\x01R\rdurationBeats\x12\x14\n" +
+	"\x05tempo\x18\x05 \x01(\x01R\x05tempo\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x04R\x04seed\"G\n" +
+	"\x05Ratio\x12\x1c\n" +
+	"\tnumerator\x18\x01 \x01(\x05R\tnumerator\x12 \n" +
+	"\vdenominator\x18\x02 \x01(\x05R\vdenominator\"\x9d\x01\n" +
+	"\x0fScaleDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05cents\x18\x02 \x03(\x01R\x05cents\x12\x10\n" +
+	"\x03edo\x18\x03 \x01(\x05R\x03edo\x12\x1b\n" +
+	"\tedo_steps\x18\x04 \x03(\x05R\bedoSteps\x121\n" +
+	"\x06ratios\x18\x05 \x03(\v2\x19.qubit_engine.music.RatioR\x06ratios\"\x86\x01\n" +
+	"\tScaleInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06tuning\x18\x02 \x01(\tR\x06tuning\x12\x14\n" +
+	"\x05cents\x18\x03 \x03(\x01R\x05cents\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x04 \x01(\x05R\tnumQubits\x12\x18\n" +
+	"\abuiltin\x18\x05 \x01(\bR\abuiltin\"\x13\n" +
+	"\x11ListScalesRequest\"B\n" +
+	"\tScaleList\x125\n" +
+	"\x06scales\x18\x01 \x03(\v2\x1d.qubit_engine.music.ScaleInfoR\x06scales\"\x98\x01\n" +
+	"\x15MarkovTrainingRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"midi_files\x18\x02 \x03(\fR\tmidiFiles\x12/\n" +
+	"\x05scale\x18\x03 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x04 \x01(\x05R\brootNote\"\xa6\x01\n" +
+	"\vMarkovModel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x05scale\x18\x02 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12'\n" +
+	"\x0fnum_transitions\x18\x03 \x01(\x05R\x0enumTransitions\x12)\n" +
+	"\x10transition_probs\x18\x04 \x03(\x01R\x0ftransitionProbs\"\x14\n" +
+	"\x12StateVectorRequest\"3\n" +
+	"\tAmplitude\x12\x12\n" +
+	"\x04real\x18\x01 \x01(\x01R\x04real\x12\x12\n" +
+	"\x04imag\x18\x02 \x01(\x01R\x04imag\"\x95\x01\n" +
+	"\vStateVector\x12=\n" +
+	"\n" +
+	"amplitudes\x18\x01 \x03(\v2\x1d.qubit_engine.music.AmplitudeR\n" +
+	"amplitudes\x12$\n" +
+	"\rprobabilities\x18\x02 \x03(\x01R\rprobabilities\x12!\n" +
+	"\flast_outcome\x18\x03 \x01(\x05R\vlastOutcome\"\xad\x01\n" +
+	"\fChordRequest\x12/\n" +
+	"\x05scale\x18\x01 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x02 \x01(\x05R\brootNote\x12\x1d\n" +
+	"\n" +
+	"num_chords\x18\x03 \x01(\x05R\tnumChords\x120\n" +
+	"\x04mood\x18\x04 \x01(\x0e2\x1c.qubit_engine.music.MoodTypeR\x04mood\"M\n" +
+	"\x05Chord\x12\x14\n" +
+	"\x05notes\x18\x01 \x03(\x05R\x05notes\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x01R\bduration\"p\n" +
+	"\x10ChordProgression\x121\n" +
+	"\x06chords\x18\x01 \x03(\v2\x19.qubit_engine.music.ChordR\x06chords\x12)\n" +
+	"\x10progression_name\x18\x02 \x01(\tR\x0fprogressionName\"\xb5\x01\n" +
+	"\x12CompositionRequest\x12\x14\n" +
+	"\x05style\x18\x01 \x01(\tR\x05style\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x01R\x0fdurationSeconds\x120\n" +
+	"\x04mood\x18\x03 \x01(\x0e2\x1c.qubit_engine.music.MoodTypeR\x04mood\x12\x16\n" +
+	"\x06tracks\x18\x04 \x01(\x05R\x06tracks\x12\x14\n" +
+	"\x05tempo\x18\x05 \x01(\x01R\x05tempo\"u\n" +
+	"\x10CompositionEvent\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12,\n" +
+	"\x04note\x18\x02 \x01(\v2\x18.qubit_engine.music.NoteR\x04note\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\"\x8b\x02\n" +
+	"\fScoreRequest\x12/\n" +
+	"\x05scale\x18\x01 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x02 \x01(\x05R\brootNote\x12\x14\n" +
+	"\x05tempo\x18\x03 \x01(\x01R\x05tempo\x12\"\n" +
+	"\rbeats_per_bar\x18\x04 \x01(\x05R\vbeatsPerBar\x12\x19\n" +
+	"\bnum_bars\x18\x05 \x01(\x05R\anumBars\x125\n" +
+	"\x06tracks\x18\x06 \x03(\x0e2\x1d.qubit_engine.music.TrackRoleR\x06tracks\x12!\n" +
+	"\fgroove_style\x18\a \x01(\tR\vgrooveStyle\"\xb9\x01\n" +
+	"\x05Track\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1d.qubit_engine.music.TrackRoleR\x04role\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\x05R\achannel\x12\x18\n" +
+	"\aprogram\x18\x04 \x01(\x05R\aprogram\x125\n" +
+	"\x05notes\x18\x05 \x03(\v2\x1f.qubit_engine.music.QuantumNoteR\x05notes\"\x84\x02\n" +
+	"\x05Score\x121\n" +
+	"\x06tracks\x18\x01 \x03(\v2\x19.qubit_engine.music.TrackR\x06tracks\x12/\n" +
+	"\x05scale\x18\x02 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x03 \x01(\x05R\brootNote\x12\x14\n" +
+	"\x05tempo\x18\x04 \x01(\x01R\x05tempo\x12\"\n" +
+	"\rbeats_per_bar\x18\x05 \x01(\x05R\vbeatsPerBar\x12\x19\n" +
+	"\bnum_bars\x18\x06 \x01(\x05R\anumBars\x12%\n" +
+	"\x0eduration_beats\x18\a \x01(\x01R\rdurationBeats\"\xaa\x01\n" +
+	"\vDuetRequest\x12/\n" +
+	"\x05scale\x18\x01 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x02 \x01(\x05R\brootNote\x12\x1b\n" +
+	"\tnum_notes\x18\x03 \x01(\x05R\bnumNotes\x12\x14\n" +
+	"\x05tempo\x18\x04 \x01(\x01R\x05tempo\x12\x1a\n" +
+	"\bcoupling\x18\x05 \x01(\x01R\bcoupling\"\x86\x01\n" +
+	"\x04Duet\x12/\n" +
+	"\x05score\x18\x01 \x01(\v2\x19.qubit_engine.music.ScoreR\x05score\x12\x1e\n" +
+	"\n" +
+	"consonance\x18\x02 \x01(\x01R\n" +
+	"consonance\x12-\n" +
+	"\x12mutual_information\x18\x03 \x01(\x01R\x11mutualInformation\"\x9b\x02\n" +
+	"\rExportRequest\x124\n" +
+	"\x06melody\x18\x01 \x01(\v2\x1a.qubit_engine.music.MelodyH\x00R\x06melody\x12>\n" +
+	"\x06chords\x18\x02 \x01(\v2$.qubit_engine.music.ChordProgressionH\x00R\x06chords\x121\n" +
+	"\x05score\x18\x04 \x01(\v2\x19.qubit_engine.music.ScoreH\x00R\x05score\x12;\n" +
+	"\x06rhythm\x18\x05 \x01(\v2!.qubit_engine.music.RhythmPatternH\x00R\x06rhythm\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilenameB\b\n" +
+	"\x06source\"\x84\x01\n" +
+	"\bMIDIFile\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"num_tracks\x18\x03 \x01(\x05R\tnumTracks\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x01R\x0fdurationSeconds\"\x87\x01\n" +
+	"\tAudioFile\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1f\n" +
+	"\vsample_rate\x18\x03 \x01(\x05R\n" +
+	"sampleRate\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x01R\x0fdurationSeconds\"\xaa\x01\n" +
+	"\rRhythmRequest\x12\"\n" +
+	"\rbeats_per_bar\x18\x01 \x01(\x05R\vbeatsPerBar\x12\x19\n" +
+	"\bnum_bars\x18\x02 \x01(\x05R\anumBars\x12\x14\n" +
+	"\x05style\x18\x03 \x01(\tR\x05style\x12\x14\n" +
+	"\x05tempo\x18\x04 \x01(\x01R\x05tempo\x12\x14\n" +
+	"\x05swing\x18\x05 \x01(\x01R\x05swing\x12\x18\n" +
+	"\adensity\x18\x06 \x01(\x01R\adensity\"\xb1\x01\n" +
+	"\rRhythmPattern\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.qubit_engine.music.BeatEventR\x06events\x12\"\n" +
+	"\rbeats_per_bar\x18\x02 \x01(\x05R\vbeatsPerBar\x12\x19\n" +
+	"\bnum_bars\x18\x03 \x01(\x05R\anumBars\x12\x14\n" +
+	"\x05style\x18\x04 \x01(\tR\x05style\x12\x14\n" +
+	"\x05tempo\x18\x05 \x01(\x01R\x05tempo\"\x84\x01\n" +
+	"\tBeatEvent\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x01R\x04time\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x02 \x01(\x05R\n" +
+	"instrument\x12\x1a\n" +
+	"\bvelocity\x18\x03 \x01(\x01R\bvelocity\x12'\n" +
+	"\x0fhit_probability\x18\x04 \x01(\x01R\x0ehitProbability\"\xc3\x01\n" +
+	"\fMotifRequest\x12\x18\n" +
+	"\acontour\x18\x01 \x01(\tR\acontour\x12/\n" +
+	"\x05scale\x18\x02 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x03 \x01(\x05R\brootNote\x12\x14\n" +
+	"\x05tempo\x18\x04 \x01(\x01R\x05tempo\x12!\n" +
+	"\fcustom_scale\x18\x05 \x01(\tR\vcustomScale\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x04R\x04seed\"\xc5\x01\n" +
+	"\tMotifStep\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\x12+\n" +
+	"\x11prior_probability\x18\x02 \x01(\x01R\x10priorProbability\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x03 \x01(\x05R\n" +
+	"iterations\x123\n" +
+	"\x15amplified_probability\x18\x04 \x01(\x01R\x14amplifiedProbability\x12\x18\n" +
+	"\amatched\x18\x05 \x01(\bR\amatched\"\xa9\x01\n" +
+	"\x05Motif\x122\n" +
+	"\x06melody\x18\x01 \x01(\v2\x1a.qubit_engine.music.MelodyR\x06melody\x12\x18\n" +
+	"\acontour\x18\x02 \x01(\tR\acontour\x123\n" +
+	"\x05steps\x18\x03 \x03(\v2\x1d.qubit_engine.music.MotifStepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"match_rate\x18\x04 \x01(\x01R\tmatchRate\"\xae\x02\n" +
+	"\x10HarmonizeRequest\x124\n" +
+	"\x06melody\x18\x01 \x01(\v2\x1a.qubit_engine.music.MelodyH\x00R\x06melody\x12\x1d\n" +
+	"\tmidi_data\x18\x02 \x01(\fH\x00R\bmidiData\x12/\n" +
+	"\x05scale\x18\x03 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x04 \x01(\x05R\brootNote\x12\x14\n" +
+	"\x05tempo\x18\x05 \x01(\x01R\x05tempo\x12\x1f\n" +
+	"\vchord_beats\x18\x06 \x01(\x01R\n" +
+	"chordBeats\x12\"\n" +
+	"\rbeats_per_bar\x18\a \x01(\x05R\vbeatsPerBar\x12\x12\n" +
+	"\x04seed\x18\b \x01(\x04R\x04seedB\b\n" +
+	"\x06source\"\xb8\x01\n" +
+	"\rHarmonization\x12/\n" +
+	"\x05score\x18\x01 \x01(\v2\x19.qubit_engine.music.ScoreR\x05score\x12F\n" +
+	"\vprogression\x18\x02 \x01(\v2$.qubit_engine.music.ChordProgressionR\vprogression\x12.\n" +
+	"\x13chord_tone_coverage\x18\x03 \x01(\x01R\x11chordToneCoverage\"\xd9\x01\n" +
+	"\vLiveRequest\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x12?\n" +
+	"\bgenerate\x18\x02 \x01(\v2!.qubit_engine.music.MelodyRequestH\x00R\bgenerate\x124\n" +
+	"\x06melody\x18\x03 \x01(\v2\x1a.qubit_engine.music.MelodyH\x00R\x06melody\x121\n" +
+	"\x05score\x18\x04 \x01(\v2\x19.qubit_engine.music.ScoreH\x00R\x05scoreB\b\n" +
+	"\x06source\"I\n" +
+	"\fMelodyRating\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\"\xaf\x02\n" +
+	"\rEvolveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12:\n" +
+	"\aratings\x18\x02 \x03(\v2 .qubit_engine.music.MelodyRatingR\aratings\x12%\n" +
+	"\x0enum_candidates\x18\x03 \x01(\x05R\rnumCandidates\x12/\n" +
+	"\x05scale\x18\x04 \x01(\x0e2\x19.qubit_engine.music.ScaleR\x05scale\x12\x1b\n" +
+	"\troot_note\x18\x05 \x01(\x05R\brootNote\x12\x1b\n" +
+	"\tnum_notes\x18\x06 \x01(\x05R\bnumNotes\x12\x14\n" +
+	"\x05tempo\x18\a \x01(\x01R\x05tempo\x12!\n" +
+	"\fcustom_scale\x18\b \x01(\tR\vcustomScale\"i\n" +
+	"\x06Genome\x12\x1f\n" +
+	"\vdegree_bias\x18\x01 \x03(\x01R\n" +
+	"degreeBias\x12\x1d\n" +
+	"\n" +
+	"step_boost\x18\x02 \x01(\x01R\tstepBoost\x12\x1f\n" +
+	"\vphase_shift\x18\x03 \x01(\x01R\n" +
+	"phaseShift\"\x83\x01\n" +
+	"\tCandidate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\x06melody\x18\x02 \x01(\v2\x1a.qubit_engine.music.MelodyR\x06melody\x122\n" +
+	"\x06genome\x18\x03 \x01(\v2\x1a.qubit_engine.music.GenomeR\x06genome\"\xca\x01\n" +
+	"\tEvolution\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x01 \x01(\x05R\n" +
+	"generation\x12=\n" +
+	"\n" +
+	"candidates\x18\x02 \x03(\v2\x1d.qubit_engine.music.CandidateR\n" +
+	"candidates\x12=\n" +
+	"\flearned_bias\x18\x03 \x01(\v2\x1a.qubit_engine.music.GenomeR\vlearnedBias\x12\x1f\n" +
+	"\vmean_rating\x18\x04 \x01(\x01R\n" +
+	"meanRating*\xd9\x01\n" +
+	"\x05Scale\x12\x0f\n" +
+	"\vSCALE_MAJOR\x10\x00\x12\x0f\n" +
+	"\vSCALE_MINOR\x10\x01\x12\x10\n" +
+	"\fSCALE_DORIAN\x10\x02\x12\x12\n" +
+	"\x0eSCALE_PHRYGIAN\x10\x03\x12\x10\n" +
+	"\fSCALE_LYDIAN\x10\x04\x12\x14\n" +
+	"\x10SCALE_MIXOLYDIAN\x10\x05\x12\x11\n" +
+	"\rSCALE_AEOLIAN\x10\x06\x12\x11\n" +
+	"\rSCALE_LOCRIAN\x10\a\x12\x14\n" +
+	"\x10SCALE_PENTATONIC\x10\b\x12\x0f\n" +
+	"\vSCALE_BLUES\x10\t\x12\x13\n" +
+	"\x0fSCALE_CHROMATIC\x10\n" +
+	"*~\n" +
+	"\bMoodType\x12\x0e\n" +
+	"\n" +
+	"MOOD_HAPPY\x10\x00\x12\f\n" +
+	"\bMOOD_SAD\x10\x01\x12\x13\n" +
+	"\x0fMOOD_MYSTERIOUS\x10\x02\x12\x12\n" +
+	"\x0eMOOD_ENERGETIC\x10\x03\x12\r\n" +
+	"\tMOOD_CALM\x10\x04\x12\r\n" +
+	"\tMOOD_EPIC\x10\x05\x12\r\n" +
+	"\tMOOD_DARK\x10\x06*p\n" +
+	"\tTrackRole\x12\x10\n" +
+	"\fTRACK_MELODY\x10\x00\x12\x18\n" +
+	"\x14TRACK_COUNTER_MELODY\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TRACK_BASS\x10\x02\x12\x14\n" +
+	"\x10TRACK_PERCUSSION\x10\x03\x12\x11\n" +
+	"\rTRACK_HARMONY\x10\x042\x8d\f\n" +
+	"\fQuantumMusic\x12O\n" +
+	"\x0eGenerateMelody\x12!.qubit_engine.music.MelodyRequest\x1a\x1a.qubit_engine.music.Melody\x12\\\n" +
+	"\x14GenerateMelodyStream\x12!.qubit_engine.music.MelodyRequest\x1a\x1f.qubit_engine.music.QuantumNote0\x01\x12Y\n" +
+	"\x0eGetStateVector\x12&.qubit_engine.music.StateVectorRequest\x1a\x1f.qubit_engine.music.StateVector\x12M\n" +
+	"\n" +
+	"ExportMIDI\x12!.qubit_engine.music.ExportRequest\x1a\x1c.qubit_engine.music.MIDIFile\x12M\n" +
+	"\tExportWAV\x12!.qubit_engine.music.ExportRequest\x1a\x1d.qubit_engine.music.AudioFile\x12V\n" +
+	"\x0eGenerateRhythm\x12!.qubit_engine.music.RhythmRequest\x1a!.qubit_engine.music.RhythmPattern\x12U\n" +
+	"\rGenerateDrums\x12!.qubit_engine.music.RhythmRequest\x1a!.qubit_engine.music.RhythmPattern\x12b\n" +
+	"\x18GenerateChordProgression\x12 .qubit_engine.music.ChordRequest\x1a$.qubit_engine.music.ChordProgression\x12^\n" +
+	"\fComposeTrack\x12&.qubit_engine.music.CompositionRequest\x1a$.qubit_engine.music.CompositionEvent0\x01\x12K\n" +
+	"\fComposeScore\x12 .qubit_engine.music.ScoreRequest\x1a\x19.qubit_engine.music.Score\x12I\n" +
+	"\fGenerateDuet\x12\x1f.qubit_engine.music.DuetRequest\x1a\x18.qubit_engine.music.Duet\x12Y\n" +
+	"\vTrainMarkov\x12).qubit_engine.music.MarkovTrainingRequest\x1a\x1f.qubit_engine.music.MarkovModel\x12S\n" +
+	"\rRegisterScale\x12#.qubit_engine.music.ScaleDefinition\x1a\x1d.qubit_engine.music.ScaleInfo\x12R\n" +
+	"\n" +
+	"ListScales\x12%.qubit_engine.music.ListScalesRequest\x1a\x1d.qubit_engine.music.ScaleList\x12N\n" +
+	"\bPlayLive\x12\x1f.qubit_engine.music.LiveRequest\x1a\x1f.qubit_engine.music.QuantumNote0\x01\x12P\n" +
+	"\fEvolveMelody\x12!.qubit_engine.music.EvolveRequest\x1a\x1d.qubit_engine.music.Evolution\x12Z\n" +
+	"\x0fHarmonizeMelody\x12$.qubit_engine.music.HarmonizeRequest\x1a!.qubit_engine.music.Harmonization\x12H\n" +
+	"\tFindMotif\x12 .qubit_engine.music.MotifRequest\x1a\x19.qubit_engine.music.MotifB>Z<github.com/perclft/QubitEngine/modules/music/generated/musicb\x06proto3"
+
+var (
+	file_music_proto_rawDescOnce sync.Once
+	file_music_proto_rawDescData []byte
+)
+
+func file_music_proto_rawDescGZIP() []byte {
+	file_music_proto_rawDescOnce.Do(func() {
+		file_music_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_music_proto_rawDesc), len(file_music_proto_rawDesc)))
+	})
+	return file_music_proto_rawDescData
+}
+
+var file_music_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_music_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_music_proto_goTypes = []any{
+	(Scale)(0),                    // 0: qubit_engine.music.Scale
+	(MoodType)(0),                 // 1: qubit_engine.music.MoodType
+	(TrackRole)(0),                // 2: qubit_engine.music.TrackRole
+	(*Note)(nil),                  // 3: qubit_engine.music.Note
+	(*MelodyRequest)(nil),         // 4: qubit_engine.music.MelodyRequest
+	(*QuantumNote)(nil),           // 5: qubit_engine.music.QuantumNote
+	(*Melody)(nil),                // 6: qubit_engine.music.Melody
+	(*Ratio)(nil),                 // 7: qubit_engine.music.Ratio
+	(*ScaleDefinition)(nil),       // 8: qubit_engine.music.ScaleDefinition
+	(*ScaleInfo)(nil),             // 9: qubit_engine.music.ScaleInfo
+	(*ListScalesRequest)(nil),     // 10: qubit_engine.music.ListScalesRequest
+	(*ScaleList)(nil),             // 11: qubit_engine.music.ScaleList
+	(*MarkovTrainingRequest)(nil), // 12: qubit_engine.music.MarkovTrainingRequest
+	(*MarkovModel)(nil),           // 13: qubit_engine.music.MarkovModel
+	(*StateVectorRequest)(nil),    // 14: qubit_engine.music.StateVectorRequest
+	(*Amplitude)(nil),             // 15: qubit_engine.music.Amplitude
+	(*StateVector)(nil),           // 16: qubit_engine.music.StateVector
+	(*ChordRequest)(nil),          // 17: qubit_engine.music.ChordRequest
+	(*Chord)(nil),                 // 18: qubit_engine.music.Chord
+	(*ChordProgression)(nil),      // 19: qubit_engine.music.ChordProgression
+	(*CompositionRequest)(nil),    // 20: qubit_engine.music.CompositionRequest
+	(*CompositionEvent)(nil),      // 21: qubit_engine.music.CompositionEvent
+	(*ScoreRequest)(nil),          // 22: qubit_engine.music.ScoreRequest
+	(*Track)(nil),                 // 23: qubit_engine.music.Track
+	(*Score)(nil),                 // 24: qubit_engine.music.Score
+	(*DuetRequest)(nil),           // 25: qubit_engine.music.DuetRequest
+	(*Duet)(nil),                  // 26: qubit_engine.music.Duet
+	(*ExportRequest)(nil),         // 27: qubit_engine.music.ExportRequest
+	(*MIDIFile)(nil),              // 28: qubit_engine.music.MIDIFile
+	(*AudioFile)(nil),             // 29: qubit_engine.music.AudioFile
+	(*RhythmRequest)(nil),         // 30: qubit_engine.music.RhythmRequest
+	(*RhythmPattern)(nil),         // 31: qubit_engine.music.RhythmPattern
+	(*BeatEvent)(nil),             // 32: qubit_engine.music.BeatEvent
+	(*MotifRequest)(nil),          // 33: qubit_engine.music.MotifRequest
+	(*MotifStep)(nil),             // 34: qubit_engine.music.MotifStep
+	(*Motif)(nil),                 // 35: qubit_engine.music.Motif
+	(*HarmonizeRequest)(nil),      // 36: qubit_engine.music.HarmonizeRequest
+	(*Harmonization)(nil),         // 37: qubit_engine.music.Harmonization
+	(*LiveRequest)(nil),           // 38: qubit_engine.music.LiveRequest
+	(*MelodyRating)(nil),          // 39: qubit_engine.music.MelodyRating
+	(*EvolveRequest)(nil),         // 40: qubit_engine.music.EvolveRequest
+	(*Genome)(nil),                // 41: qubit_engine.music.Genome
+	(*Candidate)(nil),             // 42: qubit_engine.music.Candidate
+	(*Evolution)(nil),             // 43: qubit_engine.music.Evolution
+}
+var file_music_proto_depIdxs = []int32{
+	0,  // 0: qubit_engine.music.MelodyRequest.scale:type_name -> qubit_engine.music.Scale
+	1,  // 1: qubit_engine.music.MelodyRequest.mood:type_name -> qubit_engine.music.MoodType
+	5,  // 2: qubit_engine.music.Melody.notes:type_name -> qubit_engine.music.QuantumNote
+	0,  // 3: qubit_engine.music.Melody.scale:type_name -> qubit_engine.music.Scale
+	7,  // 4: qubit_engine.music.ScaleDefinition.ratios:type_name -> qubit_engine.music.Ratio
+	9,  // 5: qubit_engine.music.ScaleList.scales:type_name -> qubit_engine.music.ScaleInfo
+	0,  // 6: qubit_engine.music.MarkovTrainingRequest.scale:type_name -> qubit_engine.music.Scale
+	0,  // 7: qubit_engine.music.MarkovModel.scale:type_name -> qubit_engine.music.Scale
+	15, // 8: qubit_engine.music.StateVector.amplitudes:type_name -> qubit_engine.music.Amplitude
+	0,  // 9: qubit_engine.music.ChordRequest.scale:type_name -> qubit_engine.music.Scale
+	1,  // 10: qubit_engine.music.ChordRequest.mood:type_name -> qubit_engine.music.MoodType
+	18, // 11: qubit_engine.music.ChordProgression.chords:type_name -> qubit_engine.music.Chord
+	1,  // 12: qubit_engine.music.CompositionRequest.mood:type_name -> qubit_engine.music.MoodType
+	3,  // 13: qubit_engine.music.CompositionEvent.note:type_name -> qubit_engine.music.Note
+	0,  // 14: qubit_engine.music.ScoreRequest.scale:type_name -> qubit_engine.music.Scale
+	2,  // 15: qubit_engine.music.ScoreRequest.tracks:type_name -> qubit_engine.music.TrackRole
+	2,  // 16: qubit_engine.music.Track.role:type_name -> qubit_engine.music.TrackRole
+	5,  // 17: qubit_engine.music.Track.notes:type_name -> qubit_engine.music.QuantumNote
+	23, // 18: qubit_engine.music.Score.tracks:type_name -> qubit_engine.music.Track
+	0,  // 19: qubit_engine.music.Score.scale:type_name -> qubit_engine.music.Scale
+	0,  // 20: qubit_engine.music.DuetRequest.scale:type_name -> qubit_engine.music.Scale
+	24, // 21: qubit_engine.music.Duet.score:type_name -> qubit_engine.music.Score
+	6,  // 22: qubit_engine.music.ExportRequest.melody:type_name -> qubit_engine.music.Melody
+	19, // 23: qubit_engine.music.ExportRequest.chords:type_name -> qubit_engine.music.ChordProgression
+	24, // 24: qubit_engine.music.ExportRequest.score:type_name -> qubit_engine.music.Score
+	31, // 25: qubit_engine.music.ExportRequest.rhythm:type_name -> qubit_engine.music.RhythmPattern
+	32, // 26: qubit_engine.music.RhythmPattern.events:type_name -> qubit_engine.music.BeatEvent
+	0,  // 27: qubit_engine.music.MotifRequest.scale:type_name -> qubit_engine.music.Scale
+	6,  // 28: qubit_engine.music.Motif.melody:type_name -> qubit_engine.music.Melody
+	34, // 29: qubit_engine.music.Motif.steps:type_name -> qubit_engine.music.MotifStep
+	6,  // 30: qubit_engine.music.HarmonizeRequest.melody:type_name -> qubit_engine.music.Melody
+	0,  // 31: qubit_engine.music.HarmonizeRequest.scale:type_name -> qubit_engine.music.Scale
+	24, // 32: qubit_engine.music.Harmonization.score:type_name -> qubit_engine.music.Score
+	19, // 33: qubit_engine.music.Harmonization.progression:type_name -> qubit_engine.music.ChordProgression
+	4,  // 34: qubit_engine.music.LiveRequest.generate:type_name -> qubit_engine.music.MelodyRequest
+	6,  // 35: qubit_engine.music.LiveRequest.melody:type_name -> qubit_engine.music.Melody
+	24, // 36: qubit_engine.music.LiveRequest.score:type_name -> qubit_engine.music.Score
+	39, // 37: qubit_engine.music.EvolveRequest.ratings:type_name -> qubit_engine.music.MelodyRating
+	0,  // 38: qubit_engine.music.EvolveRequest.scale:type_name -> qubit_engine.music.Scale
+	6,  // 39: qubit_engine.music.Candidate.melody:type_name -> qubit_engine.music.Melody
+	41, // 40: qubit_engine.music.Candidate.genome:type_name -> qubit_engine.music.Genome
+	42, // 41: qubit_engine.music.Evolution.candidates:type_name -> qubit_engine.music.Candidate
+	41, // 42: qubit_engine.music.Evolution.learned_bias:type_name -> qubit_engine.music.Genome
+	4,  // 43: qubit_engine.music.QuantumMusic.GenerateMelody:input_type -> qubit_engine.music.MelodyRequest
+	4,  // 44: qubit_engine.music.QuantumMusic.GenerateMelodyStream:input_type -> qubit_engine.music.MelodyRequest
+	14, // 45: qubit_engine.music.QuantumMusic.GetStateVector:input_type -> qubit_engine.music.StateVectorRequest
+	27, // 46: qubit_engine.music.QuantumMusic.ExportMIDI:input_type -> qubit_engine.music.ExportRequest
+	27, // 47: qubit_engine.music.QuantumMusic.ExportWAV:input_type -> qubit_engine.music.ExportRequest
+	30, // 48: qubit_engine.music.QuantumMusic.GenerateRhythm:input_type -> qubit_engine.music.RhythmRequest
+	30, // 49: qubit_engine.music.QuantumMusic.GenerateDrums:input_type -> qubit_engine.music.RhythmRequest
+	17, // 50: qubit_engine.music.QuantumMusic.GenerateChordProgression:input_type -> qubit_engine.music.ChordRequest
+	20, // 51: qubit_engine.music.QuantumMusic.ComposeTrack:input_type -> qubit_engine.music.CompositionRequest
+	22, // 52: qubit_engine.music.QuantumMusic.ComposeScore:input_type -> qubit_engine.music.ScoreRequest
+	25, // 53: qubit_engine.music.QuantumMusic.GenerateDuet:input_type -> qubit_engine.music.DuetRequest
+	12, // 54: qubit_engine.music.QuantumMusic.TrainMarkov:input_type -> qubit_engine.music.MarkovTrainingRequest
+	8,  // 55: qubit_engine.music.QuantumMusic.RegisterScale:input_type -> qubit_engine.music.ScaleDefinition
+	10, // 56: qubit_engine.music.QuantumMusic.ListScales:input_type -> qubit_engine.music.ListScalesRequest
+	38, // 57: qubit_engine.music.QuantumMusic.PlayLive:input_type -> qubit_engine.music.LiveRequest
+	40, // 58: qubit_engine.music.QuantumMusic.EvolveMelody:input_type -> qubit_engine.music.EvolveRequest
+	36, // 59: qubit_engine.music.QuantumMusic.HarmonizeMelody:input_type -> qubit_engine.music.HarmonizeRequest
+	33, // 60: qubit_engine.music.QuantumMusic.FindMotif:input_type -> qubit_engine.music.MotifRequest
+	6,  // 61: qubit_engine.music.QuantumMusic.GenerateMelody:output_type -> qubit_engine.music.Melody
+	5,  // 62: qubit_engine.music.QuantumMusic.GenerateMelodyStream:output_type -> qubit_engine.music.QuantumNote
+	16, // 63: qubit_engine.music.QuantumMusic.GetStateVector:output_type -> qubit_engine.music.StateVector
+	28, // 64: qubit_engine.music.QuantumMusic.ExportMIDI:output_type -> qubit_engine.music.MIDIFile
+	29, // 65: qubit_engine.music.QuantumMusic.ExportWAV:output_type -> qubit_engine.music.AudioFile
+	31, // 66: qubit_engine.music.QuantumMusic.GenerateRhythm:output_type -> qubit_engine.music.RhythmPattern
+	31, // 67: qubit_engine.music.QuantumMusic.GenerateDrums:output_type -> qubit_engine.music.RhythmPattern
+	19, // 68: qubit_engine.music.QuantumMusic.GenerateChordProgression:output_type -> qubit_engine.music.ChordProgression
+	21, // 69: qubit_engine.music.QuantumMusic.ComposeTrack:output_type -> qubit_engine.music.CompositionEvent
+	24, // 70: qubit_engine.music.QuantumMusic.ComposeScore:output_type -> qubit_engine.music.Score
+	26, // 71: qubit_engine.music.QuantumMusic.GenerateDuet:output_type -> qubit_engine.music.Duet
+	13, // 72: qubit_engine.music.QuantumMusic.TrainMarkov:output_type -> qubit_engine.music.MarkovModel
+	9,  // 73: qubit_engine.music.QuantumMusic.RegisterScale:output_type -> qubit_engine.music.ScaleInfo
+	11, // 74: qubit_engine.music.QuantumMusic.ListScales:output_type -> qubit_engine.music.ScaleList
+	5,  // 75: qubit_engine.music.QuantumMusic.PlayLive:output_type -> qubit_engine.music.QuantumNote
+	43, // 76: qubit_engine.music.QuantumMusic.EvolveMelody:output_type -> qubit_engine.music.Evolution
+	37, // 77: qubit_engine.music.QuantumMusic.HarmonizeMelody:output_type -> qubit_engine.music.Harmonization
+	35, // 78: qubit_engine.music.QuantumMusic.FindMotif:output_type -> qubit_engine.music.Motif
+	61, // [61:79] is the sub-list for method output_type
+	43, // [43:61] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_music_proto_init() }
+func file_music_proto_init() {
+	if File_music_proto != nil {
+		return
+	}
+	file_music_proto_msgTypes[24].OneofWrappers = []any{
+		(*ExportRequest_Melody)(nil),
+		(*ExportRequest_Chords)(nil),
+		(*ExportRequest_Score)(nil),
+		(*ExportRequest_Rhythm)(nil),
+	}
+	file_music_proto_msgTypes[33].OneofWrappers = []any{
+		(*HarmonizeRequest_Melody)(nil),
+		(*HarmonizeRequest_MidiData)(nil),
+	}
+	file_music_proto_msgTypes[35].OneofWrappers = []any{
+		(*LiveRequest_Generate)(nil),
+		(*LiveRequest_Melody)(nil),
+		(*LiveRequest_Score)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_music_proto_rawDesc), len(file_music_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_music_proto_goTypes,
+		DependencyIndexes: file_music_proto_depIdxs,
+		EnumInfos:         file_music_proto_enumTypes,
+		MessageInfos:      file_music_proto_msgTypes,
+	}.Build()
+	File_music_proto = out.File
+	file_music_proto_goTypes = nil
+	file_music_proto_depIdxs = nil
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	gaming "github.com/perclft/QubitEngine/bot/discord/generated/gaming"
+)
+
+// ------------------------------------------------------------------
+// Oracle History (the Gaming Module remembers each user's consultations)
+// ------------------------------------------------------------------
+
+const (
+	defaultHistoryCount = 10
+	maxHistoryCount     = 20
+	// trendThreshold is how far confidence must move to count as a trend
+	trendThreshold = 0.05
+)
+
+// History fetches a user's recent consultations from the Gaming Module.
+// Answers given in degraded mode never reached it, so are not included.
+func (c *OracleClient) History(userID string, count int) (*gaming.OracleHistory, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("no gaming module connected")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), oracleTimeout)
+	defer cancel()
+	return c.client.GetOracleHistory(ctx, &gaming.OracleHistoryRequest{
+		UserId: userID,
+		Limit:  int32(count),
+	})
+}
+
+var minHistoryCount = 1.0
+
+var historyCommand = &discordgo.ApplicationCommand{
+	Name:        "history",
+	Description: "Your recent questions to the Oracle (only you can see them)",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        "count",
+			Description: "How many consultations to show (default: 10)",
+			Required:    false,
+			MinValue:    &minHistoryCount,
+			MaxValue:    maxHistoryCount,
+		},
+	},
+}
+
+func (b *Bot) handleHistoryCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Questions can be personal, so only the asker sees their history
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	count := defaultHistoryCount
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "count" {
+			count = min(max(int(opt.IntValue()), 1), maxHistoryCount)
+		}
+	}
+
+	history, err := b.oracleClient.History(user.ID, count)
+	if err != nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Oracle history is unavailable: " + err.Error()),
+		})
+		return
+	}
+	if history.Total == 0 {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("🎱 You haven't consulted the Oracle yet. Try /8ball!"),
+		})
+		return
+	}
+
+	embed := b.createHistoryEmbed(history, user)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+}
+
+// fateTrend describes which way a user's confidence is heading
+func fateTrend(trend float64) string {
+	switch {
+	case trend >= trendThreshold:
+		return fmt.Sprintf("📈 Rising (+%.0f%%)", trend*100)
+	case trend <= -trendThreshold:
+		return fmt.Sprintf("📉 Falling (%.0f%%)", trend*100)
+	}
+	return "➡️ Steady"
+}
+
+func (b *Bot) createHistoryEmbed(history *gaming.OracleHistory, user *discordgo.User) *discordgo.MessageEmbed {
+	// One line per consultation, newest first
+	lines := make([]string, len(history.Consultations))
+	for n, c := range history.Consultations {
+		question := c.Question
+		if r := []rune(question); len(r) > 80 {
+			question = string(r[:79]) + "…"
+		}
+		lines[n] = fmt.Sprintf("<t:%d:R> **%s**\n→ %s · %.0f%%",
+			c.Response.Timestamp, question, c.Response.Prophecy, c.Response.Confidence*100)
+	}
+
+	total := float64(history.Total)
+	return &discordgo.MessageEmbed{
+		Title:       "📜 Your Oracle History",
+		Description: strings.Join(lines, "\n\n"),
+		Color:       0x9B59B6,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "🔮 Fate Trend",
+				Value:  fateTrend(history.Trend),
+				Inline: true,
+			},
+			{
+				Name:   "📊 Average Confidence",
+				Value:  fmt.Sprintf("%.0f%%", history.AverageConfidence*100),
+				Inline: true,
+			},
+			{
+				Name: "⚖️ Outcomes",
+				Value: fmt.Sprintf("✅ %.0f%% favorable · 🤔 %.0f%% uncertain · ❌ %.0f%% unfavorable",
+					float64(history.Favorable)/total*100,
+					float64(history.Uncertain)/total*100,
+					float64(history.Unfavorable)/total*100),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text:    fmt.Sprintf("Showing %d of %d remembered consultations • Only you can see this", len(lines), history.Total),
+			IconURL: user.AvatarURL("32"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
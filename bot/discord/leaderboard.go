@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	edu "github.com/perclft/QubitEngine/bot/discord/generated/education"
+	gaming "github.com/perclft/QubitEngine/bot/discord/generated/gaming"
+)
+
+// ------------------------------------------------------------------
+// Guild Leaderboards (standings live in the Gaming and Education Modules)
+// ------------------------------------------------------------------
+
+// leaderboardSize is how many players each board shows
+const leaderboardSize = 5
+
+// Leaderboard fetches a guild's standings on the Gaming Module
+func (c *OracleClient) Leaderboard(guildID string, metric gaming.BoardMetric, previousWeek bool) (*gaming.Leaderboard, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("no gaming module connected")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), oracleTimeout)
+	defer cancel()
+	return c.client.GetLeaderboard(ctx, &gaming.LeaderboardRequest{
+		GroupId:      guildID,
+		Metric:       metric,
+		Limit:        leaderboardSize,
+		PreviousWeek: previousWeek,
+	})
+}
+
+// QuizLeaderboard fetches quiz points across every topic for the
+// learners who have quizzed in a guild
+func (c *EducationClient) QuizLeaderboard(guildID string, previousWeek bool) (*edu.QuizLeaderboard, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return c.client.GetQuizLeaderboard(ctx, &edu.QuizLeaderboardRequest{
+		GroupId:      guildID,
+		Limit:        leaderboardSize,
+		PreviousWeek: previousWeek,
+	})
+}
+
+var leaderboardCommand = &discordgo.ApplicationCommand{
+	Name:        "leaderboard",
+	Description: "This server's weekly quantum standings",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionBoolean,
+			Name:        "last_week",
+			Description: "Show last week's final standings",
+			Required:    false,
+		},
+	},
+}
+
+func (b *Bot) handleLeaderboardCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "❌ Leaderboards are kept per server; try /leaderboard in one",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		return
+	}
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+
+	previous := false
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "last_week" {
+			previous = opt.BoolValue()
+		}
+	}
+
+	title := "🏆 This Week's Quantum Leaderboard"
+	if previous {
+		title = "🏆 Last Week's Quantum Leaderboard"
+	}
+	embed := b.createLeaderboardEmbed(title, i.GuildID, previous)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+}
+
+// medal is how a rank is shown
+func medal(rank int32) string {
+	switch rank {
+	case 1:
+		return "🥇"
+	case 2:
+		return "🥈"
+	case 3:
+		return "🥉"
+	}
+	return fmt.Sprintf("`%d.`", rank)
+}
+
+// createLeaderboardEmbed gathers a guild's boards into one embed; a board
+// whose module is unreachable says so rather than failing the rest
+func (b *Bot) createLeaderboardEmbed(title, guildID string, previousWeek bool) *discordgo.MessageEmbed {
+	board := func(name, empty string, lines func() ([]string, error)) *discordgo.MessageEmbedField {
+		value := empty
+		switch l, err := lines(); {
+		case err != nil:
+			value = "⚠️ Unavailable: " + err.Error()
+		case len(l) > 0:
+			value = strings.Join(l, "\n")
+		}
+		return &discordgo.MessageEmbedField{Name: name, Value: value}
+	}
+
+	var week string
+	var resets int64
+	oracle := board("🎱 Oracle Consultations", "No one has consulted the Oracle yet", func() ([]string, error) {
+		lb, err := b.oracleClient.Leaderboard(guildID, gaming.BoardMetric_BOARD_ORACLE, previousWeek)
+		if err != nil {
+			return nil, err
+		}
+		week, resets = lb.Week, lb.ResetsAt
+		var lines []string
+		for _, e := range lb.Entries {
+			lines = append(lines, fmt.Sprintf("%s <@%s> — %d", medal(e.Rank), e.UserId, e.OracleConsultations))
+		}
+		return lines, nil
+	})
+	quiz := board("📚 Quiz Points", "No quizzes taken yet", func() ([]string, error) {
+		if b.educationClient == nil {
+			return nil, fmt.Errorf("no education module configured")
+		}
+		lb, err := b.educationClient.QuizLeaderboard(guildID, previousWeek)
+		if err != nil {
+			return nil, err
+		}
+		week, resets = lb.Week, lb.ResetsAt
+		var lines []string
+		for _, e := range lb.Entries {
+			lines = append(lines, fmt.Sprintf("%s <@%s> — %d points", medal(e.Rank), e.UserId, e.Points))
+		}
+		return lines, nil
+	})
+	luck := board("🎲 Dice Luck", "No one has rolled enough dice yet", func() ([]string, error) {
+		lb, err := b.oracleClient.Leaderboard(guildID, gaming.BoardMetric_BOARD_DICE_LUCK, previousWeek)
+		if err != nil {
+			return nil, err
+		}
+		var lines []string
+		for _, e := range lb.Entries {
+			lines = append(lines, fmt.Sprintf("%s <@%s> — %.1f luck over %d dice", medal(e.Rank), e.UserId, e.LuckIndex, e.DiceRolled))
+		}
+		return lines, nil
+	})
+
+	footer := "Luck 50 is average • Boards reset Mondays at 00:00 UTC"
+	if week != "" {
+		footer = week + " • " + footer
+	}
+	description := ""
+	if !previousWeek && resets > 0 {
+		description = fmt.Sprintf("Resets <t:%d:R>", resets)
+	}
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0xF1C40F,
+		Fields:      []*discordgo.MessageEmbedField{oracle, quiz, luck},
+		Footer:      &discordgo.MessageEmbedFooter{Text: footer},
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+}
+
+// ------------------------------------------------------------------
+// Weekly reset announcements
+// ------------------------------------------------------------------
+
+// leaderboardWeek names the ISO week t falls in, as the modules do
+func leaderboardWeek(t time.Time) string {
+	year, week := t.UTC().ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// announceResets posts last week's final standings to every guild's
+// system channel when the boards reset, checking every interval until
+// ctx ends. A restart in a new week does not announce the old one.
+func (b *Bot) announceResets(ctx context.Context, interval time.Duration) {
+	week := leaderboardWeek(time.Now())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if current := leaderboardWeek(now); current != week {
+				week = current
+				b.announceReset()
+			}
+		}
+	}
+}
+
+func (b *Bot) announceReset() {
+	for _, guild := range b.session.State.Guilds {
+		if guild.SystemChannelID == "" {
+			continue
+		}
+		embed := b.createLeaderboardEmbed("🏁 The Weekly Leaderboard Has Reset", guild.ID, true)
+		embed.Description = "Here are last week's final standings. A new week starts now: good luck!"
+		if _, err := b.session.ChannelMessageSendEmbed(guild.SystemChannelID, embed); err != nil {
+			log.Printf("⚠️ Could not announce the leaderboard reset in %s: %v", guild.Name, err)
+		}
+	}
+}
@@ -8,24 +8,41 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"math/rand"
 	"os"
 	"os/signal"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	gaming "github.com/perclft/QubitEngine/bot/discord/generated/gaming"
 )
 
 // ------------------------------------------------------------------
 // Oracle Client (talks to Gaming Module)
 // ------------------------------------------------------------------
 
+const (
+	oracleTimeout = 5 * time.Second
+	// oracleHealthInterval is how often the Gaming Module's health is checked
+	oracleHealthInterval = 15 * time.Second
+)
+
+// OracleClient asks the Gaming Module's Oracle. While the module is
+// unreachable or unhealthy it answers locally instead and marks its
+// responses degraded, so users can tell a real measurement from a guess.
 type OracleClient struct {
 	conn       *grpc.ClientConn
+	client     gaming.QuantumGamingClient
+	health     healthpb.HealthClient
 	gamingAddr string
+	healthy    atomic.Bool
 }
 
 func NewOracleClient(gamingAddr string) (*OracleClient, error) {
@@ -34,10 +51,15 @@ func NewOracleClient(gamingAddr string) (*OracleClient, error) {
 		return nil, fmt.Errorf("failed to connect to gaming module: %w", err)
 	}
 
-	return &OracleClient{
+	c := &OracleClient{
 		conn:       conn,
+		client:     gaming.NewQuantumGamingClient(conn),
+		health:     healthpb.NewHealthClient(conn),
 		gamingAddr: gamingAddr,
-	}, nil
+	}
+	// Assume the module is up until a check or call says otherwise
+	c.healthy.Store(true)
+	return c, nil
 }
 
 func (c *OracleClient) Close() error {
@@ -47,68 +69,112 @@ func (c *OracleClient) Close() error {
 	return nil
 }
 
-// AskOracle sends a question to the Gaming Module
-// For now, this is a simplified version that simulates the Oracle response
-func (c *OracleClient) AskOracle(question, userID string, mood int) (*OracleResponse, error) {
-	// TODO: Use generated gRPC client to call Gaming.AskOracle
-	// For now, simulate locally to demonstrate functionality
-
-	prophecies := map[int][]string{
-		0: { // Mysterious
-			"The quantum realm whispers... yes ✨",
-			"Signs point to affirmative 🌙",
-			"The stars align in your favor ⭐",
-			"Uncertain. Ask again when Mercury isn't retrograde 🌑",
-			"The cosmos cannot reveal this 🔮",
-			"Dark clouds obscure the answer ☁️",
-			"The spirits say... unlikely 👻",
-			"Absolutely not. The void has spoken 🕳️",
-		},
-		1: { // Sarcastic
-			"Obviously yes, did you even need to ask? 🙄",
-			"Yeah, sure, whatever 💅",
-			"I guess... if you're lucky 🍀",
-			"Ugh, try again later 😒",
-			"I literally cannot even 💀",
-			"Not a chance, buddy 🙃",
-			"That's a hard no from me 🚫",
-			"Are you kidding? No 😂",
-		},
-		2: { // Philosophical
-			"In the infinite multiverse, this is true 🌌",
-			"The wave function collapsed favorably 〰️",
-			"Probability favors this outcome 📊",
-			"Schrödinger would say both yes and no 🐱",
-			"Some truths transcend binary answers ∞",
-			"The universe gently suggests otherwise 🌍",
-			"Entropy increases against this outcome 🔥",
-			"In no timeline does this occur ⏰",
-		},
-		3: { // Chaotic
-			"ABSOLUTELY! *explodes* 💥",
-			"YES! But also maybe no? YES! 🎭",
-			"The dice gods approve 🎲🎲🎲",
-			"ERROR 404: FATE NOT FOUND 🤖",
-			"¯\\_(ツ)_/¯ ¯\\_(ツ)_/¯ ¯\\_(ツ)_/¯",
-			"NO! And your question was bad! 😤",
-			"lol no. also lmao. also no. 💀",
-			"THE VOID CONSUMES YOUR HOPES 🕳️",
-		},
+// watch checks the Gaming Module's health every interval until ctx ends
+func (c *OracleClient) watch(ctx context.Context, interval time.Duration) {
+	if c.conn == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *OracleClient) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, oracleTimeout)
+	defer cancel()
+	resp, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{
+		Service: gaming.QuantumGaming_ServiceDesc.ServiceName,
+	})
+	serving := err == nil && resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
+	if serving {
+		c.setHealthy(true, nil)
+		return
+	}
+	if err == nil {
+		err = fmt.Errorf("status %s", resp.GetStatus())
+	}
+	c.setHealthy(false, err)
+	// Retry the connection now rather than at the end of its backoff
+	c.conn.ResetConnectBackoff()
+}
+
+// setHealthy records the module's health, logging when it changes
+func (c *OracleClient) setHealthy(healthy bool, err error) {
+	if c.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		log.Printf("✅ Gaming module at %s is back; the Oracle is quantum again", c.gamingAddr)
+	} else {
+		log.Printf("⚠️ Gaming module at %s is unavailable (%v); the Oracle is in degraded mode", c.gamingAddr, err)
 	}
+}
 
-	// Simulate 3-qubit measurement
-	outcome := int(time.Now().UnixNano() % 8)
-	moodIndex := mood % 4
+// AskOracle sends a question to the Gaming Module, falling back to the
+// local Oracle while the module is unavailable. Questions asked in a
+// guild count toward its leaderboard.
+func (c *OracleClient) AskOracle(question, userID, guildID string, mood int) (*OracleResponse, error) {
+	if c.client == nil || !c.healthy.Load() {
+		return localOracle(), nil
+	}
 
-	confidence := []float64{0.95, 0.85, 0.75, 0.50, 0.40, 0.35, 0.25, 0.15}[outcome]
+	ctx, cancel := context.WithTimeout(context.Background(), oracleTimeout)
+	defer cancel()
+	resp, err := c.client.AskOracle(ctx, &gaming.OracleRequest{
+		Question: question,
+		UserId:   userID,
+		Mood:     gaming.OracleMood(mood),
+		GroupId:  guildID,
+	})
+	if err != nil {
+		c.setHealthy(false, err)
+		return localOracle(), nil
+	}
 
 	return &OracleResponse{
-		Prophecy:     prophecies[moodIndex][outcome],
+		Prophecy:     resp.GetProphecy(),
+		OutcomeIndex: int(resp.GetOutcomeIndex()),
+		Confidence:   resp.GetConfidence(),
+		QuantumState: resp.GetQuantumState(),
+		Timestamp:    resp.GetTimestamp(),
+		FromCache:    resp.GetFromCache(),
+	}, nil
+}
+
+// Degraded-mode prophecies, from most to least favourable; moods are the
+// Gaming Module's to play
+var localProphecies = []string{
+	"It is certain ✨",
+	"Signs point to yes 🌙",
+	"Outlook good ⭐",
+	"Reply hazy, try again 🌑",
+	"Cannot predict now 🔮",
+	"Outlook not so good ☁️",
+	"Very doubtful 👻",
+	"My answer is no 🕳️",
+}
+
+var localConfidence = []float64{0.95, 0.85, 0.75, 0.50, 0.40, 0.35, 0.25, 0.15}
+
+// localOracle answers without the Gaming Module, from a classical random
+// number rather than a measured qubit register
+func localOracle() *OracleResponse {
+	outcome := rand.Intn(len(localProphecies))
+	return &OracleResponse{
+		Prophecy:     localProphecies[outcome],
 		OutcomeIndex: outcome,
-		Confidence:   confidence,
-		QuantumState: fmt.Sprintf("θ=%.3f, φ=%.3f", float64(outcome)*0.449, float64(outcome)*0.785),
+		Confidence:   localConfidence[outcome],
+		QuantumState: "classical fallback",
 		Timestamp:    time.Now().Unix(),
-	}, nil
+		Degraded:     true,
+	}
 }
 
 type OracleResponse struct {
@@ -117,6 +183,9 @@ type OracleResponse struct {
 	Confidence   float64
 	QuantumState string
 	Timestamp    int64
+	FromCache    bool
+	// Degraded responses come from the local fallback, not the Gaming Module
+	Degraded bool
 }
 
 // ------------------------------------------------------------------
@@ -124,19 +193,27 @@ type OracleResponse struct {
 // ------------------------------------------------------------------
 
 type Bot struct {
-	session      *discordgo.Session
-	oracleClient *OracleClient
+	session         *discordgo.Session
+	oracleClient    *OracleClient
+	educationClient *EducationClient // nil when badges are unavailable
+	musicClient     *MusicClient     // nil when melodies are unavailable
+	quizzes         *quizBook
+	guilds          *guildStore
 }
 
-func NewBot(token string, oracleClient *OracleClient) (*Bot, error) {
+func NewBot(token string, oracleClient *OracleClient, educationClient *EducationClient, musicClient *MusicClient, guilds *guildStore) (*Bot, error) {
 	session, err := discordgo.New("Bot " + token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Discord session: %w", err)
 	}
 
 	bot := &Bot{
-		session:      session,
-		oracleClient: oracleClient,
+		session:         session,
+		oracleClient:    oracleClient,
+		educationClient: educationClient,
+		musicClient:     musicClient,
+		quizzes:         newQuizBook(),
+		guilds:          guilds,
 	}
 
 	// Register handlers
@@ -169,12 +246,7 @@ func (b *Bot) Start() error {
 					Name:        "mood",
 					Description: "The Oracle's mood",
 					Required:    false,
-					Choices: []*discordgo.ApplicationCommandOptionChoice{
-						{Name: "🔮 Mysterious", Value: 0},
-						{Name: "🙄 Sarcastic", Value: 1},
-						{Name: "🌌 Philosophical", Value: 2},
-						{Name: "💥 Chaotic", Value: 3},
-					},
+					Choices:     oracleMoodChoices(),
 				},
 			},
 		},
@@ -190,6 +262,14 @@ func (b *Bot) Start() error {
 				},
 			},
 		},
+		badgesCommand,
+		rollCommand,
+		flipCommand,
+		melodyCommand,
+		quizCommand,
+		leaderboardCommand,
+		configCommand,
+		historyCommand,
 	}
 
 	for _, cmd := range commands {
@@ -224,7 +304,16 @@ func (b *Bot) handleMessageCreate(s *discordgo.Session, m *discordgo.MessageCrea
 		question := strings.TrimPrefix(m.Content, "!8ball ")
 		question = strings.TrimPrefix(question, "!8Ball ")
 
-		response, err := b.oracleClient.AskOracle(question, m.Author.ID, 0)
+		if !b.guilds.channelAllowed(m.GuildID, m.ChannelID) {
+			return
+		}
+		if b.guilds.take(m.GuildID, m.Author.ID, time.Now()) > 0 {
+			s.MessageReactionAdd(m.ChannelID, m.ID, "⏳")
+			return
+		}
+
+		mood := b.guilds.get(m.GuildID).Mood
+		response, err := b.oracleClient.AskOracle(question, m.Author.ID, m.GuildID, mood)
 		if err != nil {
 			s.ChannelMessageSend(m.ChannelID, "❌ The Oracle is unavailable...")
 			return
@@ -236,15 +325,43 @@ func (b *Bot) handleMessageCreate(s *discordgo.Session, m *discordgo.MessageCrea
 }
 
 func (b *Bot) handleInteractionCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type == discordgo.InteractionMessageComponent {
+		switch id := i.MessageComponentData().CustomID; {
+		case strings.HasPrefix(id, flipChallengePrefix):
+			b.handleFlipChallenge(s, i)
+		case strings.HasPrefix(id, quizButtonPrefix):
+			b.handleQuizAnswer(s, i)
+		}
+		return
+	}
 	if i.Type != discordgo.InteractionApplicationCommand {
 		return
 	}
 
 	data := i.ApplicationCommandData()
+	if data.Name != "config" && !b.admit(s, i) {
+		return
+	}
 
 	switch data.Name {
 	case "8ball", "oracle":
 		b.handleOracleCommand(s, i)
+	case "badges":
+		b.handleBadgesCommand(s, i)
+	case "roll":
+		b.handleRollCommand(s, i)
+	case "flip":
+		b.handleFlipCommand(s, i)
+	case "melody":
+		b.handleMelodyCommand(s, i)
+	case "quiz":
+		b.handleQuizCommand(s, i)
+	case "leaderboard":
+		b.handleLeaderboardCommand(s, i)
+	case "config":
+		b.handleConfigCommand(s, i)
+	case "history":
+		b.handleHistoryCommand(s, i)
 	}
 }
 
@@ -257,7 +374,7 @@ func (b *Bot) handleOracleCommand(s *discordgo.Session, i *discordgo.Interaction
 	data := i.ApplicationCommandData()
 
 	var question string
-	mood := 0 // Default: Mysterious
+	mood := b.guilds.get(i.GuildID).Mood
 
 	for _, opt := range data.Options {
 		switch opt.Name {
@@ -268,8 +385,13 @@ func (b *Bot) handleOracleCommand(s *discordgo.Session, i *discordgo.Interaction
 		}
 	}
 
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+
 	// Consult the Oracle
-	response, err := b.oracleClient.AskOracle(question, i.Member.User.ID, mood)
+	response, err := b.oracleClient.AskOracle(question, user.ID, i.GuildID, mood)
 	if err != nil {
 		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
 			Content: strPtr("❌ The Oracle is unavailable: " + err.Error()),
@@ -277,16 +399,18 @@ func (b *Bot) handleOracleCommand(s *discordgo.Session, i *discordgo.Interaction
 		return
 	}
 
-	embed := b.createOracleEmbed(question, response, i.Member.User)
+	embed := b.createOracleEmbed(question, response, user)
 	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
 		Embeds: &[]*discordgo.MessageEmbed{embed},
 	})
 }
 
 func (b *Bot) createOracleEmbed(question string, response *OracleResponse, user *discordgo.User) *discordgo.MessageEmbed {
-	// Color based on confidence
+	// Color based on confidence; grey when the Oracle is degraded
 	var color int
 	switch {
+	case response.Degraded:
+		color = 0x808080
 	case response.Confidence >= 0.75:
 		color = 0x00FF00 // Green - positive
 	case response.Confidence >= 0.50:
@@ -297,34 +421,48 @@ func (b *Bot) createOracleEmbed(question string, response *OracleResponse, user
 		color = 0xFF0000 // Red - negative
 	}
 
+	footer := "Powered by 3-qubit superposition"
+	if response.FromCache {
+		footer += " • cached"
+	}
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "❓ Question",
+			Value:  question,
+			Inline: false,
+		},
+		{
+			Name:   "📊 Confidence",
+			Value:  fmt.Sprintf("%.0f%%", response.Confidence*100),
+			Inline: true,
+		},
+		{
+			Name:   "⚛️ Quantum State",
+			Value:  response.QuantumState,
+			Inline: true,
+		},
+		{
+			Name:   "🎲 Outcome",
+			Value:  fmt.Sprintf("%d/7", response.OutcomeIndex),
+			Inline: true,
+		},
+	}
+	if response.Degraded {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "⚠️ Degraded mode",
+			Value:  "The Gaming Module is unreachable, so this answer is classical, not measured",
+			Inline: false,
+		})
+		footer = "Degraded mode • local fallback"
+	}
+
 	return &discordgo.MessageEmbed{
 		Title:       "🎱 The Quantum Oracle Speaks",
 		Description: fmt.Sprintf("**%s**", response.Prophecy),
 		Color:       color,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "❓ Question",
-				Value:  question,
-				Inline: false,
-			},
-			{
-				Name:   "📊 Confidence",
-				Value:  fmt.Sprintf("%.0f%%", response.Confidence*100),
-				Inline: true,
-			},
-			{
-				Name:   "⚛️ Quantum State",
-				Value:  response.QuantumState,
-				Inline: true,
-			},
-			{
-				Name:   "🎲 Outcome",
-				Value:  fmt.Sprintf("%d/7", response.OutcomeIndex),
-				Inline: true,
-			},
-		},
+		Fields:      fields,
 		Footer: &discordgo.MessageEmbedFooter{
-			Text:    fmt.Sprintf("Asked by %s • Powered by 3-qubit superposition", user.Username),
+			Text:    fmt.Sprintf("Asked by %s • %s", user.Username, footer),
 			IconURL: user.AvatarURL("32"),
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
@@ -342,6 +480,11 @@ func strPtr(s string) *string {
 func main() {
 	token := flag.String("token", "", "Discord bot token")
 	gamingAddr := flag.String("gaming-addr", "gaming:50061", "Gaming module address")
+	educationAddr := flag.String("education-addr", "education:50065", "Education module address, for badges (empty: off)")
+	musicAddr := flag.String("music-addr", "music:50062", "Music module address, for melodies (empty: off)")
+	reviewInterval := flag.Duration("review-reminders", time.Hour, "How often to DM learners with reviews due (0: off)")
+	guildFile := flag.String("guild-config", "", "File to keep per-server settings in (empty: in memory)")
+	announceBoards := flag.Bool("leaderboard-announcements", true, "Post last week's standings to each server's system channel when the leaderboards reset")
 	flag.Parse()
 
 	// Check for token in environment
@@ -362,8 +505,39 @@ func main() {
 	}
 	defer oracleClient.Close()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go oracleClient.watch(ctx, oracleHealthInterval)
+
+	// Connect to Education Module for badges
+	var educationClient *EducationClient
+	if *educationAddr != "" {
+		educationClient, err = NewEducationClient(*educationAddr)
+		if err != nil {
+			log.Printf("⚠️ Warning: Could not connect to Education module: %v", err)
+		} else {
+			defer educationClient.Close()
+		}
+	}
+
+	// Connect to Music Module for melodies
+	var musicClient *MusicClient
+	if *musicAddr != "" {
+		musicClient, err = NewMusicClient(*musicAddr)
+		if err != nil {
+			log.Printf("⚠️ Warning: Could not connect to Music module: %v", err)
+		} else {
+			defer musicClient.Close()
+		}
+	}
+
+	guilds, err := newGuildStore(*guildFile)
+	if err != nil {
+		log.Fatalf("Failed to load server settings: %v", err)
+	}
+
 	// Create and start bot
-	bot, err := NewBot(*token, oracleClient)
+	bot, err := NewBot(*token, oracleClient, educationClient, musicClient, guilds)
 	if err != nil {
 		log.Fatalf("Failed to create bot: %v", err)
 	}
@@ -373,6 +547,13 @@ func main() {
 	}
 	defer bot.Stop()
 
+	if educationClient != nil && *reviewInterval > 0 {
+		go newReviewReminder(bot).run(ctx, *reviewInterval)
+	}
+	if *announceBoards {
+		go bot.announceResets(ctx, time.Hour)
+	}
+
 	log.Println("🎱 Quantum Oracle Bot is running. Press Ctrl+C to stop.")
 
 	// Wait for interrupt signal
@@ -381,6 +562,4 @@ func main() {
 	<-sc
 
 	log.Println("🎱 Shutting down...")
-
-	_ = context.Background() // For future use with gRPC context
 }
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/bits"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	music "github.com/perclft/QubitEngine/bot/discord/generated/music"
+)
+
+// ------------------------------------------------------------------
+// Music Client (melodies come from the Music Module)
+// ------------------------------------------------------------------
+
+const (
+	maxMelodyNotes = 32
+	// melodyTimeout allows for composing and rendering audio
+	melodyTimeout = 15 * time.Second
+)
+
+type MusicClient struct {
+	conn   *grpc.ClientConn
+	client music.QuantumMusicClient
+}
+
+func NewMusicClient(musicAddr string) (*MusicClient, error) {
+	conn, err := grpc.Dial(musicAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to music module: %w", err)
+	}
+	return &MusicClient{conn: conn, client: music.NewQuantumMusicClient(conn)}, nil
+}
+
+func (c *MusicClient) Close() error {
+	return c.conn.Close()
+}
+
+// melodyFile is a rendered melody, ready to attach
+type melodyFile struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+// Melody composes a melody and renders it as WAV audio, or as MIDI when
+// asked or when the audio cannot be rendered
+func (c *MusicClient) Melody(scale music.Scale, length int, midi bool) (*music.Melody, *melodyFile, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), melodyTimeout)
+	defer cancel()
+	melody, err := c.client.GenerateMelody(ctx, &music.MelodyRequest{
+		Scale:    scale,
+		NumNotes: int32(length),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	export := &music.ExportRequest{Source: &music.ExportRequest_Melody{Melody: melody}}
+	if !midi {
+		audio, err := c.client.ExportWAV(ctx, export)
+		if err == nil {
+			return melody, &melodyFile{"quantum_melody.wav", "audio/wav", audio.Data}, nil
+		}
+	}
+	file, err := c.client.ExportMIDI(ctx, export)
+	if err != nil {
+		return nil, nil, err
+	}
+	return melody, &melodyFile{"quantum_melody.mid", "audio/midi", file.Data}, nil
+}
+
+var minMelodyNotes = 1.0
+
+var melodyCommand = &discordgo.ApplicationCommand{
+	Name:        "melody",
+	Description: "Compose a quantum melody and hear it",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "scale",
+			Description: "The scale to compose in (default: major)",
+			Required:    false,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "Major", Value: "major"},
+				{Name: "Minor", Value: "minor"},
+				{Name: "Blues", Value: "blues"},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        "length",
+			Description: "How many notes (default: 8)",
+			Required:    false,
+			MinValue:    &minMelodyNotes,
+			MaxValue:    maxMelodyNotes,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "format",
+			Description: "Attach audio or MIDI (default: audio)",
+			Required:    false,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "🔊 Audio (WAV)", Value: "wav"},
+				{Name: "🎹 MIDI", Value: "midi"},
+			},
+		},
+	},
+}
+
+var melodyScales = map[string]music.Scale{
+	"major": music.Scale_SCALE_MAJOR,
+	"minor": music.Scale_SCALE_MINOR,
+	"blues": music.Scale_SCALE_BLUES,
+}
+
+func (b *Bot) handleMelodyCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	scale, length, midi := "major", 8, false
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "scale":
+			scale = opt.StringValue()
+		case "length":
+			length = min(max(int(opt.IntValue()), 1), maxMelodyNotes)
+		case "format":
+			midi = opt.StringValue() == "midi"
+		}
+	}
+
+	if b.musicClient == nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Melodies are unavailable: no music module configured"),
+		})
+		return
+	}
+	melody, file, err := b.musicClient.Melody(melodyScales[scale], length, midi)
+	if err != nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Melodies are unavailable: " + err.Error()),
+		})
+		return
+	}
+
+	embed := b.createMelodyEmbed(scale, melody, user)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+		Files: []*discordgo.File{
+			{Name: file.name, ContentType: file.contentType, Reader: bytes.NewReader(file.data)},
+		},
+	})
+}
+
+func (b *Bot) createMelodyEmbed(scale string, melody *music.Melody, user *discordgo.User) *discordgo.MessageEmbed {
+	// One line per note: the measured basis state and how likely it was
+	lines := make([]string, len(melody.Notes))
+	for n, note := range melody.Notes {
+		name := note.NoteName
+		if note.Pitch > 0 {
+			name = fmt.Sprintf("%s%d", name, note.Pitch/12-1)
+		}
+		qubits := bits.Len(uint(max(len(note.StateProbsBefore), 2) - 1))
+		chance := ""
+		if k := int(note.QuantumOutcome); k < len(note.StateProbsBefore) {
+			chance = fmt.Sprintf(" · %.0f%%", note.StateProbsBefore[k]*100)
+		}
+		lines[n] = fmt.Sprintf("`%2d` **%s** |%0*b⟩%s · %g♩",
+			n+1, name, qubits, note.QuantumOutcome, chance, note.Duration)
+	}
+
+	footer := fmt.Sprintf("%.0f BPM · %.1f beats", melody.Tempo, melody.DurationBeats)
+	if melody.Seed != 0 {
+		footer += fmt.Sprintf(" · seed %d", melody.Seed)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎵 A Quantum Melody in %s", strings.ToUpper(scale[:1])+scale[1:]),
+		Description: strings.Join(lines, "\n"),
+		Color:       0x1ABC9C,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text:    fmt.Sprintf("Composed for %s · %s", user.Username, footer),
+			IconURL: user.AvatarURL("32"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
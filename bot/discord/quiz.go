@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	edu "github.com/perclft/QubitEngine/bot/discord/generated/education"
+)
+
+// ------------------------------------------------------------------
+// Quizzes (questions and grading live in the Education Module)
+// ------------------------------------------------------------------
+
+const (
+	maxQuizQuestions = 10
+	// quizButtonPrefix starts the custom IDs of answer buttons:
+	// quiz:<quiz ID>:<question index>:<answer>
+	quizButtonPrefix = "quiz:"
+)
+
+// quizTypes are the questions a button can answer
+var quizTypes = []edu.QuestionType{edu.QuestionType_QUESTION_MULTIPLE_CHOICE, edu.QuestionType_QUESTION_TRUE_FALSE}
+
+// StartQuiz draws a quiz for a learner; Discord user IDs are the learner
+// IDs, so the attempt counts toward their progress, and a guild's ID puts
+// them on its leaderboard
+func (c *EducationClient) StartQuiz(userID, guildID string, topic edu.Topic, questions int) (*edu.Quiz, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return c.client.GenerateQuiz(ctx, &edu.QuizRequest{
+		Topic:        topic,
+		NumQuestions: int32(questions),
+		UserId:       userID,
+		Types:        quizTypes,
+		GroupId:      guildID,
+	})
+}
+
+// Answer grades one answer to a quiz question
+func (c *EducationClient) Answer(quizID, questionID, answer string) (*edu.QuizResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return c.client.SubmitAnswers(ctx, &edu.QuizSubmission{
+		QuizId:  quizID,
+		Answers: []*edu.AnswerSubmission{{QuestionId: questionID, Answer: answer}},
+	})
+}
+
+// quizGame is a quiz being played in a channel. Its message is edited in
+// place through the interaction that started it, question by question,
+// and each question moves on when answered or when its time is up.
+type quizGame struct {
+	mu          sync.Mutex
+	quiz        *edu.Quiz
+	userID      string
+	topic       string
+	interaction *discordgo.Interaction
+	perQ        time.Duration
+	deadline    time.Time
+	timer       *time.Timer
+
+	current  int
+	outcomes []string // One line per question asked so far
+	last     *edu.AnswerResult
+	result   *edu.QuizResult
+}
+
+// quizBook tracks the quizzes in play, by quiz ID
+type quizBook struct {
+	mu    sync.Mutex
+	games map[string]*quizGame
+}
+
+func newQuizBook() *quizBook {
+	return &quizBook{games: make(map[string]*quizGame)}
+}
+
+func (qb *quizBook) get(id string) *quizGame {
+	qb.mu.Lock()
+	defer qb.mu.Unlock()
+	return qb.games[id]
+}
+
+func (qb *quizBook) put(g *quizGame) {
+	qb.mu.Lock()
+	defer qb.mu.Unlock()
+	qb.games[g.quiz.QuizId] = g
+}
+
+func (qb *quizBook) drop(id string) {
+	qb.mu.Lock()
+	defer qb.mu.Unlock()
+	delete(qb.games, id)
+}
+
+var quizTopics = map[string]edu.Topic{
+	"superposition": edu.Topic_TOPIC_SUPERPOSITION,
+	"entanglement":  edu.Topic_TOPIC_ENTANGLEMENT,
+}
+
+var minQuizQuestions = 1.0
+
+var quizCommand = &discordgo.ApplicationCommand{
+	Name:        "quiz",
+	Description: "Answer quantum quiz questions for points and badges",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "topic",
+			Description: "What to be quizzed on",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "🌊 Superposition", Value: "superposition"},
+				{Name: "🔗 Entanglement", Value: "entanglement"},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        "questions",
+			Description: "How many questions, each against the clock (default: 1)",
+			Required:    false,
+			MinValue:    &minQuizQuestions,
+			MaxValue:    maxQuizQuestions,
+		},
+	},
+}
+
+func (b *Bot) handleQuizCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	topic, questions := "superposition", 1
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "topic":
+			topic = opt.StringValue()
+		case "questions":
+			questions = min(max(int(opt.IntValue()), 1), maxQuizQuestions)
+		}
+	}
+
+	if b.educationClient == nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Quizzes are unavailable: no education module configured"),
+		})
+		return
+	}
+	quiz, err := b.educationClient.StartQuiz(user.ID, i.GuildID, quizTopics[topic], questions)
+	if err != nil {
+		s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: strPtr("❌ Quizzes are unavailable: " + err.Error()),
+		})
+		return
+	}
+
+	// Questions share the server's time limit out evenly
+	g := &quizGame{
+		quiz:        quiz,
+		userID:      user.ID,
+		topic:       strings.ToUpper(topic[:1]) + topic[1:],
+		interaction: i.Interaction,
+		perQ:        time.Duration(quiz.TimeLimitSeconds) * time.Second / time.Duration(len(quiz.Questions)),
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	b.quizzes.put(g)
+	b.startQuestion(s, g)
+	embeds, components := g.render()
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	})
+}
+
+// startQuestion arms the timer for the current question; callers hold
+// g.mu
+func (b *Bot) startQuestion(s *discordgo.Session, g *quizGame) {
+	g.deadline = time.Now().Add(g.perQ)
+	question := g.current
+	g.timer = time.AfterFunc(g.perQ, func() { b.quizTimeout(s, g, question) })
+}
+
+// quizTimeout moves a quiz past a question not answered in time
+func (b *Bot) quizTimeout(s *discordgo.Session, g *quizGame, question int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.current != question || g.done() {
+		return
+	}
+	g.outcomes = append(g.outcomes, fmt.Sprintf("⏰ %d. Time's up", question+1))
+	g.last = nil
+	b.advance(s, g)
+
+	embeds, components := g.render()
+	if _, err := s.InteractionResponseEdit(g.interaction, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	}); err != nil {
+		log.Printf("⚠️ Could not update quiz %s: %v", g.quiz.QuizId, err)
+	}
+}
+
+// advance moves to the next question, or ends the quiz; callers hold
+// g.mu
+func (b *Bot) advance(s *discordgo.Session, g *quizGame) {
+	g.current++
+	if g.done() {
+		b.quizzes.drop(g.quiz.QuizId)
+		return
+	}
+	b.startQuestion(s, g)
+}
+
+func (g *quizGame) done() bool {
+	return g.current >= len(g.quiz.Questions) || g.result != nil && g.result.Completed
+}
+
+// handleQuizAnswer grades an answer button. Only the learner who started
+// a quiz can answer it, and only its current question.
+func (b *Bot) handleQuizAnswer(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	clicker := i.User
+	if i.Member != nil {
+		clicker = i.Member.User
+	}
+	reply := func(content string) {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+		})
+	}
+	parts := strings.SplitN(strings.TrimPrefix(i.MessageComponentData().CustomID, quizButtonPrefix), ":", 3)
+	if len(parts) != 3 {
+		return
+	}
+	quizID, answer := parts[0], parts[2]
+	question, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return
+	}
+
+	g := b.quizzes.get(quizID)
+	if g == nil {
+		reply("⌛ This quiz is over; start another with /quiz")
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case clicker.ID != g.userID:
+		reply("❌ This is <@" + g.userID + ">'s quiz; start your own with /quiz")
+		return
+	case question != g.current || g.done():
+		reply("⌛ That question has closed")
+		return
+	}
+	if g.timer != nil {
+		g.timer.Stop()
+	}
+
+	q := g.quiz.Questions[question]
+	result, err := b.educationClient.Answer(quizID, q.QuestionId, answer)
+	if err != nil {
+		b.quizzes.drop(quizID)
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    "❌ The quiz could not be graded: " + err.Error(),
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		return
+	}
+	g.result = result
+	g.last = result.Results[0]
+	mark := "❌"
+	if g.last.Correct {
+		mark = "✅"
+	}
+	g.outcomes = append(g.outcomes, fmt.Sprintf("%s %d. %s", mark, question+1, q.Text))
+	b.advance(s, g)
+
+	embeds, components := g.render()
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{Embeds: embeds, Components: components},
+	})
+}
+
+// answerText is how a question's answer reads: an option's text rather
+// than its index
+func answerText(q *edu.Question, answer string) string {
+	if k, err := strconv.Atoi(answer); err == nil && k >= 0 && k < len(q.Options) {
+		return q.Options[k]
+	}
+	if q.Type == edu.QuestionType_QUESTION_TRUE_FALSE && answer != "" {
+		return strings.ToUpper(answer[:1]) + answer[1:]
+	}
+	return answer
+}
+
+// render draws the quiz as it stands: the verdict on the last answer, then
+// the current question with its answer buttons, or the final score
+func (g *quizGame) render() ([]*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	score, maxScore := int32(0), int32(0)
+	for _, q := range g.quiz.Questions {
+		maxScore += q.Points
+	}
+	if g.result != nil {
+		score, maxScore = g.result.Score, g.result.MaxScore
+	}
+
+	var fields []*discordgo.MessageEmbedField
+	if g.last != nil {
+		prev := g.quiz.Questions[g.current-1]
+		verdict := fmt.Sprintf("✅ Correct! **+%d**", g.last.PointsEarned)
+		if !g.last.Correct {
+			verdict = "❌ The answer was **" + answerText(prev, g.last.CorrectAnswer) + "**"
+		}
+		if g.last.Explanation != "" {
+			verdict += "\n" + g.last.Explanation
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Last answer", Value: verdict})
+	}
+
+	if g.done() {
+		// Questions a closed quiz never asked count as unanswered
+		for n := len(g.outcomes); n < len(g.quiz.Questions); n++ {
+			g.outcomes = append(g.outcomes, fmt.Sprintf("⏰ %d. Not answered", n+1))
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Questions", Value: strings.Join(g.outcomes, "\n")})
+		if g.result != nil && len(g.result.Unlocked) > 0 {
+			var badges []string
+			for _, badge := range g.result.Unlocked {
+				badges = append(badges, fmt.Sprintf("%s **%s**", badge.Emoji, badge.Name))
+			}
+			fields = append(fields, &discordgo.MessageEmbedField{Name: "🏅 Badges unlocked", Value: strings.Join(badges, "\n")})
+		}
+		embed := &discordgo.MessageEmbed{
+			Title:       fmt.Sprintf("📚 %s Quiz Complete", g.topic),
+			Description: fmt.Sprintf("<@%s> scored **%d/%d**", g.userID, score, maxScore),
+			Color:       0x2ECC71,
+			Fields:      fields,
+			Footer:      &discordgo.MessageEmbedFooter{Text: "Scores count toward your progress and /badges"},
+			Timestamp:   time.Now().Format(time.RFC3339),
+		}
+		return []*discordgo.MessageEmbed{embed}, []discordgo.MessageComponent{}
+	}
+
+	q := g.quiz.Questions[g.current]
+	description := "**" + q.Text + "**"
+	for k, option := range q.Options {
+		description += fmt.Sprintf("\n%c. %s", 'A'+k, option)
+	}
+	description += fmt.Sprintf("\n\n⏳ Time's up <t:%d:R>", g.deadline.Unix())
+
+	id := func(answer string) string {
+		return fmt.Sprintf("%s%s:%d:%s", quizButtonPrefix, g.quiz.QuizId, g.current, answer)
+	}
+	var buttons []discordgo.MessageComponent
+	if q.Type == edu.QuestionType_QUESTION_TRUE_FALSE {
+		buttons = []discordgo.MessageComponent{
+			discordgo.Button{Label: "True", Style: discordgo.SuccessButton, CustomID: id("true")},
+			discordgo.Button{Label: "False", Style: discordgo.DangerButton, CustomID: id("false")},
+		}
+	}
+	for k := range q.Options {
+		if k == 5 || q.Type == edu.QuestionType_QUESTION_TRUE_FALSE {
+			break // A row holds five buttons
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    string(rune('A' + k)),
+			Style:    discordgo.PrimaryButton,
+			CustomID: id(strconv.Itoa(k)),
+		})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📚 %s Quiz · Question %d/%d", g.topic, g.current+1, len(g.quiz.Questions)),
+		Description: description,
+		Color:       0x3498DB,
+		Fields:      fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Score %d/%d · %d points a question", score, maxScore, q.Points),
+		},
+	}
+	if q.Concept != "" {
+		embed.Author = &discordgo.MessageEmbedAuthor{Name: q.Concept}
+	}
+	return []*discordgo.MessageEmbed{embed}, []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	edu "github.com/perclft/QubitEngine/bot/discord/generated/education"
+)
+
+// reviewReminderCooldown keeps a learner from being reminded more than
+// once a day, however many cards come due
+const reviewReminderCooldown = 24 * time.Hour
+
+// DueLearners lists learners with reviews due
+func (c *EducationClient) DueLearners() ([]*edu.LearnerDue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	due, err := c.client.GetDueReviews(ctx, &edu.DueReviewsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return due.Learners, nil
+}
+
+// reviewReminder DMs learners when spaced-repetition reviews come due
+type reviewReminder struct {
+	bot      *Bot
+	mu       sync.Mutex
+	reminded map[string]time.Time // Last DM, by Discord user ID
+}
+
+func newReviewReminder(bot *Bot) *reviewReminder {
+	return &reviewReminder{bot: bot, reminded: make(map[string]time.Time)}
+}
+
+// run polls the education module on every tick until ctx ends
+func (r *reviewReminder) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.remind(time.Now())
+		}
+	}
+}
+
+func (r *reviewReminder) remind(now time.Time) {
+	learners, err := r.bot.educationClient.DueLearners()
+	if err != nil {
+		log.Printf("⚠️ Could not fetch due reviews: %v", err)
+		return
+	}
+	for _, l := range learners {
+		r.mu.Lock()
+		last, ok := r.reminded[l.UserId]
+		r.mu.Unlock()
+		if ok && now.Sub(last) < reviewReminderCooldown {
+			continue
+		}
+
+		channel, err := r.bot.session.UserChannelCreate(l.UserId)
+		if err != nil {
+			log.Printf("⚠️ Could not open DM with %s: %v", l.UserId, err)
+			continue
+		}
+		msg := fmt.Sprintf("🧠 You have **%d** quantum review%s due. A few minutes now keeps them fresh!",
+			l.DueCount, plural(int(l.DueCount)))
+		if _, err := r.bot.session.ChannelMessageSend(channel.ID, msg); err != nil {
+			log.Printf("⚠️ Could not remind %s: %v", l.UserId, err)
+			continue
+		}
+		r.mu.Lock()
+		r.reminded[l.UserId] = now
+		r.mu.Unlock()
+	}
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
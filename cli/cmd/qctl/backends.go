@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/perclft/QubitEngine/backend/backends"
+)
+
+// localSim runs circuits on the engine at -server
+const localSim = "local-sim"
+
+// hardwareShots is how many shots a hardware backend takes of a circuit
+// unless -shots says otherwise
+const hardwareShots = 1024
+
+// hardwarePoll is how often a hardware job is checked on
+const hardwarePoll = 2 * time.Second
+
+// knownBackends are the hardware targets listed by default; any other
+// name a provider offers can still be given on the command line
+var knownBackends = []string{
+	"ibm:ibm_brisbane", "ibm:ibm_kyoto", "ibm:ibm_osaka",
+	"ionq:simulator", "ionq:qpu.harmony", "ionq:qpu.aria-1",
+	"rigetti:Aspen-M-3", "rigetti:Ankaa-2",
+}
+
+// providerKeys are the variables holding each provider's API key
+var providerKeys = map[string]string{
+	"ibm":     "IBM_QUANTUM_TOKEN",
+	"ionq":    "IONQ_API_KEY",
+	"rigetti": "RIGETTI_API_KEY",
+}
+
+var backendsCmd = &command{
+	name:    "backends",
+	summary: "Show the backends circuits can run on",
+	subs: []*command{
+		{name: "list", summary: "List the execution backends", run: backendsList},
+		{name: "calibration", args: "<backend>", summary: "Show a backend's qubit and gate error rates", run: backendsCalibration},
+	},
+}
+
+// newBackend builds the backend a spec names: local-sim, ibm:<backend>,
+// ionq:<target> or rigetti:<qpu>
+func newBackend(spec string) (backends.QuantumBackend, error) {
+	if spec == localSim {
+		return backends.NewLocalSimulatorBackend(globals.server), nil
+	}
+	provider, name, ok := strings.Cut(spec, ":")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("unknown backend %q; want %s, ibm:<backend>, ionq:<target> or rigetti:<qpu>", spec, localSim)
+	}
+	key := providerKey(provider)
+	switch provider {
+	case "ibm":
+		return backends.NewIBMQuantumBackend(backends.IBMConfig{
+			APIKey:  key,
+			Hub:     envOr("IBM_QUANTUM_HUB", "ibm-q"),
+			Group:   envOr("IBM_QUANTUM_GROUP", "open"),
+			Project: envOr("IBM_QUANTUM_PROJECT", "main"),
+			Backend: name,
+		}), nil
+	case "ionq":
+		return backends.NewIonQBackend(backends.IonQConfig{APIKey: key, Target: name}), nil
+	case "rigetti":
+		return backends.NewRigettiBackend(backends.RigettiConfig{APIKey: key, QPU: name}), nil
+	}
+	return nil, fmt.Errorf("unknown provider %q in backend %q; want ibm, ionq or rigetti", provider, spec)
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// backendRegistry holds the local simulator and the known hardware
+func backendRegistry() *backends.BackendRegistry {
+	registry := backends.NewBackendRegistry()
+	for _, spec := range append([]string{localSim}, knownBackends...) {
+		b, _ := newBackend(spec)
+		registry.Register(spec, b)
+	}
+	return registry
+}
+
+// lookupBackend finds a backend in the registry, adding it first if it
+// is a provider's target not listed by default
+func lookupBackend(registry *backends.BackendRegistry, spec string) (backends.QuantumBackend, error) {
+	if b, ok := registry.Get(spec); ok {
+		return b, nil
+	}
+	b, err := newBackend(spec)
+	if err != nil {
+		return nil, err
+	}
+	registry.Register(spec, b)
+	return b, nil
+}
+
+// ------------------------------------------------------------------
+// Hardware runs
+// ------------------------------------------------------------------
+
+// hardwareGates are the hardware names of the DSL's gates
+var hardwareGates = map[string]string{
+	"H": "H", "X": "X", "S": "S", "T": "T", "RY": "RY", "RZ": "RZ",
+	"CNOT": "CNOT", "TOFFOLI": "CCX", "CCNOT": "CCX",
+}
+
+// hardwareCircuit converts a circuit for the hardware backends, which
+// measure every qubit at the end; a measurement followed by more gates
+// on its qubit cannot be run there
+func hardwareCircuit(c *CircuitFile, shots int) (*backends.Circuit, error) {
+	out := &backends.Circuit{NumQubits: int(c.Qubits), Shots: shots, Metadata: map[string]any{"name": c.Name}}
+	measured := map[uint32]bool{}
+	for i, op := range c.Ops {
+		gate := strings.ToUpper(op.Gate)
+		if gate == "M" {
+			measured[op.Target] = true
+			continue
+		}
+		name, ok := hardwareGates[gate]
+		if !ok {
+			return nil, fmt.Errorf("unknown gate type: %s", op.Gate)
+		}
+		qubits := opQubits(op)
+		for _, q := range qubits {
+			if measured[q] {
+				return nil, fmt.Errorf("op %d acts on qubit %d after it is measured; hardware backends only measure at the end", i+1, q)
+			}
+		}
+		g := backends.GateOp{Name: name}
+		for _, q := range qubits {
+			g.Qubits = append(g.Qubits, int(q))
+		}
+		if gate == "RY" || gate == "RZ" {
+			g.Params = []float64{op.Angle}
+		}
+		out.Gates = append(out.Gates, g)
+	}
+	return out, nil
+}
+
+// runOnHardware submits a circuit to a provider's backend and waits for
+// its counts
+func runOnHardware(ctx context.Context, spec string, b backends.QuantumBackend, circuit *CircuitFile, shots int) error {
+	jobID, counts, err := awaitHardware(ctx, spec, b, circuit, shots, notef)
+	if err != nil {
+		return err
+	}
+	return printShots(newShotsResult(circuit.Name, spec, jobID, counts), counts)
+}
+
+// awaitHardware submits a circuit to a hardware backend and waits for its
+// counts, reporting the job's progress through note
+func awaitHardware(ctx context.Context, spec string, b backends.QuantumBackend, circuit *CircuitFile, shots int,
+	note func(format string, a ...any)) (string, map[string]int, error) {
+	provider, _, _ := strings.Cut(spec, ":")
+	if providerKey(provider) == "" {
+		return "", nil, fmt.Errorf("%s needs an API key; set %s or the profile's provider_tokens.%s", spec, providerKeys[provider], provider)
+	}
+	if int(circuit.Qubits) > b.MaxQubits() {
+		return "", nil, fmt.Errorf("%s has %d qubits; the circuit needs %d", spec, b.MaxQubits(), circuit.Qubits)
+	}
+	if shots == 0 {
+		shots = hardwareShots
+	}
+	hw, err := hardwareCircuit(circuit, shots)
+	if err != nil {
+		return "", nil, err
+	}
+
+	note("⚡ Submitting Circuit: '%s' (%d Qubits) to %s (%s)\n", circuit.Name, circuit.Qubits, b.Name(), b.Provider())
+	jobID, err := b.Submit(ctx, hw)
+	if err != nil {
+		return "", nil, err
+	}
+	note("📥 Job %s\n", jobID)
+	for last := ""; last != "completed"; {
+		st, err := b.Status(ctx, jobID)
+		if err != nil {
+			return jobID, nil, fmt.Errorf("job status failed: %v", err)
+		}
+		if st.Status != last {
+			note("[%s] %s\n", time.Now().Format(time.TimeOnly), st.Status)
+			last = st.Status
+		}
+		switch st.Status {
+		case "completed":
+			continue
+		case "failed":
+			return jobID, nil, fmt.Errorf("job %s failed: %s", jobID, st.Error)
+		case "cancelled":
+			return jobID, nil, fmt.Errorf("job %s was cancelled", jobID)
+		}
+		select {
+		case <-ctx.Done():
+			return jobID, nil, fmt.Errorf("gave up waiting for job %s: %v", jobID, ctx.Err())
+		case <-time.After(hardwarePoll):
+		}
+	}
+
+	res, err := b.Results(ctx, jobID)
+	if err != nil {
+		return jobID, nil, fmt.Errorf("fetching results failed: %v", err)
+	}
+	return jobID, res.Counts, nil
+}
+
+// ------------------------------------------------------------------
+// Commands
+// ------------------------------------------------------------------
+
+type backendRecord struct {
+	Backend   string `json:"backend"`
+	Provider  string `json:"provider"`
+	Qubits    int    `json:"qubits"`
+	Simulator bool   `json:"simulator"`
+}
+
+type backendList []backendRecord
+
+func (l backendList) header() []string { return []string{"backend", "provider", "qubits", "simulator"} }
+
+func (l backendList) rows() [][]string {
+	rows := make([][]string, len(l))
+	for i, b := range l {
+		rows[i] = []string{b.Backend, b.Provider, fmt.Sprint(b.Qubits), fmt.Sprint(b.Simulator)}
+	}
+	return rows
+}
+
+func backendsList(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	registry := backendRegistry()
+	specs := registry.List()
+	sort.Strings(specs)
+	var list backendList
+	for _, spec := range specs {
+		b, _ := registry.Get(spec)
+		list = append(list, backendRecord{spec, b.Provider(), b.MaxQubits(), b.IsSimulator()})
+	}
+	if machineOutput() {
+		return emit(list)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BACKEND\tPROVIDER\tQUBITS\tKIND\tKEY")
+	for _, b := range list {
+		kind := "hardware"
+		if b.Simulator {
+			kind = "simulator"
+		}
+		key := "-"
+		provider, _, _ := strings.Cut(b.Backend, ":")
+		if env, ok := providerKeys[provider]; ok {
+			switch {
+			case os.Getenv(env) != "":
+				key = env
+			case providerKey(provider) != "":
+				key = "profile"
+			default:
+				key = env + " (unset)"
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\n", b.Backend, b.Provider, b.Qubits, kind, key)
+	}
+	return tw.Flush()
+}
+
+// calibrationRecord is a backend's calibration as JSON or CSV
+type calibrationRecord struct {
+	Backend string `json:"backend"`
+	*backends.CalibrationData
+}
+
+func (r calibrationRecord) header() []string {
+	return []string{"kind", "name", "t1_us", "t2_us", "readout_error", "gate_error"}
+}
+
+// rows is a row per qubit, then one per gate
+func (r calibrationRecord) rows() [][]string {
+	var rows [][]string
+	for _, q := range calibratedQubits(r.CalibrationData) {
+		rows = append(rows, []string{"qubit", fmt.Sprint(q), optFloat(r.T1, q), optFloat(r.T2, q), optFloat(r.ReadoutError, q), ""})
+	}
+	for _, gate := range sortedKeys(r.GateErrors) {
+		rows = append(rows, []string{"gate", gate, "", "", "", formatFloat(r.GateErrors[gate])})
+	}
+	return rows
+}
+
+// calibratedQubits is every qubit with any figure, in order
+func calibratedQubits(c *backends.CalibrationData) []int {
+	seen := map[int]bool{}
+	for _, m := range []map[int]float64{c.T1, c.T2, c.ReadoutError} {
+		for q := range m {
+			seen[q] = true
+		}
+	}
+	qubits := make([]int, 0, len(seen))
+	for q := range seen {
+		qubits = append(qubits, q)
+	}
+	sort.Ints(qubits)
+	return qubits
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// optFloat is m[q], or empty when there is none
+func optFloat(m map[int]float64, q int) string {
+	if v, ok := m[q]; ok {
+		return formatFloat(v)
+	}
+	return ""
+}
+
+func backendsCalibration(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<backend>"); err != nil {
+		return err
+	}
+	b, err := lookupBackend(backendRegistry(), args[0])
+	if err != nil {
+		return err
+	}
+	cal, err := b.Calibration(ctx)
+	if err != nil {
+		return fmt.Errorf("calibration failed: %v", err)
+	}
+	if machineOutput() {
+		return emit(calibrationRecord{args[0], cal})
+	}
+
+	fmt.Printf("🔧 %s (%s, %d qubits)\n", args[0], b.Provider(), b.MaxQubits())
+	fmt.Printf("   Updated: %s\n", cal.LastUpdate.Format(time.DateTime))
+	qubits := calibratedQubits(cal)
+	if len(qubits) == 0 && len(cal.GateErrors) == 0 {
+		if b.IsSimulator() {
+			fmt.Println("   Noise-free: no qubit or gate errors")
+		} else {
+			fmt.Println("   The provider reported no error rates")
+		}
+		return nil
+	}
+	if len(qubits) > 0 {
+		fmt.Println()
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "QUBIT\tT1 (µs)\tT2 (µs)\tREADOUT ERROR")
+		for _, q := range qubits {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", q, optFloat(cal.T1, q), optFloat(cal.T2, q), optFloat(cal.ReadoutError, q))
+		}
+		tw.Flush()
+	}
+	if len(cal.GateErrors) > 0 {
+		fmt.Println()
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "GATE\tERROR")
+		for _, gate := range sortedKeys(cal.GateErrors) {
+			fmt.Fprintf(tw, "%s\t%s\n", gate, formatFloat(cal.GateErrors[gate]))
+		}
+		tw.Flush()
+	}
+	if len(cal.Connectivity) > 0 {
+		pairs := make([]string, len(cal.Connectivity))
+		for i, p := range cal.Connectivity {
+			pairs[i] = fmt.Sprintf("%d-%d", p[0], p[1])
+		}
+		fmt.Printf("\nCoupling: %s\n", strings.Join(pairs, " "))
+	}
+	return nil
+}
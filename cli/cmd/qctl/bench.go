@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+	"google.golang.org/grpc"
+)
+
+// intList is a flag of comma-separated numbers and ranges, e.g. "2,4,8-12"
+// or "2-20:2" for every second number
+type intList []int
+
+func (l *intList) String() string {
+	parts := make([]string, len(*l))
+	for i, n := range *l {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (l *intList) Set(v string) error {
+	var out intList
+	for _, part := range strings.Split(v, ",") {
+		span, step, hasStep := strings.Cut(strings.TrimSpace(part), ":")
+		from, to, isRange := strings.Cut(span, "-")
+		lo, err := strconv.Atoi(from)
+		if err != nil || lo < 1 {
+			return fmt.Errorf("%q: want positive numbers or ranges like 2-20:2", part)
+		}
+		hi, by := lo, 1
+		if isRange {
+			if hi, err = strconv.Atoi(to); err != nil || hi < lo {
+				return fmt.Errorf("%q: a range must run low to high", part)
+			}
+		}
+		if hasStep {
+			if by, err = strconv.Atoi(step); err != nil || by < 1 || !isRange {
+				return fmt.Errorf("%q: a step must be a positive number after a range", part)
+			}
+		}
+		for n := lo; n <= hi; n += by {
+			out = append(out, n)
+		}
+	}
+	*l = out
+	return nil
+}
+
+var benchOpts = struct {
+	qubits  intList
+	layers  intList
+	repeat  int
+	backend string
+	shots   int
+}{
+	qubits: intList{2, 4, 8, 12, 16},
+	layers: intList{1, 10, 100},
+}
+
+var benchCmd = &command{
+	name:    "bench",
+	summary: "Time generated circuits across qubit counts and depths on a backend",
+	flags: func(fs *flag.FlagSet) {
+		fs.Var(&benchOpts.qubits, "qubits", "Qubit `counts` to sweep, e.g. 2,4,8 or 2-20:2")
+		fs.Var(&benchOpts.layers, "layers", "Circuit `depths` to sweep, in layers of rotations and CNOTs")
+		fs.IntVar(&benchOpts.repeat, "repeat", 3, "Runs of each circuit to average over")
+		fs.StringVar(&benchOpts.backend, "backend", defaultBackend(), "Backend to measure: local-sim, ibm:<backend>, ionq:<target> or rigetti:<qpu>")
+		fs.IntVar(&benchOpts.shots, "shots", 0, "Shots per hardware run; hardware takes 1024 by default")
+	},
+	run: runBench,
+}
+
+// benchCircuit is a brickwork circuit: each layer rotates every qubit,
+// then entangles neighbouring pairs, offset by one on odd layers
+func benchCircuit(qubits, layers int) *CircuitFile {
+	c := &CircuitFile{Name: fmt.Sprintf("bench-%dq-%dl", qubits, layers), Qubits: int32(qubits)}
+	for l := 0; l < layers; l++ {
+		for q := 0; q < qubits; q++ {
+			c.Ops = append(c.Ops, CircuitOp{Gate: "RY", Target: uint32(q), Angle: 0.1 * float64((l+1)*(q+1))})
+		}
+		for q := l % 2; q+1 < qubits; q += 2 {
+			c.Ops = append(c.Ops, CircuitOp{Gate: "CNOT", Control: uint32(q), Target: uint32(q + 1)})
+		}
+	}
+	return c
+}
+
+// benchResult is the timing of one circuit in the sweep
+type benchResult struct {
+	Qubits      int     `json:"qubits"`
+	Layers      int     `json:"layers"`
+	Gates       int     `json:"gates"`
+	Depth       uint32  `json:"depth"`
+	Runs        int     `json:"runs"`
+	MeanMs      float64 `json:"mean_ms"`
+	MinMs       float64 `json:"min_ms"`
+	MaxMs       float64 `json:"max_ms"`
+	StdDevMs    float64 `json:"stddev_ms"`
+	GatesPerSec float64 `json:"gates_per_sec"`
+	// Slowdown is the mean over the mean at the last qubit count swept
+	// with as many layers
+	Slowdown float64 `json:"slowdown,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// benchReport is a sweep as JSON or CSV
+type benchReport struct {
+	Backend string        `json:"backend"`
+	Results []benchResult `json:"results"`
+}
+
+func (r *benchReport) header() []string {
+	return []string{"backend", "qubits", "layers", "gates", "depth", "runs", "mean_ms", "min_ms", "max_ms",
+		"stddev_ms", "gates_per_sec", "slowdown", "error"}
+}
+
+func (r *benchReport) rows() [][]string {
+	rows := make([][]string, len(r.Results))
+	for i, b := range r.Results {
+		rows[i] = []string{r.Backend, strconv.Itoa(b.Qubits), strconv.Itoa(b.Layers), strconv.Itoa(b.Gates),
+			fmt.Sprint(b.Depth), strconv.Itoa(b.Runs), formatFloat(b.MeanMs), formatFloat(b.MinMs),
+			formatFloat(b.MaxMs), formatFloat(b.StdDevMs), formatFloat(b.GatesPerSec), formatFloat(b.Slowdown), b.Error}
+	}
+	return rows
+}
+
+// timings fills in a result's statistics from its runs
+func (b *benchResult) timings(runs []time.Duration) {
+	b.Runs = len(runs)
+	b.MinMs = math.Inf(1)
+	var sum float64
+	for _, d := range runs {
+		ms := float64(d.Microseconds()) / 1000
+		sum += ms
+		b.MinMs, b.MaxMs = math.Min(b.MinMs, ms), math.Max(b.MaxMs, ms)
+	}
+	b.MeanMs = sum / float64(len(runs))
+	for _, d := range runs {
+		dev := float64(d.Microseconds())/1000 - b.MeanMs
+		b.StdDevMs += dev * dev
+	}
+	if len(runs) > 1 {
+		b.StdDevMs = math.Sqrt(b.StdDevMs / float64(len(runs)-1))
+	} else {
+		b.StdDevMs = 0
+	}
+	if b.MeanMs > 0 {
+		b.GatesPerSec = float64(b.Gates) / (b.MeanMs / 1000)
+	}
+}
+
+func runBench(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	switch {
+	case benchOpts.repeat < 1:
+		return fmt.Errorf("-repeat must be at least 1")
+	case len(benchOpts.qubits) == 0 || len(benchOpts.layers) == 0:
+		return fmt.Errorf("-qubits and -layers need at least one value each")
+	}
+	backend, err := lookupBackend(backendRegistry(), benchOpts.backend)
+	if err != nil {
+		return err
+	}
+
+	// One run of a circuit, timed from request to result
+	var runOnce func(ctx context.Context, c *CircuitFile) error
+	if benchOpts.backend == localSim {
+		conn, err := dial(globals.server)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		client := pb.NewQuantumComputeClient(conn)
+		runOnce = func(ctx context.Context, c *CircuitFile) error {
+			req, err := c.request()
+			if err != nil {
+				return err
+			}
+			// Large registers' state vectors run past gRPC's default limit
+			_, err = client.RunCircuit(ctx, req, grpc.MaxCallRecvMsgSize(math.MaxInt32))
+			return err
+		}
+	} else {
+		quiet := func(string, ...any) {}
+		runOnce = func(ctx context.Context, c *CircuitFile) error {
+			_, _, err := awaitHardware(ctx, benchOpts.backend, backend, c, benchOpts.shots, quiet)
+			return err
+		}
+	}
+
+	// The sweep can outlast -timeout, which bounds each run instead
+	ctx = context.WithoutCancel(ctx)
+	if benchOpts.backend == localSim {
+		// Connect before the clock starts, with an untimed run
+		warmCtx, cancel := withDeadline(ctx)
+		err := runOnce(warmCtx, benchCircuit(1, 1))
+		cancel()
+		if err != nil {
+			return fmt.Errorf("engine error: %v", err)
+		}
+	}
+	report := &benchReport{Backend: benchOpts.backend}
+	lastMean := map[int]float64{}
+	for _, qubits := range benchOpts.qubits {
+		for _, layers := range benchOpts.layers {
+			circuit := benchCircuit(qubits, layers)
+			res := benchResult{Qubits: qubits, Layers: layers, Gates: len(circuit.Ops), Depth: circuitDepth(circuit)}
+			notef("⏱  %2d qubits × %d layers (%d gates) ", qubits, layers, res.Gates)
+
+			var runs []time.Duration
+			for i := 0; i < benchOpts.repeat; i++ {
+				runCtx, cancel := withDeadline(ctx)
+				start := time.Now()
+				err := runOnce(runCtx, circuit)
+				cancel()
+				if err != nil {
+					res.Error = err.Error()
+					break
+				}
+				runs = append(runs, time.Since(start))
+				notef(".")
+			}
+			if res.Error != "" {
+				notef(" ❌ %s\n", res.Error)
+			} else {
+				res.timings(runs)
+				if prev := lastMean[layers]; prev > 0 {
+					res.Slowdown = res.MeanMs / prev
+				}
+				lastMean[layers] = res.MeanMs
+				notef(" %.3fms\n", res.MeanMs)
+			}
+			report.Results = append(report.Results, res)
+		}
+	}
+
+	if machineOutput() {
+		return emit(report)
+	}
+	fmt.Printf("\n--- 📈 %s ---\n", report.Backend)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "QUBITS\tLAYERS\tGATES\tDEPTH\tMEAN\tMIN\tMAX\tSTDDEV\tGATES/S\tSLOWDOWN\t")
+	for _, b := range report.Results {
+		if b.Error != "" {
+			fmt.Fprintf(tw, "%d\t%d\t%d\t%d\tfailed\t\t\t\t\t\t\n", b.Qubits, b.Layers, b.Gates, b.Depth)
+			continue
+		}
+		slowdown := "-"
+		if b.Slowdown > 0 {
+			slowdown = fmt.Sprintf("×%.2f", b.Slowdown)
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%.3fms\t%.3fms\t%.3fms\t%.3fms\t%.0f\t%s\t\n", b.Qubits, b.Layers, b.Gates, b.Depth,
+			b.MeanMs, b.MinMs, b.MaxMs, b.StdDevMs, b.GatesPerSec, slowdown)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+)
+
+// The new Circuit DSL
+type CircuitFile struct {
+	Name   string      `json:"name"`
+	Qubits int32       `json:"qubits"`
+	Ops    []CircuitOp `json:"ops"`
+}
+
+type CircuitOp struct {
+	Gate         string  `json:"gate"`
+	Target       uint32  `json:"target"`
+	Control      uint32  `json:"control"`
+	Control2     uint32  `json:"control2"` // For Toffoli
+	Angle        float64 `json:"angle"`    // For Rotations
+	ClassicalReg uint32  `json:"classical_reg"`
+}
+
+// gateTypes maps the DSL's gate names to the engine's
+var gateTypes = map[string]pb.GateOperation_GateType{
+	"H":    pb.GateOperation_HADAMARD,
+	"X":    pb.GateOperation_PAULI_X,
+	"CNOT": pb.GateOperation_CNOT,
+	"M":    pb.GateOperation_MEASURE,
+	// Phase 3: New Gates
+	"TOFFOLI": pb.GateOperation_TOFFOLI,
+	"CCNOT":   pb.GateOperation_TOFFOLI,
+	"S":       pb.GateOperation_PHASE_S,
+	"T":       pb.GateOperation_PHASE_T,
+	"RY":      pb.GateOperation_ROTATION_Y,
+	"RZ":      pb.GateOperation_ROTATION_Z,
+}
+
+// gateNames is each engine gate's name in the DSL
+var gateNames = map[pb.GateOperation_GateType]string{
+	pb.GateOperation_HADAMARD:   "H",
+	pb.GateOperation_PAULI_X:    "X",
+	pb.GateOperation_CNOT:       "CNOT",
+	pb.GateOperation_MEASURE:    "M",
+	pb.GateOperation_TOFFOLI:    "TOFFOLI",
+	pb.GateOperation_PHASE_S:    "S",
+	pb.GateOperation_PHASE_T:    "T",
+	pb.GateOperation_ROTATION_Y: "RY",
+	pb.GateOperation_ROTATION_Z: "RZ",
+}
+
+// loadCircuit reads a circuit file: OpenQASM for a .qasm file, named
+// after it, and the JSON DSL otherwise
+func loadCircuit(path string) (*CircuitFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	if ext := filepath.Ext(path); strings.EqualFold(ext, ".qasm") {
+		circuit, err := parseQASM(path, string(data))
+		if err != nil {
+			return nil, err
+		}
+		circuit.Name = strings.TrimSuffix(filepath.Base(path), ext)
+		return circuit, nil
+	}
+	var circuit CircuitFile
+	if err := json.Unmarshal(data, &circuit); err != nil {
+		return nil, fmt.Errorf("invalid JSON format: %v", err)
+	}
+	return &circuit, nil
+}
+
+// circuitArg loads the circuit file a command was given, either as its
+// argument or with -file
+func circuitArg(file string, args []string) (*CircuitFile, error) {
+	switch {
+	case file == "" && len(args) == 1:
+		file = args[0]
+	case file == "" || len(args) > 0:
+		return nil, fmt.Errorf("give one circuit file, as an argument or with -file")
+	}
+	return loadCircuit(file)
+}
+
+// circuitSource loads the circuit a command was given: a file, or one
+// saved in the registry when id is set
+func circuitSource(ctx context.Context, file, id string, version int, args []string) (*CircuitFile, error) {
+	switch {
+	case id == "":
+		return circuitArg(file, args)
+	case file != "" || len(args) > 0:
+		return nil, fmt.Errorf("-circuit-id cannot be combined with a circuit file")
+	}
+	return storedCircuit(ctx, id, version)
+}
+
+// operations builds the circuit's proto operations
+func (c *CircuitFile) operations() ([]*pb.GateOperation, error) {
+	var pbOps []*pb.GateOperation
+	for _, op := range c.Ops {
+		gate, ok := gateTypes[strings.ToUpper(op.Gate)]
+		if !ok {
+			return nil, fmt.Errorf("unknown gate type: %s", op.Gate)
+		}
+		pbOps = append(pbOps, &pb.GateOperation{
+			Type:               gate,
+			TargetQubit:        op.Target,
+			ControlQubit:       op.Control,
+			SecondControlQubit: op.Control2,
+			Angle:              op.Angle,
+			ClassicalRegister:  op.ClassicalReg,
+		})
+	}
+	return pbOps, nil
+}
+
+// request is the circuit as the engine runs it
+func (c *CircuitFile) request() (*pb.CircuitRequest, error) {
+	ops, err := c.operations()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CircuitRequest{NumQubits: c.Qubits, Operations: ops}, nil
+}
+
+// circuitFromProto turns an engine circuit back into the DSL
+func circuitFromProto(name string, req *pb.CircuitRequest) *CircuitFile {
+	c := &CircuitFile{Name: name, Qubits: req.NumQubits}
+	for _, op := range req.Operations {
+		c.Ops = append(c.Ops, CircuitOp{
+			Gate:         gateNames[op.Type],
+			Target:       op.TargetQubit,
+			Control:      op.ControlQubit,
+			Control2:     op.SecondControlQubit,
+			Angle:        op.Angle,
+			ClassicalReg: op.ClassicalRegister,
+		})
+	}
+	return c
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// completeCmd is the hidden verb the completion scripts call back into,
+// so they know every command, flag and plugin without being regenerated
+const completeCmd = "__complete"
+
+var completionScripts = map[string]string{
+	"bash": `# bash completion for qctl; load with: source <(qctl completion bash)
+_qctl() {
+    local cur=${COMP_WORDS[COMP_CWORD]}
+    COMPREPLY=($(compgen -W "$(qctl __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null)" -- "$cur"))
+}
+complete -o default -F _qctl qctl
+`,
+	"zsh": `#compdef qctl
+# zsh completion for qctl; load with: source <(qctl completion zsh)
+_qctl() {
+    local -a candidates
+    candidates=(${(f)"$(qctl __complete "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+    if (( ${#candidates} )); then
+        compadd -- $candidates
+    else
+        _files
+    fi
+}
+compdef _qctl qctl
+`,
+	"fish": `# fish completion for qctl; load with: qctl completion fish | source
+complete -c qctl -a '(qctl __complete (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)'
+`,
+}
+
+var completionCmd = &command{
+	name:    "completion",
+	args:    "<bash|zsh|fish>",
+	summary: "Print a shell completion script",
+	run:     printCompletion,
+}
+
+func printCompletion(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<bash|zsh|fish>"); err != nil {
+		return err
+	}
+	script, ok := completionScripts[args[0]]
+	if !ok {
+		return fmt.Errorf("no completion for %q; want bash, zsh or fish", args[0])
+	}
+	fmt.Print(script)
+	return nil
+}
+
+// commandFlags is every flag a command takes, global ones included
+func commandFlags(c *command) *flag.FlagSet {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	shown := globals
+	shown.register(fs)
+	if c.flags != nil {
+		c.flags(fs)
+	}
+	return fs
+}
+
+// takesValue reports whether a flag reads the next argument as its value
+func takesValue(fs *flag.FlagSet, name string) bool {
+	f := fs.Lookup(name)
+	if f == nil {
+		return false
+	}
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return !ok || !b.IsBoolFlag()
+}
+
+// flagValues are the values worth offering for a flag; none leaves the
+// shell to complete file names
+func flagValues(name string) []string {
+	switch name {
+	case "output":
+		return []string{string(outputTable), string(outputJSON), string(outputCSV)}
+	case "backend":
+		return append([]string{localSim}, knownBackends...)
+	case "ansatz":
+		return sortedKeys(ansatzTypes)
+	case "optimizer":
+		return sortedKeys(optimizerTypes)
+	case "format":
+		return append(sortedKeys(circuitExporters), sortedKeys(resultExporters)...)
+	case "profile":
+		path, err := configPath()
+		if err != nil {
+			return nil
+		}
+		cfg, err := readConfig(path)
+		if err != nil || len(cfg.Profiles) == 0 {
+			return nil
+		}
+		return profileNames(cfg)
+	}
+	return nil
+}
+
+// completions are the candidates for the last of words, the arguments
+// after qctl; the shell completes file names when there are none
+func completions(words []string) []string {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	c, fs := rootCmd, commandFlags(rootCmd)
+	prev := ""
+	for _, w := range words[:len(words)-1] {
+		switch {
+		case prev != "":
+			prev = ""
+		case c == rootCmd && w == "help":
+			// help takes command names, as the root does
+		case strings.HasPrefix(w, "-") && w != "-" && w != "--":
+			name, _, hasValue := strings.Cut(strings.TrimLeft(w, "-"), "=")
+			if !hasValue && takesValue(fs, name) {
+				prev = name
+			}
+		case len(c.subs) > 0:
+			sub := c.find(w)
+			if sub == nil {
+				// A plugin or a mistake: either way nothing to offer
+				return nil
+			}
+			c, fs = sub, commandFlags(sub)
+		}
+	}
+
+	cur := words[len(words)-1]
+	var candidates []string
+	switch {
+	case prev != "":
+		candidates = flagValues(prev)
+	case strings.HasPrefix(cur, "-"):
+		dashes := "-"
+		if strings.HasPrefix(cur, "--") {
+			dashes = "--"
+		}
+		fs.VisitAll(func(f *flag.Flag) { candidates = append(candidates, dashes+f.Name) })
+	case c == completionCmd:
+		for shell := range completionScripts {
+			candidates = append(candidates, shell)
+		}
+		sort.Strings(candidates)
+	case c == rootCmd:
+		candidates = append(candidates, "help")
+		for _, sub := range c.subs {
+			candidates = append(candidates, sub.name)
+		}
+		for _, p := range findPlugins() {
+			if !p.Shadowed {
+				candidates = append(candidates, p.Name)
+			}
+		}
+	case len(c.subs) > 0:
+		for _, sub := range c.subs {
+			candidates = append(candidates, sub.name)
+		}
+	}
+
+	matching := candidates[:0]
+	for _, cand := range candidates {
+		if strings.HasPrefix(cand, cur) {
+			matching = append(matching, cand)
+		}
+	}
+	return matching
+}
+
+// complete answers a completion script's callback, one candidate a line
+func complete(words []string) int {
+	for _, cand := range completions(words) {
+		fmt.Fprintln(os.Stdout, cand)
+	}
+	return 0
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ------------------------------------------------------------------
+// Profiles
+// ------------------------------------------------------------------
+
+// profile is a named set of defaults in the config file. Anything left
+// out keeps qctl's own default, and flags override it all.
+type profile struct {
+	Server    string `yaml:"server"`
+	Scheduler string `yaml:"scheduler"`
+	Registry  string `yaml:"registry"`
+	VQE       string `yaml:"vqe"`
+	Timeout   string `yaml:"timeout"`
+	Output    string `yaml:"output"`
+	Backend   string `yaml:"backend"`
+	// Token is sent as a bearer token to the QubitEngine services
+	Token string `yaml:"token"`
+	// TLS and the certificates secure connections to the services, as
+	// -tls, -ca-cert, -client-cert and -client-key do
+	TLS        bool   `yaml:"tls"`
+	CACert     string `yaml:"ca_cert"`
+	ClientCert string `yaml:"client_cert"`
+	ClientKey  string `yaml:"client_key"`
+	// ProviderTokens are the hardware providers' API keys, by provider
+	ProviderTokens map[string]string `yaml:"provider_tokens"`
+}
+
+// qctlConfig is ~/.qctl/config.yaml
+type qctlConfig struct {
+	DefaultProfile string             `yaml:"default_profile"`
+	Profiles       map[string]profile `yaml:"profiles"`
+}
+
+// active is the profile in use; its name is empty when there is none
+var active struct {
+	name string
+	path string
+	profile
+}
+
+// configPath is $QCTL_CONFIG, or ~/.qctl/config.yaml
+func configPath() (string, error) {
+	if path := os.Getenv("QCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot find the config file: %v", err)
+	}
+	return filepath.Join(home, ".qctl", "config.yaml"), nil
+}
+
+// readConfig reads the config file; a missing file is an empty config
+func readConfig(path string) (*qctlConfig, error) {
+	var cfg qctlConfig
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %v", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %v", path, err)
+	}
+	return &cfg, nil
+}
+
+// profileFlag finds -profile in the arguments, which must be known
+// before the flags are parsed since the profile supplies their defaults
+func profileFlag(args []string) string {
+	name := ""
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		flagName, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || flagName != "profile" {
+			continue
+		}
+		if !hasValue && i+1 < len(args) {
+			i++
+			value = args[i]
+		}
+		name = value
+	}
+	return name
+}
+
+// loadProfile applies a profile to the global defaults: the one named,
+// else $QCTL_PROFILE, else the config's default_profile, else one
+// called "default" if there is one
+func loadProfile(name string) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	cfg, err := readConfig(path)
+	if err != nil {
+		return err
+	}
+	explicit := true
+	for _, candidate := range []string{name, os.Getenv("QCTL_PROFILE"), cfg.DefaultProfile} {
+		if name = candidate; name != "" {
+			break
+		}
+	}
+	if name == "" {
+		name, explicit = "default", false
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		if !explicit {
+			return nil
+		}
+		return fmt.Errorf("no profile %q in %s; have %s", name, path, strings.Join(profileNames(cfg), ", "))
+	}
+
+	if p.Server != "" {
+		globals.server = p.Server
+	}
+	if p.Scheduler != "" {
+		globals.scheduler = p.Scheduler
+	}
+	if p.Registry != "" {
+		globals.registry = p.Registry
+	}
+	if p.VQE != "" {
+		globals.vqe = p.VQE
+	}
+	if p.Timeout != "" {
+		if globals.timeout, err = time.ParseDuration(p.Timeout); err != nil {
+			return fmt.Errorf("profile %q: invalid timeout: %v", name, err)
+		}
+	}
+	if p.Output != "" {
+		if err := globals.output.Set(p.Output); err != nil {
+			return fmt.Errorf("profile %q: invalid output: %v", name, err)
+		}
+	}
+	if p.Backend != "" {
+		if _, err := newBackend(p.Backend); err != nil {
+			return fmt.Errorf("profile %q: %v", name, err)
+		}
+	}
+	globals.tls = p.TLS
+	globals.caCert, globals.clientCert, globals.clientKey = p.CACert, p.ClientCert, p.ClientKey
+	globals.token = p.Token
+	active.name, active.path, active.profile = name, path, p
+	return nil
+}
+
+func profileNames(cfg *qctlConfig) []string {
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return []string{"none"}
+	}
+	return names
+}
+
+// defaultBackend is the profile's backend, or the local simulator
+func defaultBackend() string {
+	if active.Backend != "" {
+		return active.Backend
+	}
+	return localSim
+}
+
+// providerKey is a hardware provider's API key, from its environment
+// variable or else the profile
+func providerKey(provider string) string {
+	if key := os.Getenv(providerKeys[provider]); key != "" {
+		return key
+	}
+	return active.ProviderTokens[provider]
+}
+
+// ------------------------------------------------------------------
+// Commands
+// ------------------------------------------------------------------
+
+var configCmd = &command{
+	name:    "config",
+	summary: "Show the config file's profiles and the settings in use",
+	subs: []*command{
+		{name: "profiles", summary: "List the profiles in the config file", run: configProfiles},
+		{name: "show", summary: "Show the settings a command would use", run: configShow},
+	},
+}
+
+func configProfiles(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	cfg, err := readConfig(path)
+	if err != nil {
+		return err
+	}
+	if len(cfg.Profiles) == 0 {
+		fmt.Printf("No profiles in %s\n", path)
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\tPROFILE\tSERVER\tBACKEND\tOUTPUT")
+	for _, name := range profileNames(cfg) {
+		p := cfg.Profiles[name]
+		mark := ""
+		if name == active.name {
+			mark = "*"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", mark, name, orDash(p.Server), orDash(p.Backend), orDash(p.Output))
+	}
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// mask hides all but the end of a secret
+func mask(secret string) string {
+	if secret == "" {
+		return "-"
+	}
+	if len(secret) <= 4 {
+		return "****"
+	}
+	return "****" + secret[len(secret)-4:]
+}
+
+func configShow(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "config\t%s\n", path)
+	fmt.Fprintf(tw, "profile\t%s\n", orDash(active.name))
+	fmt.Fprintf(tw, "server\t%s\n", globals.server)
+	fmt.Fprintf(tw, "scheduler\t%s\n", globals.scheduler)
+	fmt.Fprintf(tw, "registry\t%s\n", globals.registry)
+	fmt.Fprintf(tw, "vqe\t%s\n", globals.vqe)
+	fmt.Fprintf(tw, "timeout\t%s\n", globals.timeout)
+	fmt.Fprintf(tw, "output\t%s\n", globals.output)
+	fmt.Fprintf(tw, "backend\t%s\n", defaultBackend())
+	fmt.Fprintf(tw, "tls\t%t\n", globals.secure())
+	fmt.Fprintf(tw, "ca cert\t%s\n", orDash(globals.caCert))
+	fmt.Fprintf(tw, "client cert\t%s\n", orDash(globals.clientCert))
+	fmt.Fprintf(tw, "client key\t%s\n", orDash(globals.clientKey))
+	fmt.Fprintf(tw, "token\t%s\n", mask(globals.token))
+	providers := make([]string, 0, len(providerKeys))
+	for provider := range providerKeys {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	for _, provider := range providers {
+		fmt.Fprintf(tw, "%s key\t%s\n", provider, mask(providerKey(provider)))
+	}
+	return tw.Flush()
+}
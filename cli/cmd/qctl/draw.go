@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var drawOpts struct {
+	file  string
+	ascii bool
+}
+
+var drawCmd = &command{
+	name:    "draw",
+	args:    "[circuit.json|circuit.qasm]",
+	summary: "Draw a circuit as a wire diagram",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&drawOpts.file, "file", "", "Path to circuit JSON or OpenQASM file, in place of the argument")
+		fs.BoolVar(&drawOpts.ascii, "ascii", false, "Draw with plain ASCII for terminals without Unicode")
+	},
+	run: drawCircuit,
+}
+
+// diagramGlyphs are the pieces a diagram is drawn with
+type diagramGlyphs struct {
+	wire, vertical, cross string
+	boxOpen, boxClose     string
+	control, target       string
+	pi                    string
+}
+
+var unicodeGlyphs = diagramGlyphs{
+	wire: "─", vertical: "│", cross: "┼",
+	boxOpen: "┤", boxClose: "├",
+	control: "●", target: "⊕",
+	pi: "π",
+}
+
+var asciiGlyphs = diagramGlyphs{
+	wire: "-", vertical: "|", cross: "+",
+	boxOpen: "[", boxClose: "]",
+	control: "*", target: "(+)",
+	pi: "pi",
+}
+
+// formatAngle writes an angle as a fraction of π where one fits, e.g. "-π/4"
+func (g diagramGlyphs) formatAngle(angle float64) string {
+	for den := 1; den <= 8; den++ {
+		num := angle * float64(den) / math.Pi
+		if n := math.Round(num); n != 0 && math.Abs(num-n) < 1e-9 {
+			s := g.pi
+			switch n {
+			case 1:
+			case -1:
+				s = "-" + s
+			default:
+				s = strconv.Itoa(int(n)) + s
+			}
+			if den > 1 {
+				s += "/" + strconv.Itoa(den)
+			}
+			return s
+		}
+	}
+	return strconv.FormatFloat(angle, 'g', 3, 64)
+}
+
+// opQubits is every qubit an operation touches, target last
+func opQubits(op CircuitOp) []uint32 {
+	switch strings.ToUpper(op.Gate) {
+	case "CNOT":
+		return []uint32{op.Control, op.Target}
+	case "TOFFOLI", "CCNOT":
+		return []uint32{op.Control, op.Control2, op.Target}
+	}
+	return []uint32{op.Target}
+}
+
+// gateLabel is what a boxed operation is labelled with, e.g. "RY(π/2)"
+func (g diagramGlyphs) gateLabel(op CircuitOp) string {
+	gate := strings.ToUpper(op.Gate)
+	switch gate {
+	case "M":
+		if op.ClassicalReg != op.Target {
+			return fmt.Sprintf("M:c%d", op.ClassicalReg)
+		}
+	case "RY", "RZ":
+		return gate + "(" + g.formatAngle(op.Angle) + ")"
+	}
+	return gate
+}
+
+// symbols are what an operation shows on each qubit it touches
+func (g diagramGlyphs) symbols(op CircuitOp) map[uint32]string {
+	switch strings.ToUpper(op.Gate) {
+	case "CNOT":
+		return map[uint32]string{op.Control: g.control, op.Target: g.target}
+	case "TOFFOLI", "CCNOT":
+		return map[uint32]string{op.Control: g.control, op.Control2: g.control, op.Target: g.target}
+	}
+	return map[uint32]string{op.Target: g.boxOpen + g.gateLabel(op) + g.boxClose}
+}
+
+// centre pads s to width with fill, splitting the padding either side
+func centre(s string, width int, fill string) string {
+	pad := width - utf8.RuneCountInString(s)
+	left := pad / 2
+	return strings.Repeat(fill, left) + s + strings.Repeat(fill, pad-left)
+}
+
+// opSpan is the lowest and highest wires an operation crosses
+func opSpan(op CircuitOp) (lo, hi int) {
+	touched := opQubits(op)
+	lo, hi = int(touched[0]), int(touched[0])
+	for _, q := range touched {
+		lo, hi = min(lo, int(q)), max(hi, int(q))
+	}
+	return lo, hi
+}
+
+// layoutColumns packs a circuit's operations into columns, putting each
+// in the first column after the ones already on the wires it spans, and
+// counts the wires it needs
+func layoutColumns(c *CircuitFile) (qubits int, columns [][]CircuitOp) {
+	qubits = int(c.Qubits)
+	for _, op := range c.Ops {
+		for _, q := range opQubits(op) {
+			qubits = max(qubits, int(q)+1)
+		}
+	}
+	level := make([]int, qubits)
+	for _, op := range c.Ops {
+		lo, hi := opSpan(op)
+		col := 0
+		for q := lo; q <= hi; q++ {
+			col = max(col, level[q])
+		}
+		for q := lo; q <= hi; q++ {
+			level[q] = col + 1
+		}
+		if col == len(columns) {
+			columns = append(columns, nil)
+		}
+		columns[col] = append(columns[col], op)
+	}
+	return qubits, columns
+}
+
+// renderCircuit draws the circuit one wire per qubit, packing
+// operations that share no span of wires into the same column
+func renderCircuit(c *CircuitFile, g diagramGlyphs) string {
+	qubits, layout := layoutColumns(c)
+	if qubits == 0 {
+		return ""
+	}
+
+	// Each column is the operations in it and the wires they span
+	type span struct {
+		lo, hi int
+		cells  map[uint32]string
+	}
+	columns := make([][]span, len(layout))
+	for i, ops := range layout {
+		for _, op := range ops {
+			lo, hi := opSpan(op)
+			columns[i] = append(columns[i], span{lo, hi, g.symbols(op)})
+		}
+	}
+
+	// Rows alternate wires and the gaps between them
+	label := func(q int) string { return fmt.Sprintf("q%d: ", q) }
+	indent := utf8.RuneCountInString(label(qubits - 1))
+	rows := make([]strings.Builder, 2*qubits-1)
+	for r := range rows {
+		if r%2 == 0 {
+			rows[r].WriteString(fmt.Sprintf("%*s", indent, label(r/2)))
+			rows[r].WriteString(g.wire)
+		} else {
+			rows[r].WriteString(strings.Repeat(" ", indent+1))
+		}
+	}
+	for _, col := range columns {
+		width := 1
+		for _, s := range col {
+			for _, sym := range s.cells {
+				width = max(width, utf8.RuneCountInString(sym))
+			}
+		}
+		for r := range rows {
+			q, gap := r/2, r%2 == 1
+			cell := ""
+			for _, s := range col {
+				switch {
+				case gap && q >= s.lo && q < s.hi:
+					cell = g.vertical
+				case !gap && s.cells[uint32(q)] != "":
+					cell = s.cells[uint32(q)]
+				case !gap && q > s.lo && q < s.hi:
+					cell = g.cross
+				}
+			}
+			if gap {
+				rows[r].WriteString(centre(cell, width, " ") + " ")
+			} else {
+				rows[r].WriteString(centre(cell, width, g.wire) + g.wire)
+			}
+		}
+	}
+
+	var out strings.Builder
+	for r := range rows {
+		out.WriteString(strings.TrimRight(rows[r].String(), " "))
+		out.WriteByte('\n')
+	}
+	return out.String()
+}
+
+func drawCircuit(ctx context.Context, args []string) error {
+	circuit, err := circuitArg(drawOpts.file, args)
+	if err != nil {
+		return err
+	}
+	if _, err := circuit.operations(); err != nil {
+		return err
+	}
+	if machineOutput() {
+		return emit(circuit)
+	}
+	g := unicodeGlyphs
+	if drawOpts.ascii {
+		g = asciiGlyphs
+	}
+	if circuit.Name != "" {
+		fmt.Printf("%s (%d qubits, %d ops)\n\n", circuit.Name, circuit.Qubits, len(circuit.Ops))
+	}
+	fmt.Print(renderCircuit(circuit, g))
+	return nil
+}
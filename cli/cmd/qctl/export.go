@@ -0,0 +1,541 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+var exportOpts struct {
+	file       string
+	circuitID  string
+	version    int
+	out        string
+	format     string
+	standalone bool
+	scale      int
+}
+
+var exportCmd = &command{
+	name:    "export",
+	args:    "[circuit.json|circuit.qasm|results.json]",
+	summary: "Export a circuit as QASM, LaTeX or PNG, or results as HDF5 or Parquet",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&exportOpts.out, "out", "", "Write to `file`, in the format its extension names unless -format is given")
+		fs.StringVar(&exportOpts.format, "format", "", "Format: qasm, latex or png for circuits; hdf5 or parquet for results")
+		fs.StringVar(&exportOpts.file, "file", "", "Path to the circuit or results, in place of the argument; - reads results from standard input")
+		fs.StringVar(&exportOpts.circuitID, "circuit-id", "", "Export a circuit saved in the registry instead of a file")
+		fs.IntVar(&exportOpts.version, "version", 0, "Version of the -circuit-id circuit; 0 for the latest")
+		fs.BoolVar(&exportOpts.standalone, "standalone", false, "Wrap LaTeX in a document that compiles on its own")
+		fs.IntVar(&exportOpts.scale, "scale", 2, "Pixels per point of PNG diagrams, 1 to 8")
+	},
+	run: runExport,
+}
+
+// exportExtensions are the formats output files are taken to want
+var exportExtensions = map[string]string{
+	".qasm":    "qasm",
+	".tex":     "latex",
+	".png":     "png",
+	".h5":      "hdf5",
+	".hdf5":    "hdf5",
+	".parquet": "parquet",
+}
+
+// circuitExporters write a circuit in each circuit format
+var circuitExporters = map[string]func(io.Writer, *CircuitFile) error{
+	"qasm":  writeQASM,
+	"latex": writeQuantikz,
+	"png":   writeDiagramPNG,
+}
+
+// resultExporters write results in each results format
+var resultExporters = map[string]func(io.Writer, *resultTable) error{
+	"hdf5":    writeHDF5,
+	"parquet": writeParquet,
+}
+
+type exportRecord struct {
+	File   string `json:"file"`
+	Format string `json:"format"`
+	Bytes  int    `json:"bytes"`
+}
+
+func (r exportRecord) header() []string { return []string{"file", "format", "bytes"} }
+
+func (r exportRecord) rows() [][]string {
+	return [][]string{{r.File, r.Format, strconv.Itoa(r.Bytes)}}
+}
+
+func runExport(ctx context.Context, args []string) error {
+	if exportOpts.out == "" {
+		return fmt.Errorf("-out is required")
+	}
+	format := exportOpts.format
+	if format == "" {
+		ext := strings.ToLower(filepath.Ext(exportOpts.out))
+		if format = exportExtensions[ext]; format == "" {
+			return fmt.Errorf("no format for %q files; give -format qasm, latex, png, hdf5 or parquet", ext)
+		}
+	}
+	if exportOpts.scale < 1 || exportOpts.scale > 8 {
+		return fmt.Errorf("-scale must be 1 to 8")
+	}
+
+	var buf bytes.Buffer
+	if write, ok := resultExporters[format]; ok {
+		if exportOpts.circuitID != "" {
+			return fmt.Errorf("-circuit-id exports circuits; %s takes results", format)
+		}
+		table, err := readResults(exportOpts.file, args)
+		if err != nil {
+			return err
+		}
+		if err := write(&buf, table); err != nil {
+			return err
+		}
+		notef("📦 %d %s rows\n", table.rows, table.name)
+	} else if write, ok := circuitExporters[format]; ok {
+		circuit, err := circuitSource(ctx, exportOpts.file, exportOpts.circuitID, exportOpts.version, args)
+		if err != nil {
+			return err
+		}
+		if _, err := circuit.operations(); err != nil {
+			return err
+		}
+		if err := write(&buf, circuit); err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("unknown -format %q; want qasm, latex, png, hdf5 or parquet", format)
+	}
+
+	if err := os.WriteFile(exportOpts.out, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", exportOpts.out, err)
+	}
+	rec := exportRecord{exportOpts.out, format, buf.Len()}
+	if machineOutput() {
+		return emit(rec)
+	}
+	fmt.Printf("✅ Wrote %s (%s, %s)\n", rec.File, rec.Format, formatBytes(uint64(rec.Bytes)))
+	return nil
+}
+
+// ------------------------------------------------------------------
+// OpenQASM
+// ------------------------------------------------------------------
+
+// qasmNames are the qelib1.inc gates the engine's gates are written as
+var qasmNames = map[string]string{
+	"H": "h", "X": "x", "S": "s", "T": "t", "RY": "ry", "RZ": "rz",
+	"CNOT": "cx", "TOFFOLI": "ccx", "CCNOT": "ccx",
+}
+
+// qasmAngle writes an angle as a multiple of pi where one fits, and in
+// full otherwise, so the program reads back exactly
+func qasmAngle(angle float64) string {
+	for den := 1; den <= 8; den++ {
+		num := angle * float64(den) / math.Pi
+		n := math.Round(num)
+		if n == 0 || math.Abs(num-n) >= 1e-9 {
+			continue
+		}
+		s := "pi"
+		switch n {
+		case 1:
+		case -1:
+			s = "-pi"
+		default:
+			s = strconv.Itoa(int(n)) + "*pi"
+		}
+		if den > 1 {
+			s += "/" + strconv.Itoa(den)
+		}
+		return s
+	}
+	return strconv.FormatFloat(angle, 'g', -1, 64)
+}
+
+// writeQASM writes the circuit as an OpenQASM 2.0 program, measuring
+// into a classical register as wide as the highest one it writes
+func writeQASM(w io.Writer, c *CircuitFile) error {
+	qubits, _ := layoutColumns(c)
+	cbits := 0
+	for _, op := range c.Ops {
+		if strings.EqualFold(op.Gate, "M") {
+			cbits = max(cbits, int(op.ClassicalReg)+1)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("OPENQASM 2.0;\ninclude \"qelib1.inc\";\n")
+	if c.Name != "" {
+		fmt.Fprintf(&b, "// %s\n", strings.ReplaceAll(c.Name, "\n", " "))
+	}
+	fmt.Fprintf(&b, "qreg q[%d];\n", qubits)
+	if cbits > 0 {
+		fmt.Fprintf(&b, "creg c[%d];\n", cbits)
+	}
+	for _, op := range c.Ops {
+		gate := strings.ToUpper(op.Gate)
+		switch gate {
+		case "M":
+			fmt.Fprintf(&b, "measure q[%d] -> c[%d];\n", op.Target, op.ClassicalReg)
+		case "CNOT":
+			fmt.Fprintf(&b, "cx q[%d],q[%d];\n", op.Control, op.Target)
+		case "TOFFOLI", "CCNOT":
+			fmt.Fprintf(&b, "ccx q[%d],q[%d],q[%d];\n", op.Control, op.Control2, op.Target)
+		case "RY", "RZ":
+			fmt.Fprintf(&b, "%s(%s) q[%d];\n", qasmNames[gate], qasmAngle(op.Angle), op.Target)
+		default:
+			name, ok := qasmNames[gate]
+			if !ok {
+				return fmt.Errorf("unknown gate type: %s", op.Gate)
+			}
+			fmt.Fprintf(&b, "%s q[%d];\n", name, op.Target)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ------------------------------------------------------------------
+// LaTeX
+// ------------------------------------------------------------------
+
+var latexGlyphs = diagramGlyphs{pi: `\pi`}
+
+// writeQuantikz writes the circuit as a quantikz environment, laid out in
+// the columns qctl draw uses
+func writeQuantikz(w io.Writer, c *CircuitFile) error {
+	qubits, columns := layoutColumns(c)
+	grid := make([][]string, qubits)
+	for q := range grid {
+		grid[q] = make([]string, len(columns))
+		for col := range grid[q] {
+			grid[q][col] = `\qw`
+		}
+	}
+	for col, ops := range columns {
+		for _, op := range ops {
+			ctrl := func(q uint32) string { return fmt.Sprintf(`\ctrl{%d}`, int(op.Target)-int(q)) }
+			switch gate := strings.ToUpper(op.Gate); gate {
+			case "CNOT":
+				grid[op.Control][col], grid[op.Target][col] = ctrl(op.Control), `\targ{}`
+			case "TOFFOLI", "CCNOT":
+				grid[op.Control][col], grid[op.Control2][col] = ctrl(op.Control), ctrl(op.Control2)
+				grid[op.Target][col] = `\targ{}`
+			case "M":
+				grid[op.Target][col] = `\meter{}`
+			case "RY", "RZ":
+				grid[op.Target][col] = fmt.Sprintf(`\gate{R_%c(%s)}`, gate[1]+'a'-'A', latexGlyphs.formatAngle(op.Angle))
+			default:
+				grid[op.Target][col] = fmt.Sprintf(`\gate{%s}`, gate)
+			}
+		}
+	}
+
+	var b strings.Builder
+	if exportOpts.standalone {
+		b.WriteString("\\documentclass[border=4pt]{standalone}\n\\usepackage{quantikz}\n\\begin{document}\n")
+	} else {
+		b.WriteString("% Needs \\usepackage{quantikz}\n")
+	}
+	if c.Name != "" {
+		fmt.Fprintf(&b, "%% %s\n", strings.ReplaceAll(c.Name, "\n", " "))
+	}
+	b.WriteString("\\begin{quantikz}\n")
+	for q, cells := range grid {
+		fmt.Fprintf(&b, `\lstick{$q_{%d}$}`, q)
+		for _, cell := range cells {
+			b.WriteString(" & " + cell)
+		}
+		b.WriteString(` & \qw`)
+		if q < qubits-1 {
+			b.WriteString(` \\`)
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString("\\end{quantikz}\n")
+	if exportOpts.standalone {
+		b.WriteString("\\end{document}\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ------------------------------------------------------------------
+// PNG
+// ------------------------------------------------------------------
+
+// Diagram geometry in points, before -scale
+const (
+	pngRow     = 28 // between wires
+	pngMargin  = 12
+	pngGap     = 10 // between columns
+	pngMinCell = 20
+	pngTarget  = 7 // radius of a CNOT target
+)
+
+var diagramInk = color.RGBA{0x1B, 0x26, 0x4F, 0xFF}
+
+// diagramCanvas draws in points on an image, in diagramInk
+type diagramCanvas struct {
+	img *image.RGBA
+	ink *image.Uniform
+}
+
+func (d diagramCanvas) rect(r image.Rectangle) {
+	draw.Draw(d.img, r, d.ink, image.Point{}, draw.Src)
+}
+
+// disc fills a circle, or outlines it when ring is set
+func (d diagramCanvas) disc(cx, cy, r int, ring bool) {
+	for y := cy - r - 1; y <= cy+r+1; y++ {
+		for x := cx - r - 1; x <= cx+r+1; x++ {
+			dist := math.Hypot(float64(x-cx), float64(y-cy))
+			if dist <= float64(r)+0.5 && (!ring || dist >= float64(r)-0.5) {
+				d.img.Set(x, y, d.ink.C)
+			}
+		}
+	}
+}
+
+// text writes s centred on (cx, cy) in the 7x13 bitmap font
+func (d diagramCanvas) text(s string, cx, cy int) {
+	face := basicfont.Face7x13
+	width := font.MeasureString(face, s).Ceil()
+	dot := fixed.P(cx-width/2, cy+(face.Ascent-face.Descent)/2)
+	(&font.Drawer{Dst: d.img, Src: d.ink, Face: face, Dot: dot}).DrawString(s)
+}
+
+// box draws a labelled gate box centred on (cx, cy)
+func (d diagramCanvas) box(label string, cx, cy, width int) {
+	r := image.Rect(cx-width/2, cy-pngRow/2+4, cx+width/2, cy+pngRow/2-4)
+	d.rect(r)
+	draw.Draw(d.img, r.Inset(1), image.White, image.Point{}, draw.Src)
+	d.text(label, cx, cy)
+}
+
+// labelWidth is how wide a gate box is for its label
+func labelWidth(label string) int {
+	return max(font.MeasureString(basicfont.Face7x13, label).Ceil()+10, pngMinCell)
+}
+
+// writeDiagramPNG draws the circuit as qctl draw lays it out, scaled up
+// -scale times so the bitmap font stays sharp
+func writeDiagramPNG(w io.Writer, c *CircuitFile) error {
+	qubits, columns := layoutColumns(c)
+	if qubits == 0 {
+		return fmt.Errorf("the circuit has no qubits to draw")
+	}
+	label := func(q int) string { return fmt.Sprintf("q%d", q) }
+	left := pngMargin + labelWidth(label(qubits-1))
+	widths := make([]int, len(columns))
+	right := left + pngGap
+	for i, ops := range columns {
+		widths[i] = pngMinCell
+		for _, op := range ops {
+			if len(opQubits(op)) == 1 {
+				widths[i] = max(widths[i], labelWidth(asciiGlyphs.gateLabel(op)))
+			}
+		}
+		right += widths[i] + pngGap
+	}
+	bounds := image.Rect(0, 0, right+pngMargin, 2*pngMargin+qubits*pngRow)
+	d := diagramCanvas{image.NewRGBA(bounds), image.NewUniform(diagramInk)}
+	draw.Draw(d.img, bounds, image.White, image.Point{}, draw.Src)
+
+	wire := func(q int) int { return pngMargin + q*pngRow + pngRow/2 }
+	for q := 0; q < qubits; q++ {
+		d.text(label(q), pngMargin+labelWidth(label(q))/2-5, wire(q))
+		d.rect(image.Rect(left, wire(q), right, wire(q)+1))
+	}
+	x := left + pngGap
+	for i, ops := range columns {
+		cx := x + widths[i]/2
+		for _, op := range ops {
+			lo, hi := opSpan(op)
+			if lo != hi {
+				d.rect(image.Rect(cx, wire(lo), cx+1, wire(hi)+1))
+			}
+			switch strings.ToUpper(op.Gate) {
+			case "CNOT", "TOFFOLI", "CCNOT":
+				for _, q := range opQubits(op)[:len(opQubits(op))-1] {
+					d.disc(cx, wire(int(q)), 3, false)
+				}
+				t := wire(int(op.Target))
+				d.disc(cx, t, pngTarget, true)
+				d.rect(image.Rect(cx-pngTarget, t, cx+pngTarget+1, t+1))
+				d.rect(image.Rect(cx, t-pngTarget, cx+1, t+pngTarget+1))
+			default:
+				l := asciiGlyphs.gateLabel(op)
+				d.box(l, cx, wire(int(op.Target)), labelWidth(l))
+			}
+		}
+		x += widths[i] + pngGap
+	}
+
+	k := exportOpts.scale
+	scaled := image.NewRGBA(image.Rect(0, 0, bounds.Dx()*k, bounds.Dy()*k))
+	for y := 0; y < scaled.Rect.Dy(); y++ {
+		for x := 0; x < scaled.Rect.Dx(); x++ {
+			scaled.SetRGBA(x, y, d.img.RGBAAt(x/k, y/k))
+		}
+	}
+	return png.Encode(w, scaled)
+}
+
+// ------------------------------------------------------------------
+// Results
+// ------------------------------------------------------------------
+
+// resultColumn is one typed column of a result table; exactly one of its
+// slices is set
+type resultColumn struct {
+	name    string
+	ints    []int64
+	floats  []float64
+	strings []string
+}
+
+// resultTable is results as typed columns, with what they are results of
+// as string attributes, for the formats that keep types
+type resultTable struct {
+	name    string
+	rows    int
+	columns []resultColumn
+	attrs   [][2]string
+}
+
+func (t *resultTable) attr(key, value string) {
+	if value != "" && value != "0" {
+		t.attrs = append(t.attrs, [2]string{key, value})
+	}
+}
+
+// resultFile is what qctl run and qctl vqe run write with -output json,
+// for any kind of run
+type resultFile struct {
+	Circuit string `json:"circuit"`
+	Qubits  int32  `json:"qubits"`
+	Backend string `json:"backend"`
+	JobID   string `json:"job_id"`
+	Shots   int    `json:"shots"`
+	// A many-shot run
+	Outcomes []outcome `json:"outcomes"`
+	// A run's final state, or each step's when streamed
+	stateRecord
+	Steps []stateRecord `json:"steps"`
+	// A VQE run
+	Molecule  string    `json:"molecule"`
+	Ansatz    string    `json:"ansatz"`
+	Optimizer string    `json:"optimizer"`
+	Energy    float64   `json:"energy"`
+	History   []vqeStep `json:"history"`
+}
+
+// readResults reads results saved from -output json, from a file or
+// standard input
+func readResults(file string, args []string) (*resultTable, error) {
+	switch {
+	case file == "" && len(args) == 1:
+		file = args[0]
+	case file == "" || len(args) > 0:
+		return nil, fmt.Errorf("give one results file, as an argument or with -file")
+	}
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results: %v", err)
+	}
+	var r resultFile
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("invalid results in %s: %v", file, err)
+	}
+	return r.table(file)
+}
+
+func (r *resultFile) table(file string) (*resultTable, error) {
+	t := &resultTable{}
+	t.attr("circuit", r.Circuit)
+	t.attr("qubits", strconv.Itoa(int(r.Qubits)))
+	t.attr("backend", r.Backend)
+	t.attr("job_id", r.JobID)
+	t.attr("shots", strconv.Itoa(r.Shots))
+
+	switch {
+	case r.Outcomes != nil:
+		t.name, t.rows = "outcomes", len(r.Outcomes)
+		bits, counts := make([]string, t.rows), make([]int64, t.rows)
+		probs, errs := make([]float64, t.rows), make([]float64, t.rows)
+		for i, o := range r.Outcomes {
+			bits[i], counts[i], probs[i], errs[i] = o.Bits, int64(o.Count), o.Probability, o.StdError
+		}
+		t.columns = []resultColumn{
+			{name: "bits", strings: bits}, {name: "count", ints: counts},
+			{name: "probability", floats: probs}, {name: "std_error", floats: errs},
+		}
+	case r.History != nil:
+		t.attr("molecule", r.Molecule)
+		t.attr("ansatz", r.Ansatz)
+		t.attr("optimizer", r.Optimizer)
+		t.attr("energy", formatFloat(r.Energy))
+		t.name, t.rows = "vqe_history", len(r.History)
+		iters, energies := make([]int64, t.rows), make([]float64, t.rows)
+		variances, gradients := make([]float64, t.rows), make([]float64, t.rows)
+		for i, s := range r.History {
+			iters[i], energies[i], variances[i], gradients[i] = int64(s.Iteration), s.Energy, s.Variance, s.GradientNorm
+		}
+		t.columns = []resultColumn{
+			{name: "iteration", ints: iters}, {name: "energy", floats: energies},
+			{name: "energy_variance", floats: variances}, {name: "gradient_norm", floats: gradients},
+		}
+	case r.Amplitudes != nil || r.Steps != nil:
+		steps := r.Steps
+		if r.Amplitudes != nil {
+			steps = []stateRecord{r.stateRecord}
+		}
+		t.name = "amplitudes"
+		cols := []resultColumn{
+			{name: "step", ints: []int64{}}, {name: "state", ints: []int64{}}, {name: "bits", strings: []string{}},
+			{name: "real", floats: []float64{}}, {name: "imag", floats: []float64{}}, {name: "probability", floats: []float64{}},
+		}
+		for _, s := range steps {
+			for _, a := range s.Amplitudes {
+				cols[0].ints = append(cols[0].ints, int64(s.Step))
+				cols[1].ints = append(cols[1].ints, int64(a.State))
+				cols[2].strings = append(cols[2].strings, a.Bits)
+				cols[3].floats = append(cols[3].floats, a.Real)
+				cols[4].floats = append(cols[4].floats, a.Imag)
+				cols[5].floats = append(cols[5].floats, a.Probability)
+				t.rows++
+			}
+		}
+		t.columns = cols
+	default:
+		return nil, fmt.Errorf("%s holds no results; save them with qctl run -output json", file)
+	}
+	return t, nil
+}
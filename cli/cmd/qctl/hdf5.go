@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/bits"
+)
+
+// ------------------------------------------------------------------
+// HDF5
+// ------------------------------------------------------------------
+
+// Results are written in the plainest form of HDF5 that HDF5 1.10 and
+// later read: a version 2 superblock and object headers, a root group
+// holding the table's attributes, and under it a group named after the
+// table with one contiguous, uncompressed dataset per column. Groups
+// link their members compactly, in their own headers, so the file needs
+// no B-trees or heaps.
+
+var hdf5Signature = []byte{0x89, 'H', 'D', 'F', '\r', '\n', 0x1a, '\n'}
+
+const (
+	hdf5SuperblockSize = 48
+	// hdf5Undefined is the address of nothing
+	hdf5Undefined = math.MaxUint64
+)
+
+// Object header message types
+const (
+	hdf5Dataspace = 0x01
+	hdf5LinkInfo  = 0x02
+	hdf5Datatype  = 0x03
+	hdf5FillValue = 0x05
+	hdf5Link      = 0x06
+	hdf5Layout    = 0x08
+	hdf5GroupInfo = 0x0A
+	hdf5Attribute = 0x0C
+)
+
+// hdf5Constant marks a message that never changes
+const hdf5Constant = 0x01
+
+type hdf5Message struct {
+	kind  uint8
+	flags uint8
+	data  []byte
+}
+
+// hdf5Bytes builds a little-endian field sequence
+type hdf5Bytes struct{ bytes.Buffer }
+
+func (b *hdf5Bytes) u8(v uint8)   { b.WriteByte(v) }
+func (b *hdf5Bytes) u16(v uint16) { b.Write(binary.LittleEndian.AppendUint16(nil, v)) }
+func (b *hdf5Bytes) u32(v uint32) { b.Write(binary.LittleEndian.AppendUint32(nil, v)) }
+func (b *hdf5Bytes) u64(v uint64) { b.Write(binary.LittleEndian.AppendUint64(nil, v)) }
+
+// hdf5Header is a version 2 object header holding msgs
+func hdf5Header(msgs ...hdf5Message) []byte {
+	var body hdf5Bytes
+	for _, m := range msgs {
+		body.u8(m.kind)
+		body.u16(uint16(len(m.data)))
+		body.u8(m.flags)
+		body.Write(m.data)
+	}
+	var h hdf5Bytes
+	h.WriteString("OHDR")
+	h.u8(2)    // version
+	h.u8(0x02) // the size of chunk 0 takes four bytes; no times or phase changes
+	h.u32(uint32(body.Len()))
+	h.Write(body.Bytes())
+	h.u32(lookup3(h.Bytes()))
+	return h.Bytes()
+}
+
+// hdf5Space is a dataspace of n elements, or a scalar when n is negative
+func hdf5Space(n int) []byte {
+	var b hdf5Bytes
+	if n < 0 {
+		b.Write([]byte{2, 0, 0, 0}) // version 2, no dimensions, scalar
+		return b.Bytes()
+	}
+	b.Write([]byte{2, 1, 0, 1}) // version 2, one dimension, no maximum, simple
+	b.u64(uint64(n))
+	return b.Bytes()
+}
+
+// hdf5Type is the datatype of a column's elements, and their size
+func hdf5Type(col resultColumn) ([]byte, int) {
+	var b hdf5Bytes
+	switch {
+	case col.ints != nil:
+		// Fixed-point, version 1: little-endian, signed, 64 bits from bit 0
+		b.Write([]byte{0x10, 0x08, 0, 0})
+		b.u32(8)
+		b.u16(0)
+		b.u16(64)
+		return b.Bytes(), 8
+	case col.floats != nil:
+		// Floating-point, version 1: little-endian IEEE 754 double, with
+		// the sign at bit 63 and the mantissa's leading 1 implied
+		b.Write([]byte{0x11, 0x20, 63, 0})
+		b.u32(8)
+		b.u16(0)
+		b.u16(64)
+		b.Write([]byte{52, 11, 0, 52})
+		b.u32(1023)
+		return b.Bytes(), 8
+	}
+	width := 1
+	for _, s := range col.strings {
+		width = max(width, len(s))
+	}
+	return hdf5String(width), width
+}
+
+// hdf5String is the datatype of UTF-8 strings null-padded to width bytes
+func hdf5String(width int) []byte {
+	var b hdf5Bytes
+	b.Write([]byte{0x13, 0x11, 0, 0}) // string, version 1: null-padded UTF-8
+	b.u32(uint32(width))
+	return b.Bytes()
+}
+
+// hdf5Data is a column's elements as stored
+func hdf5Data(col resultColumn, width int) []byte {
+	var b hdf5Bytes
+	for _, v := range col.ints {
+		b.u64(uint64(v))
+	}
+	for _, v := range col.floats {
+		b.u64(math.Float64bits(v))
+	}
+	for _, s := range col.strings {
+		b.WriteString(s)
+		b.Write(make([]byte, width-len(s)))
+	}
+	return b.Bytes()
+}
+
+// hdf5Attr is a string attribute message
+func hdf5Attr(name, value string) hdf5Message {
+	dtype, space := hdf5String(max(len(value), 1)), hdf5Space(-1)
+	var b hdf5Bytes
+	b.u8(3) // version
+	b.u8(0)
+	b.u16(uint16(len(name) + 1))
+	b.u16(uint16(len(dtype)))
+	b.u16(uint16(len(space)))
+	b.u8(1) // UTF-8 name
+	b.WriteString(name)
+	b.u8(0)
+	b.Write(dtype)
+	b.Write(space)
+	b.WriteString(value)
+	b.Write(make([]byte, max(len(value), 1)-len(value)))
+	return hdf5Message{hdf5Attribute, 0, b.Bytes()}
+}
+
+// hdf5Group is the messages of a group linking each name to its object
+func hdf5Group(names []string, addrs []uint64) []hdf5Message {
+	var info hdf5Bytes
+	info.u8(0) // version
+	info.u8(0) // no creation order
+	info.u64(hdf5Undefined)
+	info.u64(hdf5Undefined)
+	msgs := []hdf5Message{{hdf5LinkInfo, 0, info.Bytes()}, {hdf5GroupInfo, 0, []byte{0, 0}}}
+	for i, name := range names {
+		var link hdf5Bytes
+		link.u8(1) // version
+		link.u8(0) // a hard link, with a one-byte name length
+		link.u8(uint8(len(name)))
+		link.WriteString(name)
+		link.u64(addrs[i])
+		msgs = append(msgs, hdf5Message{hdf5Link, 0, link.Bytes()})
+	}
+	return msgs
+}
+
+// hdf5Dataset is the header of a one-dimensional dataset stored size
+// bytes at addr
+func hdf5Dataset(rows int, dtype []byte, addr uint64, size int) []byte {
+	if size == 0 {
+		addr = hdf5Undefined
+	}
+	var layout hdf5Bytes
+	layout.u8(3) // version
+	layout.u8(1) // contiguous
+	layout.u64(addr)
+	layout.u64(uint64(size))
+	return hdf5Header(
+		hdf5Message{hdf5Dataspace, 0, hdf5Space(rows)},
+		hdf5Message{hdf5Datatype, hdf5Constant, dtype},
+		// Version 3, allocated early, written only if set, none set
+		hdf5Message{hdf5FillValue, hdf5Constant, []byte{3, 0x09}},
+		hdf5Message{hdf5Layout, 0, layout.Bytes()},
+	)
+}
+
+func writeHDF5(w io.Writer, t *resultTable) error {
+	names := make([]string, len(t.columns))
+	types := make([][]byte, len(t.columns))
+	data := make([][]byte, len(t.columns))
+	for i, col := range t.columns {
+		var width int
+		names[i] = col.name
+		types[i], width = hdf5Type(col)
+		data[i] = hdf5Data(col, width)
+	}
+	var attrs []hdf5Message
+	for _, a := range t.attrs {
+		attrs = append(attrs, hdf5Attr(a[0], a[1]))
+	}
+
+	// Headers hold addresses in fixed-size fields, so they are built once
+	// to measure them and again once everything has its place
+	objects := func(addrs, dataAddrs []uint64) [][]byte {
+		objs := [][]byte{
+			hdf5Header(append(attrs, hdf5Group([]string{t.name}, addrs[1:2])...)...),
+			hdf5Header(hdf5Group(names, addrs[2:])...),
+		}
+		for i := range t.columns {
+			objs = append(objs, hdf5Dataset(t.rows, types[i], dataAddrs[i], len(data[i])))
+		}
+		return objs
+	}
+	addrs, dataAddrs := make([]uint64, 2+len(t.columns)), make([]uint64, len(t.columns))
+	next := uint64(hdf5SuperblockSize)
+	for i, obj := range objects(addrs, dataAddrs) {
+		addrs[i] = next
+		next += uint64(len(obj))
+	}
+	for i, d := range data {
+		dataAddrs[i] = next
+		next += uint64(len(d))
+	}
+
+	var sb hdf5Bytes
+	sb.Write(hdf5Signature)
+	sb.Write([]byte{2, 8, 8, 0}) // version 2, 8-byte addresses and lengths, no flags
+	sb.u64(0)                    // base address
+	sb.u64(hdf5Undefined)        // no superblock extension
+	sb.u64(next)                 // end of file
+	sb.u64(addrs[0])             // root group
+	sb.u32(lookup3(sb.Bytes()))
+
+	chunks := append([][]byte{sb.Bytes()}, objects(addrs, dataAddrs)...)
+	for _, c := range append(chunks, data...) {
+		if _, err := w.Write(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup3 is Bob Jenkins' hashlittle with a zero seed, the checksum
+// HDF5 puts on its metadata
+func lookup3(k []byte) uint32 {
+	rot := bits.RotateLeft32
+	a := 0xdeadbeef + uint32(len(k))
+	b, c := a, a
+	for len(k) > 12 {
+		a += binary.LittleEndian.Uint32(k[0:])
+		b += binary.LittleEndian.Uint32(k[4:])
+		c += binary.LittleEndian.Uint32(k[8:])
+		a -= c
+		a ^= rot(c, 4)
+		c += b
+		b -= a
+		b ^= rot(a, 6)
+		a += c
+		c -= b
+		c ^= rot(b, 8)
+		b += a
+		a -= c
+		a ^= rot(c, 16)
+		c += b
+		b -= a
+		b ^= rot(a, 19)
+		a += c
+		c -= b
+		c ^= rot(b, 4)
+		b += a
+		k = k[12:]
+	}
+	if len(k) == 0 {
+		return c
+	}
+	var tail [12]byte
+	copy(tail[:], k)
+	a += binary.LittleEndian.Uint32(tail[0:])
+	b += binary.LittleEndian.Uint32(tail[4:])
+	c += binary.LittleEndian.Uint32(tail[8:])
+	c ^= b
+	c -= rot(b, 14)
+	a ^= c
+	a -= rot(c, 11)
+	b ^= a
+	b -= rot(a, 25)
+	c ^= b
+	c -= rot(b, 16)
+	a ^= c
+	a -= rot(c, 4)
+	b ^= a
+	b -= rot(a, 14)
+	c ^= b
+	c -= rot(b, 24)
+	return c
+}
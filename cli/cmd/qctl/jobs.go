@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	sched "github.com/perclft/QubitEngine/cli/internal/generated/scheduler"
+)
+
+var jobsListOpts struct {
+	user   string
+	state  string
+	limit  int
+	offset int
+}
+
+var jobsCmd = &command{
+	name:    "jobs",
+	summary: "Inspect and cancel jobs on the scheduler",
+	subs: []*command{
+		{
+			name:    "list",
+			summary: "List jobs, newest first",
+			flags: func(fs *flag.FlagSet) {
+				fs.StringVar(&jobsListOpts.user, "user", "", "Only this user's jobs")
+				fs.StringVar(&jobsListOpts.state, "state", "", "Only jobs in this state: queued, running, completed, failed or cancelled")
+				fs.IntVar(&jobsListOpts.limit, "limit", 20, "Most jobs to list")
+				fs.IntVar(&jobsListOpts.offset, "offset", 0, "Jobs to skip")
+			},
+			run: jobsList,
+		},
+		{name: "status", args: "<job-id>", summary: "Show a job's state and progress", run: jobsStatus},
+		{name: "cancel", args: "<job-id>", summary: "Cancel a queued or running job", run: jobsCancel},
+	},
+}
+
+// jobRecord is a job's status as JSON or CSV
+type jobRecord struct {
+	JobID           string `json:"job_id"`
+	State           string `json:"state"`
+	ProgressPercent int32  `json:"progress_percent"`
+	PositionInQueue int32  `json:"position_in_queue"`
+	WorkerID        string `json:"worker_id"`
+	StartedAt       int64  `json:"started_at"`
+	CompletedAt     int64  `json:"completed_at"`
+	Error           string `json:"error,omitempty"`
+}
+
+func newJobRecord(job *sched.JobStatus) jobRecord {
+	return jobRecord{job.JobId, jobStateName(job.State), job.ProgressPercent, job.PositionInQueue,
+		job.WorkerId, job.StartedAt, job.CompletedAt, job.ErrorMessage}
+}
+
+var jobHeader = []string{"job_id", "state", "progress_percent", "position_in_queue", "worker_id", "started_at", "completed_at", "error"}
+
+func (j jobRecord) row() []string {
+	return []string{j.JobID, j.State, fmt.Sprint(j.ProgressPercent), fmt.Sprint(j.PositionInQueue),
+		j.WorkerID, fmt.Sprint(j.StartedAt), fmt.Sprint(j.CompletedAt), j.Error}
+}
+
+func (j jobRecord) header() []string { return jobHeader }
+func (j jobRecord) rows() [][]string { return [][]string{j.row()} }
+
+type jobList struct {
+	Jobs       []jobRecord `json:"jobs"`
+	TotalCount int32       `json:"total_count"`
+}
+
+func (l jobList) header() []string { return jobHeader }
+
+func (l jobList) rows() [][]string {
+	rows := make([][]string, len(l.Jobs))
+	for i, j := range l.Jobs {
+		rows[i] = j.row()
+	}
+	return rows
+}
+
+type cancelRecord struct {
+	JobID     string `json:"job_id"`
+	Cancelled bool   `json:"cancelled"`
+	Message   string `json:"message"`
+}
+
+func (c cancelRecord) header() []string { return []string{"job_id", "cancelled", "message"} }
+func (c cancelRecord) rows() [][]string {
+	return [][]string{{c.JobID, fmt.Sprint(c.Cancelled), c.Message}}
+}
+
+// schedulerClient connects to the scheduler; the caller closes the
+// returned function when done
+func schedulerClient() (sched.QuantumSchedulerClient, func(), error) {
+	conn, err := dial(globals.scheduler)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sched.NewQuantumSchedulerClient(conn), func() { conn.Close() }, nil
+}
+
+// parseJobState reads a state by its short name, e.g. "running"
+func parseJobState(name string) (sched.JobState, error) {
+	state, ok := sched.JobState_value["STATE_"+strings.ToUpper(name)]
+	if !ok || state == int32(sched.JobState_STATE_UNKNOWN) {
+		return 0, fmt.Errorf("unknown job state %q", name)
+	}
+	return sched.JobState(state), nil
+}
+
+// jobStateName is a state's short name
+func jobStateName(state sched.JobState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "STATE_"))
+}
+
+// formatUnix prints a Unix timestamp, or "-" when unset
+func formatUnix(sec int64) string {
+	if sec == 0 {
+		return "-"
+	}
+	return time.Unix(sec, 0).Format(time.DateTime)
+}
+
+func jobsList(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	req := &sched.ListJobsRequest{
+		UserId: jobsListOpts.user,
+		Limit:  int32(jobsListOpts.limit),
+		Offset: int32(jobsListOpts.offset),
+	}
+	if jobsListOpts.state != "" {
+		state, err := parseJobState(jobsListOpts.state)
+		if err != nil {
+			return err
+		}
+		req.StateFilter = state
+	}
+
+	client, done, err := schedulerClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	list, err := client.ListJobs(ctx, req)
+	if err != nil {
+		return fmt.Errorf("listing jobs failed: %v", err)
+	}
+	if machineOutput() {
+		rec := jobList{Jobs: []jobRecord{}, TotalCount: list.TotalCount}
+		for _, job := range list.Jobs {
+			rec.Jobs = append(rec.Jobs, newJobRecord(job))
+		}
+		return emit(rec)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "JOB ID\tSTATE\tPROGRESS\tQUEUE\tWORKER\tSTARTED")
+	for _, job := range list.Jobs {
+		fmt.Fprintf(tw, "%s\t%s\t%d%%\t%d\t%s\t%s\n", job.JobId, jobStateName(job.State),
+			job.ProgressPercent, job.PositionInQueue, job.WorkerId, formatUnix(job.StartedAt))
+	}
+	tw.Flush()
+	fmt.Printf("\n%d of %d jobs\n", len(list.Jobs), list.TotalCount)
+	return nil
+}
+
+func jobsStatus(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<job-id>"); err != nil {
+		return err
+	}
+	client, done, err := schedulerClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	job, err := client.GetJobStatus(ctx, &sched.JobHandle{JobId: args[0]})
+	if err != nil {
+		return fmt.Errorf("job status failed: %v", err)
+	}
+	if machineOutput() {
+		return emit(newJobRecord(job))
+	}
+
+	fmt.Printf("📋 Job %s\n", job.JobId)
+	fmt.Printf("   State:     %s\n", jobStateName(job.State))
+	fmt.Printf("   Progress:  %d%%\n", job.ProgressPercent)
+	if job.State == sched.JobState_STATE_QUEUED {
+		fmt.Printf("   Queue:     #%d\n", job.PositionInQueue)
+	}
+	if job.WorkerId != "" {
+		fmt.Printf("   Worker:    %s\n", job.WorkerId)
+	}
+	fmt.Printf("   Started:   %s\n", formatUnix(job.StartedAt))
+	fmt.Printf("   Completed: %s\n", formatUnix(job.CompletedAt))
+	if job.ErrorMessage != "" {
+		fmt.Printf("   Error:     %s\n", job.ErrorMessage)
+	}
+	return nil
+}
+
+func jobsCancel(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<job-id>"); err != nil {
+		return err
+	}
+	client, done, err := schedulerClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	res, err := client.CancelJob(ctx, &sched.JobHandle{JobId: args[0]})
+	if err != nil {
+		return fmt.Errorf("cancel failed: %v", err)
+	}
+	if machineOutput() {
+		if err := emit(cancelRecord{args[0], res.Success, res.Message}); err != nil {
+			return err
+		}
+	}
+	if !res.Success {
+		return fmt.Errorf("job %s was not cancelled: %s", args[0], res.Message)
+	}
+	notef("🛑 Cancelled job %s\n", args[0])
+	return nil
+}
@@ -2,241 +2,356 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
-	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
-	pb "github.com/perclft/QubitEngine/cli/internal/generated"
-
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-// The new Circuit DSL
-type CircuitFile struct {
-	Name   string `json:"name"`
-	Qubits int32  `json:"qubits"`
-	Ops    []struct {
-		Gate         string  `json:"gate"`
-		Target       uint32  `json:"target"`
-		Control      uint32  `json:"control"`
-		Control2     uint32  `json:"control2"` // For Toffoli
-		Angle        float64 `json:"angle"`    // For Rotations
-		ClassicalReg uint32  `json:"classical_reg"`
-	} `json:"ops"`
+// ------------------------------------------------------------------
+// Commands
+// ------------------------------------------------------------------
+
+// command is one qctl verb. A command with subcommands dispatches on its
+// first argument; any other parses its flags and runs.
+type command struct {
+	name    string
+	args    string // Positional arguments, for the usage line
+	summary string
+	flags   func(fs *flag.FlagSet)
+	run     func(ctx context.Context, args []string) error
+	subs    []*command
 }
 
-func main() {
-	serverAddr := flag.String("server", "localhost:50051", "Engine Address")
-	fileArg := flag.String("file", "", "Path to circuit JSON file")
-	streamMode := flag.Bool("stream", false, "Enable Real-Time Streaming Visualization")
-	vizMode := flag.Bool("viz", false, "Enable Server-Side Visualization Stream")
-	flag.Parse()
+var rootCmd = &command{
+	name:    "qctl",
+	summary: "Command-line client for the Qubit Engine",
+	subs:    []*command{runCmd, validateCmd, submitCmd, drawCmd, exportCmd, replCmd, jobsCmd, registryCmd, backendsCmd, benchCmd, vqeCmd, configCmd, pluginsCmd, completionCmd},
+}
 
-	if *fileArg == "" {
-		fmt.Println("❌ Usage: qctl -file <circuit.json> [-server host:port] [-stream] [-viz]")
-		os.Exit(1)
+func (c *command) find(name string) *command {
+	for _, sub := range c.subs {
+		if sub.name == name {
+			return sub
+		}
 	}
+	return nil
+}
 
-	// 1. Read & Parse Circuit
-	data, err := os.ReadFile(*fileArg)
-	if err != nil {
-		log.Fatalf("Failed to read file: %v", err)
-	}
+// globalFlags apply to every command, given before or after its name
+type globalFlags struct {
+	server    string
+	scheduler string
+	registry  string
+	vqe       string
+	timeout   time.Duration
+	output    outputFormat
+	profile   string
 
-	var circuit CircuitFile
-	if err := json.Unmarshal(data, &circuit); err != nil {
-		log.Fatalf("Invalid JSON format: %v", err)
-	}
+	tls        bool
+	caCert     string
+	clientCert string
+	clientKey  string
+	token      string
+}
 
-	// 2. Connect to Engine
-	conn, err := grpc.NewClient(*serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Connection failed: %v", err)
-	}
-	defer conn.Close()
-	c := pb.NewQuantumComputeClient(conn)
-
-	// 3. Build Proto Operations
-	fmt.Printf("⚡ Submitting Circuit: '%s' (%d Qubits)\n", circuit.Name, circuit.Qubits)
-
-	var pbOps []*pb.GateOperation
-	for _, op := range circuit.Ops {
-		pbOp := &pb.GateOperation{
-			TargetQubit:        op.Target,
-			ControlQubit:       op.Control,
-			SecondControlQubit: op.Control2,
-			Angle:              op.Angle,
-			ClassicalRegister:  op.ClassicalReg,
-		}
-
-		switch strings.ToUpper(op.Gate) {
-		case "H":
-			pbOp.Type = pb.GateOperation_HADAMARD
-		case "X":
-			pbOp.Type = pb.GateOperation_PAULI_X
-		case "CNOT":
-			pbOp.Type = pb.GateOperation_CNOT
-		case "M":
-			pbOp.Type = pb.GateOperation_MEASURE
-		// Phase 3: New Gates
-		case "TOFFOLI", "CCNOT":
-			pbOp.Type = pb.GateOperation_TOFFOLI
-		case "S":
-			pbOp.Type = pb.GateOperation_PHASE_S
-		case "T":
-			pbOp.Type = pb.GateOperation_PHASE_T
-		case "RY":
-			pbOp.Type = pb.GateOperation_ROTATION_Y
-		case "RZ":
-			pbOp.Type = pb.GateOperation_ROTATION_Z
-		default:
-			log.Fatalf("Unknown Gate Type: %s", op.Gate)
-		}
-		pbOps = append(pbOps, pbOp)
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+var globals = globalFlags{
+	server:    "localhost:50051",
+	scheduler: "localhost:50053",
+	registry:  "localhost:50052",
+	vqe:       "localhost:50060",
+	timeout:   30 * time.Second,
+	output:    outputTable,
+}
 
-	if *streamMode {
-		runStreaming(ctx, c, pbOps)
-	} else if *vizMode {
-		runVisualize(ctx, c, circuit.Qubits, pbOps)
-	} else {
-		runStandard(ctx, c, circuit.Qubits, pbOps)
+// register adds the global flags to fs, defaulting to what they hold, so
+// values given before the command carry through
+func (g *globalFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.server, "server", g.server, "Engine address")
+	fs.StringVar(&g.scheduler, "scheduler", g.scheduler, "Scheduler address")
+	fs.StringVar(&g.registry, "registry", g.registry, "Circuit registry address")
+	fs.StringVar(&g.vqe, "vqe", g.vqe, "VQE solver address")
+	fs.DurationVar(&g.timeout, "timeout", g.timeout, "Deadline for each command; 0 for none")
+	fs.Var(&g.output, "output", "Result `format`: table, json or csv")
+	fs.StringVar(&g.profile, "profile", g.profile, "Profile in ~/.qctl/config.yaml to take defaults from")
+	fs.BoolVar(&g.tls, "tls", g.tls, "Connect over TLS, trusting the system's CAs unless -ca-cert is given ($QCTL_TLS)")
+	fs.StringVar(&g.caCert, "ca-cert", g.caCert, "PEM `file` of the CA that signed the servers' certificates ($QCTL_CA_CERT)")
+	fs.StringVar(&g.clientCert, "client-cert", g.clientCert, "PEM `file` of a client certificate for mutual TLS ($QCTL_CLIENT_CERT)")
+	fs.StringVar(&g.clientKey, "client-key", g.clientKey, "PEM `file` of the client certificate's key ($QCTL_CLIENT_KEY)")
+	fs.StringVar(&g.token, "token", g.token, "Bearer token sent with every call ($QCTL_TOKEN)")
+}
+
+// fromEnv takes the connection settings from the environment, over the
+// profile's and under the flags
+func (g *globalFlags) fromEnv() error {
+	if v := os.Getenv("QCTL_TLS"); v != "" {
+		on, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid QCTL_TLS %q: %v", v, err)
+		}
+		g.tls = on
+	}
+	for env, dst := range map[string]*string{
+		"QCTL_CA_CERT":     &g.caCert,
+		"QCTL_CLIENT_CERT": &g.clientCert,
+		"QCTL_CLIENT_KEY":  &g.clientKey,
+		"QCTL_TOKEN":       &g.token,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*dst = v
+		}
 	}
+	return nil
 }
 
-func runStandard(ctx context.Context, c pb.QuantumComputeClient, qubits int32, ops []*pb.GateOperation) {
-	start := time.Now()
-	res, err := c.RunCircuit(ctx, &pb.CircuitRequest{
-		NumQubits:  qubits,
-		Operations: ops,
-	})
-	if err != nil {
-		log.Fatalf("💥 Engine Error: %v", err)
+// withDeadline applies -timeout to ctx
+func withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if globals.timeout > 0 {
+		return context.WithTimeout(ctx, globals.timeout)
 	}
-	duration := time.Since(start)
+	return context.WithCancel(ctx)
+}
 
-	fmt.Printf("✅ Done in %s\n", duration)
-	printResults(res)
+// bearerToken sends -token with every call
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
 }
 
-func runVisualize(ctx context.Context, c pb.QuantumComputeClient, qubits int32, ops []*pb.GateOperation) {
-	fmt.Println("🎥 Requesting Visualization Stream...")
+func (t bearerToken) RequireTransportSecurity() bool { return false }
 
-	req := &pb.CircuitRequest{
-		NumQubits:  qubits,
-		Operations: ops,
-	}
+// secure reports whether connections use TLS: asked for with -tls, or
+// implied by any certificate
+func (g *globalFlags) secure() bool {
+	return g.tls || g.caCert != "" || g.clientCert != "" || g.clientKey != ""
+}
 
-	stream, err := c.VisualizeCircuit(ctx, req)
-	if err != nil {
-		log.Fatalf("Visualize init failed: %v", err)
+// transportCredentials secures connections when asked to, and leaves them
+// in plain text otherwise
+func transportCredentials() (credentials.TransportCredentials, error) {
+	if !globals.secure() {
+		return insecure.NewCredentials(), nil
 	}
-
-	step := 1
-	for {
-		res, err := stream.Recv()
-		if err == io.EOF {
-			break
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if globals.caCert != "" {
+		pem, err := os.ReadFile(globals.caCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %v", err)
 		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", globals.caCert)
+		}
+	}
+	switch {
+	case globals.clientCert != "" && globals.clientKey != "":
+		cert, err := tls.LoadX509KeyPair(globals.clientCert, globals.clientKey)
 		if err != nil {
-			log.Fatalf("Visualize stream failed: %v", err)
+			return nil, fmt.Errorf("failed to load client certificate: %v", err)
 		}
-
-		fmt.Printf("\n--- [Step %d] Visual State ---\n", step)
-		printStateVector(res.StateVector)
-		step++
+		cfg.Certificates = []tls.Certificate{cert}
+	case globals.clientCert != "" || globals.clientKey != "":
+		return nil, fmt.Errorf("-client-cert and -client-key must be given together")
 	}
-	fmt.Println("\n✅ Visualization Completed.")
+	return credentials.NewTLS(cfg), nil
 }
 
-func runStreaming(ctx context.Context, c pb.QuantumComputeClient, ops []*pb.GateOperation) {
-	fmt.Println("🌊 Connecting to Live Kernel Stream...")
-	stream, err := c.StreamGates(ctx)
+// dial connects to one of the services
+func dial(addr string) (*grpc.ClientConn, error) {
+	creds, err := transportCredentials()
 	if err != nil {
-		log.Fatalf("Stream init failed: %v", err)
-	}
-
-	// Background thread to read responses
-	waitc := make(chan struct{})
-	go func() {
-		step := 1
-		for {
-			in, err := stream.Recv()
-			if err == io.EOF {
-				close(waitc)
-				return
-			}
-			if err != nil {
-				log.Fatalf("Stream read failed: %v", err)
-			}
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if globals.token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(globals.token)))
+	}
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connection to %s failed: %v", addr, err)
+	}
+	return conn, nil
+}
 
-			// Clear screen or just print separator
-			fmt.Printf("\n--- [Step %d] Wavefunction Update ---\n", step)
-			printStateVector(in.StateVector)
-			printMeasurements(in.ClassicalResults)
-			step++
-		}
-	}()
+// ------------------------------------------------------------------
+// Help
+// ------------------------------------------------------------------
 
-	// Send Gates
-	for _, op := range ops {
-		// Artificial delay for visualization effect (optional, removed for speed)
-		// time.Sleep(500 * time.Millisecond)
-		if err := stream.Send(op); err != nil {
-			log.Fatalf("Failed to send gate: %v", err)
+func printUsage(w io.Writer, path []string, c *command) {
+	line := strings.Join(path, " ")
+	if len(path) == 1 {
+		line += " [global flags]"
+	}
+	switch {
+	case len(c.subs) > 0:
+		line += " <command>"
+	case c.flags != nil:
+		line += " [flags]"
+	}
+	if c.args != "" {
+		line += " " + c.args
+	}
+	fmt.Fprintf(w, "Usage: %s\n\n%s\n", line, c.summary)
+
+	if len(c.subs) > 0 {
+		fmt.Fprintln(w, "\nCommands:")
+		width := 10
+		for _, sub := range c.subs {
+			width = max(width, len(sub.name))
+		}
+		for _, sub := range c.subs {
+			fmt.Fprintf(w, "  %-*s %s\n", width, sub.name, sub.summary)
+		}
+	}
+	if c == rootCmd {
+		var plugins []string
+		for _, p := range findPlugins() {
+			if !p.Shadowed {
+				plugins = append(plugins, p.Name)
+			}
+		}
+		if len(plugins) > 0 {
+			fmt.Fprintf(w, "\nPlugins:\n  %s\n", strings.Join(plugins, "  "))
 		}
 	}
-	stream.CloseSend()
-	<-waitc
-	fmt.Println("\n✅ Stream Completed.")
+	if c.flags != nil {
+		fmt.Fprintln(w, "\nFlags:")
+		fs := flag.NewFlagSet(line, flag.ContinueOnError)
+		fs.SetOutput(w)
+		c.flags(fs)
+		fs.PrintDefaults()
+	}
+	fmt.Fprintln(w, "\nGlobal flags:")
+	fs := flag.NewFlagSet(line, flag.ContinueOnError)
+	fs.SetOutput(w)
+	shown := globals
+	shown.register(fs)
+	fs.PrintDefaults()
+	if len(c.subs) > 0 {
+		help := append([]string{rootCmd.name, "help"}, path[1:]...)
+		fmt.Fprintf(w, "\nRun '%s <command>' for more about a command.\n", strings.Join(help, " "))
+	}
 }
 
-func printResults(res *pb.StateResponse) {
-	fmt.Println("\n--- 🔬 Measurement Register ---")
-	printMeasurements(res.ClassicalResults)
-
-	fmt.Println("\n--- 🌊 Final Wavefunction (Non-Zero) ---")
-	printStateVector(res.StateVector)
+// helpFor prints the usage of the command at the end of path
+func helpFor(w io.Writer, path []string) error {
+	c, names := rootCmd, []string{rootCmd.name}
+	for _, name := range path {
+		sub := c.find(name)
+		if sub == nil {
+			return fmt.Errorf("unknown command %q for %s; see '%s -h'",
+				name, strings.Join(names, " "), strings.Join(names, " "))
+		}
+		c, names = sub, append(names, name)
+	}
+	printUsage(w, names, c)
+	return nil
 }
 
-func printMeasurements(results map[uint32]bool) {
-	if len(results) == 0 {
-		return
+// ------------------------------------------------------------------
+// Dispatch
+// ------------------------------------------------------------------
+
+// execute runs the command line and is the process's exit code
+func execute(args []string) int {
+	if len(args) > 0 && args[0] == completeCmd {
+		return complete(args[1:])
+	}
+	globals.profile = profileFlag(args)
+	err := loadProfile(globals.profile)
+	if err == nil {
+		err = globals.fromEnv()
 	}
-	for q, val := range results {
-		bit := "0"
-		if val {
-			bit = "1"
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 2
+	}
+	root := flag.NewFlagSet(rootCmd.name, flag.ContinueOnError)
+	root.Usage = func() { printUsage(os.Stderr, []string{rootCmd.name}, rootCmd) }
+	globals.register(root)
+	if err := root.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
 		}
-		fmt.Printf(" [Q%d] -> |%s>\n", q, bit)
+		return 2
 	}
+	args = root.Args()
+	if len(args) > 0 && args[0] == "help" {
+		if err := helpFor(os.Stdout, args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 2
+		}
+		return 0
+	}
+	return dispatch(rootCmd, []string{rootCmd.name}, args)
 }
 
-func printStateVector(vec []*pb.StateResponse_ComplexNumber) {
-	for i, amp := range vec {
-		mag := amp.Real*amp.Real + amp.Imag*amp.Imag
-		if mag > 0.0001 {
-			sign := "+"
-			if amp.Imag < 0 {
-				sign = "-"
+func dispatch(c *command, path []string, args []string) int {
+	if len(c.subs) > 0 {
+		if len(args) == 0 {
+			printUsage(os.Stderr, path, c)
+			return 2
+		}
+		sub := c.find(args[0])
+		if sub == nil {
+			if args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+				printUsage(os.Stdout, path, c)
+				return 0
 			}
-			fmt.Printf(" |%d> : (%.3f %s %.3fi)\n", i, amp.Real, sign, makePositive(amp.Imag))
+			if plugin, ok := lookupPlugin(args[0]); ok && c == rootCmd {
+				return runPlugin(plugin, args[1:])
+			}
+			fmt.Fprintf(os.Stderr, "❌ Unknown command %q for %s\n\n", args[0], strings.Join(path, " "))
+			printUsage(os.Stderr, path, c)
+			return 2
 		}
+		return dispatch(sub, append(path, sub.name), args[1:])
+	}
+
+	fs := flag.NewFlagSet(strings.Join(path, " "), flag.ContinueOnError)
+	fs.Usage = func() { printUsage(os.Stderr, path, c) }
+	globals.register(fs)
+	if c.flags != nil {
+		c.flags(fs)
 	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	ctx, cancel := withDeadline(context.Background())
+	defer cancel()
+	if err := c.run(ctx, fs.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return 1
+	}
+	return 0
 }
 
-func makePositive(f float64) float64 {
-	if f < 0 {
-		return -f
+// expectArgs checks a command's positional arguments
+func expectArgs(args []string, names ...string) error {
+	if len(names) == 0 && len(args) > 0 {
+		return fmt.Errorf("takes no arguments, got %d", len(args))
 	}
-	return f
+	if len(args) != len(names) {
+		return fmt.Errorf("expected %s, got %d argument(s)", strings.Join(names, " "), len(args))
+	}
+	return nil
+}
+
+func main() {
+	os.Exit(execute(os.Args[1:]))
 }
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+)
+
+// outputFormat is how commands print their results
+type outputFormat string
+
+const (
+	outputTable outputFormat = "table"
+	outputJSON  outputFormat = "json"
+	outputCSV   outputFormat = "csv"
+)
+
+func (o *outputFormat) String() string { return string(*o) }
+
+func (o *outputFormat) Set(v string) error {
+	switch f := outputFormat(v); f {
+	case outputTable, outputJSON, outputCSV:
+		*o = f
+		return nil
+	}
+	return fmt.Errorf("want table, json or csv")
+}
+
+// tabular is a result as rows, for -output csv
+type tabular interface {
+	header() []string
+	rows() [][]string
+}
+
+// machineOutput reports whether results go out as JSON or CSV rather
+// than tables for people
+func machineOutput() bool {
+	return globals.output != outputTable
+}
+
+// emit writes a result as JSON or CSV, per -output
+func emit(v tabular) error {
+	return emitTo(os.Stdout, v)
+}
+
+// emitTo writes a result to w as CSV for -output csv, and JSON otherwise
+func emitTo(w io.Writer, v tabular) error {
+	if globals.output == outputCSV {
+		cw := csv.NewWriter(w)
+		cw.Write(v.header())
+		cw.WriteAll(v.rows())
+		return cw.Error()
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// notef prints progress, on standard error when standard output carries
+// JSON or CSV
+func notef(format string, a ...any) {
+	w := os.Stdout
+	if machineOutput() {
+		w = os.Stderr
+	}
+	fmt.Fprintf(w, format, a...)
+}
+
+// ------------------------------------------------------------------
+// Histograms
+// ------------------------------------------------------------------
+
+// histogramWidth is the length of a bar for probability 1
+const histogramWidth = 40
+
+type histogramBar struct {
+	label string
+	prob  float64
+}
+
+func printHistogram(bars []histogramBar) {
+	writeHistogram(os.Stdout, bars)
+}
+
+func writeHistogram(w io.Writer, bars []histogramBar) {
+	width := 0
+	for _, b := range bars {
+		width = max(width, utf8.RuneCountInString(b.label))
+	}
+	for _, b := range bars {
+		bar := strings.Repeat("█", int(math.Round(b.prob*histogramWidth)))
+		if bar == "" && b.prob > 0 {
+			bar = "▏"
+		}
+		fmt.Fprintf(w, " %-*s %6.2f%% %s\n", width, b.label, b.prob*100, bar)
+	}
+}
+
+// probabilityBars is a bar per basis state with any weight
+func probabilityBars(vec []*pb.StateResponse_ComplexNumber) []histogramBar {
+	n := qubitsFor(len(vec))
+	var bars []histogramBar
+	for i, amp := range vec {
+		if prob := amp.Real*amp.Real + amp.Imag*amp.Imag; prob > 0.0001 {
+			bars = append(bars, histogramBar{label: "|" + basisLabel(i, n) + ">", prob: prob})
+		}
+	}
+	return bars
+}
+
+// ------------------------------------------------------------------
+// States
+// ------------------------------------------------------------------
+
+// qubitsFor is the register size of a state vector of the given length
+func qubitsFor(states int) int {
+	n := 0
+	for 1<<n < states {
+		n++
+	}
+	return n
+}
+
+// bitString is a measured bit
+func bitString(one bool) string {
+	if one {
+		return "1"
+	}
+	return "0"
+}
+
+// basisLabel is basis state i as bits, qubit n-1 first
+func basisLabel(i, n int) string {
+	return fmt.Sprintf("%0*b", n, i)
+}
+
+type measurement struct {
+	Qubit uint32 `json:"qubit"`
+	Bit   int    `json:"bit"`
+}
+
+type amplitude struct {
+	State       int     `json:"state"`
+	Bits        string  `json:"bits"`
+	Real        float64 `json:"real"`
+	Imag        float64 `json:"imag"`
+	Probability float64 `json:"probability"`
+}
+
+// stateRecord is an engine state as results: measured bits by qubit and
+// the basis states with any weight
+type stateRecord struct {
+	Step         int           `json:"step,omitempty"`
+	Measurements []measurement `json:"measurements"`
+	Amplitudes   []amplitude   `json:"amplitudes"`
+}
+
+func newStateRecord(step int, res *pb.StateResponse) stateRecord {
+	rec := stateRecord{Step: step, Measurements: []measurement{}, Amplitudes: []amplitude{}}
+	for q, one := range res.ClassicalResults {
+		bit := 0
+		if one {
+			bit = 1
+		}
+		rec.Measurements = append(rec.Measurements, measurement{q, bit})
+	}
+	sort.Slice(rec.Measurements, func(i, j int) bool { return rec.Measurements[i].Qubit < rec.Measurements[j].Qubit })
+	n := qubitsFor(len(res.StateVector))
+	for i, amp := range res.StateVector {
+		if prob := amp.Real*amp.Real + amp.Imag*amp.Imag; prob > 0.0001 {
+			rec.Amplitudes = append(rec.Amplitudes, amplitude{i, basisLabel(i, n), amp.Real, amp.Imag, prob})
+		}
+	}
+	return rec
+}
+
+var stateHeader = []string{"step", "kind", "index", "bits", "real", "imag", "probability"}
+
+// rows lists measurements, then amplitudes, told apart by kind
+func (r stateRecord) rows() [][]string {
+	step := strconv.Itoa(r.Step)
+	var rows [][]string
+	for _, m := range r.Measurements {
+		rows = append(rows, []string{step, "measurement", fmt.Sprint(m.Qubit), strconv.Itoa(m.Bit), "", "", ""})
+	}
+	for _, a := range r.Amplitudes {
+		rows = append(rows, []string{step, "amplitude", strconv.Itoa(a.State), a.Bits,
+			formatFloat(a.Real), formatFloat(a.Imag), formatFloat(a.Probability)})
+	}
+	return rows
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// ------------------------------------------------------------------
+// Circuits
+// ------------------------------------------------------------------
+
+func (c *CircuitFile) header() []string {
+	return []string{"gate", "target", "control", "control2", "angle", "classical_reg"}
+}
+
+func (c *CircuitFile) rows() [][]string {
+	rows := make([][]string, len(c.Ops))
+	for i, op := range c.Ops {
+		rows[i] = []string{op.Gate, fmt.Sprint(op.Target), fmt.Sprint(op.Control), fmt.Sprint(op.Control2),
+			formatFloat(op.Angle), fmt.Sprint(op.ClassicalReg)}
+	}
+	return rows
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// ------------------------------------------------------------------
+// Parquet
+// ------------------------------------------------------------------
+
+// Results are written as one row group of required columns, each a
+// single uncompressed data page in PLAIN encoding, with the table's
+// attributes as key-value metadata. Result tables are small enough that
+// nothing more is worth its code.
+
+const parquetMagic = "PAR1"
+
+// Parquet's physical types and the other enum values written
+const (
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetRequired     = 0
+	parquetUTF8         = 0 // converted type
+	parquetPlain        = 0
+	parquetRLE          = 3
+	parquetUncompressed = 0
+	parquetDataPage     = 0
+)
+
+// Thrift compact protocol field types
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter writes Thrift's compact protocol, which Parquet's footer
+// and page headers use. Fields must be written in order of their ids.
+type thriftWriter struct {
+	buf bytes.Buffer
+	// last is the last field id written in each open struct
+	last []int16
+}
+
+func newThriftWriter() *thriftWriter { return &thriftWriter{last: []int16{0}} }
+
+func (w *thriftWriter) uvarint(v uint64) { w.buf.Write(binary.AppendUvarint(nil, v)) }
+func (w *thriftWriter) varint(v int64)   { w.uvarint(uint64(v<<1 ^ v>>63)) }
+
+func (w *thriftWriter) field(id int16, kind byte) {
+	top := len(w.last) - 1
+	if delta := id - w.last[top]; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		w.buf.WriteByte(kind)
+		w.varint(int64(id))
+	}
+	w.last[top] = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) { w.field(id, thriftI32); w.varint(int64(v)) }
+func (w *thriftWriter) i64(id int16, v int64) { w.field(id, thriftI64); w.varint(v) }
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.field(id, thriftBinary)
+	w.str(s)
+}
+
+// str writes a string, as a field's value or a list element
+func (w *thriftWriter) str(s string) {
+	w.uvarint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+// list starts a list field of n elements of a kind
+func (w *thriftWriter) list(id int16, kind byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | kind)
+		return
+	}
+	w.buf.WriteByte(0xf0 | kind)
+	w.uvarint(uint64(n))
+}
+
+// begin opens a struct: a field's when id is set, otherwise a list element
+func (w *thriftWriter) begin(id int16) {
+	if id != 0 {
+		w.field(id, thriftStruct)
+	}
+	w.last = append(w.last, 0)
+}
+
+// end closes the innermost struct, or the message itself
+func (w *thriftWriter) end() {
+	w.buf.WriteByte(0)
+	w.last = w.last[:len(w.last)-1]
+}
+
+// parquetColumn is a column's physical type and PLAIN-encoded values
+func parquetColumn(col resultColumn) (int32, []byte) {
+	var b []byte
+	switch {
+	case col.ints != nil:
+		for _, v := range col.ints {
+			b = binary.LittleEndian.AppendUint64(b, uint64(v))
+		}
+		return parquetInt64, b
+	case col.floats != nil:
+		for _, v := range col.floats {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(v))
+		}
+		return parquetDouble, b
+	}
+	for _, s := range col.strings {
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(s)))
+		b = append(b, s...)
+	}
+	return parquetByteArray, b
+}
+
+func writeParquet(w io.Writer, t *resultTable) error {
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	type chunk struct {
+		kind   int32
+		offset int64
+		size   int64
+	}
+	chunks := make([]chunk, len(t.columns))
+	var total int64
+	for i, col := range t.columns {
+		kind, values := parquetColumn(col)
+		page := newThriftWriter()
+		page.i32(1, parquetDataPage)
+		page.i32(2, int32(len(values)))
+		page.i32(3, int32(len(values)))
+		page.begin(5)
+		page.i32(1, int32(t.rows))
+		page.i32(2, parquetPlain)
+		page.i32(3, parquetRLE)
+		page.i32(4, parquetRLE)
+		page.end()
+		page.end()
+
+		// Required, unnested columns have no levels to write before values
+		chunks[i] = chunk{kind, int64(file.Len()), int64(page.buf.Len() + len(values))}
+		total += chunks[i].size
+		file.Write(page.buf.Bytes())
+		file.Write(values)
+	}
+
+	meta := newThriftWriter()
+	meta.i32(1, 1) // version
+	meta.list(2, thriftStruct, 1+len(t.columns))
+	meta.begin(0)
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(t.columns)))
+	meta.end()
+	for i, col := range t.columns {
+		meta.begin(0)
+		meta.i32(1, chunks[i].kind)
+		meta.i32(3, parquetRequired)
+		meta.binary(4, col.name)
+		if chunks[i].kind == parquetByteArray {
+			meta.i32(6, parquetUTF8)
+		}
+		meta.end()
+	}
+	meta.i64(3, int64(t.rows))
+	meta.list(4, thriftStruct, 1)
+	meta.begin(0)
+	meta.list(1, thriftStruct, len(t.columns))
+	for i, col := range t.columns {
+		c := chunks[i]
+		meta.begin(0)
+		meta.i64(2, c.offset)
+		meta.begin(3)
+		meta.i32(1, c.kind)
+		meta.list(2, thriftI32, 1)
+		meta.varint(parquetPlain)
+		meta.list(3, thriftBinary, 1)
+		meta.str(col.name)
+		meta.i32(4, parquetUncompressed)
+		meta.i64(5, int64(t.rows))
+		meta.i64(6, c.size)
+		meta.i64(7, c.size)
+		meta.i64(9, c.offset)
+		meta.end()
+		meta.end()
+	}
+	meta.i64(2, total)
+	meta.i64(3, int64(t.rows))
+	meta.end()
+	meta.list(5, thriftStruct, len(t.attrs))
+	for _, a := range t.attrs {
+		meta.begin(0)
+		meta.binary(1, a[0])
+		meta.binary(2, a[1])
+		meta.end()
+	}
+	meta.binary(6, "qctl")
+	meta.end()
+
+	file.Write(meta.buf.Bytes())
+	file.Write(binary.LittleEndian.AppendUint32(nil, uint32(meta.buf.Len())))
+	file.WriteString(parquetMagic)
+	_, err := w.Write(file.Bytes())
+	return err
+}
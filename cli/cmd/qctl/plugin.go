@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// ------------------------------------------------------------------
+// Plugins
+// ------------------------------------------------------------------
+
+// pluginPrefix names plugin executables: qctl-<name> on PATH adds the
+// verb <name>, so modules can ship commands of their own. A plugin is run
+// with the rest of the command line and finds qctl's settings in the
+// QCTL_* variables of pluginEnv.
+const pluginPrefix = "qctl-"
+
+type pluginRecord struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Shadowed plugins share a built-in command's name and never run
+	Shadowed bool `json:"shadowed"`
+}
+
+type pluginList []pluginRecord
+
+func (l pluginList) header() []string { return []string{"name", "path", "shadowed"} }
+
+func (l pluginList) rows() [][]string {
+	rows := make([][]string, len(l))
+	for i, p := range l {
+		rows[i] = []string{p.Name, p.Path, fmt.Sprint(p.Shadowed)}
+	}
+	return rows
+}
+
+// findPlugins lists the plugins on PATH by name; where two directories
+// have the same one, the first wins as it would for the shell
+func findPlugins() pluginList {
+	seen := map[string]bool{}
+	var list pluginList
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name, ok := strings.CutPrefix(e.Name(), pluginPrefix)
+			if !ok || name == "" || seen[name] {
+				continue
+			}
+			path := filepath.Join(dir, e.Name())
+			if fi, err := os.Stat(path); err != nil || fi.IsDir() || fi.Mode()&0o111 == 0 {
+				continue
+			}
+			seen[name] = true
+			list = append(list, pluginRecord{name, path, builtin(name)})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// builtin reports whether qctl itself has a top-level command called name
+func builtin(name string) bool {
+	return name == "help" || rootCmd.find(name) != nil
+}
+
+// lookupPlugin finds the plugin for a verb qctl does not know
+func lookupPlugin(name string) (string, bool) {
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsRune(name, filepath.Separator) {
+		return "", false
+	}
+	path, err := exec.LookPath(pluginPrefix + name)
+	return path, err == nil
+}
+
+// pluginEnv passes qctl's settings, after profiles, the environment and
+// global flags, on to a plugin
+func pluginEnv() []string {
+	env := []string{
+		"QCTL_SERVER=" + globals.server,
+		"QCTL_SCHEDULER=" + globals.scheduler,
+		"QCTL_REGISTRY=" + globals.registry,
+		"QCTL_VQE=" + globals.vqe,
+		"QCTL_TIMEOUT=" + globals.timeout.String(),
+		"QCTL_OUTPUT=" + string(globals.output),
+		"QCTL_PROFILE=" + active.name,
+		"QCTL_BACKEND=" + defaultBackend(),
+		fmt.Sprintf("QCTL_TLS=%t", globals.secure()),
+	}
+	for name, v := range map[string]string{
+		"QCTL_CA_CERT":     globals.caCert,
+		"QCTL_CLIENT_CERT": globals.clientCert,
+		"QCTL_CLIENT_KEY":  globals.clientKey,
+		"QCTL_TOKEN":       globals.token,
+	} {
+		if v != "" {
+			env = append(env, name+"="+v)
+		}
+	}
+	return env
+}
+
+// runPlugin runs a plugin in the foreground and is its exit code. qctl
+// leaves interrupts to the plugin, which shares the terminal.
+func runPlugin(path string, args []string) int {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	cmd.Env = append(os.Environ(), pluginEnv()...)
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	err := cmd.Run()
+	var exit *exec.ExitError
+	switch {
+	case errors.As(err, &exit) && exit.ExitCode() >= 0:
+		return exit.ExitCode()
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "❌ Plugin %s failed: %v\n", filepath.Base(path), err)
+		return 1
+	}
+	return 0
+}
+
+var pluginsCmd = &command{
+	name:    "plugins",
+	summary: "List the qctl-<name> plugins found on PATH",
+}
+
+// Listing plugins checks them against rootCmd, which refers back to
+// pluginsCmd, so its run is set once both exist
+func init() { pluginsCmd.run = listPlugins }
+
+func listPlugins(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	list := findPlugins()
+	if machineOutput() {
+		return emit(list)
+	}
+	if len(list) == 0 {
+		fmt.Printf("No plugins found; put %s<name> executables on PATH to add commands\n", pluginPrefix)
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PLUGIN\tPATH")
+	for _, p := range list {
+		note := ""
+		if p.Shadowed {
+			note = "\t(shadowed by the built-in command)"
+		}
+		fmt.Fprintf(tw, "%s\t%s%s\n", p.Name, p.Path, note)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,702 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ------------------------------------------------------------------
+// OpenQASM
+// ------------------------------------------------------------------
+
+// OpenQASM 2.0 and 3.0 programs are read as far as the engine's gates
+// can express them: register declarations in either version's syntax,
+// the standard library gates (some decomposed, exactly up to a global
+// phase), measurement and barriers. Gate definitions, classical control
+// and the rest of the language are reported as unsupported.
+
+// qasmPos is a position in the source, counted from 1
+type qasmPos struct{ line, col int }
+
+type qasmError struct {
+	file string
+	pos  qasmPos
+	msg  string
+}
+
+func (e *qasmError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.file, e.pos.line, e.pos.col, e.msg)
+}
+
+type qasmTokenKind int
+
+const (
+	qasmEOF qasmTokenKind = iota
+	qasmIdent
+	qasmNumber
+	qasmString
+	qasmPunct
+)
+
+type qasmToken struct {
+	kind qasmTokenKind
+	text string
+	pos  qasmPos
+}
+
+func (t qasmToken) String() string {
+	switch t.kind {
+	case qasmEOF:
+		return "end of file"
+	case qasmString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lexQASM splits source into tokens, dropping comments
+func lexQASM(file, src string) ([]qasmToken, error) {
+	var toks []qasmToken
+	runes := []rune(src)
+	pos := qasmPos{1, 1}
+	i := 0
+	advance := func() {
+		if runes[i] == '\n' {
+			pos.line++
+			pos.col = 1
+		} else {
+			pos.col++
+		}
+		i++
+	}
+	for i < len(runes) {
+		r, start := runes[i], pos
+		switch {
+		case unicode.IsSpace(r):
+			advance()
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				advance()
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			advance()
+			advance()
+			for i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/') {
+				advance()
+			}
+			if i >= len(runes) {
+				return nil, &qasmError{file, start, "unterminated comment"}
+			}
+			advance()
+			advance()
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				advance()
+			}
+			toks = append(toks, qasmToken{qasmIdent, string(runes[j:i]), start})
+		case unicode.IsDigit(r) || r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]):
+			j := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				advance()
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				advance()
+				if i < len(runes) && (runes[i] == '+' || runes[i] == '-') {
+					advance()
+				}
+				for i < len(runes) && unicode.IsDigit(runes[i]) {
+					advance()
+				}
+			}
+			text := string(runes[j:i])
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, &qasmError{file, start, fmt.Sprintf("malformed number %q", text)}
+			}
+			toks = append(toks, qasmToken{qasmNumber, text, start})
+		case r == '"' || r == '\'':
+			advance()
+			j := i
+			for i < len(runes) && runes[i] != r && runes[i] != '\n' {
+				advance()
+			}
+			if i >= len(runes) || runes[i] != r {
+				return nil, &qasmError{file, start, "unterminated string"}
+			}
+			toks = append(toks, qasmToken{qasmString, string(runes[j:i]), start})
+			advance()
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '>':
+			advance()
+			advance()
+			toks = append(toks, qasmToken{qasmPunct, "->", start})
+		case strings.ContainsRune(";,[](){}=+-*/^", r):
+			advance()
+			toks = append(toks, qasmToken{qasmPunct, string(r), start})
+		default:
+			return nil, &qasmError{file, start, fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return append(toks, qasmToken{qasmEOF, "", pos}), nil
+}
+
+// qasmUnsupported are keywords of constructs the engine cannot run
+var qasmUnsupported = map[string]string{
+	"gate":     "gate definitions",
+	"opaque":   "opaque gates",
+	"def":      "subroutines",
+	"defcal":   "calibrations",
+	"cal":      "calibrations",
+	"if":       "classical control",
+	"else":     "classical control",
+	"for":      "loops",
+	"while":    "loops",
+	"reset":    "reset",
+	"let":      "aliases",
+	"const":    "constants",
+	"input":    "inputs",
+	"output":   "outputs",
+	"int":      "classical variables",
+	"uint":     "classical variables",
+	"float":    "classical variables",
+	"angle":    "classical variables",
+	"bool":     "classical variables",
+	"complex":  "classical variables",
+	"duration": "timing",
+	"stretch":  "timing",
+	"delay":    "timing",
+	"box":      "timing",
+	"pragma":   "pragmas",
+	"ctrl":     "gate modifiers",
+	"inv":      "gate modifiers",
+	"pow":      "gate modifiers",
+	"negctrl":  "gate modifiers",
+}
+
+// qasmGate is a standard gate in the engine's gates
+type qasmGate struct {
+	qubits, params int
+	expand         func(p []float64, q []uint32) []CircuitOp
+}
+
+func gateOp(gate string, target uint32) CircuitOp {
+	return CircuitOp{Gate: gate, Target: target}
+}
+
+func rotationOp(gate string, target uint32, angle float64) CircuitOp {
+	return CircuitOp{Gate: gate, Target: target, Angle: angle}
+}
+
+func cnotOp(control, target uint32) CircuitOp {
+	return CircuitOp{Gate: "CNOT", Control: control, Target: target}
+}
+
+// repeatOp is n of one gate
+func repeatOp(gate string, target uint32, n int) []CircuitOp {
+	ops := make([]CircuitOp, n)
+	for i := range ops {
+		ops[i] = gateOp(gate, target)
+	}
+	return ops
+}
+
+// u3Ops is U(θ,φ,λ) = Rz(φ)·Ry(θ)·Rz(λ), up to a global phase
+func u3Ops(theta, phi, lambda float64, q uint32) []CircuitOp {
+	return []CircuitOp{rotationOp("RZ", q, lambda), rotationOp("RY", q, theta), rotationOp("RZ", q, phi)}
+}
+
+var qasmGates = map[string]qasmGate{
+	"id": {1, 0, func(p []float64, q []uint32) []CircuitOp { return nil }},
+	"h":  {1, 0, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{gateOp("H", q[0])} }},
+	"x":  {1, 0, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{gateOp("X", q[0])} }},
+	// Y = i·X·Z and Z = S²
+	"y":   {1, 0, func(p []float64, q []uint32) []CircuitOp { return append(repeatOp("S", q[0], 2), gateOp("X", q[0])) }},
+	"z":   {1, 0, func(p []float64, q []uint32) []CircuitOp { return repeatOp("S", q[0], 2) }},
+	"s":   {1, 0, func(p []float64, q []uint32) []CircuitOp { return repeatOp("S", q[0], 1) }},
+	"sdg": {1, 0, func(p []float64, q []uint32) []CircuitOp { return repeatOp("S", q[0], 3) }},
+	"t":   {1, 0, func(p []float64, q []uint32) []CircuitOp { return repeatOp("T", q[0], 1) }},
+	"tdg": {1, 0, func(p []float64, q []uint32) []CircuitOp { return repeatOp("T", q[0], 7) }},
+	// Rx = H·Rz·H
+	"rx": {1, 1, func(p []float64, q []uint32) []CircuitOp {
+		return []CircuitOp{gateOp("H", q[0]), rotationOp("RZ", q[0], p[0]), gateOp("H", q[0])}
+	}},
+	"ry":    {1, 1, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{rotationOp("RY", q[0], p[0])} }},
+	"rz":    {1, 1, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{rotationOp("RZ", q[0], p[0])} }},
+	"p":     {1, 1, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{rotationOp("RZ", q[0], p[0])} }},
+	"phase": {1, 1, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{rotationOp("RZ", q[0], p[0])} }},
+	"u1":    {1, 1, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{rotationOp("RZ", q[0], p[0])} }},
+	"u2":    {1, 2, func(p []float64, q []uint32) []CircuitOp { return u3Ops(math.Pi/2, p[0], p[1], q[0]) }},
+	"u3":    {1, 3, func(p []float64, q []uint32) []CircuitOp { return u3Ops(p[0], p[1], p[2], q[0]) }},
+	"u":     {1, 3, func(p []float64, q []uint32) []CircuitOp { return u3Ops(p[0], p[1], p[2], q[0]) }},
+	"U":     {1, 3, func(p []float64, q []uint32) []CircuitOp { return u3Ops(p[0], p[1], p[2], q[0]) }},
+	"cx":    {2, 0, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{cnotOp(q[0], q[1])} }},
+	"CX":    {2, 0, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{cnotOp(q[0], q[1])} }},
+	"cnot":  {2, 0, func(p []float64, q []uint32) []CircuitOp { return []CircuitOp{cnotOp(q[0], q[1])} }},
+	// CZ = (I⊗H)·CX·(I⊗H)
+	"cz": {2, 0, func(p []float64, q []uint32) []CircuitOp {
+		return []CircuitOp{gateOp("H", q[1]), cnotOp(q[0], q[1]), gateOp("H", q[1])}
+	}},
+	"swap": {2, 0, func(p []float64, q []uint32) []CircuitOp {
+		return []CircuitOp{cnotOp(q[0], q[1]), cnotOp(q[1], q[0]), cnotOp(q[0], q[1])}
+	}},
+	"ccx": {3, 0, func(p []float64, q []uint32) []CircuitOp {
+		return []CircuitOp{{Gate: "TOFFOLI", Control: q[0], Control2: q[1], Target: q[2]}}
+	}},
+}
+
+// qasmConstants are the names an angle can use
+var qasmConstants = map[string]float64{"pi": math.Pi, "π": math.Pi, "tau": 2 * math.Pi, "τ": 2 * math.Pi, "euler": math.E, "ℇ": math.E}
+
+var qasmFuncs = map[string]func(float64) float64{
+	"sin": math.Sin, "cos": math.Cos, "tan": math.Tan, "arcsin": math.Asin, "arccos": math.Acos,
+	"arctan": math.Atan, "exp": math.Exp, "ln": math.Log, "sqrt": math.Sqrt,
+}
+
+type qasmReg struct{ start, size int }
+
+type qasmParser struct {
+	file   string
+	toks   []qasmToken
+	i      int
+	qregs  map[string]qasmReg
+	cregs  map[string]qasmReg
+	qubits int
+	clbits int
+	ops    []CircuitOp
+}
+
+// parseQASM reads an OpenQASM program into a circuit
+func parseQASM(file, src string) (*CircuitFile, error) {
+	toks, err := lexQASM(file, src)
+	if err != nil {
+		return nil, err
+	}
+	p := &qasmParser{file: file, toks: toks, qregs: make(map[string]qasmReg), cregs: make(map[string]qasmReg)}
+	if p.peek().text == "OPENQASM" {
+		if err := p.header(); err != nil {
+			return nil, err
+		}
+	}
+	for p.peek().kind != qasmEOF {
+		if err := p.statement(); err != nil {
+			return nil, err
+		}
+	}
+	if p.qubits == 0 {
+		return nil, &qasmError{file, p.peek().pos, "the program declares no qubits"}
+	}
+	return &CircuitFile{Qubits: int32(p.qubits), Ops: p.ops}, nil
+}
+
+func (p *qasmParser) peek() qasmToken { return p.toks[p.i] }
+
+func (p *qasmParser) next() qasmToken {
+	t := p.toks[p.i]
+	if t.kind != qasmEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *qasmParser) errorf(pos qasmPos, format string, args ...any) error {
+	return &qasmError{p.file, pos, fmt.Sprintf(format, args...)}
+}
+
+// expect takes the punctuation text or fails
+func (p *qasmParser) expect(text string) (qasmToken, error) {
+	t := p.next()
+	if t.kind != qasmPunct || t.text != text {
+		return t, p.errorf(t.pos, "expected %q, found %s", text, t)
+	}
+	return t, nil
+}
+
+func (p *qasmParser) ident() (qasmToken, error) {
+	t := p.next()
+	if t.kind != qasmIdent {
+		return t, p.errorf(t.pos, "expected a name, found %s", t)
+	}
+	return t, nil
+}
+
+// size reads a non-negative integer
+func (p *qasmParser) size() (int, qasmToken, error) {
+	t := p.next()
+	n, err := strconv.Atoi(t.text)
+	if t.kind != qasmNumber || err != nil || n < 0 {
+		return 0, t, p.errorf(t.pos, "expected a whole number, found %s", t)
+	}
+	return n, t, nil
+}
+
+func (p *qasmParser) header() error {
+	p.next()
+	t := p.next()
+	if t.kind != qasmNumber {
+		return p.errorf(t.pos, "expected a version, found %s", t)
+	}
+	if major := strings.SplitN(t.text, ".", 2)[0]; major != "2" && major != "3" {
+		return p.errorf(t.pos, "unsupported OpenQASM version %s; 2.0 and 3.0 are read", t.text)
+	}
+	_, err := p.expect(";")
+	return err
+}
+
+func (p *qasmParser) statement() error {
+	t := p.peek()
+	if t.kind != qasmIdent {
+		return p.errorf(t.pos, "expected a statement, found %s", t)
+	}
+	if what, ok := qasmUnsupported[t.text]; ok {
+		return p.errorf(t.pos, "unsupported: %s (%q)", what, t.text)
+	}
+	switch t.text {
+	case "OPENQASM":
+		return p.errorf(t.pos, "the OPENQASM version must be the first statement")
+	case "include":
+		p.next()
+		name := p.next()
+		if name.kind != qasmString {
+			return p.errorf(name.pos, "expected a file name, found %s", name)
+		}
+		if name.text != "qelib1.inc" && name.text != "stdgates.inc" {
+			return p.errorf(name.pos, "unsupported include %q; only qelib1.inc and stdgates.inc are known", name.text)
+		}
+		_, err := p.expect(";")
+		return err
+	case "qreg", "creg":
+		p.next()
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect("["); err != nil {
+			return err
+		}
+		n, _, err := p.size()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect("]"); err != nil {
+			return err
+		}
+		if err := p.declare(name, n, t.text == "qreg"); err != nil {
+			return err
+		}
+		_, err = p.expect(";")
+		return err
+	case "qubit", "bit":
+		p.next()
+		n := 1
+		if p.peek().text == "[" {
+			p.next()
+			var err error
+			if n, _, err = p.size(); err != nil {
+				return err
+			}
+			if _, err := p.expect("]"); err != nil {
+				return err
+			}
+		}
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if err := p.declare(name, n, t.text == "qubit"); err != nil {
+			return err
+		}
+		if p.peek().text == "=" {
+			return p.errorf(p.peek().pos, "unsupported: initialising registers")
+		}
+		_, err = p.expect(";")
+		return err
+	case "barrier":
+		for p.peek().kind != qasmEOF && p.peek().text != ";" {
+			p.next()
+		}
+		_, err := p.expect(";")
+		return err
+	case "measure":
+		p.next()
+		qubits, err := p.operand(true)
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect("->"); err != nil {
+			return err
+		}
+		bits, err := p.operand(false)
+		if err != nil {
+			return err
+		}
+		if err := p.measure(t.pos, qubits, bits); err != nil {
+			return err
+		}
+		_, err = p.expect(";")
+		return err
+	}
+
+	// bits = measure qubits;
+	if _, ok := p.cregs[t.text]; ok {
+		bits, err := p.operand(false)
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect("="); err != nil {
+			return err
+		}
+		if m := p.next(); m.text != "measure" {
+			return p.errorf(m.pos, "unsupported: assigning %s to bits", m)
+		}
+		qubits, err := p.operand(true)
+		if err != nil {
+			return err
+		}
+		if err := p.measure(t.pos, qubits, bits); err != nil {
+			return err
+		}
+		_, err = p.expect(";")
+		return err
+	}
+	return p.gateCall()
+}
+
+// declare adds a register of n qubits or bits
+func (p *qasmParser) declare(name qasmToken, n int, quantum bool) error {
+	if _, ok := p.qregs[name.text]; ok {
+		return p.errorf(name.pos, "%s is already declared", name.text)
+	}
+	if _, ok := p.cregs[name.text]; ok {
+		return p.errorf(name.pos, "%s is already declared", name.text)
+	}
+	if quantum {
+		p.qregs[name.text] = qasmReg{p.qubits, n}
+		p.qubits += n
+	} else {
+		p.cregs[name.text] = qasmReg{p.clbits, n}
+		p.clbits += n
+	}
+	return nil
+}
+
+// operand reads a register or one of its elements as the global indices
+// it covers
+func (p *qasmParser) operand(quantum bool) ([]uint32, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	regs, kind := p.qregs, "qubit"
+	if !quantum {
+		regs, kind = p.cregs, "bit"
+	}
+	reg, ok := regs[name.text]
+	if !ok {
+		return nil, p.errorf(name.pos, "%s is not a declared %s register", name.text, kind)
+	}
+	if p.peek().text != "[" {
+		all := make([]uint32, reg.size)
+		for i := range all {
+			all[i] = uint32(reg.start + i)
+		}
+		return all, nil
+	}
+	p.next()
+	index, at, err := p.size()
+	if err != nil {
+		return nil, err
+	}
+	if index >= reg.size {
+		return nil, p.errorf(at.pos, "%s[%d] is out of range; %s has %d", name.text, index, name.text, reg.size)
+	}
+	if _, err := p.expect("]"); err != nil {
+		return nil, err
+	}
+	return []uint32{uint32(reg.start + index)}, nil
+}
+
+func (p *qasmParser) measure(pos qasmPos, qubits, bits []uint32) error {
+	if len(qubits) != len(bits) {
+		return p.errorf(pos, "measuring %d qubits into %d bits", len(qubits), len(bits))
+	}
+	for i, q := range qubits {
+		p.ops = append(p.ops, CircuitOp{Gate: "M", Target: q, ClassicalReg: bits[i]})
+	}
+	return nil
+}
+
+func (p *qasmParser) gateCall() error {
+	name := p.next()
+	gate, ok := qasmGates[name.text]
+	if !ok {
+		return p.errorf(name.pos, "unsupported gate %q", name.text)
+	}
+
+	params, err := p.params()
+	if err != nil {
+		return err
+	}
+	if len(params) != gate.params {
+		return p.errorf(name.pos, "%s takes %d parameter(s), not %d", name.text, gate.params, len(params))
+	}
+
+	// Whole registers broadcast the gate over their elements
+	var args [][]uint32
+	width := 1
+	for {
+		at := p.peek().pos
+		arg, err := p.operand(true)
+		if err != nil {
+			return err
+		}
+		if len(arg) != 1 {
+			if width != 1 && len(arg) != width {
+				return p.errorf(at, "registers of sizes %d and %d cannot be broadcast together", width, len(arg))
+			}
+			width = len(arg)
+		}
+		args = append(args, arg)
+		if p.peek().text != "," {
+			break
+		}
+		p.next()
+	}
+	if len(args) != gate.qubits {
+		return p.errorf(name.pos, "%s acts on %d qubit(s), not %d", name.text, gate.qubits, len(args))
+	}
+	for i := 0; i < width; i++ {
+		qubits := make([]uint32, len(args))
+		for j, arg := range args {
+			qubits[j] = arg[min(i, len(arg)-1)]
+		}
+		if !distinct(qubits) {
+			return p.errorf(name.pos, "%s needs distinct qubits", name.text)
+		}
+		p.ops = append(p.ops, gate.expand(params, qubits)...)
+	}
+	_, err = p.expect(";")
+	return err
+}
+
+// params reads a gate's parenthesised parameters, if it has any
+func (p *qasmParser) params() ([]float64, error) {
+	var params []float64
+	if p.peek().text != "(" {
+		return nil, nil
+	}
+	p.next()
+	for p.peek().text != ")" {
+		v, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, v)
+		if p.peek().text != "," {
+			break
+		}
+		p.next()
+	}
+	_, err := p.expect(")")
+	return params, err
+}
+
+func distinct(qubits []uint32) bool {
+	for j := range qubits {
+		for k := j + 1; k < len(qubits); k++ {
+			if qubits[j] == qubits[k] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// expr reads an angle: sums of products of powers of numbers, constants
+// and function calls
+func (p *qasmParser) expr() (float64, error) {
+	v, err := p.term()
+	for err == nil && (p.peek().text == "+" || p.peek().text == "-") {
+		op := p.next().text
+		var w float64
+		if w, err = p.term(); op == "+" {
+			v += w
+		} else {
+			v -= w
+		}
+	}
+	return v, err
+}
+
+func (p *qasmParser) term() (float64, error) {
+	v, err := p.unary()
+	for err == nil && (p.peek().text == "*" || p.peek().text == "/") {
+		op := p.next()
+		var w float64
+		if w, err = p.unary(); err != nil {
+			break
+		}
+		if op.text == "*" {
+			v *= w
+		} else if w == 0 {
+			return 0, p.errorf(op.pos, "division by zero")
+		} else {
+			v /= w
+		}
+	}
+	return v, err
+}
+
+func (p *qasmParser) unary() (float64, error) {
+	if p.peek().text == "-" {
+		p.next()
+		v, err := p.unary()
+		return -v, err
+	}
+	if p.peek().text == "+" {
+		p.next()
+		return p.unary()
+	}
+	v, err := p.primary()
+	if err == nil && p.peek().text == "^" {
+		p.next()
+		var e float64
+		if e, err = p.unary(); err == nil {
+			v = math.Pow(v, e)
+		}
+	}
+	return v, err
+}
+
+func (p *qasmParser) primary() (float64, error) {
+	t := p.next()
+	switch {
+	case t.kind == qasmNumber:
+		return strconv.ParseFloat(t.text, 64)
+	case t.text == "(":
+		v, err := p.expr()
+		if err != nil {
+			return 0, err
+		}
+		_, err = p.expect(")")
+		return v, err
+	case t.kind == qasmIdent:
+		if v, ok := qasmConstants[t.text]; ok {
+			return v, nil
+		}
+		if f, ok := qasmFuncs[t.text]; ok {
+			if _, err := p.expect("("); err != nil {
+				return 0, err
+			}
+			v, err := p.expr()
+			if err != nil {
+				return 0, err
+			}
+			_, err = p.expect(")")
+			return f(v), err
+		}
+		return 0, p.errorf(t.pos, "unsupported: %q in an expression", t.text)
+	}
+	return 0, p.errorf(t.pos, "expected a number, found %s", t)
+}
@@ -0,0 +1,385 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	reg "github.com/perclft/QubitEngine/cli/internal/generated/registry"
+)
+
+var registrySaveOpts struct {
+	name        string
+	description string
+	domain      string
+	tags        string
+	public      bool
+}
+
+var registryLoadOpts struct {
+	version int
+	out     string
+}
+
+var registryListOpts struct {
+	domain   string
+	tags     string
+	author   string
+	public   bool
+	page     int
+	pageSize int
+}
+
+var registrySearchOpts struct {
+	domain string
+	author string
+	public bool
+	limit  int
+}
+
+var registryCmd = &command{
+	name:    "registry",
+	summary: "Save, load, find and fork circuits in the circuit registry",
+	subs: []*command{
+		{
+			name:    "save",
+			args:    "<circuit.json|circuit.qasm>",
+			summary: "Save a circuit file to the registry",
+			flags: func(fs *flag.FlagSet) {
+				fs.StringVar(&registrySaveOpts.name, "name", "", "Name to save under; defaults to the circuit's own")
+				fs.StringVar(&registrySaveOpts.description, "description", "", "What the circuit does")
+				fs.StringVar(&registrySaveOpts.domain, "domain", "general", "physics, gaming, finance, education, music, crypto or general")
+				fs.StringVar(&registrySaveOpts.tags, "tags", "", "Comma-separated tags")
+				fs.BoolVar(&registrySaveOpts.public, "public", false, "Share the circuit with everyone")
+			},
+			run: registrySave,
+		},
+		{
+			name:    "load",
+			args:    "<circuit-id>",
+			summary: "Fetch a circuit as a circuit file",
+			flags: func(fs *flag.FlagSet) {
+				fs.IntVar(&registryLoadOpts.version, "version", 0, "Version to load; 0 for the latest")
+				fs.StringVar(&registryLoadOpts.out, "o", "", "File to write; standard output if unset")
+			},
+			run: registryLoad,
+		},
+		{
+			name:    "list",
+			summary: "List saved circuits, newest first",
+			flags: func(fs *flag.FlagSet) {
+				fs.StringVar(&registryListOpts.domain, "domain", "", "Only circuits in this domain")
+				fs.StringVar(&registryListOpts.tags, "tags", "", "Only circuits with all these comma-separated tags")
+				fs.StringVar(&registryListOpts.author, "author", "", "Only this author's circuits")
+				fs.BoolVar(&registryListOpts.public, "public", false, "Only public circuits")
+				fs.IntVar(&registryListOpts.page, "page", 1, "Page to show")
+				fs.IntVar(&registryListOpts.pageSize, "page-size", 20, "Circuits per page, up to 100")
+			},
+			run: registryList,
+		},
+		{
+			name:    "search",
+			args:    "<query>",
+			summary: "Find circuits by name, description or tag",
+			flags: func(fs *flag.FlagSet) {
+				fs.StringVar(&registrySearchOpts.domain, "domain", "", "Only circuits in this domain")
+				fs.StringVar(&registrySearchOpts.author, "author", "", "Only this author's circuits")
+				fs.BoolVar(&registrySearchOpts.public, "public", false, "Only public circuits")
+				fs.IntVar(&registrySearchOpts.limit, "limit", 20, "Most circuits to show")
+			},
+			run: registrySearch,
+		},
+		{name: "fork", args: "<circuit-id> <new-name>", summary: "Copy a circuit under a new name", run: registryFork},
+	},
+}
+
+// circuitRecord is a saved circuit's details as JSON or CSV
+type circuitRecord struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Author        string   `json:"author"`
+	Domain        string   `json:"domain"`
+	Tags          []string `json:"tags"`
+	NumQubits     int32    `json:"num_qubits"`
+	NumOperations int32    `json:"num_operations"`
+	Version       int32    `json:"version"`
+	CreatedAt     int64    `json:"created_at"`
+	UpdatedAt     int64    `json:"updated_at"`
+	IsPublic      bool     `json:"is_public"`
+	ForkCount     int32    `json:"fork_count"`
+	RunCount      int32    `json:"run_count"`
+}
+
+func newCircuitRecord(m *reg.CircuitMetadata) circuitRecord {
+	tags := m.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return circuitRecord{m.Id, m.Name, m.Description, m.Author, m.Domain, tags, m.NumQubits, m.NumOperations,
+		m.Version, m.CreatedAt, m.UpdatedAt, m.IsPublic, m.ForkCount, m.RunCount}
+}
+
+var circuitHeader = []string{"id", "name", "description", "author", "domain", "tags", "num_qubits",
+	"num_operations", "version", "created_at", "updated_at", "is_public", "fork_count", "run_count"}
+
+func (c circuitRecord) row() []string {
+	return []string{c.ID, c.Name, c.Description, c.Author, c.Domain, strings.Join(c.Tags, ","),
+		fmt.Sprint(c.NumQubits), fmt.Sprint(c.NumOperations), fmt.Sprint(c.Version), fmt.Sprint(c.CreatedAt),
+		fmt.Sprint(c.UpdatedAt), fmt.Sprint(c.IsPublic), fmt.Sprint(c.ForkCount), fmt.Sprint(c.RunCount)}
+}
+
+func (c circuitRecord) header() []string { return circuitHeader }
+func (c circuitRecord) rows() [][]string { return [][]string{c.row()} }
+
+type circuitList struct {
+	Circuits []circuitRecord `json:"circuits"`
+	Page     int32           `json:"page"`
+	PageSize int32           `json:"page_size"`
+}
+
+func (l circuitList) header() []string { return circuitHeader }
+
+func (l circuitList) rows() [][]string {
+	rows := make([][]string, len(l.Circuits))
+	for i, c := range l.Circuits {
+		rows[i] = c.row()
+	}
+	return rows
+}
+
+// registryClient connects to the registry; the caller closes the
+// returned function when done
+func registryClient() (reg.CircuitRegistryClient, func(), error) {
+	conn, err := dial(globals.registry)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reg.NewCircuitRegistryClient(conn), func() { conn.Close() }, nil
+}
+
+// splitTags reads a comma-separated list, dropping empty entries
+func splitTags(list string) []string {
+	var tags []string
+	for _, tag := range strings.Split(list, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func registrySave(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<circuit file>"); err != nil {
+		return err
+	}
+	circuit, err := loadCircuit(args[0])
+	if err != nil {
+		return err
+	}
+	req, err := circuit.request()
+	if err != nil {
+		return err
+	}
+	name := registrySaveOpts.name
+	if name == "" {
+		name = circuit.Name
+	}
+	if name == "" {
+		return fmt.Errorf("the circuit has no name; give one with -name")
+	}
+
+	client, done, err := registryClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	meta, err := client.SaveCircuit(ctx, &reg.SaveCircuitRequest{
+		Name:        name,
+		Description: registrySaveOpts.description,
+		Circuit:     req,
+		Domain:      registrySaveOpts.domain,
+		Tags:        splitTags(registrySaveOpts.tags),
+		IsPublic:    registrySaveOpts.public,
+	})
+	if err != nil {
+		return fmt.Errorf("save failed: %v", err)
+	}
+	if machineOutput() {
+		return emit(newCircuitRecord(meta))
+	}
+	fmt.Printf("🗄️ Saved '%s' as %s (version %d)\n", meta.Name, meta.Id, meta.Version)
+	return nil
+}
+
+// storedCircuit fetches a version of a saved circuit, 0 for the latest
+func storedCircuit(ctx context.Context, id string, version int) (*CircuitFile, error) {
+	client, done, err := registryClient()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	req, err := client.LoadCircuit(ctx, &reg.LoadCircuitRequest{CircuitId: id, Version: int32(version)})
+	if err != nil {
+		return nil, fmt.Errorf("load failed: %v", err)
+	}
+	return circuitFromProto(id, req), nil
+}
+
+func registryLoad(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<circuit-id>"); err != nil {
+		return err
+	}
+	circuit, err := storedCircuit(ctx, args[0], registryLoadOpts.version)
+	if err != nil {
+		return err
+	}
+
+	// The circuit file is the result, so tables show it as JSON too
+	if registryLoadOpts.out == "" {
+		return emit(circuit)
+	}
+	f, err := os.Create(registryLoadOpts.out)
+	if err == nil {
+		err = emitTo(f, circuit)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write file: %v", err)
+	}
+	notef("🗄️ Wrote circuit %s to %s\n", args[0], registryLoadOpts.out)
+	return nil
+}
+
+func registryList(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	client, done, err := registryClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	list, err := client.ListCircuits(ctx, &reg.ListCircuitsRequest{
+		Domain:     registryListOpts.domain,
+		Tags:       splitTags(registryListOpts.tags),
+		Author:     registryListOpts.author,
+		PublicOnly: registryListOpts.public,
+		Page:       int32(registryListOpts.page),
+		PageSize:   int32(registryListOpts.pageSize),
+	})
+	if err != nil {
+		return fmt.Errorf("listing circuits failed: %v", err)
+	}
+	if machineOutput() {
+		return emit(newCircuitList(list.Circuits, list.Page, list.PageSize))
+	}
+	printCircuits(list.Circuits)
+	fmt.Printf("\nPage %d, %d per page\n", list.Page, list.PageSize)
+	return nil
+}
+
+func newCircuitList(circuits []*reg.CircuitMetadata, page, pageSize int32) circuitList {
+	list := circuitList{Circuits: []circuitRecord{}, Page: page, PageSize: pageSize}
+	for _, c := range circuits {
+		list.Circuits = append(list.Circuits, newCircuitRecord(c))
+	}
+	return list
+}
+
+func printCircuits(circuits []*reg.CircuitMetadata) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tDOMAIN\tQUBITS\tOPS\tVERSION\tRUNS\tTAGS")
+	for _, c := range circuits {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n", c.Id, c.Name, c.Domain,
+			c.NumQubits, c.NumOperations, c.Version, c.RunCount, strings.Join(c.Tags, ","))
+	}
+	tw.Flush()
+}
+
+// matches reports whether every word of the query is in the circuit's
+// name, description or tags, ignoring case
+func matches(c *reg.CircuitMetadata, words []string) bool {
+	text := strings.ToLower(c.Name + " " + c.Description + " " + strings.Join(c.Tags, " "))
+	for _, w := range words {
+		if !strings.Contains(text, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// registrySearch pages through the registry's listing, which has no
+// text search of its own, keeping the circuits that match
+func registrySearch(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("expected <query>, got no arguments")
+	}
+	if registrySearchOpts.limit < 1 {
+		return fmt.Errorf("-limit must be at least 1")
+	}
+	words := strings.Fields(strings.ToLower(strings.Join(args, " ")))
+	client, done, err := registryClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	const pageSize = 100
+	var found []*reg.CircuitMetadata
+	for page := int32(1); len(found) < registrySearchOpts.limit; page++ {
+		list, err := client.ListCircuits(ctx, &reg.ListCircuitsRequest{
+			Domain:     registrySearchOpts.domain,
+			Author:     registrySearchOpts.author,
+			PublicOnly: registrySearchOpts.public,
+			Page:       page,
+			PageSize:   pageSize,
+		})
+		if err != nil {
+			return fmt.Errorf("searching circuits failed: %v", err)
+		}
+		for _, c := range list.Circuits {
+			if matches(c, words) && len(found) < registrySearchOpts.limit {
+				found = append(found, c)
+			}
+		}
+		if len(list.Circuits) < pageSize {
+			break
+		}
+	}
+
+	if machineOutput() {
+		return emit(newCircuitList(found, 1, int32(registrySearchOpts.limit)))
+	}
+	if len(found) == 0 {
+		fmt.Printf("No circuits match %q\n", strings.Join(args, " "))
+		return nil
+	}
+	printCircuits(found)
+	return nil
+}
+
+func registryFork(ctx context.Context, args []string) error {
+	if err := expectArgs(args, "<circuit-id>", "<new-name>"); err != nil {
+		return err
+	}
+	client, done, err := registryClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	meta, err := client.ForkCircuit(ctx, &reg.ForkCircuitRequest{SourceCircuitId: args[0], NewName: args[1]})
+	if err != nil {
+		return fmt.Errorf("fork failed: %v", err)
+	}
+	if machineOutput() {
+		return emit(newCircuitRecord(meta))
+	}
+	fmt.Printf("🍴 Forked %s as '%s' (%s)\n", args[0], meta.Name, meta.Id)
+	return nil
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+	reg "github.com/perclft/QubitEngine/cli/internal/generated/registry"
+)
+
+// streamQubits is the register a StreamGates session holds
+const streamQubits = 3
+
+var replCmd = &command{
+	name:    "repl",
+	summary: "Apply gates one at a time to a live engine session",
+	run:     runREPL,
+}
+
+const replHelp = `Gates, one per line, on qubits 0-%d:
+  h 0   x 1   y 0   z 0   s 0   sdg 0   t 0   tdg 0
+  rx(pi/2) 0   ry(0.5) 1   rz(-pi/4) 2   u3(t, p, l) 0
+  cx 0 1   cz 0 1   swap 0 1   ccx 0 1 2
+  measure 0 [bit]
+Commands:
+  .state          Show the state vector
+  .probs          Show the basis state and per-qubit probabilities
+  .circuit        List the gates applied so far
+  .undo           Take back the last line, replaying the rest
+  .reset          Start again from |000>
+  .save <file>    Write the circuit as a circuit file
+  .push <name>    Save the circuit to the registry
+  .help           Show this help
+  .quit           Leave
+`
+
+// replSession is a StreamGates session and the lines applied to it.
+// The engine cannot take gates back, so undo and reset open a fresh
+// session and replay what remains.
+type replSession struct {
+	ctx    context.Context
+	client pb.QuantumComputeClient
+	stream pb.QuantumCompute_StreamGatesClient
+	cancel context.CancelFunc
+	steps  [][]CircuitOp
+	state  *pb.StateResponse
+}
+
+func (s *replSession) open() error {
+	s.close()
+	ctx, cancel := context.WithCancel(s.ctx)
+	stream, err := s.client.StreamGates(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("stream init failed: %v", err)
+	}
+	s.stream, s.cancel, s.state = stream, cancel, nil
+	return nil
+}
+
+func (s *replSession) close() {
+	if s.stream != nil {
+		s.stream.CloseSend()
+		s.cancel()
+		s.stream = nil
+	}
+}
+
+// send applies ops, keeping the state after the last
+func (s *replSession) send(ops []CircuitOp) error {
+	pbOps, err := (&CircuitFile{Ops: ops}).operations()
+	if err != nil {
+		return err
+	}
+	for _, op := range pbOps {
+		if err := s.stream.Send(op); err != nil {
+			return fmt.Errorf("failed to send gate: %v", err)
+		}
+		res, err := s.stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("the engine closed the session")
+		}
+		if err != nil {
+			return fmt.Errorf("stream read failed: %v", err)
+		}
+		s.state = res
+	}
+	return nil
+}
+
+func (s *replSession) apply(ops []CircuitOp) error {
+	if err := s.send(ops); err != nil {
+		return err
+	}
+	s.steps = append(s.steps, ops)
+	return nil
+}
+
+// replay opens a fresh session and applies the steps kept
+func (s *replSession) replay(steps [][]CircuitOp) error {
+	if err := s.open(); err != nil {
+		return err
+	}
+	s.steps = nil
+	for _, ops := range steps {
+		if err := s.apply(ops); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *replSession) circuit(name string) *CircuitFile {
+	c := &CircuitFile{Name: name, Qubits: streamQubits}
+	for _, ops := range s.steps {
+		c.Ops = append(c.Ops, ops...)
+	}
+	return c
+}
+
+// replAliases are the JSON DSL's gate names and other spellings
+var replAliases = map[string]string{"m": "measure", "cnot": "cx", "toffoli": "ccx", "ccnot": "ccx"}
+
+// parseREPLGate reads a gate line, e.g. "ry(pi/2) 1" or "cx 0 1"
+func parseREPLGate(line string) ([]CircuitOp, error) {
+	toks, err := lexQASM("input", line)
+	if err != nil {
+		return nil, err
+	}
+	p := &qasmParser{file: "input", toks: toks}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	gateName := strings.ToLower(name.text)
+	if alias, ok := replAliases[gateName]; ok {
+		gateName = alias
+	}
+	params, err := p.params()
+	if err != nil {
+		return nil, err
+	}
+	var qubits []uint32
+	for p.peek().kind != qasmEOF && p.peek().text != ";" {
+		n, t, err := p.size()
+		if err != nil {
+			return nil, err
+		}
+		if n >= streamQubits && !(gateName == "measure" && len(qubits) == 1) {
+			return nil, p.errorf(t.pos, "qubit %d is out of range; the session has %d", n, streamQubits)
+		}
+		qubits = append(qubits, uint32(n))
+		if p.peek().text == "," {
+			p.next()
+		}
+	}
+
+	if gateName == "measure" {
+		switch len(qubits) {
+		case 1:
+			return []CircuitOp{{Gate: "M", Target: qubits[0], ClassicalReg: qubits[0]}}, nil
+		case 2:
+			return []CircuitOp{{Gate: "M", Target: qubits[0], ClassicalReg: qubits[1]}}, nil
+		}
+		return nil, p.errorf(name.pos, "measure takes a qubit and optionally a bit")
+	}
+	gate, ok := qasmGates[gateName]
+	if !ok {
+		return nil, p.errorf(name.pos, "unknown gate %q; type .help for the list", name.text)
+	}
+	switch {
+	case len(params) != gate.params:
+		return nil, p.errorf(name.pos, "%s takes %d parameter(s), not %d", gateName, gate.params, len(params))
+	case len(qubits) != gate.qubits:
+		return nil, p.errorf(name.pos, "%s acts on %d qubit(s), not %d", gateName, gate.qubits, len(qubits))
+	case !distinct(qubits):
+		return nil, p.errorf(name.pos, "%s needs distinct qubits", gateName)
+	}
+	return gate.expand(params, qubits), nil
+}
+
+func printProbabilities(vec []*pb.StateResponse_ComplexNumber) {
+	printHistogram(probabilityBars(vec))
+	ones := make([]float64, qubitsFor(len(vec)))
+	for i, amp := range vec {
+		for q := range ones {
+			if i>>q&1 == 1 {
+				ones[q] += amp.Real*amp.Real + amp.Imag*amp.Imag
+			}
+		}
+	}
+	fmt.Println()
+	for q, p := range ones {
+		fmt.Printf(" Q%d: P(1) = %.4f\n", q, p)
+	}
+}
+
+func runREPL(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	conn, err := dial(globals.server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The session lasts as long as the user types; -timeout is for
+	// one-shot commands
+	s := &replSession{ctx: context.WithoutCancel(ctx), client: pb.NewQuantumComputeClient(conn)}
+	if err := s.open(); err != nil {
+		return err
+	}
+	defer s.close()
+
+	fmt.Printf("🔁 Live session on %s with %d qubits; type .help for commands\n", globals.server, streamQubits)
+	in := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print("qctl> ")
+		if !in.Scan() {
+			fmt.Println()
+			return in.Err()
+		}
+		line := strings.TrimSpace(in.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if !strings.HasPrefix(line, ".") {
+			ops, err := parseREPLGate(line)
+			if err == nil {
+				err = s.apply(ops)
+			}
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				continue
+			}
+			if ops[0].Gate == "M" {
+				fmt.Printf(" [Q%d] -> |%s>\n", ops[0].Target, bitString(s.state.ClassicalResults[ops[0].ClassicalReg]))
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)
+		var err error
+		switch fields[0] {
+		case ".help":
+			fmt.Printf(replHelp, streamQubits-1)
+		case ".quit", ".exit":
+			return nil
+		case ".state":
+			if s.state == nil {
+				fmt.Println(" |000> : (1.000 + 0.000i)")
+				break
+			}
+			printStateVector(s.state.StateVector)
+		case ".probs":
+			if s.state == nil {
+				fmt.Println(" |000> 100.00%")
+				break
+			}
+			printProbabilities(s.state.StateVector)
+		case ".circuit":
+			for i, op := range s.circuit("").Ops {
+				fmt.Printf(" %3d  %-7s target=%d control=%d control2=%d angle=%.4f\n",
+					i+1, op.Gate, op.Target, op.Control, op.Control2, op.Angle)
+			}
+		case ".undo":
+			if len(s.steps) == 0 {
+				fmt.Println("Nothing to undo")
+				break
+			}
+			err = s.replay(s.steps[:len(s.steps)-1])
+			if err == nil {
+				fmt.Println("↩️  Undone; measurements replayed may come out differently")
+			}
+		case ".reset":
+			err = s.replay(nil)
+			if err == nil {
+				fmt.Println("🔄 Back to |000>")
+			}
+		case ".save":
+			if len(fields) != 2 {
+				err = fmt.Errorf("usage: .save <file>")
+				break
+			}
+			var data []byte
+			if data, err = json.MarshalIndent(s.circuit("repl"), "", "  "); err == nil {
+				err = os.WriteFile(fields[1], append(data, '\n'), 0o644)
+			}
+			if err == nil {
+				fmt.Printf("💾 Wrote %s\n", fields[1])
+			}
+		case ".push":
+			if len(fields) != 2 {
+				err = fmt.Errorf("usage: .push <name>")
+				break
+			}
+			err = pushREPLCircuit(s, fields[1])
+		default:
+			err = fmt.Errorf("unknown command %s; type .help for the list", fields[0])
+		}
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+		}
+	}
+}
+
+func pushREPLCircuit(s *replSession, name string) error {
+	req, err := s.circuit(name).request()
+	if err != nil {
+		return err
+	}
+	client, done, err := registryClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	ctx, cancel := withDeadline(s.ctx)
+	defer cancel()
+	meta, err := client.SaveCircuit(ctx, &reg.SaveCircuitRequest{Name: name, Circuit: req, Domain: "general"})
+	if err != nil {
+		return fmt.Errorf("save failed: %v", err)
+	}
+	fmt.Printf("🗄️ Saved '%s' as %s\n", meta.Name, meta.Id)
+	return nil
+}
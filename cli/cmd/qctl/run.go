@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+)
+
+var runOpts struct {
+	file       string
+	circuitID  string
+	version    int
+	backend    string
+	shots      int
+	streamMode bool
+	vizMode    bool
+	draw       bool
+	dryRun     bool
+	stepDelay  time.Duration
+}
+
+var runCmd = &command{
+	name:    "run",
+	args:    "[circuit.json|circuit.qasm]",
+	summary: "Run a circuit on the engine and print its final state",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&runOpts.file, "file", "", "Path to circuit JSON or OpenQASM file, in place of the argument")
+		fs.StringVar(&runOpts.circuitID, "circuit-id", "", "Run a circuit saved in the registry instead of a file")
+		fs.IntVar(&runOpts.version, "version", 0, "Version of the -circuit-id circuit; 0 for the latest")
+		fs.StringVar(&runOpts.backend, "backend", defaultBackend(), "Where to run: local-sim, ibm:<backend>, ionq:<target> or rigetti:<qpu>")
+		fs.IntVar(&runOpts.shots, "shots", 0, "Run the circuit this many times and print outcome counts instead of the final state; hardware takes 1024 by default")
+		fs.BoolVar(&runOpts.streamMode, "stream", false, "Enable Real-Time Streaming Visualization")
+		fs.DurationVar(&runOpts.stepDelay, "step-delay", 0, "Pause between gates in -stream mode, to watch the state evolve")
+		fs.BoolVar(&runOpts.vizMode, "viz", false, "Enable Server-Side Visualization Stream")
+		fs.BoolVar(&runOpts.draw, "draw", false, "Draw the circuit before running it")
+		fs.BoolVar(&runOpts.dryRun, "dry-run", false, "Check the circuit and estimate its resources instead of running it")
+	},
+	run: runCircuit,
+}
+
+// runResult is a run as JSON or CSV: its final state, or each step's
+// when streamed
+type runResult struct {
+	Circuit      string  `json:"circuit"`
+	Qubits       int32   `json:"qubits"`
+	DurationMs   float64 `json:"duration_ms,omitempty"`
+	*stateRecord `json:",omitempty"`
+	Steps        []stateRecord `json:"steps,omitempty"`
+}
+
+func (r *runResult) header() []string { return stateHeader }
+
+func (r *runResult) rows() [][]string {
+	if r.stateRecord != nil {
+		return r.stateRecord.rows()
+	}
+	var rows [][]string
+	for _, step := range r.Steps {
+		rows = append(rows, step.rows()...)
+	}
+	return rows
+}
+
+func runCircuit(ctx context.Context, args []string) error {
+	if runOpts.streamMode && runOpts.vizMode {
+		return fmt.Errorf("-stream and -viz cannot be combined")
+	}
+	backend, err := lookupBackend(backendRegistry(), runOpts.backend)
+	if err != nil {
+		return err
+	}
+	switch {
+	case runOpts.shots < 0:
+		return fmt.Errorf("-shots must not be negative")
+	case runOpts.backend != localSim && (runOpts.streamMode || runOpts.vizMode):
+		return fmt.Errorf("-stream and -viz need the %s backend", localSim)
+	case runOpts.shots > 0 && (runOpts.streamMode || runOpts.vizMode):
+		return fmt.Errorf("-shots cannot be combined with -stream or -viz")
+	case runOpts.stepDelay != 0 && !runOpts.streamMode:
+		return fmt.Errorf("-step-delay needs -stream")
+	}
+	// 1. Read & Parse Circuit
+	circuit, err := circuitSource(ctx, runOpts.file, runOpts.circuitID, runOpts.version, args)
+	if err != nil {
+		return err
+	}
+	if runOpts.dryRun {
+		var extra []string
+		if runOpts.backend != localSim {
+			if _, err := hardwareCircuit(circuit, runOpts.shots); err != nil {
+				extra = append(extra, fmt.Sprintf("%s: %v", runOpts.backend, err))
+			}
+		}
+		return dryRun(ctx, circuit, false, extra...)
+	}
+	pbOps, err := circuit.operations()
+	if err != nil {
+		return err
+	}
+
+	if runOpts.draw {
+		notef("%s\n", renderCircuit(circuit, unicodeGlyphs))
+	}
+	if runOpts.backend != localSim {
+		return runOnHardware(ctx, runOpts.backend, backend, circuit, runOpts.shots)
+	}
+
+	// 2. Connect to Engine
+	conn, err := dial(globals.server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewQuantumComputeClient(conn)
+	if runOpts.shots > 0 {
+		return runShots(ctx, c, circuit, runOpts.shots)
+	}
+
+	notef("⚡ Submitting Circuit: '%s' (%d Qubits)\n", circuit.Name, circuit.Qubits)
+	rec := &runResult{Circuit: circuit.Name, Qubits: circuit.Qubits}
+	switch {
+	case runOpts.streamMode:
+		err = runStreaming(ctx, c, circuit, pbOps, rec)
+	case runOpts.vizMode:
+		err = runVisualize(ctx, c, circuit.Qubits, pbOps, rec)
+	default:
+		err = runStandard(ctx, c, circuit.Qubits, pbOps, rec)
+	}
+	if err != nil || !machineOutput() {
+		return err
+	}
+	return emit(rec)
+}
+
+func runStandard(ctx context.Context, c pb.QuantumComputeClient, qubits int32, ops []*pb.GateOperation, rec *runResult) error {
+	start := time.Now()
+	res, err := c.RunCircuit(ctx, &pb.CircuitRequest{
+		NumQubits:  qubits,
+		Operations: ops,
+	})
+	if err != nil {
+		return fmt.Errorf("engine error: %v", err)
+	}
+	duration := time.Since(start)
+
+	if machineOutput() {
+		state := newStateRecord(0, res)
+		rec.stateRecord, rec.DurationMs = &state, float64(duration.Microseconds())/1000
+		return nil
+	}
+	fmt.Printf("✅ Done in %s\n", duration)
+	printResults(res)
+	return nil
+}
+
+func runVisualize(ctx context.Context, c pb.QuantumComputeClient, qubits int32, ops []*pb.GateOperation, rec *runResult) error {
+	notef("🎥 Requesting Visualization Stream...\n")
+
+	req := &pb.CircuitRequest{
+		NumQubits:  qubits,
+		Operations: ops,
+	}
+
+	stream, err := c.VisualizeCircuit(ctx, req)
+	if err != nil {
+		return fmt.Errorf("visualize init failed: %v", err)
+	}
+
+	step := 1
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("visualize stream failed: %v", err)
+		}
+
+		if machineOutput() {
+			rec.Steps = append(rec.Steps, newStateRecord(step, res))
+		} else {
+			fmt.Printf("\n--- [Step %d] Visual State ---\n", step)
+			printStateVector(res.StateVector)
+		}
+		step++
+	}
+	notef("\n✅ Visualization Completed.\n")
+	return nil
+}
+
+func runStreaming(ctx context.Context, c pb.QuantumComputeClient, circuit *CircuitFile, ops []*pb.GateOperation, rec *runResult) error {
+	notef("🌊 Connecting to Live Kernel Stream...\n")
+	stream, err := c.StreamGates(ctx)
+	if err != nil {
+		return fmt.Errorf("stream init failed: %v", err)
+	}
+
+	// On a terminal each step redraws a live view; otherwise steps print
+	// one after another
+	var view *streamView
+	if liveTerminal() {
+		view = newStreamView(circuit)
+	}
+
+	// Background thread to read responses
+	waitc := make(chan error, 1)
+	go func() {
+		step := 1
+		for {
+			in, err := stream.Recv()
+			if err == io.EOF {
+				waitc <- nil
+				return
+			}
+			if err != nil {
+				waitc <- fmt.Errorf("stream read failed: %v", err)
+				return
+			}
+
+			if machineOutput() {
+				rec.Steps = append(rec.Steps, newStateRecord(step, in))
+				step++
+				continue
+			}
+			if view != nil {
+				view.update(in)
+				view.draw(os.Stdout)
+				continue
+			}
+			// Clear screen or just print separator
+			fmt.Printf("\n--- [Step %d] Wavefunction Update ---\n", step)
+			printStateVector(in.StateVector)
+			printMeasurements(in.ClassicalResults)
+			step++
+		}
+	}()
+
+	// Send Gates
+	for i, op := range ops {
+		if i > 0 && runOpts.stepDelay > 0 {
+			select {
+			case <-time.After(runOpts.stepDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := stream.Send(op); err != nil {
+			return fmt.Errorf("failed to send gate: %v", err)
+		}
+	}
+	stream.CloseSend()
+	if err := <-waitc; err != nil {
+		return err
+	}
+	notef("\n✅ Stream Completed.\n")
+	return nil
+}
+
+func printResults(res *pb.StateResponse) {
+	fmt.Println("\n--- 🔬 Measurement Register ---")
+	printMeasurements(res.ClassicalResults)
+
+	fmt.Println("\n--- 🌊 Final Wavefunction (Non-Zero) ---")
+	printStateVector(res.StateVector)
+
+	fmt.Println("\n--- 📊 Probabilities ---")
+	printHistogram(probabilityBars(res.StateVector))
+}
+
+func printMeasurements(results map[uint32]bool) {
+	if len(results) == 0 {
+		return
+	}
+	for q, val := range results {
+		bit := "0"
+		if val {
+			bit = "1"
+		}
+		fmt.Printf(" [Q%d] -> |%s>\n", q, bit)
+	}
+}
+
+func printStateVector(vec []*pb.StateResponse_ComplexNumber) {
+	for i, amp := range vec {
+		mag := amp.Real*amp.Real + amp.Imag*amp.Imag
+		if mag > 0.0001 {
+			sign := "+"
+			if amp.Imag < 0 {
+				sign = "-"
+			}
+			fmt.Printf(" |%d> : (%.3f %s %.3fi)\n", i, amp.Real, sign, makePositive(amp.Imag))
+		}
+	}
+}
+
+func makePositive(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
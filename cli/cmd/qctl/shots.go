@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+)
+
+// shotWorkers is how many shots run on the engine at once
+const shotWorkers = 8
+
+// outcome is how often one set of measured bits came up
+type outcome struct {
+	Bits        string  `json:"bits"`
+	Count       int     `json:"count"`
+	Probability float64 `json:"probability"`
+	StdError    float64 `json:"std_error"`
+}
+
+// outcomes are the counts as estimated probabilities, each with the
+// binomial standard error sqrt(p(1-p)/n), in bit order
+func outcomes(counts map[string]int) []outcome {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	out := make([]outcome, 0, len(counts))
+	for bits, n := range counts {
+		p := float64(n) / float64(total)
+		out = append(out, outcome{bits, n, p, math.Sqrt(p * (1 - p) / float64(total))})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Bits < out[j].Bits })
+	return out
+}
+
+var outcomeHeader = []string{"bits", "count", "probability", "std_error"}
+
+func (o outcome) row() []string {
+	return []string{o.Bits, fmt.Sprint(o.Count), formatFloat(o.Probability), formatFloat(o.StdError)}
+}
+
+// printCounts prints a table of outcomes with a bar for each
+func printCounts(counts map[string]int) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "OUTCOME\tCOUNT\tPROBABILITY\tSTD ERROR")
+	for _, o := range outcomes(counts) {
+		bar := strings.Repeat("█", int(math.Round(o.Probability*histogramWidth)))
+		fmt.Fprintf(tw, "%s\t%d\t%.4f\t±%.4f\t%s\n", o.Bits, o.Count, o.Probability, o.StdError, bar)
+	}
+	tw.Flush()
+}
+
+// shotsResult is a many-shot run as JSON or CSV
+type shotsResult struct {
+	Circuit  string    `json:"circuit"`
+	Backend  string    `json:"backend"`
+	JobID    string    `json:"job_id,omitempty"`
+	Shots    int       `json:"shots"`
+	Outcomes []outcome `json:"outcomes"`
+}
+
+func newShotsResult(circuit, backend, jobID string, counts map[string]int) *shotsResult {
+	r := &shotsResult{Circuit: circuit, Backend: backend, JobID: jobID, Outcomes: outcomes(counts)}
+	for _, n := range counts {
+		r.Shots += n
+	}
+	return r
+}
+
+func (r *shotsResult) header() []string { return outcomeHeader }
+
+func (r *shotsResult) rows() [][]string {
+	rows := make([][]string, len(r.Outcomes))
+	for i, o := range r.Outcomes {
+		rows[i] = o.row()
+	}
+	return rows
+}
+
+// printShots prints a many-shot run's counts, or emits them for -output
+func printShots(r *shotsResult, counts map[string]int) error {
+	if machineOutput() {
+		return emit(r)
+	}
+	fmt.Printf("\n--- 📊 Counts over %d shots ---\n", r.Shots)
+	printCounts(counts)
+	return nil
+}
+
+// measuredRegisters are the classical registers a circuit writes, highest
+// first as outcomes print them; a circuit without measurements has one
+// added on every qubit, so each shot reads the whole register
+func measuredRegisters(c *CircuitFile) (*CircuitFile, []uint32) {
+	seen := map[uint32]bool{}
+	for _, op := range c.Ops {
+		if strings.EqualFold(op.Gate, "M") {
+			seen[op.ClassicalReg] = true
+		}
+	}
+	if len(seen) == 0 {
+		measured := &CircuitFile{Name: c.Name, Qubits: c.Qubits, Ops: append([]CircuitOp(nil), c.Ops...)}
+		for q := uint32(0); q < uint32(c.Qubits); q++ {
+			measured.Ops = append(measured.Ops, CircuitOp{Gate: "M", Target: q, ClassicalReg: q})
+			seen[q] = true
+		}
+		c = measured
+	}
+	regs := make([]uint32, 0, len(seen))
+	for r := range seen {
+		regs = append(regs, r)
+	}
+	sort.Slice(regs, func(i, j int) bool { return regs[i] > regs[j] })
+	return c, regs
+}
+
+// runShots runs the circuit shots times on the engine, which takes one
+// shot per call, and counts what was measured
+func runShots(ctx context.Context, c pb.QuantumComputeClient, circuit *CircuitFile, shots int) error {
+	circuit, regs := measuredRegisters(circuit)
+	req, err := circuit.request()
+	if err != nil {
+		return err
+	}
+
+	notef("⚡ Running '%s' (%d Qubits) for %d shots\n", circuit.Name, circuit.Qubits, shots)
+	start := time.Now()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu       sync.Mutex
+		counts   = map[string]int{}
+		firstErr error
+		wg       sync.WaitGroup
+		next     = make(chan struct{})
+	)
+	for w := 0; w < min(shotWorkers, shots); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range next {
+				res, err := c.RunCircuit(ctx, req)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = fmt.Errorf("engine error: %v", err)
+					cancel()
+				}
+				if err == nil {
+					var bits strings.Builder
+					for _, r := range regs {
+						bits.WriteString(bitString(res.ClassicalResults[r]))
+					}
+					counts[bits.String()]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := 0; i < shots && ctx.Err() == nil; i++ {
+		next <- struct{}{}
+	}
+	close(next)
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	notef("✅ Done in %s\n", time.Since(start))
+	return printShots(newShotsResult(circuit.Name, localSim, "", counts), counts)
+}
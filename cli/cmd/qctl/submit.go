@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	sched "github.com/perclft/QubitEngine/cli/internal/generated/scheduler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metadataFlag collects repeated -meta key=value pairs
+type metadataFlag map[string]string
+
+func (m metadataFlag) String() string {
+	var pairs []string
+	for k, v := range m {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (m metadataFlag) Set(pair string) error {
+	k, v, ok := strings.Cut(pair, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("want key=value, got %q", pair)
+	}
+	m[k] = v
+	return nil
+}
+
+var submitOpts = struct {
+	file     string
+	priority string
+	shots    int
+	user     string
+	callback string
+	meta     metadataFlag
+	watch    bool
+	interval time.Duration
+}{meta: metadataFlag{}}
+
+var submitCmd = &command{
+	name:    "submit",
+	args:    "[circuit.json|circuit.qasm]",
+	summary: "Queue a circuit on the scheduler",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&submitOpts.file, "file", "", "Path to circuit JSON or OpenQASM file, in place of the argument")
+		fs.StringVar(&submitOpts.priority, "priority", "normal", "low, normal, high or realtime")
+		fs.IntVar(&submitOpts.shots, "shots", 1, "Measurement repetitions")
+		fs.StringVar(&submitOpts.user, "user", "", "User the job runs as")
+		fs.StringVar(&submitOpts.callback, "callback", "", "URL to notify when the job finishes")
+		fs.Var(submitOpts.meta, "meta", "Metadata as `key=value`; repeat for more")
+		fs.BoolVar(&submitOpts.watch, "watch", false, "Follow the job until it finishes and print its results")
+		fs.DurationVar(&submitOpts.interval, "interval", time.Second, "How often -watch checks the job")
+	},
+	run: submitJob,
+}
+
+// parseJobPriority reads a priority by its short name, e.g. "high"
+func parseJobPriority(name string) (sched.JobPriority, error) {
+	priority, ok := sched.JobPriority_value["PRIORITY_"+strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown priority %q; want low, normal, high or realtime", name)
+	}
+	return sched.JobPriority(priority), nil
+}
+
+// finished reports whether a job has reached its final state
+func finished(state sched.JobState) bool {
+	switch state {
+	case sched.JobState_STATE_COMPLETED, sched.JobState_STATE_FAILED, sched.JobState_STATE_CANCELLED:
+		return true
+	}
+	return false
+}
+
+// submitRecord is a submitted job as JSON or CSV, with how it ended
+// when watched
+type submitRecord struct {
+	JobID                string         `json:"job_id"`
+	SubmittedAt          int64          `json:"submitted_at"`
+	EstimatedWaitSeconds int32          `json:"estimated_wait_seconds"`
+	State                string         `json:"state,omitempty"`
+	Error                string         `json:"error,omitempty"`
+	Shots                int            `json:"shots,omitempty"`
+	Counts               map[string]int `json:"counts,omitempty"`
+}
+
+func (r *submitRecord) header() []string {
+	return append([]string{"job_id", "state"}, outcomeHeader...)
+}
+
+// rows is a row per outcome counted, or one for the job alone
+func (r *submitRecord) rows() [][]string {
+	if len(r.Counts) == 0 {
+		return [][]string{{r.JobID, r.State, "", "", "", ""}}
+	}
+	var rows [][]string
+	for _, o := range outcomes(r.Counts) {
+		rows = append(rows, append([]string{r.JobID, r.State}, o.row()...))
+	}
+	return rows
+}
+
+// outcomeLabel is a shot's measured bits, highest qubit first
+func outcomeLabel(measurements map[int32]bool) string {
+	qubits := make([]int32, 0, len(measurements))
+	for q := range measurements {
+		qubits = append(qubits, q)
+	}
+	sort.Slice(qubits, func(i, j int) bool { return qubits[i] > qubits[j] })
+	var label strings.Builder
+	for _, q := range qubits {
+		label.WriteString(bitString(measurements[q]))
+	}
+	return label.String()
+}
+
+func submitJob(ctx context.Context, args []string) error {
+	priority, err := parseJobPriority(submitOpts.priority)
+	if err != nil {
+		return err
+	}
+	switch {
+	case submitOpts.shots < 1:
+		return fmt.Errorf("-shots must be at least 1")
+	case submitOpts.interval <= 0:
+		return fmt.Errorf("-interval must be positive")
+	}
+	circuit, err := circuitArg(submitOpts.file, args)
+	if err != nil {
+		return err
+	}
+	req, err := circuit.request()
+	if err != nil {
+		return err
+	}
+
+	client, done, err := schedulerClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	handle, err := client.SubmitJob(ctx, &sched.JobRequest{
+		Circuit:     req,
+		Priority:    priority,
+		Shots:       int32(submitOpts.shots),
+		CallbackUrl: submitOpts.callback,
+		UserId:      submitOpts.user,
+		Metadata:    submitOpts.meta,
+	})
+	if err != nil {
+		return fmt.Errorf("submit failed: %v", err)
+	}
+	notef("📥 Queued '%s' as job %s (about %ds wait)\n", circuit.Name, handle.JobId, handle.EstimatedWaitSeconds)
+	rec := &submitRecord{JobID: handle.JobId, SubmittedAt: handle.SubmittedAt, EstimatedWaitSeconds: handle.EstimatedWaitSeconds}
+	if submitOpts.watch {
+		// A job can queue for longer than -timeout allows a command, so it
+		// bounds each call instead
+		if err := watchJob(context.WithoutCancel(ctx), client, handle, rec); err != nil {
+			return err
+		}
+	}
+
+	if machineOutput() {
+		if err := emit(rec); err != nil {
+			return err
+		}
+	} else if rec.Counts != nil {
+		fmt.Printf("\n--- 📊 Counts over %d shots ---\n", rec.Shots)
+		printCounts(rec.Counts)
+	}
+	switch rec.State {
+	case jobStateName(sched.JobState_STATE_FAILED):
+		return fmt.Errorf("job %s failed: %s", rec.JobID, rec.Error)
+	case jobStateName(sched.JobState_STATE_CANCELLED):
+		return fmt.Errorf("job %s was cancelled", rec.JobID)
+	}
+	return nil
+}
+
+// watchJob polls the job, printing each change of state, then collects
+// its results once it completes
+func watchJob(ctx context.Context, client sched.QuantumSchedulerClient, handle *sched.JobHandle, rec *submitRecord) error {
+	var last *sched.JobStatus
+	for {
+		callCtx, cancel := withDeadline(ctx)
+		job, err := client.GetJobStatus(callCtx, handle)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("job status failed: %v", err)
+		}
+		if last == nil || job.State != last.State || job.PositionInQueue != last.PositionInQueue ||
+			job.ProgressPercent != last.ProgressPercent {
+			printJobUpdate(job)
+		}
+		last = job
+		if finished(job.State) {
+			break
+		}
+		time.Sleep(submitOpts.interval)
+	}
+
+	rec.State, rec.Error = jobStateName(last.State), last.ErrorMessage
+	if last.State != sched.JobState_STATE_COMPLETED {
+		return nil
+	}
+	return collectResults(ctx, client, handle, rec)
+}
+
+func printJobUpdate(job *sched.JobStatus) {
+	line := fmt.Sprintf("[%s] %-9s", time.Now().Format(time.TimeOnly), jobStateName(job.State))
+	switch job.State {
+	case sched.JobState_STATE_QUEUED:
+		line += fmt.Sprintf(" position %d", job.PositionInQueue)
+	case sched.JobState_STATE_RUNNING:
+		line += fmt.Sprintf(" %3d%%", job.ProgressPercent)
+		if job.WorkerId != "" {
+			line += " on " + job.WorkerId
+		}
+	}
+	notef("%s\n", line)
+}
+
+// collectResults counts the outcomes of a completed job's shots
+func collectResults(ctx context.Context, client sched.QuantumSchedulerClient, handle *sched.JobHandle, rec *submitRecord) error {
+	ctx, cancel := withDeadline(ctx)
+	defer cancel()
+	stream, err := client.StreamJobResults(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("results stream failed: %v", err)
+	}
+	counts := map[string]int{}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if status.Code(err) == codes.Unimplemented {
+			notef("✅ Job completed; this scheduler does not stream results\n")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("results stream failed: %v", err)
+		}
+		counts[outcomeLabel(res.Measurements)]++
+		rec.Shots++
+	}
+	rec.Counts = counts
+	notef("✅ Job completed with %d result(s)\n", rec.Shots)
+	return nil
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+)
+
+// maxAmplitudeRows is how many basis states the live view shows, the most
+// probable first
+const maxAmplitudeRows = 16
+
+// liveTerminal reports whether standard output is a terminal that frames
+// can be drawn over in place
+func liveTerminal() bool {
+	if machineOutput() || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	fi, err := os.Stdout.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// liveFrame redraws a frame over the last one it drew
+type liveFrame struct {
+	lines int
+}
+
+func (f *liveFrame) draw(w io.Writer, frame string) {
+	if f.lines > 0 {
+		fmt.Fprintf(w, "\x1b[%dF\x1b[J", f.lines)
+	}
+	fmt.Fprint(w, frame)
+	f.lines = strings.Count(frame, "\n")
+}
+
+// streamView is the live view of a -stream run. Like a bubbletea model it
+// changes only through update, and view renders it whole for draw.
+type streamView struct {
+	circuit  *CircuitFile
+	step     int
+	state    []*pb.StateResponse_ComplexNumber
+	measured map[uint32]bool
+	server   string
+	frame    liveFrame
+}
+
+func newStreamView(c *CircuitFile) *streamView {
+	return &streamView{circuit: c, measured: map[uint32]bool{}}
+}
+
+// update takes the state after the next gate
+func (v *streamView) update(res *pb.StateResponse) {
+	v.step++
+	v.state = res.StateVector
+	v.server = res.ServerId
+	for reg, bit := range res.ClassicalResults {
+		v.measured[reg] = bit
+	}
+}
+
+func (v *streamView) view() string {
+	var b strings.Builder
+	total := len(v.circuit.Ops)
+	fmt.Fprintf(&b, "🌊 %s · step %d/%d", v.circuit.Name, v.step, total)
+	if v.step > 0 && v.step <= total {
+		fmt.Fprintf(&b, " · %s", describeOp(v.circuit.Ops[v.step-1]))
+	}
+	b.WriteByte('\n')
+	if total > 0 {
+		done := histogramWidth * v.step / total
+		fmt.Fprintf(&b, " %s%s\n", strings.Repeat("█", done), strings.Repeat("░", histogramWidth-done))
+	}
+
+	n := qubitsFor(len(v.state))
+	b.WriteString("\nAmplitudes\n")
+	writeHistogram(&b, v.amplitudeBars(n))
+
+	b.WriteString("\nBloch vectors\n")
+	for q := 0; q < n; q++ {
+		x, y, z := blochVector(v.state, q)
+		fmt.Fprintf(&b, " q%-2d x %+.3f  y %+.3f  z %+.3f  |r| %.3f\n", q, x, y, z, math.Sqrt(x*x+y*y+z*z))
+	}
+
+	b.WriteString("\nMeasurements\n")
+	if len(v.measured) == 0 {
+		b.WriteString(" none yet\n")
+	} else {
+		regs := make([]uint32, 0, len(v.measured))
+		for reg := range v.measured {
+			regs = append(regs, reg)
+		}
+		sort.Slice(regs, func(i, j int) bool { return regs[i] < regs[j] })
+		for _, reg := range regs {
+			fmt.Fprintf(&b, " c%d=%s", reg, bitString(v.measured[reg]))
+		}
+		b.WriteByte('\n')
+	}
+	if v.server != "" {
+		fmt.Fprintf(&b, "\nServed by %s\n", v.server)
+	}
+	return b.String()
+}
+
+// amplitudeBars are the most probable basis states, in basis order, with
+// a last bar saying how many more were left out
+func (v *streamView) amplitudeBars(n int) []histogramBar {
+	type amp struct {
+		i    int
+		prob float64
+	}
+	var amps []amp
+	for i, a := range v.state {
+		if prob := a.Real*a.Real + a.Imag*a.Imag; prob > 0.0001 {
+			amps = append(amps, amp{i, prob})
+		}
+	}
+	hidden := 0
+	if len(amps) > maxAmplitudeRows {
+		sort.Slice(amps, func(i, j int) bool { return amps[i].prob > amps[j].prob })
+		hidden, amps = len(amps)-maxAmplitudeRows, amps[:maxAmplitudeRows]
+		sort.Slice(amps, func(i, j int) bool { return amps[i].i < amps[j].i })
+	}
+	bars := make([]histogramBar, 0, len(amps)+1)
+	for _, a := range amps {
+		c := v.state[a.i]
+		bars = append(bars, histogramBar{fmt.Sprintf("|%s> %+.3f%+.3fi", basisLabel(a.i, n), c.Real, c.Imag), a.prob})
+	}
+	if hidden > 0 {
+		rest := 1.0
+		for _, a := range amps {
+			rest -= a.prob
+		}
+		bars = append(bars, histogramBar{fmt.Sprintf("… %d more", hidden), math.Max(rest, 0)})
+	}
+	return bars
+}
+
+// draw writes the view over the last frame it drew
+func (v *streamView) draw(w io.Writer) {
+	v.frame.draw(w, v.view())
+}
+
+// blochVector is qubit q's point in the Bloch ball, from its reduced
+// density matrix: x = 2 Re ρ01, y = -2 Im ρ01, z = ρ00 - ρ11
+func blochVector(state []*pb.StateResponse_ComplexNumber, q int) (x, y, z float64) {
+	bit := 1 << q
+	for i, a := range state {
+		prob := a.Real*a.Real + a.Imag*a.Imag
+		if i&bit != 0 {
+			z -= prob
+			continue
+		}
+		z += prob
+		if i|bit >= len(state) {
+			continue
+		}
+		b := state[i|bit]
+		// a * conj(b)
+		x += 2 * (a.Real*b.Real + a.Imag*b.Imag)
+		y -= 2 * (a.Imag*b.Real - a.Real*b.Imag)
+	}
+	return x, y, z
+}
+
+// describeOp is an operation as the live view names it, e.g. "CNOT q0 → q1"
+func describeOp(op CircuitOp) string {
+	gate := strings.ToUpper(op.Gate)
+	switch gate {
+	case "CNOT":
+		return fmt.Sprintf("CNOT q%d → q%d", op.Control, op.Target)
+	case "TOFFOLI", "CCNOT":
+		return fmt.Sprintf("%s q%d,q%d → q%d", gate, op.Control, op.Control2, op.Target)
+	case "RY", "RZ":
+		return fmt.Sprintf("%s(%s) q%d", gate, unicodeGlyphs.formatAngle(op.Angle), op.Target)
+	}
+	return fmt.Sprintf("%s q%d", gate, op.Target)
+}
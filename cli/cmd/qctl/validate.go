@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/cli/internal/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxQubits is the largest register the engine will simulate
+	maxQubits = 30
+	// nanosPerAmplitude is what one gate's pass over one amplitude costs,
+	// as the engine's estimator reckons it
+	nanosPerAmplitude = 1.5
+)
+
+var validateOpts struct {
+	file      string
+	circuitID string
+	version   int
+	local     bool
+}
+
+var validateCmd = &command{
+	name:    "validate",
+	args:    "[circuit.json|circuit.qasm]",
+	summary: "Check a circuit and estimate what running it would take, without running it",
+	flags: func(fs *flag.FlagSet) {
+		fs.StringVar(&validateOpts.file, "file", "", "Path to circuit JSON or OpenQASM file, in place of the argument")
+		fs.StringVar(&validateOpts.circuitID, "circuit-id", "", "Check a circuit saved in the registry instead of a file")
+		fs.IntVar(&validateOpts.version, "version", 0, "Version of the -circuit-id circuit; 0 for the latest")
+		fs.BoolVar(&validateOpts.local, "local", false, "Estimate locally instead of asking the engine")
+	},
+	run: validateCircuit,
+}
+
+// circuitProblems is everything the engine would reject the circuit for
+func circuitProblems(c *CircuitFile) []string {
+	var problems []string
+	if c.Qubits < 1 || c.Qubits > maxQubits {
+		problems = append(problems, fmt.Sprintf("qubits must be between 1 and %d, not %d", maxQubits, c.Qubits))
+	}
+	for i, op := range c.Ops {
+		gate := strings.ToUpper(op.Gate)
+		if _, ok := gateTypes[gate]; !ok {
+			problems = append(problems, fmt.Sprintf("op %d: unknown gate type: %s", i+1, op.Gate))
+			continue
+		}
+		seen := map[uint32]bool{}
+		for _, q := range opQubits(op) {
+			switch {
+			case q >= uint32(max(c.Qubits, 0)):
+				problems = append(problems, fmt.Sprintf("op %d (%s): qubit %d is outside the %d-qubit register", i+1, gate, q, c.Qubits))
+			case seen[q]:
+				problems = append(problems, fmt.Sprintf("op %d (%s): uses qubit %d twice", i+1, gate, q))
+			}
+			seen[q] = true
+		}
+		if math.IsNaN(op.Angle) || math.IsInf(op.Angle, 0) {
+			problems = append(problems, fmt.Sprintf("op %d (%s): angle must be a finite number", i+1, gate))
+		}
+	}
+	return problems
+}
+
+// circuitDepth is how many layers the gates fall into, each gate one
+// layer after the latest gate on any of its qubits
+func circuitDepth(c *CircuitFile) uint32 {
+	layer := map[uint32]uint32{}
+	var depth uint32
+	for _, op := range c.Ops {
+		qubits := opQubits(op)
+		var at uint32
+		for _, q := range qubits {
+			at = max(at, layer[q])
+		}
+		for _, q := range qubits {
+			layer[q] = at + 1
+		}
+		depth = max(depth, at+1)
+	}
+	return depth
+}
+
+// localEstimate is the engine's estimate worked out here, less whether
+// the engine has the memory free
+func localEstimate(c *CircuitFile) *pb.ResourceEstimate {
+	amplitudes := uint64(1) << c.Qubits
+	return &pb.ResourceEstimate{
+		NumQubits:          c.Qubits,
+		GateCount:          uint32(len(c.Ops)),
+		Depth:              circuitDepth(c),
+		MemoryBytes:        amplitudes * 16,
+		EstimatedRuntimeMs: float64(len(c.Ops)) * float64(amplitudes) * nanosPerAmplitude / 1e6,
+	}
+}
+
+// estimateRecord is a dry run's verdict: the circuit's problems, or what
+// running it would take
+type estimateRecord struct {
+	Circuit      string   `json:"circuit"`
+	Valid        bool     `json:"valid"`
+	Problems     []string `json:"problems,omitempty"`
+	Qubits       int32    `json:"qubits"`
+	Gates        uint32   `json:"gates"`
+	Depth        uint32   `json:"depth"`
+	MemoryBytes  uint64   `json:"memory_bytes"`
+	RuntimeMs    float64  `json:"runtime_ms"`
+	FitsInMemory *bool    `json:"fits_in_memory,omitempty"`
+	Server       string   `json:"server,omitempty"`
+	Estimator    string   `json:"estimator,omitempty"`
+}
+
+func (r *estimateRecord) header() []string {
+	return []string{"circuit", "valid", "problems", "qubits", "gates", "depth", "memory_bytes", "runtime_ms", "fits_in_memory", "server", "estimator"}
+}
+
+func (r *estimateRecord) rows() [][]string {
+	fits := ""
+	if r.FitsInMemory != nil {
+		fits = strconv.FormatBool(*r.FitsInMemory)
+	}
+	return [][]string{{r.Circuit, strconv.FormatBool(r.Valid), strings.Join(r.Problems, "; "),
+		fmt.Sprint(r.Qubits), fmt.Sprint(r.Gates), fmt.Sprint(r.Depth), fmt.Sprint(r.MemoryBytes),
+		formatFloat(r.RuntimeMs), fits, r.Server, r.Estimator}}
+}
+
+// formatBytes is a size in binary units, e.g. "16.0 MiB"
+func formatBytes(n uint64) string {
+	if n < 1024 {
+		return fmt.Sprintf("%d B", n)
+	}
+	size, unit := float64(n)/1024, 0
+	for size >= 1024 && unit < 4 {
+		size /= 1024
+		unit++
+	}
+	return fmt.Sprintf("%.1f %ciB", size, "KMGTP"[unit])
+}
+
+// dryRun checks a circuit and estimates it, on the engine unless local
+// is set or the engine has no estimator, then reports without running
+// anything; extra problems come from the caller's own checks
+func dryRun(ctx context.Context, circuit *CircuitFile, local bool, extra ...string) error {
+	rec := &estimateRecord{Circuit: circuit.Name, Problems: append(circuitProblems(circuit), extra...)}
+	if rec.Valid = len(rec.Problems) == 0; rec.Valid {
+		est, byEngine, err := estimate(ctx, circuit, local)
+		if err != nil {
+			return err
+		}
+		rec.Qubits, rec.Gates, rec.Depth = est.NumQubits, est.GateCount, est.Depth
+		rec.MemoryBytes, rec.RuntimeMs = est.MemoryBytes, est.EstimatedRuntimeMs
+		rec.Estimator = "local"
+		if byEngine {
+			rec.FitsInMemory, rec.Server, rec.Estimator = &est.FitsInMemory, est.ServerId, "engine"
+		}
+	}
+
+	if machineOutput() {
+		if err := emit(rec); err != nil {
+			return err
+		}
+	} else {
+		printEstimate(rec)
+	}
+	if !rec.Valid {
+		return fmt.Errorf("'%s' is not valid: %d problem(s)", circuit.Name, len(rec.Problems))
+	}
+	return nil
+}
+
+// estimate asks the engine for a resource estimate, and reports whether
+// it did, falling back to working it out here when the engine is too old
+// to have an estimator
+func estimate(ctx context.Context, circuit *CircuitFile, local bool) (*pb.ResourceEstimate, bool, error) {
+	if local {
+		return localEstimate(circuit), false, nil
+	}
+	req, err := circuit.request()
+	if err != nil {
+		return nil, false, err
+	}
+	conn, err := dial(globals.server)
+	if err != nil {
+		return nil, false, err
+	}
+	defer conn.Close()
+	ctx, cancel := withDeadline(ctx)
+	defer cancel()
+	est, err := pb.NewQuantumComputeClient(conn).EstimateResources(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		notef("⚠️  The engine has no resource estimator; estimating locally\n")
+		return localEstimate(circuit), false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("estimate failed: %v", err)
+	}
+	return est, true, nil
+}
+
+func printEstimate(rec *estimateRecord) {
+	if !rec.Valid {
+		fmt.Printf("Problems in '%s':\n", rec.Circuit)
+		for _, p := range rec.Problems {
+			fmt.Printf("  - %s\n", p)
+		}
+		return
+	}
+	fmt.Printf("✅ '%s' is valid; nothing was run\n\n", rec.Circuit)
+	runtime := time.Duration(rec.RuntimeMs * float64(time.Millisecond))
+	if runtime > time.Millisecond {
+		runtime = runtime.Round(time.Microsecond)
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "qubits\t%d\n", rec.Qubits)
+	fmt.Fprintf(tw, "gates\t%d\n", rec.Gates)
+	fmt.Fprintf(tw, "depth\t%d\n", rec.Depth)
+	fmt.Fprintf(tw, "memory\t%s\n", formatBytes(rec.MemoryBytes))
+	fmt.Fprintf(tw, "runtime\t~%s\n", runtime)
+	if rec.FitsInMemory != nil {
+		fits := "yes"
+		if !*rec.FitsInMemory {
+			fits = "no, not with the memory free now"
+		}
+		fmt.Fprintf(tw, "fits on %s\t%s\n", orDash(rec.Server), fits)
+	}
+	fmt.Fprintf(tw, "estimated by\t%s\n", rec.Estimator)
+	tw.Flush()
+}
+
+func validateCircuit(ctx context.Context, args []string) error {
+	circuit, err := circuitSource(ctx, validateOpts.file, validateOpts.circuitID, validateOpts.version, args)
+	if err != nil {
+		return err
+	}
+	return dryRun(ctx, circuit, validateOpts.local)
+}
@@ -0,0 +1,455 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	vqe "github.com/perclft/QubitEngine/cli/internal/generated/physics/vqe"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chemicalAccuracy is the error, in Hartree, within which an energy is
+// good enough to predict chemistry (1 kcal/mol)
+const chemicalAccuracy = 1.6e-3
+
+var ansatzTypes = map[string]vqe.AnsatzType{
+	"uccsd":              vqe.AnsatzType_ANSATZ_UCCSD,
+	"hardware-efficient": vqe.AnsatzType_ANSATZ_HARDWARE_EFFICIENT,
+	"ry":                 vqe.AnsatzType_ANSATZ_RY,
+}
+
+var optimizerTypes = map[string]vqe.OptimizerType{
+	"cobyla":           vqe.OptimizerType_OPTIMIZER_COBYLA,
+	"spsa":             vqe.OptimizerType_OPTIMIZER_SPSA,
+	"adam":             vqe.OptimizerType_OPTIMIZER_ADAM,
+	"gradient-descent": vqe.OptimizerType_OPTIMIZER_GRADIENT_DESCENT,
+	"nelder-mead":      vqe.OptimizerType_OPTIMIZER_NELDER_MEAD,
+	"lbfgs":            vqe.OptimizerType_OPTIMIZER_LBFGS,
+}
+
+// choose looks a flag's value up by name, case-insensitively
+func choose[T any](flagName, value string, choices map[string]T) (T, error) {
+	if v, ok := choices[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	var zero T
+	return zero, fmt.Errorf("unknown -%s %q; want one of %s", flagName, value, strings.Join(sortedKeys(choices), ", "))
+}
+
+var vqeRunOpts struct {
+	molecule  string
+	ansatz    string
+	optimizer string
+	maxIter   int
+	tolerance float64
+	shots     int
+	init      string
+	out       string
+}
+
+var vqeCmd = &command{
+	name:    "vqe",
+	summary: "Find molecules' ground-state energies on the VQE solver",
+	subs: []*command{
+		{
+			name:    "run",
+			summary: "Run VQE on a molecule, plotting the energy as it converges",
+			flags: func(fs *flag.FlagSet) {
+				fs.StringVar(&vqeRunOpts.molecule, "molecule", "H2_equilibrium", "Preset `id` from 'qctl vqe molecules'")
+				fs.StringVar(&vqeRunOpts.ansatz, "ansatz", "uccsd", "Ansatz: uccsd, hardware-efficient or ry")
+				fs.StringVar(&vqeRunOpts.optimizer, "optimizer", "spsa", "Optimizer: cobyla, spsa, adam, gradient-descent, nelder-mead or lbfgs")
+				fs.IntVar(&vqeRunOpts.maxIter, "max-iter", 100, "Iterations to stop after if not converged")
+				fs.Float64Var(&vqeRunOpts.tolerance, "tol", 0, "Energy change, in Hartree, to count as converged; 0 for the solver's default")
+				fs.IntVar(&vqeRunOpts.shots, "shots", 0, "Shots per energy evaluation; 0 for exact expectation values")
+				fs.StringVar(&vqeRunOpts.init, "init", "", "Start from the parameters in a `file` written by an earlier run")
+				fs.StringVar(&vqeRunOpts.out, "out", "", "Write the final parameters to `file` (default <molecule>-params.json)")
+			},
+			run: vqeRun,
+		},
+		{name: "molecules", summary: "List the solver's molecule presets", run: vqeMolecules},
+	},
+}
+
+// vqeClient connects to the VQE solver; the caller closes the returned
+// function when done
+func vqeClient() (vqe.VQESolverClient, func(), error) {
+	conn, err := dial(globals.vqe)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vqe.NewVQESolverClient(conn), func() { conn.Close() }, nil
+}
+
+// ------------------------------------------------------------------
+// Molecules
+// ------------------------------------------------------------------
+
+type moleculeList []*vqe.MoleculePreset
+
+func (l moleculeList) header() []string { return []string{"id", "formula", "name", "reference_energy"} }
+
+func (l moleculeList) rows() [][]string {
+	rows := make([][]string, len(l))
+	for i, p := range l {
+		rows[i] = []string{p.Id, p.Formula, p.Name, formatFloat(p.ReferenceEnergy)}
+	}
+	return rows
+}
+
+func (l moleculeList) MarshalJSON() ([]byte, error) {
+	type preset struct {
+		ID              string  `json:"id"`
+		Formula         string  `json:"formula"`
+		Name            string  `json:"name"`
+		ReferenceEnergy float64 `json:"reference_energy"`
+		Description     string  `json:"description"`
+	}
+	out := make([]preset, len(l))
+	for i, p := range l {
+		out[i] = preset{p.Id, p.Formula, p.Name, p.ReferenceEnergy, p.Description}
+	}
+	return json.Marshal(out)
+}
+
+// molecules fetches the solver's presets, by id
+func molecules(ctx context.Context, client vqe.VQESolverClient) (moleculeList, error) {
+	ctx, cancel := withDeadline(ctx)
+	defer cancel()
+	lib, err := client.GetMoleculeLibrary(ctx, &vqe.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("molecule library failed: %v", err)
+	}
+	list := moleculeList(lib.Presets)
+	sort.Slice(list, func(i, j int) bool { return list[i].Id < list[j].Id })
+	return list, nil
+}
+
+func vqeMolecules(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	client, done, err := vqeClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	list, err := molecules(ctx, client)
+	if err != nil {
+		return err
+	}
+	if machineOutput() {
+		return emit(list)
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tFORMULA\tREFERENCE (Ha)\tDESCRIPTION")
+	for _, p := range list {
+		fmt.Fprintf(tw, "%s\t%s\t%.7f\t%s\n", p.Id, p.Formula, p.ReferenceEnergy, p.Description)
+	}
+	return tw.Flush()
+}
+
+// ------------------------------------------------------------------
+// Runs
+// ------------------------------------------------------------------
+
+type vqeStep struct {
+	Iteration    int32   `json:"iteration"`
+	Energy       float64 `json:"energy"`
+	Variance     float64 `json:"energy_variance"`
+	GradientNorm float64 `json:"gradient_norm"`
+}
+
+// vqeResult is a run's outcome; without its history it is also the
+// parameters file that -init reads back
+type vqeResult struct {
+	Molecule        string    `json:"molecule"`
+	Ansatz          string    `json:"ansatz"`
+	Optimizer       string    `json:"optimizer"`
+	Iterations      int       `json:"iterations"`
+	Energy          float64   `json:"energy"`
+	ReferenceEnergy float64   `json:"reference_energy,omitempty"`
+	Converged       bool      `json:"converged"`
+	Status          string    `json:"status,omitempty"`
+	Parameters      []float64 `json:"parameters"`
+	History         []vqeStep `json:"history,omitempty"`
+}
+
+func (r *vqeResult) header() []string {
+	return []string{"iteration", "energy", "energy_variance", "gradient_norm"}
+}
+
+func (r *vqeResult) rows() [][]string {
+	rows := make([][]string, len(r.History))
+	for i, s := range r.History {
+		rows[i] = []string{strconv.Itoa(int(s.Iteration)), formatFloat(s.Energy), formatFloat(s.Variance), formatFloat(s.GradientNorm)}
+	}
+	return rows
+}
+
+// plotHeight is how many rows the live energy plot takes
+const plotHeight = 12
+
+// vqeView is the live view of a run: the energy at each iteration,
+// plotted against the preset's reference energy
+type vqeView struct {
+	title    string
+	maxIter  int
+	ref      float64
+	energies []float64
+	last     *vqe.VQEIteration
+	frame    liveFrame
+}
+
+func (v *vqeView) update(it *vqe.VQEIteration) {
+	v.energies = append(v.energies, it.Energy)
+	v.last = it
+}
+
+func (v *vqeView) view() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "⚛️  %s · iteration %d/%d\n\n", v.title, len(v.energies), v.maxIter)
+	b.WriteString(energyPlot(v.energies, v.ref, histogramWidth+20, plotHeight))
+	if it := v.last; it != nil {
+		fmt.Fprintf(&b, "\n E %.8f Ha", it.Energy)
+		if v.ref != 0 {
+			fmt.Fprintf(&b, "  error %+.2e", it.Energy-v.ref)
+		}
+		if it.GradientNorm > 0 {
+			fmt.Fprintf(&b, "  |∇| %.2e", it.GradientNorm)
+		}
+		if d := it.Diagnostics; d != nil {
+			fmt.Fprintf(&b, "  evals %d", d.FunctionEvaluations)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// draw writes the view over the last frame it drew
+func (v *vqeView) draw(w io.Writer) {
+	v.frame.draw(w, v.view())
+}
+
+// energyPlot plots energies by iteration, squeezing them into width
+// columns, with a dashed line at the reference energy when there is one
+func energyPlot(energies []float64, ref float64, width, height int) string {
+	if len(energies) == 0 {
+		return strings.Repeat("\n", height+2)
+	}
+	lo, hi := energies[0], energies[0]
+	for _, e := range energies {
+		lo, hi = math.Min(lo, e), math.Max(hi, e)
+	}
+	if ref != 0 {
+		lo, hi = math.Min(lo, ref), math.Max(hi, ref)
+	}
+	if pad := 1e-9 - (hi - lo); pad > 0 {
+		lo, hi = lo-pad/2, hi+pad/2
+	}
+	row := func(e float64) int { return int(math.Round((hi - e) / (hi - lo) * float64(height-1))) }
+
+	cols := min(len(energies), width)
+	grid := make([][]string, height)
+	for r := range grid {
+		grid[r] = make([]string, cols)
+		for c := range grid[r] {
+			grid[r][c] = " "
+		}
+	}
+	if ref != 0 {
+		for c := range grid[row(ref)] {
+			grid[row(ref)][c] = "┄"
+		}
+	}
+	for c := 0; c < cols; c++ {
+		// Each column shows the last iteration it covers
+		grid[row(energies[(c+1)*len(energies)/cols-1])][c] = "•"
+	}
+
+	var b strings.Builder
+	for r, cells := range grid {
+		label := ""
+		switch {
+		case r == 0:
+			label = fmt.Sprintf("%.6f", hi)
+		case r == height-1:
+			label = fmt.Sprintf("%.6f", lo)
+		case ref != 0 && r == row(ref):
+			label = "ref"
+		}
+		fmt.Fprintf(&b, "%11s ┤%s\n", label, strings.Join(cells, ""))
+	}
+	fmt.Fprintf(&b, "%11s └%s\n", "", strings.Repeat("─", cols))
+	fmt.Fprintf(&b, "%11s  1", "")
+	if cols > 1 {
+		fmt.Fprintf(&b, "%*d", cols-1, len(energies))
+	}
+	b.WriteByte('\n')
+	return b.String()
+}
+
+// readParameters reads the parameters from a file written by -out
+func readParameters(path string) ([]float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parameters: %v", err)
+	}
+	var saved vqeResult
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("invalid parameters file %s: %v", path, err)
+	}
+	return saved.Parameters, nil
+}
+
+func vqeRun(ctx context.Context, args []string) error {
+	if err := expectArgs(args); err != nil {
+		return err
+	}
+	ansatz, err := choose("ansatz", vqeRunOpts.ansatz, ansatzTypes)
+	if err != nil {
+		return err
+	}
+	optimizer, err := choose("optimizer", vqeRunOpts.optimizer, optimizerTypes)
+	if err != nil {
+		return err
+	}
+	if vqeRunOpts.maxIter < 1 {
+		return fmt.Errorf("-max-iter must be at least 1")
+	}
+	req := &vqe.VQERequest{
+		Ansatz:               ansatz,
+		Optimizer:            optimizer,
+		MaxIterations:        int32(vqeRunOpts.maxIter),
+		ConvergenceThreshold: vqeRunOpts.tolerance,
+		ShotsPerEvaluation:   int32(vqeRunOpts.shots),
+	}
+	if vqeRunOpts.init != "" {
+		if req.InitialParameters, err = readParameters(vqeRunOpts.init); err != nil {
+			return err
+		}
+	}
+
+	client, done, err := vqeClient()
+	if err != nil {
+		return err
+	}
+	defer done()
+	presets, err := molecules(ctx, client)
+	if err != nil {
+		return err
+	}
+	var preset *vqe.MoleculePreset
+	ids := make([]string, len(presets))
+	for i, p := range presets {
+		if strings.EqualFold(p.Id, vqeRunOpts.molecule) {
+			preset = p
+		}
+		ids[i] = p.Id
+	}
+	if preset == nil {
+		return fmt.Errorf("no molecule %q; have %s", vqeRunOpts.molecule, strings.Join(ids, ", "))
+	}
+	req.Target = &vqe.VQERequest_Molecule{Molecule: preset.Config}
+
+	res := &vqeResult{
+		Molecule:        preset.Id,
+		Ansatz:          strings.ToLower(vqeRunOpts.ansatz),
+		Optimizer:       strings.ToLower(vqeRunOpts.optimizer),
+		ReferenceEnergy: preset.ReferenceEnergy,
+		Parameters:      req.InitialParameters,
+	}
+	var view *vqeView
+	if liveTerminal() {
+		view = &vqeView{title: fmt.Sprintf("%s · %s · %s", preset.Id, res.Ansatz, res.Optimizer),
+			maxIter: vqeRunOpts.maxIter, ref: preset.ReferenceEnergy}
+	}
+
+	// Runs outlast -timeout, so only an interrupt ends one early, keeping
+	// the parameters reached so far
+	ctx, stop := signal.NotifyContext(context.WithoutCancel(ctx), os.Interrupt)
+	defer stop()
+	notef("⚛️  Finding the ground state of %s (%s)\n", preset.Name, preset.Id)
+	stream, err := client.FindGroundState(ctx, req)
+	if err != nil {
+		return fmt.Errorf("VQE failed: %v", err)
+	}
+	for {
+		it, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if status.Code(err) == codes.Canceled && ctx.Err() != nil {
+			res.Status = "interrupted"
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("VQE stream failed: %v", err)
+		}
+		res.History = append(res.History, vqeStep{it.Iteration, it.Energy, it.EnergyVariance, it.GradientNorm})
+		res.Iterations, res.Energy, res.Parameters = len(res.History), it.Energy, it.Parameters
+		res.Converged, res.Status = it.Converged, it.Status
+		switch {
+		case machineOutput():
+		case view != nil:
+			view.update(it)
+			view.draw(os.Stdout)
+		default:
+			fmt.Printf("iter %4d  E %.8f Ha", it.Iteration, it.Energy)
+			if it.GradientNorm > 0 {
+				fmt.Printf("  |∇| %.2e", it.GradientNorm)
+			}
+			fmt.Println()
+		}
+	}
+	if res.Iterations == 0 {
+		return fmt.Errorf("the solver returned no iterations")
+	}
+
+	out := vqeRunOpts.out
+	if out == "" {
+		out = preset.Id + "-params.json"
+	}
+	saved := *res
+	saved.History = nil
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write parameters: %v", err)
+	}
+
+	if machineOutput() {
+		return emit(res)
+	}
+	switch {
+	case res.Converged:
+		fmt.Printf("\n✅ Converged after %d iterations\n", res.Iterations)
+	case res.Status == "interrupted":
+		fmt.Printf("\n⏹  Interrupted after %d iterations\n", res.Iterations)
+	default:
+		fmt.Printf("\n⚠️  Not converged after %d iterations\n", res.Iterations)
+	}
+	fmt.Printf("   Energy:     %.8f Ha\n", res.Energy)
+	if ref := res.ReferenceEnergy; ref != 0 {
+		verdict := "outside"
+		if math.Abs(res.Energy-ref) < chemicalAccuracy {
+			verdict = "within"
+		}
+		fmt.Printf("   Reference:  %.8f Ha (error %+.2e Ha, %s chemical accuracy)\n", ref, res.Energy-ref, verdict)
+	}
+	fmt.Printf("   Parameters: %d written to %s\n", len(res.Parameters), out)
+	return nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	vqe "github.com/perclft/QubitEngine/cli/internal/generated/physics/vqe"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeSolver serves one preset and converges in three iterations,
+// recording the requests it was sent
+type fakeSolver struct {
+	vqe.UnimplementedVQESolverServer
+	requests []*vqe.VQERequest
+}
+
+var fakeH2 = &vqe.MoleculePreset{
+	Id:              "H2_equilibrium",
+	Formula:         "H2",
+	Name:            "Hydrogen Molecule (equilibrium)",
+	ReferenceEnergy: -1.137,
+	Config:          &vqe.MoleculeConfig{Name: "H2", Atoms: []*vqe.Atom{{Element: "H"}, {Element: "H", Z: 0.735}}},
+}
+
+func (f *fakeSolver) GetMoleculeLibrary(context.Context, *vqe.Empty) (*vqe.MoleculeLibrary, error) {
+	return &vqe.MoleculeLibrary{Presets: []*vqe.MoleculePreset{fakeH2}}, nil
+}
+
+func (f *fakeSolver) FindGroundState(req *vqe.VQERequest, stream grpc.ServerStreamingServer[vqe.VQEIteration]) error {
+	f.requests = append(f.requests, req)
+	for i, e := range []float64{-1.0, -1.12, -1.137} {
+		if err := stream.Send(&vqe.VQEIteration{
+			Iteration:  int32(i + 1),
+			Energy:     e,
+			Parameters: []float64{-e},
+			Converged:  i == 2,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serveFakeSolver points -vqe at a fakeSolver on a loopback port and
+// resets the run flags when the test ends
+func serveFakeSolver(t *testing.T) *fakeSolver {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	fake := &fakeSolver{}
+	srv := grpc.NewServer()
+	vqe.RegisterVQESolverServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	addr, opts := globals.vqe, vqeRunOpts
+	t.Cleanup(func() { globals.vqe, vqeRunOpts = addr, opts })
+	globals.vqe = lis.Addr().String()
+	return fake
+}
+
+func TestVQERun(t *testing.T) {
+	fake := serveFakeSolver(t)
+	out := filepath.Join(t.TempDir(), "params.json")
+	vqeRunOpts.molecule = "h2_equilibrium"
+	vqeRunOpts.ansatz = "UCCSD"
+	vqeRunOpts.optimizer = "lbfgs"
+	vqeRunOpts.maxIter = 50
+	vqeRunOpts.out = out
+
+	if err := vqeRun(context.Background(), nil); err != nil {
+		t.Fatalf("vqeRun: %v", err)
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("solver got %d runs, want 1", len(fake.requests))
+	}
+	req := fake.requests[0]
+	if req.Ansatz != vqe.AnsatzType_ANSATZ_UCCSD || req.Optimizer != vqe.OptimizerType_OPTIMIZER_LBFGS || req.MaxIterations != 50 {
+		t.Errorf("sent ansatz %v, optimizer %v, %d iterations", req.Ansatz, req.Optimizer, req.MaxIterations)
+	}
+	if !proto.Equal(req.GetMolecule(), fakeH2.Config) {
+		t.Errorf("sent molecule %v, want the preset's config", req.GetMolecule())
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("parameters file: %v", err)
+	}
+	var saved vqeResult
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("parameters file: %v", err)
+	}
+	if saved.Molecule != "H2_equilibrium" || saved.Iterations != 3 || !saved.Converged || saved.Energy != -1.137 {
+		t.Errorf("saved %+v", saved)
+	}
+	if len(saved.Parameters) != 1 || saved.Parameters[0] != 1.137 || saved.History != nil {
+		t.Errorf("saved parameters %v and history %v", saved.Parameters, saved.History)
+	}
+
+	// -init starts the next run from the saved parameters
+	vqeRunOpts.init = out
+	if err := vqeRun(context.Background(), nil); err != nil {
+		t.Fatalf("vqeRun -init: %v", err)
+	}
+	if got := fake.requests[1].InitialParameters; len(got) != 1 || got[0] != saved.Parameters[0] {
+		t.Errorf("-init sent initial parameters %v, want %v", got, saved.Parameters)
+	}
+}
+
+func TestVQERunRejects(t *testing.T) {
+	fake := serveFakeSolver(t)
+	for _, tc := range []struct {
+		name string
+		set  func()
+		want string
+	}{
+		{"molecule", func() { vqeRunOpts.molecule = "He2" }, `no molecule "He2"; have H2_equilibrium`},
+		{"ansatz", func() { vqeRunOpts.ansatz = "qaoa" }, `unknown -ansatz "qaoa"`},
+		{"optimizer", func() { vqeRunOpts.optimizer = "bfgs" }, `unknown -optimizer "bfgs"`},
+		{"max-iter", func() { vqeRunOpts.maxIter = 0 }, "-max-iter must be at least 1"},
+	} {
+		vqeRunOpts.molecule, vqeRunOpts.ansatz, vqeRunOpts.optimizer, vqeRunOpts.maxIter = "H2_equilibrium", "uccsd", "spsa", 10
+		vqeRunOpts.out = filepath.Join(t.TempDir(), "params.json")
+		tc.set()
+		err := vqeRun(context.Background(), nil)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got %v, want %q", tc.name, err, tc.want)
+		}
+	}
+	if len(fake.requests) != 0 {
+		t.Errorf("solver got %d runs for rejected flags", len(fake.requests))
+	}
+}
@@ -0,0 +1,3994 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: api/proto/physics/vqe.proto
+
+package generated
+
+import (
+	_ "github.com/perclft/QubitEngine/cli/internal/generated"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpinModelType int32
+
+const (
+	SpinModelType_SPIN_MODEL_TRANSVERSE_ISING SpinModelType = 0
+	SpinModelType_SPIN_MODEL_HEISENBERG       SpinModelType = 1
+	SpinModelType_SPIN_MODEL_XY               SpinModelType = 2
+)
+
+// Enum value maps for SpinModelType.
+var (
+	SpinModelType_name = map[int32]string{
+		0: "SPIN_MODEL_TRANSVERSE_ISING",
+		1: "SPIN_MODEL_HEISENBERG",
+		2: "SPIN_MODEL_XY",
+	}
+	SpinModelType_value = map[string]int32{
+		"SPIN_MODEL_TRANSVERSE_ISING": 0,
+		"SPIN_MODEL_HEISENBERG":       1,
+		"SPIN_MODEL_XY":               2,
+	}
+)
+
+func (x SpinModelType) Enum() *SpinModelType {
+	p := new(SpinModelType)
+	*p = x
+	return p
+}
+
+func (x SpinModelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpinModelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_physics_vqe_proto_enumTypes[0].Descriptor()
+}
+
+func (SpinModelType) Type() protoreflect.EnumType {
+	return &file_api_proto_physics_vqe_proto_enumTypes[0]
+}
+
+func (x SpinModelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpinModelType.Descriptor instead.
+func (SpinModelType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{0}
+}
+
+type PauliType int32
+
+const (
+	PauliType_PAULI_I PauliType = 0
+	PauliType_PAULI_X PauliType = 1
+	PauliType_PAULI_Y PauliType = 2
+	PauliType_PAULI_Z PauliType = 3
+)
+
+// Enum value maps for PauliType.
+var (
+	PauliType_name = map[int32]string{
+		0: "PAULI_I",
+		1: "PAULI_X",
+		2: "PAULI_Y",
+		3: "PAULI_Z",
+	}
+	PauliType_value = map[string]int32{
+		"PAULI_I": 0,
+		"PAULI_X": 1,
+		"PAULI_Y": 2,
+		"PAULI_Z": 3,
+	}
+)
+
+func (x PauliType) Enum() *PauliType {
+	p := new(PauliType)
+	*p = x
+	return p
+}
+
+func (x PauliType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauliType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_physics_vqe_proto_enumTypes[1].Descriptor()
+}
+
+func (PauliType) Type() protoreflect.EnumType {
+	return &file_api_proto_physics_vqe_proto_enumTypes[1]
+}
+
+func (x PauliType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauliType.Descriptor instead.
+func (PauliType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{1}
+}
+
+type AnsatzType int32
+
+const (
+	AnsatzType_ANSATZ_UCCSD              AnsatzType = 0 // Unitary Coupled Cluster Singles and Doubles
+	AnsatzType_ANSATZ_HARDWARE_EFFICIENT AnsatzType = 1 // Hardware efficient ansatz
+	AnsatzType_ANSATZ_RY                 AnsatzType = 2 // Simple RY rotation layers
+)
+
+// Enum value maps for AnsatzType.
+var (
+	AnsatzType_name = map[int32]string{
+		0: "ANSATZ_UCCSD",
+		1: "ANSATZ_HARDWARE_EFFICIENT",
+		2: "ANSATZ_RY",
+	}
+	AnsatzType_value = map[string]int32{
+		"ANSATZ_UCCSD":              0,
+		"ANSATZ_HARDWARE_EFFICIENT": 1,
+		"ANSATZ_RY":                 2,
+	}
+)
+
+func (x AnsatzType) Enum() *AnsatzType {
+	p := new(AnsatzType)
+	*p = x
+	return p
+}
+
+func (x AnsatzType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnsatzType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_physics_vqe_proto_enumTypes[2].Descriptor()
+}
+
+func (AnsatzType) Type() protoreflect.EnumType {
+	return &file_api_proto_physics_vqe_proto_enumTypes[2]
+}
+
+func (x AnsatzType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnsatzType.Descriptor instead.
+func (AnsatzType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{2}
+}
+
+type OptimizerType int32
+
+const (
+	OptimizerType_OPTIMIZER_COBYLA           OptimizerType = 0
+	OptimizerType_OPTIMIZER_SPSA             OptimizerType = 1
+	OptimizerType_OPTIMIZER_ADAM             OptimizerType = 2
+	OptimizerType_OPTIMIZER_GRADIENT_DESCENT OptimizerType = 3
+	OptimizerType_OPTIMIZER_NELDER_MEAD      OptimizerType = 4
+	OptimizerType_OPTIMIZER_LBFGS            OptimizerType = 5
+)
+
+// Enum value maps for OptimizerType.
+var (
+	OptimizerType_name = map[int32]string{
+		0: "OPTIMIZER_COBYLA",
+		1: "OPTIMIZER_SPSA",
+		2: "OPTIMIZER_ADAM",
+		3: "OPTIMIZER_GRADIENT_DESCENT",
+		4: "OPTIMIZER_NELDER_MEAD",
+		5: "OPTIMIZER_LBFGS",
+	}
+	OptimizerType_value = map[string]int32{
+		"OPTIMIZER_COBYLA":           0,
+		"OPTIMIZER_SPSA":             1,
+		"OPTIMIZER_ADAM":             2,
+		"OPTIMIZER_GRADIENT_DESCENT": 3,
+		"OPTIMIZER_NELDER_MEAD":      4,
+		"OPTIMIZER_LBFGS":            5,
+	}
+)
+
+func (x OptimizerType) Enum() *OptimizerType {
+	p := new(OptimizerType)
+	*p = x
+	return p
+}
+
+func (x OptimizerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptimizerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_physics_vqe_proto_enumTypes[3].Descriptor()
+}
+
+func (OptimizerType) Type() protoreflect.EnumType {
+	return &file_api_proto_physics_vqe_proto_enumTypes[3]
+}
+
+func (x OptimizerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptimizerType.Descriptor instead.
+func (OptimizerType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{3}
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{0}
+}
+
+type MoleculeConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // e.g. "H2", "LiH", "HeH+"
+	Atoms         []*Atom                `protobuf:"bytes,2,rep,name=atoms,proto3" json:"atoms,omitempty"`                       // Atom positions
+	Charge        int32                  `protobuf:"varint,3,opt,name=charge,proto3" json:"charge,omitempty"`                    // Molecular charge
+	Multiplicity  int32                  `protobuf:"varint,4,opt,name=multiplicity,proto3" json:"multiplicity,omitempty"`        // Spin multiplicity (1=singlet, 2=doublet, etc.)
+	BasisSet      string                 `protobuf:"bytes,5,opt,name=basis_set,json=basisSet,proto3" json:"basis_set,omitempty"` // "sto-3g" (computed in-process)
+	Integrals     string                 `protobuf:"bytes,6,opt,name=integrals,proto3" json:"integrals,omitempty"`               // FCIDUMP or JSON MO integrals; overrides atoms
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoleculeConfig) Reset() {
+	*x = MoleculeConfig{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoleculeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoleculeConfig) ProtoMessage() {}
+
+func (x *MoleculeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoleculeConfig.ProtoReflect.Descriptor instead.
+func (*MoleculeConfig) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MoleculeConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoleculeConfig) GetAtoms() []*Atom {
+	if x != nil {
+		return x.Atoms
+	}
+	return nil
+}
+
+func (x *MoleculeConfig) GetCharge() int32 {
+	if x != nil {
+		return x.Charge
+	}
+	return 0
+}
+
+func (x *MoleculeConfig) GetMultiplicity() int32 {
+	if x != nil {
+		return x.Multiplicity
+	}
+	return 0
+}
+
+func (x *MoleculeConfig) GetBasisSet() string {
+	if x != nil {
+		return x.BasisSet
+	}
+	return ""
+}
+
+func (x *MoleculeConfig) GetIntegrals() string {
+	if x != nil {
+		return x.Integrals
+	}
+	return ""
+}
+
+// JSON integral format (spatial orbitals, chemist notation)
+type ElectronicIntegrals struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NumOrbitals      int32                  `protobuf:"varint,1,opt,name=num_orbitals,json=numOrbitals,proto3" json:"num_orbitals,omitempty"`
+	NumElectrons     int32                  `protobuf:"varint,2,opt,name=num_electrons,json=numElectrons,proto3" json:"num_electrons,omitempty"`
+	NuclearRepulsion float64                `protobuf:"fixed64,3,opt,name=nuclear_repulsion,json=nuclearRepulsion,proto3" json:"nuclear_repulsion,omitempty"` // Core energy
+	OneBody          []float64              `protobuf:"fixed64,4,rep,packed,name=one_body,json=oneBody,proto3" json:"one_body,omitempty"`                     // h_pq, row-major n×n
+	TwoBody          []float64              `protobuf:"fixed64,5,rep,packed,name=two_body,json=twoBody,proto3" json:"two_body,omitempty"`                     // (pq|rs), n⁴
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ElectronicIntegrals) Reset() {
+	*x = ElectronicIntegrals{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElectronicIntegrals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectronicIntegrals) ProtoMessage() {}
+
+func (x *ElectronicIntegrals) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectronicIntegrals.ProtoReflect.Descriptor instead.
+func (*ElectronicIntegrals) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ElectronicIntegrals) GetNumOrbitals() int32 {
+	if x != nil {
+		return x.NumOrbitals
+	}
+	return 0
+}
+
+func (x *ElectronicIntegrals) GetNumElectrons() int32 {
+	if x != nil {
+		return x.NumElectrons
+	}
+	return 0
+}
+
+func (x *ElectronicIntegrals) GetNuclearRepulsion() float64 {
+	if x != nil {
+		return x.NuclearRepulsion
+	}
+	return 0
+}
+
+func (x *ElectronicIntegrals) GetOneBody() []float64 {
+	if x != nil {
+		return x.OneBody
+	}
+	return nil
+}
+
+func (x *ElectronicIntegrals) GetTwoBody() []float64 {
+	if x != nil {
+		return x.TwoBody
+	}
+	return nil
+}
+
+type Atom struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Element       string                 `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"` // e.g. "H", "Li", "He"
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`           // Position in Angstroms
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float64                `protobuf:"fixed64,4,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Atom) Reset() {
+	*x = Atom{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Atom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Atom) ProtoMessage() {}
+
+func (x *Atom) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Atom.ProtoReflect.Descriptor instead.
+func (*Atom) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Atom) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *Atom) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Atom) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Atom) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type SpinModelConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         SpinModelType          `protobuf:"varint,1,opt,name=model,proto3,enum=qubit_engine.physics.SpinModelType" json:"model,omitempty"`
+	NumSites      int32                  `protobuf:"varint,2,opt,name=num_sites,json=numSites,proto3" json:"num_sites,omitempty"`     // 2-20
+	Coupling      float64                `protobuf:"fixed64,3,opt,name=coupling,proto3" json:"coupling,omitempty"`                    // J (default 1)
+	CouplingZ     float64                `protobuf:"fixed64,4,opt,name=coupling_z,json=couplingZ,proto3" json:"coupling_z,omitempty"` // Heisenberg J_z (default J)
+	Anisotropy    float64                `protobuf:"fixed64,5,opt,name=anisotropy,proto3" json:"anisotropy,omitempty"`                // XY gamma in [-1, 1] (0 = isotropic XX)
+	Field         float64                `protobuf:"fixed64,6,opt,name=field,proto3" json:"field,omitempty"`                          // h: transverse (X) for Ising, longitudinal (Z) otherwise
+	Periodic      bool                   `protobuf:"varint,7,opt,name=periodic,proto3" json:"periodic,omitempty"`                     // Close the chain into a ring
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpinModelConfig) Reset() {
+	*x = SpinModelConfig{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpinModelConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpinModelConfig) ProtoMessage() {}
+
+func (x *SpinModelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpinModelConfig.ProtoReflect.Descriptor instead.
+func (*SpinModelConfig) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SpinModelConfig) GetModel() SpinModelType {
+	if x != nil {
+		return x.Model
+	}
+	return SpinModelType_SPIN_MODEL_TRANSVERSE_ISING
+}
+
+func (x *SpinModelConfig) GetNumSites() int32 {
+	if x != nil {
+		return x.NumSites
+	}
+	return 0
+}
+
+func (x *SpinModelConfig) GetCoupling() float64 {
+	if x != nil {
+		return x.Coupling
+	}
+	return 0
+}
+
+func (x *SpinModelConfig) GetCouplingZ() float64 {
+	if x != nil {
+		return x.CouplingZ
+	}
+	return 0
+}
+
+func (x *SpinModelConfig) GetAnisotropy() float64 {
+	if x != nil {
+		return x.Anisotropy
+	}
+	return 0
+}
+
+func (x *SpinModelConfig) GetField() float64 {
+	if x != nil {
+		return x.Field
+	}
+	return 0
+}
+
+func (x *SpinModelConfig) GetPeriodic() bool {
+	if x != nil {
+		return x.Periodic
+	}
+	return false
+}
+
+type Hamiltonian struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MoleculeName     string                 `protobuf:"bytes,1,opt,name=molecule_name,json=moleculeName,proto3" json:"molecule_name,omitempty"`
+	NumQubits        int32                  `protobuf:"varint,2,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`                       // Number of qubits needed
+	Terms            []*PauliTerm           `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`                                                 // Sum of Pauli terms
+	NuclearRepulsion float64                `protobuf:"fixed64,4,opt,name=nuclear_repulsion,json=nuclearRepulsion,proto3" json:"nuclear_repulsion,omitempty"` // Nuclear repulsion energy (constant offset)
+	NumElectrons     int32                  `protobuf:"varint,5,opt,name=num_electrons,json=numElectrons,proto3" json:"num_electrons,omitempty"`              // Selects the reference determinant (0 = unknown)
+	Groups           []*MeasurementGroup    `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`                                               // Qubit-wise commuting term groups
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Hamiltonian) Reset() {
+	*x = Hamiltonian{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hamiltonian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hamiltonian) ProtoMessage() {}
+
+func (x *Hamiltonian) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hamiltonian.ProtoReflect.Descriptor instead.
+func (*Hamiltonian) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Hamiltonian) GetMoleculeName() string {
+	if x != nil {
+		return x.MoleculeName
+	}
+	return ""
+}
+
+func (x *Hamiltonian) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *Hamiltonian) GetTerms() []*PauliTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *Hamiltonian) GetNuclearRepulsion() float64 {
+	if x != nil {
+		return x.NuclearRepulsion
+	}
+	return 0
+}
+
+func (x *Hamiltonian) GetNumElectrons() int32 {
+	if x != nil {
+		return x.NumElectrons
+	}
+	return 0
+}
+
+func (x *Hamiltonian) GetGroups() []*MeasurementGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Terms measured together from one circuit: every term is diagonal in the
+// product basis, so one set of shots estimates them all
+type MeasurementGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermIndices   []int32                `protobuf:"varint,1,rep,packed,name=term_indices,json=termIndices,proto3" json:"term_indices,omitempty"` // Into Hamiltonian.terms
+	Basis         []*PauliOperator       `protobuf:"bytes,2,rep,name=basis,proto3" json:"basis,omitempty"`                                        // Measurement Pauli per qubit (unlisted: any)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeasurementGroup) Reset() {
+	*x = MeasurementGroup{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeasurementGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurementGroup) ProtoMessage() {}
+
+func (x *MeasurementGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurementGroup.ProtoReflect.Descriptor instead.
+func (*MeasurementGroup) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MeasurementGroup) GetTermIndices() []int32 {
+	if x != nil {
+		return x.TermIndices
+	}
+	return nil
+}
+
+func (x *MeasurementGroup) GetBasis() []*PauliOperator {
+	if x != nil {
+		return x.Basis
+	}
+	return nil
+}
+
+type ImportHamiltonianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`                              // QubitOperator JSON or one "coefficient Pauli-string" per line
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                                  // "json", "text" or "" to detect
+	NumQubits     int32                  `protobuf:"varint,4,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`          // 0 = highest qubit index + 1
+	NumElectrons  int32                  `protobuf:"varint,5,opt,name=num_electrons,json=numElectrons,proto3" json:"num_electrons,omitempty"` // Selects the reference determinant (0 = unknown)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHamiltonianRequest) Reset() {
+	*x = ImportHamiltonianRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHamiltonianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHamiltonianRequest) ProtoMessage() {}
+
+func (x *ImportHamiltonianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHamiltonianRequest.ProtoReflect.Descriptor instead.
+func (*ImportHamiltonianRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportHamiltonianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportHamiltonianRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ImportHamiltonianRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportHamiltonianRequest) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *ImportHamiltonianRequest) GetNumElectrons() int32 {
+	if x != nil {
+		return x.NumElectrons
+	}
+	return 0
+}
+
+type PauliTerm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coefficient   float64                `protobuf:"fixed64,1,opt,name=coefficient,proto3" json:"coefficient,omitempty"` // Real coefficient
+	Operators     []*PauliOperator       `protobuf:"bytes,2,rep,name=operators,proto3" json:"operators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauliTerm) Reset() {
+	*x = PauliTerm{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauliTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauliTerm) ProtoMessage() {}
+
+func (x *PauliTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauliTerm.ProtoReflect.Descriptor instead.
+func (*PauliTerm) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PauliTerm) GetCoefficient() float64 {
+	if x != nil {
+		return x.Coefficient
+	}
+	return 0
+}
+
+func (x *PauliTerm) GetOperators() []*PauliOperator {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+type PauliOperator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Qubit         int32                  `protobuf:"varint,1,opt,name=qubit,proto3" json:"qubit,omitempty"`
+	Type          PauliType              `protobuf:"varint,2,opt,name=type,proto3,enum=qubit_engine.physics.PauliType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauliOperator) Reset() {
+	*x = PauliOperator{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauliOperator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauliOperator) ProtoMessage() {}
+
+func (x *PauliOperator) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauliOperator.ProtoReflect.Descriptor instead.
+func (*PauliOperator) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PauliOperator) GetQubit() int32 {
+	if x != nil {
+		return x.Qubit
+	}
+	return 0
+}
+
+func (x *PauliOperator) GetType() PauliType {
+	if x != nil {
+		return x.Type
+	}
+	return PauliType_PAULI_I
+}
+
+type VQERequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*VQERequest_Molecule
+	//	*VQERequest_Hamiltonian
+	//	*VQERequest_SpinModel
+	Target               isVQERequest_Target `protobuf_oneof:"target"`
+	Ansatz               AnsatzType          `protobuf:"varint,3,opt,name=ansatz,proto3,enum=qubit_engine.physics.AnsatzType" json:"ansatz,omitempty"`
+	Optimizer            OptimizerType       `protobuf:"varint,4,opt,name=optimizer,proto3,enum=qubit_engine.physics.OptimizerType" json:"optimizer,omitempty"`
+	MaxIterations        int32               `protobuf:"varint,5,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	ConvergenceThreshold float64             `protobuf:"fixed64,6,opt,name=convergence_threshold,json=convergenceThreshold,proto3" json:"convergence_threshold,omitempty"`
+	InitialParameters    []float64           `protobuf:"fixed64,7,rep,packed,name=initial_parameters,json=initialParameters,proto3" json:"initial_parameters,omitempty"` // Optional starting point
+	ShotsPerEvaluation   int32               `protobuf:"varint,8,opt,name=shots_per_evaluation,json=shotsPerEvaluation,proto3" json:"shots_per_evaluation,omitempty"`
+	// Hyperparameters for the selected optimizer (zero values use defaults)
+	Cobyla          *CobylaOptions          `protobuf:"bytes,9,opt,name=cobyla,proto3" json:"cobyla,omitempty"`
+	Spsa            *SPSAOptions            `protobuf:"bytes,10,opt,name=spsa,proto3" json:"spsa,omitempty"`
+	NelderMead      *NelderMeadOptions      `protobuf:"bytes,11,opt,name=nelder_mead,json=nelderMead,proto3" json:"nelder_mead,omitempty"`
+	Adam            *AdamOptions            `protobuf:"bytes,12,opt,name=adam,proto3" json:"adam,omitempty"`
+	Lbfgs           *LBFGSOptions           `protobuf:"bytes,13,opt,name=lbfgs,proto3" json:"lbfgs,omitempty"`
+	GradientDescent *GradientDescentOptions `protobuf:"bytes,14,opt,name=gradient_descent,json=gradientDescent,proto3" json:"gradient_descent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VQERequest) Reset() {
+	*x = VQERequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VQERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VQERequest) ProtoMessage() {}
+
+func (x *VQERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VQERequest.ProtoReflect.Descriptor instead.
+func (*VQERequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VQERequest) GetTarget() isVQERequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *VQERequest) GetMolecule() *MoleculeConfig {
+	if x != nil {
+		if x, ok := x.Target.(*VQERequest_Molecule); ok {
+			return x.Molecule
+		}
+	}
+	return nil
+}
+
+func (x *VQERequest) GetHamiltonian() *Hamiltonian {
+	if x != nil {
+		if x, ok := x.Target.(*VQERequest_Hamiltonian); ok {
+			return x.Hamiltonian
+		}
+	}
+	return nil
+}
+
+func (x *VQERequest) GetSpinModel() *SpinModelConfig {
+	if x != nil {
+		if x, ok := x.Target.(*VQERequest_SpinModel); ok {
+			return x.SpinModel
+		}
+	}
+	return nil
+}
+
+func (x *VQERequest) GetAnsatz() AnsatzType {
+	if x != nil {
+		return x.Ansatz
+	}
+	return AnsatzType_ANSATZ_UCCSD
+}
+
+func (x *VQERequest) GetOptimizer() OptimizerType {
+	if x != nil {
+		return x.Optimizer
+	}
+	return OptimizerType_OPTIMIZER_COBYLA
+}
+
+func (x *VQERequest) GetMaxIterations() int32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+func (x *VQERequest) GetConvergenceThreshold() float64 {
+	if x != nil {
+		return x.ConvergenceThreshold
+	}
+	return 0
+}
+
+func (x *VQERequest) GetInitialParameters() []float64 {
+	if x != nil {
+		return x.InitialParameters
+	}
+	return nil
+}
+
+func (x *VQERequest) GetShotsPerEvaluation() int32 {
+	if x != nil {
+		return x.ShotsPerEvaluation
+	}
+	return 0
+}
+
+func (x *VQERequest) GetCobyla() *CobylaOptions {
+	if x != nil {
+		return x.Cobyla
+	}
+	return nil
+}
+
+func (x *VQERequest) GetSpsa() *SPSAOptions {
+	if x != nil {
+		return x.Spsa
+	}
+	return nil
+}
+
+func (x *VQERequest) GetNelderMead() *NelderMeadOptions {
+	if x != nil {
+		return x.NelderMead
+	}
+	return nil
+}
+
+func (x *VQERequest) GetAdam() *AdamOptions {
+	if x != nil {
+		return x.Adam
+	}
+	return nil
+}
+
+func (x *VQERequest) GetLbfgs() *LBFGSOptions {
+	if x != nil {
+		return x.Lbfgs
+	}
+	return nil
+}
+
+func (x *VQERequest) GetGradientDescent() *GradientDescentOptions {
+	if x != nil {
+		return x.GradientDescent
+	}
+	return nil
+}
+
+type isVQERequest_Target interface {
+	isVQERequest_Target()
+}
+
+type VQERequest_Molecule struct {
+	Molecule *MoleculeConfig `protobuf:"bytes,1,opt,name=molecule,proto3,oneof"`
+}
+
+type VQERequest_Hamiltonian struct {
+	Hamiltonian *Hamiltonian `protobuf:"bytes,2,opt,name=hamiltonian,proto3,oneof"`
+}
+
+type VQERequest_SpinModel struct {
+	SpinModel *SpinModelConfig `protobuf:"bytes,15,opt,name=spin_model,json=spinModel,proto3,oneof"`
+}
+
+func (*VQERequest_Molecule) isVQERequest_Target() {}
+
+func (*VQERequest_Hamiltonian) isVQERequest_Target() {}
+
+func (*VQERequest_SpinModel) isVQERequest_Target() {}
+
+type CobylaOptions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	InitialTrustRadius float64                `protobuf:"fixed64,1,opt,name=initial_trust_radius,json=initialTrustRadius,proto3" json:"initial_trust_radius,omitempty"` // rhobeg (default 0.5)
+	FinalTrustRadius   float64                `protobuf:"fixed64,2,opt,name=final_trust_radius,json=finalTrustRadius,proto3" json:"final_trust_radius,omitempty"`       // rhoend (default 1e-4)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CobylaOptions) Reset() {
+	*x = CobylaOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CobylaOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CobylaOptions) ProtoMessage() {}
+
+func (x *CobylaOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CobylaOptions.ProtoReflect.Descriptor instead.
+func (*CobylaOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CobylaOptions) GetInitialTrustRadius() float64 {
+	if x != nil {
+		return x.InitialTrustRadius
+	}
+	return 0
+}
+
+func (x *CobylaOptions) GetFinalTrustRadius() float64 {
+	if x != nil {
+		return x.FinalTrustRadius
+	}
+	return 0
+}
+
+type SPSAOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	A             float64                `protobuf:"fixed64,1,opt,name=a,proto3" json:"a,omitempty"`                 // Step gain a_k = a/(k+1+A)^α (default 0.2)
+	C             float64                `protobuf:"fixed64,2,opt,name=c,proto3" json:"c,omitempty"`                 // Perturbation c_k = c/(k+1)^γ (default 0.1)
+	Alpha         float64                `protobuf:"fixed64,3,opt,name=alpha,proto3" json:"alpha,omitempty"`         // Default 0.602
+	Gamma         float64                `protobuf:"fixed64,4,opt,name=gamma,proto3" json:"gamma,omitempty"`         // Default 0.101
+	Stability     float64                `protobuf:"fixed64,5,opt,name=stability,proto3" json:"stability,omitempty"` // A (default 10)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SPSAOptions) Reset() {
+	*x = SPSAOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SPSAOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPSAOptions) ProtoMessage() {}
+
+func (x *SPSAOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPSAOptions.ProtoReflect.Descriptor instead.
+func (*SPSAOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SPSAOptions) GetA() float64 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+func (x *SPSAOptions) GetC() float64 {
+	if x != nil {
+		return x.C
+	}
+	return 0
+}
+
+func (x *SPSAOptions) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *SPSAOptions) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+func (x *SPSAOptions) GetStability() float64 {
+	if x != nil {
+		return x.Stability
+	}
+	return 0
+}
+
+type NelderMeadOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitialSimplex float64                `protobuf:"fixed64,1,opt,name=initial_simplex,json=initialSimplex,proto3" json:"initial_simplex,omitempty"` // Edge length of the starting simplex (default 0.5)
+	Reflection     float64                `protobuf:"fixed64,2,opt,name=reflection,proto3" json:"reflection,omitempty"`                               // Default 1
+	Expansion      float64                `protobuf:"fixed64,3,opt,name=expansion,proto3" json:"expansion,omitempty"`                                 // Default 2
+	Contraction    float64                `protobuf:"fixed64,4,opt,name=contraction,proto3" json:"contraction,omitempty"`                             // Default 0.5
+	Shrink         float64                `protobuf:"fixed64,5,opt,name=shrink,proto3" json:"shrink,omitempty"`                                       // Default 0.5
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NelderMeadOptions) Reset() {
+	*x = NelderMeadOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NelderMeadOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NelderMeadOptions) ProtoMessage() {}
+
+func (x *NelderMeadOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NelderMeadOptions.ProtoReflect.Descriptor instead.
+func (*NelderMeadOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NelderMeadOptions) GetInitialSimplex() float64 {
+	if x != nil {
+		return x.InitialSimplex
+	}
+	return 0
+}
+
+func (x *NelderMeadOptions) GetReflection() float64 {
+	if x != nil {
+		return x.Reflection
+	}
+	return 0
+}
+
+func (x *NelderMeadOptions) GetExpansion() float64 {
+	if x != nil {
+		return x.Expansion
+	}
+	return 0
+}
+
+func (x *NelderMeadOptions) GetContraction() float64 {
+	if x != nil {
+		return x.Contraction
+	}
+	return 0
+}
+
+func (x *NelderMeadOptions) GetShrink() float64 {
+	if x != nil {
+		return x.Shrink
+	}
+	return 0
+}
+
+type AdamOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LearningRate  float64                `protobuf:"fixed64,1,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"` // Default 0.05
+	Beta1         float64                `protobuf:"fixed64,2,opt,name=beta1,proto3" json:"beta1,omitempty"`                                   // Default 0.9
+	Beta2         float64                `protobuf:"fixed64,3,opt,name=beta2,proto3" json:"beta2,omitempty"`                                   // Default 0.999
+	Epsilon       float64                `protobuf:"fixed64,4,opt,name=epsilon,proto3" json:"epsilon,omitempty"`                               // Default 1e-8
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdamOptions) Reset() {
+	*x = AdamOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdamOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdamOptions) ProtoMessage() {}
+
+func (x *AdamOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdamOptions.ProtoReflect.Descriptor instead.
+func (*AdamOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AdamOptions) GetLearningRate() float64 {
+	if x != nil {
+		return x.LearningRate
+	}
+	return 0
+}
+
+func (x *AdamOptions) GetBeta1() float64 {
+	if x != nil {
+		return x.Beta1
+	}
+	return 0
+}
+
+func (x *AdamOptions) GetBeta2() float64 {
+	if x != nil {
+		return x.Beta2
+	}
+	return 0
+}
+
+func (x *AdamOptions) GetEpsilon() float64 {
+	if x != nil {
+		return x.Epsilon
+	}
+	return 0
+}
+
+type LBFGSOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        int32                  `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`  // Curvature pairs kept (default 10)
+	Armijo        float64                `protobuf:"fixed64,2,opt,name=armijo,proto3" json:"armijo,omitempty"` // Sufficient-decrease constant (default 1e-4)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LBFGSOptions) Reset() {
+	*x = LBFGSOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LBFGSOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LBFGSOptions) ProtoMessage() {}
+
+func (x *LBFGSOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LBFGSOptions.ProtoReflect.Descriptor instead.
+func (*LBFGSOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LBFGSOptions) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *LBFGSOptions) GetArmijo() float64 {
+	if x != nil {
+		return x.Armijo
+	}
+	return 0
+}
+
+type GradientDescentOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LearningRate  float64                `protobuf:"fixed64,1,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"` // Default 0.1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradientDescentOptions) Reset() {
+	*x = GradientDescentOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradientDescentOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradientDescentOptions) ProtoMessage() {}
+
+func (x *GradientDescentOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradientDescentOptions.ProtoReflect.Descriptor instead.
+func (*GradientDescentOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GradientDescentOptions) GetLearningRate() float64 {
+	if x != nil {
+		return x.LearningRate
+	}
+	return 0
+}
+
+type VQEIteration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Iteration      int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Energy         float64                `protobuf:"fixed64,2,opt,name=energy,proto3" json:"energy,omitempty"`                                       // Current energy estimate
+	EnergyVariance float64                `protobuf:"fixed64,3,opt,name=energy_variance,json=energyVariance,proto3" json:"energy_variance,omitempty"` // Statistical uncertainty
+	Parameters     []float64              `protobuf:"fixed64,4,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
+	GradientNorm   float64                `protobuf:"fixed64,5,opt,name=gradient_norm,json=gradientNorm,proto3" json:"gradient_norm,omitempty"` // For gradient-based optimizers
+	Converged      bool                   `protobuf:"varint,6,opt,name=converged,proto3" json:"converged,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // "running", "converged", "max_iterations"
+	Diagnostics    *OptimizerDiagnostics  `protobuf:"bytes,8,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Gradient       []float64              `protobuf:"fixed64,9,rep,packed,name=gradient,proto3" json:"gradient,omitempty"`                             // ∂E/∂θ by parameter shift (SPSA: its estimate)
+	OverlapPenalty float64                `protobuf:"fixed64,10,opt,name=overlap_penalty,json=overlapPenalty,proto3" json:"overlap_penalty,omitempty"` // VQD penalty in the minimized cost (not in energy)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VQEIteration) Reset() {
+	*x = VQEIteration{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VQEIteration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VQEIteration) ProtoMessage() {}
+
+func (x *VQEIteration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VQEIteration.ProtoReflect.Descriptor instead.
+func (*VQEIteration) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VQEIteration) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *VQEIteration) GetEnergy() float64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *VQEIteration) GetEnergyVariance() float64 {
+	if x != nil {
+		return x.EnergyVariance
+	}
+	return 0
+}
+
+func (x *VQEIteration) GetParameters() []float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *VQEIteration) GetGradientNorm() float64 {
+	if x != nil {
+		return x.GradientNorm
+	}
+	return 0
+}
+
+func (x *VQEIteration) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+func (x *VQEIteration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VQEIteration) GetDiagnostics() *OptimizerDiagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *VQEIteration) GetGradient() []float64 {
+	if x != nil {
+		return x.Gradient
+	}
+	return nil
+}
+
+func (x *VQEIteration) GetOverlapPenalty() float64 {
+	if x != nil {
+		return x.OverlapPenalty
+	}
+	return 0
+}
+
+type OptimizerDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Optimizer           string                 `protobuf:"bytes,1,opt,name=optimizer,proto3" json:"optimizer,omitempty"`
+	FunctionEvaluations int32                  `protobuf:"varint,2,opt,name=function_evaluations,json=functionEvaluations,proto3" json:"function_evaluations,omitempty"` // Circuit evaluations so far
+	EnergyChange        float64                `protobuf:"fixed64,3,opt,name=energy_change,json=energyChange,proto3" json:"energy_change,omitempty"`                     // |E_k - E_k-1|
+	StepNorm            float64                `protobuf:"fixed64,4,opt,name=step_norm,json=stepNorm,proto3" json:"step_norm,omitempty"`                                 // |θ_k - θ_k-1|
+	StepSize            float64                `protobuf:"fixed64,5,opt,name=step_size,json=stepSize,proto3" json:"step_size,omitempty"`                                 // Trust radius, simplex size, learning rate, gain or line-search step
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OptimizerDiagnostics) Reset() {
+	*x = OptimizerDiagnostics{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimizerDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizerDiagnostics) ProtoMessage() {}
+
+func (x *OptimizerDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizerDiagnostics.ProtoReflect.Descriptor instead.
+func (*OptimizerDiagnostics) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OptimizerDiagnostics) GetOptimizer() string {
+	if x != nil {
+		return x.Optimizer
+	}
+	return ""
+}
+
+func (x *OptimizerDiagnostics) GetFunctionEvaluations() int32 {
+	if x != nil {
+		return x.FunctionEvaluations
+	}
+	return 0
+}
+
+func (x *OptimizerDiagnostics) GetEnergyChange() float64 {
+	if x != nil {
+		return x.EnergyChange
+	}
+	return 0
+}
+
+func (x *OptimizerDiagnostics) GetStepNorm() float64 {
+	if x != nil {
+		return x.StepNorm
+	}
+	return 0
+}
+
+func (x *OptimizerDiagnostics) GetStepSize() float64 {
+	if x != nil {
+		return x.StepSize
+	}
+	return 0
+}
+
+type BondScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      string                 `protobuf:"bytes,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`                  // Diatomic preset, e.g. "H2_equilibrium"
+	StartDistance float64                `protobuf:"fixed64,2,opt,name=start_distance,json=startDistance,proto3" json:"start_distance,omitempty"` // Angstroms
+	EndDistance   float64                `protobuf:"fixed64,3,opt,name=end_distance,json=endDistance,proto3" json:"end_distance,omitempty"`       // Angstroms
+	NumPoints     int32                  `protobuf:"varint,4,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`              // Default 10
+	Vqe           *VQERequest            `protobuf:"bytes,5,opt,name=vqe,proto3" json:"vqe,omitempty"`                                            // Ansatz, optimizer and hyperparameters (target ignored)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BondScanRequest) Reset() {
+	*x = BondScanRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondScanRequest) ProtoMessage() {}
+
+func (x *BondScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondScanRequest.ProtoReflect.Descriptor instead.
+func (*BondScanRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BondScanRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+func (x *BondScanRequest) GetStartDistance() float64 {
+	if x != nil {
+		return x.StartDistance
+	}
+	return 0
+}
+
+func (x *BondScanRequest) GetEndDistance() float64 {
+	if x != nil {
+		return x.EndDistance
+	}
+	return 0
+}
+
+func (x *BondScanRequest) GetNumPoints() int32 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *BondScanRequest) GetVqe() *VQERequest {
+	if x != nil {
+		return x.Vqe
+	}
+	return nil
+}
+
+type BondScanPoint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Distance          float64                `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"` // Angstroms
+	Energy            float64                `protobuf:"fixed64,2,opt,name=energy,proto3" json:"energy,omitempty"`     // VQE energy (Hartree)
+	HartreeFockEnergy float64                `protobuf:"fixed64,3,opt,name=hartree_fock_energy,json=hartreeFockEnergy,proto3" json:"hartree_fock_energy,omitempty"`
+	CorrelationEnergy float64                `protobuf:"fixed64,4,opt,name=correlation_energy,json=correlationEnergy,proto3" json:"correlation_energy,omitempty"` // energy - hartree_fock_energy
+	Iterations        int32                  `protobuf:"varint,5,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Converged         bool                   `protobuf:"varint,6,opt,name=converged,proto3" json:"converged,omitempty"`
+	Parameters        []float64              `protobuf:"fixed64,7,rep,packed,name=parameters,proto3" json:"parameters,omitempty"` // Warm start for the next point
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BondScanPoint) Reset() {
+	*x = BondScanPoint{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondScanPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondScanPoint) ProtoMessage() {}
+
+func (x *BondScanPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondScanPoint.ProtoReflect.Descriptor instead.
+func (*BondScanPoint) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BondScanPoint) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *BondScanPoint) GetEnergy() float64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *BondScanPoint) GetHartreeFockEnergy() float64 {
+	if x != nil {
+		return x.HartreeFockEnergy
+	}
+	return 0
+}
+
+func (x *BondScanPoint) GetCorrelationEnergy() float64 {
+	if x != nil {
+		return x.CorrelationEnergy
+	}
+	return 0
+}
+
+func (x *BondScanPoint) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *BondScanPoint) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+func (x *BondScanPoint) GetParameters() []float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type AnsatzCircuitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to System:
+	//
+	//	*AnsatzCircuitRequest_Hamiltonian
+	//	*AnsatzCircuitRequest_Molecule
+	System        isAnsatzCircuitRequest_System `protobuf_oneof:"system"`
+	Ansatz        AnsatzType                    `protobuf:"varint,3,opt,name=ansatz,proto3,enum=qubit_engine.physics.AnsatzType" json:"ansatz,omitempty"`
+	NumElectrons  int32                         `protobuf:"varint,4,opt,name=num_electrons,json=numElectrons,proto3" json:"num_electrons,omitempty"` // Overrides the Hamiltonian's electron count
+	Parameters    []float64                     `protobuf:"fixed64,5,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`                 // Bound into the QASM (default: all zero)
+	Trotterize    bool                          `protobuf:"varint,6,opt,name=trotterize,proto3" json:"trotterize,omitempty"`                         // List compiled gates instead of excitations
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnsatzCircuitRequest) Reset() {
+	*x = AnsatzCircuitRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnsatzCircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnsatzCircuitRequest) ProtoMessage() {}
+
+func (x *AnsatzCircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnsatzCircuitRequest.ProtoReflect.Descriptor instead.
+func (*AnsatzCircuitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AnsatzCircuitRequest) GetSystem() isAnsatzCircuitRequest_System {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitRequest) GetHamiltonian() *Hamiltonian {
+	if x != nil {
+		if x, ok := x.System.(*AnsatzCircuitRequest_Hamiltonian); ok {
+			return x.Hamiltonian
+		}
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitRequest) GetMolecule() *MoleculeConfig {
+	if x != nil {
+		if x, ok := x.System.(*AnsatzCircuitRequest_Molecule); ok {
+			return x.Molecule
+		}
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitRequest) GetAnsatz() AnsatzType {
+	if x != nil {
+		return x.Ansatz
+	}
+	return AnsatzType_ANSATZ_UCCSD
+}
+
+func (x *AnsatzCircuitRequest) GetNumElectrons() int32 {
+	if x != nil {
+		return x.NumElectrons
+	}
+	return 0
+}
+
+func (x *AnsatzCircuitRequest) GetParameters() []float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitRequest) GetTrotterize() bool {
+	if x != nil {
+		return x.Trotterize
+	}
+	return false
+}
+
+type isAnsatzCircuitRequest_System interface {
+	isAnsatzCircuitRequest_System()
+}
+
+type AnsatzCircuitRequest_Hamiltonian struct {
+	Hamiltonian *Hamiltonian `protobuf:"bytes,1,opt,name=hamiltonian,proto3,oneof"`
+}
+
+type AnsatzCircuitRequest_Molecule struct {
+	Molecule *MoleculeConfig `protobuf:"bytes,2,opt,name=molecule,proto3,oneof"`
+}
+
+func (*AnsatzCircuitRequest_Hamiltonian) isAnsatzCircuitRequest_System() {}
+
+func (*AnsatzCircuitRequest_Molecule) isAnsatzCircuitRequest_System() {}
+
+type AnsatzCircuitInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NumQubits      int32                  `protobuf:"varint,1,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	NumParams      int32                  `protobuf:"varint,2,opt,name=num_params,json=numParams,proto3" json:"num_params,omitempty"`
+	NumElectrons   int32                  `protobuf:"varint,3,opt,name=num_electrons,json=numElectrons,proto3" json:"num_electrons,omitempty"`
+	ReferenceState string                 `protobuf:"bytes,4,opt,name=reference_state,json=referenceState,proto3" json:"reference_state,omitempty"` // Occupation per qubit, qubit 0 first
+	Excitations    []*Excitation          `protobuf:"bytes,5,rep,name=excitations,proto3" json:"excitations,omitempty"`
+	Gates          []*CircuitGate         `protobuf:"bytes,6,rep,name=gates,proto3" json:"gates,omitempty"`
+	GateCounts     map[string]int32       `protobuf:"bytes,7,rep,name=gate_counts,json=gateCounts,proto3" json:"gate_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Depth          int32                  `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	Qasm           string                 `protobuf:"bytes,9,opt,name=qasm,proto3" json:"qasm,omitempty"` // OpenQASM 2.0 with excitations compiled
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnsatzCircuitInfo) Reset() {
+	*x = AnsatzCircuitInfo{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnsatzCircuitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnsatzCircuitInfo) ProtoMessage() {}
+
+func (x *AnsatzCircuitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnsatzCircuitInfo.ProtoReflect.Descriptor instead.
+func (*AnsatzCircuitInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AnsatzCircuitInfo) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *AnsatzCircuitInfo) GetNumParams() int32 {
+	if x != nil {
+		return x.NumParams
+	}
+	return 0
+}
+
+func (x *AnsatzCircuitInfo) GetNumElectrons() int32 {
+	if x != nil {
+		return x.NumElectrons
+	}
+	return 0
+}
+
+func (x *AnsatzCircuitInfo) GetReferenceState() string {
+	if x != nil {
+		return x.ReferenceState
+	}
+	return ""
+}
+
+func (x *AnsatzCircuitInfo) GetExcitations() []*Excitation {
+	if x != nil {
+		return x.Excitations
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitInfo) GetGates() []*CircuitGate {
+	if x != nil {
+		return x.Gates
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitInfo) GetGateCounts() map[string]int32 {
+	if x != nil {
+		return x.GateCounts
+	}
+	return nil
+}
+
+func (x *AnsatzCircuitInfo) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *AnsatzCircuitInfo) GetQasm() string {
+	if x != nil {
+		return x.Qasm
+	}
+	return ""
+}
+
+type Excitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occupied      []int32                `protobuf:"varint,1,rep,packed,name=occupied,proto3" json:"occupied,omitempty"`
+	Virtual       []int32                `protobuf:"varint,2,rep,packed,name=virtual,proto3" json:"virtual,omitempty"`
+	Param         int32                  `protobuf:"varint,3,opt,name=param,proto3" json:"param,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Excitation) Reset() {
+	*x = Excitation{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Excitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Excitation) ProtoMessage() {}
+
+func (x *Excitation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Excitation.ProtoReflect.Descriptor instead.
+func (*Excitation) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Excitation) GetOccupied() []int32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return nil
+}
+
+func (x *Excitation) GetVirtual() []int32 {
+	if x != nil {
+		return x.Virtual
+	}
+	return nil
+}
+
+func (x *Excitation) GetParam() int32 {
+	if x != nil {
+		return x.Param
+	}
+	return 0
+}
+
+type CircuitGate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // x, h, rx, ry, rz, cx or excitation
+	Qubits        []int32                `protobuf:"varint,2,rep,packed,name=qubits,proto3" json:"qubits,omitempty"`
+	Param         int32                  `protobuf:"varint,3,opt,name=param,proto3" json:"param,omitempty"`  // -1 for fixed gates
+	Scale         float64                `protobuf:"fixed64,4,opt,name=scale,proto3" json:"scale,omitempty"` // Angle = scale * theta[param], or the fixed angle
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitGate) Reset() {
+	*x = CircuitGate{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitGate) ProtoMessage() {}
+
+func (x *CircuitGate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitGate.ProtoReflect.Descriptor instead.
+func (*CircuitGate) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CircuitGate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CircuitGate) GetQubits() []int32 {
+	if x != nil {
+		return x.Qubits
+	}
+	return nil
+}
+
+func (x *CircuitGate) GetParam() int32 {
+	if x != nil {
+		return x.Param
+	}
+	return 0
+}
+
+func (x *CircuitGate) GetScale() float64 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+type ExcitedStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vqe           *VQERequest            `protobuf:"bytes,1,opt,name=vqe,proto3" json:"vqe,omitempty"`                                            // Hamiltonian or molecule, ansatz, optimizer and hyperparameters
+	NumStates     int32                  `protobuf:"varint,2,opt,name=num_states,json=numStates,proto3" json:"num_states,omitempty"`              // Default 3
+	OverlapWeight float64                `protobuf:"fixed64,3,opt,name=overlap_weight,json=overlapWeight,proto3" json:"overlap_weight,omitempty"` // β; 0 picks a bound on the spectral width
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcitedStatesRequest) Reset() {
+	*x = ExcitedStatesRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcitedStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitedStatesRequest) ProtoMessage() {}
+
+func (x *ExcitedStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitedStatesRequest.ProtoReflect.Descriptor instead.
+func (*ExcitedStatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExcitedStatesRequest) GetVqe() *VQERequest {
+	if x != nil {
+		return x.Vqe
+	}
+	return nil
+}
+
+func (x *ExcitedStatesRequest) GetNumStates() int32 {
+	if x != nil {
+		return x.NumStates
+	}
+	return 0
+}
+
+func (x *ExcitedStatesRequest) GetOverlapWeight() float64 {
+	if x != nil {
+		return x.OverlapWeight
+	}
+	return 0
+}
+
+type ExcitedStatesResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []*ExcitedState        `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	OverlapWeight float64                `protobuf:"fixed64,2,opt,name=overlap_weight,json=overlapWeight,proto3" json:"overlap_weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcitedStatesResult) Reset() {
+	*x = ExcitedStatesResult{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcitedStatesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitedStatesResult) ProtoMessage() {}
+
+func (x *ExcitedStatesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitedStatesResult.ProtoReflect.Descriptor instead.
+func (*ExcitedStatesResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExcitedStatesResult) GetStates() []*ExcitedState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ExcitedStatesResult) GetOverlapWeight() float64 {
+	if x != nil {
+		return x.OverlapWeight
+	}
+	return 0
+}
+
+type ExcitedState struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Index            int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Energy           float64                `protobuf:"fixed64,2,opt,name=energy,proto3" json:"energy,omitempty"`
+	EnergyVariance   float64                `protobuf:"fixed64,3,opt,name=energy_variance,json=energyVariance,proto3" json:"energy_variance,omitempty"`
+	ExcitationEnergy float64                `protobuf:"fixed64,4,opt,name=excitation_energy,json=excitationEnergy,proto3" json:"excitation_energy,omitempty"` // energy - ground state energy
+	Overlaps         []float64              `protobuf:"fixed64,5,rep,packed,name=overlaps,proto3" json:"overlaps,omitempty"`                                  // |<psi_j|psi>|^2 with each earlier state j
+	Parameters       []float64              `protobuf:"fixed64,6,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
+	Iterations       int32                  `protobuf:"varint,7,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Converged        bool                   `protobuf:"varint,8,opt,name=converged,proto3" json:"converged,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExcitedState) Reset() {
+	*x = ExcitedState{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcitedState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcitedState) ProtoMessage() {}
+
+func (x *ExcitedState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcitedState.ProtoReflect.Descriptor instead.
+func (*ExcitedState) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExcitedState) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ExcitedState) GetEnergy() float64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *ExcitedState) GetEnergyVariance() float64 {
+	if x != nil {
+		return x.EnergyVariance
+	}
+	return 0
+}
+
+func (x *ExcitedState) GetExcitationEnergy() float64 {
+	if x != nil {
+		return x.ExcitationEnergy
+	}
+	return 0
+}
+
+func (x *ExcitedState) GetOverlaps() []float64 {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+func (x *ExcitedState) GetParameters() []float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ExcitedState) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *ExcitedState) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+type ExpectationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hamiltonian      *Hamiltonian           `protobuf:"bytes,1,opt,name=hamiltonian,proto3" json:"hamiltonian,omitempty"`
+	AnsatzParameters []float64              `protobuf:"fixed64,2,rep,packed,name=ansatz_parameters,json=ansatzParameters,proto3" json:"ansatz_parameters,omitempty"`
+	Ansatz           AnsatzType             `protobuf:"varint,3,opt,name=ansatz,proto3,enum=qubit_engine.physics.AnsatzType" json:"ansatz,omitempty"`
+	Shots            int32                  `protobuf:"varint,4,opt,name=shots,proto3" json:"shots,omitempty"`
+	Noise            *NoiseModel            `protobuf:"bytes,5,opt,name=noise,proto3" json:"noise,omitempty"`           // Optional simulated hardware noise
+	Mitigation       *MitigationOptions     `protobuf:"bytes,6,opt,name=mitigation,proto3" json:"mitigation,omitempty"` // Optional post-processing
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExpectationRequest) Reset() {
+	*x = ExpectationRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpectationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectationRequest) ProtoMessage() {}
+
+func (x *ExpectationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectationRequest.ProtoReflect.Descriptor instead.
+func (*ExpectationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExpectationRequest) GetHamiltonian() *Hamiltonian {
+	if x != nil {
+		return x.Hamiltonian
+	}
+	return nil
+}
+
+func (x *ExpectationRequest) GetAnsatzParameters() []float64 {
+	if x != nil {
+		return x.AnsatzParameters
+	}
+	return nil
+}
+
+func (x *ExpectationRequest) GetAnsatz() AnsatzType {
+	if x != nil {
+		return x.Ansatz
+	}
+	return AnsatzType_ANSATZ_UCCSD
+}
+
+func (x *ExpectationRequest) GetShots() int32 {
+	if x != nil {
+		return x.Shots
+	}
+	return 0
+}
+
+func (x *ExpectationRequest) GetNoise() *NoiseModel {
+	if x != nil {
+		return x.Noise
+	}
+	return nil
+}
+
+func (x *ExpectationRequest) GetMitigation() *MitigationOptions {
+	if x != nil {
+		return x.Mitigation
+	}
+	return nil
+}
+
+type NoiseModel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GateError       float64                `protobuf:"fixed64,1,opt,name=gate_error,json=gateError,proto3" json:"gate_error,omitempty"`                  // Depolarizing probability per qubit per gate
+	ReadoutError_01 float64                `protobuf:"fixed64,2,opt,name=readout_error_01,json=readoutError01,proto3" json:"readout_error_01,omitempty"` // P(read 1 | 0)
+	ReadoutError_10 float64                `protobuf:"fixed64,3,opt,name=readout_error_10,json=readoutError10,proto3" json:"readout_error_10,omitempty"` // P(read 0 | 1)
+	Trajectories    int32                  `protobuf:"varint,4,opt,name=trajectories,proto3" json:"trajectories,omitempty"`                              // Pauli trajectories averaged (default 100 with gate noise)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NoiseModel) Reset() {
+	*x = NoiseModel{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoiseModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoiseModel) ProtoMessage() {}
+
+func (x *NoiseModel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoiseModel.ProtoReflect.Descriptor instead.
+func (*NoiseModel) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NoiseModel) GetGateError() float64 {
+	if x != nil {
+		return x.GateError
+	}
+	return 0
+}
+
+func (x *NoiseModel) GetReadoutError_01() float64 {
+	if x != nil {
+		return x.ReadoutError_01
+	}
+	return 0
+}
+
+func (x *NoiseModel) GetReadoutError_10() float64 {
+	if x != nil {
+		return x.ReadoutError_10
+	}
+	return 0
+}
+
+func (x *NoiseModel) GetTrajectories() int32 {
+	if x != nil {
+		return x.Trajectories
+	}
+	return 0
+}
+
+type MitigationOptions struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ZeroNoiseExtrapolation bool                   `protobuf:"varint,1,opt,name=zero_noise_extrapolation,json=zeroNoiseExtrapolation,proto3" json:"zero_noise_extrapolation,omitempty"`
+	NoiseScales            []int32                `protobuf:"varint,2,rep,packed,name=noise_scales,json=noiseScales,proto3" json:"noise_scales,omitempty"`            // Odd folding factors U(U†U)^k (default 1, 3, 5)
+	Extrapolation          string                 `protobuf:"bytes,3,opt,name=extrapolation,proto3" json:"extrapolation,omitempty"`                                   // "richardson" (default) or "linear"
+	ReadoutMitigation      bool                   `protobuf:"varint,4,opt,name=readout_mitigation,json=readoutMitigation,proto3" json:"readout_mitigation,omitempty"` // Invert calibrated confusion matrices
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MitigationOptions) Reset() {
+	*x = MitigationOptions{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MitigationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MitigationOptions) ProtoMessage() {}
+
+func (x *MitigationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MitigationOptions.ProtoReflect.Descriptor instead.
+func (*MitigationOptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MitigationOptions) GetZeroNoiseExtrapolation() bool {
+	if x != nil {
+		return x.ZeroNoiseExtrapolation
+	}
+	return false
+}
+
+func (x *MitigationOptions) GetNoiseScales() []int32 {
+	if x != nil {
+		return x.NoiseScales
+	}
+	return nil
+}
+
+func (x *MitigationOptions) GetExtrapolation() string {
+	if x != nil {
+		return x.Extrapolation
+	}
+	return ""
+}
+
+func (x *MitigationOptions) GetReadoutMitigation() bool {
+	if x != nil {
+		return x.ReadoutMitigation
+	}
+	return false
+}
+
+type MitigationResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RawEnergy          float64                `protobuf:"fixed64,1,opt,name=raw_energy,json=rawEnergy,proto3" json:"raw_energy,omitempty"`
+	MitigatedEnergy    float64                `protobuf:"fixed64,2,opt,name=mitigated_energy,json=mitigatedEnergy,proto3" json:"mitigated_energy,omitempty"`
+	NoiseScales        []int32                `protobuf:"varint,3,rep,packed,name=noise_scales,json=noiseScales,proto3" json:"noise_scales,omitempty"`
+	ScaledEnergies     []float64              `protobuf:"fixed64,4,rep,packed,name=scaled_energies,json=scaledEnergies,proto3" json:"scaled_energies,omitempty"` // Readout-corrected energy at each noise scale
+	ReadoutCalibration []*ReadoutCalibration  `protobuf:"bytes,5,rep,name=readout_calibration,json=readoutCalibration,proto3" json:"readout_calibration,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MitigationResult) Reset() {
+	*x = MitigationResult{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MitigationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MitigationResult) ProtoMessage() {}
+
+func (x *MitigationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MitigationResult.ProtoReflect.Descriptor instead.
+func (*MitigationResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MitigationResult) GetRawEnergy() float64 {
+	if x != nil {
+		return x.RawEnergy
+	}
+	return 0
+}
+
+func (x *MitigationResult) GetMitigatedEnergy() float64 {
+	if x != nil {
+		return x.MitigatedEnergy
+	}
+	return 0
+}
+
+func (x *MitigationResult) GetNoiseScales() []int32 {
+	if x != nil {
+		return x.NoiseScales
+	}
+	return nil
+}
+
+func (x *MitigationResult) GetScaledEnergies() []float64 {
+	if x != nil {
+		return x.ScaledEnergies
+	}
+	return nil
+}
+
+func (x *MitigationResult) GetReadoutCalibration() []*ReadoutCalibration {
+	if x != nil {
+		return x.ReadoutCalibration
+	}
+	return nil
+}
+
+type ReadoutCalibration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Qubit         int32                  `protobuf:"varint,1,opt,name=qubit,proto3" json:"qubit,omitempty"`
+	Error_01      float64                `protobuf:"fixed64,2,opt,name=error_01,json=error01,proto3" json:"error_01,omitempty"` // Estimated P(read 1 | 0)
+	Error_10      float64                `protobuf:"fixed64,3,opt,name=error_10,json=error10,proto3" json:"error_10,omitempty"` // Estimated P(read 0 | 1)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadoutCalibration) Reset() {
+	*x = ReadoutCalibration{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadoutCalibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadoutCalibration) ProtoMessage() {}
+
+func (x *ReadoutCalibration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadoutCalibration.ProtoReflect.Descriptor instead.
+func (*ReadoutCalibration) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReadoutCalibration) GetQubit() int32 {
+	if x != nil {
+		return x.Qubit
+	}
+	return 0
+}
+
+func (x *ReadoutCalibration) GetError_01() float64 {
+	if x != nil {
+		return x.Error_01
+	}
+	return 0
+}
+
+func (x *ReadoutCalibration) GetError_10() float64 {
+	if x != nil {
+		return x.Error_10
+	}
+	return 0
+}
+
+type ExpectationResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ExpectationValue  float64                `protobuf:"fixed64,1,opt,name=expectation_value,json=expectationValue,proto3" json:"expectation_value,omitempty"`
+	Variance          float64                `protobuf:"fixed64,2,opt,name=variance,proto3" json:"variance,omitempty"`
+	TotalShots        int32                  `protobuf:"varint,3,opt,name=total_shots,json=totalShots,proto3" json:"total_shots,omitempty"`
+	TermContributions map[string]float64     `protobuf:"bytes,4,rep,name=term_contributions,json=termContributions,proto3" json:"term_contributions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // Per-term breakdown
+	MeasurementGroups int32                  `protobuf:"varint,5,opt,name=measurement_groups,json=measurementGroups,proto3" json:"measurement_groups,omitempty"`                                                                            // Circuits measured (each with `shots` shots)
+	Mitigation        *MitigationResult      `protobuf:"bytes,6,opt,name=mitigation,proto3" json:"mitigation,omitempty"`                                                                                                                    // Set when mitigation was requested; fields above are raw
+	Terms             []*TermExpectation     `protobuf:"bytes,7,rep,name=terms,proto3" json:"terms,omitempty"`                                                                                                                              // Largest |contribution| first
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExpectationResult) Reset() {
+	*x = ExpectationResult{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpectationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectationResult) ProtoMessage() {}
+
+func (x *ExpectationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectationResult.ProtoReflect.Descriptor instead.
+func (*ExpectationResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExpectationResult) GetExpectationValue() float64 {
+	if x != nil {
+		return x.ExpectationValue
+	}
+	return 0
+}
+
+func (x *ExpectationResult) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *ExpectationResult) GetTotalShots() int32 {
+	if x != nil {
+		return x.TotalShots
+	}
+	return 0
+}
+
+func (x *ExpectationResult) GetTermContributions() map[string]float64 {
+	if x != nil {
+		return x.TermContributions
+	}
+	return nil
+}
+
+func (x *ExpectationResult) GetMeasurementGroups() int32 {
+	if x != nil {
+		return x.MeasurementGroups
+	}
+	return 0
+}
+
+func (x *ExpectationResult) GetMitigation() *MitigationResult {
+	if x != nil {
+		return x.Mitigation
+	}
+	return nil
+}
+
+func (x *ExpectationResult) GetTerms() []*TermExpectation {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type TermExpectation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Into Hamiltonian.terms
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`  // e.g. "X0 X1 Y2 Y3"
+	Coefficient   float64                `protobuf:"fixed64,3,opt,name=coefficient,proto3" json:"coefficient,omitempty"`
+	Expectation   float64                `protobuf:"fixed64,4,opt,name=expectation,proto3" json:"expectation,omitempty"`   // <P>
+	Contribution  float64                `protobuf:"fixed64,5,opt,name=contribution,proto3" json:"contribution,omitempty"` // c<P>
+	Variance      float64                `protobuf:"fixed64,6,opt,name=variance,proto3" json:"variance,omitempty"`         // Variance of the contribution estimate (0 when exact)
+	Group         int32                  `protobuf:"varint,7,opt,name=group,proto3" json:"group,omitempty"`                // Measurement group (-1 for the identity, which is not measured)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermExpectation) Reset() {
+	*x = TermExpectation{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermExpectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermExpectation) ProtoMessage() {}
+
+func (x *TermExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermExpectation.ProtoReflect.Descriptor instead.
+func (*TermExpectation) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TermExpectation) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TermExpectation) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TermExpectation) GetCoefficient() float64 {
+	if x != nil {
+		return x.Coefficient
+	}
+	return 0
+}
+
+func (x *TermExpectation) GetExpectation() float64 {
+	if x != nil {
+		return x.Expectation
+	}
+	return 0
+}
+
+func (x *TermExpectation) GetContribution() float64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *TermExpectation) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *TermExpectation) GetGroup() int32 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+type ExpectationBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hamiltonian   *Hamiltonian           `protobuf:"bytes,1,opt,name=hamiltonian,proto3" json:"hamiltonian,omitempty"` // Builds the ansatz (and is the observable by default)
+	Ansatz        AnsatzType             `protobuf:"varint,2,opt,name=ansatz,proto3,enum=qubit_engine.physics.AnsatzType" json:"ansatz,omitempty"`
+	ParameterSets []*ParameterSet        `protobuf:"bytes,3,rep,name=parameter_sets,json=parameterSets,proto3" json:"parameter_sets,omitempty"`
+	Observables   []*Hamiltonian         `protobuf:"bytes,4,rep,name=observables,proto3" json:"observables,omitempty"` // Measured on every state (default: the Hamiltonian)
+	Shots         int32                  `protobuf:"varint,5,opt,name=shots,proto3" json:"shots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpectationBatchRequest) Reset() {
+	*x = ExpectationBatchRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpectationBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectationBatchRequest) ProtoMessage() {}
+
+func (x *ExpectationBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectationBatchRequest.ProtoReflect.Descriptor instead.
+func (*ExpectationBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExpectationBatchRequest) GetHamiltonian() *Hamiltonian {
+	if x != nil {
+		return x.Hamiltonian
+	}
+	return nil
+}
+
+func (x *ExpectationBatchRequest) GetAnsatz() AnsatzType {
+	if x != nil {
+		return x.Ansatz
+	}
+	return AnsatzType_ANSATZ_UCCSD
+}
+
+func (x *ExpectationBatchRequest) GetParameterSets() []*ParameterSet {
+	if x != nil {
+		return x.ParameterSets
+	}
+	return nil
+}
+
+func (x *ExpectationBatchRequest) GetObservables() []*Hamiltonian {
+	if x != nil {
+		return x.Observables
+	}
+	return nil
+}
+
+func (x *ExpectationBatchRequest) GetShots() int32 {
+	if x != nil {
+		return x.Shots
+	}
+	return 0
+}
+
+type ParameterSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParameterSet) Reset() {
+	*x = ParameterSet{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterSet) ProtoMessage() {}
+
+func (x *ParameterSet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterSet.ProtoReflect.Descriptor instead.
+func (*ParameterSet) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ParameterSet) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ExpectationBatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Parameter-major: parameter_index * len(observables) + observable_index
+	Results       []*BatchExpectation `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpectationBatchResult) Reset() {
+	*x = ExpectationBatchResult{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpectationBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectationBatchResult) ProtoMessage() {}
+
+func (x *ExpectationBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectationBatchResult.ProtoReflect.Descriptor instead.
+func (*ExpectationBatchResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExpectationBatchResult) GetResults() []*BatchExpectation {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchExpectation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ParameterIndex   int32                  `protobuf:"varint,1,opt,name=parameter_index,json=parameterIndex,proto3" json:"parameter_index,omitempty"`
+	ObservableIndex  int32                  `protobuf:"varint,2,opt,name=observable_index,json=observableIndex,proto3" json:"observable_index,omitempty"`
+	ExpectationValue float64                `protobuf:"fixed64,3,opt,name=expectation_value,json=expectationValue,proto3" json:"expectation_value,omitempty"`
+	Variance         float64                `protobuf:"fixed64,4,opt,name=variance,proto3" json:"variance,omitempty"`
+	TotalShots       int32                  `protobuf:"varint,5,opt,name=total_shots,json=totalShots,proto3" json:"total_shots,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchExpectation) Reset() {
+	*x = BatchExpectation{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchExpectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchExpectation) ProtoMessage() {}
+
+func (x *BatchExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchExpectation.ProtoReflect.Descriptor instead.
+func (*BatchExpectation) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchExpectation) GetParameterIndex() int32 {
+	if x != nil {
+		return x.ParameterIndex
+	}
+	return 0
+}
+
+func (x *BatchExpectation) GetObservableIndex() int32 {
+	if x != nil {
+		return x.ObservableIndex
+	}
+	return 0
+}
+
+func (x *BatchExpectation) GetExpectationValue() float64 {
+	if x != nil {
+		return x.ExpectationValue
+	}
+	return 0
+}
+
+func (x *BatchExpectation) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *BatchExpectation) GetTotalShots() int32 {
+	if x != nil {
+		return x.TotalShots
+	}
+	return 0
+}
+
+type TimeEvolutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hamiltonian   *Hamiltonian           `protobuf:"bytes,1,opt,name=hamiltonian,proto3" json:"hamiltonian,omitempty"`
+	InitialState  string                 `protobuf:"bytes,2,opt,name=initial_state,json=initialState,proto3" json:"initial_state,omitempty"`  // '0', '1', '+' or '-' per qubit, qubit 0 first (default: reference determinant)
+	TotalTime     float64                `protobuf:"fixed64,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`         // hbar = 1, in inverse Hamiltonian units
+	TrotterSteps  int32                  `protobuf:"varint,4,opt,name=trotter_steps,json=trotterSteps,proto3" json:"trotter_steps,omitempty"` // Default 100
+	TrotterOrder  int32                  `protobuf:"varint,5,opt,name=trotter_order,json=trotterOrder,proto3" json:"trotter_order,omitempty"` // 1 (default) or 2
+	Observables   []*Hamiltonian         `protobuf:"bytes,6,rep,name=observables,proto3" json:"observables,omitempty"`                        // Extra operators tracked over time
+	ReportEvery   int32                  `protobuf:"varint,7,opt,name=report_every,json=reportEvery,proto3" json:"report_every,omitempty"`    // Steps between frames (default 1; the last step is always sent)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeEvolutionRequest) Reset() {
+	*x = TimeEvolutionRequest{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEvolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEvolutionRequest) ProtoMessage() {}
+
+func (x *TimeEvolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEvolutionRequest.ProtoReflect.Descriptor instead.
+func (*TimeEvolutionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TimeEvolutionRequest) GetHamiltonian() *Hamiltonian {
+	if x != nil {
+		return x.Hamiltonian
+	}
+	return nil
+}
+
+func (x *TimeEvolutionRequest) GetInitialState() string {
+	if x != nil {
+		return x.InitialState
+	}
+	return ""
+}
+
+func (x *TimeEvolutionRequest) GetTotalTime() float64 {
+	if x != nil {
+		return x.TotalTime
+	}
+	return 0
+}
+
+func (x *TimeEvolutionRequest) GetTrotterSteps() int32 {
+	if x != nil {
+		return x.TrotterSteps
+	}
+	return 0
+}
+
+func (x *TimeEvolutionRequest) GetTrotterOrder() int32 {
+	if x != nil {
+		return x.TrotterOrder
+	}
+	return 0
+}
+
+func (x *TimeEvolutionRequest) GetObservables() []*Hamiltonian {
+	if x != nil {
+		return x.Observables
+	}
+	return nil
+}
+
+func (x *TimeEvolutionRequest) GetReportEvery() int32 {
+	if x != nil {
+		return x.ReportEvery
+	}
+	return 0
+}
+
+type TimeEvolutionFrame struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Step              int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Time              float64                `protobuf:"fixed64,2,opt,name=time,proto3" json:"time,omitempty"`
+	Energy            float64                `protobuf:"fixed64,3,opt,name=energy,proto3" json:"energy,omitempty"`                                                // Conserved exactly; drift is Trotter error
+	ReturnProbability float64                `protobuf:"fixed64,4,opt,name=return_probability,json=returnProbability,proto3" json:"return_probability,omitempty"` // |<psi(0)|psi(t)>|^2 (Loschmidt echo)
+	Magnetization     []float64              `protobuf:"fixed64,5,rep,packed,name=magnetization,proto3" json:"magnetization,omitempty"`                           // <Z_q> per qubit
+	Observables       []float64              `protobuf:"fixed64,6,rep,packed,name=observables,proto3" json:"observables,omitempty"`                               // Same order as the request
+	GatesPerStep      int32                  `protobuf:"varint,7,opt,name=gates_per_step,json=gatesPerStep,proto3" json:"gates_per_step,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TimeEvolutionFrame) Reset() {
+	*x = TimeEvolutionFrame{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEvolutionFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEvolutionFrame) ProtoMessage() {}
+
+func (x *TimeEvolutionFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEvolutionFrame.ProtoReflect.Descriptor instead.
+func (*TimeEvolutionFrame) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TimeEvolutionFrame) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *TimeEvolutionFrame) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *TimeEvolutionFrame) GetEnergy() float64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *TimeEvolutionFrame) GetReturnProbability() float64 {
+	if x != nil {
+		return x.ReturnProbability
+	}
+	return 0
+}
+
+func (x *TimeEvolutionFrame) GetMagnetization() []float64 {
+	if x != nil {
+		return x.Magnetization
+	}
+	return nil
+}
+
+func (x *TimeEvolutionFrame) GetObservables() []float64 {
+	if x != nil {
+		return x.Observables
+	}
+	return nil
+}
+
+func (x *TimeEvolutionFrame) GetGatesPerStep() int32 {
+	if x != nil {
+		return x.GatesPerStep
+	}
+	return 0
+}
+
+type MoleculeLibrary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presets       []*MoleculePreset      `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoleculeLibrary) Reset() {
+	*x = MoleculeLibrary{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoleculeLibrary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoleculeLibrary) ProtoMessage() {}
+
+func (x *MoleculeLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoleculeLibrary.ProtoReflect.Descriptor instead.
+func (*MoleculeLibrary) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MoleculeLibrary) GetPresets() []*MoleculePreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type MoleculePreset struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`           // e.g. "H2_equilibrium"
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // e.g. "Hydrogen Molecule (equilibrium)"
+	Formula         string                 `protobuf:"bytes,3,opt,name=formula,proto3" json:"formula,omitempty"` // e.g. "H2"
+	Config          *MoleculeConfig        `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReferenceEnergy float64                `protobuf:"fixed64,5,opt,name=reference_energy,json=referenceEnergy,proto3" json:"reference_energy,omitempty"` // Known ground state energy (Hartree)
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoleculePreset) Reset() {
+	*x = MoleculePreset{}
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoleculePreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoleculePreset) ProtoMessage() {}
+
+func (x *MoleculePreset) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_physics_vqe_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoleculePreset.ProtoReflect.Descriptor instead.
+func (*MoleculePreset) Descriptor() ([]byte, []int) {
+	return file_api_proto_physics_vqe_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MoleculePreset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoleculePreset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoleculePreset) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *MoleculePreset) GetConfig() *MoleculeConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *MoleculePreset) GetReferenceEnergy() float64 {
+	if x != nil {
+		return x.ReferenceEnergy
+	}
+	return 0
+}
+
+func (x *MoleculePreset) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_api_proto_physics_vqe_proto protoreflect.FileDescriptor
+
+const file_api_proto_physics_vqe_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapi/proto/physics/vqe.proto\x12\x14qubit_engine.physics\x1a\x17api/proto/quantum.proto\"\a\n" +
+	"\x05Empty\"\xcd\x01\n" +
+	"\x0eMoleculeConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x05atoms\x18\x02 \x03(\v2\x1a.qubit_engine.physics.AtomR\x05atoms\x12\x16\n" +
+	"\x06charge\x18\x03 \x01(\x05R\x06charge\x12\"\n" +
+	"\fmultiplicity\x18\x04 \x01(\x05R\fmultiplicity\x12\x1b\n" +
+	"\tbasis_set\x18\x05 \x01(\tR\bbasisSet\x12\x1c\n" +
+	"\tintegrals\x18\x06 \x01(\tR\tintegrals\"\xc0\x01\n" +
+	"\x13ElectronicIntegrals\x12!\n" +
+	"\fnum_orbitals\x18\x01 \x01(\x05R\vnumOrbitals\x12#\n" +
+	"\rnum_electrons\x18\x02 \x01(\x05R\fnumElectrons\x12+\n" +
+	"\x11nuclear_repulsion\x18\x03 \x01(\x01R\x10nuclearRepulsion\x12\x19\n" +
+	"\bone_body\x18\x04 \x03(\x01R\aoneBody\x12\x19\n" +
+	"\btwo_body\x18\x05 \x03(\x01R\atwoBody\"J\n" +
+	"\x04Atom\x12\x18\n" +
+	"\aelement\x18\x01 \x01(\tR\aelement\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\f\n" +
+	"\x01z\x18\x04 \x01(\x01R\x01z\"\xf6\x01\n" +
+	"\x0fSpinModelConfig\x129\n" +
+	"\x05model\x18\x01 \x01(\x0e2#.qubit_engine.physics.SpinModelTypeR\x05model\x12\x1b\n" +
+	"\tnum_sites\x18\x02 \x01(\x05R\bnumSites\x12\x1a\n" +
+	"\bcoupling\x18\x03 \x01(\x01R\bcoupling\x12\x1d\n" +
+	"\n" +
+	"coupling_z\x18\x04 \x01(\x01R\tcouplingZ\x12\x1e\n" +
+	"\n" +
+	"anisotropy\x18\x05 \x01(\x01R\n" +
+	"anisotropy\x12\x14\n" +
+	"\x05field\x18\x06 \x01(\x01R\x05field\x12\x1a\n" +
+	"\bperiodic\x18\a \x01(\bR\bperiodic\"\x9a\x02\n" +
+	"\vHamiltonian\x12#\n" +
+	"\rmolecule_name\x18\x01 \x01(\tR\fmoleculeName\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x02 \x01(\x05R\tnumQubits\x125\n" +
+	"\x05terms\x18\x03 \x03(\v2\x1f.qubit_engine.physics.PauliTermR\x05terms\x12+\n" +
+	"\x11nuclear_repulsion\x18\x04 \x01(\x01R\x10nuclearRepulsion\x12#\n" +
+	"\rnum_electrons\x18\x05 \x01(\x05R\fnumElectrons\x12>\n" +
+	"\x06groups\x18\x06 \x03(\v2&.qubit_engine.physics.MeasurementGroupR\x06groups\"p\n" +
+	"\x10MeasurementGroup\x12!\n" +
+	"\fterm_indices\x18\x01 \x03(\x05R\vtermIndices\x129\n" +
+	"\x05basis\x18\x02 \x03(\v2#.qubit_engine.physics.PauliOperatorR\x05basis\"\xa6\x01\n" +
+	"\x18ImportHamiltonianRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x04 \x01(\x05R\tnumQubits\x12#\n" +
+	"\rnum_electrons\x18\x05 \x01(\x05R\fnumElectrons\"p\n" +
+	"\tPauliTerm\x12 \n" +
+	"\vcoefficient\x18\x01 \x01(\x01R\vcoefficient\x12A\n" +
+	"\toperators\x18\x02 \x03(\v2#.qubit_engine.physics.PauliOperatorR\toperators\"Z\n" +
+	"\rPauliOperator\x12\x14\n" +
+	"\x05qubit\x18\x01 \x01(\x05R\x05qubit\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.qubit_engine.physics.PauliTypeR\x04type\"\xab\a\n" +
+	"\n" +
+	"VQERequest\x12B\n" +
+	"\bmolecule\x18\x01 \x01(\v2$.qubit_engine.physics.MoleculeConfigH\x00R\bmolecule\x12E\n" +
+	"\vhamiltonian\x18\x02 \x01(\v2!.qubit_engine.physics.HamiltonianH\x00R\vhamiltonian\x12F\n" +
+	"\n" +
+	"spin_model\x18\x0f \x01(\v2%.qubit_engine.physics.SpinModelConfigH\x00R\tspinModel\x128\n" +
+	"\x06ansatz\x18\x03 \x01(\x0e2 .qubit_engine.physics.AnsatzTypeR\x06ansatz\x12A\n" +
+	"\toptimizer\x18\x04 \x01(\x0e2#.qubit_engine.physics.OptimizerTypeR\toptimizer\x12%\n" +
+	"\x0emax_iterations\x18\x05 \x01(\x05R\rmaxIterations\x123\n" +
+	"\x15convergence_threshold\x18\x06 \x01(\x01R\x14convergenceThreshold\x12-\n" +
+	"\x12initial_parameters\x18\a \x03(\x01R\x11initialParameters\x120\n" +
+	"\x14shots_per_evaluation\x18\b \x01(\x05R\x12shotsPerEvaluation\x12;\n" +
+	"\x06cobyla\x18\t \x01(\v2#.qubit_engine.physics.CobylaOptionsR\x06cobyla\x125\n" +
+	"\x04spsa\x18\n" +
+	" \x01(\v2!.qubit_engine.physics.SPSAOptionsR\x04spsa\x12H\n" +
+	"\vnelder_mead\x18\v \x01(\v2'.qubit_engine.physics.NelderMeadOptionsR\n" +
+	"nelderMead\x125\n" +
+	"\x04adam\x18\f \x01(\v2!.qubit_engine.physics.AdamOptionsR\x04adam\x128\n" +
+	"\x05lbfgs\x18\r \x01(\v2\".qubit_engine.physics.LBFGSOptionsR\x05lbfgs\x12W\n" +
+	"\x10gradient_descent\x18\x0e \x01(\v2,.qubit_engine.physics.GradientDescentOptionsR\x0fgradientDescentB\b\n" +
+	"\x06target\"o\n" +
+	"\rCobylaOptions\x120\n" +
+	"\x14initial_trust_radius\x18\x01 \x01(\x01R\x12initialTrustRadius\x12,\n" +
+	"\x12final_trust_radius\x18\x02 \x01(\x01R\x10finalTrustRadius\"s\n" +
+	"\vSPSAOptions\x12\f\n" +
+	"\x01a\x18\x01 \x01(\x01R\x01a\x12\f\n" +
+	"\x01c\x18\x02 \x01(\x01R\x01c\x12\x14\n" +
+	"\x05alpha\x18\x03 \x01(\x01R\x05alpha\x12\x14\n" +
+	"\x05gamma\x18\x04 \x01(\x01R\x05gamma\x12\x1c\n" +
+	"\tstability\x18\x05 \x01(\x01R\tstability\"\xb4\x01\n" +
+	"\x11NelderMeadOptions\x12'\n" +
+	"\x0finitial_simplex\x18\x01 \x01(\x01R\x0einitialSimplex\x12\x1e\n" +
+	"\n" +
+	"reflection\x18\x02 \x01(\x01R\n" +
+	"reflection\x12\x1c\n" +
+	"\texpansion\x18\x03 \x01(\x01R\texpansion\x12 \n" +
+	"\vcontraction\x18\x04 \x01(\x01R\vcontraction\x12\x16\n" +
+	"\x06shrink\x18\x05 \x01(\x01R\x06shrink\"x\n" +
+	"\vAdamOptions\x12#\n" +
+	"\rlearning_rate\x18\x01 \x01(\x01R\flearningRate\x12\x14\n" +
+	"\x05beta1\x18\x02 \x01(\x01R\x05beta1\x12\x14\n" +
+	"\x05beta2\x18\x03 \x01(\x01R\x05beta2\x12\x18\n" +
+	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\">\n" +
+	"\fLBFGSOptions\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\x05R\x06memory\x12\x16\n" +
+	"\x06armijo\x18\x02 \x01(\x01R\x06armijo\"=\n" +
+	"\x16GradientDescentOptions\x12#\n" +
+	"\rlearning_rate\x18\x01 \x01(\x01R\flearningRate\"\xfb\x02\n" +
+	"\fVQEIteration\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12\x16\n" +
+	"\x06energy\x18\x02 \x01(\x01R\x06energy\x12'\n" +
+	"\x0fenergy_variance\x18\x03 \x01(\x01R\x0eenergyVariance\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\x01R\n" +
+	"parameters\x12#\n" +
+	"\rgradient_norm\x18\x05 \x01(\x01R\fgradientNorm\x12\x1c\n" +
+	"\tconverged\x18\x06 \x01(\bR\tconverged\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12L\n" +
+	"\vdiagnostics\x18\b \x01(\v2*.qubit_engine.physics.OptimizerDiagnosticsR\vdiagnostics\x12\x1a\n" +
+	"\bgradient\x18\t \x03(\x01R\bgradient\x12'\n" +
+	"\x0foverlap_penalty\x18\n" +
+	" \x01(\x01R\x0eoverlapPenalty\"\xc6\x01\n" +
+	"\x14OptimizerDiagnostics\x12\x1c\n" +
+	"\toptimizer\x18\x01 \x01(\tR\toptimizer\x121\n" +
+	"\x14function_evaluations\x18\x02 \x01(\x05R\x13functionEvaluations\x12#\n" +
+	"\renergy_change\x18\x03 \x01(\x01R\fenergyChange\x12\x1b\n" +
+	"\tstep_norm\x18\x04 \x01(\x01R\bstepNorm\x12\x1b\n" +
+	"\tstep_size\x18\x05 \x01(\x01R\bstepSize\"\xcb\x01\n" +
+	"\x0fBondScanRequest\x12\x1b\n" +
+	"\tpreset_id\x18\x01 \x01(\tR\bpresetId\x12%\n" +
+	"\x0estart_distance\x18\x02 \x01(\x01R\rstartDistance\x12!\n" +
+	"\fend_distance\x18\x03 \x01(\x01R\vendDistance\x12\x1d\n" +
+	"\n" +
+	"num_points\x18\x04 \x01(\x05R\tnumPoints\x122\n" +
+	"\x03vqe\x18\x05 \x01(\v2 .qubit_engine.physics.VQERequestR\x03vqe\"\x80\x02\n" +
+	"\rBondScanPoint\x12\x1a\n" +
+	"\bdistance\x18\x01 \x01(\x01R\bdistance\x12\x16\n" +
+	"\x06energy\x18\x02 \x01(\x01R\x06energy\x12.\n" +
+	"\x13hartree_fock_energy\x18\x03 \x01(\x01R\x11hartreeFockEnergy\x12-\n" +
+	"\x12correlation_energy\x18\x04 \x01(\x01R\x11correlationEnergy\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x05 \x01(\x05R\n" +
+	"iterations\x12\x1c\n" +
+	"\tconverged\x18\x06 \x01(\bR\tconverged\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\a \x03(\x01R\n" +
+	"parameters\"\xca\x02\n" +
+	"\x14AnsatzCircuitRequest\x12E\n" +
+	"\vhamiltonian\x18\x01 \x01(\v2!.qubit_engine.physics.HamiltonianH\x00R\vhamiltonian\x12B\n" +
+	"\bmolecule\x18\x02 \x01(\v2$.qubit_engine.physics.MoleculeConfigH\x00R\bmolecule\x128\n" +
+	"\x06ansatz\x18\x03 \x01(\x0e2 .qubit_engine.physics.AnsatzTypeR\x06ansatz\x12#\n" +
+	"\rnum_electrons\x18\x04 \x01(\x05R\fnumElectrons\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x05 \x03(\x01R\n" +
+	"parameters\x12\x1e\n" +
+	"\n" +
+	"trotterize\x18\x06 \x01(\bR\n" +
+	"trotterizeB\b\n" +
+	"\x06system\"\xdf\x03\n" +
+	"\x11AnsatzCircuitInfo\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x12\x1d\n" +
+	"\n" +
+	"num_params\x18\x02 \x01(\x05R\tnumParams\x12#\n" +
+	"\rnum_electrons\x18\x03 \x01(\x05R\fnumElectrons\x12'\n" +
+	"\x0freference_state\x18\x04 \x01(\tR\x0ereferenceState\x12B\n" +
+	"\vexcitations\x18\x05 \x03(\v2 .qubit_engine.physics.ExcitationR\vexcitations\x127\n" +
+	"\x05gates\x18\x06 \x03(\v2!.qubit_engine.physics.CircuitGateR\x05gates\x12X\n" +
+	"\vgate_counts\x18\a \x03(\v27.qubit_engine.physics.AnsatzCircuitInfo.GateCountsEntryR\n" +
+	"gateCounts\x12\x14\n" +
+	"\x05depth\x18\b \x01(\x05R\x05depth\x12\x12\n" +
+	"\x04qasm\x18\t \x01(\tR\x04qasm\x1a=\n" +
+	"\x0fGateCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"X\n" +
+	"\n" +
+	"Excitation\x12\x1a\n" +
+	"\boccupied\x18\x01 \x03(\x05R\boccupied\x12\x18\n" +
+	"\avirtual\x18\x02 \x03(\x05R\avirtual\x12\x14\n" +
+	"\x05param\x18\x03 \x01(\x05R\x05param\"e\n" +
+	"\vCircuitGate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06qubits\x18\x02 \x03(\x05R\x06qubits\x12\x14\n" +
+	"\x05param\x18\x03 \x01(\x05R\x05param\x12\x14\n" +
+	"\x05scale\x18\x04 \x01(\x01R\x05scale\"\x90\x01\n" +
+	"\x14ExcitedStatesRequest\x122\n" +
+	"\x03vqe\x18\x01 \x01(\v2 .qubit_engine.physics.VQERequestR\x03vqe\x12\x1d\n" +
+	"\n" +
+	"num_states\x18\x02 \x01(\x05R\tnumStates\x12%\n" +
+	"\x0eoverlap_weight\x18\x03 \x01(\x01R\roverlapWeight\"x\n" +
+	"\x13ExcitedStatesResult\x12:\n" +
+	"\x06states\x18\x01 \x03(\v2\".qubit_engine.physics.ExcitedStateR\x06states\x12%\n" +
+	"\x0eoverlap_weight\x18\x02 \x01(\x01R\roverlapWeight\"\x8c\x02\n" +
+	"\fExcitedState\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06energy\x18\x02 \x01(\x01R\x06energy\x12'\n" +
+	"\x0fenergy_variance\x18\x03 \x01(\x01R\x0eenergyVariance\x12+\n" +
+	"\x11excitation_energy\x18\x04 \x01(\x01R\x10excitationEnergy\x12\x1a\n" +
+	"\boverlaps\x18\x05 \x03(\x01R\boverlaps\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x06 \x03(\x01R\n" +
+	"parameters\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\a \x01(\x05R\n" +
+	"iterations\x12\x1c\n" +
+	"\tconverged\x18\b \x01(\bR\tconverged\"\xd7\x02\n" +
+	"\x12ExpectationRequest\x12C\n" +
+	"\vhamiltonian\x18\x01 \x01(\v2!.qubit_engine.physics.HamiltonianR\vhamiltonian\x12+\n" +
+	"\x11ansatz_parameters\x18\x02 \x03(\x01R\x10ansatzParameters\x128\n" +
+	"\x06ansatz\x18\x03 \x01(\x0e2 .qubit_engine.physics.AnsatzTypeR\x06ansatz\x12\x14\n" +
+	"\x05shots\x18\x04 \x01(\x05R\x05shots\x126\n" +
+	"\x05noise\x18\x05 \x01(\v2 .qubit_engine.physics.NoiseModelR\x05noise\x12G\n" +
+	"\n" +
+	"mitigation\x18\x06 \x01(\v2'.qubit_engine.physics.MitigationOptionsR\n" +
+	"mitigation\"\xa3\x01\n" +
+	"\n" +
+	"NoiseModel\x12\x1d\n" +
+	"\n" +
+	"gate_error\x18\x01 \x01(\x01R\tgateError\x12(\n" +
+	"\x10readout_error_01\x18\x02 \x01(\x01R\x0ereadoutError01\x12(\n" +
+	"\x10readout_error_10\x18\x03 \x01(\x01R\x0ereadoutError10\x12\"\n" +
+	"\ftrajectories\x18\x04 \x01(\x05R\ftrajectories\"\xc5\x01\n" +
+	"\x11MitigationOptions\x128\n" +
+	"\x18zero_noise_extrapolation\x18\x01 \x01(\bR\x16zeroNoiseExtrapolation\x12!\n" +
+	"\fnoise_scales\x18\x02 \x03(\x05R\vnoiseScales\x12$\n" +
+	"\rextrapolation\x18\x03 \x01(\tR\rextrapolation\x12-\n" +
+	"\x12readout_mitigation\x18\x04 \x01(\bR\x11readoutMitigation\"\x83\x02\n" +
+	"\x10MitigationResult\x12\x1d\n" +
+	"\n" +
+	"raw_energy\x18\x01 \x01(\x01R\trawEnergy\x12)\n" +
+	"\x10mitigated_energy\x18\x02 \x01(\x01R\x0fmitigatedEnergy\x12!\n" +
+	"\fnoise_scales\x18\x03 \x03(\x05R\vnoiseScales\x12'\n" +
+	"\x0fscaled_energies\x18\x04 \x03(\x01R\x0escaledEnergies\x12Y\n" +
+	"\x13readout_calibration\x18\x05 \x03(\v2(.qubit_engine.physics.ReadoutCalibrationR\x12readoutCalibration\"`\n" +
+	"\x12ReadoutCalibration\x12\x14\n" +
+	"\x05qubit\x18\x01 \x01(\x05R\x05qubit\x12\x19\n" +
+	"\berror_01\x18\x02 \x01(\x01R\aerror01\x12\x19\n" +
+	"\berror_10\x18\x03 \x01(\x01R\aerror10\"\xe6\x03\n" +
+	"\x11ExpectationResult\x12+\n" +
+	"\x11expectation_value\x18\x01 \x01(\x01R\x10expectationValue\x12\x1a\n" +
+	"\bvariance\x18\x02 \x01(\x01R\bvariance\x12\x1f\n" +
+	"\vtotal_shots\x18\x03 \x01(\x05R\n" +
+	"totalShots\x12m\n" +
+	"\x12term_contributions\x18\x04 \x03(\v2>.qubit_engine.physics.ExpectationResult.TermContributionsEntryR\x11termContributions\x12-\n" +
+	"\x12measurement_groups\x18\x05 \x01(\x05R\x11measurementGroups\x12F\n" +
+	"\n" +
+	"mitigation\x18\x06 \x01(\v2&.qubit_engine.physics.MitigationResultR\n" +
+	"mitigation\x12;\n" +
+	"\x05terms\x18\a \x03(\v2%.qubit_engine.physics.TermExpectationR\x05terms\x1aD\n" +
+	"\x16TermContributionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xd7\x01\n" +
+	"\x0fTermExpectation\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vcoefficient\x18\x03 \x01(\x01R\vcoefficient\x12 \n" +
+	"\vexpectation\x18\x04 \x01(\x01R\vexpectation\x12\"\n" +
+	"\fcontribution\x18\x05 \x01(\x01R\fcontribution\x12\x1a\n" +
+	"\bvariance\x18\x06 \x01(\x01R\bvariance\x12\x14\n" +
+	"\x05group\x18\a \x01(\x05R\x05group\"\xbe\x02\n" +
+	"\x17ExpectationBatchRequest\x12C\n" +
+	"\vhamiltonian\x18\x01 \x01(\v2!.qubit_engine.physics.HamiltonianR\vhamiltonian\x128\n" +
+	"\x06ansatz\x18\x02 \x01(\x0e2 .qubit_engine.physics.AnsatzTypeR\x06ansatz\x12I\n" +
+	"\x0eparameter_sets\x18\x03 \x03(\v2\".qubit_engine.physics.ParameterSetR\rparameterSets\x12C\n" +
+	"\vobservables\x18\x04 \x03(\v2!.qubit_engine.physics.HamiltonianR\vobservables\x12\x14\n" +
+	"\x05shots\x18\x05 \x01(\x05R\x05shots\"&\n" +
+	"\fParameterSet\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x01R\x06values\"Z\n" +
+	"\x16ExpectationBatchResult\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.qubit_engine.physics.BatchExpectationR\aresults\"\xd0\x01\n" +
+	"\x10BatchExpectation\x12'\n" +
+	"\x0fparameter_index\x18\x01 \x01(\x05R\x0eparameterIndex\x12)\n" +
+	"\x10observable_index\x18\x02 \x01(\x05R\x0fobservableIndex\x12+\n" +
+	"\x11expectation_value\x18\x03 \x01(\x01R\x10expectationValue\x12\x1a\n" +
+	"\bvariance\x18\x04 \x01(\x01R\bvariance\x12\x1f\n" +
+	"\vtotal_shots\x18\x05 \x01(\x05R\n" +
+	"totalShots\"\xd1\x02\n" +
+	"\x14TimeEvolutionRequest\x12C\n" +
+	"\vhamiltonian\x18\x01 \x01(\v2!.qubit_engine.physics.HamiltonianR\vhamiltonian\x12#\n" +
+	"\rinitial_state\x18\x02 \x01(\tR\finitialState\x12\x1d\n" +
+	"\n" +
+	"total_time\x18\x03 \x01(\x01R\ttotalTime\x12#\n" +
+	"\rtrotter_steps\x18\x04 \x01(\x05R\ftrotterSteps\x12#\n" +
+	"\rtrotter_order\x18\x05 \x01(\x05R\ftrotterOrder\x12C\n" +
+	"\vobservables\x18\x06 \x03(\v2!.qubit_engine.physics.HamiltonianR\vobservables\x12!\n" +
+	"\freport_every\x18\a \x01(\x05R\vreportEvery\"\xf1\x01\n" +
+	"\x12TimeEvolutionFrame\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x01R\x04time\x12\x16\n" +
+	"\x06energy\x18\x03 \x01(\x01R\x06energy\x12-\n" +
+	"\x12return_probability\x18\x04 \x01(\x01R\x11returnProbability\x12$\n" +
+	"\rmagnetization\x18\x05 \x03(\x01R\rmagnetization\x12 \n" +
+	"\vobservables\x18\x06 \x03(\x01R\vobservables\x12$\n" +
+	"\x0egates_per_step\x18\a \x01(\x05R\fgatesPerStep\"Q\n" +
+	"\x0fMoleculeLibrary\x12>\n" +
+	"\apresets\x18\x01 \x03(\v2$.qubit_engine.physics.MoleculePresetR\apresets\"\xd9\x01\n" +
+	"\x0eMoleculePreset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aformula\x18\x03 \x01(\tR\aformula\x12<\n" +
+	"\x06config\x18\x04 \x01(\v2$.qubit_engine.physics.MoleculeConfigR\x06config\x12)\n" +
+	"\x10reference_energy\x18\x05 \x01(\x01R\x0freferenceEnergy\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription*^\n" +
+	"\rSpinModelType\x12\x1f\n" +
+	"\x1bSPIN_MODEL_TRANSVERSE_ISING\x10\x00\x12\x19\n" +
+	"\x15SPIN_MODEL_HEISENBERG\x10\x01\x12\x11\n" +
+	"\rSPIN_MODEL_XY\x10\x02*?\n" +
+	"\tPauliType\x12\v\n" +
+	"\aPAULI_I\x10\x00\x12\v\n" +
+	"\aPAULI_X\x10\x01\x12\v\n" +
+	"\aPAULI_Y\x10\x02\x12\v\n" +
+	"\aPAULI_Z\x10\x03*L\n" +
+	"\n" +
+	"AnsatzType\x12\x10\n" +
+	"\fANSATZ_UCCSD\x10\x00\x12\x1d\n" +
+	"\x19ANSATZ_HARDWARE_EFFICIENT\x10\x01\x12\r\n" +
+	"\tANSATZ_RY\x10\x02*\x9d\x01\n" +
+	"\rOptimizerType\x12\x14\n" +
+	"\x10OPTIMIZER_COBYLA\x10\x00\x12\x12\n" +
+	"\x0eOPTIMIZER_SPSA\x10\x01\x12\x12\n" +
+	"\x0eOPTIMIZER_ADAM\x10\x02\x12\x1e\n" +
+	"\x1aOPTIMIZER_GRADIENT_DESCENT\x10\x03\x12\x19\n" +
+	"\x15OPTIMIZER_NELDER_MEAD\x10\x04\x12\x13\n" +
+	"\x0fOPTIMIZER_LBFGS\x10\x052\xea\b\n" +
+	"\tVQESolver\x12Y\n" +
+	"\x0fFindGroundState\x12 .qubit_engine.physics.VQERequest\x1a\".qubit_engine.physics.VQEIteration0\x01\x12X\n" +
+	"\x12GetMoleculeLibrary\x12\x1b.qubit_engine.physics.Empty\x1a%.qubit_engine.physics.MoleculeLibrary\x12[\n" +
+	"\x10BuildHamiltonian\x12$.qubit_engine.physics.MoleculeConfig\x1a!.qubit_engine.physics.Hamiltonian\x12f\n" +
+	"\x11ImportHamiltonian\x12..qubit_engine.physics.ImportHamiltonianRequest\x1a!.qubit_engine.physics.Hamiltonian\x12Z\n" +
+	"\x0eBuildSpinModel\x12%.qubit_engine.physics.SpinModelConfig\x1a!.qubit_engine.physics.Hamiltonian\x12h\n" +
+	"\x13EvaluateExpectation\x12(.qubit_engine.physics.ExpectationRequest\x1a'.qubit_engine.physics.ExpectationResult\x12w\n" +
+	"\x18EvaluateExpectationBatch\x12-.qubit_engine.physics.ExpectationBatchRequest\x1a,.qubit_engine.physics.ExpectationBatchResult\x12^\n" +
+	"\x0eScanBondLength\x12%.qubit_engine.physics.BondScanRequest\x1a#.qubit_engine.physics.BondScanPoint0\x01\x12g\n" +
+	"\x10GetAnsatzCircuit\x12*.qubit_engine.physics.AnsatzCircuitRequest\x1a'.qubit_engine.physics.AnsatzCircuitInfo\x12j\n" +
+	"\x11FindExcitedStates\x12*.qubit_engine.physics.ExcitedStatesRequest\x1a).qubit_engine.physics.ExcitedStatesResult\x12o\n" +
+	"\x15SimulateTimeEvolution\x12*.qubit_engine.physics.TimeEvolutionRequest\x1a(.qubit_engine.physics.TimeEvolutionFrame0\x01B:Z8github.com/perclft/QubitEngine/modules/physics/generatedb\x06proto3"
+
+var (
+	file_api_proto_physics_vqe_proto_rawDescOnce sync.Once
+	file_api_proto_physics_vqe_proto_rawDescData []byte
+)
+
+func file_api_proto_physics_vqe_proto_rawDescGZIP() []byte {
+	file_api_proto_physics_vqe_proto_rawDescOnce.Do(func() {
+		file_api_proto_physics_vqe_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_physics_vqe_proto_rawDesc), len(file_api_proto_physics_vqe_proto_rawDesc)))
+	})
+	return file_api_proto_physics_vqe_proto_rawDescData
+}
+
+var file_api_proto_physics_vqe_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_proto_physics_vqe_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_api_proto_physics_vqe_proto_goTypes = []any{
+	(SpinModelType)(0),               // 0: qubit_engine.physics.SpinModelType
+	(PauliType)(0),                   // 1: qubit_engine.physics.PauliType
+	(AnsatzType)(0),                  // 2: qubit_engine.physics.AnsatzType
+	(OptimizerType)(0),               // 3: qubit_engine.physics.OptimizerType
+	(*Empty)(nil),                    // 4: qubit_engine.physics.Empty
+	(*MoleculeConfig)(nil),           // 5: qubit_engine.physics.MoleculeConfig
+	(*ElectronicIntegrals)(nil),      // 6: qubit_engine.physics.ElectronicIntegrals
+	(*Atom)(nil),                     // 7: qubit_engine.physics.Atom
+	(*SpinModelConfig)(nil),          // 8: qubit_engine.physics.SpinModelConfig
+	(*Hamiltonian)(nil),              // 9: qubit_engine.physics.Hamiltonian
+	(*MeasurementGroup)(nil),         // 10: qubit_engine.physics.MeasurementGroup
+	(*ImportHamiltonianRequest)(nil), // 11: qubit_engine.physics.ImportHamiltonianRequest
+	(*PauliTerm)(nil),                // 12: qubit_engine.physics.PauliTerm
+	(*PauliOperator)(nil),            // 13: qubit_engine.physics.PauliOperator
+	(*VQERequest)(nil),               // 14: qubit_engine.physics.VQERequest
+	(*CobylaOptions)(nil),            // 15: qubit_engine.physics.CobylaOptions
+	(*SPSAOptions)(nil),              // 16: qubit_engine.physics.SPSAOptions
+	(*NelderMeadOptions)(nil),        // 17: qubit_engine.physics.NelderMeadOptions
+	(*AdamOptions)(nil),              // 18: qubit_engine.physics.AdamOptions
+	(*LBFGSOptions)(nil),             // 19: qubit_engine.physics.LBFGSOptions
+	(*GradientDescentOptions)(nil),   // 20: qubit_engine.physics.GradientDescentOptions
+	(*VQEIteration)(nil),             // 21: qubit_engine.physics.VQEIteration
+	(*OptimizerDiagnostics)(nil),     // 22: qubit_engine.physics.OptimizerDiagnostics
+	(*BondScanRequest)(nil),          // 23: qubit_engine.physics.BondScanRequest
+	(*BondScanPoint)(nil),            // 24: qubit_engine.physics.BondScanPoint
+	(*AnsatzCircuitRequest)(nil),     // 25: qubit_engine.physics.AnsatzCircuitRequest
+	(*AnsatzCircuitInfo)(nil),        // 26: qubit_engine.physics.AnsatzCircuitInfo
+	(*Excitation)(nil),               // 27: qubit_engine.physics.Excitation
+	(*CircuitGate)(nil),              // 28: qubit_engine.physics.CircuitGate
+	(*ExcitedStatesRequest)(nil),     // 29: qubit_engine.physics.ExcitedStatesRequest
+	(*ExcitedStatesResult)(nil),      // 30: qubit_engine.physics.ExcitedStatesResult
+	(*ExcitedState)(nil),             // 31: qubit_engine.physics.ExcitedState
+	(*ExpectationRequest)(nil),       // 32: qubit_engine.physics.ExpectationRequest
+	(*NoiseModel)(nil),               // 33: qubit_engine.physics.NoiseModel
+	(*MitigationOptions)(nil),        // 34: qubit_engine.physics.MitigationOptions
+	(*MitigationResult)(nil),         // 35: qubit_engine.physics.MitigationResult
+	(*ReadoutCalibration)(nil),       // 36: qubit_engine.physics.ReadoutCalibration
+	(*ExpectationResult)(nil),        // 37: qubit_engine.physics.ExpectationResult
+	(*TermExpectation)(nil),          // 38: qubit_engine.physics.TermExpectation
+	(*ExpectationBatchRequest)(nil),  // 39: qubit_engine.physics.ExpectationBatchRequest
+	(*ParameterSet)(nil),             // 40: qubit_engine.physics.ParameterSet
+	(*ExpectationBatchResult)(nil),   // 41: qubit_engine.physics.ExpectationBatchResult
+	(*BatchExpectation)(nil),         // 42: qubit_engine.physics.BatchExpectation
+	(*TimeEvolutionRequest)(nil),     // 43: qubit_engine.physics.TimeEvolutionRequest
+	(*TimeEvolutionFrame)(nil),       // 44: qubit_engine.physics.TimeEvolutionFrame
+	(*MoleculeLibrary)(nil),          // 45: qubit_engine.physics.MoleculeLibrary
+	(*MoleculePreset)(nil),           // 46: qubit_engine.physics.MoleculePreset
+	nil,                              // 47: qubit_engine.physics.AnsatzCircuitInfo.GateCountsEntry
+	nil,                              // 48: qubit_engine.physics.ExpectationResult.TermContributionsEntry
+}
+var file_api_proto_physics_vqe_proto_depIdxs = []int32{
+	7,  // 0: qubit_engine.physics.MoleculeConfig.atoms:type_name -> qubit_engine.physics.Atom
+	0,  // 1: qubit_engine.physics.SpinModelConfig.model:type_name -> qubit_engine.physics.SpinModelType
+	12, // 2: qubit_engine.physics.Hamiltonian.terms:type_name -> qubit_engine.physics.PauliTerm
+	10, // 3: qubit_engine.physics.Hamiltonian.groups:type_name -> qubit_engine.physics.MeasurementGroup
+	13, // 4: qubit_engine.physics.MeasurementGroup.basis:type_name -> qubit_engine.physics.PauliOperator
+	13, // 5: qubit_engine.physics.PauliTerm.operators:type_name -> qubit_engine.physics.PauliOperator
+	1,  // 6: qubit_engine.physics.PauliOperator.type:type_name -> qubit_engine.physics.PauliType
+	5,  // 7: qubit_engine.physics.VQERequest.molecule:type_name -> qubit_engine.physics.MoleculeConfig
+	9,  // 8: qubit_engine.physics.VQERequest.hamiltonian:type_name -> qubit_engine.physics.Hamiltonian
+	8,  // 9: qubit_engine.physics.VQERequest.spin_model:type_name -> qubit_engine.physics.SpinModelConfig
+	2,  // 10: qubit_engine.physics.VQERequest.ansatz:type_name -> qubit_engine.physics.AnsatzType
+	3,  // 11: qubit_engine.physics.VQERequest.optimizer:type_name -> qubit_engine.physics.OptimizerType
+	15, // 12: qubit_engine.physics.VQERequest.cobyla:type_name -> qubit_engine.physics.CobylaOptions
+	16, // 13: qubit_engine.physics.VQERequest.spsa:type_name -> qubit_engine.physics.SPSAOptions
+	17, // 14: qubit_engine.physics.VQERequest.nelder_mead:type_name -> qubit_engine.physics.NelderMeadOptions
+	18, // 15: qubit_engine.physics.VQERequest.adam:type_name -> qubit_engine.physics.AdamOptions
+	19, // 16: qubit_engine.physics.VQERequest.lbfgs:type_name -> qubit_engine.physics.LBFGSOptions
+	20, // 17: qubit_engine.physics.VQERequest.gradient_descent:type_name -> qubit_engine.physics.GradientDescentOptions
+	22, // 18: qubit_engine.physics.VQEIteration.diagnostics:type_name -> qubit_engine.physics.OptimizerDiagnostics
+	14, // 19: qubit_engine.physics.BondScanRequest.vqe:type_name -> qubit_engine.physics.VQERequest
+	9,  // 20: qubit_engine.physics.AnsatzCircuitRequest.hamiltonian:type_name -> qubit_engine.physics.Hamiltonian
+	5,  // 21: qubit_engine.physics.AnsatzCircuitRequest.molecule:type_name -> qubit_engine.physics.MoleculeConfig
+	2,  // 22: qubit_engine.physics.AnsatzCircuitRequest.ansatz:type_name -> qubit_engine.physics.AnsatzType
+	27, // 23: qubit_engine.physics.AnsatzCircuitInfo.excitations:type_name -> qubit_engine.physics.Excitation
+	28, // 24: qubit_engine.physics.AnsatzCircuitInfo.gates:type_name -> qubit_engine.physics.CircuitGate
+	47, // 25: qubit_engine.physics.AnsatzCircuitInfo.gate_counts:type_name -> qubit_engine.physics.AnsatzCircuitInfo.GateCountsEntry
+	14, // 26: qubit_engine.physics.ExcitedStatesRequest.vqe:type_name -> qubit_engine.physics.VQERequest
+	31, // 27: qubit_engine.physics.ExcitedStatesResult.states:type_name -> qubit_engine.physics.ExcitedState
+	9,  // 28: qubit_engine.physics.ExpectationRequest.hamiltonian:type_name -> qubit_engine.physics.Hamiltonian
+	2,  // 29: qubit_engine.physics.ExpectationRequest.ansatz:type_name -> qubit_engine.physics.AnsatzType
+	33, // 30: qubit_engine.physics.ExpectationRequest.noise:type_name -> qubit_engine.physics.NoiseModel
+	34, // 31: qubit_engine.physics.ExpectationRequest.mitigation:type_name -> qubit_engine.physics.MitigationOptions
+	36, // 32: qubit_engine.physics.MitigationResult.readout_calibration:type_name -> qubit_engine.physics.ReadoutCalibration
+	48, // 33: qubit_engine.physics.ExpectationResult.term_contributions:type_name -> qubit_engine.physics.ExpectationResult.TermContributionsEntry
+	35, // 34: qubit_engine.physics.ExpectationResult.mitigation:type_name -> qubit_engine.physics.MitigationResult
+	38, // 35: qubit_engine.physics.ExpectationResult.terms:type_name -> qubit_engine.physics.TermExpectation
+	9,  // 36: qubit_engine.physics.ExpectationBatchRequest.hamiltonian:type_name -> qubit_engine.physics.Hamiltonian
+	2,  // 37: qubit_engine.physics.ExpectationBatchRequest.ansatz:type_name -> qubit_engine.physics.AnsatzType
+	40, // 38: qubit_engine.physics.ExpectationBatchRequest.parameter_sets:type_name -> qubit_engine.physics.ParameterSet
+	9,  // 39: qubit_engine.physics.ExpectationBatchRequest.observables:type_name -> qubit_engine.physics.Hamiltonian
+	42, // 40: qubit_engine.physics.ExpectationBatchResult.results:type_name -> qubit_engine.physics.BatchExpectation
+	9,  // 41: qubit_engine.physics.TimeEvolutionRequest.hamiltonian:type_name -> qubit_engine.physics.Hamiltonian
+	9,  // 42: qubit_engine.physics.TimeEvolutionRequest.observables:type_name -> qubit_engine.physics.Hamiltonian
+	46, // 43: qubit_engine.physics.MoleculeLibrary.presets:type_name -> qubit_engine.physics.MoleculePreset
+	5,  // 44: qubit_engine.physics.MoleculePreset.config:type_name -> qubit_engine.physics.MoleculeConfig
+	14, // 45: qubit_engine.physics.VQESolver.FindGroundState:input_type -> qubit_engine.physics.VQERequest
+	4,  // 46: qubit_engine.physics.VQESolver.GetMoleculeLibrary:input_type -> qubit_engine.physics.Empty
+	5,  // 47: qubit_engine.physics.VQESolver.BuildHamiltonian:input_type -> qubit_engine.physics.MoleculeConfig
+	11, // 48: qubit_engine.physics.VQESolver.ImportHamiltonian:input_type -> qubit_engine.physics.ImportHamiltonianRequest
+	8,  // 49: qubit_engine.physics.VQESolver.BuildSpinModel:input_type -> qubit_engine.physics.SpinModelConfig
+	32, // 50: qubit_engine.physics.VQESolver.EvaluateExpectation:input_type -> qubit_engine.physics.ExpectationRequest
+	39, // 51: qubit_engine.physics.VQESolver.EvaluateExpectationBatch:input_type -> qubit_engine.physics.ExpectationBatchRequest
+	23, // 52: qubit_engine.physics.VQESolver.ScanBondLength:input_type -> qubit_engine.physics.BondScanRequest
+	25, // 53: qubit_engine.physics.VQESolver.GetAnsatzCircuit:input_type -> qubit_engine.physics.AnsatzCircuitRequest
+	29, // 54: qubit_engine.physics.VQESolver.FindExcitedStates:input_type -> qubit_engine.physics.ExcitedStatesRequest
+	43, // 55: qubit_engine.physics.VQESolver.SimulateTimeEvolution:input_type -> qubit_engine.physics.TimeEvolutionRequest
+	21, // 56: qubit_engine.physics.VQESolver.FindGroundState:output_type -> qubit_engine.physics.VQEIteration
+	45, // 57: qubit_engine.physics.VQESolver.GetMoleculeLibrary:output_type -> qubit_engine.physics.MoleculeLibrary
+	9,  // 58: qubit_engine.physics.VQESolver.BuildHamiltonian:output_type -> qubit_engine.physics.Hamiltonian
+	9,  // 59: qubit_engine.physics.VQESolver.ImportHamiltonian:output_type -> qubit_engine.physics.Hamiltonian
+	9,  // 60: qubit_engine.physics.VQESolver.BuildSpinModel:output_type -> qubit_engine.physics.Hamiltonian
+	37, // 61: qubit_engine.physics.VQESolver.EvaluateExpectation:output_type -> qubit_engine.physics.ExpectationResult
+	41, // 62: qubit_engine.physics.VQESolver.EvaluateExpectationBatch:output_type -> qubit_engine.physics.ExpectationBatchResult
+	24, // 63: qubit_engine.physics.VQESolver.ScanBondLength:output_type -> qubit_engine.physics.BondScanPoint
+	26, // 64: qubit_engine.physics.VQESolver.GetAnsatzCircuit:output_type -> qubit_engine.physics.AnsatzCircuitInfo
+	30, // 65: qubit_engine.physics.VQESolver.FindExcitedStates:output_type -> qubit_engine.physics.ExcitedStatesResult
+	44, // 66: qubit_engine.physics.VQESolver.SimulateTimeEvolution:output_type -> qubit_engine.physics.TimeEvolutionFrame
+	56, // [56:67] is the sub-list for method output_type
+	45, // [45:56] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_physics_vqe_proto_init() }
+func file_api_proto_physics_vqe_proto_init() {
+	if File_api_proto_physics_vqe_proto != nil {
+		return
+	}
+	file_api_proto_physics_vqe_proto_msgTypes[10].OneofWrappers = []any{
+		(*VQERequest_Molecule)(nil),
+		(*VQERequest_Hamiltonian)(nil),
+		(*VQERequest_SpinModel)(nil),
+	}
+	file_api_proto_physics_vqe_proto_msgTypes[21].OneofWrappers = []any{
+		(*AnsatzCircuitRequest_Hamiltonian)(nil),
+		(*AnsatzCircuitRequest_Molecule)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_physics_vqe_proto_rawDesc), len(file_api_proto_physics_vqe_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_physics_vqe_proto_goTypes,
+		DependencyIndexes: file_api_proto_physics_vqe_proto_depIdxs,
+		EnumInfos:         file_api_proto_physics_vqe_proto_enumTypes,
+		MessageInfos:      file_api_proto_physics_vqe_proto_msgTypes,
+	}.Build()
+	File_api_proto_physics_vqe_proto = out.File
+	file_api_proto_physics_vqe_proto_goTypes = nil
+	file_api_proto_physics_vqe_proto_depIdxs = nil
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.11
-// 	protoc        v6.33.1
+// 	protoc        v6.33.0
 // source: quantum.proto
 
 package generated
@@ -181,7 +181,7 @@ func (x VQERequest_Molecule) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VQERequest_Molecule.Descriptor instead.
 func (VQERequest_Molecule) EnumDescriptor() ([]byte, []int) {
-	return file_quantum_proto_rawDescGZIP(), []int{4, 0}
+	return file_quantum_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type VQERequest_OptimizerType int32
@@ -227,7 +227,7 @@ func (x VQERequest_OptimizerType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VQERequest_OptimizerType.Descriptor instead.
 func (VQERequest_OptimizerType) EnumDescriptor() ([]byte, []int) {
-	return file_quantum_proto_rawDescGZIP(), []int{4, 1}
+	return file_quantum_proto_rawDescGZIP(), []int{5, 1}
 }
 
 type CircuitRequest struct {
@@ -237,8 +237,11 @@ type CircuitRequest struct {
 	// Probability of a depolarizing error occurring per step (0.0 - 1.0)
 	NoiseProbability float64                         `protobuf:"fixed64,3,opt,name=noise_probability,json=noiseProbability,proto3" json:"noise_probability,omitempty"`
 	ExecutionBackend CircuitRequest_ExecutionBackend `protobuf:"varint,4,opt,name=execution_backend,json=executionBackend,proto3,enum=qubit_engine.CircuitRequest_ExecutionBackend" json:"execution_backend,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Deterministic mode: a non-zero seed fixes the measurement and noise RNG,
+	// so the same seed + circuit always yields the same classical results.
+	Seed          uint64 `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CircuitRequest) Reset() {
@@ -299,6 +302,13 @@ func (x *CircuitRequest) GetExecutionBackend() CircuitRequest_ExecutionBackend {
 	return CircuitRequest_SIMULATOR
 }
 
+func (x *CircuitRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
 type GateOperation struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	Type         GateOperation_GateType `protobuf:"varint,1,opt,name=type,proto3,enum=qubit_engine.GateOperation_GateType" json:"type,omitempty"`
@@ -449,6 +459,102 @@ func (x *StateResponse) GetServerId() string {
 	return ""
 }
 
+// What running a circuit on this server would take
+type ResourceEstimate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	NumQubits int32                  `protobuf:"varint,1,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	GateCount uint32                 `protobuf:"varint,2,opt,name=gate_count,json=gateCount,proto3" json:"gate_count,omitempty"`
+	// Layers of gates that can run at once
+	Depth uint32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// State vector memory: 2^num_qubits * 16 bytes
+	MemoryBytes        uint64  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	EstimatedRuntimeMs float64 `protobuf:"fixed64,5,opt,name=estimated_runtime_ms,json=estimatedRuntimeMs,proto3" json:"estimated_runtime_ms,omitempty"`
+	// Whether the server has the free memory for the state vector right now
+	FitsInMemory  bool   `protobuf:"varint,6,opt,name=fits_in_memory,json=fitsInMemory,proto3" json:"fits_in_memory,omitempty"`
+	ServerId      string `protobuf:"bytes,7,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceEstimate) Reset() {
+	*x = ResourceEstimate{}
+	mi := &file_quantum_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceEstimate) ProtoMessage() {}
+
+func (x *ResourceEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_quantum_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceEstimate.ProtoReflect.Descriptor instead.
+func (*ResourceEstimate) Descriptor() ([]byte, []int) {
+	return file_quantum_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceEstimate) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetGateCount() uint32 {
+	if x != nil {
+		return x.GateCount
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetEstimatedRuntimeMs() float64 {
+	if x != nil {
+		return x.EstimatedRuntimeMs
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetFitsInMemory() bool {
+	if x != nil {
+		return x.FitsInMemory
+	}
+	return false
+}
+
+func (x *ResourceEstimate) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
 type Measurement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QubitIndex    uint32                 `protobuf:"varint,1,opt,name=qubit_index,json=qubitIndex,proto3" json:"qubit_index,omitempty"`
@@ -460,7 +566,7 @@ type Measurement struct {
 
 func (x *Measurement) Reset() {
 	*x = Measurement{}
-	mi := &file_quantum_proto_msgTypes[3]
+	mi := &file_quantum_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +578,7 @@ func (x *Measurement) String() string {
 func (*Measurement) ProtoMessage() {}
 
 func (x *Measurement) ProtoReflect() protoreflect.Message {
-	mi := &file_quantum_proto_msgTypes[3]
+	mi := &file_quantum_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +591,7 @@ func (x *Measurement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Measurement.ProtoReflect.Descriptor instead.
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return file_quantum_proto_rawDescGZIP(), []int{3}
+	return file_quantum_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Measurement) GetQubitIndex() uint32 {
@@ -521,7 +627,7 @@ type VQERequest struct {
 
 func (x *VQERequest) Reset() {
 	*x = VQERequest{}
-	mi := &file_quantum_proto_msgTypes[4]
+	mi := &file_quantum_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +639,7 @@ func (x *VQERequest) String() string {
 func (*VQERequest) ProtoMessage() {}
 
 func (x *VQERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_quantum_proto_msgTypes[4]
+	mi := &file_quantum_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +652,7 @@ func (x *VQERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VQERequest.ProtoReflect.Descriptor instead.
 func (*VQERequest) Descriptor() ([]byte, []int) {
-	return file_quantum_proto_rawDescGZIP(), []int{4}
+	return file_quantum_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *VQERequest) GetMolecule() VQERequest_Molecule {
@@ -589,7 +695,7 @@ type VQEResponse struct {
 
 func (x *VQEResponse) Reset() {
 	*x = VQEResponse{}
-	mi := &file_quantum_proto_msgTypes[5]
+	mi := &file_quantum_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +707,7 @@ func (x *VQEResponse) String() string {
 func (*VQEResponse) ProtoMessage() {}
 
 func (x *VQEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_quantum_proto_msgTypes[5]
+	mi := &file_quantum_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +720,7 @@ func (x *VQEResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VQEResponse.ProtoReflect.Descriptor instead.
 func (*VQEResponse) Descriptor() ([]byte, []int) {
-	return file_quantum_proto_rawDescGZIP(), []int{5}
+	return file_quantum_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *VQEResponse) GetIteration() int32 {
@@ -656,7 +762,7 @@ type StateResponse_ComplexNumber struct {
 
 func (x *StateResponse_ComplexNumber) Reset() {
 	*x = StateResponse_ComplexNumber{}
-	mi := &file_quantum_proto_msgTypes[6]
+	mi := &file_quantum_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -668,7 +774,7 @@ func (x *StateResponse_ComplexNumber) String() string {
 func (*StateResponse_ComplexNumber) ProtoMessage() {}
 
 func (x *StateResponse_ComplexNumber) ProtoReflect() protoreflect.Message {
-	mi := &file_quantum_proto_msgTypes[6]
+	mi := &file_quantum_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +808,7 @@ var File_quantum_proto protoreflect.FileDescriptor
 
 const file_quantum_proto_rawDesc = "" +
 	"\n" +
-	"\rquantum.proto\x12\fqubit_engine\"\xbb\x02\n" +
+	"\rquantum.proto\x12\fqubit_engine\"\xcf\x02\n" +
 	"\x0eCircuitRequest\x12\x1d\n" +
 	"\n" +
 	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x12;\n" +
@@ -710,7 +816,8 @@ const file_quantum_proto_rawDesc = "" +
 	"operations\x18\x02 \x03(\v2\x1b.qubit_engine.GateOperationR\n" +
 	"operations\x12+\n" +
 	"\x11noise_probability\x18\x03 \x01(\x01R\x10noiseProbability\x12Z\n" +
-	"\x11execution_backend\x18\x04 \x01(\x0e2-.qubit_engine.CircuitRequest.ExecutionBackendR\x10executionBackend\"D\n" +
+	"\x11execution_backend\x18\x04 \x01(\x0e2-.qubit_engine.CircuitRequest.ExecutionBackendR\x10executionBackend\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\x04R\x04seed\"D\n" +
 	"\x10ExecutionBackend\x12\r\n" +
 	"\tSIMULATOR\x10\x00\x12\x11\n" +
 	"\rMOCK_HARDWARE\x10\x01\x12\x0e\n" +
@@ -744,7 +851,17 @@ const file_quantum_proto_rawDesc = "" +
 	"\x04imag\x18\x02 \x01(\x01R\x04imag\x1aC\n" +
 	"\x15ClassicalResultsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"h\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xfe\x01\n" +
+	"\x10ResourceEstimate\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x12\x1d\n" +
+	"\n" +
+	"gate_count\x18\x02 \x01(\rR\tgateCount\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\rR\x05depth\x12!\n" +
+	"\fmemory_bytes\x18\x04 \x01(\x04R\vmemoryBytes\x120\n" +
+	"\x14estimated_runtime_ms\x18\x05 \x01(\x01R\x12estimatedRuntimeMs\x12$\n" +
+	"\x0efits_in_memory\x18\x06 \x01(\bR\ffitsInMemory\x12\x1b\n" +
+	"\tserver_id\x18\a \x01(\tR\bserverId\"h\n" +
 	"\vMeasurement\x12\x1f\n" +
 	"\vqubit_index\x18\x01 \x01(\rR\n" +
 	"qubitIndex\x12\x16\n" +
@@ -768,13 +885,14 @@ const file_quantum_proto_rawDesc = "" +
 	"\n" +
 	"parameters\x18\x03 \x03(\x01R\n" +
 	"parameters\x12\x1c\n" +
-	"\tconverged\x18\x04 \x01(\bR\tconverged2\xc0\x02\n" +
+	"\tconverged\x18\x04 \x01(\bR\tconverged2\x95\x03\n" +
 	"\x0eQuantumCompute\x12I\n" +
 	"\n" +
 	"RunCircuit\x12\x1c.qubit_engine.CircuitRequest\x1a\x1b.qubit_engine.StateResponse\"\x00\x12M\n" +
 	"\vStreamGates\x12\x1b.qubit_engine.GateOperation\x1a\x1b.qubit_engine.StateResponse\"\x00(\x010\x01\x12Q\n" +
 	"\x10VisualizeCircuit\x12\x1c.qubit_engine.CircuitRequest\x1a\x1b.qubit_engine.StateResponse\"\x000\x01\x12A\n" +
-	"\x06RunVQE\x12\x18.qubit_engine.VQERequest\x1a\x19.qubit_engine.VQEResponse\"\x000\x01BU\n" +
+	"\x06RunVQE\x12\x18.qubit_engine.VQERequest\x1a\x19.qubit_engine.VQEResponse\"\x000\x01\x12S\n" +
+	"\x11EstimateResources\x12\x1c.qubit_engine.CircuitRequest\x1a\x1e.qubit_engine.ResourceEstimate\"\x00BU\n" +
 	"\x17com.perclft.qubitengineP\x01Z5github.com/perclft/QubitEngine/cli/internal/generated\xf8\x01\x01b\x06proto3"
 
 var (
@@ -790,7 +908,7 @@ func file_quantum_proto_rawDescGZIP() []byte {
 }
 
 var file_quantum_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_quantum_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_quantum_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_quantum_proto_goTypes = []any{
 	(CircuitRequest_ExecutionBackend)(0), // 0: qubit_engine.CircuitRequest.ExecutionBackend
 	(GateOperation_GateType)(0),          // 1: qubit_engine.GateOperation.GateType
@@ -799,30 +917,33 @@ var file_quantum_proto_goTypes = []any{
 	(*CircuitRequest)(nil),               // 4: qubit_engine.CircuitRequest
 	(*GateOperation)(nil),                // 5: qubit_engine.GateOperation
 	(*StateResponse)(nil),                // 6: qubit_engine.StateResponse
-	(*Measurement)(nil),                  // 7: qubit_engine.Measurement
-	(*VQERequest)(nil),                   // 8: qubit_engine.VQERequest
-	(*VQEResponse)(nil),                  // 9: qubit_engine.VQEResponse
-	(*StateResponse_ComplexNumber)(nil),  // 10: qubit_engine.StateResponse.ComplexNumber
-	nil,                                  // 11: qubit_engine.StateResponse.ClassicalResultsEntry
+	(*ResourceEstimate)(nil),             // 7: qubit_engine.ResourceEstimate
+	(*Measurement)(nil),                  // 8: qubit_engine.Measurement
+	(*VQERequest)(nil),                   // 9: qubit_engine.VQERequest
+	(*VQEResponse)(nil),                  // 10: qubit_engine.VQEResponse
+	(*StateResponse_ComplexNumber)(nil),  // 11: qubit_engine.StateResponse.ComplexNumber
+	nil,                                  // 12: qubit_engine.StateResponse.ClassicalResultsEntry
 }
 var file_quantum_proto_depIdxs = []int32{
 	5,  // 0: qubit_engine.CircuitRequest.operations:type_name -> qubit_engine.GateOperation
 	0,  // 1: qubit_engine.CircuitRequest.execution_backend:type_name -> qubit_engine.CircuitRequest.ExecutionBackend
 	1,  // 2: qubit_engine.GateOperation.type:type_name -> qubit_engine.GateOperation.GateType
-	10, // 3: qubit_engine.StateResponse.state_vector:type_name -> qubit_engine.StateResponse.ComplexNumber
-	11, // 4: qubit_engine.StateResponse.classical_results:type_name -> qubit_engine.StateResponse.ClassicalResultsEntry
+	11, // 3: qubit_engine.StateResponse.state_vector:type_name -> qubit_engine.StateResponse.ComplexNumber
+	12, // 4: qubit_engine.StateResponse.classical_results:type_name -> qubit_engine.StateResponse.ClassicalResultsEntry
 	2,  // 5: qubit_engine.VQERequest.molecule:type_name -> qubit_engine.VQERequest.Molecule
 	3,  // 6: qubit_engine.VQERequest.optimizer_type:type_name -> qubit_engine.VQERequest.OptimizerType
 	4,  // 7: qubit_engine.QuantumCompute.RunCircuit:input_type -> qubit_engine.CircuitRequest
 	5,  // 8: qubit_engine.QuantumCompute.StreamGates:input_type -> qubit_engine.GateOperation
 	4,  // 9: qubit_engine.QuantumCompute.VisualizeCircuit:input_type -> qubit_engine.CircuitRequest
-	8,  // 10: qubit_engine.QuantumCompute.RunVQE:input_type -> qubit_engine.VQERequest
-	6,  // 11: qubit_engine.QuantumCompute.RunCircuit:output_type -> qubit_engine.StateResponse
-	6,  // 12: qubit_engine.QuantumCompute.StreamGates:output_type -> qubit_engine.StateResponse
-	6,  // 13: qubit_engine.QuantumCompute.VisualizeCircuit:output_type -> qubit_engine.StateResponse
-	9,  // 14: qubit_engine.QuantumCompute.RunVQE:output_type -> qubit_engine.VQEResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
+	9,  // 10: qubit_engine.QuantumCompute.RunVQE:input_type -> qubit_engine.VQERequest
+	4,  // 11: qubit_engine.QuantumCompute.EstimateResources:input_type -> qubit_engine.CircuitRequest
+	6,  // 12: qubit_engine.QuantumCompute.RunCircuit:output_type -> qubit_engine.StateResponse
+	6,  // 13: qubit_engine.QuantumCompute.StreamGates:output_type -> qubit_engine.StateResponse
+	6,  // 14: qubit_engine.QuantumCompute.VisualizeCircuit:output_type -> qubit_engine.StateResponse
+	10, // 15: qubit_engine.QuantumCompute.RunVQE:output_type -> qubit_engine.VQEResponse
+	7,  // 16: qubit_engine.QuantumCompute.EstimateResources:output_type -> qubit_engine.ResourceEstimate
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -839,7 +960,7 @@ func file_quantum_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quantum_proto_rawDesc), len(file_quantum_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.6.0
-// - protoc             v6.33.1
+// - protoc             v6.33.0
 // source: quantum.proto
 
 package generated
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuantumCompute_RunCircuit_FullMethodName       = "/qubit_engine.QuantumCompute/RunCircuit"
-	QuantumCompute_StreamGates_FullMethodName      = "/qubit_engine.QuantumCompute/StreamGates"
-	QuantumCompute_VisualizeCircuit_FullMethodName = "/qubit_engine.QuantumCompute/VisualizeCircuit"
-	QuantumCompute_RunVQE_FullMethodName           = "/qubit_engine.QuantumCompute/RunVQE"
+	QuantumCompute_RunCircuit_FullMethodName        = "/qubit_engine.QuantumCompute/RunCircuit"
+	QuantumCompute_StreamGates_FullMethodName       = "/qubit_engine.QuantumCompute/StreamGates"
+	QuantumCompute_VisualizeCircuit_FullMethodName  = "/qubit_engine.QuantumCompute/VisualizeCircuit"
+	QuantumCompute_RunVQE_FullMethodName            = "/qubit_engine.QuantumCompute/RunVQE"
+	QuantumCompute_EstimateResources_FullMethodName = "/qubit_engine.QuantumCompute/EstimateResources"
 )
 
 // QuantumComputeClient is the client API for QuantumCompute service.
@@ -40,6 +41,8 @@ type QuantumComputeClient interface {
 	VisualizeCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StateResponse], error)
 	// VQE Simulation for Quantum Chemistry
 	RunVQE(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEResponse], error)
+	// Predicts what a circuit would cost to run, without running it.
+	EstimateResources(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*ResourceEstimate, error)
 }
 
 type quantumComputeClient struct {
@@ -111,6 +114,16 @@ func (c *quantumComputeClient) RunVQE(ctx context.Context, in *VQERequest, opts
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type QuantumCompute_RunVQEClient = grpc.ServerStreamingClient[VQEResponse]
 
+func (c *quantumComputeClient) EstimateResources(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*ResourceEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceEstimate)
+	err := c.cc.Invoke(ctx, QuantumCompute_EstimateResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuantumComputeServer is the server API for QuantumCompute service.
 // All implementations must embed UnimplementedQuantumComputeServer
 // for forward compatibility.
@@ -126,6 +139,8 @@ type QuantumComputeServer interface {
 	VisualizeCircuit(*CircuitRequest, grpc.ServerStreamingServer[StateResponse]) error
 	// VQE Simulation for Quantum Chemistry
 	RunVQE(*VQERequest, grpc.ServerStreamingServer[VQEResponse]) error
+	// Predicts what a circuit would cost to run, without running it.
+	EstimateResources(context.Context, *CircuitRequest) (*ResourceEstimate, error)
 	mustEmbedUnimplementedQuantumComputeServer()
 }
 
@@ -148,6 +163,9 @@ func (UnimplementedQuantumComputeServer) VisualizeCircuit(*CircuitRequest, grpc.
 func (UnimplementedQuantumComputeServer) RunVQE(*VQERequest, grpc.ServerStreamingServer[VQEResponse]) error {
 	return status.Error(codes.Unimplemented, "method RunVQE not implemented")
 }
+func (UnimplementedQuantumComputeServer) EstimateResources(context.Context, *CircuitRequest) (*ResourceEstimate, error) {
+	return nil, status.Error(codes.Unimplemented, "method EstimateResources not implemented")
+}
 func (UnimplementedQuantumComputeServer) mustEmbedUnimplementedQuantumComputeServer() {}
 func (UnimplementedQuantumComputeServer) testEmbeddedByValue()                        {}
 
@@ -216,6 +234,24 @@ func _QuantumCompute_RunVQE_Handler(srv interface{}, stream grpc.ServerStream) e
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type QuantumCompute_RunVQEServer = grpc.ServerStreamingServer[VQEResponse]
 
+func _QuantumCompute_EstimateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumComputeServer).EstimateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCompute_EstimateResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumComputeServer).EstimateResources(ctx, req.(*CircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuantumCompute_ServiceDesc is the grpc.ServiceDesc for QuantumCompute service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +263,10 @@ var QuantumCompute_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunCircuit",
 			Handler:    _QuantumCompute_RunCircuit_Handler,
 		},
+		{
+			MethodName: "EstimateResources",
+			Handler:    _QuantumCompute_EstimateResources_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: api/proto/registry.proto
+
+package generated
+
+import (
+	generated "github.com/perclft/QubitEngine/cli/internal/generated"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SaveCircuitRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Name          string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Circuit       *generated.CircuitRequest `protobuf:"bytes,3,opt,name=circuit,proto3" json:"circuit,omitempty"`
+	Domain        string                    `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"` // "physics", "gaming", "finance", "education", "music", "crypto"
+	Tags          []string                  `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsPublic      bool                      `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCircuitRequest) Reset() {
+	*x = SaveCircuitRequest{}
+	mi := &file_api_proto_registry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCircuitRequest) ProtoMessage() {}
+
+func (x *SaveCircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCircuitRequest.ProtoReflect.Descriptor instead.
+func (*SaveCircuitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SaveCircuitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveCircuitRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveCircuitRequest) GetCircuit() *generated.CircuitRequest {
+	if x != nil {
+		return x.Circuit
+	}
+	return nil
+}
+
+func (x *SaveCircuitRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SaveCircuitRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveCircuitRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type LoadCircuitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CircuitId     string                 `protobuf:"bytes,1,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0 = latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadCircuitRequest) Reset() {
+	*x = LoadCircuitRequest{}
+	mi := &file_api_proto_registry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadCircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadCircuitRequest) ProtoMessage() {}
+
+func (x *LoadCircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadCircuitRequest.ProtoReflect.Descriptor instead.
+func (*LoadCircuitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoadCircuitRequest) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+func (x *LoadCircuitRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ListCircuitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // Filter by domain
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`     // Filter by tags (AND)
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"` // Filter by author
+	PublicOnly    bool                   `protobuf:"varint,4,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCircuitsRequest) Reset() {
+	*x = ListCircuitsRequest{}
+	mi := &file_api_proto_registry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCircuitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitsRequest) ProtoMessage() {}
+
+func (x *ListCircuitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitsRequest.ProtoReflect.Descriptor instead.
+func (*ListCircuitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCircuitsRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ListCircuitsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListCircuitsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListCircuitsRequest) GetPublicOnly() bool {
+	if x != nil {
+		return x.PublicOnly
+	}
+	return false
+}
+
+func (x *ListCircuitsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCircuitsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ForkCircuitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceCircuitId string                 `protobuf:"bytes,1,opt,name=source_circuit_id,json=sourceCircuitId,proto3" json:"source_circuit_id,omitempty"`
+	NewName         string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ForkCircuitRequest) Reset() {
+	*x = ForkCircuitRequest{}
+	mi := &file_api_proto_registry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkCircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkCircuitRequest) ProtoMessage() {}
+
+func (x *ForkCircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkCircuitRequest.ProtoReflect.Descriptor instead.
+func (*ForkCircuitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForkCircuitRequest) GetSourceCircuitId() string {
+	if x != nil {
+		return x.SourceCircuitId
+	}
+	return ""
+}
+
+func (x *ForkCircuitRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type DeleteCircuitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CircuitId     string                 `protobuf:"bytes,1,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCircuitRequest) Reset() {
+	*x = DeleteCircuitRequest{}
+	mi := &file_api_proto_registry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCircuitRequest) ProtoMessage() {}
+
+func (x *DeleteCircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCircuitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCircuitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCircuitRequest) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+type CircuitMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Domain        string                 `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,7,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	NumOperations int32                  `protobuf:"varint,8,opt,name=num_operations,json=numOperations,proto3" json:"num_operations,omitempty"`
+	Version       int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,12,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	ForkCount     int32                  `protobuf:"varint,13,opt,name=fork_count,json=forkCount,proto3" json:"fork_count,omitempty"`
+	RunCount      int32                  `protobuf:"varint,14,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitMetadata) Reset() {
+	*x = CircuitMetadata{}
+	mi := &file_api_proto_registry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitMetadata) ProtoMessage() {}
+
+func (x *CircuitMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitMetadata.ProtoReflect.Descriptor instead.
+func (*CircuitMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CircuitMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CircuitMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CircuitMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CircuitMetadata) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CircuitMetadata) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CircuitMetadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CircuitMetadata) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *CircuitMetadata) GetNumOperations() int32 {
+	if x != nil {
+		return x.NumOperations
+	}
+	return 0
+}
+
+func (x *CircuitMetadata) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CircuitMetadata) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CircuitMetadata) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CircuitMetadata) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *CircuitMetadata) GetForkCount() int32 {
+	if x != nil {
+		return x.ForkCount
+	}
+	return 0
+}
+
+func (x *CircuitMetadata) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+type CircuitList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Circuits      []*CircuitMetadata     `protobuf:"bytes,1,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitList) Reset() {
+	*x = CircuitList{}
+	mi := &file_api_proto_registry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitList) ProtoMessage() {}
+
+func (x *CircuitList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitList.ProtoReflect.Descriptor instead.
+func (*CircuitList) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CircuitList) GetCircuits() []*CircuitMetadata {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+func (x *CircuitList) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *CircuitList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CircuitList) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_api_proto_registry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_registry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_api_proto_registry_proto_rawDescGZIP(), []int{7}
+}
+
+var File_api_proto_registry_proto protoreflect.FileDescriptor
+
+const file_api_proto_registry_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/proto/registry.proto\x12\fqubit_engine\x1a\x17api/proto/quantum.proto\"\xcb\x01\n" +
+	"\x12SaveCircuitRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x126\n" +
+	"\acircuit\x18\x03 \x01(\v2\x1c.qubit_engine.CircuitRequestR\acircuit\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tis_public\x18\x06 \x01(\bR\bisPublic\"M\n" +
+	"\x12LoadCircuitRequest\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\x01 \x01(\tR\tcircuitId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xab\x01\n" +
+	"\x13ListCircuitsRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x1f\n" +
+	"\vpublic_only\x18\x04 \x01(\bR\n" +
+	"publicOnly\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"[\n" +
+	"\x12ForkCircuitRequest\x12*\n" +
+	"\x11source_circuit_id\x18\x01 \x01(\tR\x0fsourceCircuitId\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\"5\n" +
+	"\x14DeleteCircuitRequest\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\x01 \x01(\tR\tcircuitId\"\x92\x03\n" +
+	"\x0fCircuitMetadata\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x16\n" +
+	"\x06domain\x18\x05 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\a \x01(\x05R\tnumQubits\x12%\n" +
+	"\x0enum_operations\x18\b \x01(\x05R\rnumOperations\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12\x1b\n" +
+	"\tis_public\x18\f \x01(\bR\bisPublic\x12\x1d\n" +
+	"\n" +
+	"fork_count\x18\r \x01(\x05R\tforkCount\x12\x1b\n" +
+	"\trun_count\x18\x0e \x01(\x05R\brunCount\"\x9a\x01\n" +
+	"\vCircuitList\x129\n" +
+	"\bcircuits\x18\x01 \x03(\v2\x1d.qubit_engine.CircuitMetadataR\bcircuits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\a\n" +
+	"\x05Empty2\x98\x03\n" +
+	"\x0fCircuitRegistry\x12N\n" +
+	"\vSaveCircuit\x12 .qubit_engine.SaveCircuitRequest\x1a\x1d.qubit_engine.CircuitMetadata\x12M\n" +
+	"\vLoadCircuit\x12 .qubit_engine.LoadCircuitRequest\x1a\x1c.qubit_engine.CircuitRequest\x12L\n" +
+	"\fListCircuits\x12!.qubit_engine.ListCircuitsRequest\x1a\x19.qubit_engine.CircuitList\x12N\n" +
+	"\vForkCircuit\x12 .qubit_engine.ForkCircuitRequest\x1a\x1d.qubit_engine.CircuitMetadata\x12H\n" +
+	"\rDeleteCircuit\x12\".qubit_engine.DeleteCircuitRequest\x1a\x13.qubit_engine.EmptyB<Z:github.com/perclft/QubitEngine/services/registry/generatedb\x06proto3"
+
+var (
+	file_api_proto_registry_proto_rawDescOnce sync.Once
+	file_api_proto_registry_proto_rawDescData []byte
+)
+
+func file_api_proto_registry_proto_rawDescGZIP() []byte {
+	file_api_proto_registry_proto_rawDescOnce.Do(func() {
+		file_api_proto_registry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_registry_proto_rawDesc), len(file_api_proto_registry_proto_rawDesc)))
+	})
+	return file_api_proto_registry_proto_rawDescData
+}
+
+var file_api_proto_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_registry_proto_goTypes = []any{
+	(*SaveCircuitRequest)(nil),       // 0: qubit_engine.SaveCircuitRequest
+	(*LoadCircuitRequest)(nil),       // 1: qubit_engine.LoadCircuitRequest
+	(*ListCircuitsRequest)(nil),      // 2: qubit_engine.ListCircuitsRequest
+	(*ForkCircuitRequest)(nil),       // 3: qubit_engine.ForkCircuitRequest
+	(*DeleteCircuitRequest)(nil),     // 4: qubit_engine.DeleteCircuitRequest
+	(*CircuitMetadata)(nil),          // 5: qubit_engine.CircuitMetadata
+	(*CircuitList)(nil),              // 6: qubit_engine.CircuitList
+	(*Empty)(nil),                    // 7: qubit_engine.Empty
+	(*generated.CircuitRequest)(nil), // 8: qubit_engine.CircuitRequest
+}
+var file_api_proto_registry_proto_depIdxs = []int32{
+	8, // 0: qubit_engine.SaveCircuitRequest.circuit:type_name -> qubit_engine.CircuitRequest
+	5, // 1: qubit_engine.CircuitList.circuits:type_name -> qubit_engine.CircuitMetadata
+	0, // 2: qubit_engine.CircuitRegistry.SaveCircuit:input_type -> qubit_engine.SaveCircuitRequest
+	1, // 3: qubit_engine.CircuitRegistry.LoadCircuit:input_type -> qubit_engine.LoadCircuitRequest
+	2, // 4: qubit_engine.CircuitRegistry.ListCircuits:input_type -> qubit_engine.ListCircuitsRequest
+	3, // 5: qubit_engine.CircuitRegistry.ForkCircuit:input_type -> qubit_engine.ForkCircuitRequest
+	4, // 6: qubit_engine.CircuitRegistry.DeleteCircuit:input_type -> qubit_engine.DeleteCircuitRequest
+	5, // 7: qubit_engine.CircuitRegistry.SaveCircuit:output_type -> qubit_engine.CircuitMetadata
+	8, // 8: qubit_engine.CircuitRegistry.LoadCircuit:output_type -> qubit_engine.CircuitRequest
+	6, // 9: qubit_engine.CircuitRegistry.ListCircuits:output_type -> qubit_engine.CircuitList
+	5, // 10: qubit_engine.CircuitRegistry.ForkCircuit:output_type -> qubit_engine.CircuitMetadata
+	7, // 11: qubit_engine.CircuitRegistry.DeleteCircuit:output_type -> qubit_engine.Empty
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_registry_proto_init() }
+func file_api_proto_registry_proto_init() {
+	if File_api_proto_registry_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_registry_proto_rawDesc), len(file_api_proto_registry_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_registry_proto_goTypes,
+		DependencyIndexes: file_api_proto_registry_proto_depIdxs,
+		MessageInfos:      file_api_proto_registry_proto_msgTypes,
+	}.Build()
+	File_api_proto_registry_proto = out.File
+	file_api_proto_registry_proto_goTypes = nil
+	file_api_proto_registry_proto_depIdxs = nil
+}
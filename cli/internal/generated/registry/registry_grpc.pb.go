@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: api/proto/registry.proto
+
+package generated
+
+import (
+	context "context"
+	generated "github.com/perclft/QubitEngine/cli/internal/generated"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CircuitRegistry_SaveCircuit_FullMethodName   = "/qubit_engine.CircuitRegistry/SaveCircuit"
+	CircuitRegistry_LoadCircuit_FullMethodName   = "/qubit_engine.CircuitRegistry/LoadCircuit"
+	CircuitRegistry_ListCircuits_FullMethodName  = "/qubit_engine.CircuitRegistry/ListCircuits"
+	CircuitRegistry_ForkCircuit_FullMethodName   = "/qubit_engine.CircuitRegistry/ForkCircuit"
+	CircuitRegistry_DeleteCircuit_FullMethodName = "/qubit_engine.CircuitRegistry/DeleteCircuit"
+)
+
+// CircuitRegistryClient is the client API for CircuitRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CircuitRegistryClient interface {
+	// Save a circuit to the registry
+	SaveCircuit(ctx context.Context, in *SaveCircuitRequest, opts ...grpc.CallOption) (*CircuitMetadata, error)
+	// Load a circuit by ID
+	LoadCircuit(ctx context.Context, in *LoadCircuitRequest, opts ...grpc.CallOption) (*generated.CircuitRequest, error)
+	// List circuits with optional filters
+	ListCircuits(ctx context.Context, in *ListCircuitsRequest, opts ...grpc.CallOption) (*CircuitList, error)
+	// Fork (copy) an existing circuit for modification
+	ForkCircuit(ctx context.Context, in *ForkCircuitRequest, opts ...grpc.CallOption) (*CircuitMetadata, error)
+	// Delete a circuit (owner only)
+	DeleteCircuit(ctx context.Context, in *DeleteCircuitRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type circuitRegistryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCircuitRegistryClient(cc grpc.ClientConnInterface) CircuitRegistryClient {
+	return &circuitRegistryClient{cc}
+}
+
+func (c *circuitRegistryClient) SaveCircuit(ctx context.Context, in *SaveCircuitRequest, opts ...grpc.CallOption) (*CircuitMetadata, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CircuitMetadata)
+	err := c.cc.Invoke(ctx, CircuitRegistry_SaveCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitRegistryClient) LoadCircuit(ctx context.Context, in *LoadCircuitRequest, opts ...grpc.CallOption) (*generated.CircuitRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(generated.CircuitRequest)
+	err := c.cc.Invoke(ctx, CircuitRegistry_LoadCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitRegistryClient) ListCircuits(ctx context.Context, in *ListCircuitsRequest, opts ...grpc.CallOption) (*CircuitList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CircuitList)
+	err := c.cc.Invoke(ctx, CircuitRegistry_ListCircuits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitRegistryClient) ForkCircuit(ctx context.Context, in *ForkCircuitRequest, opts ...grpc.CallOption) (*CircuitMetadata, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CircuitMetadata)
+	err := c.cc.Invoke(ctx, CircuitRegistry_ForkCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitRegistryClient) DeleteCircuit(ctx context.Context, in *DeleteCircuitRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CircuitRegistry_DeleteCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CircuitRegistryServer is the server API for CircuitRegistry service.
+// All implementations must embed UnimplementedCircuitRegistryServer
+// for forward compatibility.
+type CircuitRegistryServer interface {
+	// Save a circuit to the registry
+	SaveCircuit(context.Context, *SaveCircuitRequest) (*CircuitMetadata, error)
+	// Load a circuit by ID
+	LoadCircuit(context.Context, *LoadCircuitRequest) (*generated.CircuitRequest, error)
+	// List circuits with optional filters
+	ListCircuits(context.Context, *ListCircuitsRequest) (*CircuitList, error)
+	// Fork (copy) an existing circuit for modification
+	ForkCircuit(context.Context, *ForkCircuitRequest) (*CircuitMetadata, error)
+	// Delete a circuit (owner only)
+	DeleteCircuit(context.Context, *DeleteCircuitRequest) (*Empty, error)
+	mustEmbedUnimplementedCircuitRegistryServer()
+}
+
+// UnimplementedCircuitRegistryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCircuitRegistryServer struct{}
+
+func (UnimplementedCircuitRegistryServer) SaveCircuit(context.Context, *SaveCircuitRequest) (*CircuitMetadata, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCircuit not implemented")
+}
+func (UnimplementedCircuitRegistryServer) LoadCircuit(context.Context, *LoadCircuitRequest) (*generated.CircuitRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method LoadCircuit not implemented")
+}
+func (UnimplementedCircuitRegistryServer) ListCircuits(context.Context, *ListCircuitsRequest) (*CircuitList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCircuits not implemented")
+}
+func (UnimplementedCircuitRegistryServer) ForkCircuit(context.Context, *ForkCircuitRequest) (*CircuitMetadata, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForkCircuit not implemented")
+}
+func (UnimplementedCircuitRegistryServer) DeleteCircuit(context.Context, *DeleteCircuitRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCircuit not implemented")
+}
+func (UnimplementedCircuitRegistryServer) mustEmbedUnimplementedCircuitRegistryServer() {}
+func (UnimplementedCircuitRegistryServer) testEmbeddedByValue()                         {}
+
+// UnsafeCircuitRegistryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CircuitRegistryServer will
+// result in compilation errors.
+type UnsafeCircuitRegistryServer interface {
+	mustEmbedUnimplementedCircuitRegistryServer()
+}
+
+func RegisterCircuitRegistryServer(s grpc.ServiceRegistrar, srv CircuitRegistryServer) {
+	// If the following call panics, it indicates UnimplementedCircuitRegistryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CircuitRegistry_ServiceDesc, srv)
+}
+
+func _CircuitRegistry_SaveCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitRegistryServer).SaveCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitRegistry_SaveCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitRegistryServer).SaveCircuit(ctx, req.(*SaveCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitRegistry_LoadCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitRegistryServer).LoadCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitRegistry_LoadCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitRegistryServer).LoadCircuit(ctx, req.(*LoadCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitRegistry_ListCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCircuitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitRegistryServer).ListCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitRegistry_ListCircuits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitRegistryServer).ListCircuits(ctx, req.(*ListCircuitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitRegistry_ForkCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitRegistryServer).ForkCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitRegistry_ForkCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitRegistryServer).ForkCircuit(ctx, req.(*ForkCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitRegistry_DeleteCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitRegistryServer).DeleteCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitRegistry_DeleteCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitRegistryServer).DeleteCircuit(ctx, req.(*DeleteCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CircuitRegistry_ServiceDesc is the grpc.ServiceDesc for CircuitRegistry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CircuitRegistry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.CircuitRegistry",
+	HandlerType: (*CircuitRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveCircuit",
+			Handler:    _CircuitRegistry_SaveCircuit_Handler,
+		},
+		{
+			MethodName: "LoadCircuit",
+			Handler:    _CircuitRegistry_LoadCircuit_Handler,
+		},
+		{
+			MethodName: "ListCircuits",
+			Handler:    _CircuitRegistry_ListCircuits_Handler,
+		},
+		{
+			MethodName: "ForkCircuit",
+			Handler:    _CircuitRegistry_ForkCircuit_Handler,
+		},
+		{
+			MethodName: "DeleteCircuit",
+			Handler:    _CircuitRegistry_DeleteCircuit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/registry.proto",
+}
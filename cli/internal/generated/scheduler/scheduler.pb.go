@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: api/proto/scheduler.proto
+
+package generated
+
+import (
+	generated "github.com/perclft/QubitEngine/cli/internal/generated"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JobPriority int32
+
+const (
+	JobPriority_PRIORITY_LOW      JobPriority = 0
+	JobPriority_PRIORITY_NORMAL   JobPriority = 1
+	JobPriority_PRIORITY_HIGH     JobPriority = 2
+	JobPriority_PRIORITY_REALTIME JobPriority = 3
+)
+
+// Enum value maps for JobPriority.
+var (
+	JobPriority_name = map[int32]string{
+		0: "PRIORITY_LOW",
+		1: "PRIORITY_NORMAL",
+		2: "PRIORITY_HIGH",
+		3: "PRIORITY_REALTIME",
+	}
+	JobPriority_value = map[string]int32{
+		"PRIORITY_LOW":      0,
+		"PRIORITY_NORMAL":   1,
+		"PRIORITY_HIGH":     2,
+		"PRIORITY_REALTIME": 3,
+	}
+)
+
+func (x JobPriority) Enum() *JobPriority {
+	p := new(JobPriority)
+	*p = x
+	return p
+}
+
+func (x JobPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_scheduler_proto_enumTypes[0].Descriptor()
+}
+
+func (JobPriority) Type() protoreflect.EnumType {
+	return &file_api_proto_scheduler_proto_enumTypes[0]
+}
+
+func (x JobPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobPriority.Descriptor instead.
+func (JobPriority) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+type JobState int32
+
+const (
+	JobState_STATE_UNKNOWN   JobState = 0
+	JobState_STATE_QUEUED    JobState = 1
+	JobState_STATE_RUNNING   JobState = 2
+	JobState_STATE_COMPLETED JobState = 3
+	JobState_STATE_FAILED    JobState = 4
+	JobState_STATE_CANCELLED JobState = 5
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_QUEUED",
+		2: "STATE_RUNNING",
+		3: "STATE_COMPLETED",
+		4: "STATE_FAILED",
+		5: "STATE_CANCELLED",
+	}
+	JobState_value = map[string]int32{
+		"STATE_UNKNOWN":   0,
+		"STATE_QUEUED":    1,
+		"STATE_RUNNING":   2,
+		"STATE_COMPLETED": 3,
+		"STATE_FAILED":    4,
+		"STATE_CANCELLED": 5,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_scheduler_proto_enumTypes[1].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_api_proto_scheduler_proto_enumTypes[1]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+type JobRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Circuit       *generated.CircuitRequest `protobuf:"bytes,1,opt,name=circuit,proto3" json:"circuit,omitempty"`
+	Priority      JobPriority               `protobuf:"varint,2,opt,name=priority,proto3,enum=qubit_engine.JobPriority" json:"priority,omitempty"`
+	Shots         int32                     `protobuf:"varint,3,opt,name=shots,proto3" json:"shots,omitempty"`                                                                                // Number of measurement repetitions
+	CallbackUrl   string                    `protobuf:"bytes,4,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`                                                  // Optional webhook for completion notification
+	UserId        string                    `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                                 // User/tenant identifier
+	Metadata      map[string]string         `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Custom metadata
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRequest) Reset() {
+	*x = JobRequest{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRequest) ProtoMessage() {}
+
+func (x *JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRequest.ProtoReflect.Descriptor instead.
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobRequest) GetCircuit() *generated.CircuitRequest {
+	if x != nil {
+		return x.Circuit
+	}
+	return nil
+}
+
+func (x *JobRequest) GetPriority() JobPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return JobPriority_PRIORITY_LOW
+}
+
+func (x *JobRequest) GetShots() int32 {
+	if x != nil {
+		return x.Shots
+	}
+	return 0
+}
+
+func (x *JobRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *JobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JobRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type JobHandle struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	JobId                string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	SubmittedAt          int64                  `protobuf:"varint,2,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"` // Unix timestamp
+	EstimatedWaitSeconds int32                  `protobuf:"varint,3,opt,name=estimated_wait_seconds,json=estimatedWaitSeconds,proto3" json:"estimated_wait_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *JobHandle) Reset() {
+	*x = JobHandle{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobHandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobHandle) ProtoMessage() {}
+
+func (x *JobHandle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobHandle.ProtoReflect.Descriptor instead.
+func (*JobHandle) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobHandle) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobHandle) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *JobHandle) GetEstimatedWaitSeconds() int32 {
+	if x != nil {
+		return x.EstimatedWaitSeconds
+	}
+	return 0
+}
+
+type JobStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobId           string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	State           JobState               `protobuf:"varint,2,opt,name=state,proto3,enum=qubit_engine.JobState" json:"state,omitempty"`
+	PositionInQueue int32                  `protobuf:"varint,3,opt,name=position_in_queue,json=positionInQueue,proto3" json:"position_in_queue,omitempty"` // 0 if running or completed
+	ProgressPercent int32                  `protobuf:"varint,4,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`   // 0-100
+	WorkerId        string                 `protobuf:"bytes,5,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`                         // Which engine pod is processing
+	StartedAt       int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt     int64                  `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ErrorMessage    string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Set if state == FAILED
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JobStatus) Reset() {
+	*x = JobStatus{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatus) ProtoMessage() {}
+
+func (x *JobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStatus.ProtoReflect.Descriptor instead.
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JobStatus) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobStatus) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_STATE_UNKNOWN
+}
+
+func (x *JobStatus) GetPositionInQueue() int32 {
+	if x != nil {
+		return x.PositionInQueue
+	}
+	return 0
+}
+
+func (x *JobStatus) GetProgressPercent() int32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *JobStatus) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *JobStatus) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *JobStatus) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *JobStatus) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type JobResult struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	JobId         string                   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ShotNumber    int32                    `protobuf:"varint,2,opt,name=shot_number,json=shotNumber,proto3" json:"shot_number,omitempty"`                                                              // Which shot (1 to N)
+	State         *generated.StateResponse `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                                                                                           // The quantum state after circuit
+	Measurements  map[int32]bool           `protobuf:"bytes,4,rep,name=measurements,proto3" json:"measurements,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Qubit -> measurement result
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult) Reset() {
+	*x = JobResult{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult) ProtoMessage() {}
+
+func (x *JobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult.ProtoReflect.Descriptor instead.
+func (*JobResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JobResult) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobResult) GetShotNumber() int32 {
+	if x != nil {
+		return x.ShotNumber
+	}
+	return 0
+}
+
+func (x *JobResult) GetState() *generated.StateResponse {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *JobResult) GetMeasurements() map[int32]bool {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StateFilter   JobState               `protobuf:"varint,2,opt,name=state_filter,json=stateFilter,proto3,enum=qubit_engine.JobState" json:"state_filter,omitempty"` // Optional, 0 = all states
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListJobsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetStateFilter() JobState {
+	if x != nil {
+		return x.StateFilter
+	}
+	return JobState_STATE_UNKNOWN
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type JobList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*JobStatus           `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobList) Reset() {
+	*x = JobList{}
+	mi := &file_api_proto_scheduler_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobList) ProtoMessage() {}
+
+func (x *JobList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_scheduler_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobList.ProtoReflect.Descriptor instead.
+func (*JobList) Descriptor() ([]byte, []int) {
+	return file_api_proto_scheduler_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JobList) GetJobs() []*JobStatus {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *JobList) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+var File_api_proto_scheduler_proto protoreflect.FileDescriptor
+
+const file_api_proto_scheduler_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/proto/scheduler.proto\x12\fqubit_engine\x1a\x17api/proto/quantum.proto\"\xce\x02\n" +
+	"\n" +
+	"JobRequest\x126\n" +
+	"\acircuit\x18\x01 \x01(\v2\x1c.qubit_engine.CircuitRequestR\acircuit\x125\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x19.qubit_engine.JobPriorityR\bpriority\x12\x14\n" +
+	"\x05shots\x18\x03 \x01(\x05R\x05shots\x12!\n" +
+	"\fcallback_url\x18\x04 \x01(\tR\vcallbackUrl\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12B\n" +
+	"\bmetadata\x18\x06 \x03(\v2&.qubit_engine.JobRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"{\n" +
+	"\tJobHandle\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12!\n" +
+	"\fsubmitted_at\x18\x02 \x01(\x03R\vsubmittedAt\x124\n" +
+	"\x16estimated_wait_seconds\x18\x03 \x01(\x05R\x14estimatedWaitSeconds\"\xab\x02\n" +
+	"\tJobStatus\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12,\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x16.qubit_engine.JobStateR\x05state\x12*\n" +
+	"\x11position_in_queue\x18\x03 \x01(\x05R\x0fpositionInQueue\x12)\n" +
+	"\x10progress_percent\x18\x04 \x01(\x05R\x0fprogressPercent\x12\x1b\n" +
+	"\tworker_id\x18\x05 \x01(\tR\bworkerId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\a \x01(\x03R\vcompletedAt\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\"D\n" +
+	"\x0eCancelResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x86\x02\n" +
+	"\tJobResult\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1f\n" +
+	"\vshot_number\x18\x02 \x01(\x05R\n" +
+	"shotNumber\x121\n" +
+	"\x05state\x18\x03 \x01(\v2\x1b.qubit_engine.StateResponseR\x05state\x12M\n" +
+	"\fmeasurements\x18\x04 \x03(\v2).qubit_engine.JobResult.MeasurementsEntryR\fmeasurements\x1a?\n" +
+	"\x11MeasurementsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x0fListJobsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\fstate_filter\x18\x02 \x01(\x0e2\x16.qubit_engine.JobStateR\vstateFilter\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"W\n" +
+	"\aJobList\x12+\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x17.qubit_engine.JobStatusR\x04jobs\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount*^\n" +
+	"\vJobPriority\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x00\x12\x13\n" +
+	"\x0fPRIORITY_NORMAL\x10\x01\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x02\x12\x15\n" +
+	"\x11PRIORITY_REALTIME\x10\x03*~\n" +
+	"\bJobState\x12\x11\n" +
+	"\rSTATE_UNKNOWN\x10\x00\x12\x10\n" +
+	"\fSTATE_QUEUED\x10\x01\x12\x11\n" +
+	"\rSTATE_RUNNING\x10\x02\x12\x13\n" +
+	"\x0fSTATE_COMPLETED\x10\x03\x12\x10\n" +
+	"\fSTATE_FAILED\x10\x04\x12\x13\n" +
+	"\x0fSTATE_CANCELLED\x10\x052\xe2\x02\n" +
+	"\x10QuantumScheduler\x12>\n" +
+	"\tSubmitJob\x12\x18.qubit_engine.JobRequest\x1a\x17.qubit_engine.JobHandle\x12@\n" +
+	"\fGetJobStatus\x12\x17.qubit_engine.JobHandle\x1a\x17.qubit_engine.JobStatus\x12B\n" +
+	"\tCancelJob\x12\x17.qubit_engine.JobHandle\x1a\x1c.qubit_engine.CancelResponse\x12F\n" +
+	"\x10StreamJobResults\x12\x17.qubit_engine.JobHandle\x1a\x17.qubit_engine.JobResult0\x01\x12@\n" +
+	"\bListJobs\x12\x1d.qubit_engine.ListJobsRequest\x1a\x15.qubit_engine.JobListB=Z;github.com/perclft/QubitEngine/services/scheduler/generatedb\x06proto3"
+
+var (
+	file_api_proto_scheduler_proto_rawDescOnce sync.Once
+	file_api_proto_scheduler_proto_rawDescData []byte
+)
+
+func file_api_proto_scheduler_proto_rawDescGZIP() []byte {
+	file_api_proto_scheduler_proto_rawDescOnce.Do(func() {
+		file_api_proto_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_scheduler_proto_rawDesc), len(file_api_proto_scheduler_proto_rawDesc)))
+	})
+	return file_api_proto_scheduler_proto_rawDescData
+}
+
+var file_api_proto_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_proto_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_proto_scheduler_proto_goTypes = []any{
+	(JobPriority)(0),                 // 0: qubit_engine.JobPriority
+	(JobState)(0),                    // 1: qubit_engine.JobState
+	(*JobRequest)(nil),               // 2: qubit_engine.JobRequest
+	(*JobHandle)(nil),                // 3: qubit_engine.JobHandle
+	(*JobStatus)(nil),                // 4: qubit_engine.JobStatus
+	(*CancelResponse)(nil),           // 5: qubit_engine.CancelResponse
+	(*JobResult)(nil),                // 6: qubit_engine.JobResult
+	(*ListJobsRequest)(nil),          // 7: qubit_engine.ListJobsRequest
+	(*JobList)(nil),                  // 8: qubit_engine.JobList
+	nil,                              // 9: qubit_engine.JobRequest.MetadataEntry
+	nil,                              // 10: qubit_engine.JobResult.MeasurementsEntry
+	(*generated.CircuitRequest)(nil), // 11: qubit_engine.CircuitRequest
+	(*generated.StateResponse)(nil),  // 12: qubit_engine.StateResponse
+}
+var file_api_proto_scheduler_proto_depIdxs = []int32{
+	11, // 0: qubit_engine.JobRequest.circuit:type_name -> qubit_engine.CircuitRequest
+	0,  // 1: qubit_engine.JobRequest.priority:type_name -> qubit_engine.JobPriority
+	9,  // 2: qubit_engine.JobRequest.metadata:type_name -> qubit_engine.JobRequest.MetadataEntry
+	1,  // 3: qubit_engine.JobStatus.state:type_name -> qubit_engine.JobState
+	12, // 4: qubit_engine.JobResult.state:type_name -> qubit_engine.StateResponse
+	10, // 5: qubit_engine.JobResult.measurements:type_name -> qubit_engine.JobResult.MeasurementsEntry
+	1,  // 6: qubit_engine.ListJobsRequest.state_filter:type_name -> qubit_engine.JobState
+	4,  // 7: qubit_engine.JobList.jobs:type_name -> qubit_engine.JobStatus
+	2,  // 8: qubit_engine.QuantumScheduler.SubmitJob:input_type -> qubit_engine.JobRequest
+	3,  // 9: qubit_engine.QuantumScheduler.GetJobStatus:input_type -> qubit_engine.JobHandle
+	3,  // 10: qubit_engine.QuantumScheduler.CancelJob:input_type -> qubit_engine.JobHandle
+	3,  // 11: qubit_engine.QuantumScheduler.StreamJobResults:input_type -> qubit_engine.JobHandle
+	7,  // 12: qubit_engine.QuantumScheduler.ListJobs:input_type -> qubit_engine.ListJobsRequest
+	3,  // 13: qubit_engine.QuantumScheduler.SubmitJob:output_type -> qubit_engine.JobHandle
+	4,  // 14: qubit_engine.QuantumScheduler.GetJobStatus:output_type -> qubit_engine.JobStatus
+	5,  // 15: qubit_engine.QuantumScheduler.CancelJob:output_type -> qubit_engine.CancelResponse
+	6,  // 16: qubit_engine.QuantumScheduler.StreamJobResults:output_type -> qubit_engine.JobResult
+	8,  // 17: qubit_engine.QuantumScheduler.ListJobs:output_type -> qubit_engine.JobList
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_scheduler_proto_init() }
+func file_api_proto_scheduler_proto_init() {
+	if File_api_proto_scheduler_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_scheduler_proto_rawDesc), len(file_api_proto_scheduler_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_scheduler_proto_goTypes,
+		DependencyIndexes: file_api_proto_scheduler_proto_depIdxs,
+		EnumInfos:         file_api_proto_scheduler_proto_enumTypes,
+		MessageInfos:      file_api_proto_scheduler_proto_msgTypes,
+	}.Build()
+	File_api_proto_scheduler_proto = out.File
+	file_api_proto_scheduler_proto_goTypes = nil
+	file_api_proto_scheduler_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: api/proto/scheduler.proto
+
+package generated
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuantumScheduler_SubmitJob_FullMethodName        = "/qubit_engine.QuantumScheduler/SubmitJob"
+	QuantumScheduler_GetJobStatus_FullMethodName     = "/qubit_engine.QuantumScheduler/GetJobStatus"
+	QuantumScheduler_CancelJob_FullMethodName        = "/qubit_engine.QuantumScheduler/CancelJob"
+	QuantumScheduler_StreamJobResults_FullMethodName = "/qubit_engine.QuantumScheduler/StreamJobResults"
+	QuantumScheduler_ListJobs_FullMethodName         = "/qubit_engine.QuantumScheduler/ListJobs"
+)
+
+// QuantumSchedulerClient is the client API for QuantumScheduler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumSchedulerClient interface {
+	// Submit a circuit job to the queue
+	SubmitJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobHandle, error)
+	// Get the status of a job
+	GetJobStatus(ctx context.Context, in *JobHandle, opts ...grpc.CallOption) (*JobStatus, error)
+	// Cancel a pending or running job
+	CancelJob(ctx context.Context, in *JobHandle, opts ...grpc.CallOption) (*CancelResponse, error)
+	// Stream results as they become available
+	StreamJobResults(ctx context.Context, in *JobHandle, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobResult], error)
+	// List all jobs for a user
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*JobList, error)
+}
+
+type quantumSchedulerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumSchedulerClient(cc grpc.ClientConnInterface) QuantumSchedulerClient {
+	return &quantumSchedulerClient{cc}
+}
+
+func (c *quantumSchedulerClient) SubmitJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobHandle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobHandle)
+	err := c.cc.Invoke(ctx, QuantumScheduler_SubmitJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumSchedulerClient) GetJobStatus(ctx context.Context, in *JobHandle, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, QuantumScheduler_GetJobStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumSchedulerClient) CancelJob(ctx context.Context, in *JobHandle, opts ...grpc.CallOption) (*CancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, QuantumScheduler_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumSchedulerClient) StreamJobResults(ctx context.Context, in *JobHandle, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumScheduler_ServiceDesc.Streams[0], QuantumScheduler_StreamJobResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[JobHandle, JobResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumScheduler_StreamJobResultsClient = grpc.ServerStreamingClient[JobResult]
+
+func (c *quantumSchedulerClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*JobList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobList)
+	err := c.cc.Invoke(ctx, QuantumScheduler_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumSchedulerServer is the server API for QuantumScheduler service.
+// All implementations must embed UnimplementedQuantumSchedulerServer
+// for forward compatibility.
+type QuantumSchedulerServer interface {
+	// Submit a circuit job to the queue
+	SubmitJob(context.Context, *JobRequest) (*JobHandle, error)
+	// Get the status of a job
+	GetJobStatus(context.Context, *JobHandle) (*JobStatus, error)
+	// Cancel a pending or running job
+	CancelJob(context.Context, *JobHandle) (*CancelResponse, error)
+	// Stream results as they become available
+	StreamJobResults(*JobHandle, grpc.ServerStreamingServer[JobResult]) error
+	// List all jobs for a user
+	ListJobs(context.Context, *ListJobsRequest) (*JobList, error)
+	mustEmbedUnimplementedQuantumSchedulerServer()
+}
+
+// UnimplementedQuantumSchedulerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumSchedulerServer struct{}
+
+func (UnimplementedQuantumSchedulerServer) SubmitJob(context.Context, *JobRequest) (*JobHandle, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (UnimplementedQuantumSchedulerServer) GetJobStatus(context.Context, *JobHandle) (*JobStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedQuantumSchedulerServer) CancelJob(context.Context, *JobHandle) (*CancelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedQuantumSchedulerServer) StreamJobResults(*JobHandle, grpc.ServerStreamingServer[JobResult]) error {
+	return status.Error(codes.Unimplemented, "method StreamJobResults not implemented")
+}
+func (UnimplementedQuantumSchedulerServer) ListJobs(context.Context, *ListJobsRequest) (*JobList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedQuantumSchedulerServer) mustEmbedUnimplementedQuantumSchedulerServer() {}
+func (UnimplementedQuantumSchedulerServer) testEmbeddedByValue()                          {}
+
+// UnsafeQuantumSchedulerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumSchedulerServer will
+// result in compilation errors.
+type UnsafeQuantumSchedulerServer interface {
+	mustEmbedUnimplementedQuantumSchedulerServer()
+}
+
+func RegisterQuantumSchedulerServer(s grpc.ServiceRegistrar, srv QuantumSchedulerServer) {
+	// If the following call panics, it indicates UnimplementedQuantumSchedulerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumScheduler_ServiceDesc, srv)
+}
+
+func _QuantumScheduler_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumSchedulerServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumScheduler_SubmitJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumSchedulerServer).SubmitJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumScheduler_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumSchedulerServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumScheduler_GetJobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumSchedulerServer).GetJobStatus(ctx, req.(*JobHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumScheduler_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumSchedulerServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumScheduler_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumSchedulerServer).CancelJob(ctx, req.(*JobHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumScheduler_StreamJobResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobHandle)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumSchedulerServer).StreamJobResults(m, &grpc.GenericServerStream[JobHandle, JobResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumScheduler_StreamJobResultsServer = grpc.ServerStreamingServer[JobResult]
+
+func _QuantumScheduler_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumSchedulerServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumScheduler_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumSchedulerServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumScheduler_ServiceDesc is the grpc.ServiceDesc for QuantumScheduler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumScheduler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.QuantumScheduler",
+	HandlerType: (*QuantumSchedulerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJob",
+			Handler:    _QuantumScheduler_SubmitJob_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _QuantumScheduler_GetJobStatus_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _QuantumScheduler_CancelJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _QuantumScheduler_ListJobs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamJobResults",
+			Handler:       _QuantumScheduler_StreamJobResults_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/scheduler.proto",
+}
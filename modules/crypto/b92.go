@@ -0,0 +1,49 @@
+package main
+
+import (
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+// B92 encodes each bit in one of two non-orthogonal states, 0 → |0⟩ and
+// 1 → |+⟩. Bob measures in a random basis; a 1 outcome rules out one of
+// the states (Z basis: not |0⟩, so 1; X basis: not |+⟩, so 0) and is kept,
+// every 0 outcome is inconclusive and discarded. About a quarter of the
+// qubits survive sifting, against half for BB84.
+
+// preparation returns the states Alice sends as (bit, basis) pairs, the
+// form the BB84 preparation circuit takes
+func (session *BB84Session) preparation() ([]int32, []pb.Basis) {
+	if session.Protocol != pb.Protocol_PROTOCOL_B92 {
+		return session.AliceBits, session.AliceBases
+	}
+	// |0⟩ is 0 in the rectilinear basis, |+⟩ is 0 in the diagonal one
+	return make([]int32, len(session.AliceBits)), session.AliceBases
+}
+
+// siftedIndices lists the positions both sides keep after announcing
+// bases (BB84) or conclusive results (B92)
+func (session *BB84Session) siftedIndices() []int {
+	var kept []int
+	for i := range session.AliceBits {
+		switch session.Protocol {
+		case pb.Protocol_PROTOCOL_B92:
+			if session.BobMeasures[i] == 1 {
+				kept = append(kept, i)
+			}
+		default:
+			if session.AliceBases[i] == session.BobBases[i] && session.BobMeasures[i] != noClick {
+				kept = append(kept, i)
+			}
+		}
+	}
+	return kept
+}
+
+// bobBit is Bob's key bit at a sifted position
+func (session *BB84Session) bobBit(i int) int32 {
+	if session.Protocol == pb.Protocol_PROTOCOL_B92 {
+		// A click in the diagonal basis means |0⟩ was sent
+		return 1 - int32(session.BobBases[i])
+	}
+	return session.BobMeasures[i]
+}
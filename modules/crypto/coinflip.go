@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+const (
+	defaultFlipQubits = 64
+	maxFlipQubits     = 1024
+)
+
+// coinFlip is one BB84-style coin flip between two named players
+type coinFlip struct {
+	ID         string
+	Alice      string
+	Bob        string
+	AliceToken string
+	BobToken   string
+	Stage      pb.FlipStage
+	AliceBasis pb.Basis
+	AliceBits  []int32
+	BobBases   []pb.Basis
+	BobResults []int32
+	Guess      pb.Basis
+	Result     *pb.FlipResult
+	ExpiresAt  time.Time
+}
+
+// session renders the flip for a player; Bob's records stay with Bob
+func (f *coinFlip) session(forBob bool) *pb.FlipSession {
+	out := &pb.FlipSession{
+		FlipId:    f.ID,
+		Alice:     f.Alice,
+		Bob:       f.Bob,
+		Stage:     f.Stage,
+		NumQubits: int32(len(f.AliceBits)),
+		ExpiresAt: f.ExpiresAt.Unix(),
+	}
+	if f.Stage >= pb.FlipStage_FLIP_GUESSED {
+		out.Guess = f.Guess
+	}
+	if forBob {
+		out.BobBases = f.BobBases
+		out.BobResults = f.BobResults
+	}
+	return out
+}
+
+// authorize checks that token is the one issued to player
+func (f *coinFlip) authorize(player, token string) error {
+	var want string
+	switch player {
+	case f.Alice:
+		want = f.AliceToken
+	case f.Bob:
+		want = f.BobToken
+	default:
+		return fmt.Errorf("%q is not playing flip %s", player, f.ID)
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(want)) != 1 {
+		return fmt.Errorf("wrong token for %s in flip %s", player, f.ID)
+	}
+	return nil
+}
+
+// newFlipToken is a player's secret for one flip
+func newFlipToken() string {
+	token := make([]byte, 16)
+	crand.Read(token)
+	return hex.EncodeToString(token)
+}
+
+// flip looks up a live flip; callers hold s.flipMu
+func (s *CryptoServer) flip(id string) (*coinFlip, error) {
+	now := time.Now()
+	for fid, f := range s.flips {
+		if now.After(f.ExpiresAt) {
+			delete(s.flips, fid)
+		}
+	}
+	f, ok := s.flips[id]
+	if !ok {
+		return nil, fmt.Errorf("flip %s not found", id)
+	}
+	return f, nil
+}
+
+func (s *CryptoServer) CreateFlipSession(ctx context.Context, req *pb.CreateFlipRequest) (*pb.FlipSession, error) {
+	if req.Alice == "" || req.Bob == "" || req.Alice == req.Bob {
+		return nil, fmt.Errorf("alice and bob must be two different players")
+	}
+	n := int(req.NumQubits)
+	if n == 0 {
+		n = defaultFlipQubits
+	}
+	if n < 1 || n > maxFlipQubits {
+		return nil, fmt.Errorf("num_qubits must be 1-%d", maxFlipQubits)
+	}
+
+	f := &coinFlip{
+		ID:         newID("flip-"),
+		Alice:      req.Alice,
+		Bob:        req.Bob,
+		AliceToken: newFlipToken(),
+		BobToken:   newFlipToken(),
+		Stage:      pb.FlipStage_FLIP_CREATED,
+		AliceBits:  make([]int32, n),
+		ExpiresAt:  time.Now().Add(s.sessionTTL),
+	}
+	s.flipMu.Lock()
+	s.flips[f.ID] = f
+	s.flipMu.Unlock()
+
+	log.Printf("🪙 Coin flip %s: %s vs %s over %d qubits", f.ID, f.Alice, f.Bob, n)
+	out := f.session(false)
+	out.AliceToken, out.BobToken = f.AliceToken, f.BobToken
+	return out, nil
+}
+
+// CommitFlip advances the flip for one player: Alice's commit sends her
+// qubits (measured straight away by Bob's device), Bob's records his guess
+func (s *CryptoServer) CommitFlip(ctx context.Context, req *pb.CommitFlipRequest) (*pb.FlipSession, error) {
+	s.flipMu.Lock()
+	f, err := s.flip(req.FlipId)
+	if err != nil {
+		s.flipMu.Unlock()
+		return nil, err
+	}
+	if err := f.authorize(req.Player, req.Token); err != nil {
+		s.flipMu.Unlock()
+		return nil, err
+	}
+
+	if req.Player == f.Bob {
+		defer s.flipMu.Unlock()
+		if f.Stage != pb.FlipStage_FLIP_COMMITTED || f.BobResults == nil {
+			return nil, fmt.Errorf("bob guesses after alice's qubits have arrived (flip is %s)", f.Stage)
+		}
+		f.Guess = req.Guess
+		f.Stage = pb.FlipStage_FLIP_GUESSED
+		return f.session(true), nil
+	}
+
+	if f.Stage != pb.FlipStage_FLIP_CREATED {
+		s.flipMu.Unlock()
+		return nil, fmt.Errorf("alice has already committed to flip %s", req.FlipId)
+	}
+	n := len(f.AliceBits)
+	// Hold the stage while the engine runs so a second commit is refused
+	f.Stage = pb.FlipStage_FLIP_COMMITTED
+	s.flipMu.Unlock()
+
+	basis, bits, bobBases, results, err := s.sendFlipQubits(ctx, n)
+
+	s.flipMu.Lock()
+	defer s.flipMu.Unlock()
+	if err != nil {
+		f.Stage = pb.FlipStage_FLIP_CREATED
+		return nil, err
+	}
+	f.AliceBasis, f.AliceBits = basis, bits
+	f.BobBases, f.BobResults = bobBases, results
+	return f.session(false), nil
+}
+
+// sendFlipQubits draws Alice's basis and bits and Bob's bases from the
+// QRNG, so neither player can predict the other's, and has Bob measure
+// Alice's qubits
+func (s *CryptoServer) sendFlipQubits(ctx context.Context, n int) (pb.Basis, []int32, []pb.Basis, []int32, error) {
+	random, _, err := s.qrngBits(ctx, 2*n+1)
+	if err != nil {
+		return 0, nil, nil, nil, err
+	}
+	basis := pb.Basis(random[2*n])
+	bits := random[:n]
+	aliceBases := make([]pb.Basis, n)
+	bobBases := make([]pb.Basis, n)
+	for i := range bits {
+		aliceBases[i] = basis
+		bobBases[i] = pb.Basis(random[n+i])
+	}
+	results, err := s.transmitBB84(ctx, bits, aliceBases, bobBases, 0)
+	if err != nil {
+		return 0, nil, nil, nil, err
+	}
+	return basis, bits, bobBases, results, nil
+}
+
+// RevealFlip opens Alice's commitment and settles the flip. A dishonest
+// Alice claiming the other basis has to invent bits for it, which Bob's
+// results in that basis contradict about half the time. Only Alice can
+// reveal; either player can fetch the result afterwards.
+func (s *CryptoServer) RevealFlip(ctx context.Context, req *pb.RevealFlipRequest) (*pb.FlipResult, error) {
+	s.flipMu.Lock()
+	defer s.flipMu.Unlock()
+	f, err := s.flip(req.FlipId)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.authorize(req.Player, req.Token); err != nil {
+		return nil, err
+	}
+	if f.Stage == pb.FlipStage_FLIP_REVEALED {
+		return f.Result, nil
+	}
+	if req.Player != f.Alice {
+		return nil, fmt.Errorf("only %s can reveal flip %s", f.Alice, req.FlipId)
+	}
+	if f.Stage != pb.FlipStage_FLIP_GUESSED {
+		return nil, fmt.Errorf("flip %s is waiting for both players to commit (%s)", req.FlipId, f.Stage)
+	}
+
+	basis, bits := f.AliceBasis, slices.Clone(f.AliceBits)
+	if req.ClaimOtherBasis {
+		// Alice never measured in the other basis, so she can only guess
+		invented, _, err := s.qrngBits(ctx, len(bits))
+		if err != nil {
+			return nil, err
+		}
+		basis, bits = 1-basis, invented
+	}
+
+	result := &pb.FlipResult{
+		FlipId:     f.ID,
+		AliceBasis: basis,
+		AliceBits:  bits,
+		Guess:      f.Guess,
+		BobWins:    f.Guess == basis,
+	}
+	for i, b := range f.BobBases {
+		if b == basis {
+			result.CheckedQubits++
+			if f.BobResults[i] != bits[i] {
+				result.Mismatches++
+			}
+		}
+	}
+	result.Verified = result.Mismatches == 0
+	switch {
+	case !result.Verified:
+		// A reveal that fails verification forfeits the flip
+		result.BobWins = true
+		result.Winner = f.Bob
+	case result.BobWins:
+		result.Winner = f.Bob
+	default:
+		result.Winner = f.Alice
+	}
+
+	f.Stage = pb.FlipStage_FLIP_REVEALED
+	f.Result = result
+	log.Printf("🪙 Coin flip %s: %s wins (verified: %v, %d/%d mismatches)",
+		f.ID, result.Winner, result.Verified, result.Mismatches, result.CheckedQubits)
+	return result, nil
+}
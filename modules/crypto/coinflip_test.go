@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+// guessedFlip plays a flip up to the point where only the reveal is left
+func guessedFlip(t *testing.T, s *CryptoServer) *pb.FlipSession {
+	t.Helper()
+	ctx := context.Background()
+	flip, err := s.CreateFlipSession(ctx, &pb.CreateFlipRequest{Alice: "alice", Bob: "bob", NumQubits: 32})
+	if err != nil {
+		t.Fatalf("CreateFlipSession: %v", err)
+	}
+	if _, err := s.CommitFlip(ctx, &pb.CommitFlipRequest{FlipId: flip.FlipId, Player: "alice", Token: flip.AliceToken}); err != nil {
+		t.Fatalf("alice's commit: %v", err)
+	}
+	if _, err := s.CommitFlip(ctx, &pb.CommitFlipRequest{
+		FlipId: flip.FlipId, Player: "bob", Token: flip.BobToken, Guess: pb.Basis_BASIS_DIAGONAL,
+	}); err != nil {
+		t.Fatalf("bob's guess: %v", err)
+	}
+	return flip
+}
+
+func TestRevealFlipOnlyByAlice(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	flip := guessedFlip(t, s)
+
+	for _, req := range []*pb.RevealFlipRequest{
+		{Player: "bob", Token: flip.BobToken, ClaimOtherBasis: true},
+		{Player: "mallory", Token: flip.BobToken, ClaimOtherBasis: true},
+		{Player: "alice", Token: flip.BobToken, ClaimOtherBasis: true},
+		{Player: "alice", ClaimOtherBasis: true},
+	} {
+		req.FlipId = flip.FlipId
+		if result, err := s.RevealFlip(ctx, req); err == nil {
+			t.Errorf("reveal as %s with token %q succeeded: %s wins", req.Player, req.Token, result.Winner)
+		}
+	}
+
+	result, err := s.RevealFlip(ctx, &pb.RevealFlipRequest{FlipId: flip.FlipId, Player: "alice", Token: flip.AliceToken})
+	if err != nil {
+		t.Fatalf("alice's reveal: %v", err)
+	}
+	if !result.Verified || result.Mismatches != 0 {
+		t.Errorf("honest reveal did not verify: %d/%d mismatches", result.Mismatches, result.CheckedQubits)
+	}
+	want := "alice"
+	if result.Guess == result.AliceBasis {
+		want = "bob"
+	}
+	if result.Winner != want {
+		t.Errorf("winner %s, want %s", result.Winner, want)
+	}
+
+	// Bob can read the settled result but not change it
+	again, err := s.RevealFlip(ctx, &pb.RevealFlipRequest{FlipId: flip.FlipId, Player: "bob", Token: flip.BobToken, ClaimOtherBasis: true})
+	if err != nil || again.Winner != result.Winner {
+		t.Errorf("bob fetching the result: %v, winner %s", err, again.GetWinner())
+	}
+}
+
+func TestCommitFlipNeedsPlayerToken(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	flip, err := s.CreateFlipSession(ctx, &pb.CreateFlipRequest{Alice: "alice", Bob: "bob"})
+	if err != nil {
+		t.Fatalf("CreateFlipSession: %v", err)
+	}
+	for _, req := range []*pb.CommitFlipRequest{
+		{Player: "alice"},
+		{Player: "alice", Token: flip.BobToken},
+		{Player: "mallory", Token: flip.AliceToken},
+	} {
+		req.FlipId = flip.FlipId
+		if _, err := s.CommitFlip(ctx, req); err == nil {
+			t.Errorf("commit as %s with token %q succeeded", req.Player, req.Token)
+		}
+	}
+}
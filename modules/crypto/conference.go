@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+	engine "github.com/perclft/QubitEngine/modules/crypto/generated/engine"
+)
+
+// Conference key agreement distributes N-qubit GHZ states, one qubit per
+// party. In key rounds everyone measures Z and gets the same bit; in test
+// rounds (announced by the initiator only after distribution) everyone
+// measures X, and an odd parity of the outcomes is an error. The key rate
+// is 1 - h(Q_X) - max_i h(Q_Z between the initiator and party i).
+
+const (
+	minConferenceParties = 3
+	maxConferenceParties = 10
+	defaultTestFraction  = 0.25
+)
+
+func (s *CryptoServer) ConferenceKey(ctx context.Context, req *pb.ConferenceKeyRequest) (*pb.ConferenceKeyResult, error) {
+	n := len(req.Parties)
+	if n < minConferenceParties || n > maxConferenceParties {
+		return nil, fmt.Errorf("a conference needs %d-%d parties", minConferenceParties, maxConferenceParties)
+	}
+	keyBits := int(req.KeyLengthBits)
+	if keyBits == 0 {
+		keyBits = defaultKeyBits
+	}
+	if keyBits < 0 || keyBits > maxKeyBits || keyBits%8 != 0 {
+		return nil, fmt.Errorf("key_length_bits must be a multiple of 8 up to %d", maxKeyBits)
+	}
+	if req.EavesdropProbability < 0 || req.EavesdropProbability > 1 {
+		return nil, fmt.Errorf("eavesdrop_probability must be between 0 and 1")
+	}
+	testFraction := req.TestFraction
+	if testFraction == 0 {
+		testFraction = defaultTestFraction
+	}
+	if testFraction <= 0 || testFraction >= 1 {
+		return nil, fmt.Errorf("test_fraction must be between 0 and 1")
+	}
+
+	// keyRounds[p] holds party p's Z outcomes; the initiator is party 0
+	keyRounds := make([][]int32, n)
+	testRounds, testErrors, rounds := 0, 0, 0
+	target := int(math.Ceil(float64(keyBits) / (1 - sampleFraction)))
+
+	for attempt := 0; attempt < maxKeyRounds; attempt++ {
+		need := int(float64(target-len(keyRounds[0]))/(1-testFraction)) + 16
+		// Which rounds are tested stays secret until distribution is over
+		test := make([]bool, need)
+		for r := range test {
+			u, err := secureFloat64()
+			if err != nil {
+				return nil, err
+			}
+			test[r] = u < testFraction
+		}
+		outcomes, err := s.distributeGHZ(ctx, n, test, req.EavesdropProbability)
+		if err != nil {
+			return nil, err
+		}
+		rounds += need
+		for r, out := range outcomes {
+			if test[r] {
+				testRounds++
+				parity := int32(0)
+				for _, bit := range out {
+					parity ^= bit
+				}
+				if parity != 0 {
+					testErrors++
+				}
+				continue
+			}
+			for p, bit := range out {
+				keyRounds[p] = append(keyRounds[p], bit)
+			}
+		}
+
+		// Disclose a random sample of key rounds to estimate the Z errors
+		total := len(keyRounds[0])
+		disclosed := int(math.Ceil(sampleFraction * float64(total)))
+		if err := secureShuffle(total, func(i, j int) {
+			for p := range keyRounds {
+				keyRounds[p][i], keyRounds[p][j] = keyRounds[p][j], keyRounds[p][i]
+			}
+		}); err != nil {
+			return nil, err
+		}
+		pairwise := make([]float64, n-1)
+		qberZ := 0.0
+		for p := 1; p < n; p++ {
+			mismatches := 0
+			for i := 0; i < disclosed; i++ {
+				if keyRounds[p][i] != keyRounds[0][i] {
+					mismatches++
+				}
+			}
+			pairwise[p-1] = float64(mismatches) / float64(disclosed)
+			qberZ = math.Max(qberZ, pairwise[p-1])
+		}
+		qberX := 0.0
+		if testRounds > 0 {
+			qberX = float64(testErrors) / float64(testRounds)
+		}
+
+		result := &pb.ConferenceKeyResult{
+			Parties:         req.Parties,
+			Rounds:          int32(rounds),
+			KeyRounds:       int32(total),
+			TestRounds:      int32(testRounds),
+			DisclosedRounds: int32(disclosed),
+			QberX:           qberX,
+			QberZ:           qberZ,
+			PairwiseQber:    pairwise,
+			KeyRate:         1 - binaryEntropy(qberX) - binaryEntropy(qberZ),
+			GeneratedAt:     time.Now().Unix(),
+		}
+		if qberX >= qberThreshold || qberZ >= qberThreshold {
+			log.Printf("🔐 Conference key aborted for %d parties: QBER X=%.2f%% Z=%.2f%%", n, qberX*100, qberZ*100)
+			return result, nil
+		}
+
+		// Error correction brings every party to the initiator's bits; its
+		// leakage is what the h(Q_Z) term charges for
+		remaining := keyRounds[0][disclosed:]
+		if float64(len(remaining))*result.KeyRate >= float64(keyBits) {
+			result.Key = privacyAmplify(remaining, keyBits)
+			result.Secure = true
+			result.KeyId = s.registerKey(result.Key, "", strings.Join(req.Parties, ","))
+			log.Printf("🔐 Conference key: %d bits for %d parties from %d GHZ rounds (QBER X=%.2f%% Z=%.2f%%)",
+				keyBits, n, rounds, qberX*100, qberZ*100)
+			return result, nil
+		}
+		if result.KeyRate > 0 {
+			target = int(math.Ceil(float64(keyBits)/result.KeyRate/(1-sampleFraction))) + 1
+		}
+	}
+	return nil, fmt.Errorf("could not distill %d conference key bits in %d rounds", keyBits, maxKeyRounds)
+}
+
+// distributeGHZ prepares one GHZ state per round and returns every
+// party's outcome, measured in X for test rounds and Z otherwise. An
+// eavesdropper at the source measures and resends each qubit of a round in
+// a random basis.
+func (s *CryptoServer) distributeGHZ(ctx context.Context, parties int, test []bool, eveProb float64) ([][]int32, error) {
+	outcomes := make([][]int32, len(test))
+	perCircuit := max(1, 20/parties)
+
+	for start := 0; start < len(test); start += perCircuit {
+		count := min(perCircuit, len(test)-start)
+		ops := make([]*engine.GateOperation, 0)
+		for r := 0; r < count; r++ {
+			base := uint32(r * parties)
+
+			// |GHZ⟩ = (|0…0⟩ + |1…1⟩)/√2
+			ops = append(ops, &engine.GateOperation{Type: engine.GateOperation_HADAMARD, TargetQubit: base})
+			for p := 1; p < parties; p++ {
+				ops = append(ops, &engine.GateOperation{
+					Type:         engine.GateOperation_CNOT,
+					ControlQubit: base,
+					TargetQubit:  base + uint32(p),
+				})
+			}
+
+			intercepted := eveProb > 0 && s.rng.Float64() < eveProb
+			for p := 0; p < parties; p++ {
+				q := base + uint32(p)
+				if intercepted {
+					// Eve's basis change is undone before resending
+					diagonal := s.rng.Intn(2) == 1
+					if diagonal {
+						ops = append(ops, &engine.GateOperation{Type: engine.GateOperation_HADAMARD, TargetQubit: q})
+					}
+					ops = append(ops, &engine.GateOperation{Type: engine.GateOperation_MEASURE, TargetQubit: q, ClassicalRegister: q + 100})
+					if diagonal {
+						ops = append(ops, &engine.GateOperation{Type: engine.GateOperation_HADAMARD, TargetQubit: q})
+					}
+				}
+				if test[start+r] {
+					ops = append(ops, &engine.GateOperation{Type: engine.GateOperation_HADAMARD, TargetQubit: q})
+				}
+				ops = append(ops, &engine.GateOperation{Type: engine.GateOperation_MEASURE, TargetQubit: q, ClassicalRegister: q})
+			}
+		}
+
+		resp, err := s.engineClient.RunCircuit(ctx, &engine.CircuitRequest{
+			NumQubits:  int32(count * parties),
+			Operations: ops,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("engine error: %v", err)
+		}
+		for r := 0; r < count; r++ {
+			out := make([]int32, parties)
+			for p := range out {
+				if resp.ClassicalResults[uint32(r*parties+p)] {
+					out[p] = 1
+				}
+			}
+			outcomes[start+r] = out
+		}
+	}
+	return outcomes, nil
+}
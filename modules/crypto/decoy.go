@@ -0,0 +1,244 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+// Decoy-state BB84 sends weak coherent pulses whose photon number is
+// Poisson-distributed, at an intensity Alice picks at random per pulse.
+// Multi-photon pulses leak the bit to a photon-number-splitting attacker,
+// who can hide by blocking single photons; since she cannot tell signal
+// and decoy pulses apart, comparing their gains exposes her. Reconciliation
+// bounds the single-photon yield and error (asymptotic vacuum + weak decoy
+// bounds of Ma, Qi, Zhao and Lo) and reports the resulting key rate.
+
+const (
+	defaultTransmittance = 0.2
+	defaultDarkCountRate = 1e-5
+	// errorCorrectionEfficiency is the usual f ≥ 1 penalty on h(E)
+	errorCorrectionEfficiency = 1.16
+	// noClick marks Bob's measurement when nothing was detected
+	noClick = -1
+)
+
+type decoyLevel struct {
+	Name        string
+	Mean        float64
+	Probability float64
+}
+
+// decoySettings describes the source and channel of a decoy-state session
+type decoySettings struct {
+	Levels        []decoyLevel
+	Signal        int // Index of the brightest level, the only one keyed
+	Transmittance float64
+	DarkCountRate float64
+	PNSAttack     bool
+}
+
+func defaultDecoyLevels() []decoyLevel {
+	return []decoyLevel{
+		{Name: "signal", Mean: 0.5, Probability: 0.7},
+		{Name: "decoy", Mean: 0.1, Probability: 0.2},
+		{Name: "vacuum", Mean: 0, Probability: 0.1},
+	}
+}
+
+func newDecoySettings(cfg *pb.DecoyConfig) (*decoySettings, error) {
+	d := &decoySettings{
+		Transmittance: cfg.Transmittance,
+		DarkCountRate: cfg.DarkCountRate,
+		PNSAttack:     cfg.PnsAttack,
+	}
+	if d.Transmittance == 0 {
+		d.Transmittance = defaultTransmittance
+	}
+	if d.DarkCountRate == 0 {
+		d.DarkCountRate = defaultDarkCountRate
+	}
+	if d.Transmittance <= 0 || d.Transmittance > 1 || d.DarkCountRate < 0 || d.DarkCountRate >= 1 {
+		return nil, fmt.Errorf("transmittance must be in (0, 1] and dark_count_rate in [0, 1)")
+	}
+
+	for _, l := range cfg.Levels {
+		d.Levels = append(d.Levels, decoyLevel{Name: l.Name, Mean: l.MeanPhotonNumber, Probability: l.Probability})
+	}
+	if len(d.Levels) == 0 {
+		d.Levels = defaultDecoyLevels()
+	}
+	total, decoys := 0.0, 0
+	for i, l := range d.Levels {
+		if l.Mean < 0 || l.Probability <= 0 {
+			return nil, fmt.Errorf("decoy level %d needs a non-negative mean and a positive probability", i)
+		}
+		total += l.Probability
+		if l.Mean > d.Levels[d.Signal].Mean {
+			d.Signal = i
+		}
+	}
+	for i, l := range d.Levels {
+		if i != d.Signal && l.Mean > 0 && l.Mean < d.Levels[d.Signal].Mean {
+			decoys++
+		}
+	}
+	if decoys == 0 {
+		return nil, fmt.Errorf("decoy levels need a signal and at least one weaker non-vacuum decoy")
+	}
+	if math.Abs(total-1) > 1e-6 {
+		return nil, fmt.Errorf("decoy level probabilities sum to %.3f, not 1", total)
+	}
+	return d, nil
+}
+
+// drawLevels picks each pulse's intensity level. Which pulses are decoys
+// must stay hidden from Eve, so the draw uses crypto/rand.
+func (d *decoySettings) drawLevels(n int) ([]int32, error) {
+	levels := make([]int32, n)
+	for i := range levels {
+		u, err := secureFloat64()
+		if err != nil {
+			return nil, err
+		}
+		for j, l := range d.Levels {
+			if u < l.Probability || j == len(d.Levels)-1 {
+				levels[i] = int32(j)
+				break
+			}
+			u -= l.Probability
+		}
+	}
+	return levels, nil
+}
+
+// applyChannel decides which pulses Bob detects, overwriting lost ones
+// with noClick and dark counts with a random bit. A PNS attacker blocks
+// single-photon pulses, keeps a photon of each multi-photon pulse and
+// forwards the rest losslessly, just often enough that the signal gain
+// matches an honest channel.
+func (d *decoySettings) applyChannel(rng *rand.Rand, levels []int32, measures []int32) {
+	forward := 1.0
+	if d.PNSAttack {
+		mu := d.Levels[d.Signal].Mean
+		honest := 1 - math.Exp(-d.Transmittance*mu)
+		multi := 1 - math.Exp(-mu)*(1+mu)
+		forward = math.Min(1, honest/multi)
+	}
+
+	for i, level := range levels {
+		photons := poisson(rng, d.Levels[level].Mean)
+		var detect float64
+		switch {
+		case !d.PNSAttack:
+			detect = 1 - math.Pow(1-d.Transmittance, float64(photons))
+		case photons >= 2:
+			detect = forward
+		}
+		if rng.Float64() < detect {
+			continue
+		}
+		if rng.Float64() < d.DarkCountRate {
+			measures[i] = int32(rng.Intn(2))
+		} else {
+			measures[i] = noClick
+		}
+	}
+}
+
+// poisson draws by Knuth's method, fine for the small means used here
+func poisson(rng *rand.Rand, mean float64) int {
+	limit, k, p := math.Exp(-mean), 0, rng.Float64()
+	for p > limit {
+		k++
+		p *= rng.Float64()
+	}
+	return k
+}
+
+// keyed reports whether a position may contribute to the key: decoy
+// pulses are only there for the statistics
+func (session *BB84Session) keyed(i int) bool {
+	return session.Decoy == nil || int(session.PulseLevels[i]) == session.Decoy.Signal
+}
+
+// decoyClassStats tallies gain and sifted error rate per intensity level
+func (session *BB84Session) decoyClassStats() []*pb.DecoyClassStats {
+	stats := make([]*pb.DecoyClassStats, len(session.Decoy.Levels))
+	for j, l := range session.Decoy.Levels {
+		stats[j] = &pb.DecoyClassStats{Name: l.Name, MeanPhotonNumber: l.Mean}
+	}
+	for i, level := range session.PulseLevels {
+		c := stats[level]
+		c.Pulses++
+		if session.BobMeasures[i] == noClick {
+			continue
+		}
+		c.Detections++
+		if session.AliceBases[i] == session.BobBases[i] {
+			c.SiftedBits++
+			if session.AliceBits[i] != session.BobMeasures[i] {
+				c.Errors++
+			}
+		}
+	}
+	for _, c := range stats {
+		if c.Pulses > 0 {
+			c.Gain = float64(c.Detections) / float64(c.Pulses)
+		}
+		if c.SiftedBits > 0 {
+			c.ErrorRate = float64(c.Errors) / float64(c.SiftedBits)
+		}
+	}
+	return stats
+}
+
+// analyzeDecoys bounds the single-photon contribution from the signal,
+// the weakest non-vacuum decoy and (if sent) the vacuum level, and returns
+// the GLLP key rate per signal pulse
+func analyzeDecoys(d *decoySettings, stats []*pb.DecoyClassStats) *pb.DecoyAnalysis {
+	signal, weak, vacuum := stats[d.Signal], (*pb.DecoyClassStats)(nil), (*pb.DecoyClassStats)(nil)
+	for i, c := range stats {
+		switch {
+		case i == d.Signal:
+		case c.MeanPhotonNumber == 0:
+			vacuum = c
+		case weak == nil || c.MeanPhotonNumber < weak.MeanPhotonNumber:
+			weak = c
+		}
+	}
+	mu, nu := signal.MeanPhotonNumber, weak.MeanPhotonNumber
+	qMu, eMu := signal.Gain, signal.ErrorRate
+	qNu, eNu := weak.Gain, weak.ErrorRate
+	const e0 = 0.5 // Background counts are random
+
+	// Y0 enters Y1's bound with a minus sign and e1's with a plus, so the
+	// bounds need it from above and below respectively
+	var y0Low, y0High float64
+	if vacuum != nil {
+		y0Low, y0High = vacuum.Gain, vacuum.Gain
+	} else {
+		y0Low = math.Max(0, (nu*qMu*math.Exp(mu)-mu*qNu*math.Exp(nu))/(nu-mu))
+		y0High = eNu * qNu * math.Exp(nu) / e0
+	}
+
+	y1 := mu / (mu*nu - nu*nu) * (qNu*math.Exp(nu) - qMu*math.Exp(mu)*nu*nu/(mu*mu) - (mu*mu-nu*nu)/(mu*mu)*y0High)
+	y1 = math.Max(0, y1)
+	e1 := e0
+	if y1 > 0 {
+		e1 = math.Max(0, math.Min(e0, (eNu*qNu*math.Exp(nu)-e0*y0Low)/(y1*nu)))
+	}
+	q1 := y1 * mu * math.Exp(-mu)
+	rate := 0.5 * (q1*(1-binaryEntropy(e1)) - qMu*errorCorrectionEfficiency*binaryEntropy(eMu))
+
+	return &pb.DecoyAnalysis{
+		BackgroundYield:        y0High,
+		SinglePhotonYieldLower: y1,
+		SinglePhotonErrorUpper: e1,
+		SinglePhotonGainLower:  q1,
+		KeyRate:                rate,
+		PnsSuspected:           rate <= 0 && eMu < qberThreshold,
+	}
+}
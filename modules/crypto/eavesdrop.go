@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+const defaultConfidence = 0.95
+
+// DetectEavesdropping estimates the QBER from check bits and decides
+// whether the key is safe to use. For a stored session it discloses a
+// random sample of the sifted bits (which ReconcileBB84 then leaves out
+// of the key); otherwise the caller supplies Alice's and Bob's check bits.
+//
+// The decision compares a Wilson confidence interval on the QBER with the
+// abort threshold: proceed when the whole interval lies below it, abort
+// when it lies above, and retry with more check bits when it straddles.
+func (s *CryptoServer) DetectEavesdropping(ctx context.Context, req *pb.EavesdropRequest) (*pb.EavesdropResult, error) {
+	confidence := req.Confidence
+	if confidence == 0 {
+		confidence = defaultConfidence
+	}
+	if confidence <= 0 || confidence >= 1 {
+		return nil, fmt.Errorf("confidence must be between 0 and 1")
+	}
+	threshold := req.AbortThreshold
+	if threshold == 0 {
+		threshold = qberThreshold
+		if req.SessionId != "" {
+			if session, err := s.sessions.Get(ctx, req.SessionId); err == nil {
+				threshold = session.policy().QBERThreshold
+			}
+		}
+	}
+	if threshold <= 0 || threshold >= 0.5 {
+		return nil, fmt.Errorf("abort_threshold must be between 0 and 0.5")
+	}
+	if req.ChannelErrorRate < 0 || req.ChannelErrorRate >= threshold {
+		return nil, fmt.Errorf("channel_error_rate must be non-negative and below the abort threshold")
+	}
+
+	var alice, bob []int32
+	if len(req.AliceCheckBits) > 0 || len(req.BobCheckBits) > 0 {
+		if len(req.AliceCheckBits) != len(req.BobCheckBits) {
+			return nil, fmt.Errorf("alice_check_bits and bob_check_bits differ in length")
+		}
+		alice, bob = req.AliceCheckBits, req.BobCheckBits
+	} else {
+		var err error
+		if alice, bob, err = s.discloseSample(ctx, req.SessionId, req.SampleFraction); err != nil {
+			return nil, err
+		}
+	}
+
+	n, errors := len(alice), 0
+	for i := range alice {
+		if alice[i] != bob[i] {
+			errors++
+		}
+	}
+	qber := float64(errors) / float64(n)
+	lower, upper := wilsonInterval(errors, n, confidence)
+	pValue := binomialTail(errors, n, req.ChannelErrorRate)
+
+	result := &pb.EavesdropResult{
+		ErrorRate:                     qber,
+		EavesdropperDetected:          pValue < 1-confidence,
+		SampleSize:                    int32(n),
+		Errors:                        int32(errors),
+		ConfidenceLower:               lower,
+		ConfidenceUpper:               upper,
+		Confidence:                    confidence,
+		PValue:                        pValue,
+		EstimatedEavesdropProbability: interceptFraction(qber, req.ChannelErrorRate),
+		AbortThreshold:                threshold,
+	}
+	switch {
+	case upper < threshold:
+		result.Recommendation = "proceed"
+	case lower > threshold:
+		result.Recommendation = "abort"
+		result.Abort = true
+	default:
+		result.Recommendation = "retry"
+	}
+
+	log.Printf("🕵️ Eavesdrop check %s: QBER=%.2f%% [%.2f%%, %.2f%%] over %d bits, p=%.3g → %s",
+		req.SessionId, qber*100, lower*100, upper*100, n, pValue, result.Recommendation)
+	return result, nil
+}
+
+// discloseSample reveals a random fraction of the session's undisclosed
+// sifted bits
+func (s *CryptoServer) discloseSample(ctx context.Context, sessionID string, fraction float64) ([]int32, []int32, error) {
+	if fraction == 0 {
+		fraction = sampleFraction
+	}
+	if fraction <= 0 || fraction > 1 {
+		return nil, nil, fmt.Errorf("sample_fraction must be in (0, 1]")
+	}
+
+	var alice, bob []int32
+	err := s.sessions.Update(ctx, sessionID, func(session *BB84Session) error {
+		if session.Aborted {
+			return fmt.Errorf("session %s was aborted: %s", sessionID, session.AbortReason)
+		}
+		if session.BobMeasures == nil {
+			return fmt.Errorf("session %s has no measurements yet", sessionID)
+		}
+		if session.Disclosed == nil {
+			session.Disclosed = make([]bool, len(session.AliceBits))
+		}
+
+		var candidates []int
+		for _, i := range session.siftedIndices() {
+			if !session.Disclosed[i] {
+				candidates = append(candidates, i)
+			}
+		}
+		n := int(math.Ceil(fraction * float64(len(candidates))))
+		if n == 0 {
+			return fmt.Errorf("session %s has no undisclosed sifted bits", sessionID)
+		}
+		if err := secureShuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		}); err != nil {
+			return err
+		}
+
+		alice, bob = make([]int32, n), make([]int32, n)
+		for k, i := range candidates[:n] {
+			session.Disclosed[i] = true
+			alice[k], bob[k] = session.AliceBits[i], session.bobBit(i)
+		}
+		return nil
+	})
+	return alice, bob, err
+}
+
+// wilsonInterval is the two-sided Wilson score interval for k successes
+// in n trials, which stays sensible when k is 0 or n
+func wilsonInterval(k, n int, confidence float64) (float64, float64) {
+	z := math.Sqrt2 * math.Erfinv(confidence)
+	p, nf := float64(k)/float64(n), float64(n)
+	center := (p + z*z/(2*nf)) / (1 + z*z/nf)
+	half := z / (1 + z*z/nf) * math.Sqrt(p*(1-p)/nf+z*z/(4*nf*nf))
+	return math.Max(0, center-half), math.Min(1, center+half)
+}
+
+// binomialTail is P(X ≥ k) for X ~ Binomial(n, p): the p-value of k
+// errors when the channel alone errs at rate p
+func binomialTail(k, n int, p float64) float64 {
+	if k == 0 {
+		return 1
+	}
+	if p <= 0 {
+		return 0
+	}
+	lgN, _ := math.Lgamma(float64(n + 1))
+	tail := 0.0
+	for i := k; i <= n; i++ {
+		lgI, _ := math.Lgamma(float64(i + 1))
+		lgNI, _ := math.Lgamma(float64(n - i + 1))
+		tail += math.Exp(lgN - lgI - lgNI + float64(i)*math.Log(p) + float64(n-i)*math.Log1p(-p))
+	}
+	return math.Min(1, tail)
+}
+
+// interceptFraction inverts the intercept-resend error model
+// QBER = e + f/4·(1 − 2e) for the fraction f of qubits Eve measured
+func interceptFraction(qber, channelError float64) float64 {
+	f := 4 * (qber - channelError) / (1 - 2*channelError)
+	return math.Max(0, math.Min(1, f))
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+// aesKeyBytes of pad are consumed per AES-256-GCM message
+const aesKeyBytes = 32
+
+// normalizeAlgorithm maps request names onto "otp" and "aes-gcm" ("" is auto)
+func normalizeAlgorithm(name string) (string, error) {
+	switch strings.ToLower(name) {
+	case "":
+		return "", nil
+	case "otp":
+		return "otp", nil
+	case "aes-gcm", "aes-qrng":
+		return "aes-gcm", nil
+	}
+	return "", fmt.Errorf("unsupported algorithm %q (use otp or aes-gcm)", name)
+}
+
+// QuantumEncrypt encrypts with a pooled quantum key (key_id) or a caller
+// key. One-time pads consume as many key bytes as the message; messages
+// longer than the remaining pad fall back to AES-256-GCM under a fresh
+// 32-byte slice of it.
+func (s *CryptoServer) QuantumEncrypt(ctx context.Context, req *pb.EncryptRequest) (*pb.EncryptedMessage, error) {
+	algorithm, err := normalizeAlgorithm(req.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if (req.KeyId == "") == (len(req.Key) == 0) {
+		return nil, fmt.Errorf("provide exactly one of key_id and key")
+	}
+	if req.KeyId != "" {
+		return s.encryptPooled(req, algorithm)
+	}
+
+	if algorithm == "" {
+		algorithm = "otp"
+		if len(req.Key) < len(req.Plaintext) {
+			algorithm = "aes-gcm"
+		}
+	}
+	out := &pb.EncryptedMessage{Algorithm: algorithm}
+	switch algorithm {
+	case "otp":
+		if len(req.Key) < len(req.Plaintext) {
+			return nil, fmt.Errorf("one-time pad of %d bytes is shorter than the %d-byte message", len(req.Key), len(req.Plaintext))
+		}
+		// Caller pads are fingerprinted so the same pad is refused twice
+		pad := req.Key[:len(req.Plaintext)]
+		digest := sha256.Sum256(pad)
+		s.padMu.Lock()
+		reused := s.usedPads[digest]
+		s.usedPads[digest] = true
+		s.padMu.Unlock()
+		if reused {
+			return nil, fmt.Errorf("one-time pad has already been used")
+		}
+		out.Ciphertext = xorBytes(req.Plaintext, pad)
+		out.KeyBytesUsed = int32(len(pad))
+	default:
+		out.Ciphertext, out.Nonce, err = sealGCM(req.Key, req.Plaintext)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (s *CryptoServer) encryptPooled(req *pb.EncryptRequest, algorithm string) (*pb.EncryptedMessage, error) {
+	if algorithm == "" {
+		remaining, err := s.keys.remaining(req.KeyId, req.ReservationId)
+		if err != nil {
+			return nil, err
+		}
+		algorithm = "otp"
+		if remaining < len(req.Plaintext) {
+			algorithm = "aes-gcm"
+		}
+	}
+
+	need := len(req.Plaintext)
+	if algorithm == "aes-gcm" {
+		need = aesKeyBytes
+	}
+	pad, err := s.keys.consume(req.KeyId, req.ReservationId, need)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.EncryptedMessage{
+		Algorithm:         algorithm,
+		KeyId:             req.KeyId,
+		KeyOffset:         pad.Offset,
+		KeyBytesUsed:      int32(need),
+		KeyBytesRemaining: pad.AvailableBytes,
+	}
+	if algorithm == "otp" {
+		out.Ciphertext = xorBytes(req.Plaintext, pad.Material)
+	} else if out.Ciphertext, out.Nonce, err = sealGCM(pad.Material, req.Plaintext); err != nil {
+		return nil, err
+	}
+	log.Printf("🔒 Encrypted %d bytes with %s (key %s, %d bytes left)", len(req.Plaintext), algorithm, req.KeyId, pad.AvailableBytes)
+	return out, nil
+}
+
+// QuantumDecrypt reverses QuantumEncrypt; pooled keys are located by
+// key_id and the key_offset the encryption reported
+func (s *CryptoServer) QuantumDecrypt(ctx context.Context, req *pb.DecryptRequest) (*pb.DecryptedMessage, error) {
+	algorithm, err := normalizeAlgorithm(req.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if algorithm == "" {
+		algorithm = "otp"
+		if len(req.Nonce) > 0 {
+			algorithm = "aes-gcm"
+		}
+	}
+
+	key := req.Key
+	if req.KeyId != "" {
+		need := len(req.Ciphertext)
+		if algorithm == "aes-gcm" {
+			need = aesKeyBytes
+		}
+		if key, err = s.keys.consumed(req.KeyId, int(req.KeyOffset), need); err != nil {
+			return nil, err
+		}
+	}
+
+	if algorithm == "otp" {
+		if len(key) < len(req.Ciphertext) {
+			return nil, fmt.Errorf("one-time pad of %d bytes is shorter than the %d-byte message", len(key), len(req.Ciphertext))
+		}
+		return &pb.DecryptedMessage{Plaintext: xorBytes(req.Ciphertext, key), Valid: true}, nil
+	}
+	plaintext, err := openGCM(key, req.Nonce, req.Ciphertext)
+	if err != nil {
+		return &pb.DecryptedMessage{Valid: false}, nil
+	}
+	return &pb.DecryptedMessage{Plaintext: plaintext, Valid: true}, nil
+}
+
+func xorBytes(data, pad []byte) []byte {
+	out := make([]byte, len(data))
+	for i := range data {
+		out[i] = data[i] ^ pad[i]
+	}
+	return out
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("aes-gcm key: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func sealGCM(key, plaintext []byte) ([]byte, []byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := crand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return gcm.Seal(nil, nonce, plaintext, nil), nonce, nil
+}
+
+func openGCM(key, nonce, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("nonce must be %d bytes", gcm.NonceSize())
+	}
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+// TLS 1.3 external PSKs (RFC 8446 §4.2.11, RFC 9258) are an identity, a
+// secret and the hash the secret is used with. The secret is taken as
+// unused pad, so each exported PSK is fresh key material that is never
+// handed out again, and its length matches the hash so both cipher suites
+// get full strength.
+
+const sinkTimeout = 10 * time.Second
+
+var pskSuites = map[pb.PskHash]struct {
+	size  int
+	suite string
+}{
+	pb.PskHash_PSK_SHA256: {32, "TLS_AES_128_GCM_SHA256"},
+	pb.PskHash_PSK_SHA384: {48, "TLS_AES_256_GCM_SHA384"},
+}
+
+// keySink receives exported PSKs on behalf of the services that use them
+// and returns a reference to where the key was stored
+type keySink interface {
+	Push(ctx context.Context, psk *pb.TlsPsk) (string, error)
+}
+
+// pskRecord is what sinks store: everything a TLS endpoint needs
+func pskRecord(psk *pb.TlsPsk) map[string]string {
+	return map[string]string{
+		"identity":     psk.Identity,
+		"key":          hex.EncodeToString(psk.Key),
+		"hash":         psk.Hash.String(),
+		"cipher_suite": psk.CipherSuite,
+		"key_id":       psk.KeyId,
+		"peer":         psk.Peer,
+	}
+}
+
+// vaultSink writes PSKs to a KV version 2 secrets engine, one secret per
+// identity under mount/data/path
+type vaultSink struct {
+	addr, token, mount, path string
+	client                   *http.Client
+}
+
+func newVaultSink(addr, token, mount, path string) *vaultSink {
+	return &vaultSink{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		mount:  strings.Trim(mount, "/"),
+		path:   strings.Trim(path, "/"),
+		client: &http.Client{Timeout: sinkTimeout},
+	}
+}
+
+func (v *vaultSink) Push(ctx context.Context, psk *pb.TlsPsk) (string, error) {
+	secret := v.path + "/" + url.PathEscape(psk.Identity)
+	body, _ := json.Marshal(map[string]any{"data": pskRecord(psk)})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/v1/%s/data/%s", v.addr, v.mount, secret), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("vault: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	var written struct {
+		Data struct {
+			Version int `json:"version"`
+		} `json:"data"`
+	}
+	json.NewDecoder(resp.Body).Decode(&written)
+	return fmt.Sprintf("vault:%s/%s?version=%d", v.mount, secret, written.Data.Version), nil
+}
+
+// webhookSink posts PSKs as JSON to a KMS gateway or any other service
+// that takes keys over HTTP
+type webhookSink struct {
+	url, token string
+	client     *http.Client
+}
+
+func newWebhookSink(url, token string) *webhookSink {
+	return &webhookSink{url: url, token: token, client: &http.Client{Timeout: sinkTimeout}}
+}
+
+func (w *webhookSink) Push(ctx context.Context, psk *pb.TlsPsk) (string, error) {
+	body, _ := json.Marshal(pskRecord(psk))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.token)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("webhook: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("webhook: %s", resp.Status)
+	}
+	ref := resp.Header.Get("Location")
+	if ref == "" {
+		ref = w.url
+	}
+	return "webhook:" + ref, nil
+}
+
+// ExportPSK turns the next unused pad bytes of a key into a TLS 1.3
+// external PSK. With a sink named the PSK is pushed there and the secret
+// is left out of the reply; if the push fails the bytes stay used, since
+// they may have reached the sink.
+func (s *CryptoServer) ExportPSK(ctx context.Context, req *pb.ExportPskRequest) (*pb.TlsPsk, error) {
+	suite, ok := pskSuites[req.Hash]
+	if !ok {
+		return nil, fmt.Errorf("unsupported PSK hash %v", req.Hash)
+	}
+	var sink keySink
+	if req.Sink != "" {
+		if sink, ok = s.sinks[req.Sink]; !ok {
+			return nil, fmt.Errorf("key sink %q is not configured", req.Sink)
+		}
+	}
+	// PSK files separate identity and key with the first colon
+	if len(req.Identity) > 0xffff || strings.Contains(req.Identity, ":") {
+		return nil, fmt.Errorf("identity must be at most 65535 bytes and contain no colon")
+	}
+	info, err := s.keys.get(req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	material, err := s.keys.consume(req.KeyId, req.ReservationId, suite.size)
+	if err != nil {
+		return nil, err
+	}
+	psk := &pb.TlsPsk{
+		KeyId:       req.KeyId,
+		Peer:        info.Peer,
+		Identity:    req.Identity,
+		Key:         material.Material,
+		Hash:        req.Hash,
+		CipherSuite: suite.suite,
+		Offset:      material.Offset,
+		ExportedAt:  time.Now().Unix(),
+	}
+	if psk.Identity == "" {
+		psk.Identity = fmt.Sprintf("%s.%d", req.KeyId, material.Offset)
+	}
+
+	if sink != nil {
+		ref, err := sink.Push(ctx, psk)
+		if err != nil {
+			return nil, fmt.Errorf("push to %s failed: %v", req.Sink, err)
+		}
+		psk.Key = nil
+		psk.Sink, psk.SinkRef = req.Sink, ref
+		log.Printf("🔑 Exported PSK %s from key %s to %s", psk.Identity, req.KeyId, ref)
+		return psk, nil
+	}
+
+	secret := hex.EncodeToString(psk.Key)
+	psk.OpensslArgs = fmt.Sprintf("-psk_identity %s -psk %s -ciphersuites %s", psk.Identity, secret, psk.CipherSuite)
+	psk.PskFileLine = psk.Identity + ":" + secret
+	log.Printf("🔑 Exported PSK %s from key %s", psk.Identity, req.KeyId)
+	return psk, nil
+}
+
+// ListKeySinks names the configured sinks
+func (s *CryptoServer) ListKeySinks(ctx context.Context, req *pb.ListKeySinksRequest) (*pb.KeySinkList, error) {
+	list := &pb.KeySinkList{}
+	for name := range s.sinks {
+		list.Sinks = append(list.Sinks, name)
+	}
+	slices.Sort(list.Sinks)
+	return list, nil
+}
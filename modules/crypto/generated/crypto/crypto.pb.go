@@ -67,11 +67,264 @@ func (Basis) EnumDescriptor() ([]byte, []int) {
 	return file_crypto_crypto_proto_rawDescGZIP(), []int{0}
 }
 
+// Sessions run BB84 (four states, keep matching bases) or B92 (two
+// non-orthogonal states 0 → |0⟩, 1 → |+⟩; keep Bob's conclusive 1
+// outcomes). Both share sessions, eavesdrop checks and reconciliation.
+type Protocol int32
+
+const (
+	Protocol_PROTOCOL_BB84 Protocol = 0
+	Protocol_PROTOCOL_B92  Protocol = 1
+)
+
+// Enum value maps for Protocol.
+var (
+	Protocol_name = map[int32]string{
+		0: "PROTOCOL_BB84",
+		1: "PROTOCOL_B92",
+	}
+	Protocol_value = map[string]int32{
+		"PROTOCOL_BB84": 0,
+		"PROTOCOL_B92":  1,
+	}
+)
+
+func (x Protocol) Enum() *Protocol {
+	p := new(Protocol)
+	*p = x
+	return p
+}
+
+func (x Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_crypto_proto_enumTypes[1].Descriptor()
+}
+
+func (Protocol) Type() protoreflect.EnumType {
+	return &file_crypto_crypto_proto_enumTypes[1]
+}
+
+func (x Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Protocol.Descriptor instead.
+func (Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{1}
+}
+
+type SessionState int32
+
+const (
+	SessionState_SESSION_PREPARED   SessionState = 0 // Alice has sent; Bob has not measured
+	SessionState_SESSION_MEASURED   SessionState = 1
+	SessionState_SESSION_RECONCILED SessionState = 2
+	SessionState_SESSION_ABORTED    SessionState = 3
+)
+
+// Enum value maps for SessionState.
+var (
+	SessionState_name = map[int32]string{
+		0: "SESSION_PREPARED",
+		1: "SESSION_MEASURED",
+		2: "SESSION_RECONCILED",
+		3: "SESSION_ABORTED",
+	}
+	SessionState_value = map[string]int32{
+		"SESSION_PREPARED":   0,
+		"SESSION_MEASURED":   1,
+		"SESSION_RECONCILED": 2,
+		"SESSION_ABORTED":    3,
+	}
+)
+
+func (x SessionState) Enum() *SessionState {
+	p := new(SessionState)
+	*p = x
+	return p
+}
+
+func (x SessionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_crypto_proto_enumTypes[2].Descriptor()
+}
+
+func (SessionState) Type() protoreflect.EnumType {
+	return &file_crypto_crypto_proto_enumTypes[2]
+}
+
+func (x SessionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionState.Descriptor instead.
+func (SessionState) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{2}
+}
+
+type FlipStage int32
+
+const (
+	FlipStage_FLIP_CREATED   FlipStage = 0
+	FlipStage_FLIP_COMMITTED FlipStage = 1 // Alice's qubits have been measured by Bob
+	FlipStage_FLIP_GUESSED   FlipStage = 2
+	FlipStage_FLIP_REVEALED  FlipStage = 3
+)
+
+// Enum value maps for FlipStage.
+var (
+	FlipStage_name = map[int32]string{
+		0: "FLIP_CREATED",
+		1: "FLIP_COMMITTED",
+		2: "FLIP_GUESSED",
+		3: "FLIP_REVEALED",
+	}
+	FlipStage_value = map[string]int32{
+		"FLIP_CREATED":   0,
+		"FLIP_COMMITTED": 1,
+		"FLIP_GUESSED":   2,
+		"FLIP_REVEALED":  3,
+	}
+)
+
+func (x FlipStage) Enum() *FlipStage {
+	p := new(FlipStage)
+	*p = x
+	return p
+}
+
+func (x FlipStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlipStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_crypto_proto_enumTypes[3].Descriptor()
+}
+
+func (FlipStage) Type() protoreflect.EnumType {
+	return &file_crypto_crypto_proto_enumTypes[3]
+}
+
+func (x FlipStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlipStage.Descriptor instead.
+func (FlipStage) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{3}
+}
+
+type KeyState int32
+
+const (
+	KeyState_KEY_ACTIVE    KeyState = 0
+	KeyState_KEY_RETIRED   KeyState = 1
+	KeyState_KEY_DESTROYED KeyState = 2
+)
+
+// Enum value maps for KeyState.
+var (
+	KeyState_name = map[int32]string{
+		0: "KEY_ACTIVE",
+		1: "KEY_RETIRED",
+		2: "KEY_DESTROYED",
+	}
+	KeyState_value = map[string]int32{
+		"KEY_ACTIVE":    0,
+		"KEY_RETIRED":   1,
+		"KEY_DESTROYED": 2,
+	}
+)
+
+func (x KeyState) Enum() *KeyState {
+	p := new(KeyState)
+	*p = x
+	return p
+}
+
+func (x KeyState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyState) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_crypto_proto_enumTypes[4].Descriptor()
+}
+
+func (KeyState) Type() protoreflect.EnumType {
+	return &file_crypto_crypto_proto_enumTypes[4]
+}
+
+func (x KeyState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyState.Descriptor instead.
+func (KeyState) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{4}
+}
+
+type PskHash int32
+
+const (
+	PskHash_PSK_SHA256 PskHash = 0 // 32-byte key, TLS_AES_128_GCM_SHA256
+	PskHash_PSK_SHA384 PskHash = 1 // 48-byte key, TLS_AES_256_GCM_SHA384
+)
+
+// Enum value maps for PskHash.
+var (
+	PskHash_name = map[int32]string{
+		0: "PSK_SHA256",
+		1: "PSK_SHA384",
+	}
+	PskHash_value = map[string]int32{
+		"PSK_SHA256": 0,
+		"PSK_SHA384": 1,
+	}
+)
+
+func (x PskHash) Enum() *PskHash {
+	p := new(PskHash)
+	*p = x
+	return p
+}
+
+func (x PskHash) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PskHash) Descriptor() protoreflect.EnumDescriptor {
+	return file_crypto_crypto_proto_enumTypes[5].Descriptor()
+}
+
+func (PskHash) Type() protoreflect.EnumType {
+	return &file_crypto_crypto_proto_enumTypes[5]
+}
+
+func (x PskHash) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PskHash.Descriptor instead.
+func (PskHash) EnumDescriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{5}
+}
+
 type BB84AliceRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	NumBits              int32                  `protobuf:"varint,1,opt,name=num_bits,json=numBits,proto3" json:"num_bits,omitempty"` // Number of qubits to send
 	SessionId            string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	EavesdropProbability float64                `protobuf:"fixed64,3,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"` // Simulation: Probability of eavesdropping
+	Protocol             Protocol               `protobuf:"varint,4,opt,name=protocol,proto3,enum=qubit_engine.crypto.Protocol" json:"protocol,omitempty"`
+	AuthKey              []byte                 `protobuf:"bytes,5,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`           // Pre-shared secret (16-64 bytes) authenticating the classical channel
+	Decoy                *DecoyConfig           `protobuf:"bytes,6,opt,name=decoy,proto3" json:"decoy,omitempty"`                              // BB84 only: weak coherent pulses with decoy intensities
+	TtlSeconds           int32                  `protobuf:"varint,7,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Session lifetime (default: server -session-ttl, max 1 day)
+	Policy               *SecurityPolicy        `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`                            // Rules the reconciled key must meet (default: 10% QBER only)
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -127,19 +380,128 @@ func (x *BB84AliceRequest) GetEavesdropProbability() float64 {
 	return 0
 }
 
-type BB84AliceState struct {
+func (x *BB84AliceRequest) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_BB84
+}
+
+func (x *BB84AliceRequest) GetAuthKey() []byte {
+	if x != nil {
+		return x.AuthKey
+	}
+	return nil
+}
+
+func (x *BB84AliceRequest) GetDecoy() *DecoyConfig {
+	if x != nil {
+		return x.Decoy
+	}
+	return nil
+}
+
+func (x *BB84AliceRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *BB84AliceRequest) GetPolicy() *SecurityPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Fixed when Alice starts a session and echoed in BB84Key. Zero fields
+// take the default.
+type SecurityPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Bits          []int32                `protobuf:"varint,2,rep,packed,name=bits,proto3" json:"bits,omitempty"`                                  // Alice's random bits
-	Bases         []Basis                `protobuf:"varint,3,rep,packed,name=bases,proto3,enum=qubit_engine.crypto.Basis" json:"bases,omitempty"` // Alice's random basis choices
-	QuantumStates []byte                 `protobuf:"bytes,4,opt,name=quantum_states,json=quantumStates,proto3" json:"quantum_states,omitempty"`   // Encoded quantum states (simulated)
+	QberThreshold float64                `protobuf:"fixed64,1,opt,name=qber_threshold,json=qberThreshold,proto3" json:"qber_threshold,omitempty"`  // Abort at or above this QBER (default 0.1, below 0.5)
+	MinSiftedBits int32                  `protobuf:"varint,2,opt,name=min_sifted_bits,json=minSiftedBits,proto3" json:"min_sifted_bits,omitempty"` // Bits surviving sifting (default 0)
+	MinKeyBits    int32                  `protobuf:"varint,3,opt,name=min_key_bits,json=minKeyBits,proto3" json:"min_key_bits,omitempty"`          // Finite-key secure bits (default 0)
+	Epsilon       float64                `protobuf:"fixed64,4,opt,name=epsilon,proto3" json:"epsilon,omitempty"`                                   // Finite-key security parameter (default 1e-10, max 1e-2)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *SecurityPolicy) Reset() {
+	*x = SecurityPolicy{}
+	mi := &file_crypto_crypto_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityPolicy) ProtoMessage() {}
+
+func (x *SecurityPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityPolicy.ProtoReflect.Descriptor instead.
+func (*SecurityPolicy) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SecurityPolicy) GetQberThreshold() float64 {
+	if x != nil {
+		return x.QberThreshold
+	}
+	return 0
+}
+
+func (x *SecurityPolicy) GetMinSiftedBits() int32 {
+	if x != nil {
+		return x.MinSiftedBits
+	}
+	return 0
+}
+
+func (x *SecurityPolicy) GetMinKeyBits() int32 {
+	if x != nil {
+		return x.MinKeyBits
+	}
+	return 0
+}
+
+func (x *SecurityPolicy) GetEpsilon() float64 {
+	if x != nil {
+		return x.Epsilon
+	}
+	return 0
+}
+
+type BB84AliceState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Bits            []int32                `protobuf:"varint,2,rep,packed,name=bits,proto3" json:"bits,omitempty"`                                  // Alice's random bits
+	Bases           []Basis                `protobuf:"varint,3,rep,packed,name=bases,proto3,enum=qubit_engine.crypto.Basis" json:"bases,omitempty"` // Alice's random basis choices (B92: fixed by the bit)
+	QuantumStates   []byte                 `protobuf:"bytes,4,opt,name=quantum_states,json=quantumStates,proto3" json:"quantum_states,omitempty"`   // Encoded quantum states (simulated)
+	Protocol        Protocol               `protobuf:"varint,5,opt,name=protocol,proto3,enum=qubit_engine.crypto.Protocol" json:"protocol,omitempty"`
+	BasesMac        []byte                 `protobuf:"bytes,6,opt,name=bases_mac,json=basesMac,proto3" json:"bases_mac,omitempty"`                              // HMAC over Alice's basis announcement (BB84 with auth_key)
+	IntensityLevels []int32                `protobuf:"varint,7,rep,packed,name=intensity_levels,json=intensityLevels,proto3" json:"intensity_levels,omitempty"` // Decoy level of each pulse
+	Policy          *SecurityPolicy        `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *BB84AliceState) Reset() {
 	*x = BB84AliceState{}
-	mi := &file_crypto_crypto_proto_msgTypes[1]
+	mi := &file_crypto_crypto_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -151,7 +513,7 @@ func (x *BB84AliceState) String() string {
 func (*BB84AliceState) ProtoMessage() {}
 
 func (x *BB84AliceState) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[1]
+	mi := &file_crypto_crypto_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +526,7 @@ func (x *BB84AliceState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BB84AliceState.ProtoReflect.Descriptor instead.
 func (*BB84AliceState) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{1}
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BB84AliceState) GetSessionId() string {
@@ -195,6 +557,34 @@ func (x *BB84AliceState) GetQuantumStates() []byte {
 	return nil
 }
 
+func (x *BB84AliceState) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_BB84
+}
+
+func (x *BB84AliceState) GetBasesMac() []byte {
+	if x != nil {
+		return x.BasesMac
+	}
+	return nil
+}
+
+func (x *BB84AliceState) GetIntensityLevels() []int32 {
+	if x != nil {
+		return x.IntensityLevels
+	}
+	return nil
+}
+
+func (x *BB84AliceState) GetPolicy() *SecurityPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
 type BB84BobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -205,7 +595,7 @@ type BB84BobRequest struct {
 
 func (x *BB84BobRequest) Reset() {
 	*x = BB84BobRequest{}
-	mi := &file_crypto_crypto_proto_msgTypes[2]
+	mi := &file_crypto_crypto_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -217,7 +607,7 @@ func (x *BB84BobRequest) String() string {
 func (*BB84BobRequest) ProtoMessage() {}
 
 func (x *BB84BobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[2]
+	mi := &file_crypto_crypto_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +620,7 @@ func (x *BB84BobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BB84BobRequest.ProtoReflect.Descriptor instead.
 func (*BB84BobRequest) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{2}
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BB84BobRequest) GetSessionId() string {
@@ -251,14 +641,15 @@ type BB84BobState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	Bases         []Basis                `protobuf:"varint,2,rep,packed,name=bases,proto3,enum=qubit_engine.crypto.Basis" json:"bases,omitempty"` // Bob's random basis choices
-	Measurements  []int32                `protobuf:"varint,3,rep,packed,name=measurements,proto3" json:"measurements,omitempty"`                  // Bob's measurement results
+	Measurements  []int32                `protobuf:"varint,3,rep,packed,name=measurements,proto3" json:"measurements,omitempty"`                  // Bob's measurement results (-1: no detection, decoy sessions)
+	Mac           []byte                 `protobuf:"bytes,4,opt,name=mac,proto3" json:"mac,omitempty"`                                            // HMAC over Bob's announcement: bases (BB84) or conclusive results (B92)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BB84BobState) Reset() {
 	*x = BB84BobState{}
-	mi := &file_crypto_crypto_proto_msgTypes[3]
+	mi := &file_crypto_crypto_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +661,7 @@ func (x *BB84BobState) String() string {
 func (*BB84BobState) ProtoMessage() {}
 
 func (x *BB84BobState) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[3]
+	mi := &file_crypto_crypto_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +674,7 @@ func (x *BB84BobState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BB84BobState.ProtoReflect.Descriptor instead.
 func (*BB84BobState) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{3}
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BB84BobState) GetSessionId() string {
@@ -307,32 +698,39 @@ func (x *BB84BobState) GetMeasurements() []int32 {
 	return nil
 }
 
-type ReconcileRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AliceBases      []Basis                `protobuf:"varint,2,rep,packed,name=alice_bases,json=aliceBases,proto3,enum=qubit_engine.crypto.Basis" json:"alice_bases,omitempty"`
-	BobBases        []Basis                `protobuf:"varint,3,rep,packed,name=bob_bases,json=bobBases,proto3,enum=qubit_engine.crypto.Basis" json:"bob_bases,omitempty"`
-	AliceBits       []int32                `protobuf:"varint,4,rep,packed,name=alice_bits,json=aliceBits,proto3" json:"alice_bits,omitempty"`
-	BobMeasurements []int32                `protobuf:"varint,5,rep,packed,name=bob_measurements,json=bobMeasurements,proto3" json:"bob_measurements,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *BB84BobState) GetMac() []byte {
+	if x != nil {
+		return x.Mac
+	}
+	return nil
 }
 
-func (x *ReconcileRequest) Reset() {
-	*x = ReconcileRequest{}
-	mi := &file_crypto_crypto_proto_msgTypes[4]
+type BB84StreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*BB84StreamRequest_Start
+	//	*BB84StreamRequest_Ack
+	Message       isBB84StreamRequest_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BB84StreamRequest) Reset() {
+	*x = BB84StreamRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReconcileRequest) String() string {
+func (x *BB84StreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileRequest) ProtoMessage() {}
+func (*BB84StreamRequest) ProtoMessage() {}
 
-func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[4]
+func (x *BB84StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,73 +741,81 @@ func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
-func (*ReconcileRequest) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BB84StreamRequest.ProtoReflect.Descriptor instead.
+func (*BB84StreamRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ReconcileRequest) GetSessionId() string {
+func (x *BB84StreamRequest) GetMessage() isBB84StreamRequest_Message {
 	if x != nil {
-		return x.SessionId
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *ReconcileRequest) GetAliceBases() []Basis {
+func (x *BB84StreamRequest) GetStart() *BB84StreamStart {
 	if x != nil {
-		return x.AliceBases
+		if x, ok := x.Message.(*BB84StreamRequest_Start); ok {
+			return x.Start
+		}
 	}
 	return nil
 }
 
-func (x *ReconcileRequest) GetBobBases() []Basis {
+func (x *BB84StreamRequest) GetAck() *BB84StreamAck {
 	if x != nil {
-		return x.BobBases
+		if x, ok := x.Message.(*BB84StreamRequest_Ack); ok {
+			return x.Ack
+		}
 	}
 	return nil
 }
 
-func (x *ReconcileRequest) GetAliceBits() []int32 {
-	if x != nil {
-		return x.AliceBits
-	}
-	return nil
+type isBB84StreamRequest_Message interface {
+	isBB84StreamRequest_Message()
 }
 
-func (x *ReconcileRequest) GetBobMeasurements() []int32 {
-	if x != nil {
-		return x.BobMeasurements
-	}
-	return nil
+type BB84StreamRequest_Start struct {
+	Start *BB84StreamStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
 }
 
-type BB84Key struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SharedKey     []byte                 `protobuf:"bytes,2,opt,name=shared_key,json=sharedKey,proto3" json:"shared_key,omitempty"`           // The resulting shared secret
-	OriginalBits  int32                  `protobuf:"varint,3,opt,name=original_bits,json=originalBits,proto3" json:"original_bits,omitempty"` // How many bits we started with
-	SiftedBits    int32                  `protobuf:"varint,4,opt,name=sifted_bits,json=siftedBits,proto3" json:"sifted_bits,omitempty"`       // How many bits survived sifting
-	ErrorRate     float64                `protobuf:"fixed64,5,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`         // Estimated error rate
-	Secure        bool                   `protobuf:"varint,6,opt,name=secure,proto3" json:"secure,omitempty"`                                 // True if error rate is acceptable
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BB84StreamRequest_Ack struct {
+	Ack *BB84StreamAck `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
 }
 
-func (x *BB84Key) Reset() {
-	*x = BB84Key{}
-	mi := &file_crypto_crypto_proto_msgTypes[5]
+func (*BB84StreamRequest_Start) isBB84StreamRequest_Message() {}
+
+func (*BB84StreamRequest_Ack) isBB84StreamRequest_Message() {}
+
+type BB84StreamStart struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SessionId            string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	NumBits              int32                  `protobuf:"varint,2,opt,name=num_bits,json=numBits,proto3" json:"num_bits,omitempty"`
+	EavesdropProbability float64                `protobuf:"fixed64,3,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"`
+	Protocol             Protocol               `protobuf:"varint,4,opt,name=protocol,proto3,enum=qubit_engine.crypto.Protocol" json:"protocol,omitempty"`
+	BatchSize            int32                  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // Qubits per batch (default 256)
+	Window               int32                  `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`                        // Unacknowledged batches allowed (default 4)
+	AuthKey              []byte                 `protobuf:"bytes,7,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
+	Policy               *SecurityPolicy        `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BB84StreamStart) Reset() {
+	*x = BB84StreamStart{}
+	mi := &file_crypto_crypto_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BB84Key) String() string {
+func (x *BB84StreamStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BB84Key) ProtoMessage() {}
+func (*BB84StreamStart) ProtoMessage() {}
 
-func (x *BB84Key) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[5]
+func (x *BB84StreamStart) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,76 +826,89 @@ func (x *BB84Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BB84Key.ProtoReflect.Descriptor instead.
-func (*BB84Key) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BB84StreamStart.ProtoReflect.Descriptor instead.
+func (*BB84StreamStart) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BB84Key) GetSessionId() string {
+func (x *BB84StreamStart) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *BB84Key) GetSharedKey() []byte {
+func (x *BB84StreamStart) GetNumBits() int32 {
 	if x != nil {
-		return x.SharedKey
+		return x.NumBits
 	}
-	return nil
+	return 0
 }
 
-func (x *BB84Key) GetOriginalBits() int32 {
+func (x *BB84StreamStart) GetEavesdropProbability() float64 {
 	if x != nil {
-		return x.OriginalBits
+		return x.EavesdropProbability
 	}
 	return 0
 }
 
-func (x *BB84Key) GetSiftedBits() int32 {
+func (x *BB84StreamStart) GetProtocol() Protocol {
 	if x != nil {
-		return x.SiftedBits
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_BB84
+}
+
+func (x *BB84StreamStart) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
 	}
 	return 0
 }
 
-func (x *BB84Key) GetErrorRate() float64 {
+func (x *BB84StreamStart) GetWindow() int32 {
 	if x != nil {
-		return x.ErrorRate
+		return x.Window
 	}
 	return 0
 }
 
-func (x *BB84Key) GetSecure() bool {
+func (x *BB84StreamStart) GetAuthKey() []byte {
 	if x != nil {
-		return x.Secure
+		return x.AuthKey
 	}
-	return false
+	return nil
 }
 
-type KeyRequest struct {
+func (x *BB84StreamStart) GetPolicy() *SecurityPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type BB84StreamAck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	KeyLengthBits int32                  `protobuf:"varint,1,opt,name=key_length_bits,json=keyLengthBits,proto3" json:"key_length_bits,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // "qrng", "bb84", "e91"
+	Batches       int32                  `protobuf:"varint,1,opt,name=batches,proto3" json:"batches,omitempty"` // Batches consumed since the last ack
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KeyRequest) Reset() {
-	*x = KeyRequest{}
-	mi := &file_crypto_crypto_proto_msgTypes[6]
+func (x *BB84StreamAck) Reset() {
+	*x = BB84StreamAck{}
+	mi := &file_crypto_crypto_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KeyRequest) String() string {
+func (x *BB84StreamAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRequest) ProtoMessage() {}
+func (*BB84StreamAck) ProtoMessage() {}
 
-func (x *KeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[6]
+func (x *BB84StreamAck) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,50 +919,49 @@ func (x *KeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRequest.ProtoReflect.Descriptor instead.
-func (*KeyRequest) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BB84StreamAck.ProtoReflect.Descriptor instead.
+func (*BB84StreamAck) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *KeyRequest) GetKeyLengthBits() int32 {
+func (x *BB84StreamAck) GetBatches() int32 {
 	if x != nil {
-		return x.KeyLengthBits
+		return x.Batches
 	}
 	return 0
 }
 
-func (x *KeyRequest) GetAlgorithm() string {
-	if x != nil {
-		return x.Algorithm
-	}
-	return ""
-}
-
-type QuantumKey struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	GeneratedAt   int64                  `protobuf:"varint,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
-	EntropySource string                 `protobuf:"bytes,4,opt,name=entropy_source,json=entropySource,proto3" json:"entropy_source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BB84StreamBatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Index           int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // Position of the first qubit in the session
+	AliceBits       []int32                `protobuf:"varint,4,rep,packed,name=alice_bits,json=aliceBits,proto3" json:"alice_bits,omitempty"`
+	AliceBases      []Basis                `protobuf:"varint,5,rep,packed,name=alice_bases,json=aliceBases,proto3,enum=qubit_engine.crypto.Basis" json:"alice_bases,omitempty"`
+	BobBases        []Basis                `protobuf:"varint,6,rep,packed,name=bob_bases,json=bobBases,proto3,enum=qubit_engine.crypto.Basis" json:"bob_bases,omitempty"`
+	BobMeasurements []int32                `protobuf:"varint,7,rep,packed,name=bob_measurements,json=bobMeasurements,proto3" json:"bob_measurements,omitempty"`
+	Last            bool                   `protobuf:"varint,8,opt,name=last,proto3" json:"last,omitempty"`
+	AliceBasesMac   []byte                 `protobuf:"bytes,9,opt,name=alice_bases_mac,json=aliceBasesMac,proto3" json:"alice_bases_mac,omitempty"` // Last batch only: tags over the whole session
+	BobMac          []byte                 `protobuf:"bytes,10,opt,name=bob_mac,json=bobMac,proto3" json:"bob_mac,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *QuantumKey) Reset() {
-	*x = QuantumKey{}
-	mi := &file_crypto_crypto_proto_msgTypes[7]
+func (x *BB84StreamBatch) Reset() {
+	*x = BB84StreamBatch{}
+	mi := &file_crypto_crypto_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuantumKey) String() string {
+func (x *BB84StreamBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuantumKey) ProtoMessage() {}
+func (*BB84StreamBatch) ProtoMessage() {}
 
-func (x *QuantumKey) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[7]
+func (x *BB84StreamBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,123 +972,3525 @@ func (x *QuantumKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuantumKey.ProtoReflect.Descriptor instead.
-func (*QuantumKey) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BB84StreamBatch.ProtoReflect.Descriptor instead.
+func (*BB84StreamBatch) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QuantumKey) GetKey() []byte {
+func (x *BB84StreamBatch) GetSessionId() string {
 	if x != nil {
-		return x.Key
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-func (x *QuantumKey) GetAlgorithm() string {
+func (x *BB84StreamBatch) GetIndex() int32 {
 	if x != nil {
-		return x.Algorithm
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *QuantumKey) GetGeneratedAt() int64 {
+func (x *BB84StreamBatch) GetOffset() int32 {
 	if x != nil {
-		return x.GeneratedAt
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *QuantumKey) GetEntropySource() string {
+func (x *BB84StreamBatch) GetAliceBits() []int32 {
 	if x != nil {
-		return x.EntropySource
+		return x.AliceBits
 	}
-	return ""
+	return nil
 }
 
-type EncryptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
-	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // "otp", "aes-qrng"
+func (x *BB84StreamBatch) GetAliceBases() []Basis {
+	if x != nil {
+		return x.AliceBases
+	}
+	return nil
+}
+
+func (x *BB84StreamBatch) GetBobBases() []Basis {
+	if x != nil {
+		return x.BobBases
+	}
+	return nil
+}
+
+func (x *BB84StreamBatch) GetBobMeasurements() []int32 {
+	if x != nil {
+		return x.BobMeasurements
+	}
+	return nil
+}
+
+func (x *BB84StreamBatch) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *BB84StreamBatch) GetAliceBasesMac() []byte {
+	if x != nil {
+		return x.AliceBasesMac
+	}
+	return nil
+}
+
+func (x *BB84StreamBatch) GetBobMac() []byte {
+	if x != nil {
+		return x.BobMac
+	}
+	return nil
+}
+
+type ReconcileRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AliceBases      []Basis                `protobuf:"varint,2,rep,packed,name=alice_bases,json=aliceBases,proto3,enum=qubit_engine.crypto.Basis" json:"alice_bases,omitempty"`
+	BobBases        []Basis                `protobuf:"varint,3,rep,packed,name=bob_bases,json=bobBases,proto3,enum=qubit_engine.crypto.Basis" json:"bob_bases,omitempty"`
+	AliceBits       []int32                `protobuf:"varint,4,rep,packed,name=alice_bits,json=aliceBits,proto3" json:"alice_bits,omitempty"`
+	BobMeasurements []int32                `protobuf:"varint,5,rep,packed,name=bob_measurements,json=bobMeasurements,proto3" json:"bob_measurements,omitempty"`
+	// Authenticated sessions: the announcements above as relayed, with
+	// their tags. A tag that fails to verify aborts the session.
+	AliceBasesMac []byte `protobuf:"bytes,6,opt,name=alice_bases_mac,json=aliceBasesMac,proto3" json:"alice_bases_mac,omitempty"`
+	BobMac        []byte `protobuf:"bytes,7,opt,name=bob_mac,json=bobMac,proto3" json:"bob_mac,omitempty"`
+	Peer          string `protobuf:"bytes,8,opt,name=peer,proto3" json:"peer,omitempty"` // Recorded with the pooled key
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EncryptRequest) Reset() {
-	*x = EncryptRequest{}
-	mi := &file_crypto_crypto_proto_msgTypes[8]
+func (x *ReconcileRequest) Reset() {
+	*x = ReconcileRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EncryptRequest) String() string {
+func (x *ReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptRequest) ProtoMessage() {}
-
-func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[8]
+func (*ReconcileRequest) ProtoMessage() {}
+
+func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReconcileRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReconcileRequest) GetAliceBases() []Basis {
+	if x != nil {
+		return x.AliceBases
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetBobBases() []Basis {
+	if x != nil {
+		return x.BobBases
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetAliceBits() []int32 {
+	if x != nil {
+		return x.AliceBits
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetBobMeasurements() []int32 {
+	if x != nil {
+		return x.BobMeasurements
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetAliceBasesMac() []byte {
+	if x != nil {
+		return x.AliceBasesMac
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetBobMac() []byte {
+	if x != nil {
+		return x.BobMac
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+type BB84Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SharedKey     []byte                 `protobuf:"bytes,2,opt,name=shared_key,json=sharedKey,proto3" json:"shared_key,omitempty"`           // The resulting shared secret
+	OriginalBits  int32                  `protobuf:"varint,3,opt,name=original_bits,json=originalBits,proto3" json:"original_bits,omitempty"` // How many bits we started with
+	SiftedBits    int32                  `protobuf:"varint,4,opt,name=sifted_bits,json=siftedBits,proto3" json:"sifted_bits,omitempty"`       // How many bits survived sifting
+	ErrorRate     float64                `protobuf:"fixed64,5,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`         // Estimated error rate
+	Secure        bool                   `protobuf:"varint,6,opt,name=secure,proto3" json:"secure,omitempty"`                                 // True if error rate is acceptable
+	KeyId         string                 `protobuf:"bytes,7,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                       // Pooled for QuantumEncrypt when secure
+	Protocol      Protocol               `protobuf:"varint,8,opt,name=protocol,proto3,enum=qubit_engine.crypto.Protocol" json:"protocol,omitempty"`
+	Authenticated bool                   `protobuf:"varint,9,opt,name=authenticated,proto3" json:"authenticated,omitempty"`          // Announcements were verified against the pre-shared secret
+	AuthError     string                 `protobuf:"bytes,10,opt,name=auth_error,json=authError,proto3" json:"auth_error,omitempty"` // Why the session was aborted, if it was
+	DecoyClasses  []*DecoyClassStats     `protobuf:"bytes,11,rep,name=decoy_classes,json=decoyClasses,proto3" json:"decoy_classes,omitempty"`
+	DecoyAnalysis *DecoyAnalysis         `protobuf:"bytes,12,opt,name=decoy_analysis,json=decoyAnalysis,proto3" json:"decoy_analysis,omitempty"`
+	Policy        *SecurityPolicy        `protobuf:"bytes,13,opt,name=policy,proto3" json:"policy,omitempty"`                                       // The policy applied, defaults filled in
+	FiniteKeyBits int32                  `protobuf:"varint,14,opt,name=finite_key_bits,json=finiteKeyBits,proto3" json:"finite_key_bits,omitempty"` // Secure bits extractable at the policy's epsilon
+	Violations    []string               `protobuf:"bytes,15,rep,name=violations,proto3" json:"violations,omitempty"`                               // Why the key is not secure, if it is not
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BB84Key) Reset() {
+	*x = BB84Key{}
+	mi := &file_crypto_crypto_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BB84Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BB84Key) ProtoMessage() {}
+
+func (x *BB84Key) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BB84Key.ProtoReflect.Descriptor instead.
+func (*BB84Key) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BB84Key) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BB84Key) GetSharedKey() []byte {
+	if x != nil {
+		return x.SharedKey
+	}
+	return nil
+}
+
+func (x *BB84Key) GetOriginalBits() int32 {
+	if x != nil {
+		return x.OriginalBits
+	}
+	return 0
+}
+
+func (x *BB84Key) GetSiftedBits() int32 {
+	if x != nil {
+		return x.SiftedBits
+	}
+	return 0
+}
+
+func (x *BB84Key) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *BB84Key) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
+}
+
+func (x *BB84Key) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *BB84Key) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_BB84
+}
+
+func (x *BB84Key) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *BB84Key) GetAuthError() string {
+	if x != nil {
+		return x.AuthError
+	}
+	return ""
+}
+
+func (x *BB84Key) GetDecoyClasses() []*DecoyClassStats {
+	if x != nil {
+		return x.DecoyClasses
+	}
+	return nil
+}
+
+func (x *BB84Key) GetDecoyAnalysis() *DecoyAnalysis {
+	if x != nil {
+		return x.DecoyAnalysis
+	}
+	return nil
+}
+
+func (x *BB84Key) GetPolicy() *SecurityPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *BB84Key) GetFiniteKeyBits() int32 {
+	if x != nil {
+		return x.FiniteKeyBits
+	}
+	return 0
+}
+
+func (x *BB84Key) GetViolations() []string {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type DecoyLevel struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MeanPhotonNumber float64                `protobuf:"fixed64,2,opt,name=mean_photon_number,json=meanPhotonNumber,proto3" json:"mean_photon_number,omitempty"` // 0 for vacuum
+	Probability      float64                `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DecoyLevel) Reset() {
+	*x = DecoyLevel{}
+	mi := &file_crypto_crypto_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecoyLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecoyLevel) ProtoMessage() {}
+
+func (x *DecoyLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecoyLevel.ProtoReflect.Descriptor instead.
+func (*DecoyLevel) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DecoyLevel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecoyLevel) GetMeanPhotonNumber() float64 {
+	if x != nil {
+		return x.MeanPhotonNumber
+	}
+	return 0
+}
+
+func (x *DecoyLevel) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type DecoyConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Levels        []*DecoyLevel          `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`                                        // Default: signal 0.5 (70%), decoy 0.1 (20%), vacuum (10%)
+	Transmittance float64                `protobuf:"fixed64,2,opt,name=transmittance,proto3" json:"transmittance,omitempty"`                        // Channel and detector efficiency (default 0.2)
+	DarkCountRate float64                `protobuf:"fixed64,3,opt,name=dark_count_rate,json=darkCountRate,proto3" json:"dark_count_rate,omitempty"` // Per-pulse background click probability (default 1e-5)
+	PnsAttack     bool                   `protobuf:"varint,4,opt,name=pns_attack,json=pnsAttack,proto3" json:"pns_attack,omitempty"`                // Simulate a photon-number-splitting eavesdropper
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecoyConfig) Reset() {
+	*x = DecoyConfig{}
+	mi := &file_crypto_crypto_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecoyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecoyConfig) ProtoMessage() {}
+
+func (x *DecoyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecoyConfig.ProtoReflect.Descriptor instead.
+func (*DecoyConfig) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DecoyConfig) GetLevels() []*DecoyLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *DecoyConfig) GetTransmittance() float64 {
+	if x != nil {
+		return x.Transmittance
+	}
+	return 0
+}
+
+func (x *DecoyConfig) GetDarkCountRate() float64 {
+	if x != nil {
+		return x.DarkCountRate
+	}
+	return 0
+}
+
+func (x *DecoyConfig) GetPnsAttack() bool {
+	if x != nil {
+		return x.PnsAttack
+	}
+	return false
+}
+
+type DecoyClassStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MeanPhotonNumber float64                `protobuf:"fixed64,2,opt,name=mean_photon_number,json=meanPhotonNumber,proto3" json:"mean_photon_number,omitempty"`
+	Pulses           int32                  `protobuf:"varint,3,opt,name=pulses,proto3" json:"pulses,omitempty"`
+	Detections       int32                  `protobuf:"varint,4,opt,name=detections,proto3" json:"detections,omitempty"`
+	Gain             float64                `protobuf:"fixed64,5,opt,name=gain,proto3" json:"gain,omitempty"` // Detections per pulse
+	SiftedBits       int32                  `protobuf:"varint,6,opt,name=sifted_bits,json=siftedBits,proto3" json:"sifted_bits,omitempty"`
+	Errors           int32                  `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+	ErrorRate        float64                `protobuf:"fixed64,8,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DecoyClassStats) Reset() {
+	*x = DecoyClassStats{}
+	mi := &file_crypto_crypto_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecoyClassStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecoyClassStats) ProtoMessage() {}
+
+func (x *DecoyClassStats) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecoyClassStats.ProtoReflect.Descriptor instead.
+func (*DecoyClassStats) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DecoyClassStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecoyClassStats) GetMeanPhotonNumber() float64 {
+	if x != nil {
+		return x.MeanPhotonNumber
+	}
+	return 0
+}
+
+func (x *DecoyClassStats) GetPulses() int32 {
+	if x != nil {
+		return x.Pulses
+	}
+	return 0
+}
+
+func (x *DecoyClassStats) GetDetections() int32 {
+	if x != nil {
+		return x.Detections
+	}
+	return 0
+}
+
+func (x *DecoyClassStats) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *DecoyClassStats) GetSiftedBits() int32 {
+	if x != nil {
+		return x.SiftedBits
+	}
+	return 0
+}
+
+func (x *DecoyClassStats) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *DecoyClassStats) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+type DecoyAnalysis struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	BackgroundYield        float64                `protobuf:"fixed64,1,opt,name=background_yield,json=backgroundYield,proto3" json:"background_yield,omitempty"`                          // Y0
+	SinglePhotonYieldLower float64                `protobuf:"fixed64,2,opt,name=single_photon_yield_lower,json=singlePhotonYieldLower,proto3" json:"single_photon_yield_lower,omitempty"` // Y1
+	SinglePhotonErrorUpper float64                `protobuf:"fixed64,3,opt,name=single_photon_error_upper,json=singlePhotonErrorUpper,proto3" json:"single_photon_error_upper,omitempty"` // e1
+	SinglePhotonGainLower  float64                `protobuf:"fixed64,4,opt,name=single_photon_gain_lower,json=singlePhotonGainLower,proto3" json:"single_photon_gain_lower,omitempty"`    // Q1 for the signal level
+	KeyRate                float64                `protobuf:"fixed64,5,opt,name=key_rate,json=keyRate,proto3" json:"key_rate,omitempty"`                                                  // Secure bits per signal pulse; ≤ 0 means no key
+	PnsSuspected           bool                   `protobuf:"varint,6,opt,name=pns_suspected,json=pnsSuspected,proto3" json:"pns_suspected,omitempty"`                                    // Errors look fine but single photons cannot account for the key
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DecoyAnalysis) Reset() {
+	*x = DecoyAnalysis{}
+	mi := &file_crypto_crypto_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecoyAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecoyAnalysis) ProtoMessage() {}
+
+func (x *DecoyAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecoyAnalysis.ProtoReflect.Descriptor instead.
+func (*DecoyAnalysis) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DecoyAnalysis) GetBackgroundYield() float64 {
+	if x != nil {
+		return x.BackgroundYield
+	}
+	return 0
+}
+
+func (x *DecoyAnalysis) GetSinglePhotonYieldLower() float64 {
+	if x != nil {
+		return x.SinglePhotonYieldLower
+	}
+	return 0
+}
+
+func (x *DecoyAnalysis) GetSinglePhotonErrorUpper() float64 {
+	if x != nil {
+		return x.SinglePhotonErrorUpper
+	}
+	return 0
+}
+
+func (x *DecoyAnalysis) GetSinglePhotonGainLower() float64 {
+	if x != nil {
+		return x.SinglePhotonGainLower
+	}
+	return 0
+}
+
+func (x *DecoyAnalysis) GetKeyRate() float64 {
+	if x != nil {
+		return x.KeyRate
+	}
+	return 0
+}
+
+func (x *DecoyAnalysis) GetPnsSuspected() bool {
+	if x != nil {
+		return x.PnsSuspected
+	}
+	return false
+}
+
+type SessionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	State         SessionState           `protobuf:"varint,2,opt,name=state,proto3,enum=qubit_engine.crypto.SessionState" json:"state,omitempty"`
+	Protocol      Protocol               `protobuf:"varint,3,opt,name=protocol,proto3,enum=qubit_engine.crypto.Protocol" json:"protocol,omitempty"`
+	NumBits       int32                  `protobuf:"varint,4,opt,name=num_bits,json=numBits,proto3" json:"num_bits,omitempty"`
+	DisclosedBits int32                  `protobuf:"varint,5,opt,name=disclosed_bits,json=disclosedBits,proto3" json:"disclosed_bits,omitempty"`
+	Authenticated bool                   `protobuf:"varint,6,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	Decoy         bool                   `protobuf:"varint,7,opt,name=decoy,proto3" json:"decoy,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AbortReason   string                 `protobuf:"bytes,10,opt,name=abort_reason,json=abortReason,proto3" json:"abort_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionStatus) Reset() {
+	*x = SessionStatus{}
+	mi := &file_crypto_crypto_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStatus) ProtoMessage() {}
+
+func (x *SessionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStatus.ProtoReflect.Descriptor instead.
+func (*SessionStatus) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SessionStatus) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionStatus) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_SESSION_PREPARED
+}
+
+func (x *SessionStatus) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_BB84
+}
+
+func (x *SessionStatus) GetNumBits() int32 {
+	if x != nil {
+		return x.NumBits
+	}
+	return 0
+}
+
+func (x *SessionStatus) GetDisclosedBits() int32 {
+	if x != nil {
+		return x.DisclosedBits
+	}
+	return 0
+}
+
+func (x *SessionStatus) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *SessionStatus) GetDecoy() bool {
+	if x != nil {
+		return x.Decoy
+	}
+	return false
+}
+
+func (x *SessionStatus) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SessionStatus) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SessionStatus) GetAbortReason() string {
+	if x != nil {
+		return x.AbortReason
+	}
+	return ""
+}
+
+type ListSessionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeAborted bool                   `protobuf:"varint,1,opt,name=include_aborted,json=includeAborted,proto3" json:"include_aborted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSessionsRequest) GetIncludeAborted() bool {
+	if x != nil {
+		return x.IncludeAborted
+	}
+	return false
+}
+
+type SessionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionStatus       `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionList) Reset() {
+	*x = SessionList{}
+	mi := &file_crypto_crypto_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionList) ProtoMessage() {}
+
+func (x *SessionList) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionList.ProtoReflect.Descriptor instead.
+func (*SessionList) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SessionList) GetSessions() []*SessionStatus {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SessionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionStatusRequest) Reset() {
+	*x = SessionStatusRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStatusRequest) ProtoMessage() {}
+
+func (x *SessionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStatusRequest.ProtoReflect.Descriptor instead.
+func (*SessionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SessionStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type AbortSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortSessionRequest) Reset() {
+	*x = AbortSessionRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortSessionRequest) ProtoMessage() {}
+
+func (x *AbortSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortSessionRequest.ProtoReflect.Descriptor instead.
+func (*AbortSessionRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AbortSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AbortSessionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ConferenceKeyRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Parties              []string               `protobuf:"bytes,1,rep,name=parties,proto3" json:"parties,omitempty"`                                                         // 3-10 names; the first is the initiator
+	KeyLengthBits        int32                  `protobuf:"varint,2,opt,name=key_length_bits,json=keyLengthBits,proto3" json:"key_length_bits,omitempty"`                     // Multiple of 8 (default 256)
+	EavesdropProbability float64                `protobuf:"fixed64,3,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"` // Intercept-resend rate at the source
+	TestFraction         float64                `protobuf:"fixed64,4,opt,name=test_fraction,json=testFraction,proto3" json:"test_fraction,omitempty"`                         // Rounds measured in X (default 0.25)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ConferenceKeyRequest) Reset() {
+	*x = ConferenceKeyRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConferenceKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConferenceKeyRequest) ProtoMessage() {}
+
+func (x *ConferenceKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConferenceKeyRequest.ProtoReflect.Descriptor instead.
+func (*ConferenceKeyRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConferenceKeyRequest) GetParties() []string {
+	if x != nil {
+		return x.Parties
+	}
+	return nil
+}
+
+func (x *ConferenceKeyRequest) GetKeyLengthBits() int32 {
+	if x != nil {
+		return x.KeyLengthBits
+	}
+	return 0
+}
+
+func (x *ConferenceKeyRequest) GetEavesdropProbability() float64 {
+	if x != nil {
+		return x.EavesdropProbability
+	}
+	return 0
+}
+
+func (x *ConferenceKeyRequest) GetTestFraction() float64 {
+	if x != nil {
+		return x.TestFraction
+	}
+	return 0
+}
+
+type ConferenceKeyResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Key             []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                  // Empty when aborted
+	KeyId           string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // Pooled with the party names as peer
+	Parties         []string               `protobuf:"bytes,3,rep,name=parties,proto3" json:"parties,omitempty"`
+	Rounds          int32                  `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"` // GHZ states distributed
+	KeyRounds       int32                  `protobuf:"varint,5,opt,name=key_rounds,json=keyRounds,proto3" json:"key_rounds,omitempty"`
+	TestRounds      int32                  `protobuf:"varint,6,opt,name=test_rounds,json=testRounds,proto3" json:"test_rounds,omitempty"`
+	DisclosedRounds int32                  `protobuf:"varint,7,opt,name=disclosed_rounds,json=disclosedRounds,proto3" json:"disclosed_rounds,omitempty"` // Key rounds sacrificed to estimate Z errors
+	QberX           float64                `protobuf:"fixed64,8,opt,name=qber_x,json=qberX,proto3" json:"qber_x,omitempty"`                              // Test rounds with odd parity
+	QberZ           float64                `protobuf:"fixed64,9,opt,name=qber_z,json=qberZ,proto3" json:"qber_z,omitempty"`                              // Worst disagreement with the initiator
+	PairwiseQber    []float64              `protobuf:"fixed64,10,rep,packed,name=pairwise_qber,json=pairwiseQber,proto3" json:"pairwise_qber,omitempty"` // Z disagreement of parties 2..N with the initiator
+	KeyRate         float64                `protobuf:"fixed64,11,opt,name=key_rate,json=keyRate,proto3" json:"key_rate,omitempty"`                       // 1 - h(qber_x) - h(qber_z)
+	Secure          bool                   `protobuf:"varint,12,opt,name=secure,proto3" json:"secure,omitempty"`
+	GeneratedAt     int64                  `protobuf:"varint,13,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConferenceKeyResult) Reset() {
+	*x = ConferenceKeyResult{}
+	mi := &file_crypto_crypto_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConferenceKeyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConferenceKeyResult) ProtoMessage() {}
+
+func (x *ConferenceKeyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConferenceKeyResult.ProtoReflect.Descriptor instead.
+func (*ConferenceKeyResult) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConferenceKeyResult) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ConferenceKeyResult) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ConferenceKeyResult) GetParties() []string {
+	if x != nil {
+		return x.Parties
+	}
+	return nil
+}
+
+func (x *ConferenceKeyResult) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetKeyRounds() int32 {
+	if x != nil {
+		return x.KeyRounds
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetTestRounds() int32 {
+	if x != nil {
+		return x.TestRounds
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetDisclosedRounds() int32 {
+	if x != nil {
+		return x.DisclosedRounds
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetQberX() float64 {
+	if x != nil {
+		return x.QberX
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetQberZ() float64 {
+	if x != nil {
+		return x.QberZ
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetPairwiseQber() []float64 {
+	if x != nil {
+		return x.PairwiseQber
+	}
+	return nil
+}
+
+func (x *ConferenceKeyResult) GetKeyRate() float64 {
+	if x != nil {
+		return x.KeyRate
+	}
+	return 0
+}
+
+func (x *ConferenceKeyResult) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
+}
+
+func (x *ConferenceKeyResult) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+type CreateFlipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alice         string                 `protobuf:"bytes,1,opt,name=alice,proto3" json:"alice,omitempty"`
+	Bob           string                 `protobuf:"bytes,2,opt,name=bob,proto3" json:"bob,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,3,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"` // Default 64, max 1024
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFlipRequest) Reset() {
+	*x = CreateFlipRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlipRequest) ProtoMessage() {}
+
+func (x *CreateFlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlipRequest.ProtoReflect.Descriptor instead.
+func (*CreateFlipRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateFlipRequest) GetAlice() string {
+	if x != nil {
+		return x.Alice
+	}
+	return ""
+}
+
+func (x *CreateFlipRequest) GetBob() string {
+	if x != nil {
+		return x.Bob
+	}
+	return ""
+}
+
+func (x *CreateFlipRequest) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+type FlipSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlipId        string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	Alice         string                 `protobuf:"bytes,2,opt,name=alice,proto3" json:"alice,omitempty"`
+	Bob           string                 `protobuf:"bytes,3,opt,name=bob,proto3" json:"bob,omitempty"`
+	Stage         FlipStage              `protobuf:"varint,4,opt,name=stage,proto3,enum=qubit_engine.crypto.FlipStage" json:"stage,omitempty"`
+	NumQubits     int32                  `protobuf:"varint,5,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	BobBases      []Basis                `protobuf:"varint,6,rep,packed,name=bob_bases,json=bobBases,proto3,enum=qubit_engine.crypto.Basis" json:"bob_bases,omitempty"` // Bob's records; only returned to Bob
+	BobResults    []int32                `protobuf:"varint,7,rep,packed,name=bob_results,json=bobResults,proto3" json:"bob_results,omitempty"`
+	Guess         Basis                  `protobuf:"varint,8,opt,name=guess,proto3,enum=qubit_engine.crypto.Basis" json:"guess,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AliceToken    string                 `protobuf:"bytes,10,opt,name=alice_token,json=aliceToken,proto3" json:"alice_token,omitempty"` // Only in CreateFlipSession's response; hand each
+	BobToken      string                 `protobuf:"bytes,11,opt,name=bob_token,json=bobToken,proto3" json:"bob_token,omitempty"`       // player their own
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlipSession) Reset() {
+	*x = FlipSession{}
+	mi := &file_crypto_crypto_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlipSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlipSession) ProtoMessage() {}
+
+func (x *FlipSession) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlipSession.ProtoReflect.Descriptor instead.
+func (*FlipSession) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FlipSession) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *FlipSession) GetAlice() string {
+	if x != nil {
+		return x.Alice
+	}
+	return ""
+}
+
+func (x *FlipSession) GetBob() string {
+	if x != nil {
+		return x.Bob
+	}
+	return ""
+}
+
+func (x *FlipSession) GetStage() FlipStage {
+	if x != nil {
+		return x.Stage
+	}
+	return FlipStage_FLIP_CREATED
+}
+
+func (x *FlipSession) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *FlipSession) GetBobBases() []Basis {
+	if x != nil {
+		return x.BobBases
+	}
+	return nil
+}
+
+func (x *FlipSession) GetBobResults() []int32 {
+	if x != nil {
+		return x.BobResults
+	}
+	return nil
+}
+
+func (x *FlipSession) GetGuess() Basis {
+	if x != nil {
+		return x.Guess
+	}
+	return Basis_BASIS_RECTILINEAR
+}
+
+func (x *FlipSession) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *FlipSession) GetAliceToken() string {
+	if x != nil {
+		return x.AliceToken
+	}
+	return ""
+}
+
+func (x *FlipSession) GetBobToken() string {
+	if x != nil {
+		return x.BobToken
+	}
+	return ""
+}
+
+type CommitFlipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlipId        string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	Player        string                 `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`                               // Alice sends her qubits, then Bob guesses
+	Guess         Basis                  `protobuf:"varint,3,opt,name=guess,proto3,enum=qubit_engine.crypto.Basis" json:"guess,omitempty"` // Bob only
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`                                 // The player's token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitFlipRequest) Reset() {
+	*x = CommitFlipRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitFlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitFlipRequest) ProtoMessage() {}
+
+func (x *CommitFlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitFlipRequest.ProtoReflect.Descriptor instead.
+func (*CommitFlipRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CommitFlipRequest) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *CommitFlipRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *CommitFlipRequest) GetGuess() Basis {
+	if x != nil {
+		return x.Guess
+	}
+	return Basis_BASIS_RECTILINEAR
+}
+
+func (x *CommitFlipRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevealFlipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FlipId          string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	ClaimOtherBasis bool                   `protobuf:"varint,2,opt,name=claim_other_basis,json=claimOtherBasis,proto3" json:"claim_other_basis,omitempty"` // Simulate a dishonest Alice
+	Player          string                 `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`                                             // Alice; Bob may fetch the result once revealed
+	Token           string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`                                               // The player's token
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevealFlipRequest) Reset() {
+	*x = RevealFlipRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealFlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealFlipRequest) ProtoMessage() {}
+
+func (x *RevealFlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealFlipRequest.ProtoReflect.Descriptor instead.
+func (*RevealFlipRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevealFlipRequest) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *RevealFlipRequest) GetClaimOtherBasis() bool {
+	if x != nil {
+		return x.ClaimOtherBasis
+	}
+	return false
+}
+
+func (x *RevealFlipRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *RevealFlipRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type FlipResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlipId        string                 `protobuf:"bytes,1,opt,name=flip_id,json=flipId,proto3" json:"flip_id,omitempty"`
+	AliceBasis    Basis                  `protobuf:"varint,2,opt,name=alice_basis,json=aliceBasis,proto3,enum=qubit_engine.crypto.Basis" json:"alice_basis,omitempty"` // As revealed
+	AliceBits     []int32                `protobuf:"varint,3,rep,packed,name=alice_bits,json=aliceBits,proto3" json:"alice_bits,omitempty"`
+	Guess         Basis                  `protobuf:"varint,4,opt,name=guess,proto3,enum=qubit_engine.crypto.Basis" json:"guess,omitempty"`
+	BobWins       bool                   `protobuf:"varint,5,opt,name=bob_wins,json=bobWins,proto3" json:"bob_wins,omitempty"`
+	Winner        string                 `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Verified      bool                   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`                                // Revealed bits agree with Bob's results
+	CheckedQubits int32                  `protobuf:"varint,8,opt,name=checked_qubits,json=checkedQubits,proto3" json:"checked_qubits,omitempty"` // Qubits Bob measured in the revealed basis
+	Mismatches    int32                  `protobuf:"varint,9,opt,name=mismatches,proto3" json:"mismatches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlipResult) Reset() {
+	*x = FlipResult{}
+	mi := &file_crypto_crypto_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlipResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlipResult) ProtoMessage() {}
+
+func (x *FlipResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlipResult.ProtoReflect.Descriptor instead.
+func (*FlipResult) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FlipResult) GetFlipId() string {
+	if x != nil {
+		return x.FlipId
+	}
+	return ""
+}
+
+func (x *FlipResult) GetAliceBasis() Basis {
+	if x != nil {
+		return x.AliceBasis
+	}
+	return Basis_BASIS_RECTILINEAR
+}
+
+func (x *FlipResult) GetAliceBits() []int32 {
+	if x != nil {
+		return x.AliceBits
+	}
+	return nil
+}
+
+func (x *FlipResult) GetGuess() Basis {
+	if x != nil {
+		return x.Guess
+	}
+	return Basis_BASIS_RECTILINEAR
+}
+
+func (x *FlipResult) GetBobWins() bool {
+	if x != nil {
+		return x.BobWins
+	}
+	return false
+}
+
+func (x *FlipResult) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *FlipResult) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *FlipResult) GetCheckedQubits() int32 {
+	if x != nil {
+		return x.CheckedQubits
+	}
+	return 0
+}
+
+func (x *FlipResult) GetMismatches() int32 {
+	if x != nil {
+		return x.Mismatches
+	}
+	return 0
+}
+
+// ------------------------------------------------------------------
+// BB84 Benchmark
+// ------------------------------------------------------------------
+type BenchmarkRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EavesdropMin     float64                `protobuf:"fixed64,1,opt,name=eavesdrop_min,json=eavesdropMin,proto3" json:"eavesdrop_min,omitempty"`
+	EavesdropMax     float64                `protobuf:"fixed64,2,opt,name=eavesdrop_max,json=eavesdropMax,proto3" json:"eavesdrop_max,omitempty"`      // Default sweep is 0 to 1
+	EavesdropSteps   int32                  `protobuf:"varint,3,opt,name=eavesdrop_steps,json=eavesdropSteps,proto3" json:"eavesdrop_steps,omitempty"` // Default 11, max 101
+	NoiseMin         float64                `protobuf:"fixed64,4,opt,name=noise_min,json=noiseMin,proto3" json:"noise_min,omitempty"`                  // Bit-flip probability on the channel, up to 0.5
+	NoiseMax         float64                `protobuf:"fixed64,5,opt,name=noise_max,json=noiseMax,proto3" json:"noise_max,omitempty"`
+	NoiseSteps       int32                  `protobuf:"varint,6,opt,name=noise_steps,json=noiseSteps,proto3" json:"noise_steps,omitempty"`                     // Default 1
+	SessionsPerPoint int32                  `protobuf:"varint,7,opt,name=sessions_per_point,json=sessionsPerPoint,proto3" json:"sessions_per_point,omitempty"` // Default 10, max 100
+	BitsPerSession   int32                  `protobuf:"varint,8,opt,name=bits_per_session,json=bitsPerSession,proto3" json:"bits_per_session,omitempty"`       // Default 256, max 4096
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BenchmarkRequest) Reset() {
+	*x = BenchmarkRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkRequest) ProtoMessage() {}
+
+func (x *BenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*BenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BenchmarkRequest) GetEavesdropMin() float64 {
+	if x != nil {
+		return x.EavesdropMin
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetEavesdropMax() float64 {
+	if x != nil {
+		return x.EavesdropMax
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetEavesdropSteps() int32 {
+	if x != nil {
+		return x.EavesdropSteps
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetNoiseMin() float64 {
+	if x != nil {
+		return x.NoiseMin
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetNoiseMax() float64 {
+	if x != nil {
+		return x.NoiseMax
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetNoiseSteps() int32 {
+	if x != nil {
+		return x.NoiseSteps
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetSessionsPerPoint() int32 {
+	if x != nil {
+		return x.SessionsPerPoint
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetBitsPerSession() int32 {
+	if x != nil {
+		return x.BitsPerSession
+	}
+	return 0
+}
+
+type BenchmarkPoint struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	EavesdropProbability float64                `protobuf:"fixed64,1,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"`
+	Noise                float64                `protobuf:"fixed64,2,opt,name=noise,proto3" json:"noise,omitempty"`
+	MeanQber             float64                `protobuf:"fixed64,3,opt,name=mean_qber,json=meanQber,proto3" json:"mean_qber,omitempty"`
+	QberStddev           float64                `protobuf:"fixed64,4,opt,name=qber_stddev,json=qberStddev,proto3" json:"qber_stddev,omitempty"`
+	MinQber              float64                `protobuf:"fixed64,5,opt,name=min_qber,json=minQber,proto3" json:"min_qber,omitempty"`
+	MaxQber              float64                `protobuf:"fixed64,6,opt,name=max_qber,json=maxQber,proto3" json:"max_qber,omitempty"`
+	TheoreticalQber      float64                `protobuf:"fixed64,7,opt,name=theoretical_qber,json=theoreticalQber,proto3" json:"theoretical_qber,omitempty"`
+	MeanSiftedRatio      float64                `protobuf:"fixed64,8,opt,name=mean_sifted_ratio,json=meanSiftedRatio,proto3" json:"mean_sifted_ratio,omitempty"`
+	SecureFraction       float64                `protobuf:"fixed64,9,opt,name=secure_fraction,json=secureFraction,proto3" json:"secure_fraction,omitempty"` // Sessions below the QBER threshold
+	KeyRate              float64                `protobuf:"fixed64,10,opt,name=key_rate,json=keyRate,proto3" json:"key_rate,omitempty"`                     // Secure bits per qubit sent, 1 - 2h(Q) per sifted bit
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BenchmarkPoint) Reset() {
+	*x = BenchmarkPoint{}
+	mi := &file_crypto_crypto_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkPoint) ProtoMessage() {}
+
+func (x *BenchmarkPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkPoint.ProtoReflect.Descriptor instead.
+func (*BenchmarkPoint) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BenchmarkPoint) GetEavesdropProbability() float64 {
+	if x != nil {
+		return x.EavesdropProbability
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetNoise() float64 {
+	if x != nil {
+		return x.Noise
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetMeanQber() float64 {
+	if x != nil {
+		return x.MeanQber
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetQberStddev() float64 {
+	if x != nil {
+		return x.QberStddev
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetMinQber() float64 {
+	if x != nil {
+		return x.MinQber
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetMaxQber() float64 {
+	if x != nil {
+		return x.MaxQber
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetTheoreticalQber() float64 {
+	if x != nil {
+		return x.TheoreticalQber
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetMeanSiftedRatio() float64 {
+	if x != nil {
+		return x.MeanSiftedRatio
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetSecureFraction() float64 {
+	if x != nil {
+		return x.SecureFraction
+	}
+	return 0
+}
+
+func (x *BenchmarkPoint) GetKeyRate() float64 {
+	if x != nil {
+		return x.KeyRate
+	}
+	return 0
+}
+
+type BenchmarkResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Points         []*BenchmarkPoint      `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // Eavesdrop varies fastest
+	TotalSessions  int32                  `protobuf:"varint,2,opt,name=total_sessions,json=totalSessions,proto3" json:"total_sessions,omitempty"`
+	BitsPerSession int32                  `protobuf:"varint,3,opt,name=bits_per_session,json=bitsPerSession,proto3" json:"bits_per_session,omitempty"`
+	Threshold      float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ElapsedMs      int64                  `protobuf:"varint,5,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BenchmarkResult) Reset() {
+	*x = BenchmarkResult{}
+	mi := &file_crypto_crypto_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkResult) ProtoMessage() {}
+
+func (x *BenchmarkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkResult.ProtoReflect.Descriptor instead.
+func (*BenchmarkResult) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BenchmarkResult) GetPoints() []*BenchmarkPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetTotalSessions() int32 {
+	if x != nil {
+		return x.TotalSessions
+	}
+	return 0
+}
+
+func (x *BenchmarkResult) GetBitsPerSession() int32 {
+	if x != nil {
+		return x.BitsPerSession
+	}
+	return 0
+}
+
+func (x *BenchmarkResult) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *BenchmarkResult) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+type KeyRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	KeyLengthBits        int32                  `protobuf:"varint,1,opt,name=key_length_bits,json=keyLengthBits,proto3" json:"key_length_bits,omitempty"`                     // Multiple of 8 (default 256)
+	Algorithm            string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                                                     // "bb84" (default) or "qrng"
+	EavesdropProbability float64                `protobuf:"fixed64,3,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"` // bb84 only: simulated intercept-resend rate
+	Peer                 string                 `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`                                                               // Recorded with the pooled key
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *KeyRequest) Reset() {
+	*x = KeyRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRequest) ProtoMessage() {}
+
+func (x *KeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRequest.ProtoReflect.Descriptor instead.
+func (*KeyRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KeyRequest) GetKeyLengthBits() int32 {
+	if x != nil {
+		return x.KeyLengthBits
+	}
+	return 0
+}
+
+func (x *KeyRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *KeyRequest) GetEavesdropProbability() float64 {
+	if x != nil {
+		return x.EavesdropProbability
+	}
+	return 0
+}
+
+func (x *KeyRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+type QuantumKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Empty when the session was aborted
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	GeneratedAt   int64                  `protobuf:"varint,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	EntropySource string                 `protobuf:"bytes,4,opt,name=entropy_source,json=entropySource,proto3" json:"entropy_source,omitempty"`
+	Qber          float64                `protobuf:"fixed64,5,opt,name=qber,proto3" json:"qber,omitempty"`                                  // Error rate on the disclosed sample (bb84)
+	SiftedRatio   float64                `protobuf:"fixed64,6,opt,name=sifted_ratio,json=siftedRatio,proto3" json:"sifted_ratio,omitempty"` // Sifted / raw bits (bb84)
+	RawBits       int32                  `protobuf:"varint,7,opt,name=raw_bits,json=rawBits,proto3" json:"raw_bits,omitempty"`              // Qubits sent (or measured, for qrng)
+	SiftedBits    int32                  `protobuf:"varint,8,opt,name=sifted_bits,json=siftedBits,proto3" json:"sifted_bits,omitempty"`
+	DisclosedBits int32                  `protobuf:"varint,9,opt,name=disclosed_bits,json=disclosedBits,proto3" json:"disclosed_bits,omitempty"` // Sifted bits sacrificed to estimate the QBER
+	Secure        bool                   `protobuf:"varint,10,opt,name=secure,proto3" json:"secure,omitempty"`                                   // False when the QBER exceeded the abort threshold
+	KeyId         string                 `protobuf:"bytes,11,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                         // Pooled for QuantumEncrypt
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuantumKey) Reset() {
+	*x = QuantumKey{}
+	mi := &file_crypto_crypto_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuantumKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantumKey) ProtoMessage() {}
+
+func (x *QuantumKey) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantumKey.ProtoReflect.Descriptor instead.
+func (*QuantumKey) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QuantumKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *QuantumKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *QuantumKey) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+func (x *QuantumKey) GetEntropySource() string {
+	if x != nil {
+		return x.EntropySource
+	}
+	return ""
+}
+
+func (x *QuantumKey) GetQber() float64 {
+	if x != nil {
+		return x.Qber
+	}
+	return 0
+}
+
+func (x *QuantumKey) GetSiftedRatio() float64 {
+	if x != nil {
+		return x.SiftedRatio
+	}
+	return 0
+}
+
+func (x *QuantumKey) GetRawBits() int32 {
+	if x != nil {
+		return x.RawBits
+	}
+	return 0
+}
+
+func (x *QuantumKey) GetSiftedBits() int32 {
+	if x != nil {
+		return x.SiftedBits
+	}
+	return 0
+}
+
+func (x *QuantumKey) GetDisclosedBits() int32 {
+	if x != nil {
+		return x.DisclosedBits
+	}
+	return 0
+}
+
+func (x *QuantumKey) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
+}
+
+func (x *QuantumKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type EncryptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // "otp", "aes-gcm" ("aes-qrng" is an alias) or "" (auto)
+	KeyId         string                 `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,5,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Draw key_id's pad from this reservation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptRequest) Reset() {
+	*x = EncryptRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptRequest) ProtoMessage() {}
+
+func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
+func (*EncryptRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EncryptRequest) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *EncryptRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *EncryptRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type EncryptedMessage struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Ciphertext        []byte                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Nonce             []byte                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"` // AES-GCM only
+	Algorithm         string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	KeyId             string                 `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	KeyOffset         int32                  `protobuf:"varint,5,opt,name=key_offset,json=keyOffset,proto3" json:"key_offset,omitempty"` // First pad byte used; pass back to decrypt
+	KeyBytesUsed      int32                  `protobuf:"varint,6,opt,name=key_bytes_used,json=keyBytesUsed,proto3" json:"key_bytes_used,omitempty"`
+	KeyBytesRemaining int32                  `protobuf:"varint,7,opt,name=key_bytes_remaining,json=keyBytesRemaining,proto3" json:"key_bytes_remaining,omitempty"` // Unused pad left under key_id
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EncryptedMessage) Reset() {
+	*x = EncryptedMessage{}
+	mi := &file_crypto_crypto_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedMessage) ProtoMessage() {}
+
+func (x *EncryptedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptedMessage.ProtoReflect.Descriptor instead.
+func (*EncryptedMessage) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EncryptedMessage) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+func (x *EncryptedMessage) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *EncryptedMessage) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *EncryptedMessage) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *EncryptedMessage) GetKeyOffset() int32 {
+	if x != nil {
+		return x.KeyOffset
+	}
+	return 0
+}
+
+func (x *EncryptedMessage) GetKeyBytesUsed() int32 {
+	if x != nil {
+		return x.KeyBytesUsed
+	}
+	return 0
+}
+
+func (x *EncryptedMessage) GetKeyBytesRemaining() int32 {
+	if x != nil {
+		return x.KeyBytesRemaining
+	}
+	return 0
+}
+
+type DecryptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ciphertext    []byte                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Nonce         []byte                 `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	KeyId         string                 `protobuf:"bytes,5,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	KeyOffset     int32                  `protobuf:"varint,6,opt,name=key_offset,json=keyOffset,proto3" json:"key_offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecryptRequest) Reset() {
+	*x = DecryptRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptRequest) ProtoMessage() {}
+
+func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
+func (*DecryptRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DecryptRequest) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DecryptRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *DecryptRequest) GetKeyOffset() int32 {
+	if x != nil {
+		return x.KeyOffset
+	}
+	return 0
+}
+
+type DecryptedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecryptedMessage) Reset() {
+	*x = DecryptedMessage{}
+	mi := &file_crypto_crypto_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptedMessage) ProtoMessage() {}
+
+func (x *DecryptedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptedMessage.ProtoReflect.Descriptor instead.
+func (*DecryptedMessage) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DecryptedMessage) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *DecryptedMessage) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type KeyInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeyId          string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	SessionId      string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Peer           string                 `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	State          KeyState               `protobuf:"varint,4,opt,name=state,proto3,enum=qubit_engine.crypto.KeyState" json:"state,omitempty"`
+	SizeBytes      int32                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	UsedBytes      int32                  `protobuf:"varint,6,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	ReservedBytes  int32                  `protobuf:"varint,7,opt,name=reserved_bytes,json=reservedBytes,proto3" json:"reserved_bytes,omitempty"`
+	AvailableBytes int32                  `protobuf:"varint,8,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"` // Neither used nor reserved
+	Consumptions   int32                  `protobuf:"varint,9,opt,name=consumptions,proto3" json:"consumptions,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt     int64                  `protobuf:"varint,11,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RotatedTo      string                 `protobuf:"bytes,12,opt,name=rotated_to,json=rotatedTo,proto3" json:"rotated_to,omitempty"` // Replacement key, once retired
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *KeyInfo) Reset() {
+	*x = KeyInfo{}
+	mi := &file_crypto_crypto_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyInfo) ProtoMessage() {}
+
+func (x *KeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyInfo.ProtoReflect.Descriptor instead.
+func (*KeyInfo) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *KeyInfo) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *KeyInfo) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *KeyInfo) GetState() KeyState {
+	if x != nil {
+		return x.State
+	}
+	return KeyState_KEY_ACTIVE
+}
+
+func (x *KeyInfo) GetSizeBytes() int32 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetUsedBytes() int32 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetReservedBytes() int32 {
+	if x != nil {
+		return x.ReservedBytes
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetAvailableBytes() int32 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetConsumptions() int32 {
+	if x != nil {
+		return x.Consumptions
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *KeyInfo) GetRotatedTo() string {
+	if x != nil {
+		return x.RotatedTo
+	}
+	return ""
+}
+
+type ListKeysRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	KeyId            string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // Filters; empty matches everything
+	SessionId        string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Peer             string                 `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	IncludeDestroyed bool                   `protobuf:"varint,4,opt,name=include_destroyed,json=includeDestroyed,proto3" json:"include_destroyed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListKeysRequest) Reset() {
+	*x = ListKeysRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeysRequest) ProtoMessage() {}
+
+func (x *ListKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListKeysRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListKeysRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ListKeysRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ListKeysRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *ListKeysRequest) GetIncludeDestroyed() bool {
+	if x != nil {
+		return x.IncludeDestroyed
+	}
+	return false
+}
+
+type KeyList struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Keys                []*KeyInfo             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	TotalAvailableBytes int64                  `protobuf:"varint,2,opt,name=total_available_bytes,json=totalAvailableBytes,proto3" json:"total_available_bytes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KeyList) Reset() {
+	*x = KeyList{}
+	mi := &file_crypto_crypto_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyList) ProtoMessage() {}
+
+func (x *KeyList) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyList.ProtoReflect.Descriptor instead.
+func (*KeyList) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *KeyList) GetKeys() []*KeyInfo {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *KeyList) GetTotalAvailableBytes() int64 {
+	if x != nil {
+		return x.TotalAvailableBytes
+	}
+	return 0
+}
+
+type ReserveKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	NumBytes      int32                  `protobuf:"varint,2,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Default 300
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveKeyRequest) Reset() {
+	*x = ReserveKeyRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveKeyRequest) ProtoMessage() {}
+
+func (x *ReserveKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveKeyRequest.ProtoReflect.Descriptor instead.
+func (*ReserveKeyRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReserveKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ReserveKeyRequest) GetNumBytes() int32 {
+	if x != nil {
+		return x.NumBytes
+	}
+	return 0
+}
+
+func (x *ReserveKeyRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type KeyReservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	NumBytes      int32                  `protobuf:"varint,3,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyReservation) Reset() {
+	*x = KeyReservation{}
+	mi := &file_crypto_crypto_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyReservation) ProtoMessage() {}
+
+func (x *KeyReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyReservation.ProtoReflect.Descriptor instead.
+func (*KeyReservation) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *KeyReservation) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *KeyReservation) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyReservation) GetNumBytes() int32 {
+	if x != nil {
+		return x.NumBytes
+	}
+	return 0
+}
+
+func (x *KeyReservation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ConsumeKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Optional: draw from a reservation
+	NumBytes      int32                  `protobuf:"varint,3,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeKeyRequest) Reset() {
+	*x = ConsumeKeyRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeKeyRequest) ProtoMessage() {}
+
+func (x *ConsumeKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeKeyRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeKeyRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConsumeKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ConsumeKeyRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ConsumeKeyRequest) GetNumBytes() int32 {
+	if x != nil {
+		return x.NumBytes
+	}
+	return 0
+}
+
+type KeyMaterial struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	KeyId                string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Material             []byte                 `protobuf:"bytes,2,opt,name=material,proto3" json:"material,omitempty"`
+	Offset               int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	AvailableBytes       int32                  `protobuf:"varint,4,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	ReservationRemaining int32                  `protobuf:"varint,5,opt,name=reservation_remaining,json=reservationRemaining,proto3" json:"reservation_remaining,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *KeyMaterial) Reset() {
+	*x = KeyMaterial{}
+	mi := &file_crypto_crypto_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyMaterial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyMaterial) ProtoMessage() {}
+
+func (x *KeyMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyMaterial.ProtoReflect.Descriptor instead.
+func (*KeyMaterial) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *KeyMaterial) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyMaterial) GetMaterial() []byte {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *KeyMaterial) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *KeyMaterial) GetAvailableBytes() int32 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+func (x *KeyMaterial) GetReservationRemaining() int32 {
+	if x != nil {
+		return x.ReservationRemaining
+	}
+	return 0
+}
+
+type RotateKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateKeyRequest) Reset() {
+	*x = RotateKeyRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateKeyRequest) ProtoMessage() {}
+
+func (x *RotateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RotateKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type DestroyKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyKeyRequest) Reset() {
+	*x = DestroyKeyRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyKeyRequest) ProtoMessage() {}
+
+func (x *DestroyKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyKeyRequest.ProtoReflect.Descriptor instead.
+func (*DestroyKeyRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DestroyKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type ExportPskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"` // Optional: draw from a reservation
+	Hash          PskHash                `protobuf:"varint,3,opt,name=hash,proto3,enum=qubit_engine.crypto.PskHash" json:"hash,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"` // No colons; default "<key_id>.<offset>"
+	Sink          string                 `protobuf:"bytes,5,opt,name=sink,proto3" json:"sink,omitempty"`         // "vault" or "webhook", if configured
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPskRequest) Reset() {
+	*x = ExportPskRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPskRequest) ProtoMessage() {}
+
+func (x *ExportPskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPskRequest.ProtoReflect.Descriptor instead.
+func (*ExportPskRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportPskRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ExportPskRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ExportPskRequest) GetHash() PskHash {
+	if x != nil {
+		return x.Hash
+	}
+	return PskHash_PSK_SHA256
+}
+
+func (x *ExportPskRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *ExportPskRequest) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+type TlsPsk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Peer          string                 `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Identity      string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Key           []byte                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"` // Empty when pushed to a sink
+	Hash          PskHash                `protobuf:"varint,5,opt,name=hash,proto3,enum=qubit_engine.crypto.PskHash" json:"hash,omitempty"`
+	CipherSuite   string                 `protobuf:"bytes,6,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`                               // Pad offset the key was taken from
+	OpensslArgs   string                 `protobuf:"bytes,8,opt,name=openssl_args,json=opensslArgs,proto3" json:"openssl_args,omitempty"`   // For openssl s_client / s_server
+	PskFileLine   string                 `protobuf:"bytes,9,opt,name=psk_file_line,json=pskFileLine,proto3" json:"psk_file_line,omitempty"` // identity:hexkey, as read by psktool and stunnel
+	Sink          string                 `protobuf:"bytes,10,opt,name=sink,proto3" json:"sink,omitempty"`
+	SinkRef       string                 `protobuf:"bytes,11,opt,name=sink_ref,json=sinkRef,proto3" json:"sink_ref,omitempty"` // Where the sink stored the key
+	ExportedAt    int64                  `protobuf:"varint,12,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TlsPsk) Reset() {
+	*x = TlsPsk{}
+	mi := &file_crypto_crypto_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TlsPsk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TlsPsk) ProtoMessage() {}
+
+func (x *TlsPsk) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TlsPsk.ProtoReflect.Descriptor instead.
+func (*TlsPsk) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TlsPsk) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *TlsPsk) GetHash() PskHash {
+	if x != nil {
+		return x.Hash
+	}
+	return PskHash_PSK_SHA256
+}
+
+func (x *TlsPsk) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TlsPsk) GetOpensslArgs() string {
+	if x != nil {
+		return x.OpensslArgs
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetPskFileLine() string {
+	if x != nil {
+		return x.PskFileLine
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetSinkRef() string {
+	if x != nil {
+		return x.SinkRef
+	}
+	return ""
+}
+
+func (x *TlsPsk) GetExportedAt() int64 {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return 0
+}
+
+type ListKeySinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeySinksRequest) Reset() {
+	*x = ListKeySinksRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeySinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeySinksRequest) ProtoMessage() {}
+
+func (x *ListKeySinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeySinksRequest.ProtoReflect.Descriptor instead.
+func (*ListKeySinksRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{47}
+}
+
+type KeySinkList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sinks         []string               `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeySinkList) Reset() {
+	*x = KeySinkList{}
+	mi := &file_crypto_crypto_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeySinkList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeySinkList) ProtoMessage() {}
+
+func (x *KeySinkList) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeySinkList.ProtoReflect.Descriptor instead.
+func (*KeySinkList) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *KeySinkList) GetSinks() []string {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type EavesdropRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SessionId            string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AliceCheckBits       []int32                `protobuf:"varint,2,rep,packed,name=alice_check_bits,json=aliceCheckBits,proto3" json:"alice_check_bits,omitempty"`
+	BobCheckBits         []int32                `protobuf:"varint,3,rep,packed,name=bob_check_bits,json=bobCheckBits,proto3" json:"bob_check_bits,omitempty"`
+	EavesdropProbability float64                `protobuf:"fixed64,4,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"` // Unused; see estimated_eavesdrop_probability
+	SampleFraction       float64                `protobuf:"fixed64,5,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`                   // Sifted bits to disclose (default 0.25)
+	Confidence           float64                `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`                                                 // Interval confidence level (default 0.95)
+	AbortThreshold       float64                `protobuf:"fixed64,7,opt,name=abort_threshold,json=abortThreshold,proto3" json:"abort_threshold,omitempty"`                   // QBER above which keys are unsafe (default 0.1)
+	ChannelErrorRate     float64                `protobuf:"fixed64,8,opt,name=channel_error_rate,json=channelErrorRate,proto3" json:"channel_error_rate,omitempty"`           // Intrinsic error rate without an eavesdropper
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EavesdropRequest) Reset() {
+	*x = EavesdropRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EavesdropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EavesdropRequest) ProtoMessage() {}
+
+func (x *EavesdropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EavesdropRequest.ProtoReflect.Descriptor instead.
+func (*EavesdropRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EavesdropRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *EavesdropRequest) GetAliceCheckBits() []int32 {
+	if x != nil {
+		return x.AliceCheckBits
+	}
+	return nil
+}
+
+func (x *EavesdropRequest) GetBobCheckBits() []int32 {
+	if x != nil {
+		return x.BobCheckBits
+	}
+	return nil
+}
+
+func (x *EavesdropRequest) GetEavesdropProbability() float64 {
+	if x != nil {
+		return x.EavesdropProbability
+	}
+	return 0
+}
+
+func (x *EavesdropRequest) GetSampleFraction() float64 {
+	if x != nil {
+		return x.SampleFraction
+	}
+	return 0
+}
+
+func (x *EavesdropRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *EavesdropRequest) GetAbortThreshold() float64 {
+	if x != nil {
+		return x.AbortThreshold
+	}
+	return 0
+}
+
+func (x *EavesdropRequest) GetChannelErrorRate() float64 {
+	if x != nil {
+		return x.ChannelErrorRate
+	}
+	return 0
+}
+
+type EavesdropResult struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorRate                     float64                `protobuf:"fixed64,1,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	EavesdropperDetected          bool                   `protobuf:"varint,2,opt,name=eavesdropper_detected,json=eavesdropperDetected,proto3" json:"eavesdropper_detected,omitempty"` // Errors significantly exceed the channel error rate
+	Recommendation                string                 `protobuf:"bytes,3,opt,name=recommendation,proto3" json:"recommendation,omitempty"`                                          // "proceed", "abort", "retry" (inconclusive: check more bits)
+	SampleSize                    int32                  `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	Errors                        int32                  `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	ConfidenceLower               float64                `protobuf:"fixed64,6,opt,name=confidence_lower,json=confidenceLower,proto3" json:"confidence_lower,omitempty"` // QBER confidence interval
+	ConfidenceUpper               float64                `protobuf:"fixed64,7,opt,name=confidence_upper,json=confidenceUpper,proto3" json:"confidence_upper,omitempty"`
+	Confidence                    float64                `protobuf:"fixed64,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	PValue                        float64                `protobuf:"fixed64,9,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`                                                                         // P(at least this many errors | channel errors only)
+	EstimatedEavesdropProbability float64                `protobuf:"fixed64,10,opt,name=estimated_eavesdrop_probability,json=estimatedEavesdropProbability,proto3" json:"estimated_eavesdrop_probability,omitempty"` // Intercept-resend fraction implied by the QBER
+	AbortThreshold                float64                `protobuf:"fixed64,11,opt,name=abort_threshold,json=abortThreshold,proto3" json:"abort_threshold,omitempty"`
+	Abort                         bool                   `protobuf:"varint,12,opt,name=abort,proto3" json:"abort,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *EavesdropResult) Reset() {
+	*x = EavesdropResult{}
+	mi := &file_crypto_crypto_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EavesdropResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EavesdropResult) ProtoMessage() {}
+
+func (x *EavesdropResult) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EavesdropResult.ProtoReflect.Descriptor instead.
+func (*EavesdropResult) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EavesdropResult) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *EavesdropResult) GetEavesdropperDetected() bool {
+	if x != nil {
+		return x.EavesdropperDetected
+	}
+	return false
+}
+
+func (x *EavesdropResult) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *EavesdropResult) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *EavesdropResult) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *EavesdropResult) GetConfidenceLower() float64 {
+	if x != nil {
+		return x.ConfidenceLower
+	}
+	return 0
+}
+
+func (x *EavesdropResult) GetConfidenceUpper() float64 {
+	if x != nil {
+		return x.ConfidenceUpper
+	}
+	return 0
+}
+
+func (x *EavesdropResult) GetConfidence() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Confidence
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
-func (*EncryptRequest) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{8}
+func (x *EavesdropResult) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
 }
 
-func (x *EncryptRequest) GetPlaintext() []byte {
+func (x *EavesdropResult) GetEstimatedEavesdropProbability() float64 {
 	if x != nil {
-		return x.Plaintext
+		return x.EstimatedEavesdropProbability
 	}
-	return nil
+	return 0
 }
 
-func (x *EncryptRequest) GetKey() []byte {
+func (x *EavesdropResult) GetAbortThreshold() float64 {
 	if x != nil {
-		return x.Key
+		return x.AbortThreshold
 	}
-	return nil
+	return 0
 }
 
-func (x *EncryptRequest) GetAlgorithm() string {
+func (x *EavesdropResult) GetAbort() bool {
 	if x != nil {
-		return x.Algorithm
+		return x.Abort
 	}
-	return ""
+	return false
 }
 
-type EncryptedMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ciphertext    []byte                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
-	Nonce         []byte                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RandomRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NumBytes       int32                  `protobuf:"varint,1,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`                   // Up to 65536
+	RequireHealthy bool                   `protobuf:"varint,2,opt,name=require_healthy,json=requireHealthy,proto3" json:"require_healthy,omitempty"` // Fail instead of serving degraded output
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *EncryptedMessage) Reset() {
-	*x = EncryptedMessage{}
-	mi := &file_crypto_crypto_proto_msgTypes[9]
+func (x *RandomRequest) Reset() {
+	*x = RandomRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EncryptedMessage) String() string {
+func (x *RandomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptedMessage) ProtoMessage() {}
+func (*RandomRequest) ProtoMessage() {}
 
-func (x *EncryptedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[9]
+func (x *RandomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,57 +4501,49 @@ func (x *EncryptedMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptedMessage.ProtoReflect.Descriptor instead.
-func (*EncryptedMessage) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *EncryptedMessage) GetCiphertext() []byte {
-	if x != nil {
-		return x.Ciphertext
-	}
-	return nil
+// Deprecated: Use RandomRequest.ProtoReflect.Descriptor instead.
+func (*RandomRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *EncryptedMessage) GetNonce() []byte {
+func (x *RandomRequest) GetNumBytes() int32 {
 	if x != nil {
-		return x.Nonce
+		return x.NumBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *EncryptedMessage) GetAlgorithm() string {
+func (x *RandomRequest) GetRequireHealthy() bool {
 	if x != nil {
-		return x.Algorithm
+		return x.RequireHealthy
 	}
-	return ""
+	return false
 }
 
-type DecryptRequest struct {
+type RandomBytes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ciphertext    []byte                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
-	Key           []byte                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Nonce         []byte                 `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Degraded      bool                   `protobuf:"varint,2,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DecryptRequest) Reset() {
-	*x = DecryptRequest{}
-	mi := &file_crypto_crypto_proto_msgTypes[10]
+func (x *RandomBytes) Reset() {
+	*x = RandomBytes{}
+	mi := &file_crypto_crypto_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DecryptRequest) String() string {
+func (x *RandomBytes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptRequest) ProtoMessage() {}
+func (*RandomBytes) ProtoMessage() {}
 
-func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[10]
+func (x *RandomBytes) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,62 +4554,53 @@ func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
-func (*DecryptRequest) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *DecryptRequest) GetCiphertext() []byte {
-	if x != nil {
-		return x.Ciphertext
-	}
-	return nil
+// Deprecated: Use RandomBytes.ProtoReflect.Descriptor instead.
+func (*RandomBytes) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DecryptRequest) GetKey() []byte {
+func (x *RandomBytes) GetData() []byte {
 	if x != nil {
-		return x.Key
+		return x.Data
 	}
 	return nil
 }
 
-func (x *DecryptRequest) GetNonce() []byte {
+func (x *RandomBytes) GetDegraded() bool {
 	if x != nil {
-		return x.Nonce
+		return x.Degraded
 	}
-	return nil
+	return false
 }
 
-func (x *DecryptRequest) GetAlgorithm() string {
+func (x *RandomBytes) GetSource() string {
 	if x != nil {
-		return x.Algorithm
+		return x.Source
 	}
 	return ""
 }
 
-type DecryptedMessage struct {
+type EntropyReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
-	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DecryptedMessage) Reset() {
-	*x = DecryptedMessage{}
-	mi := &file_crypto_crypto_proto_msgTypes[11]
+func (x *EntropyReportRequest) Reset() {
+	*x = EntropyReportRequest{}
+	mi := &file_crypto_crypto_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DecryptedMessage) String() string {
+func (x *EntropyReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptedMessage) ProtoMessage() {}
+func (*EntropyReportRequest) ProtoMessage() {}
 
-func (x *DecryptedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[11]
+func (x *EntropyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,50 +4611,47 @@ func (x *DecryptedMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptedMessage.ProtoReflect.Descriptor instead.
-func (*DecryptedMessage) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *DecryptedMessage) GetPlaintext() []byte {
-	if x != nil {
-		return x.Plaintext
-	}
-	return nil
-}
-
-func (x *DecryptedMessage) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
-}
-
-type EavesdropRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	SessionId            string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AliceCheckBits       []int32                `protobuf:"varint,2,rep,packed,name=alice_check_bits,json=aliceCheckBits,proto3" json:"alice_check_bits,omitempty"`
-	BobCheckBits         []int32                `protobuf:"varint,3,rep,packed,name=bob_check_bits,json=bobCheckBits,proto3" json:"bob_check_bits,omitempty"`
-	EavesdropProbability float64                `protobuf:"fixed64,4,opt,name=eavesdrop_probability,json=eavesdropProbability,proto3" json:"eavesdrop_probability,omitempty"` // Probability that Eve measures a qubit (0.0 - 1.0)
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *EavesdropRequest) Reset() {
-	*x = EavesdropRequest{}
-	mi := &file_crypto_crypto_proto_msgTypes[12]
+// Deprecated: Use EntropyReportRequest.ProtoReflect.Descriptor instead.
+func (*EntropyReportRequest) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{53}
+}
+
+type EntropyReport struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Degraded           bool                   `protobuf:"varint,1,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	DegradedReason     string                 `protobuf:"bytes,2,opt,name=degraded_reason,json=degradedReason,proto3" json:"degraded_reason,omitempty"`
+	RawBits            int64                  `protobuf:"varint,3,opt,name=raw_bits,json=rawBits,proto3" json:"raw_bits,omitempty"` // Engine bits health-tested so far
+	OnesFraction       float64                `protobuf:"fixed64,4,opt,name=ones_fraction,json=onesFraction,proto3" json:"ones_fraction,omitempty"`
+	MinEntropyEstimate float64                `protobuf:"fixed64,5,opt,name=min_entropy_estimate,json=minEntropyEstimate,proto3" json:"min_entropy_estimate,omitempty"` // Most-common-value estimate, bits per raw bit
+	AssessedMinEntropy float64                `protobuf:"fixed64,6,opt,name=assessed_min_entropy,json=assessedMinEntropy,proto3" json:"assessed_min_entropy,omitempty"` // What the test cutoffs assume
+	RepetitionCutoff   int32                  `protobuf:"varint,7,opt,name=repetition_cutoff,json=repetitionCutoff,proto3" json:"repetition_cutoff,omitempty"`
+	AdaptiveCutoff     int32                  `protobuf:"varint,8,opt,name=adaptive_cutoff,json=adaptiveCutoff,proto3" json:"adaptive_cutoff,omitempty"`
+	AdaptiveWindow     int32                  `protobuf:"varint,9,opt,name=adaptive_window,json=adaptiveWindow,proto3" json:"adaptive_window,omitempty"`
+	RepetitionFailures int64                  `protobuf:"varint,10,opt,name=repetition_failures,json=repetitionFailures,proto3" json:"repetition_failures,omitempty"`
+	AdaptiveFailures   int64                  `protobuf:"varint,11,opt,name=adaptive_failures,json=adaptiveFailures,proto3" json:"adaptive_failures,omitempty"`
+	EngineErrors       int64                  `protobuf:"varint,12,opt,name=engine_errors,json=engineErrors,proto3" json:"engine_errors,omitempty"`
+	PoolBytes          int32                  `protobuf:"varint,13,opt,name=pool_bytes,json=poolBytes,proto3" json:"pool_bytes,omitempty"` // Conditioned output ready to serve
+	BytesServed        int64                  `protobuf:"varint,14,opt,name=bytes_served,json=bytesServed,proto3" json:"bytes_served,omitempty"`
+	LastFailureAt      int64                  `protobuf:"varint,15,opt,name=last_failure_at,json=lastFailureAt,proto3" json:"last_failure_at,omitempty"` // Unix seconds, 0 if none
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EntropyReport) Reset() {
+	*x = EntropyReport{}
+	mi := &file_crypto_crypto_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EavesdropRequest) String() string {
+func (x *EntropyReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EavesdropRequest) ProtoMessage() {}
+func (*EntropyReport) ProtoMessage() {}
 
-func (x *EavesdropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[12]
+func (x *EntropyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_crypto_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,124 +4662,190 @@ func (x *EavesdropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EavesdropRequest.ProtoReflect.Descriptor instead.
-func (*EavesdropRequest) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EntropyReport.ProtoReflect.Descriptor instead.
+func (*EntropyReport) Descriptor() ([]byte, []int) {
+	return file_crypto_crypto_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *EavesdropRequest) GetSessionId() string {
+func (x *EntropyReport) GetDegraded() bool {
 	if x != nil {
-		return x.SessionId
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *EntropyReport) GetDegradedReason() string {
+	if x != nil {
+		return x.DegradedReason
 	}
 	return ""
 }
 
-func (x *EavesdropRequest) GetAliceCheckBits() []int32 {
+func (x *EntropyReport) GetRawBits() int64 {
 	if x != nil {
-		return x.AliceCheckBits
+		return x.RawBits
 	}
-	return nil
+	return 0
 }
 
-func (x *EavesdropRequest) GetBobCheckBits() []int32 {
+func (x *EntropyReport) GetOnesFraction() float64 {
 	if x != nil {
-		return x.BobCheckBits
+		return x.OnesFraction
 	}
-	return nil
+	return 0
 }
 
-func (x *EavesdropRequest) GetEavesdropProbability() float64 {
+func (x *EntropyReport) GetMinEntropyEstimate() float64 {
 	if x != nil {
-		return x.EavesdropProbability
+		return x.MinEntropyEstimate
 	}
 	return 0
 }
 
-type EavesdropResult struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	ErrorRate            float64                `protobuf:"fixed64,1,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
-	EavesdropperDetected bool                   `protobuf:"varint,2,opt,name=eavesdropper_detected,json=eavesdropperDetected,proto3" json:"eavesdropper_detected,omitempty"`
-	Recommendation       string                 `protobuf:"bytes,3,opt,name=recommendation,proto3" json:"recommendation,omitempty"` // "proceed", "abort", "retry"
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *EntropyReport) GetAssessedMinEntropy() float64 {
+	if x != nil {
+		return x.AssessedMinEntropy
+	}
+	return 0
 }
 
-func (x *EavesdropResult) Reset() {
-	*x = EavesdropResult{}
-	mi := &file_crypto_crypto_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EntropyReport) GetRepetitionCutoff() int32 {
+	if x != nil {
+		return x.RepetitionCutoff
+	}
+	return 0
 }
 
-func (x *EavesdropResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EntropyReport) GetAdaptiveCutoff() int32 {
+	if x != nil {
+		return x.AdaptiveCutoff
+	}
+	return 0
 }
 
-func (*EavesdropResult) ProtoMessage() {}
+func (x *EntropyReport) GetAdaptiveWindow() int32 {
+	if x != nil {
+		return x.AdaptiveWindow
+	}
+	return 0
+}
 
-func (x *EavesdropResult) ProtoReflect() protoreflect.Message {
-	mi := &file_crypto_crypto_proto_msgTypes[13]
+func (x *EntropyReport) GetRepetitionFailures() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RepetitionFailures
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use EavesdropResult.ProtoReflect.Descriptor instead.
-func (*EavesdropResult) Descriptor() ([]byte, []int) {
-	return file_crypto_crypto_proto_rawDescGZIP(), []int{13}
+func (x *EntropyReport) GetAdaptiveFailures() int64 {
+	if x != nil {
+		return x.AdaptiveFailures
+	}
+	return 0
 }
 
-func (x *EavesdropResult) GetErrorRate() float64 {
+func (x *EntropyReport) GetEngineErrors() int64 {
 	if x != nil {
-		return x.ErrorRate
+		return x.EngineErrors
 	}
 	return 0
 }
 
-func (x *EavesdropResult) GetEavesdropperDetected() bool {
+func (x *EntropyReport) GetPoolBytes() int32 {
 	if x != nil {
-		return x.EavesdropperDetected
+		return x.PoolBytes
 	}
-	return false
+	return 0
 }
 
-func (x *EavesdropResult) GetRecommendation() string {
+func (x *EntropyReport) GetBytesServed() int64 {
 	if x != nil {
-		return x.Recommendation
+		return x.BytesServed
 	}
-	return ""
+	return 0
+}
+
+func (x *EntropyReport) GetLastFailureAt() int64 {
+	if x != nil {
+		return x.LastFailureAt
+	}
+	return 0
 }
 
 var File_crypto_crypto_proto protoreflect.FileDescriptor
 
 const file_crypto_crypto_proto_rawDesc = "" +
 	"\n" +
-	"\x13crypto/crypto.proto\x12\x13qubit_engine.crypto\"\x81\x01\n" +
+	"\x13crypto/crypto.proto\x12\x13qubit_engine.crypto\"\xed\x02\n" +
 	"\x10BB84AliceRequest\x12\x19\n" +
 	"\bnum_bits\x18\x01 \x01(\x05R\anumBits\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x02 \x01(\tR\tsessionId\x123\n" +
-	"\x15eavesdrop_probability\x18\x03 \x01(\x01R\x14eavesdropProbability\"\x9c\x01\n" +
+	"\x15eavesdrop_probability\x18\x03 \x01(\x01R\x14eavesdropProbability\x129\n" +
+	"\bprotocol\x18\x04 \x01(\x0e2\x1d.qubit_engine.crypto.ProtocolR\bprotocol\x12\x19\n" +
+	"\bauth_key\x18\x05 \x01(\fR\aauthKey\x126\n" +
+	"\x05decoy\x18\x06 \x01(\v2 .qubit_engine.crypto.DecoyConfigR\x05decoy\x12\x1f\n" +
+	"\vttl_seconds\x18\a \x01(\x05R\n" +
+	"ttlSeconds\x12;\n" +
+	"\x06policy\x18\b \x01(\v2#.qubit_engine.crypto.SecurityPolicyR\x06policy\"\x9b\x01\n" +
+	"\x0eSecurityPolicy\x12%\n" +
+	"\x0eqber_threshold\x18\x01 \x01(\x01R\rqberThreshold\x12&\n" +
+	"\x0fmin_sifted_bits\x18\x02 \x01(\x05R\rminSiftedBits\x12 \n" +
+	"\fmin_key_bits\x18\x03 \x01(\x05R\n" +
+	"minKeyBits\x12\x18\n" +
+	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\"\xdc\x02\n" +
 	"\x0eBB84AliceState\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x12\n" +
 	"\x04bits\x18\x02 \x03(\x05R\x04bits\x120\n" +
 	"\x05bases\x18\x03 \x03(\x0e2\x1a.qubit_engine.crypto.BasisR\x05bases\x12%\n" +
-	"\x0equantum_states\x18\x04 \x01(\fR\rquantumStates\"V\n" +
+	"\x0equantum_states\x18\x04 \x01(\fR\rquantumStates\x129\n" +
+	"\bprotocol\x18\x05 \x01(\x0e2\x1d.qubit_engine.crypto.ProtocolR\bprotocol\x12\x1b\n" +
+	"\tbases_mac\x18\x06 \x01(\fR\bbasesMac\x12)\n" +
+	"\x10intensity_levels\x18\a \x03(\x05R\x0fintensityLevels\x12;\n" +
+	"\x06policy\x18\b \x01(\v2#.qubit_engine.crypto.SecurityPolicyR\x06policy\"V\n" +
 	"\x0eBB84BobRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12%\n" +
-	"\x0equantum_states\x18\x02 \x01(\fR\rquantumStates\"\x83\x01\n" +
+	"\x0equantum_states\x18\x02 \x01(\fR\rquantumStates\"\x95\x01\n" +
 	"\fBB84BobState\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x120\n" +
 	"\x05bases\x18\x02 \x03(\x0e2\x1a.qubit_engine.crypto.BasisR\x05bases\x12\"\n" +
-	"\fmeasurements\x18\x03 \x03(\x05R\fmeasurements\"\xf1\x01\n" +
+	"\fmeasurements\x18\x03 \x03(\x05R\fmeasurements\x12\x10\n" +
+	"\x03mac\x18\x04 \x01(\fR\x03mac\"\x94\x01\n" +
+	"\x11BB84StreamRequest\x12<\n" +
+	"\x05start\x18\x01 \x01(\v2$.qubit_engine.crypto.BB84StreamStartH\x00R\x05start\x126\n" +
+	"\x03ack\x18\x02 \x01(\v2\".qubit_engine.crypto.BB84StreamAckH\x00R\x03ackB\t\n" +
+	"\amessage\"\xca\x02\n" +
+	"\x0fBB84StreamStart\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bnum_bits\x18\x02 \x01(\x05R\anumBits\x123\n" +
+	"\x15eavesdrop_probability\x18\x03 \x01(\x01R\x14eavesdropProbability\x129\n" +
+	"\bprotocol\x18\x04 \x01(\x0e2\x1d.qubit_engine.crypto.ProtocolR\bprotocol\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\x12\x16\n" +
+	"\x06window\x18\x06 \x01(\x05R\x06window\x12\x19\n" +
+	"\bauth_key\x18\a \x01(\fR\aauthKey\x12;\n" +
+	"\x06policy\x18\b \x01(\v2#.qubit_engine.crypto.SecurityPolicyR\x06policy\")\n" +
+	"\rBB84StreamAck\x12\x18\n" +
+	"\abatches\x18\x01 \x01(\x05R\abatches\"\xf3\x02\n" +
+	"\x0fBB84StreamBatch\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"alice_bits\x18\x04 \x03(\x05R\taliceBits\x12;\n" +
+	"\valice_bases\x18\x05 \x03(\x0e2\x1a.qubit_engine.crypto.BasisR\n" +
+	"aliceBases\x127\n" +
+	"\tbob_bases\x18\x06 \x03(\x0e2\x1a.qubit_engine.crypto.BasisR\bbobBases\x12)\n" +
+	"\x10bob_measurements\x18\a \x03(\x05R\x0fbobMeasurements\x12\x12\n" +
+	"\x04last\x18\b \x01(\bR\x04last\x12&\n" +
+	"\x0falice_bases_mac\x18\t \x01(\fR\raliceBasesMac\x12\x17\n" +
+	"\abob_mac\x18\n" +
+	" \x01(\fR\x06bobMac\"\xc6\x02\n" +
 	"\x10ReconcileRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12;\n" +
@@ -988,7 +4854,10 @@ const file_crypto_crypto_proto_rawDesc = "" +
 	"\tbob_bases\x18\x03 \x03(\x0e2\x1a.qubit_engine.crypto.BasisR\bbobBases\x12\x1d\n" +
 	"\n" +
 	"alice_bits\x18\x04 \x03(\x05R\taliceBits\x12)\n" +
-	"\x10bob_measurements\x18\x05 \x03(\x05R\x0fbobMeasurements\"\xc4\x01\n" +
+	"\x10bob_measurements\x18\x05 \x03(\x05R\x0fbobMeasurements\x12&\n" +
+	"\x0falice_bases_mac\x18\x06 \x01(\fR\raliceBasesMac\x12\x17\n" +
+	"\abob_mac\x18\a \x01(\fR\x06bobMac\x12\x12\n" +
+	"\x04peer\x18\b \x01(\tR\x04peer\"\xf6\x04\n" +
 	"\aBB84Key\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
@@ -999,51 +4868,387 @@ const file_crypto_crypto_proto_rawDesc = "" +
 	"siftedBits\x12\x1d\n" +
 	"\n" +
 	"error_rate\x18\x05 \x01(\x01R\terrorRate\x12\x16\n" +
-	"\x06secure\x18\x06 \x01(\bR\x06secure\"R\n" +
+	"\x06secure\x18\x06 \x01(\bR\x06secure\x12\x15\n" +
+	"\x06key_id\x18\a \x01(\tR\x05keyId\x129\n" +
+	"\bprotocol\x18\b \x01(\x0e2\x1d.qubit_engine.crypto.ProtocolR\bprotocol\x12$\n" +
+	"\rauthenticated\x18\t \x01(\bR\rauthenticated\x12\x1d\n" +
+	"\n" +
+	"auth_error\x18\n" +
+	" \x01(\tR\tauthError\x12I\n" +
+	"\rdecoy_classes\x18\v \x03(\v2$.qubit_engine.crypto.DecoyClassStatsR\fdecoyClasses\x12I\n" +
+	"\x0edecoy_analysis\x18\f \x01(\v2\".qubit_engine.crypto.DecoyAnalysisR\rdecoyAnalysis\x12;\n" +
+	"\x06policy\x18\r \x01(\v2#.qubit_engine.crypto.SecurityPolicyR\x06policy\x12&\n" +
+	"\x0ffinite_key_bits\x18\x0e \x01(\x05R\rfiniteKeyBits\x12\x1e\n" +
+	"\n" +
+	"violations\x18\x0f \x03(\tR\n" +
+	"violations\"p\n" +
+	"\n" +
+	"DecoyLevel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x12mean_photon_number\x18\x02 \x01(\x01R\x10meanPhotonNumber\x12 \n" +
+	"\vprobability\x18\x03 \x01(\x01R\vprobability\"\xb3\x01\n" +
+	"\vDecoyConfig\x127\n" +
+	"\x06levels\x18\x01 \x03(\v2\x1f.qubit_engine.crypto.DecoyLevelR\x06levels\x12$\n" +
+	"\rtransmittance\x18\x02 \x01(\x01R\rtransmittance\x12&\n" +
+	"\x0fdark_count_rate\x18\x03 \x01(\x01R\rdarkCountRate\x12\x1d\n" +
+	"\n" +
+	"pns_attack\x18\x04 \x01(\bR\tpnsAttack\"\xf7\x01\n" +
+	"\x0fDecoyClassStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x12mean_photon_number\x18\x02 \x01(\x01R\x10meanPhotonNumber\x12\x16\n" +
+	"\x06pulses\x18\x03 \x01(\x05R\x06pulses\x12\x1e\n" +
+	"\n" +
+	"detections\x18\x04 \x01(\x05R\n" +
+	"detections\x12\x12\n" +
+	"\x04gain\x18\x05 \x01(\x01R\x04gain\x12\x1f\n" +
+	"\vsifted_bits\x18\x06 \x01(\x05R\n" +
+	"siftedBits\x12\x16\n" +
+	"\x06errors\x18\a \x01(\x05R\x06errors\x12\x1d\n" +
+	"\n" +
+	"error_rate\x18\b \x01(\x01R\terrorRate\"\xa9\x02\n" +
+	"\rDecoyAnalysis\x12)\n" +
+	"\x10background_yield\x18\x01 \x01(\x01R\x0fbackgroundYield\x129\n" +
+	"\x19single_photon_yield_lower\x18\x02 \x01(\x01R\x16singlePhotonYieldLower\x129\n" +
+	"\x19single_photon_error_upper\x18\x03 \x01(\x01R\x16singlePhotonErrorUpper\x127\n" +
+	"\x18single_photon_gain_lower\x18\x04 \x01(\x01R\x15singlePhotonGainLower\x12\x19\n" +
+	"\bkey_rate\x18\x05 \x01(\x01R\akeyRate\x12#\n" +
+	"\rpns_suspected\x18\x06 \x01(\bR\fpnsSuspected\"\x81\x03\n" +
+	"\rSessionStatus\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x127\n" +
+	"\x05state\x18\x02 \x01(\x0e2!.qubit_engine.crypto.SessionStateR\x05state\x129\n" +
+	"\bprotocol\x18\x03 \x01(\x0e2\x1d.qubit_engine.crypto.ProtocolR\bprotocol\x12\x19\n" +
+	"\bnum_bits\x18\x04 \x01(\x05R\anumBits\x12%\n" +
+	"\x0edisclosed_bits\x18\x05 \x01(\x05R\rdisclosedBits\x12$\n" +
+	"\rauthenticated\x18\x06 \x01(\bR\rauthenticated\x12\x14\n" +
+	"\x05decoy\x18\a \x01(\bR\x05decoy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\x12!\n" +
+	"\fabort_reason\x18\n" +
+	" \x01(\tR\vabortReason\">\n" +
+	"\x13ListSessionsRequest\x12'\n" +
+	"\x0finclude_aborted\x18\x01 \x01(\bR\x0eincludeAborted\"M\n" +
+	"\vSessionList\x12>\n" +
+	"\bsessions\x18\x01 \x03(\v2\".qubit_engine.crypto.SessionStatusR\bsessions\"5\n" +
+	"\x14SessionStatusRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"L\n" +
+	"\x13AbortSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xb2\x01\n" +
+	"\x14ConferenceKeyRequest\x12\x18\n" +
+	"\aparties\x18\x01 \x03(\tR\aparties\x12&\n" +
+	"\x0fkey_length_bits\x18\x02 \x01(\x05R\rkeyLengthBits\x123\n" +
+	"\x15eavesdrop_probability\x18\x03 \x01(\x01R\x14eavesdropProbability\x12#\n" +
+	"\rtest_fraction\x18\x04 \x01(\x01R\ftestFraction\"\x84\x03\n" +
+	"\x13ConferenceKeyResult\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12\x18\n" +
+	"\aparties\x18\x03 \x03(\tR\aparties\x12\x16\n" +
+	"\x06rounds\x18\x04 \x01(\x05R\x06rounds\x12\x1d\n" +
+	"\n" +
+	"key_rounds\x18\x05 \x01(\x05R\tkeyRounds\x12\x1f\n" +
+	"\vtest_rounds\x18\x06 \x01(\x05R\n" +
+	"testRounds\x12)\n" +
+	"\x10disclosed_rounds\x18\a \x01(\x05R\x0fdisclosedRounds\x12\x15\n" +
+	"\x06qber_x\x18\b \x01(\x01R\x05qberX\x12\x15\n" +
+	"\x06qber_z\x18\t \x01(\x01R\x05qberZ\x12#\n" +
+	"\rpairwise_qber\x18\n" +
+	" \x03(\x01R\fpairwiseQber\x12\x19\n" +
+	"\bkey_rate\x18\v \x01(\x01R\akeyRate\x12\x16\n" +
+	"\x06secure\x18\f \x01(\bR\x06secure\x12!\n" +
+	"\fgenerated_at\x18\r \x01(\x03R\vgeneratedAt\"Z\n" +
+	"\x11CreateFlipRequest\x12\x14\n" +
+	"\x05alice\x18\x01 \x01(\tR\x05alice\x12\x10\n" +
+	"\x03bob\x18\x02 \x01(\tR\x03bob\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x03 \x01(\x05R\tnumQubits\"\x8c\x03\n" +
+	"\vFlipSession\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12\x14\n" +
+	"\x05alice\x18\x02 \x01(\tR\x05alice\x12\x10\n" +
+	"\x03bob\x18\x03 \x01(\tR\x03bob\x124\n" +
+	"\x05stage\x18\x04 \x01(\x0e2\x1e.qubit_engine.crypto.FlipStageR\x05stage\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x05 \x01(\x05R\tnumQubits\x127\n" +
+	"\tbob_bases\x18\x06 \x03(\x0e2\x1a.qubit_engine.crypto.BasisR\bbobBases\x12\x1f\n" +
+	"\vbob_results\x18\a \x03(\x05R\n" +
+	"bobResults\x120\n" +
+	"\x05guess\x18\b \x01(\x0e2\x1a.qubit_engine.crypto.BasisR\x05guess\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\valice_token\x18\n" +
+	" \x01(\tR\n" +
+	"aliceToken\x12\x1b\n" +
+	"\tbob_token\x18\v \x01(\tR\bbobToken\"\x8c\x01\n" +
+	"\x11CommitFlipRequest\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x120\n" +
+	"\x05guess\x18\x03 \x01(\x0e2\x1a.qubit_engine.crypto.BasisR\x05guess\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"\x86\x01\n" +
+	"\x11RevealFlipRequest\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12*\n" +
+	"\x11claim_other_basis\x18\x02 \x01(\bR\x0fclaimOtherBasis\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\tR\x06player\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"\xc9\x02\n" +
+	"\n" +
+	"FlipResult\x12\x17\n" +
+	"\aflip_id\x18\x01 \x01(\tR\x06flipId\x12;\n" +
+	"\valice_basis\x18\x02 \x01(\x0e2\x1a.qubit_engine.crypto.BasisR\n" +
+	"aliceBasis\x12\x1d\n" +
+	"\n" +
+	"alice_bits\x18\x03 \x03(\x05R\taliceBits\x120\n" +
+	"\x05guess\x18\x04 \x01(\x0e2\x1a.qubit_engine.crypto.BasisR\x05guess\x12\x19\n" +
+	"\bbob_wins\x18\x05 \x01(\bR\abobWins\x12\x16\n" +
+	"\x06winner\x18\x06 \x01(\tR\x06winner\x12\x1a\n" +
+	"\bverified\x18\a \x01(\bR\bverified\x12%\n" +
+	"\x0echecked_qubits\x18\b \x01(\x05R\rcheckedQubits\x12\x1e\n" +
+	"\n" +
+	"mismatches\x18\t \x01(\x05R\n" +
+	"mismatches\"\xb8\x02\n" +
+	"\x10BenchmarkRequest\x12#\n" +
+	"\reavesdrop_min\x18\x01 \x01(\x01R\feavesdropMin\x12#\n" +
+	"\reavesdrop_max\x18\x02 \x01(\x01R\feavesdropMax\x12'\n" +
+	"\x0feavesdrop_steps\x18\x03 \x01(\x05R\x0eeavesdropSteps\x12\x1b\n" +
+	"\tnoise_min\x18\x04 \x01(\x01R\bnoiseMin\x12\x1b\n" +
+	"\tnoise_max\x18\x05 \x01(\x01R\bnoiseMax\x12\x1f\n" +
+	"\vnoise_steps\x18\x06 \x01(\x05R\n" +
+	"noiseSteps\x12,\n" +
+	"\x12sessions_per_point\x18\a \x01(\x05R\x10sessionsPerPoint\x12(\n" +
+	"\x10bits_per_session\x18\b \x01(\x05R\x0ebitsPerSession\"\xea\x02\n" +
+	"\x0eBenchmarkPoint\x123\n" +
+	"\x15eavesdrop_probability\x18\x01 \x01(\x01R\x14eavesdropProbability\x12\x14\n" +
+	"\x05noise\x18\x02 \x01(\x01R\x05noise\x12\x1b\n" +
+	"\tmean_qber\x18\x03 \x01(\x01R\bmeanQber\x12\x1f\n" +
+	"\vqber_stddev\x18\x04 \x01(\x01R\n" +
+	"qberStddev\x12\x19\n" +
+	"\bmin_qber\x18\x05 \x01(\x01R\aminQber\x12\x19\n" +
+	"\bmax_qber\x18\x06 \x01(\x01R\amaxQber\x12)\n" +
+	"\x10theoretical_qber\x18\a \x01(\x01R\x0ftheoreticalQber\x12*\n" +
+	"\x11mean_sifted_ratio\x18\b \x01(\x01R\x0fmeanSiftedRatio\x12'\n" +
+	"\x0fsecure_fraction\x18\t \x01(\x01R\x0esecureFraction\x12\x19\n" +
+	"\bkey_rate\x18\n" +
+	" \x01(\x01R\akeyRate\"\xdc\x01\n" +
+	"\x0fBenchmarkResult\x12;\n" +
+	"\x06points\x18\x01 \x03(\v2#.qubit_engine.crypto.BenchmarkPointR\x06points\x12%\n" +
+	"\x0etotal_sessions\x18\x02 \x01(\x05R\rtotalSessions\x12(\n" +
+	"\x10bits_per_session\x18\x03 \x01(\x05R\x0ebitsPerSession\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\x05 \x01(\x03R\telapsedMs\"\x9b\x01\n" +
 	"\n" +
 	"KeyRequest\x12&\n" +
 	"\x0fkey_length_bits\x18\x01 \x01(\x05R\rkeyLengthBits\x12\x1c\n" +
-	"\talgorithm\x18\x02 \x01(\tR\talgorithm\"\x86\x01\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x123\n" +
+	"\x15eavesdrop_probability\x18\x03 \x01(\x01R\x14eavesdropProbability\x12\x12\n" +
+	"\x04peer\x18\x04 \x01(\tR\x04peer\"\xcf\x02\n" +
 	"\n" +
 	"QuantumKey\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\fR\x03key\x12\x1c\n" +
 	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12!\n" +
 	"\fgenerated_at\x18\x03 \x01(\x03R\vgeneratedAt\x12%\n" +
-	"\x0eentropy_source\x18\x04 \x01(\tR\rentropySource\"^\n" +
+	"\x0eentropy_source\x18\x04 \x01(\tR\rentropySource\x12\x12\n" +
+	"\x04qber\x18\x05 \x01(\x01R\x04qber\x12!\n" +
+	"\fsifted_ratio\x18\x06 \x01(\x01R\vsiftedRatio\x12\x19\n" +
+	"\braw_bits\x18\a \x01(\x05R\arawBits\x12\x1f\n" +
+	"\vsifted_bits\x18\b \x01(\x05R\n" +
+	"siftedBits\x12%\n" +
+	"\x0edisclosed_bits\x18\t \x01(\x05R\rdisclosedBits\x12\x16\n" +
+	"\x06secure\x18\n" +
+	" \x01(\bR\x06secure\x12\x15\n" +
+	"\x06key_id\x18\v \x01(\tR\x05keyId\"\x9c\x01\n" +
 	"\x0eEncryptRequest\x12\x1c\n" +
 	"\tplaintext\x18\x01 \x01(\fR\tplaintext\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\fR\x03key\x12\x1c\n" +
-	"\talgorithm\x18\x03 \x01(\tR\talgorithm\"f\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12\x15\n" +
+	"\x06key_id\x18\x04 \x01(\tR\x05keyId\x12%\n" +
+	"\x0ereservation_id\x18\x05 \x01(\tR\rreservationId\"\xf2\x01\n" +
 	"\x10EncryptedMessage\x12\x1e\n" +
 	"\n" +
 	"ciphertext\x18\x01 \x01(\fR\n" +
 	"ciphertext\x12\x14\n" +
 	"\x05nonce\x18\x02 \x01(\fR\x05nonce\x12\x1c\n" +
-	"\talgorithm\x18\x03 \x01(\tR\talgorithm\"v\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12\x15\n" +
+	"\x06key_id\x18\x04 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"key_offset\x18\x05 \x01(\x05R\tkeyOffset\x12$\n" +
+	"\x0ekey_bytes_used\x18\x06 \x01(\x05R\fkeyBytesUsed\x12.\n" +
+	"\x13key_bytes_remaining\x18\a \x01(\x05R\x11keyBytesRemaining\"\xac\x01\n" +
 	"\x0eDecryptRequest\x12\x1e\n" +
 	"\n" +
 	"ciphertext\x18\x01 \x01(\fR\n" +
 	"ciphertext\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\fR\x03key\x12\x14\n" +
 	"\x05nonce\x18\x03 \x01(\fR\x05nonce\x12\x1c\n" +
-	"\talgorithm\x18\x04 \x01(\tR\talgorithm\"F\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm\x12\x15\n" +
+	"\x06key_id\x18\x05 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"key_offset\x18\x06 \x01(\x05R\tkeyOffset\"F\n" +
 	"\x10DecryptedMessage\x12\x1c\n" +
 	"\tplaintext\x18\x01 \x01(\fR\tplaintext\x12\x14\n" +
-	"\x05valid\x18\x02 \x01(\bR\x05valid\"\xb6\x01\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\"\x9a\x03\n" +
+	"\aKeyInfo\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04peer\x18\x03 \x01(\tR\x04peer\x123\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x1d.qubit_engine.crypto.KeyStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x05R\tsizeBytes\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x06 \x01(\x05R\tusedBytes\x12%\n" +
+	"\x0ereserved_bytes\x18\a \x01(\x05R\rreservedBytes\x12'\n" +
+	"\x0favailable_bytes\x18\b \x01(\x05R\x0eavailableBytes\x12\"\n" +
+	"\fconsumptions\x18\t \x01(\x05R\fconsumptions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\v \x01(\x03R\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"rotated_to\x18\f \x01(\tR\trotatedTo\"\x88\x01\n" +
+	"\x0fListKeysRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04peer\x18\x03 \x01(\tR\x04peer\x12+\n" +
+	"\x11include_destroyed\x18\x04 \x01(\bR\x10includeDestroyed\"o\n" +
+	"\aKeyList\x120\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1c.qubit_engine.crypto.KeyInfoR\x04keys\x122\n" +
+	"\x15total_available_bytes\x18\x02 \x01(\x03R\x13totalAvailableBytes\"h\n" +
+	"\x11ReserveKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1b\n" +
+	"\tnum_bytes\x18\x02 \x01(\x05R\bnumBytes\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"\x8a\x01\n" +
+	"\x0eKeyReservation\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12\x1b\n" +
+	"\tnum_bytes\x18\x03 \x01(\x05R\bnumBytes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"n\n" +
+	"\x11ConsumeKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12%\n" +
+	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\x12\x1b\n" +
+	"\tnum_bytes\x18\x03 \x01(\x05R\bnumBytes\"\xb6\x01\n" +
+	"\vKeyMaterial\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1a\n" +
+	"\bmaterial\x18\x02 \x01(\fR\bmaterial\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12'\n" +
+	"\x0favailable_bytes\x18\x04 \x01(\x05R\x0eavailableBytes\x123\n" +
+	"\x15reservation_remaining\x18\x05 \x01(\x05R\x14reservationRemaining\")\n" +
+	"\x10RotateKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"*\n" +
+	"\x11DestroyKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"\xb2\x01\n" +
+	"\x10ExportPskRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12%\n" +
+	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\x120\n" +
+	"\x04hash\x18\x03 \x01(\x0e2\x1c.qubit_engine.crypto.PskHashR\x04hash\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x12\n" +
+	"\x04sink\x18\x05 \x01(\tR\x04sink\"\xe5\x02\n" +
+	"\x06TlsPsk\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04peer\x18\x02 \x01(\tR\x04peer\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\fR\x03key\x120\n" +
+	"\x04hash\x18\x05 \x01(\x0e2\x1c.qubit_engine.crypto.PskHashR\x04hash\x12!\n" +
+	"\fcipher_suite\x18\x06 \x01(\tR\vcipherSuite\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\x12!\n" +
+	"\fopenssl_args\x18\b \x01(\tR\vopensslArgs\x12\"\n" +
+	"\rpsk_file_line\x18\t \x01(\tR\vpskFileLine\x12\x12\n" +
+	"\x04sink\x18\n" +
+	" \x01(\tR\x04sink\x12\x19\n" +
+	"\bsink_ref\x18\v \x01(\tR\asinkRef\x12\x1f\n" +
+	"\vexported_at\x18\f \x01(\x03R\n" +
+	"exportedAt\"\x15\n" +
+	"\x13ListKeySinksRequest\"#\n" +
+	"\vKeySinkList\x12\x14\n" +
+	"\x05sinks\x18\x01 \x03(\tR\x05sinks\"\xd6\x02\n" +
 	"\x10EavesdropRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12(\n" +
 	"\x10alice_check_bits\x18\x02 \x03(\x05R\x0ealiceCheckBits\x12$\n" +
 	"\x0ebob_check_bits\x18\x03 \x03(\x05R\fbobCheckBits\x123\n" +
-	"\x15eavesdrop_probability\x18\x04 \x01(\x01R\x14eavesdropProbability\"\x8d\x01\n" +
+	"\x15eavesdrop_probability\x18\x04 \x01(\x01R\x14eavesdropProbability\x12'\n" +
+	"\x0fsample_fraction\x18\x05 \x01(\x01R\x0esampleFraction\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x01R\n" +
+	"confidence\x12'\n" +
+	"\x0fabort_threshold\x18\a \x01(\x01R\x0eabortThreshold\x12,\n" +
+	"\x12channel_error_rate\x18\b \x01(\x01R\x10channelErrorRate\"\xdc\x03\n" +
 	"\x0fEavesdropResult\x12\x1d\n" +
 	"\n" +
 	"error_rate\x18\x01 \x01(\x01R\terrorRate\x123\n" +
 	"\x15eavesdropper_detected\x18\x02 \x01(\bR\x14eavesdropperDetected\x12&\n" +
-	"\x0erecommendation\x18\x03 \x01(\tR\x0erecommendation*2\n" +
+	"\x0erecommendation\x18\x03 \x01(\tR\x0erecommendation\x12\x1f\n" +
+	"\vsample_size\x18\x04 \x01(\x05R\n" +
+	"sampleSize\x12\x16\n" +
+	"\x06errors\x18\x05 \x01(\x05R\x06errors\x12)\n" +
+	"\x10confidence_lower\x18\x06 \x01(\x01R\x0fconfidenceLower\x12)\n" +
+	"\x10confidence_upper\x18\a \x01(\x01R\x0fconfidenceUpper\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\b \x01(\x01R\n" +
+	"confidence\x12\x17\n" +
+	"\ap_value\x18\t \x01(\x01R\x06pValue\x12F\n" +
+	"\x1festimated_eavesdrop_probability\x18\n" +
+	" \x01(\x01R\x1destimatedEavesdropProbability\x12'\n" +
+	"\x0fabort_threshold\x18\v \x01(\x01R\x0eabortThreshold\x12\x14\n" +
+	"\x05abort\x18\f \x01(\bR\x05abort\"U\n" +
+	"\rRandomRequest\x12\x1b\n" +
+	"\tnum_bytes\x18\x01 \x01(\x05R\bnumBytes\x12'\n" +
+	"\x0frequire_healthy\x18\x02 \x01(\bR\x0erequireHealthy\"U\n" +
+	"\vRandomBytes\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bdegraded\x18\x02 \x01(\bR\bdegraded\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\"\x16\n" +
+	"\x14EntropyReportRequest\"\xe4\x04\n" +
+	"\rEntropyReport\x12\x1a\n" +
+	"\bdegraded\x18\x01 \x01(\bR\bdegraded\x12'\n" +
+	"\x0fdegraded_reason\x18\x02 \x01(\tR\x0edegradedReason\x12\x19\n" +
+	"\braw_bits\x18\x03 \x01(\x03R\arawBits\x12#\n" +
+	"\rones_fraction\x18\x04 \x01(\x01R\fonesFraction\x120\n" +
+	"\x14min_entropy_estimate\x18\x05 \x01(\x01R\x12minEntropyEstimate\x120\n" +
+	"\x14assessed_min_entropy\x18\x06 \x01(\x01R\x12assessedMinEntropy\x12+\n" +
+	"\x11repetition_cutoff\x18\a \x01(\x05R\x10repetitionCutoff\x12'\n" +
+	"\x0fadaptive_cutoff\x18\b \x01(\x05R\x0eadaptiveCutoff\x12'\n" +
+	"\x0fadaptive_window\x18\t \x01(\x05R\x0eadaptiveWindow\x12/\n" +
+	"\x13repetition_failures\x18\n" +
+	" \x01(\x03R\x12repetitionFailures\x12+\n" +
+	"\x11adaptive_failures\x18\v \x01(\x03R\x10adaptiveFailures\x12#\n" +
+	"\rengine_errors\x18\f \x01(\x03R\fengineErrors\x12\x1d\n" +
+	"\n" +
+	"pool_bytes\x18\r \x01(\x05R\tpoolBytes\x12!\n" +
+	"\fbytes_served\x18\x0e \x01(\x03R\vbytesServed\x12&\n" +
+	"\x0flast_failure_at\x18\x0f \x01(\x03R\rlastFailureAt*2\n" +
 	"\x05Basis\x12\x15\n" +
 	"\x11BASIS_RECTILINEAR\x10\x00\x12\x12\n" +
-	"\x0eBASIS_DIAGONAL\x10\x012\x93\x05\n" +
+	"\x0eBASIS_DIAGONAL\x10\x01*/\n" +
+	"\bProtocol\x12\x11\n" +
+	"\rPROTOCOL_BB84\x10\x00\x12\x10\n" +
+	"\fPROTOCOL_B92\x10\x01*g\n" +
+	"\fSessionState\x12\x14\n" +
+	"\x10SESSION_PREPARED\x10\x00\x12\x14\n" +
+	"\x10SESSION_MEASURED\x10\x01\x12\x16\n" +
+	"\x12SESSION_RECONCILED\x10\x02\x12\x13\n" +
+	"\x0fSESSION_ABORTED\x10\x03*V\n" +
+	"\tFlipStage\x12\x10\n" +
+	"\fFLIP_CREATED\x10\x00\x12\x12\n" +
+	"\x0eFLIP_COMMITTED\x10\x01\x12\x10\n" +
+	"\fFLIP_GUESSED\x10\x02\x12\x11\n" +
+	"\rFLIP_REVEALED\x10\x03*>\n" +
+	"\bKeyState\x12\x0e\n" +
+	"\n" +
+	"KEY_ACTIVE\x10\x00\x12\x0f\n" +
+	"\vKEY_RETIRED\x10\x01\x12\x11\n" +
+	"\rKEY_DESTROYED\x10\x02*)\n" +
+	"\aPskHash\x12\x0e\n" +
+	"\n" +
+	"PSK_SHA256\x10\x00\x12\x0e\n" +
+	"\n" +
+	"PSK_SHA384\x10\x012\xb8\x10\n" +
 	"\rQuantumCrypto\x12\\\n" +
 	"\x0eStartBB84Alice\x12%.qubit_engine.crypto.BB84AliceRequest\x1a#.qubit_engine.crypto.BB84AliceState\x12V\n" +
 	"\fStartBB84Bob\x12#.qubit_engine.crypto.BB84BobRequest\x1a!.qubit_engine.crypto.BB84BobState\x12T\n" +
@@ -1051,7 +5256,33 @@ const file_crypto_crypto_proto_rawDesc = "" +
 	"\x12GenerateQuantumKey\x12\x1f.qubit_engine.crypto.KeyRequest\x1a\x1f.qubit_engine.crypto.QuantumKey\x12\\\n" +
 	"\x0eQuantumEncrypt\x12#.qubit_engine.crypto.EncryptRequest\x1a%.qubit_engine.crypto.EncryptedMessage\x12\\\n" +
 	"\x0eQuantumDecrypt\x12#.qubit_engine.crypto.DecryptRequest\x1a%.qubit_engine.crypto.DecryptedMessage\x12b\n" +
-	"\x13DetectEavesdropping\x12%.qubit_engine.crypto.EavesdropRequest\x1a$.qubit_engine.crypto.EavesdropResultB@Z>github.com/perclft/QubitEngine/modules/crypto/generated/cryptob\x06proto3"
+	"\x13DetectEavesdropping\x12%.qubit_engine.crypto.EavesdropRequest\x1a$.qubit_engine.crypto.EavesdropResult\x12\\\n" +
+	"\rBenchmarkBB84\x12%.qubit_engine.crypto.BenchmarkRequest\x1a$.qubit_engine.crypto.BenchmarkResult\x12^\n" +
+	"\n" +
+	"StreamBB84\x12&.qubit_engine.crypto.BB84StreamRequest\x1a$.qubit_engine.crypto.BB84StreamBatch(\x010\x01\x12d\n" +
+	"\rConferenceKey\x12).qubit_engine.crypto.ConferenceKeyRequest\x1a(.qubit_engine.crypto.ConferenceKeyResult\x12]\n" +
+	"\x11CreateFlipSession\x12&.qubit_engine.crypto.CreateFlipRequest\x1a .qubit_engine.crypto.FlipSession\x12V\n" +
+	"\n" +
+	"CommitFlip\x12&.qubit_engine.crypto.CommitFlipRequest\x1a .qubit_engine.crypto.FlipSession\x12U\n" +
+	"\n" +
+	"RevealFlip\x12&.qubit_engine.crypto.RevealFlipRequest\x1a\x1f.qubit_engine.crypto.FlipResult\x12N\n" +
+	"\bListKeys\x12$.qubit_engine.crypto.ListKeysRequest\x1a\x1c.qubit_engine.crypto.KeyList\x12Y\n" +
+	"\n" +
+	"ReserveKey\x12&.qubit_engine.crypto.ReserveKeyRequest\x1a#.qubit_engine.crypto.KeyReservation\x12V\n" +
+	"\n" +
+	"ConsumeKey\x12&.qubit_engine.crypto.ConsumeKeyRequest\x1a .qubit_engine.crypto.KeyMaterial\x12P\n" +
+	"\tRotateKey\x12%.qubit_engine.crypto.RotateKeyRequest\x1a\x1c.qubit_engine.crypto.KeyInfo\x12R\n" +
+	"\n" +
+	"DestroyKey\x12&.qubit_engine.crypto.DestroyKeyRequest\x1a\x1c.qubit_engine.crypto.KeyInfo\x12O\n" +
+	"\tExportPSK\x12%.qubit_engine.crypto.ExportPskRequest\x1a\x1b.qubit_engine.crypto.TlsPsk\x12Z\n" +
+	"\fListKeySinks\x12(.qubit_engine.crypto.ListKeySinksRequest\x1a .qubit_engine.crypto.KeySinkList\x12Z\n" +
+	"\fListSessions\x12(.qubit_engine.crypto.ListSessionsRequest\x1a .qubit_engine.crypto.SessionList\x12a\n" +
+	"\x10GetSessionStatus\x12).qubit_engine.crypto.SessionStatusRequest\x1a\".qubit_engine.crypto.SessionStatus\x12\\\n" +
+	"\fAbortSession\x12(.qubit_engine.crypto.AbortSessionRequest\x1a\".qubit_engine.crypto.SessionStatus2\xc2\x01\n" +
+	"\n" +
+	"QuantumRNG\x12Q\n" +
+	"\tGetRandom\x12\".qubit_engine.crypto.RandomRequest\x1a .qubit_engine.crypto.RandomBytes\x12a\n" +
+	"\x10GetEntropyReport\x12).qubit_engine.crypto.EntropyReportRequest\x1a\".qubit_engine.crypto.EntropyReportB@Z>github.com/perclft/QubitEngine/modules/crypto/generated/cryptob\x06proto3"
 
 var (
 	file_crypto_crypto_proto_rawDescOnce sync.Once
@@ -1065,49 +5296,161 @@ func file_crypto_crypto_proto_rawDescGZIP() []byte {
 	return file_crypto_crypto_proto_rawDescData
 }
 
-var file_crypto_crypto_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_crypto_crypto_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_crypto_crypto_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_crypto_crypto_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_crypto_crypto_proto_goTypes = []any{
-	(Basis)(0),               // 0: qubit_engine.crypto.Basis
-	(*BB84AliceRequest)(nil), // 1: qubit_engine.crypto.BB84AliceRequest
-	(*BB84AliceState)(nil),   // 2: qubit_engine.crypto.BB84AliceState
-	(*BB84BobRequest)(nil),   // 3: qubit_engine.crypto.BB84BobRequest
-	(*BB84BobState)(nil),     // 4: qubit_engine.crypto.BB84BobState
-	(*ReconcileRequest)(nil), // 5: qubit_engine.crypto.ReconcileRequest
-	(*BB84Key)(nil),          // 6: qubit_engine.crypto.BB84Key
-	(*KeyRequest)(nil),       // 7: qubit_engine.crypto.KeyRequest
-	(*QuantumKey)(nil),       // 8: qubit_engine.crypto.QuantumKey
-	(*EncryptRequest)(nil),   // 9: qubit_engine.crypto.EncryptRequest
-	(*EncryptedMessage)(nil), // 10: qubit_engine.crypto.EncryptedMessage
-	(*DecryptRequest)(nil),   // 11: qubit_engine.crypto.DecryptRequest
-	(*DecryptedMessage)(nil), // 12: qubit_engine.crypto.DecryptedMessage
-	(*EavesdropRequest)(nil), // 13: qubit_engine.crypto.EavesdropRequest
-	(*EavesdropResult)(nil),  // 14: qubit_engine.crypto.EavesdropResult
+	(Basis)(0),                   // 0: qubit_engine.crypto.Basis
+	(Protocol)(0),                // 1: qubit_engine.crypto.Protocol
+	(SessionState)(0),            // 2: qubit_engine.crypto.SessionState
+	(FlipStage)(0),               // 3: qubit_engine.crypto.FlipStage
+	(KeyState)(0),                // 4: qubit_engine.crypto.KeyState
+	(PskHash)(0),                 // 5: qubit_engine.crypto.PskHash
+	(*BB84AliceRequest)(nil),     // 6: qubit_engine.crypto.BB84AliceRequest
+	(*SecurityPolicy)(nil),       // 7: qubit_engine.crypto.SecurityPolicy
+	(*BB84AliceState)(nil),       // 8: qubit_engine.crypto.BB84AliceState
+	(*BB84BobRequest)(nil),       // 9: qubit_engine.crypto.BB84BobRequest
+	(*BB84BobState)(nil),         // 10: qubit_engine.crypto.BB84BobState
+	(*BB84StreamRequest)(nil),    // 11: qubit_engine.crypto.BB84StreamRequest
+	(*BB84StreamStart)(nil),      // 12: qubit_engine.crypto.BB84StreamStart
+	(*BB84StreamAck)(nil),        // 13: qubit_engine.crypto.BB84StreamAck
+	(*BB84StreamBatch)(nil),      // 14: qubit_engine.crypto.BB84StreamBatch
+	(*ReconcileRequest)(nil),     // 15: qubit_engine.crypto.ReconcileRequest
+	(*BB84Key)(nil),              // 16: qubit_engine.crypto.BB84Key
+	(*DecoyLevel)(nil),           // 17: qubit_engine.crypto.DecoyLevel
+	(*DecoyConfig)(nil),          // 18: qubit_engine.crypto.DecoyConfig
+	(*DecoyClassStats)(nil),      // 19: qubit_engine.crypto.DecoyClassStats
+	(*DecoyAnalysis)(nil),        // 20: qubit_engine.crypto.DecoyAnalysis
+	(*SessionStatus)(nil),        // 21: qubit_engine.crypto.SessionStatus
+	(*ListSessionsRequest)(nil),  // 22: qubit_engine.crypto.ListSessionsRequest
+	(*SessionList)(nil),          // 23: qubit_engine.crypto.SessionList
+	(*SessionStatusRequest)(nil), // 24: qubit_engine.crypto.SessionStatusRequest
+	(*AbortSessionRequest)(nil),  // 25: qubit_engine.crypto.AbortSessionRequest
+	(*ConferenceKeyRequest)(nil), // 26: qubit_engine.crypto.ConferenceKeyRequest
+	(*ConferenceKeyResult)(nil),  // 27: qubit_engine.crypto.ConferenceKeyResult
+	(*CreateFlipRequest)(nil),    // 28: qubit_engine.crypto.CreateFlipRequest
+	(*FlipSession)(nil),          // 29: qubit_engine.crypto.FlipSession
+	(*CommitFlipRequest)(nil),    // 30: qubit_engine.crypto.CommitFlipRequest
+	(*RevealFlipRequest)(nil),    // 31: qubit_engine.crypto.RevealFlipRequest
+	(*FlipResult)(nil),           // 32: qubit_engine.crypto.FlipResult
+	(*BenchmarkRequest)(nil),     // 33: qubit_engine.crypto.BenchmarkRequest
+	(*BenchmarkPoint)(nil),       // 34: qubit_engine.crypto.BenchmarkPoint
+	(*BenchmarkResult)(nil),      // 35: qubit_engine.crypto.BenchmarkResult
+	(*KeyRequest)(nil),           // 36: qubit_engine.crypto.KeyRequest
+	(*QuantumKey)(nil),           // 37: qubit_engine.crypto.QuantumKey
+	(*EncryptRequest)(nil),       // 38: qubit_engine.crypto.EncryptRequest
+	(*EncryptedMessage)(nil),     // 39: qubit_engine.crypto.EncryptedMessage
+	(*DecryptRequest)(nil),       // 40: qubit_engine.crypto.DecryptRequest
+	(*DecryptedMessage)(nil),     // 41: qubit_engine.crypto.DecryptedMessage
+	(*KeyInfo)(nil),              // 42: qubit_engine.crypto.KeyInfo
+	(*ListKeysRequest)(nil),      // 43: qubit_engine.crypto.ListKeysRequest
+	(*KeyList)(nil),              // 44: qubit_engine.crypto.KeyList
+	(*ReserveKeyRequest)(nil),    // 45: qubit_engine.crypto.ReserveKeyRequest
+	(*KeyReservation)(nil),       // 46: qubit_engine.crypto.KeyReservation
+	(*ConsumeKeyRequest)(nil),    // 47: qubit_engine.crypto.ConsumeKeyRequest
+	(*KeyMaterial)(nil),          // 48: qubit_engine.crypto.KeyMaterial
+	(*RotateKeyRequest)(nil),     // 49: qubit_engine.crypto.RotateKeyRequest
+	(*DestroyKeyRequest)(nil),    // 50: qubit_engine.crypto.DestroyKeyRequest
+	(*ExportPskRequest)(nil),     // 51: qubit_engine.crypto.ExportPskRequest
+	(*TlsPsk)(nil),               // 52: qubit_engine.crypto.TlsPsk
+	(*ListKeySinksRequest)(nil),  // 53: qubit_engine.crypto.ListKeySinksRequest
+	(*KeySinkList)(nil),          // 54: qubit_engine.crypto.KeySinkList
+	(*EavesdropRequest)(nil),     // 55: qubit_engine.crypto.EavesdropRequest
+	(*EavesdropResult)(nil),      // 56: qubit_engine.crypto.EavesdropResult
+	(*RandomRequest)(nil),        // 57: qubit_engine.crypto.RandomRequest
+	(*RandomBytes)(nil),          // 58: qubit_engine.crypto.RandomBytes
+	(*EntropyReportRequest)(nil), // 59: qubit_engine.crypto.EntropyReportRequest
+	(*EntropyReport)(nil),        // 60: qubit_engine.crypto.EntropyReport
 }
 var file_crypto_crypto_proto_depIdxs = []int32{
-	0,  // 0: qubit_engine.crypto.BB84AliceState.bases:type_name -> qubit_engine.crypto.Basis
-	0,  // 1: qubit_engine.crypto.BB84BobState.bases:type_name -> qubit_engine.crypto.Basis
-	0,  // 2: qubit_engine.crypto.ReconcileRequest.alice_bases:type_name -> qubit_engine.crypto.Basis
-	0,  // 3: qubit_engine.crypto.ReconcileRequest.bob_bases:type_name -> qubit_engine.crypto.Basis
-	1,  // 4: qubit_engine.crypto.QuantumCrypto.StartBB84Alice:input_type -> qubit_engine.crypto.BB84AliceRequest
-	3,  // 5: qubit_engine.crypto.QuantumCrypto.StartBB84Bob:input_type -> qubit_engine.crypto.BB84BobRequest
-	5,  // 6: qubit_engine.crypto.QuantumCrypto.ReconcileBB84:input_type -> qubit_engine.crypto.ReconcileRequest
-	7,  // 7: qubit_engine.crypto.QuantumCrypto.GenerateQuantumKey:input_type -> qubit_engine.crypto.KeyRequest
-	9,  // 8: qubit_engine.crypto.QuantumCrypto.QuantumEncrypt:input_type -> qubit_engine.crypto.EncryptRequest
-	11, // 9: qubit_engine.crypto.QuantumCrypto.QuantumDecrypt:input_type -> qubit_engine.crypto.DecryptRequest
-	13, // 10: qubit_engine.crypto.QuantumCrypto.DetectEavesdropping:input_type -> qubit_engine.crypto.EavesdropRequest
-	2,  // 11: qubit_engine.crypto.QuantumCrypto.StartBB84Alice:output_type -> qubit_engine.crypto.BB84AliceState
-	4,  // 12: qubit_engine.crypto.QuantumCrypto.StartBB84Bob:output_type -> qubit_engine.crypto.BB84BobState
-	6,  // 13: qubit_engine.crypto.QuantumCrypto.ReconcileBB84:output_type -> qubit_engine.crypto.BB84Key
-	8,  // 14: qubit_engine.crypto.QuantumCrypto.GenerateQuantumKey:output_type -> qubit_engine.crypto.QuantumKey
-	10, // 15: qubit_engine.crypto.QuantumCrypto.QuantumEncrypt:output_type -> qubit_engine.crypto.EncryptedMessage
-	12, // 16: qubit_engine.crypto.QuantumCrypto.QuantumDecrypt:output_type -> qubit_engine.crypto.DecryptedMessage
-	14, // 17: qubit_engine.crypto.QuantumCrypto.DetectEavesdropping:output_type -> qubit_engine.crypto.EavesdropResult
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 0: qubit_engine.crypto.BB84AliceRequest.protocol:type_name -> qubit_engine.crypto.Protocol
+	18, // 1: qubit_engine.crypto.BB84AliceRequest.decoy:type_name -> qubit_engine.crypto.DecoyConfig
+	7,  // 2: qubit_engine.crypto.BB84AliceRequest.policy:type_name -> qubit_engine.crypto.SecurityPolicy
+	0,  // 3: qubit_engine.crypto.BB84AliceState.bases:type_name -> qubit_engine.crypto.Basis
+	1,  // 4: qubit_engine.crypto.BB84AliceState.protocol:type_name -> qubit_engine.crypto.Protocol
+	7,  // 5: qubit_engine.crypto.BB84AliceState.policy:type_name -> qubit_engine.crypto.SecurityPolicy
+	0,  // 6: qubit_engine.crypto.BB84BobState.bases:type_name -> qubit_engine.crypto.Basis
+	12, // 7: qubit_engine.crypto.BB84StreamRequest.start:type_name -> qubit_engine.crypto.BB84StreamStart
+	13, // 8: qubit_engine.crypto.BB84StreamRequest.ack:type_name -> qubit_engine.crypto.BB84StreamAck
+	1,  // 9: qubit_engine.crypto.BB84StreamStart.protocol:type_name -> qubit_engine.crypto.Protocol
+	7,  // 10: qubit_engine.crypto.BB84StreamStart.policy:type_name -> qubit_engine.crypto.SecurityPolicy
+	0,  // 11: qubit_engine.crypto.BB84StreamBatch.alice_bases:type_name -> qubit_engine.crypto.Basis
+	0,  // 12: qubit_engine.crypto.BB84StreamBatch.bob_bases:type_name -> qubit_engine.crypto.Basis
+	0,  // 13: qubit_engine.crypto.ReconcileRequest.alice_bases:type_name -> qubit_engine.crypto.Basis
+	0,  // 14: qubit_engine.crypto.ReconcileRequest.bob_bases:type_name -> qubit_engine.crypto.Basis
+	1,  // 15: qubit_engine.crypto.BB84Key.protocol:type_name -> qubit_engine.crypto.Protocol
+	19, // 16: qubit_engine.crypto.BB84Key.decoy_classes:type_name -> qubit_engine.crypto.DecoyClassStats
+	20, // 17: qubit_engine.crypto.BB84Key.decoy_analysis:type_name -> qubit_engine.crypto.DecoyAnalysis
+	7,  // 18: qubit_engine.crypto.BB84Key.policy:type_name -> qubit_engine.crypto.SecurityPolicy
+	17, // 19: qubit_engine.crypto.DecoyConfig.levels:type_name -> qubit_engine.crypto.DecoyLevel
+	2,  // 20: qubit_engine.crypto.SessionStatus.state:type_name -> qubit_engine.crypto.SessionState
+	1,  // 21: qubit_engine.crypto.SessionStatus.protocol:type_name -> qubit_engine.crypto.Protocol
+	21, // 22: qubit_engine.crypto.SessionList.sessions:type_name -> qubit_engine.crypto.SessionStatus
+	3,  // 23: qubit_engine.crypto.FlipSession.stage:type_name -> qubit_engine.crypto.FlipStage
+	0,  // 24: qubit_engine.crypto.FlipSession.bob_bases:type_name -> qubit_engine.crypto.Basis
+	0,  // 25: qubit_engine.crypto.FlipSession.guess:type_name -> qubit_engine.crypto.Basis
+	0,  // 26: qubit_engine.crypto.CommitFlipRequest.guess:type_name -> qubit_engine.crypto.Basis
+	0,  // 27: qubit_engine.crypto.FlipResult.alice_basis:type_name -> qubit_engine.crypto.Basis
+	0,  // 28: qubit_engine.crypto.FlipResult.guess:type_name -> qubit_engine.crypto.Basis
+	34, // 29: qubit_engine.crypto.BenchmarkResult.points:type_name -> qubit_engine.crypto.BenchmarkPoint
+	4,  // 30: qubit_engine.crypto.KeyInfo.state:type_name -> qubit_engine.crypto.KeyState
+	42, // 31: qubit_engine.crypto.KeyList.keys:type_name -> qubit_engine.crypto.KeyInfo
+	5,  // 32: qubit_engine.crypto.ExportPskRequest.hash:type_name -> qubit_engine.crypto.PskHash
+	5,  // 33: qubit_engine.crypto.TlsPsk.hash:type_name -> qubit_engine.crypto.PskHash
+	6,  // 34: qubit_engine.crypto.QuantumCrypto.StartBB84Alice:input_type -> qubit_engine.crypto.BB84AliceRequest
+	9,  // 35: qubit_engine.crypto.QuantumCrypto.StartBB84Bob:input_type -> qubit_engine.crypto.BB84BobRequest
+	15, // 36: qubit_engine.crypto.QuantumCrypto.ReconcileBB84:input_type -> qubit_engine.crypto.ReconcileRequest
+	36, // 37: qubit_engine.crypto.QuantumCrypto.GenerateQuantumKey:input_type -> qubit_engine.crypto.KeyRequest
+	38, // 38: qubit_engine.crypto.QuantumCrypto.QuantumEncrypt:input_type -> qubit_engine.crypto.EncryptRequest
+	40, // 39: qubit_engine.crypto.QuantumCrypto.QuantumDecrypt:input_type -> qubit_engine.crypto.DecryptRequest
+	55, // 40: qubit_engine.crypto.QuantumCrypto.DetectEavesdropping:input_type -> qubit_engine.crypto.EavesdropRequest
+	33, // 41: qubit_engine.crypto.QuantumCrypto.BenchmarkBB84:input_type -> qubit_engine.crypto.BenchmarkRequest
+	11, // 42: qubit_engine.crypto.QuantumCrypto.StreamBB84:input_type -> qubit_engine.crypto.BB84StreamRequest
+	26, // 43: qubit_engine.crypto.QuantumCrypto.ConferenceKey:input_type -> qubit_engine.crypto.ConferenceKeyRequest
+	28, // 44: qubit_engine.crypto.QuantumCrypto.CreateFlipSession:input_type -> qubit_engine.crypto.CreateFlipRequest
+	30, // 45: qubit_engine.crypto.QuantumCrypto.CommitFlip:input_type -> qubit_engine.crypto.CommitFlipRequest
+	31, // 46: qubit_engine.crypto.QuantumCrypto.RevealFlip:input_type -> qubit_engine.crypto.RevealFlipRequest
+	43, // 47: qubit_engine.crypto.QuantumCrypto.ListKeys:input_type -> qubit_engine.crypto.ListKeysRequest
+	45, // 48: qubit_engine.crypto.QuantumCrypto.ReserveKey:input_type -> qubit_engine.crypto.ReserveKeyRequest
+	47, // 49: qubit_engine.crypto.QuantumCrypto.ConsumeKey:input_type -> qubit_engine.crypto.ConsumeKeyRequest
+	49, // 50: qubit_engine.crypto.QuantumCrypto.RotateKey:input_type -> qubit_engine.crypto.RotateKeyRequest
+	50, // 51: qubit_engine.crypto.QuantumCrypto.DestroyKey:input_type -> qubit_engine.crypto.DestroyKeyRequest
+	51, // 52: qubit_engine.crypto.QuantumCrypto.ExportPSK:input_type -> qubit_engine.crypto.ExportPskRequest
+	53, // 53: qubit_engine.crypto.QuantumCrypto.ListKeySinks:input_type -> qubit_engine.crypto.ListKeySinksRequest
+	22, // 54: qubit_engine.crypto.QuantumCrypto.ListSessions:input_type -> qubit_engine.crypto.ListSessionsRequest
+	24, // 55: qubit_engine.crypto.QuantumCrypto.GetSessionStatus:input_type -> qubit_engine.crypto.SessionStatusRequest
+	25, // 56: qubit_engine.crypto.QuantumCrypto.AbortSession:input_type -> qubit_engine.crypto.AbortSessionRequest
+	57, // 57: qubit_engine.crypto.QuantumRNG.GetRandom:input_type -> qubit_engine.crypto.RandomRequest
+	59, // 58: qubit_engine.crypto.QuantumRNG.GetEntropyReport:input_type -> qubit_engine.crypto.EntropyReportRequest
+	8,  // 59: qubit_engine.crypto.QuantumCrypto.StartBB84Alice:output_type -> qubit_engine.crypto.BB84AliceState
+	10, // 60: qubit_engine.crypto.QuantumCrypto.StartBB84Bob:output_type -> qubit_engine.crypto.BB84BobState
+	16, // 61: qubit_engine.crypto.QuantumCrypto.ReconcileBB84:output_type -> qubit_engine.crypto.BB84Key
+	37, // 62: qubit_engine.crypto.QuantumCrypto.GenerateQuantumKey:output_type -> qubit_engine.crypto.QuantumKey
+	39, // 63: qubit_engine.crypto.QuantumCrypto.QuantumEncrypt:output_type -> qubit_engine.crypto.EncryptedMessage
+	41, // 64: qubit_engine.crypto.QuantumCrypto.QuantumDecrypt:output_type -> qubit_engine.crypto.DecryptedMessage
+	56, // 65: qubit_engine.crypto.QuantumCrypto.DetectEavesdropping:output_type -> qubit_engine.crypto.EavesdropResult
+	35, // 66: qubit_engine.crypto.QuantumCrypto.BenchmarkBB84:output_type -> qubit_engine.crypto.BenchmarkResult
+	14, // 67: qubit_engine.crypto.QuantumCrypto.StreamBB84:output_type -> qubit_engine.crypto.BB84StreamBatch
+	27, // 68: qubit_engine.crypto.QuantumCrypto.ConferenceKey:output_type -> qubit_engine.crypto.ConferenceKeyResult
+	29, // 69: qubit_engine.crypto.QuantumCrypto.CreateFlipSession:output_type -> qubit_engine.crypto.FlipSession
+	29, // 70: qubit_engine.crypto.QuantumCrypto.CommitFlip:output_type -> qubit_engine.crypto.FlipSession
+	32, // 71: qubit_engine.crypto.QuantumCrypto.RevealFlip:output_type -> qubit_engine.crypto.FlipResult
+	44, // 72: qubit_engine.crypto.QuantumCrypto.ListKeys:output_type -> qubit_engine.crypto.KeyList
+	46, // 73: qubit_engine.crypto.QuantumCrypto.ReserveKey:output_type -> qubit_engine.crypto.KeyReservation
+	48, // 74: qubit_engine.crypto.QuantumCrypto.ConsumeKey:output_type -> qubit_engine.crypto.KeyMaterial
+	42, // 75: qubit_engine.crypto.QuantumCrypto.RotateKey:output_type -> qubit_engine.crypto.KeyInfo
+	42, // 76: qubit_engine.crypto.QuantumCrypto.DestroyKey:output_type -> qubit_engine.crypto.KeyInfo
+	52, // 77: qubit_engine.crypto.QuantumCrypto.ExportPSK:output_type -> qubit_engine.crypto.TlsPsk
+	54, // 78: qubit_engine.crypto.QuantumCrypto.ListKeySinks:output_type -> qubit_engine.crypto.KeySinkList
+	23, // 79: qubit_engine.crypto.QuantumCrypto.ListSessions:output_type -> qubit_engine.crypto.SessionList
+	21, // 80: qubit_engine.crypto.QuantumCrypto.GetSessionStatus:output_type -> qubit_engine.crypto.SessionStatus
+	21, // 81: qubit_engine.crypto.QuantumCrypto.AbortSession:output_type -> qubit_engine.crypto.SessionStatus
+	58, // 82: qubit_engine.crypto.QuantumRNG.GetRandom:output_type -> qubit_engine.crypto.RandomBytes
+	60, // 83: qubit_engine.crypto.QuantumRNG.GetEntropyReport:output_type -> qubit_engine.crypto.EntropyReport
+	59, // [59:84] is the sub-list for method output_type
+	34, // [34:59] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_crypto_crypto_proto_init() }
@@ -1115,15 +5458,19 @@ func file_crypto_crypto_proto_init() {
 	if File_crypto_crypto_proto != nil {
 		return
 	}
+	file_crypto_crypto_proto_msgTypes[5].OneofWrappers = []any{
+		(*BB84StreamRequest_Start)(nil),
+		(*BB84StreamRequest_Ack)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_crypto_crypto_proto_rawDesc), len(file_crypto_crypto_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   14,
+			NumEnums:      6,
+			NumMessages:   55,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_crypto_crypto_proto_goTypes,
 		DependencyIndexes: file_crypto_crypto_proto_depIdxs,
@@ -26,6 +26,22 @@ const (
 	QuantumCrypto_QuantumEncrypt_FullMethodName      = "/qubit_engine.crypto.QuantumCrypto/QuantumEncrypt"
 	QuantumCrypto_QuantumDecrypt_FullMethodName      = "/qubit_engine.crypto.QuantumCrypto/QuantumDecrypt"
 	QuantumCrypto_DetectEavesdropping_FullMethodName = "/qubit_engine.crypto.QuantumCrypto/DetectEavesdropping"
+	QuantumCrypto_BenchmarkBB84_FullMethodName       = "/qubit_engine.crypto.QuantumCrypto/BenchmarkBB84"
+	QuantumCrypto_StreamBB84_FullMethodName          = "/qubit_engine.crypto.QuantumCrypto/StreamBB84"
+	QuantumCrypto_ConferenceKey_FullMethodName       = "/qubit_engine.crypto.QuantumCrypto/ConferenceKey"
+	QuantumCrypto_CreateFlipSession_FullMethodName   = "/qubit_engine.crypto.QuantumCrypto/CreateFlipSession"
+	QuantumCrypto_CommitFlip_FullMethodName          = "/qubit_engine.crypto.QuantumCrypto/CommitFlip"
+	QuantumCrypto_RevealFlip_FullMethodName          = "/qubit_engine.crypto.QuantumCrypto/RevealFlip"
+	QuantumCrypto_ListKeys_FullMethodName            = "/qubit_engine.crypto.QuantumCrypto/ListKeys"
+	QuantumCrypto_ReserveKey_FullMethodName          = "/qubit_engine.crypto.QuantumCrypto/ReserveKey"
+	QuantumCrypto_ConsumeKey_FullMethodName          = "/qubit_engine.crypto.QuantumCrypto/ConsumeKey"
+	QuantumCrypto_RotateKey_FullMethodName           = "/qubit_engine.crypto.QuantumCrypto/RotateKey"
+	QuantumCrypto_DestroyKey_FullMethodName          = "/qubit_engine.crypto.QuantumCrypto/DestroyKey"
+	QuantumCrypto_ExportPSK_FullMethodName           = "/qubit_engine.crypto.QuantumCrypto/ExportPSK"
+	QuantumCrypto_ListKeySinks_FullMethodName        = "/qubit_engine.crypto.QuantumCrypto/ListKeySinks"
+	QuantumCrypto_ListSessions_FullMethodName        = "/qubit_engine.crypto.QuantumCrypto/ListSessions"
+	QuantumCrypto_GetSessionStatus_FullMethodName    = "/qubit_engine.crypto.QuantumCrypto/GetSessionStatus"
+	QuantumCrypto_AbortSession_FullMethodName        = "/qubit_engine.crypto.QuantumCrypto/AbortSession"
 )
 
 // QuantumCryptoClient is the client API for QuantumCrypto service.
@@ -46,6 +62,29 @@ type QuantumCryptoClient interface {
 	QuantumDecrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*DecryptedMessage, error)
 	// Detect eavesdropping in BB84
 	DetectEavesdropping(ctx context.Context, in *EavesdropRequest, opts ...grpc.CallOption) (*EavesdropResult, error)
+	// Sweep eavesdropping (and channel noise) and return QBER/key-rate curves
+	BenchmarkBB84(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResult, error)
+	// Run a large BB84/B92 exchange as a stream of batches with flow control
+	StreamBB84(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BB84StreamRequest, BB84StreamBatch], error)
+	// Agree one key among three or more parties from GHZ states
+	ConferenceKey(ctx context.Context, in *ConferenceKeyRequest, opts ...grpc.CallOption) (*ConferenceKeyResult, error)
+	// Two-party coin flip: Alice commits in qubits, Bob guesses, Alice reveals
+	CreateFlipSession(ctx context.Context, in *CreateFlipRequest, opts ...grpc.CallOption) (*FlipSession, error)
+	CommitFlip(ctx context.Context, in *CommitFlipRequest, opts ...grpc.CallOption) (*FlipSession, error)
+	RevealFlip(ctx context.Context, in *RevealFlipRequest, opts ...grpc.CallOption) (*FlipResult, error)
+	// Key store: inspect, reserve, consume, rotate and destroy pooled keys
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*KeyList, error)
+	ReserveKey(ctx context.Context, in *ReserveKeyRequest, opts ...grpc.CallOption) (*KeyReservation, error)
+	ConsumeKey(ctx context.Context, in *ConsumeKeyRequest, opts ...grpc.CallOption) (*KeyMaterial, error)
+	RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*KeyInfo, error)
+	DestroyKey(ctx context.Context, in *DestroyKeyRequest, opts ...grpc.CallOption) (*KeyInfo, error)
+	// Key export: TLS 1.3 external PSKs, optionally pushed to Vault or a KMS
+	ExportPSK(ctx context.Context, in *ExportPskRequest, opts ...grpc.CallOption) (*TlsPsk, error)
+	ListKeySinks(ctx context.Context, in *ListKeySinksRequest, opts ...grpc.CallOption) (*KeySinkList, error)
+	// Session administration: inspect and abort in-flight exchanges
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*SessionList, error)
+	GetSessionStatus(ctx context.Context, in *SessionStatusRequest, opts ...grpc.CallOption) (*SessionStatus, error)
+	AbortSession(ctx context.Context, in *AbortSessionRequest, opts ...grpc.CallOption) (*SessionStatus, error)
 }
 
 type quantumCryptoClient struct {
@@ -126,6 +165,169 @@ func (c *quantumCryptoClient) DetectEavesdropping(ctx context.Context, in *Eaves
 	return out, nil
 }
 
+func (c *quantumCryptoClient) BenchmarkBB84(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BenchmarkResult)
+	err := c.cc.Invoke(ctx, QuantumCrypto_BenchmarkBB84_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) StreamBB84(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BB84StreamRequest, BB84StreamBatch], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumCrypto_ServiceDesc.Streams[0], QuantumCrypto_StreamBB84_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BB84StreamRequest, BB84StreamBatch]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCrypto_StreamBB84Client = grpc.BidiStreamingClient[BB84StreamRequest, BB84StreamBatch]
+
+func (c *quantumCryptoClient) ConferenceKey(ctx context.Context, in *ConferenceKeyRequest, opts ...grpc.CallOption) (*ConferenceKeyResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConferenceKeyResult)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ConferenceKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) CreateFlipSession(ctx context.Context, in *CreateFlipRequest, opts ...grpc.CallOption) (*FlipSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlipSession)
+	err := c.cc.Invoke(ctx, QuantumCrypto_CreateFlipSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) CommitFlip(ctx context.Context, in *CommitFlipRequest, opts ...grpc.CallOption) (*FlipSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlipSession)
+	err := c.cc.Invoke(ctx, QuantumCrypto_CommitFlip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) RevealFlip(ctx context.Context, in *RevealFlipRequest, opts ...grpc.CallOption) (*FlipResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlipResult)
+	err := c.cc.Invoke(ctx, QuantumCrypto_RevealFlip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*KeyList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyList)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ListKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) ReserveKey(ctx context.Context, in *ReserveKeyRequest, opts ...grpc.CallOption) (*KeyReservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyReservation)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ReserveKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) ConsumeKey(ctx context.Context, in *ConsumeKeyRequest, opts ...grpc.CallOption) (*KeyMaterial, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyMaterial)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ConsumeKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*KeyInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyInfo)
+	err := c.cc.Invoke(ctx, QuantumCrypto_RotateKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) DestroyKey(ctx context.Context, in *DestroyKeyRequest, opts ...grpc.CallOption) (*KeyInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyInfo)
+	err := c.cc.Invoke(ctx, QuantumCrypto_DestroyKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) ExportPSK(ctx context.Context, in *ExportPskRequest, opts ...grpc.CallOption) (*TlsPsk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TlsPsk)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ExportPSK_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) ListKeySinks(ctx context.Context, in *ListKeySinksRequest, opts ...grpc.CallOption) (*KeySinkList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeySinkList)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ListKeySinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*SessionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionList)
+	err := c.cc.Invoke(ctx, QuantumCrypto_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) GetSessionStatus(ctx context.Context, in *SessionStatusRequest, opts ...grpc.CallOption) (*SessionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatus)
+	err := c.cc.Invoke(ctx, QuantumCrypto_GetSessionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumCryptoClient) AbortSession(ctx context.Context, in *AbortSessionRequest, opts ...grpc.CallOption) (*SessionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionStatus)
+	err := c.cc.Invoke(ctx, QuantumCrypto_AbortSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuantumCryptoServer is the server API for QuantumCrypto service.
 // All implementations must embed UnimplementedQuantumCryptoServer
 // for forward compatibility.
@@ -144,6 +346,29 @@ type QuantumCryptoServer interface {
 	QuantumDecrypt(context.Context, *DecryptRequest) (*DecryptedMessage, error)
 	// Detect eavesdropping in BB84
 	DetectEavesdropping(context.Context, *EavesdropRequest) (*EavesdropResult, error)
+	// Sweep eavesdropping (and channel noise) and return QBER/key-rate curves
+	BenchmarkBB84(context.Context, *BenchmarkRequest) (*BenchmarkResult, error)
+	// Run a large BB84/B92 exchange as a stream of batches with flow control
+	StreamBB84(grpc.BidiStreamingServer[BB84StreamRequest, BB84StreamBatch]) error
+	// Agree one key among three or more parties from GHZ states
+	ConferenceKey(context.Context, *ConferenceKeyRequest) (*ConferenceKeyResult, error)
+	// Two-party coin flip: Alice commits in qubits, Bob guesses, Alice reveals
+	CreateFlipSession(context.Context, *CreateFlipRequest) (*FlipSession, error)
+	CommitFlip(context.Context, *CommitFlipRequest) (*FlipSession, error)
+	RevealFlip(context.Context, *RevealFlipRequest) (*FlipResult, error)
+	// Key store: inspect, reserve, consume, rotate and destroy pooled keys
+	ListKeys(context.Context, *ListKeysRequest) (*KeyList, error)
+	ReserveKey(context.Context, *ReserveKeyRequest) (*KeyReservation, error)
+	ConsumeKey(context.Context, *ConsumeKeyRequest) (*KeyMaterial, error)
+	RotateKey(context.Context, *RotateKeyRequest) (*KeyInfo, error)
+	DestroyKey(context.Context, *DestroyKeyRequest) (*KeyInfo, error)
+	// Key export: TLS 1.3 external PSKs, optionally pushed to Vault or a KMS
+	ExportPSK(context.Context, *ExportPskRequest) (*TlsPsk, error)
+	ListKeySinks(context.Context, *ListKeySinksRequest) (*KeySinkList, error)
+	// Session administration: inspect and abort in-flight exchanges
+	ListSessions(context.Context, *ListSessionsRequest) (*SessionList, error)
+	GetSessionStatus(context.Context, *SessionStatusRequest) (*SessionStatus, error)
+	AbortSession(context.Context, *AbortSessionRequest) (*SessionStatus, error)
 	mustEmbedUnimplementedQuantumCryptoServer()
 }
 
@@ -175,6 +400,54 @@ func (UnimplementedQuantumCryptoServer) QuantumDecrypt(context.Context, *Decrypt
 func (UnimplementedQuantumCryptoServer) DetectEavesdropping(context.Context, *EavesdropRequest) (*EavesdropResult, error) {
 	return nil, status.Error(codes.Unimplemented, "method DetectEavesdropping not implemented")
 }
+func (UnimplementedQuantumCryptoServer) BenchmarkBB84(context.Context, *BenchmarkRequest) (*BenchmarkResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method BenchmarkBB84 not implemented")
+}
+func (UnimplementedQuantumCryptoServer) StreamBB84(grpc.BidiStreamingServer[BB84StreamRequest, BB84StreamBatch]) error {
+	return status.Error(codes.Unimplemented, "method StreamBB84 not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ConferenceKey(context.Context, *ConferenceKeyRequest) (*ConferenceKeyResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConferenceKey not implemented")
+}
+func (UnimplementedQuantumCryptoServer) CreateFlipSession(context.Context, *CreateFlipRequest) (*FlipSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFlipSession not implemented")
+}
+func (UnimplementedQuantumCryptoServer) CommitFlip(context.Context, *CommitFlipRequest) (*FlipSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitFlip not implemented")
+}
+func (UnimplementedQuantumCryptoServer) RevealFlip(context.Context, *RevealFlipRequest) (*FlipResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevealFlip not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ListKeys(context.Context, *ListKeysRequest) (*KeyList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ReserveKey(context.Context, *ReserveKeyRequest) (*KeyReservation, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveKey not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ConsumeKey(context.Context, *ConsumeKeyRequest) (*KeyMaterial, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeKey not implemented")
+}
+func (UnimplementedQuantumCryptoServer) RotateKey(context.Context, *RotateKeyRequest) (*KeyInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateKey not implemented")
+}
+func (UnimplementedQuantumCryptoServer) DestroyKey(context.Context, *DestroyKeyRequest) (*KeyInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method DestroyKey not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ExportPSK(context.Context, *ExportPskRequest) (*TlsPsk, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportPSK not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ListKeySinks(context.Context, *ListKeySinksRequest) (*KeySinkList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKeySinks not implemented")
+}
+func (UnimplementedQuantumCryptoServer) ListSessions(context.Context, *ListSessionsRequest) (*SessionList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedQuantumCryptoServer) GetSessionStatus(context.Context, *SessionStatusRequest) (*SessionStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionStatus not implemented")
+}
+func (UnimplementedQuantumCryptoServer) AbortSession(context.Context, *AbortSessionRequest) (*SessionStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortSession not implemented")
+}
 func (UnimplementedQuantumCryptoServer) mustEmbedUnimplementedQuantumCryptoServer() {}
 func (UnimplementedQuantumCryptoServer) testEmbeddedByValue()                       {}
 
@@ -322,6 +595,283 @@ func _QuantumCrypto_DetectEavesdropping_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuantumCrypto_BenchmarkBB84_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).BenchmarkBB84(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_BenchmarkBB84_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).BenchmarkBB84(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_StreamBB84_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QuantumCryptoServer).StreamBB84(&grpc.GenericServerStream[BB84StreamRequest, BB84StreamBatch]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCrypto_StreamBB84Server = grpc.BidiStreamingServer[BB84StreamRequest, BB84StreamBatch]
+
+func _QuantumCrypto_ConferenceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConferenceKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ConferenceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ConferenceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ConferenceKey(ctx, req.(*ConferenceKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_CreateFlipSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).CreateFlipSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_CreateFlipSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).CreateFlipSession(ctx, req.(*CreateFlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_CommitFlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitFlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).CommitFlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_CommitFlip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).CommitFlip(ctx, req.(*CommitFlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_RevealFlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealFlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).RevealFlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_RevealFlip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).RevealFlip(ctx, req.(*RevealFlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ListKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_ReserveKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ReserveKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ReserveKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ReserveKey(ctx, req.(*ReserveKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_ConsumeKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ConsumeKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ConsumeKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ConsumeKey(ctx, req.(*ConsumeKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).RotateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_RotateKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).RotateKey(ctx, req.(*RotateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_DestroyKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).DestroyKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_DestroyKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).DestroyKey(ctx, req.(*DestroyKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_ExportPSK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ExportPSK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ExportPSK_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ExportPSK(ctx, req.(*ExportPskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_ListKeySinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeySinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ListKeySinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ListKeySinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ListKeySinks(ctx, req.(*ListKeySinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_GetSessionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).GetSessionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_GetSessionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).GetSessionStatus(ctx, req.(*SessionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCrypto_AbortSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumCryptoServer).AbortSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCrypto_AbortSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumCryptoServer).AbortSession(ctx, req.(*AbortSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuantumCrypto_ServiceDesc is the grpc.ServiceDesc for QuantumCrypto service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +907,213 @@ var QuantumCrypto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DetectEavesdropping",
 			Handler:    _QuantumCrypto_DetectEavesdropping_Handler,
 		},
+		{
+			MethodName: "BenchmarkBB84",
+			Handler:    _QuantumCrypto_BenchmarkBB84_Handler,
+		},
+		{
+			MethodName: "ConferenceKey",
+			Handler:    _QuantumCrypto_ConferenceKey_Handler,
+		},
+		{
+			MethodName: "CreateFlipSession",
+			Handler:    _QuantumCrypto_CreateFlipSession_Handler,
+		},
+		{
+			MethodName: "CommitFlip",
+			Handler:    _QuantumCrypto_CommitFlip_Handler,
+		},
+		{
+			MethodName: "RevealFlip",
+			Handler:    _QuantumCrypto_RevealFlip_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _QuantumCrypto_ListKeys_Handler,
+		},
+		{
+			MethodName: "ReserveKey",
+			Handler:    _QuantumCrypto_ReserveKey_Handler,
+		},
+		{
+			MethodName: "ConsumeKey",
+			Handler:    _QuantumCrypto_ConsumeKey_Handler,
+		},
+		{
+			MethodName: "RotateKey",
+			Handler:    _QuantumCrypto_RotateKey_Handler,
+		},
+		{
+			MethodName: "DestroyKey",
+			Handler:    _QuantumCrypto_DestroyKey_Handler,
+		},
+		{
+			MethodName: "ExportPSK",
+			Handler:    _QuantumCrypto_ExportPSK_Handler,
+		},
+		{
+			MethodName: "ListKeySinks",
+			Handler:    _QuantumCrypto_ListKeySinks_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _QuantumCrypto_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSessionStatus",
+			Handler:    _QuantumCrypto_GetSessionStatus_Handler,
+		},
+		{
+			MethodName: "AbortSession",
+			Handler:    _QuantumCrypto_AbortSession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBB84",
+			Handler:       _QuantumCrypto_StreamBB84_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "crypto/crypto.proto",
+}
+
+const (
+	QuantumRNG_GetRandom_FullMethodName        = "/qubit_engine.crypto.QuantumRNG/GetRandom"
+	QuantumRNG_GetEntropyReport_FullMethodName = "/qubit_engine.crypto.QuantumRNG/GetEntropyReport"
+)
+
+// QuantumRNGClient is the client API for QuantumRNG service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumRNGClient interface {
+	GetRandom(ctx context.Context, in *RandomRequest, opts ...grpc.CallOption) (*RandomBytes, error)
+	GetEntropyReport(ctx context.Context, in *EntropyReportRequest, opts ...grpc.CallOption) (*EntropyReport, error)
+}
+
+type quantumRNGClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumRNGClient(cc grpc.ClientConnInterface) QuantumRNGClient {
+	return &quantumRNGClient{cc}
+}
+
+func (c *quantumRNGClient) GetRandom(ctx context.Context, in *RandomRequest, opts ...grpc.CallOption) (*RandomBytes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RandomBytes)
+	err := c.cc.Invoke(ctx, QuantumRNG_GetRandom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumRNGClient) GetEntropyReport(ctx context.Context, in *EntropyReportRequest, opts ...grpc.CallOption) (*EntropyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntropyReport)
+	err := c.cc.Invoke(ctx, QuantumRNG_GetEntropyReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumRNGServer is the server API for QuantumRNG service.
+// All implementations must embed UnimplementedQuantumRNGServer
+// for forward compatibility.
+type QuantumRNGServer interface {
+	GetRandom(context.Context, *RandomRequest) (*RandomBytes, error)
+	GetEntropyReport(context.Context, *EntropyReportRequest) (*EntropyReport, error)
+	mustEmbedUnimplementedQuantumRNGServer()
+}
+
+// UnimplementedQuantumRNGServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumRNGServer struct{}
+
+func (UnimplementedQuantumRNGServer) GetRandom(context.Context, *RandomRequest) (*RandomBytes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRandom not implemented")
+}
+func (UnimplementedQuantumRNGServer) GetEntropyReport(context.Context, *EntropyReportRequest) (*EntropyReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEntropyReport not implemented")
+}
+func (UnimplementedQuantumRNGServer) mustEmbedUnimplementedQuantumRNGServer() {}
+func (UnimplementedQuantumRNGServer) testEmbeddedByValue()                    {}
+
+// UnsafeQuantumRNGServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumRNGServer will
+// result in compilation errors.
+type UnsafeQuantumRNGServer interface {
+	mustEmbedUnimplementedQuantumRNGServer()
+}
+
+func RegisterQuantumRNGServer(s grpc.ServiceRegistrar, srv QuantumRNGServer) {
+	// If the following call panics, it indicates UnimplementedQuantumRNGServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumRNG_ServiceDesc, srv)
+}
+
+func _QuantumRNG_GetRandom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumRNGServer).GetRandom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumRNG_GetRandom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumRNGServer).GetRandom(ctx, req.(*RandomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumRNG_GetEntropyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntropyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumRNGServer).GetEntropyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumRNG_GetEntropyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumRNGServer).GetEntropyReport(ctx, req.(*EntropyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumRNG_ServiceDesc is the grpc.ServiceDesc for QuantumRNG service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumRNG_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.crypto.QuantumRNG",
+	HandlerType: (*QuantumRNGServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRandom",
+			Handler:    _QuantumRNG_GetRandom_Handler,
+		},
+		{
+			MethodName: "GetEntropyReport",
+			Handler:    _QuantumRNG_GetEntropyReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crypto/crypto.proto",
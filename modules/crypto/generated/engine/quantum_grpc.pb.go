@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuantumCompute_RunCircuit_FullMethodName       = "/qubit_engine.QuantumCompute/RunCircuit"
-	QuantumCompute_StreamGates_FullMethodName      = "/qubit_engine.QuantumCompute/StreamGates"
-	QuantumCompute_VisualizeCircuit_FullMethodName = "/qubit_engine.QuantumCompute/VisualizeCircuit"
+	QuantumCompute_RunCircuit_FullMethodName        = "/qubit_engine.QuantumCompute/RunCircuit"
+	QuantumCompute_StreamGates_FullMethodName       = "/qubit_engine.QuantumCompute/StreamGates"
+	QuantumCompute_VisualizeCircuit_FullMethodName  = "/qubit_engine.QuantumCompute/VisualizeCircuit"
+	QuantumCompute_RunVQE_FullMethodName            = "/qubit_engine.QuantumCompute/RunVQE"
+	QuantumCompute_EstimateResources_FullMethodName = "/qubit_engine.QuantumCompute/EstimateResources"
 )
 
 // QuantumComputeClient is the client API for QuantumCompute service.
@@ -37,6 +39,10 @@ type QuantumComputeClient interface {
 	// gRPC-Web does not support bidirectional streaming.
 	// This executes a circuit and streams back the state after EACH step.
 	VisualizeCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StateResponse], error)
+	// VQE Simulation for Quantum Chemistry
+	RunVQE(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEResponse], error)
+	// Predicts what a circuit would cost to run, without running it.
+	EstimateResources(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*ResourceEstimate, error)
 }
 
 type quantumComputeClient struct {
@@ -89,6 +95,35 @@ func (c *quantumComputeClient) VisualizeCircuit(ctx context.Context, in *Circuit
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type QuantumCompute_VisualizeCircuitClient = grpc.ServerStreamingClient[StateResponse]
 
+func (c *quantumComputeClient) RunVQE(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumCompute_ServiceDesc.Streams[2], QuantumCompute_RunVQE_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VQERequest, VQEResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_RunVQEClient = grpc.ServerStreamingClient[VQEResponse]
+
+func (c *quantumComputeClient) EstimateResources(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*ResourceEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceEstimate)
+	err := c.cc.Invoke(ctx, QuantumCompute_EstimateResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuantumComputeServer is the server API for QuantumCompute service.
 // All implementations must embed UnimplementedQuantumComputeServer
 // for forward compatibility.
@@ -102,6 +137,10 @@ type QuantumComputeServer interface {
 	// gRPC-Web does not support bidirectional streaming.
 	// This executes a circuit and streams back the state after EACH step.
 	VisualizeCircuit(*CircuitRequest, grpc.ServerStreamingServer[StateResponse]) error
+	// VQE Simulation for Quantum Chemistry
+	RunVQE(*VQERequest, grpc.ServerStreamingServer[VQEResponse]) error
+	// Predicts what a circuit would cost to run, without running it.
+	EstimateResources(context.Context, *CircuitRequest) (*ResourceEstimate, error)
 	mustEmbedUnimplementedQuantumComputeServer()
 }
 
@@ -121,6 +160,12 @@ func (UnimplementedQuantumComputeServer) StreamGates(grpc.BidiStreamingServer[Ga
 func (UnimplementedQuantumComputeServer) VisualizeCircuit(*CircuitRequest, grpc.ServerStreamingServer[StateResponse]) error {
 	return status.Error(codes.Unimplemented, "method VisualizeCircuit not implemented")
 }
+func (UnimplementedQuantumComputeServer) RunVQE(*VQERequest, grpc.ServerStreamingServer[VQEResponse]) error {
+	return status.Error(codes.Unimplemented, "method RunVQE not implemented")
+}
+func (UnimplementedQuantumComputeServer) EstimateResources(context.Context, *CircuitRequest) (*ResourceEstimate, error) {
+	return nil, status.Error(codes.Unimplemented, "method EstimateResources not implemented")
+}
 func (UnimplementedQuantumComputeServer) mustEmbedUnimplementedQuantumComputeServer() {}
 func (UnimplementedQuantumComputeServer) testEmbeddedByValue()                        {}
 
@@ -178,6 +223,35 @@ func _QuantumCompute_VisualizeCircuit_Handler(srv interface{}, stream grpc.Serve
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type QuantumCompute_VisualizeCircuitServer = grpc.ServerStreamingServer[StateResponse]
 
+func _QuantumCompute_RunVQE_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VQERequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumComputeServer).RunVQE(m, &grpc.GenericServerStream[VQERequest, VQEResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_RunVQEServer = grpc.ServerStreamingServer[VQEResponse]
+
+func _QuantumCompute_EstimateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumComputeServer).EstimateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCompute_EstimateResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumComputeServer).EstimateResources(ctx, req.(*CircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuantumCompute_ServiceDesc is the grpc.ServiceDesc for QuantumCompute service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +263,10 @@ var QuantumCompute_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunCircuit",
 			Handler:    _QuantumCompute_RunCircuit_Handler,
 		},
+		{
+			MethodName: "EstimateResources",
+			Handler:    _QuantumCompute_EstimateResources_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -202,6 +280,11 @@ var QuantumCompute_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _QuantumCompute_VisualizeCircuit_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RunVQE",
+			Handler:       _QuantumCompute_RunVQE_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "quantum.proto",
 }
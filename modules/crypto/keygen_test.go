@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+func TestBB84KeyNotReproducibleFromSeed(t *testing.T) {
+	var keys [2][]byte
+	for i := range keys {
+		key, err := newTestServer().GenerateQuantumKey(context.Background(), &pb.KeyRequest{KeyLengthBits: 128})
+		if err != nil {
+			t.Fatalf("GenerateQuantumKey: %v", err)
+		}
+		if !key.Secure || len(key.Key) != 16 {
+			t.Fatalf("got %d-byte key (secure: %v, QBER %.3f), want a secure 16-byte key", len(key.Key), key.Secure, key.Qber)
+		}
+		keys[i] = key.Key
+	}
+	if bytes.Equal(keys[0], keys[1]) {
+		t.Errorf("two servers seeded alike produced the same key %x", keys[0])
+	}
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"context"
+	"crypto/cipher"
+	crand "crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+const (
+	defaultReservationTTL = 5 * time.Minute
+	maxReservationTTL     = 24 * time.Hour
+	maxConsumeBytes       = 1 << 20
+)
+
+// storedKey is a distilled key sealed under the store's master key. Pad
+// bytes before Used have been handed out and are never given out again;
+// Reserved of the rest are held for reservations.
+type storedKey struct {
+	ID           string
+	SessionID    string
+	Peer         string
+	Sealed       []byte
+	Nonce        []byte
+	Size         int
+	Used         int
+	Reserved     int
+	Consumptions int
+	State        pb.KeyState
+	CreatedAt    time.Time
+	LastUsedAt   time.Time
+	RotatedTo    string
+}
+
+func (k *storedKey) available() int {
+	return k.Size - k.Used - k.Reserved
+}
+
+type reservation struct {
+	KeyID     string
+	Remaining int
+	ExpiresAt time.Time
+}
+
+// keyStore pools distilled keys for encryption. Material is sealed with
+// AES-256-GCM under a master key drawn at start-up and only unsealed
+// while a request is using it.
+type keyStore struct {
+	mu           sync.Mutex
+	master       cipher.AEAD
+	keys         map[string]*storedKey
+	reservations map[string]*reservation
+}
+
+func newKeyStore() *keyStore {
+	master := make([]byte, aesKeyBytes)
+	crand.Read(master)
+	aead, err := newGCM(master)
+	if err != nil {
+		panic(err) // Unreachable with a 32-byte key
+	}
+	return &keyStore{
+		master:       aead,
+		keys:         make(map[string]*storedKey),
+		reservations: make(map[string]*reservation),
+	}
+}
+
+func newID(prefix string) string {
+	id := make([]byte, 8)
+	crand.Read(id)
+	return prefix + hex.EncodeToString(id)
+}
+
+// add seals key material into the store and returns its ID
+func (ks *keyStore) add(key []byte, sessionID, peer string) string {
+	keyID := newID("qk-")
+	nonce := make([]byte, ks.master.NonceSize())
+	crand.Read(nonce)
+
+	ks.mu.Lock()
+	ks.keys[keyID] = &storedKey{
+		ID:        keyID,
+		SessionID: sessionID,
+		Peer:      peer,
+		Sealed:    ks.master.Seal(nil, nonce, key, []byte(keyID)),
+		Nonce:     nonce,
+		Size:      len(key),
+		State:     pb.KeyState_KEY_ACTIVE,
+		CreatedAt: time.Now(),
+	}
+	ks.mu.Unlock()
+	return keyID
+}
+
+// The helpers below expect ks.mu to be held
+
+func (ks *keyStore) open(k *storedKey) ([]byte, error) {
+	material, err := ks.master.Open(nil, k.Nonce, k.Sealed, []byte(k.ID))
+	if err != nil {
+		return nil, fmt.Errorf("key %s failed to unseal: %v", k.ID, err)
+	}
+	return material, nil
+}
+
+func (ks *keyStore) lookup(keyID string) (*storedKey, error) {
+	k, ok := ks.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", keyID)
+	}
+	if k.State == pb.KeyState_KEY_DESTROYED {
+		return nil, fmt.Errorf("key %s was destroyed", keyID)
+	}
+	return k, nil
+}
+
+// lookupActive is lookup for operations that hand out new pad
+func (ks *keyStore) lookupActive(keyID string) (*storedKey, error) {
+	k, err := ks.lookup(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if k.State == pb.KeyState_KEY_RETIRED {
+		return nil, fmt.Errorf("key %s is retired; use %s", keyID, k.RotatedTo)
+	}
+	return k, nil
+}
+
+// expire returns the unused part of lapsed reservations to their keys
+func (ks *keyStore) expire(now time.Time) {
+	for id, r := range ks.reservations {
+		if now.After(r.ExpiresAt) {
+			ks.keys[r.KeyID].Reserved -= r.Remaining
+			delete(ks.reservations, id)
+		}
+	}
+}
+
+// release drops every reservation on a key
+func (ks *keyStore) release(k *storedKey) {
+	for id, r := range ks.reservations {
+		if r.KeyID == k.ID {
+			delete(ks.reservations, id)
+		}
+	}
+	k.Reserved = 0
+}
+
+func (ks *keyStore) info(k *storedKey) *pb.KeyInfo {
+	info := &pb.KeyInfo{
+		KeyId:         k.ID,
+		SessionId:     k.SessionID,
+		Peer:          k.Peer,
+		State:         k.State,
+		SizeBytes:     int32(k.Size),
+		UsedBytes:     int32(k.Used),
+		ReservedBytes: int32(k.Reserved),
+		Consumptions:  int32(k.Consumptions),
+		CreatedAt:     k.CreatedAt.Unix(),
+		RotatedTo:     k.RotatedTo,
+	}
+	if k.State == pb.KeyState_KEY_ACTIVE {
+		info.AvailableBytes = int32(k.available())
+	}
+	if !k.LastUsedAt.IsZero() {
+		info.LastUsedAt = k.LastUsedAt.Unix()
+	}
+	return info
+}
+
+// remaining is how much pad a caller may take: the reservation's balance,
+// or the key's unreserved bytes
+func (ks *keyStore) remaining(keyID, reservationID string) (int, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.expire(time.Now())
+	k, err := ks.lookupActive(keyID)
+	if err != nil {
+		return 0, err
+	}
+	if reservationID == "" {
+		return k.available(), nil
+	}
+	r, ok := ks.reservations[reservationID]
+	if !ok || r.KeyID != keyID {
+		return 0, fmt.Errorf("reservation %s not found for key %s", reservationID, keyID)
+	}
+	return r.Remaining, nil
+}
+
+// consume hands out the next n pad bytes, drawn from a reservation when
+// one is given
+func (ks *keyStore) consume(keyID, reservationID string, n int) (*pb.KeyMaterial, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	now := time.Now()
+	ks.expire(now)
+	k, err := ks.lookupActive(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.KeyMaterial{KeyId: keyID}
+	if reservationID != "" {
+		r, ok := ks.reservations[reservationID]
+		if !ok || r.KeyID != keyID {
+			return nil, fmt.Errorf("reservation %s not found for key %s", reservationID, keyID)
+		}
+		if n > r.Remaining {
+			return nil, fmt.Errorf("reservation %s has %d bytes left, %d needed", reservationID, r.Remaining, n)
+		}
+		r.Remaining -= n
+		k.Reserved -= n
+		out.ReservationRemaining = int32(r.Remaining)
+		if r.Remaining == 0 {
+			delete(ks.reservations, reservationID)
+		}
+	} else if available := k.available(); n > available {
+		return nil, fmt.Errorf("key %s has %d unused bytes, %d needed", keyID, available, n)
+	}
+
+	material, err := ks.open(k)
+	if err != nil {
+		return nil, err
+	}
+	out.Offset = int32(k.Used)
+	out.Material = material[k.Used : k.Used+n]
+	k.Used += n
+	k.Consumptions++
+	k.LastUsedAt = now
+	out.AvailableBytes = int32(k.available())
+	return out, nil
+}
+
+// consumed returns pad bytes that were handed out earlier, so messages
+// encrypted under a retired key still decrypt
+func (ks *keyStore) consumed(keyID string, offset, n int) ([]byte, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	k, err := ks.lookup(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 || n < 0 || offset+n > k.Used {
+		return nil, fmt.Errorf("key %s bytes %d-%d were never used for encryption", keyID, offset, offset+n)
+	}
+	material, err := ks.open(k)
+	if err != nil {
+		return nil, err
+	}
+	return material[offset : offset+n], nil
+}
+
+func (ks *keyStore) reserve(keyID string, n int, ttl time.Duration) (*pb.KeyReservation, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	now := time.Now()
+	ks.expire(now)
+	k, err := ks.lookupActive(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if available := k.available(); n > available {
+		return nil, fmt.Errorf("key %s has %d unreserved bytes, %d requested", keyID, available, n)
+	}
+
+	id := newID("qr-")
+	r := &reservation{KeyID: keyID, Remaining: n, ExpiresAt: now.Add(ttl)}
+	ks.reservations[id] = r
+	k.Reserved += n
+	return &pb.KeyReservation{
+		ReservationId: id,
+		KeyId:         keyID,
+		NumBytes:      int32(n),
+		ExpiresAt:     r.ExpiresAt.Unix(),
+	}, nil
+}
+
+// retire stops a key handing out pad in favour of its replacement
+func (ks *keyStore) retire(keyID, replacement string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	k, err := ks.lookupActive(keyID)
+	if err != nil {
+		return err
+	}
+	ks.release(k)
+	k.State = pb.KeyState_KEY_RETIRED
+	k.RotatedTo = replacement
+	return nil
+}
+
+// destroy wipes a key's material; its accounting stays listable
+func (ks *keyStore) destroy(keyID string) (*pb.KeyInfo, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	k, err := ks.lookup(keyID)
+	if err != nil {
+		return nil, err
+	}
+	ks.release(k)
+	clear(k.Sealed)
+	k.Sealed, k.Nonce = nil, nil
+	k.State = pb.KeyState_KEY_DESTROYED
+	return ks.info(k), nil
+}
+
+func (ks *keyStore) get(keyID string) (*pb.KeyInfo, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.expire(time.Now())
+	k, err := ks.lookup(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return ks.info(k), nil
+}
+
+func (ks *keyStore) list(req *pb.ListKeysRequest) *pb.KeyList {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.expire(time.Now())
+
+	var matched []*storedKey
+	for _, k := range ks.keys {
+		switch {
+		case req.KeyId != "" && k.ID != req.KeyId,
+			req.SessionId != "" && k.SessionID != req.SessionId,
+			req.Peer != "" && k.Peer != req.Peer,
+			k.State == pb.KeyState_KEY_DESTROYED && !req.IncludeDestroyed:
+			continue
+		}
+		matched = append(matched, k)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	list := &pb.KeyList{}
+	for _, k := range matched {
+		info := ks.info(k)
+		list.Keys = append(list.Keys, info)
+		list.TotalAvailableBytes += int64(info.AvailableBytes)
+	}
+	return list
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// registerKey adds distilled key material to the store and returns its ID
+func (s *CryptoServer) registerKey(key []byte, sessionID, peer string) string {
+	return s.keys.add(key, sessionID, peer)
+}
+
+func (s *CryptoServer) ListKeys(ctx context.Context, req *pb.ListKeysRequest) (*pb.KeyList, error) {
+	return s.keys.list(req), nil
+}
+
+// ReserveKey holds pad bytes back for one caller until used or expired
+func (s *CryptoServer) ReserveKey(ctx context.Context, req *pb.ReserveKeyRequest) (*pb.KeyReservation, error) {
+	if req.NumBytes <= 0 {
+		return nil, fmt.Errorf("num_bytes must be positive")
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = defaultReservationTTL
+	}
+	if ttl < 0 || ttl > maxReservationTTL {
+		return nil, fmt.Errorf("ttl_seconds must be at most %d", int(maxReservationTTL.Seconds()))
+	}
+	res, err := s.keys.reserve(req.KeyId, int(req.NumBytes), ttl)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("🔑 Reserved %d bytes of key %s until %s", req.NumBytes, req.KeyId, time.Unix(res.ExpiresAt, 0).Format(time.RFC3339))
+	return res, nil
+}
+
+// ConsumeKey hands raw pad bytes to a caller that encrypts elsewhere
+func (s *CryptoServer) ConsumeKey(ctx context.Context, req *pb.ConsumeKeyRequest) (*pb.KeyMaterial, error) {
+	if req.NumBytes <= 0 || req.NumBytes > maxConsumeBytes {
+		return nil, fmt.Errorf("num_bytes must be 1-%d", maxConsumeBytes)
+	}
+	return s.keys.consume(req.KeyId, req.ReservationId, int(req.NumBytes))
+}
+
+// RotateKey distills a fresh key of the same size for the same session
+// and peer, and retires the old one
+func (s *CryptoServer) RotateKey(ctx context.Context, req *pb.RotateKeyRequest) (*pb.KeyInfo, error) {
+	old, err := s.keys.get(req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	if old.State != pb.KeyState_KEY_ACTIVE {
+		return nil, fmt.Errorf("key %s is not active", req.KeyId)
+	}
+
+	fresh, err := s.generateBB84Key(ctx, int(old.SizeBytes)*8, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !fresh.Secure {
+		return nil, fmt.Errorf("replacement key was aborted (QBER %.2f%%)", fresh.Qber*100)
+	}
+	replacement := s.registerKey(fresh.Key, old.SessionId, old.Peer)
+	if err := s.keys.retire(req.KeyId, replacement); err != nil {
+		s.keys.destroy(replacement)
+		return nil, err
+	}
+
+	log.Printf("🔑 Rotated key %s → %s", req.KeyId, replacement)
+	return s.keys.get(replacement)
+}
+
+func (s *CryptoServer) DestroyKey(ctx context.Context, req *pb.DestroyKeyRequest) (*pb.KeyInfo, error) {
+	info, err := s.keys.destroy(req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("🔑 Destroyed key %s (%d of %d bytes used)", req.KeyId, info.UsedBytes, info.SizeBytes)
+	return info, nil
+}
@@ -8,9 +8,11 @@ import (
 	"log"
 	"math/rand"
 	"net"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -30,77 +32,224 @@ type BB84Session struct {
 	SharedKey   []byte
 	ErrorRate   float64
 	EveProb     float64 // Probability of eavesdropping per qubit
+	Disclosed   []bool  // Sifted bits revealed for error estimation
+	Protocol    pb.Protocol
+	AuthKey     []byte // Pre-shared secret for the classical channel
+	Aborted     bool
+	AbortReason string
+	Decoy       *decoySettings // Decoy-state source, nil for single photons
+	PulseLevels []int32        // Decoy level of each pulse
+	Reconciled  bool
+	Policy      securityPolicy
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
 }
 
+// qberThreshold aborts sessions whose error rate suggests an eavesdropper,
+// unless a session's policy sets its own
+const qberThreshold = 0.1
+
 type CryptoServer struct {
 	pb.UnimplementedQuantumCryptoServer
 	rng          *rand.Rand
-	sessions     map[string]*BB84Session
-	mu           sync.RWMutex
+	sessions     SessionStore
+	sessionTTL   time.Duration
 	engineClient engine.QuantumComputeClient
+	qrng         *QRNGServer
+
+	// Distilled keys, and fingerprints of caller-supplied pads
+	keys     *keyStore
+	usedPads map[[32]byte]bool
+	padMu    sync.Mutex
+
+	flips  map[string]*coinFlip
+	flipMu sync.Mutex
+
+	// Where ExportPSK can push keys, by name
+	sinks map[string]keySink
 }
 
-func NewCryptoServer(engineClient engine.QuantumComputeClient) *CryptoServer {
+// lockedSource lets concurrent requests and batches share one rng
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (l *lockedSource) Int63() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.src.Int63()
+}
+
+func (l *lockedSource) Uint64() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.src.Uint64()
+}
+
+func (l *lockedSource) Seed(seed int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.src.Seed(seed)
+}
+
+func NewCryptoServer(engineClient engine.QuantumComputeClient, sessions SessionStore, sessionTTL time.Duration) *CryptoServer {
 	return &CryptoServer{
-		rng:          rand.New(rand.NewSource(time.Now().UnixNano())),
-		sessions:     make(map[string]*BB84Session),
+		rng:          rand.New(&lockedSource{src: rand.NewSource(time.Now().UnixNano()).(rand.Source64)}),
+		sessions:     sessions,
+		sessionTTL:   sessionTTL,
 		engineClient: engineClient,
+		qrng:         NewQRNGServer(engineClient),
+		keys:         newKeyStore(),
+		usedPads:     make(map[[32]byte]bool),
+		flips:        make(map[string]*coinFlip),
+		sinks:        make(map[string]keySink),
 	}
 }
 
-// StartBB84Alice prepares bits and bases, "sending" them conceptually (storing in session)
-func (s *CryptoServer) StartBB84Alice(ctx context.Context, req *pb.BB84AliceRequest) (*pb.BB84AliceState, error) {
-	numBits := int(req.NumBits)
-	bits := make([]int32, numBits)
+// newSession draws Alice's random bits and bases from the QRNG
+func (s *CryptoServer) newSession(ctx context.Context, id string, numBits int, eveProb float64, protocol pb.Protocol) (*BB84Session, error) {
+	if numBits < 1 {
+		return nil, fmt.Errorf("num_bits must be positive")
+	}
+	random, _, err := s.qrngBits(ctx, 2*numBits)
+	if err != nil {
+		return nil, err
+	}
+	bits := random[:numBits]
 	bases := make([]pb.Basis, numBits)
-
-	for i := 0; i < numBits; i++ {
-		bits[i] = int32(s.rng.Intn(2))
-		bases[i] = pb.Basis(s.rng.Intn(2))
+	for i := range bases {
+		bases[i] = pb.Basis(random[numBits+i])
+		if protocol == pb.Protocol_PROTOCOL_B92 {
+			bases[i] = pb.Basis(bits[i]) // The bit picks the state
+		}
 	}
 
-	session := &BB84Session{
-		ID:         req.SessionId,
+	now := time.Now()
+	return &BB84Session{
+		ID:         id,
 		AliceBits:  bits,
 		AliceBases: bases,
-		EveProb:    req.EavesdropProbability,
+		EveProb:    eveProb,
+		Protocol:   protocol,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.sessionTTL),
+	}, nil
+}
+
+// StartBB84Alice prepares bits and bases, "sending" them conceptually (storing in session)
+func (s *CryptoServer) StartBB84Alice(ctx context.Context, req *pb.BB84AliceRequest) (*pb.BB84AliceState, error) {
+	if err := validateAuthKey(req.AuthKey); err != nil {
+		return nil, err
+	}
+	if req.TtlSeconds < 0 || time.Duration(req.TtlSeconds)*time.Second > maxSessionTTL {
+		return nil, fmt.Errorf("ttl_seconds must be at most %d", int(maxSessionTTL.Seconds()))
 	}
+	policy, err := newSecurityPolicy(req.Policy)
+	if err != nil {
+		return nil, err
+	}
+	numBits := int(req.NumBits)
+	session, err := s.newSession(ctx, req.SessionId, numBits, req.EavesdropProbability, req.Protocol)
+	if err != nil {
+		return nil, err
+	}
+	session.AuthKey = req.AuthKey
+	session.Policy = policy
+	if req.TtlSeconds > 0 {
+		session.ExpiresAt = session.CreatedAt.Add(time.Duration(req.TtlSeconds) * time.Second)
+	}
+	if req.Decoy != nil {
+		if req.Protocol != pb.Protocol_PROTOCOL_BB84 {
+			return nil, fmt.Errorf("decoy states are only supported for BB84")
+		}
+		decoy, err := newDecoySettings(req.Decoy)
+		if err != nil {
+			return nil, err
+		}
+		session.Decoy = decoy
+		if session.PulseLevels, err = decoy.drawLevels(numBits); err != nil {
+			return nil, err
+		}
+	}
+	bits, bases := session.AliceBits, session.AliceBases
 
-	s.mu.Lock()
-	s.sessions[req.SessionId] = session
-	s.mu.Unlock()
+	if err := s.sessions.Put(ctx, session); err != nil {
+		return nil, err
+	}
 
-	log.Printf("🔐 Alice started session %s: %d bits (Eve prob: %.2f)", req.SessionId, numBits, req.EavesdropProbability)
+	log.Printf("🔐 Alice started %s session %s: %d bits (Eve prob: %.2f)", req.Protocol, req.SessionId, numBits, req.EavesdropProbability)
 	return &pb.BB84AliceState{
-		SessionId: req.SessionId,
-		Bits:      bits,
-		Bases:     bases,
+		SessionId:       req.SessionId,
+		Bits:            bits,
+		Bases:           bases,
+		Protocol:        req.Protocol,
+		BasesMac:        session.aliceMAC(),
+		IntensityLevels: session.PulseLevels,
+		Policy:          policy.proto(),
 	}, nil
 }
 
 // StartBB84Bob receives qubits. Here we simulate the quantum channel + Eve + Bob's measurement
 func (s *CryptoServer) StartBB84Bob(ctx context.Context, req *pb.BB84BobRequest) (*pb.BB84BobState, error) {
-	s.mu.RLock()
-	session, ok := s.sessions[req.SessionId]
-	s.mu.RUnlock()
-	if !ok {
-		return nil, fmt.Errorf("session not found")
+	session, err := s.sessions.Get(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if session.Aborted {
+		return nil, fmt.Errorf("session %s was aborted: %s", req.SessionId, session.AbortReason)
 	}
 
+	// Bob picks his bases before anything arrives
 	numBits := len(session.AliceBits)
+	random, _, err := s.qrngBits(ctx, numBits)
+	if err != nil {
+		return nil, err
+	}
 	bobBases := make([]pb.Basis, numBits)
-	// We will build a circuit to simulate the whole process for each qubit/batch
-	// Or simpler: One big circuit?
-	// QubitEngine handles ~30 qubits. If numBits > 30, we must batch.
-	// Let's assume typical demo is 10-20 bits. Or we batch 30 at a time.
+	for i, r := range random {
+		bobBases[i] = pb.Basis(r)
+	}
+
+	sent, sentBases := session.preparation()
+	results, err := s.transmitBB84(ctx, sent, sentBases, bobBases, session.EveProb)
+	if err != nil {
+		return nil, err
+	}
+	if session.Decoy != nil {
+		session.Decoy.applyChannel(s.rng, session.PulseLevels, results)
+	}
 
-	// Generate Bob's bases first
-	for i := 0; i < numBits; i++ {
-		bobBases[i] = pb.Basis(s.rng.Intn(2))
+	err = s.sessions.Update(ctx, req.SessionId, func(session *BB84Session) error {
+		session.BobBases = bobBases
+		session.BobMeasures = results
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	session.BobBases, session.BobMeasures = bobBases, results
 
+	log.Printf("🔐 Bob measured session %s", req.SessionId)
+	return &pb.BB84BobState{
+		SessionId:    req.SessionId,
+		Bases:        bobBases,
+		Measurements: results,
+		Mac:          session.bobMAC(),
+	}, nil
+}
+
+// transmitBB84 sends Alice's qubits through the (optionally tapped) channel
+// and returns Bob's measurement in his chosen bases
+func (s *CryptoServer) transmitBB84(ctx context.Context, aliceBits []int32, aliceBases, bobBases []pb.Basis, eveProb float64) ([]int32, error) {
+	numBits := len(aliceBits)
 	results := make([]int32, numBits)
 
+	// We will build a circuit to simulate the whole process for each qubit/batch
+	// Or simpler: One big circuit?
+	// QubitEngine handles ~30 qubits. If numBits > 30, we must batch.
+	// Let's assume typical demo is 10-20 bits. Or we batch 30 at a time.
+
 	// Process in batches of 20 to be safe
 	batchSize := 20
 	for i := 0; i < numBits; i += batchSize {
@@ -118,14 +267,14 @@ func (s *CryptoServer) StartBB84Bob(ctx context.Context, req *pb.BB84BobRequest)
 			qubit := uint32(j)
 
 			// X if bit is 1
-			if session.AliceBits[idx] == 1 {
+			if aliceBits[idx] == 1 {
 				ops = append(ops, &engine.GateOperation{
 					Type:        engine.GateOperation_PAULI_X,
 					TargetQubit: qubit,
 				})
 			}
 			// H if basis is Diagonal (1)
-			if session.AliceBases[idx] == pb.Basis_BASIS_DIAGONAL {
+			if aliceBases[idx] == pb.Basis_BASIS_DIAGONAL {
 				ops = append(ops, &engine.GateOperation{
 					Type:        engine.GateOperation_HADAMARD,
 					TargetQubit: qubit,
@@ -133,7 +282,7 @@ func (s *CryptoServer) StartBB84Bob(ctx context.Context, req *pb.BB84BobRequest)
 			}
 
 			// 2. Eve Intercepts (Simulated per qubit)
-			if session.EveProb > 0 && s.rng.Float64() < session.EveProb {
+			if eveProb > 0 && s.rng.Float64() < eveProb {
 				// Eve picks random basis
 				eveBasis := pb.Basis(s.rng.Intn(2))
 				if eveBasis == pb.Basis_BASIS_DIAGONAL {
@@ -212,26 +361,36 @@ func (s *CryptoServer) StartBB84Bob(ctx context.Context, req *pb.BB84BobRequest)
 			}
 		}
 	}
-
-	s.mu.Lock()
-	session.BobBases = bobBases
-	session.BobMeasures = results
-	s.mu.Unlock()
-
-	log.Printf("🔐 Bob measured session %s", req.SessionId)
-	return &pb.BB84BobState{
-		SessionId:    req.SessionId,
-		Bases:        bobBases,
-		Measurements: results,
-	}, nil
+	return results, nil
 }
 
 func (s *CryptoServer) ReconcileBB84(ctx context.Context, req *pb.ReconcileRequest) (*pb.BB84Key, error) {
-	s.mu.RLock()
-	session, ok := s.sessions[req.SessionId]
-	s.mu.RUnlock()
-	if !ok {
-		return nil, fmt.Errorf("session not found")
+	session, err := s.sessions.Get(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if session.BobMeasures == nil {
+		return nil, fmt.Errorf("session %s has no measurements yet", req.SessionId)
+	}
+
+	// Sifting is only trusted once both announcements verify
+	if session.AuthKey != nil && !session.Aborted {
+		if err := session.verifyAnnouncements(req); err != nil {
+			if err := s.abortSession(ctx, req.SessionId, err.Error()); err != nil {
+				return nil, err
+			}
+			session.Aborted, session.AbortReason = true, err.Error()
+		}
+	}
+	policy := session.policy()
+	if session.Aborted {
+		return &pb.BB84Key{
+			SessionId:    req.SessionId,
+			Protocol:     session.Protocol,
+			OriginalBits: int32(len(session.AliceBits)),
+			AuthError:    session.AbortReason,
+			Policy:       policy.proto(),
+		}, nil
 	}
 
 	var siftedKey []byte
@@ -239,13 +398,14 @@ func (s *CryptoServer) ReconcileBB84(ctx context.Context, req *pb.ReconcileReque
 	matched := 0
 
 	// Compare stored alice/bob data
-	for i := 0; i < len(session.AliceBases); i++ {
-		if session.AliceBases[i] == session.BobBases[i] {
-			matched++
-			siftedKey = append(siftedKey, byte(session.BobMeasures[i]))
-			if session.AliceBits[i] != session.BobMeasures[i] {
-				errors++
-			}
+	for _, i := range session.siftedIndices() {
+		matched++
+		bit := session.bobBit(i)
+		if (session.Disclosed == nil || !session.Disclosed[i]) && session.keyed(i) {
+			siftedKey = append(siftedKey, byte(bit))
+		}
+		if session.AliceBits[i] != bit {
+			errors++
 		}
 	}
 
@@ -255,39 +415,85 @@ func (s *CryptoServer) ReconcileBB84(ctx context.Context, req *pb.ReconcileReque
 	}
 
 	h := sha256.Sum256(siftedKey)
-	secure := errorRate < 0.1 // Threshold (10%)
+	finiteBits := finiteKeyBits(len(siftedKey), matched, errorRate, policy.Epsilon)
+	violations := policy.violations(errorRate, matched, finiteBits)
+	secure := len(violations) == 0
+
+	var decoyClasses []*pb.DecoyClassStats
+	var decoyAnalysis *pb.DecoyAnalysis
+	if session.Decoy != nil {
+		decoyClasses = session.decoyClassStats()
+		decoyAnalysis = analyzeDecoys(session.Decoy, decoyClasses)
+		if decoyAnalysis.KeyRate <= 0 {
+			secure = false
+			violations = append(violations, "decoy analysis leaves no single-photon key rate")
+		}
+		log.Printf("🔐 Decoy analysis %s: Y1≥%.3g e1≤%.2f%% rate=%.3g PNS suspected=%v", req.SessionId,
+			decoyAnalysis.SinglePhotonYieldLower, decoyAnalysis.SinglePhotonErrorUpper*100,
+			decoyAnalysis.KeyRate, decoyAnalysis.PnsSuspected)
+	}
 
-	log.Printf("🔐 Reconciled session %s: ErrRate=%.2f%%, Secure=%v", req.SessionId, errorRate*100, secure)
+	log.Printf("🔐 Reconciled session %s: ErrRate=%.2f%%, Secure=%v, Authenticated=%v, FiniteKey=%d bits",
+		req.SessionId, errorRate*100, secure, session.AuthKey != nil, finiteBits)
+
+	var keyID string
+	if secure {
+		keyID = s.registerKey(h[:], req.SessionId, req.Peer)
+	}
+	if !session.Reconciled {
+		err := s.sessions.Update(ctx, req.SessionId, func(session *BB84Session) error {
+			session.Reconciled = true
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &pb.BB84Key{
-		SessionId:    req.SessionId,
-		SharedKey:    h[:],
-		OriginalBits: int32(len(session.AliceBits)),
-		SiftedBits:   int32(matched),
-		ErrorRate:    errorRate,
-		Secure:       secure,
+		SessionId:     req.SessionId,
+		Protocol:      session.Protocol,
+		SharedKey:     h[:],
+		OriginalBits:  int32(len(session.AliceBits)),
+		SiftedBits:    int32(matched),
+		ErrorRate:     errorRate,
+		Secure:        secure,
+		KeyId:         keyID,
+		Authenticated: session.AuthKey != nil,
+		DecoyClasses:  decoyClasses,
+		DecoyAnalysis: decoyAnalysis,
+		Policy:        policy.proto(),
+		FiniteKeyBits: int32(finiteBits),
+		Violations:    violations,
 	}, nil
 }
 
 // Stubs for others
-func (s *CryptoServer) GenerateQuantumKey(ctx context.Context, req *pb.KeyRequest) (*pb.QuantumKey, error) {
-	return nil, nil
-}
-func (s *CryptoServer) QuantumEncrypt(ctx context.Context, req *pb.EncryptRequest) (*pb.EncryptedMessage, error) {
-	return nil, nil
-}
-func (s *CryptoServer) QuantumDecrypt(ctx context.Context, req *pb.DecryptRequest) (*pb.DecryptedMessage, error) {
-	return nil, nil
-}
-func (s *CryptoServer) DetectEavesdropping(ctx context.Context, req *pb.EavesdropRequest) (*pb.EavesdropResult, error) {
-	return nil, nil
-}
 
 func main() {
 	port := flag.Int("port", 50063, "gRPC port")
 	engineAddr := flag.String("engine-addr", "engine:50051", "Quantum Engine address")
+	redisAddr := flag.String("redis-addr", "", "Redis address for shared sessions (empty: in-memory)")
+	sessionTTL := flag.Duration("session-ttl", time.Hour, "Default lifetime of a key exchange session")
+	vaultAddr := flag.String("vault-addr", "", "Vault address for exported PSKs (token from VAULT_TOKEN)")
+	vaultMount := flag.String("vault-mount", "secret", "Vault KV v2 mount for exported PSKs")
+	vaultPath := flag.String("vault-path", "qke/psk", "Vault path under the mount for exported PSKs")
+	kmsWebhook := flag.String("kms-webhook", "", "URL to POST exported PSKs to (bearer token from KMS_TOKEN)")
 	flag.Parse()
 
+	var sessions SessionStore = newMemorySessionStore()
+	if *redisAddr != "" {
+		rdb := redis.NewClient(&redis.Options{
+			Addr: *redisAddr,
+			DB:   2, // Scheduler uses 0, cache 1
+		})
+		if err := rdb.Ping(context.Background()).Err(); err != nil {
+			log.Fatalf("Failed to connect to Redis: %v", err)
+		}
+		sessions = newRedisSessionStore(rdb)
+		log.Printf("🔐 Sessions stored in Redis at %s (TTL %v)", *redisAddr, *sessionTTL)
+	}
+
 	conn, err := grpc.Dial(*engineAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatalf("Failed to connect to engine: %v", err)
@@ -295,15 +501,27 @@ func main() {
 	defer conn.Close()
 
 	engineClient := engine.NewQuantumComputeClient(conn)
-	server := NewCryptoServer(engineClient)
+	server := NewCryptoServer(engineClient, sessions, *sessionTTL)
+	go server.reapSessions(context.Background(), reapInterval)
+	if *vaultAddr != "" {
+		server.sinks["vault"] = newVaultSink(*vaultAddr, os.Getenv("VAULT_TOKEN"), *vaultMount, *vaultPath)
+		log.Printf("🔑 Exporting PSKs to Vault at %s (%s/%s)", *vaultAddr, *vaultMount, *vaultPath)
+	}
+	if *kmsWebhook != "" {
+		server.sinks["webhook"] = newWebhookSink(*kmsWebhook, os.Getenv("KMS_TOKEN"))
+		log.Printf("🔑 Exporting PSKs to %s", *kmsWebhook)
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
+	go server.qrng.Run(context.Background())
+
 	grpcServer := grpc.NewServer()
 	pb.RegisterQuantumCryptoServer(grpcServer, server)
+	pb.RegisterQuantumRNGServer(grpcServer, server.qrng)
 
 	log.Printf("🔐 Quantum Crypto starting on port %d", *port)
 	if err := grpcServer.Serve(lis); err != nil {
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+	engine "github.com/perclft/QubitEngine/modules/crypto/generated/engine"
+)
+
+// fakeEngine runs circuits of single-qubit gates, which is all BB84 and
+// the QRNG send, tracking each qubit's real amplitudes on its own
+type fakeEngine struct {
+	engine.QuantumComputeClient
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func newFakeEngine() *fakeEngine {
+	return &fakeEngine{rng: rand.New(rand.NewSource(1))}
+}
+
+func (e *fakeEngine) RunCircuit(ctx context.Context, req *engine.CircuitRequest, _ ...grpc.CallOption) (*engine.StateResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	qubits := make([][2]float64, req.NumQubits)
+	for q := range qubits {
+		qubits[q] = [2]float64{1, 0}
+	}
+	results := make(map[uint32]bool)
+	for _, op := range req.Operations {
+		a := &qubits[op.TargetQubit]
+		switch op.Type {
+		case engine.GateOperation_PAULI_X:
+			a[0], a[1] = a[1], a[0]
+		case engine.GateOperation_HADAMARD:
+			a[0], a[1] = (a[0]+a[1])/math.Sqrt2, (a[0]-a[1])/math.Sqrt2
+		case engine.GateOperation_MEASURE:
+			one := e.rng.Float64() < a[1]*a[1]
+			*a = [2]float64{1, 0}
+			if one {
+				*a = [2]float64{0, 1}
+			}
+			results[op.ClassicalRegister] = one
+		default:
+			return nil, fmt.Errorf("fake engine does not run %s", op.Type)
+		}
+	}
+	return &engine.StateResponse{ClassicalResults: results}, nil
+}
+
+// newTestServer returns a server on the fake engine whose math/rand
+// source is fixed, as an attacker who guessed the start time would have it
+func newTestServer() *CryptoServer {
+	s := NewCryptoServer(newFakeEngine(), newMemorySessionStore(), time.Hour)
+	s.rng = rand.New(rand.NewSource(1))
+	return s
+}
+
+func TestBB84SessionNotReproducibleFromSeed(t *testing.T) {
+	ctx := context.Background()
+	var alice [2]*pb.BB84AliceState
+	var bob [2]*pb.BB84BobState
+	for i := range alice {
+		s := newTestServer()
+		var err error
+		alice[i], err = s.StartBB84Alice(ctx, &pb.BB84AliceRequest{SessionId: "s", NumBits: 256})
+		if err != nil {
+			t.Fatalf("StartBB84Alice: %v", err)
+		}
+		bob[i], err = s.StartBB84Bob(ctx, &pb.BB84BobRequest{SessionId: "s"})
+		if err != nil {
+			t.Fatalf("StartBB84Bob: %v", err)
+		}
+	}
+	// 256 random bits agree by chance with probability 2^-256
+	if slices.Equal(alice[0].Bits, alice[1].Bits) {
+		t.Errorf("Alice's bits repeat under the same seed")
+	}
+	if slices.Equal(alice[0].Bases, alice[1].Bases) {
+		t.Errorf("Alice's bases repeat under the same seed")
+	}
+	if slices.Equal(bob[0].Bases, bob[1].Bases) {
+		t.Errorf("Bob's bases repeat under the same seed")
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+const (
+	// defaultEpsilon bounds the probability that a key passing the policy
+	// is not secure
+	defaultEpsilon = 1e-10
+	maxEpsilon     = 1e-2
+)
+
+// securityPolicy decides whether a session's key is kept. It is fixed when
+// Alice starts the session so both sides are judged by the same rules.
+type securityPolicy struct {
+	QBERThreshold float64
+	MinSiftedBits int
+	MinKeyBits    int
+	Epsilon       float64
+}
+
+func defaultPolicy() securityPolicy {
+	return securityPolicy{QBERThreshold: qberThreshold, Epsilon: defaultEpsilon}
+}
+
+// newSecurityPolicy fills unset fields with defaults and validates the rest
+func newSecurityPolicy(req *pb.SecurityPolicy) (securityPolicy, error) {
+	p := defaultPolicy()
+	if req == nil {
+		return p, nil
+	}
+	if req.QberThreshold != 0 {
+		p.QBERThreshold = req.QberThreshold
+	}
+	if req.Epsilon != 0 {
+		p.Epsilon = req.Epsilon
+	}
+	p.MinSiftedBits = int(req.MinSiftedBits)
+	p.MinKeyBits = int(req.MinKeyBits)
+
+	switch {
+	case p.QBERThreshold <= 0 || p.QBERThreshold >= 0.5:
+		return p, fmt.Errorf("qber_threshold must be between 0 and 0.5")
+	case p.Epsilon <= 0 || p.Epsilon > maxEpsilon:
+		return p, fmt.Errorf("epsilon must be in (0, %g]", maxEpsilon)
+	case p.MinSiftedBits < 0 || p.MinKeyBits < 0:
+		return p, fmt.Errorf("min_sifted_bits and min_key_bits must not be negative")
+	}
+	return p, nil
+}
+
+func (p securityPolicy) proto() *pb.SecurityPolicy {
+	return &pb.SecurityPolicy{
+		QberThreshold: p.QBERThreshold,
+		MinSiftedBits: int32(p.MinSiftedBits),
+		MinKeyBits:    int32(p.MinKeyBits),
+		Epsilon:       p.Epsilon,
+	}
+}
+
+// policy is the session's policy; sessions stored before policies existed
+// get the default
+func (session *BB84Session) policy() securityPolicy {
+	if session.Policy.QBERThreshold == 0 {
+		return defaultPolicy()
+	}
+	return session.Policy
+}
+
+// violations lists every rule a reconciled session breaks
+func (p securityPolicy) violations(qber float64, sifted, finiteKeyBits int) []string {
+	var broken []string
+	if qber >= p.QBERThreshold {
+		broken = append(broken, fmt.Sprintf("QBER %.2f%% is not below the %.2f%% threshold", qber*100, p.QBERThreshold*100))
+	}
+	if sifted < p.MinSiftedBits {
+		broken = append(broken, fmt.Sprintf("%d sifted bits, %d required", sifted, p.MinSiftedBits))
+	}
+	if finiteKeyBits < p.MinKeyBits {
+		broken = append(broken, fmt.Sprintf("%d secure key bits, %d required", finiteKeyBits, p.MinKeyBits))
+	}
+	return broken
+}
+
+// finiteKeyBits is how many ε-secure bits privacy amplification can keep
+// from keyBits raw bits when the QBER was measured on checked bits. The
+// phase error is bounded from above by Hoeffding's inequality, error
+// correction leaks f·h(Q) per bit, and the leftover hash lemma costs
+// 2 log2(1/ε) more; ε is split evenly between estimation and hashing.
+func finiteKeyBits(keyBits, checked int, qber, epsilon float64) int {
+	if keyBits == 0 || checked == 0 {
+		return 0
+	}
+	mu := math.Sqrt(math.Log(2/epsilon) / (2 * float64(checked)))
+	phase := math.Min(0.5, qber+mu)
+	n := float64(keyBits)
+	length := n*(1-binaryEntropy(phase)) - n*errorCorrectionEfficiency*binaryEntropy(qber) - 2*math.Log2(2/epsilon)
+	return max(0, int(math.Floor(length)))
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+	engine "github.com/perclft/QubitEngine/modules/crypto/generated/engine"
+)
+
+const (
+	maxRandomBytes = 65536
+	qrngPoolBytes  = 1024
+	qrngQubits     = 20
+	// qrngBlockBits raw engine bits are conditioned into each 32-byte block
+	qrngBlockBits = 256
+
+	// assessedEntropy is the min-entropy per raw bit the test cutoffs
+	// assume; healthAlpha is each test's false-alarm probability
+	assessedEntropy = 0.9
+	healthAlpha     = 1.0 / (1 << 20)
+	aptWindow       = 1024
+	// recoveryWindows clean adaptive-proportion windows end degraded mode
+	// (including the start-up tests)
+	recoveryWindows = 2
+	healthInterval  = 5 * time.Second
+)
+
+// QRNGServer serves random bytes that mix engine measurements of |+⟩
+// qubits with crypto/rand. Every raw engine bit goes through the
+// SP800-90B continuous health tests; while they are failing (or the engine
+// is down) the service is degraded and serves crypto/rand alone.
+type QRNGServer struct {
+	pb.UnimplementedQuantumRNGServer
+	engineClient engine.QuantumComputeClient
+
+	mu             sync.Mutex
+	health         *healthTester
+	pool           []byte // Conditioned output from healthy samples
+	degraded       bool
+	degradedReason string
+	cleanWindows   int
+	stats          entropyStats
+}
+
+type entropyStats struct {
+	rawBits, ones  int64
+	rctFailures    int64
+	aptFailures    int64
+	engineErrors   int64
+	bytesServed    int64
+	lastFailure    time.Time
+	windowHadFault bool
+}
+
+func NewQRNGServer(engineClient engine.QuantumComputeClient) *QRNGServer {
+	return &QRNGServer{
+		engineClient:   engineClient,
+		health:         newHealthTester(assessedEntropy),
+		degraded:       true,
+		degradedReason: "start-up health tests pending",
+	}
+}
+
+// GetRandom returns num_bytes of conditioned output, from the pool when
+// it has enough
+func (q *QRNGServer) GetRandom(ctx context.Context, req *pb.RandomRequest) (*pb.RandomBytes, error) {
+	n := int(req.NumBytes)
+	if n <= 0 || n > maxRandomBytes {
+		return nil, fmt.Errorf("num_bytes must be 1-%d", maxRandomBytes)
+	}
+	if req.RequireHealthy {
+		q.mu.Lock()
+		degraded, reason := q.degraded, q.degradedReason
+		q.mu.Unlock()
+		if degraded {
+			return nil, fmt.Errorf("QRNG is degraded: %s", reason)
+		}
+	}
+
+	data, degraded, err := q.read(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	if req.RequireHealthy && degraded {
+		return nil, fmt.Errorf("QRNG degraded while serving the request")
+	}
+	return &pb.RandomBytes{
+		Data:     data,
+		Degraded: degraded,
+		Source:   qrngSource(degraded),
+	}, nil
+}
+
+// GetEntropyReport summarises the health tests and the raw bits seen
+func (q *QRNGServer) GetEntropyReport(ctx context.Context, req *pb.EntropyReportRequest) (*pb.EntropyReport, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	report := &pb.EntropyReport{
+		Degraded:           q.degraded,
+		DegradedReason:     q.degradedReason,
+		RawBits:            q.stats.rawBits,
+		AssessedMinEntropy: assessedEntropy,
+		RepetitionCutoff:   int32(q.health.rctCutoff),
+		AdaptiveCutoff:     int32(q.health.aptCutoff),
+		AdaptiveWindow:     aptWindow,
+		RepetitionFailures: q.stats.rctFailures,
+		AdaptiveFailures:   q.stats.aptFailures,
+		EngineErrors:       q.stats.engineErrors,
+		PoolBytes:          int32(len(q.pool)),
+		BytesServed:        q.stats.bytesServed,
+	}
+	if !q.stats.lastFailure.IsZero() {
+		report.LastFailureAt = q.stats.lastFailure.Unix()
+	}
+	if n := q.stats.rawBits; n > 1 {
+		report.OnesFraction = float64(q.stats.ones) / float64(n)
+		report.MinEntropyEstimate = mostCommonValueEntropy(q.stats.ones, n)
+	}
+	return report, nil
+}
+
+// Run keeps the pool topped up. Once it is full, or while degraded, it
+// tests a fresh window every so often so that degraded mode is entered
+// and left without waiting for traffic.
+func (q *QRNGServer) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		q.mu.Lock()
+		full, degraded := len(q.pool) >= qrngPoolBytes, q.degraded
+		q.mu.Unlock()
+
+		idle := full
+		if full || degraded {
+			raw, err := q.sampleEngine(ctx, aptWindow)
+			if err != nil {
+				q.engineFailed(err)
+				idle = true
+			} else if !q.test(raw) {
+				idle = true
+			}
+		} else {
+			block, healthy, err := q.block(ctx)
+			if err != nil {
+				log.Printf("🎲 QRNG refill failed: %v", err)
+				idle = true
+			} else if healthy {
+				q.mu.Lock()
+				if !q.degraded {
+					q.pool = append(q.pool, block...)
+				}
+				q.mu.Unlock()
+			}
+		}
+
+		if idle {
+			select {
+			case <-time.After(healthInterval):
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+// read drains the pool and conditions fresh blocks for the rest; the
+// result is degraded if any block came from crypto/rand alone
+func (q *QRNGServer) read(ctx context.Context, n int) ([]byte, bool, error) {
+	out := make([]byte, 0, n)
+	q.mu.Lock()
+	take := min(n, len(q.pool))
+	out = append(out, q.pool[:take]...)
+	q.pool = q.pool[take:]
+	q.mu.Unlock()
+
+	degraded := false
+	for len(out) < n {
+		block, healthy, err := q.block(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		degraded = degraded || !healthy
+		out = append(out, block[:min(len(block), n-len(out))]...)
+	}
+
+	q.mu.Lock()
+	q.stats.bytesServed += int64(n)
+	q.mu.Unlock()
+	return out, degraded, nil
+}
+
+// block produces 32 bytes: SHA-256 of health-tested engine bits and
+// crypto/rand, or crypto/rand alone when the engine side is not healthy
+func (q *QRNGServer) block(ctx context.Context) ([]byte, bool, error) {
+	osBytes := make([]byte, sha256.Size)
+	if _, err := cryptorand.Read(osBytes); err != nil {
+		return nil, false, fmt.Errorf("crypto/rand: %v", err)
+	}
+
+	q.mu.Lock()
+	degraded := q.degraded
+	q.mu.Unlock()
+	if degraded {
+		return osBytes, false, nil
+	}
+
+	raw, err := q.sampleEngine(ctx, qrngBlockBits)
+	if err != nil {
+		q.engineFailed(err)
+		return osBytes, false, nil
+	}
+	if !q.test(raw) {
+		return osBytes, false, nil
+	}
+
+	h := sha256.New()
+	h.Write(packBits(raw))
+	h.Write(osBytes)
+	return h.Sum(nil), true, nil
+}
+
+// sampleEngine measures n |+⟩ qubits, qrngQubits per circuit, one bit
+// per byte
+func (q *QRNGServer) sampleEngine(ctx context.Context, n int) ([]byte, error) {
+	bits := make([]byte, n)
+	for i := 0; i < n; i += qrngQubits {
+		k := min(qrngQubits, n-i)
+		ops := make([]*engine.GateOperation, 0, 2*k)
+		for j := 0; j < k; j++ {
+			ops = append(ops,
+				&engine.GateOperation{Type: engine.GateOperation_HADAMARD, TargetQubit: uint32(j)},
+				&engine.GateOperation{Type: engine.GateOperation_MEASURE, TargetQubit: uint32(j), ClassicalRegister: uint32(j)})
+		}
+		resp, err := q.engineClient.RunCircuit(ctx, &engine.CircuitRequest{
+			NumQubits:  int32(k),
+			Operations: ops,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("engine error: %v", err)
+		}
+		for j := 0; j < k; j++ {
+			if resp.ClassicalResults[uint32(j)] {
+				bits[i+j] = 1
+			}
+		}
+	}
+	return bits, nil
+}
+
+// test runs the raw bits through the health tests and updates the
+// degraded state; it reports whether they all passed
+func (q *QRNGServer) test(raw []byte) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	passed := true
+	for _, bit := range raw {
+		q.stats.rawBits++
+		q.stats.ones += int64(bit)
+
+		rctFail, aptFail, windowDone := q.health.feed(bit)
+		if rctFail || aptFail {
+			passed = false
+			q.stats.windowHadFault = true
+			q.stats.lastFailure = time.Now()
+			reason := "repetition count test failed"
+			if rctFail {
+				q.stats.rctFailures++
+			} else {
+				q.stats.aptFailures++
+				reason = "adaptive proportion test failed"
+			}
+			q.degrade(reason)
+		}
+		if windowDone {
+			if q.degraded && !q.stats.windowHadFault {
+				q.cleanWindows++
+				if q.cleanWindows >= recoveryWindows {
+					log.Printf("🎲 QRNG healthy after %d clean windows", q.cleanWindows)
+					q.degraded, q.degradedReason = false, ""
+				}
+			}
+			q.stats.windowHadFault = false
+		}
+	}
+	return passed
+}
+
+func (q *QRNGServer) engineFailed(err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.stats.engineErrors++
+	// Bits either side of the outage are not one continuous stream
+	q.health.reset()
+	q.stats.windowHadFault = false
+	q.degrade("engine unavailable: " + err.Error())
+}
+
+// degrade enters degraded mode, dropping pooled output that the failing
+// samples may have contributed to. Callers hold q.mu.
+func (q *QRNGServer) degrade(reason string) {
+	if !q.degraded || q.degradedReason != reason {
+		log.Printf("🎲 QRNG degraded: %s", reason)
+	}
+	q.degraded, q.degradedReason = true, reason
+	q.cleanWindows = 0
+	q.pool = nil
+}
+
+func qrngSource(degraded bool) string {
+	if degraded {
+		return "crypto/rand (QRNG degraded)"
+	}
+	return "engine ⊕ crypto/rand (SHA-256 conditioned)"
+}
+
+func packBits(bits []byte) []byte {
+	packed := make([]byte, (len(bits)+7)/8)
+	for i, bit := range bits {
+		packed[i/8] |= bit << (7 - i%8)
+	}
+	return packed
+}
+
+// ------------------------------------------------------------------
+// SP800-90B §4.4 continuous health tests on binary samples
+// ------------------------------------------------------------------
+
+type healthTester struct {
+	rctCutoff, aptCutoff int
+
+	last    byte
+	run     int
+	aptRef  byte
+	aptHits int
+	aptSeen int
+}
+
+func newHealthTester(minEntropy float64) *healthTester {
+	return &healthTester{
+		rctCutoff: 1 + int(math.Ceil(-math.Log2(healthAlpha)/minEntropy)),
+		aptCutoff: 1 + critBinom(aptWindow, math.Exp2(-minEntropy), 1-healthAlpha),
+	}
+}
+
+// feed tests one sample. Each failure is reported once: when a run
+// reaches the repetition cutoff, or a window's count of its first sample
+// reaches the adaptive proportion cutoff.
+func (t *healthTester) feed(bit byte) (rctFail, aptFail, windowDone bool) {
+	if t.run > 0 && bit == t.last {
+		t.run++
+	} else {
+		t.last, t.run = bit, 1
+	}
+	rctFail = t.run == t.rctCutoff
+
+	if t.aptSeen == 0 {
+		t.aptRef = bit
+	}
+	if bit == t.aptRef {
+		t.aptHits++
+		aptFail = t.aptHits == t.aptCutoff
+	}
+	t.aptSeen++
+	if t.aptSeen == aptWindow {
+		t.aptSeen, t.aptHits = 0, 0
+		windowDone = true
+	}
+	return rctFail, aptFail, windowDone
+}
+
+func (t *healthTester) reset() {
+	t.run, t.aptSeen, t.aptHits = 0, 0, 0
+}
+
+// critBinom is the smallest k with P(X ≤ k) ≥ q for X ~ Binomial(n, p)
+func critBinom(n int, p, q float64) int {
+	lgN, _ := math.Lgamma(float64(n + 1))
+	cdf := 0.0
+	for k := 0; k <= n; k++ {
+		lgK, _ := math.Lgamma(float64(k + 1))
+		lgNK, _ := math.Lgamma(float64(n - k + 1))
+		cdf += math.Exp(lgN - lgK - lgNK + float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+		if cdf >= q {
+			return k
+		}
+	}
+	return n
+}
+
+// mostCommonValueEntropy is the SP800-90B §6.3.1 min-entropy estimate:
+// the upper 99% bound on the most common value's probability
+func mostCommonValueEntropy(ones, n int64) float64 {
+	pHat := float64(max(ones, n-ones)) / float64(n)
+	pu := math.Min(1, pHat+2.576*math.Sqrt(pHat*(1-pHat)/float64(n-1)))
+	return math.Max(0, -math.Log2(pu))
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+const (
+	maxSessionTTL = 24 * time.Hour
+	reapInterval  = time.Minute
+)
+
+// reapSessions deletes expired sessions until ctx is cancelled
+func (s *CryptoServer) reapSessions(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			n, err := s.sessions.Reap(ctx, now)
+			if err != nil {
+				log.Printf("🔐 Session reaper failed: %v", err)
+			} else if n > 0 {
+				log.Printf("🔐 Reaped %d expired sessions", n)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// abortSession marks the session unusable; later reconciliation and
+// eavesdrop checks report the stored reason
+func (s *CryptoServer) abortSession(ctx context.Context, sessionID, reason string) error {
+	log.Printf("🔐 Aborted session %s: %s", sessionID, reason)
+	return s.sessions.Update(ctx, sessionID, func(session *BB84Session) error {
+		session.Aborted = true
+		session.AbortReason = reason
+		return nil
+	})
+}
+
+func (session *BB84Session) state() pb.SessionState {
+	switch {
+	case session.Aborted:
+		return pb.SessionState_SESSION_ABORTED
+	case session.Reconciled:
+		return pb.SessionState_SESSION_RECONCILED
+	case session.BobMeasures != nil:
+		return pb.SessionState_SESSION_MEASURED
+	}
+	return pb.SessionState_SESSION_PREPARED
+}
+
+func (session *BB84Session) status() *pb.SessionStatus {
+	disclosed := 0
+	for _, d := range session.Disclosed {
+		if d {
+			disclosed++
+		}
+	}
+	status := &pb.SessionStatus{
+		SessionId:     session.ID,
+		State:         session.state(),
+		Protocol:      session.Protocol,
+		NumBits:       int32(len(session.AliceBits)),
+		DisclosedBits: int32(disclosed),
+		Authenticated: session.AuthKey != nil,
+		Decoy:         session.Decoy != nil,
+		AbortReason:   session.AbortReason,
+	}
+	if !session.CreatedAt.IsZero() {
+		status.CreatedAt = session.CreatedAt.Unix()
+	}
+	if !session.ExpiresAt.IsZero() {
+		status.ExpiresAt = session.ExpiresAt.Unix()
+	}
+	return status
+}
+
+// ListSessions returns live sessions, oldest first
+func (s *CryptoServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.SessionList, error) {
+	sessions, err := s.sessions.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].CreatedAt.Equal(sessions[j].CreatedAt) {
+			return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+		}
+		return sessions[i].ID < sessions[j].ID
+	})
+
+	list := &pb.SessionList{}
+	for _, session := range sessions {
+		if session.Aborted && !req.IncludeAborted {
+			continue
+		}
+		list.Sessions = append(list.Sessions, session.status())
+	}
+	return list, nil
+}
+
+func (s *CryptoServer) GetSessionStatus(ctx context.Context, req *pb.SessionStatusRequest) (*pb.SessionStatus, error) {
+	session, err := s.sessions.Get(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	return session.status(), nil
+}
+
+// AbortSession lets an operator stop a stuck or suspect exchange; the
+// session is kept, aborted, until it expires
+func (s *CryptoServer) AbortSession(ctx context.Context, req *pb.AbortSessionRequest) (*pb.SessionStatus, error) {
+	reason := req.Reason
+	if reason == "" {
+		reason = "aborted by operator"
+	}
+	if err := s.abortSession(ctx, req.SessionId, reason); err != nil {
+		return nil, fmt.Errorf("abort %s: %v", req.SessionId, err)
+	}
+	return s.GetSessionStatus(ctx, &pb.SessionStatusRequest{SessionId: req.SessionId})
+}
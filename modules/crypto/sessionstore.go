@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var errSessionNotFound = errors.New("session not found")
+
+// SessionStore holds in-flight key exchanges until their ExpiresAt. Update
+// is a read-modify-write that either applies fn to the latest state or
+// fails; fn may run more than once when another replica wins a race.
+// Reap deletes expired sessions where the backend does not do so itself.
+type SessionStore interface {
+	Get(ctx context.Context, id string) (*BB84Session, error)
+	Put(ctx context.Context, session *BB84Session) error
+	Update(ctx context.Context, id string, fn func(*BB84Session) error) error
+	List(ctx context.Context) ([]*BB84Session, error)
+	Reap(ctx context.Context, now time.Time) (int, error)
+}
+
+func (session *BB84Session) expired(now time.Time) bool {
+	return !session.ExpiresAt.IsZero() && now.After(session.ExpiresAt)
+}
+
+// clone copies the session so callers never share slices with the store
+func (session *BB84Session) clone() *BB84Session {
+	c := *session
+	c.AliceBits = slices.Clone(session.AliceBits)
+	c.AliceBases = slices.Clone(session.AliceBases)
+	c.BobBases = slices.Clone(session.BobBases)
+	c.BobMeasures = slices.Clone(session.BobMeasures)
+	c.SharedKey = slices.Clone(session.SharedKey)
+	c.Disclosed = slices.Clone(session.Disclosed)
+	c.AuthKey = slices.Clone(session.AuthKey)
+	c.PulseLevels = slices.Clone(session.PulseLevels)
+	if session.Decoy != nil {
+		decoy := *session.Decoy
+		decoy.Levels = slices.Clone(session.Decoy.Levels)
+		c.Decoy = &decoy
+	}
+	return &c
+}
+
+// ------------------------------------------------------------------
+// In-memory store (single replica)
+// ------------------------------------------------------------------
+
+type memorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*BB84Session
+}
+
+func newMemorySessionStore() *memorySessionStore {
+	return &memorySessionStore{sessions: make(map[string]*BB84Session)}
+}
+
+func (m *memorySessionStore) Get(ctx context.Context, id string) (*BB84Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[id]
+	if !ok || session.expired(time.Now()) {
+		return nil, errSessionNotFound
+	}
+	return session.clone(), nil
+}
+
+func (m *memorySessionStore) Put(ctx context.Context, session *BB84Session) error {
+	m.mu.Lock()
+	m.sessions[session.ID] = session.clone()
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *memorySessionStore) Update(ctx context.Context, id string, fn func(*BB84Session) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[id]
+	if !ok || session.expired(time.Now()) {
+		return errSessionNotFound
+	}
+	updated := session.clone()
+	if err := fn(updated); err != nil {
+		return err
+	}
+	m.sessions[id] = updated
+	return nil
+}
+
+func (m *memorySessionStore) List(ctx context.Context) ([]*BB84Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	var sessions []*BB84Session
+	for _, session := range m.sessions {
+		if !session.expired(now) {
+			sessions = append(sessions, session.clone())
+		}
+	}
+	return sessions, nil
+}
+
+func (m *memorySessionStore) Reap(ctx context.Context, now time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reaped := 0
+	for id, session := range m.sessions {
+		if session.expired(now) {
+			delete(m.sessions, id)
+			reaped++
+		}
+	}
+	return reaped, nil
+}
+
+// ------------------------------------------------------------------
+// Redis store (shared by replicas)
+// Sessions are JSON under crypto:session:<id>, and Redis expires the key
+// at the session's ExpiresAt; updates WATCH the key, so a concurrent write
+// aborts the transaction and the update is retried against the new state.
+// ------------------------------------------------------------------
+
+const maxUpdateRetries = 10
+
+const sessionKeyPrefix = "crypto:session:"
+
+type redisSessionStore struct {
+	rdb *redis.Client
+}
+
+func newRedisSessionStore(rdb *redis.Client) *redisSessionStore {
+	return &redisSessionStore{rdb: rdb}
+}
+
+func sessionKey(id string) string {
+	return sessionKeyPrefix + id
+}
+
+func (r *redisSessionStore) Get(ctx context.Context, id string) (*BB84Session, error) {
+	data, err := r.rdb.Get(ctx, sessionKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, errSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis error: %v", err)
+	}
+	var session BB84Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("corrupt session %s: %v", id, err)
+	}
+	return &session, nil
+}
+
+func (r *redisSessionStore) Put(ctx context.Context, session *BB84Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	var ttl time.Duration // 0 keeps the key until deleted
+	if !session.ExpiresAt.IsZero() {
+		if ttl = time.Until(session.ExpiresAt); ttl <= 0 {
+			return fmt.Errorf("session %s has already expired", session.ID)
+		}
+	}
+	if err := r.rdb.Set(ctx, sessionKey(session.ID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("redis error: %v", err)
+	}
+	return nil
+}
+
+func (r *redisSessionStore) Update(ctx context.Context, id string, fn func(*BB84Session) error) error {
+	key := sessionKey(id)
+	for attempt := 0; attempt < maxUpdateRetries; attempt++ {
+		err := r.rdb.Watch(ctx, func(tx *redis.Tx) error {
+			data, err := tx.Get(ctx, key).Bytes()
+			if err == redis.Nil {
+				return errSessionNotFound
+			}
+			if err != nil {
+				return err
+			}
+			var session BB84Session
+			if err := json.Unmarshal(data, &session); err != nil {
+				return fmt.Errorf("corrupt session %s: %v", id, err)
+			}
+			if err := fn(&session); err != nil {
+				return err
+			}
+			updated, err := json.Marshal(&session)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Set(ctx, key, updated, redis.KeepTTL)
+				return nil
+			})
+			return err
+		}, key)
+		if err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return fmt.Errorf("session %s is being updated concurrently; try again", id)
+}
+
+func (r *redisSessionStore) List(ctx context.Context) ([]*BB84Session, error) {
+	var sessions []*BB84Session
+	iter := r.rdb.Scan(ctx, 0, sessionKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		session, err := r.Get(ctx, strings.TrimPrefix(iter.Val(), sessionKeyPrefix))
+		if err == errSessionNotFound {
+			continue // Expired between SCAN and GET
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("redis error: %v", err)
+	}
+	return sessions, nil
+}
+
+// Reap has nothing to do: Redis expires the keys
+func (r *redisSessionStore) Reap(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
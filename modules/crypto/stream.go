@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	pb "github.com/perclft/QubitEngine/modules/crypto/generated/crypto"
+)
+
+const (
+	defaultStreamBatch  = 256
+	maxStreamBatch      = 4096
+	defaultStreamWindow = 4
+	maxStreamWindow     = 64
+	maxStreamBits       = 1 << 20
+)
+
+// batchResult is Bob's side of one simulated batch
+type batchResult struct {
+	bases    []pb.Basis
+	measures []int32
+	err      error
+}
+
+// StreamBB84 runs a large exchange batch by batch. Up to window batches
+// are simulated concurrently ahead of the client; each sent batch uses one
+// credit, and acks return credit.
+func (s *CryptoServer) StreamBB84(stream pb.QuantumCrypto_StreamBB84Server) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := first.GetStart()
+	if start == nil {
+		return fmt.Errorf("first message must be start")
+	}
+	numBits := int(start.NumBits)
+	if numBits <= 0 || numBits > maxStreamBits {
+		return fmt.Errorf("num_bits must be 1-%d", maxStreamBits)
+	}
+	batchSize := int(start.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultStreamBatch
+	}
+	window := int(start.Window)
+	if window == 0 {
+		window = defaultStreamWindow
+	}
+	if batchSize < 1 || batchSize > maxStreamBatch || window < 1 || window > maxStreamWindow {
+		return fmt.Errorf("batch_size must be 1-%d and window 1-%d", maxStreamBatch, maxStreamWindow)
+	}
+	if err := validateAuthKey(start.AuthKey); err != nil {
+		return err
+	}
+	policy, err := newSecurityPolicy(start.Policy)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.newSession(ctx, start.SessionId, numBits, start.EavesdropProbability, start.Protocol)
+	if err != nil {
+		return err
+	}
+	session.AuthKey = start.AuthKey
+	session.Policy = policy
+	if err := s.sessions.Put(ctx, session); err != nil {
+		return err
+	}
+	sent, sentBases := session.preparation()
+
+	// Acks arrive concurrently; a closed client side means unlimited credit
+	credit := make(chan int, maxStreamWindow)
+	unlimited := make(chan struct{})
+	go func() {
+		defer close(unlimited)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if n := int(msg.GetAck().GetBatches()); n > 0 {
+				select {
+				case credit <- n:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	// Each batch is simulated as soon as a slot in the window frees up
+	numBatches := (numBits + batchSize - 1) / batchSize
+	results := make([]chan batchResult, numBatches)
+	for b := range results {
+		results[b] = make(chan batchResult, 1)
+	}
+	slots := make(chan struct{}, window)
+	go func() {
+		for b := 0; b < numBatches; b++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(b int) {
+				lo, hi := b*batchSize, min((b+1)*batchSize, numBits)
+				random, _, err := s.qrngBits(ctx, hi-lo)
+				if err != nil {
+					results[b] <- batchResult{err: err}
+					return
+				}
+				bases := make([]pb.Basis, hi-lo)
+				for i, r := range random {
+					bases[i] = pb.Basis(r)
+				}
+				measures, err := s.transmitBB84(ctx, sent[lo:hi], sentBases[lo:hi], bases, session.EveProb)
+				results[b] <- batchResult{bases: bases, measures: measures, err: err}
+			}(b)
+		}
+	}()
+
+	log.Printf("🔐 Streaming %s session %s: %d bits in %d batches (window %d)",
+		start.Protocol, start.SessionId, numBits, numBatches, window)
+
+	available := window
+	bobBases := make([]pb.Basis, 0, numBits)
+	bobMeasures := make([]int32, 0, numBits)
+	for b := 0; b < numBatches; b++ {
+		for available == 0 {
+			select {
+			case n := <-credit:
+				available += n
+			case <-unlimited:
+				available = numBatches
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		var r batchResult
+		select {
+		case r = <-results[b]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if r.err != nil {
+			return r.err
+		}
+		bobBases = append(bobBases, r.bases...)
+		bobMeasures = append(bobMeasures, r.measures...)
+
+		last := b == numBatches-1
+		var aliceMAC, bobMAC []byte
+		if last {
+			// Store Bob's side before the client can reconcile
+			err := s.sessions.Update(ctx, start.SessionId, func(session *BB84Session) error {
+				session.BobBases = bobBases
+				session.BobMeasures = bobMeasures
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			// The tags cover the whole session's announcements
+			session.BobBases, session.BobMeasures = bobBases, bobMeasures
+			aliceMAC, bobMAC = session.aliceMAC(), session.bobMAC()
+		}
+
+		lo, hi := b*batchSize, min((b+1)*batchSize, numBits)
+		err := stream.Send(&pb.BB84StreamBatch{
+			SessionId:       start.SessionId,
+			Index:           int32(b),
+			Offset:          int32(lo),
+			AliceBits:       session.AliceBits[lo:hi],
+			AliceBases:      session.AliceBases[lo:hi],
+			BobBases:        r.bases,
+			BobMeasurements: r.measures,
+			Last:            last,
+			AliceBasesMac:   aliceMAC,
+			BobMac:          bobMAC,
+		})
+		if err != nil {
+			return err
+		}
+		available--
+		<-slots
+	}
+
+	log.Printf("🔐 Streamed session %s: %d bits", start.SessionId, numBits)
+	return nil
+}
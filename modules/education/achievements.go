@@ -0,0 +1,356 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+const (
+	// passPercent is the quiz score that counts toward streaks
+	passPercent   = 70
+	maxEventCount = 10000
+)
+
+// badge unlocks once a learner's counter reaches the goal
+type badge struct {
+	ID          string
+	Name        string
+	Emoji       string
+	Description string
+	Counter     string
+	Goal        int
+}
+
+var badges = []badge{
+	{"first_bell", "Spooky Action", "🔗", "Prepare your first Bell state", "bell_state", 1},
+	{"circuit_builder", "Circuit Builder", "🛠️", "Build 5 circuits that reach their target state", "circuit_built", 5},
+	{"first_quiz", "First Steps", "📝", "Pass a quiz", "quiz_passed", 1},
+	{"perfect_quiz", "Flawless", "💯", "Score full marks on a quiz", "quiz_perfect", 1},
+	{"quiz_streak_3", "On a Roll", "🔥", "Pass 3 quizzes in a row", "best_quiz_streak", 3},
+	{"quiz_streak_10", "Unstoppable", "☄️", "Pass 10 quizzes in a row", "best_quiz_streak", 10},
+	{"daily_streak_7", "Habit Forming", "📅", "Finish a quiz 7 days in a row", "best_daily_streak", 7},
+	{"collapse_100", "Wave Function Wrecker", "💥", "Collapse 100 superpositions", "superposition_collapsed", 100},
+	{"oracle_10", "Seeker", "🎱", "Consult the oracle 10 times", "oracle_consulted", 10},
+	{"lessons_5", "Bookworm", "📖", "Finish 5 lessons", "lesson_completed", 5},
+	{"challenges_3", "Problem Solver", "🧩", "Solve 3 circuit challenges", "challenge_solved", 3},
+}
+
+// externalEvents are the kinds RecordEvent accepts. Education counts its
+// own activity where it happens, so learners cannot report it themselves.
+var externalEvents = map[string]bool{
+	"superposition_collapsed": true,
+	"oracle_consulted":        true,
+}
+
+// achievementEvent is n occurrences of something a badge may count
+type achievementEvent struct {
+	kind string
+	n    int
+}
+
+type learnerProgress struct {
+	Counters map[string]int         `json:"counters"`
+	Unlocked map[string]time.Time   `json:"unlocked"`
+	Lessons  map[string]time.Time   `json:"lessons"` // Finished, by lesson ID
+	Solved   map[string]time.Time   `json:"solved"`  // First solved, by challenge ID
+	Hints    map[string]int         `json:"hints"`   // Hints taken, by challenge ID
+	Reviews  map[string]*reviewCard `json:"reviews"` // Missed questions, by question ID
+
+	QuizBest     map[string]int           `json:"quiz_best"`    // Best completed quiz percent, by topic
+	Circuits     map[string]*builtCircuit `json:"circuits"`     // Best solution, by question or challenge
+	Certificates map[string]*certificate  `json:"certificates"` // By track ID
+
+	QuizWeeks map[string]*quizWeek `json:"quiz_weeks"` // Leaderboard weeks, by ISO week
+	Streak    quizStreak           `json:"quiz_streak"`
+	Groups    map[string]bool      `json:"groups"` // Quizzed in, e.g. Discord guilds
+}
+
+func (p *learnerProgress) add(e achievementEvent) {
+	switch e.kind {
+	case "quiz_failed":
+		p.Counters["quiz_streak"] = 0
+	case "quiz_passed":
+		p.Counters["quiz_streak"] += e.n
+		p.Counters["best_quiz_streak"] = max(p.Counters["best_quiz_streak"], p.Counters["quiz_streak"])
+	}
+	p.Counters[e.kind] += e.n
+}
+
+func (b *badge) proto(p *learnerProgress) *pb.Badge {
+	out := &pb.Badge{
+		Id:          b.ID,
+		Name:        b.Name,
+		Emoji:       b.Emoji,
+		Description: b.Description,
+		Goal:        int32(b.Goal),
+	}
+	if p == nil {
+		return out
+	}
+	out.Progress = int32(min(p.Counters[b.Counter], b.Goal))
+	if at, ok := p.Unlocked[b.ID]; ok {
+		out.Unlocked, out.UnlockedAt = true, at.Unix()
+	}
+	return out
+}
+
+// achievementStore keeps every learner's counters, badges and finished
+// lessons, saved to a JSON file when one is configured
+type achievementStore struct {
+	path     string
+	mu       sync.Mutex
+	learners map[string]*learnerProgress
+}
+
+func newAchievementStore(path string) (*achievementStore, error) {
+	as := &achievementStore{path: path, learners: make(map[string]*learnerProgress)}
+	if path == "" {
+		return as, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return as, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &as.learners); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return as, nil
+}
+
+// record applies events to a learner and returns the badges they unlocked
+func (as *achievementStore) record(userID string, events ...achievementEvent) []*pb.Badge {
+	if userID == "" || len(events) == 0 {
+		return nil
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	return as.recordLocked(userID, events)
+}
+
+// learner returns a learner's progress, creating it; callers hold as.mu
+func (as *achievementStore) learner(userID string) *learnerProgress {
+	p, ok := as.learners[userID]
+	if !ok {
+		p = &learnerProgress{Counters: make(map[string]int), Unlocked: make(map[string]time.Time)}
+		as.learners[userID] = p
+	}
+	if p.Lessons == nil {
+		p.Lessons = make(map[string]time.Time)
+	}
+	if p.Solved == nil {
+		p.Solved = make(map[string]time.Time)
+	}
+	if p.Hints == nil {
+		p.Hints = make(map[string]int)
+	}
+	if p.Reviews == nil {
+		p.Reviews = make(map[string]*reviewCard)
+	}
+	if p.QuizBest == nil {
+		p.QuizBest = make(map[string]int)
+	}
+	if p.Circuits == nil {
+		p.Circuits = make(map[string]*builtCircuit)
+	}
+	if p.Certificates == nil {
+		p.Certificates = make(map[string]*certificate)
+	}
+	if p.QuizWeeks == nil {
+		p.QuizWeeks = make(map[string]*quizWeek)
+	}
+	if p.Groups == nil {
+		p.Groups = make(map[string]bool)
+	}
+	return p
+}
+
+// recordLocked is record for callers holding as.mu
+func (as *achievementStore) recordLocked(userID string, events []achievementEvent) []*pb.Badge {
+	p := as.learner(userID)
+	for _, e := range events {
+		p.add(e)
+	}
+
+	var unlocked []*pb.Badge
+	now := time.Now()
+	for i := range badges {
+		b := &badges[i]
+		if _, ok := p.Unlocked[b.ID]; ok || p.Counters[b.Counter] < b.Goal {
+			continue
+		}
+		p.Unlocked[b.ID] = now
+		unlocked = append(unlocked, b.proto(p))
+		log.Printf("📚 %q unlocked %s %s", userID, b.Emoji, b.Name)
+	}
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save achievements: %v", err)
+	}
+	return unlocked
+}
+
+// completeLesson marks a lesson finished, once, and returns the badges
+// that unlocked
+func (as *achievementStore) completeLesson(userID, lessonID string) []*pb.Badge {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if _, ok := p.Lessons[lessonID]; ok {
+		return nil
+	}
+	p.Lessons[lessonID] = time.Now()
+	return as.recordLocked(userID, []achievementEvent{{"lesson_completed", 1}})
+}
+
+// solveChallenge marks a challenge solved, once, and returns the badges
+// that unlocked
+func (as *achievementStore) solveChallenge(userID, challengeID string) []*pb.Badge {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if _, ok := p.Solved[challengeID]; ok {
+		return nil
+	}
+	p.Solved[challengeID] = time.Now()
+	return as.recordLocked(userID, []achievementEvent{{"challenge_solved", 1}})
+}
+
+// completedLessons is a copy of when a learner finished each lesson
+func (as *achievementStore) completedLessons(userID string) map[string]time.Time {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	done := make(map[string]time.Time)
+	if p, ok := as.learners[userID]; ok {
+		for id, at := range p.Lessons {
+			done[id] = at
+		}
+	}
+	return done
+}
+
+func (as *achievementStore) list(userID string) []*pb.Badge {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learners[userID]
+	out := make([]*pb.Badge, len(badges))
+	for i := range badges {
+		out[i] = badges[i].proto(p)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Unlocked != out[j].Unlocked {
+			return out[i].Unlocked
+		}
+		return out[i].UnlockedAt > out[j].UnlockedAt
+	})
+	return out
+}
+
+// save writes the store atomically; callers hold as.mu
+func (as *achievementStore) save() error {
+	if as.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(as.learners, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(as.path), ".achievements-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), as.path)
+}
+
+// isBellState reports whether a two-qubit state is one of the four Bell
+// states, up to global phase
+func isBellState(state []complex128) bool {
+	if len(state) != 4 {
+		return false
+	}
+	r := complex(1/math.Sqrt2, 0)
+	for _, bell := range [][]complex128{
+		{r, 0, 0, r}, {r, 0, 0, -r}, {0, r, r, 0}, {0, r, -r, 0},
+	} {
+		if stateFidelity(bell, state) >= 1-fidelityTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// quizEvents is what a completed quiz counts toward
+func quizEvents(score, maxScore int) []achievementEvent {
+	if maxScore == 0 || score*100 < passPercent*maxScore {
+		return []achievementEvent{{"quiz_failed", 1}}
+	}
+	events := []achievementEvent{{"quiz_passed", 1}}
+	if score == maxScore {
+		events = append(events, achievementEvent{"quiz_perfect", 1})
+	}
+	return events
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *EducationServer) RecordEvent(ctx context.Context, req *pb.AchievementEvent) (*pb.EventAck, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	if !externalEvents[req.Kind] {
+		return nil, fmt.Errorf("unknown event kind %q", req.Kind)
+	}
+	n := int(req.Count)
+	if n == 0 {
+		n = 1
+	}
+	if n < 0 || n > maxEventCount {
+		return nil, fmt.Errorf("count must be 1-%d", maxEventCount)
+	}
+	unlocked := s.achievements.record(req.UserId, achievementEvent{req.Kind, n})
+	log.Printf("📚 Event from %s: %s ×%d for %q", req.Source, req.Kind, n, req.UserId)
+	return &pb.EventAck{Unlocked: unlocked}, nil
+}
+
+func (s *EducationServer) GetAchievements(ctx context.Context, req *pb.AchievementsRequest) (*pb.AchievementList, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	list := &pb.AchievementList{UserId: req.UserId, Badges: s.achievements.list(req.UserId)}
+	for _, b := range list.Badges {
+		if b.Unlocked {
+			list.UnlockedCount++
+		}
+	}
+	return list, nil
+}
+
+func (s *EducationServer) ListBadges(ctx context.Context, req *pb.Empty) (*pb.BadgeCatalog, error) {
+	catalog := &pb.BadgeCatalog{}
+	for i := range badges {
+		catalog.Badges = append(catalog.Badges, badges[i].proto(nil))
+	}
+	return catalog, nil
+}
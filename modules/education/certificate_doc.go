@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Certificates are laid out once as lines of text and drawn as a PNG or
+// a PDF. Both use monospaced type, so lines center without font metrics.
+
+type lineStyle int
+
+const (
+	styleTitle lineStyle = iota
+	styleName
+	styleBody
+	styleSmall
+)
+
+type docLine struct {
+	text  string
+	style lineStyle
+}
+
+// maxSmallChars is the longest small line either document fits
+const maxSmallChars = 90
+
+// maxDocCircuits caps the circuits listed on a document; the signed
+// certificate keeps them all
+const maxDocCircuits = 6
+
+func titleCase(name string) string {
+	words := strings.Fields(strings.ToLower(strings.ReplaceAll(name, "_", " ")))
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n-3]) + "..."
+	}
+	return s
+}
+
+// wrap breaks text at spaces into lines of at most n characters
+func wrap(text string, n int) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len([]rune(line))+1+len([]rune(word)) > n {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func certificateLines(c *certificate) []docLine {
+	lines := []docLine{
+		{"Certificate of Completion", styleTitle},
+		{"QubitEngine Quantum Education", styleBody},
+		{"", styleBody},
+		{"This certifies that", styleBody},
+		{truncate(c.name(), 40), styleName},
+		{fmt.Sprintf("has completed the %s track", c.TrackName), styleBody},
+		{"", styleSmall},
+	}
+	for _, l := range wrap("Lessons: "+strings.Join(c.LessonIDs, ", "), maxSmallChars) {
+		lines = append(lines, docLine{l, styleSmall})
+	}
+
+	var scores []string
+	for _, topic := range sortedIDs(c.Scores) {
+		scores = append(scores, fmt.Sprintf("%s %d%%", titleCase(topic), c.Scores[topic]))
+	}
+	if len(scores) == 0 {
+		scores = []string{"none recorded"}
+	}
+	for _, l := range wrap("Best quiz scores: "+strings.Join(scores, ", "), maxSmallChars) {
+		lines = append(lines, docLine{l, styleSmall})
+	}
+
+	if len(c.Circuits) > 0 {
+		lines = append(lines, docLine{"", styleSmall}, docLine{"Circuits built:", styleSmall})
+		for i, bc := range c.Circuits {
+			if i == maxDocCircuits {
+				lines = append(lines, docLine{fmt.Sprintf("and %d more", len(c.Circuits)-i), styleSmall})
+				break
+			}
+			text := bc.Title + ": " + bc.Gates
+			if bc.Score > 0 {
+				text += fmt.Sprintf(" (%d pts)", bc.Score)
+			}
+			lines = append(lines, docLine{truncate(text, maxSmallChars), styleSmall})
+		}
+	}
+
+	return append(lines,
+		docLine{"", styleSmall},
+		docLine{fmt.Sprintf("Issued %s    Verification code %s", c.IssuedAt.Format("2006-01-02"), c.Code), styleSmall},
+		docLine{"Check it with the VerifyCertificate RPC of the Education module", styleSmall},
+	)
+}
+
+// ------------------------------------------------------------------
+// PNG
+// ------------------------------------------------------------------
+
+// Pixel scale of the 7x13 bitmap font for each style
+var pngScale = map[lineStyle]int{styleTitle: 5, styleName: 4, styleBody: 3, styleSmall: 2}
+
+const (
+	pngWidth  = 1400
+	pngMargin = 90
+)
+
+var (
+	inkColor    = color.RGBA{0x1B, 0x26, 0x4F, 0xFF} // Navy
+	accentColor = color.RGBA{0x9B, 0x59, 0xB6, 0xFF} // The bot's badge purple
+)
+
+// asciiOnly swaps what the bitmap font lacks for "?"
+func asciiOnly(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return '?'
+		}
+		return r
+	}, s)
+}
+
+// drawScaled draws text centered at baseline y, each font pixel scale
+// pixels square
+func drawScaled(dst *image.RGBA, text string, y, scale int, ink color.Color) {
+	face := basicfont.Face7x13
+	text = asciiOnly(text)
+	width := font.MeasureString(face, text).Ceil()
+	if width == 0 {
+		return
+	}
+	glyphs := image.NewAlpha(image.Rect(0, 0, width, face.Height))
+	d := &font.Drawer{Dst: glyphs, Src: image.Opaque, Face: face, Dot: fixed.P(0, face.Ascent)}
+	d.DrawString(text)
+
+	x0 := (dst.Bounds().Dx() - width*scale) / 2
+	y0 := y - face.Ascent*scale
+	src := image.NewUniform(ink)
+	for gy := 0; gy < face.Height; gy++ {
+		for gx := 0; gx < width; gx++ {
+			if glyphs.AlphaAt(gx, gy).A < 0x80 {
+				continue
+			}
+			r := image.Rect(x0+gx*scale, y0+gy*scale, x0+(gx+1)*scale, y0+(gy+1)*scale)
+			draw.Draw(dst, r, src, image.Point{}, draw.Src)
+		}
+	}
+}
+
+func certificatePNG(c *certificate) ([]byte, error) {
+	lines := certificateLines(c)
+	lineHeight := func(l docLine) int { return basicfont.Face7x13.Height * pngScale[l.style] * 5 / 4 }
+	height := 2 * pngMargin
+	for _, l := range lines {
+		height += lineHeight(l)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, pngWidth, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	for i, ink := range []color.Color{inkColor, accentColor} {
+		inset := 20 + 12*i
+		border := image.Rect(inset, inset, pngWidth-inset, height-inset)
+		for _, edge := range []image.Rectangle{
+			{border.Min, image.Pt(border.Max.X, border.Min.Y+4)},
+			{image.Pt(border.Min.X, border.Max.Y-4), border.Max},
+			{border.Min, image.Pt(border.Min.X+4, border.Max.Y)},
+			{image.Pt(border.Max.X-4, border.Min.Y), border.Max},
+		} {
+			draw.Draw(img, edge, image.NewUniform(ink), image.Point{}, draw.Src)
+		}
+	}
+
+	y := pngMargin
+	for _, l := range lines {
+		y += lineHeight(l)
+		ink := color.Color(inkColor)
+		if l.style == styleName {
+			ink = accentColor
+		}
+		drawScaled(img, l.text, y, pngScale[l.style], ink)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ------------------------------------------------------------------
+// PDF
+// ------------------------------------------------------------------
+
+// Point size of each style, in Courier
+var pdfSize = map[lineStyle]float64{styleTitle: 30, styleName: 24, styleBody: 15, styleSmall: 10}
+
+const (
+	pdfWidth  = 842 // A4 landscape, in points
+	pdfHeight = 595
+	// courierAdvance is Courier's width per character, in ems
+	courierAdvance = 0.6
+)
+
+// pdfString escapes text for a PDF string in WinAnsi, which covers
+// Latin-1; anything else becomes "?"
+func pdfString(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r <= 0x7E || r >= 0xA0 && r <= 0xFF:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func certificatePDF(c *certificate) []byte {
+	var content bytes.Buffer
+	content.WriteString("q 0.106 0.149 0.31 RG 3 w 20 20 802 555 re S 0.608 0.349 0.714 RG 1.5 w 30 30 782 535 re S Q\n")
+	y := float64(pdfHeight - 80)
+	for _, l := range certificateLines(c) {
+		size := pdfSize[l.style]
+		y -= size * 1.35
+		if l.text == "" {
+			continue
+		}
+		fontName, rgb := "F1", "0.106 0.149 0.31"
+		if l.style == styleTitle || l.style == styleName {
+			fontName = "F2"
+		}
+		if l.style == styleName {
+			rgb = "0.608 0.349 0.714"
+		}
+		x := (pdfWidth - float64(len([]rune(l.text)))*size*courierAdvance) / 2
+		fmt.Fprintf(&content, "BT %s rg /%s %.0f Tf %.1f %.1f Td %s Tj ET\n", rgb, fontName, size, x, y, pdfString(l.text))
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>", pdfWidth, pdfHeight),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = pdf.Len()
+		fmt.Fprintf(&pdf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := pdf.Len()
+	fmt.Fprintf(&pdf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&pdf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&pdf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return pdf.Bytes()
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// allTopics keys quizzes drawn from every topic in QuizBest
+const allTopics = "ALL"
+
+// builtCircuit is a learner's best solution to a question or challenge
+type builtCircuit struct {
+	Source  string    `json:"source"` // "question:q6" or "challenge:ghz_four"
+	Title   string    `json:"title"`
+	Gates   string    `json:"gates"`
+	Score   int       `json:"score"`
+	BuiltAt time.Time `json:"built_at"`
+}
+
+func (c *builtCircuit) proto() *pb.BuiltCircuit {
+	return &pb.BuiltCircuit{
+		SourceId: c.Source,
+		Title:    c.Title,
+		Gates:    c.Gates,
+		Score:    int32(c.Score),
+		BuiltAt:  c.BuiltAt.Unix(),
+	}
+}
+
+// certificate is what a learner earns by finishing a track. Everything
+// but Code and Signature is signed; the code is derived from the
+// signature.
+type certificate struct {
+	Code        string         `json:"code,omitempty"`
+	UserID      string         `json:"user_id"`
+	LearnerName string         `json:"learner_name,omitempty"`
+	TrackID     string         `json:"track_id"`
+	TrackName   string         `json:"track_name"`
+	LessonIDs   []string       `json:"lesson_ids"`
+	Scores      map[string]int `json:"scores"` // Best quiz percent, by topic
+	Circuits    []builtCircuit `json:"circuits"`
+	IssuedAt    time.Time      `json:"issued_at"`
+	Signature   []byte         `json:"signature,omitempty"`
+}
+
+// payload is the certificate as signed
+func (c *certificate) payload() []byte {
+	v := *c
+	v.Code, v.Signature = "", nil
+	data, _ := json.Marshal(&v)
+	return data
+}
+
+func (c *certificate) sign(key ed25519.PrivateKey) {
+	c.Signature = ed25519.Sign(key, c.payload())
+	c.Code = certificateCode(c.Signature)
+}
+
+// verify checks the signature and that the code belongs to it
+func (c *certificate) verify(key ed25519.PublicKey) error {
+	if !ed25519.Verify(key, c.payload(), c.Signature) {
+		return errors.New("the signature does not match the certificate")
+	}
+	if c.Code != certificateCode(c.Signature) {
+		return errors.New("the code does not match the signature")
+	}
+	return nil
+}
+
+// name is who the certificate is for, as printed
+func (c *certificate) name() string {
+	if c.LearnerName != "" {
+		return c.LearnerName
+	}
+	return c.UserID
+}
+
+func (c *certificate) proto() *pb.Certificate {
+	out := &pb.Certificate{
+		Code:        c.Code,
+		UserId:      c.UserID,
+		LearnerName: c.LearnerName,
+		TrackId:     c.TrackID,
+		TrackName:   c.TrackName,
+		LessonIds:   c.LessonIDs,
+		IssuedAt:    c.IssuedAt.Unix(),
+		Signature:   base64.StdEncoding.EncodeToString(c.Signature),
+	}
+	for _, topic := range sortedIDs(c.Scores) {
+		score := &pb.TopicScore{BestPercent: int32(c.Scores[topic])}
+		if topic != allTopics {
+			score.Topic = topicEnum(topic)
+		}
+		out.Scores = append(out.Scores, score)
+	}
+	for i := range c.Circuits {
+		out.Circuits = append(out.Circuits, c.Circuits[i].proto())
+	}
+	return out
+}
+
+// certificateCode turns a signature into a code people can type:
+// "QE-" and four groups of four base32 characters
+func certificateCode(signature []byte) string {
+	sum := sha256.Sum256(signature)
+	raw := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum[:10])
+	return fmt.Sprintf("QE-%s-%s-%s-%s", raw[0:4], raw[4:8], raw[8:12], raw[12:16])
+}
+
+// normalizeCode accepts a code however it was typed
+func normalizeCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	code = strings.TrimPrefix(code, "QE")
+	if len(code) != 16 {
+		return ""
+	}
+	return fmt.Sprintf("QE-%s-%s-%s-%s", code[0:4], code[4:8], code[8:12], code[12:16])
+}
+
+// loadCertificateKey reads the hex Ed25519 seed that signs certificates,
+// creating the file on first use. Without a path the key only lasts until
+// restart, and so do the certificates it signed.
+func loadCertificateKey(path string) (ed25519.PrivateKey, error) {
+	if path == "" {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		seed := make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, []byte(hex.EncodeToString(seed)+"\n"), 0o600); err != nil {
+			return nil, err
+		}
+		log.Printf("📚 Created certificate key %s", path)
+		return ed25519.NewKeyFromSeed(seed), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	seed, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("%s: not a hex Ed25519 seed", path)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// ------------------------------------------------------------------
+// Store
+// ------------------------------------------------------------------
+
+// recordQuiz keeps a learner's best completed quiz on a topic
+func (as *achievementStore) recordQuiz(userID, topic string, percent int) {
+	if userID == "" {
+		return
+	}
+	if topic == "" {
+		topic = allTopics
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if best, ok := p.QuizBest[topic]; ok && best >= percent {
+		return
+	}
+	p.QuizBest[topic] = percent
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save quiz scores: %v", err)
+	}
+}
+
+// recordCircuit keeps a learner's best solution to a question or
+// challenge; a later solution replaces one it scores at least as well as
+func (as *achievementStore) recordCircuit(userID string, c builtCircuit) {
+	if userID == "" {
+		return
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if prev, ok := p.Circuits[c.Source]; ok && prev.Score > c.Score {
+		return
+	}
+	c.BuiltAt = c.BuiltAt.UTC().Truncate(time.Second) // As it will be signed
+	p.Circuits[c.Source] = &c
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save circuits: %v", err)
+	}
+}
+
+// issueCertificate signs a certificate for a finished track, with the
+// learner's quiz scores on its topics and every circuit they have built.
+// A track is certified once; later calls return the first certificate.
+func (as *achievementStore) issueCertificate(key ed25519.PrivateKey, userID, name string, t *Track, lessonIDs, topics []string) *certificate {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if c, ok := p.Certificates[t.ID]; ok {
+		return c
+	}
+
+	c := &certificate{
+		UserID:      userID,
+		LearnerName: name,
+		TrackID:     t.ID,
+		TrackName:   t.Name,
+		LessonIDs:   lessonIDs,
+		Scores:      make(map[string]int),
+		IssuedAt:    time.Now().UTC().Truncate(time.Second),
+	}
+	for _, topic := range append(topics, allTopics) {
+		if best, ok := p.QuizBest[topic]; ok {
+			c.Scores[topic] = best
+		}
+	}
+	for _, source := range sortedIDs(p.Circuits) {
+		c.Circuits = append(c.Circuits, *p.Circuits[source])
+	}
+	c.sign(key)
+	p.Certificates[t.ID] = c
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save certificate: %v", err)
+	}
+	return c
+}
+
+func (as *achievementStore) certificate(userID, trackID string) *certificate {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	if p, ok := as.learners[userID]; ok {
+		return p.Certificates[trackID]
+	}
+	return nil
+}
+
+func (as *achievementStore) certificateByCode(code string) *certificate {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	for _, p := range as.learners {
+		for _, c := range p.Certificates {
+			if c.Code == code {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------
+// Issuing
+// ------------------------------------------------------------------
+
+// trackFinished reports whether every lesson on a track's path is done,
+// and lists the path and its topics
+func trackFinished(catalog map[string]*Lesson, done map[string]time.Time, t *Track) (bool, []string, []string) {
+	path := lessonOrder(catalog, t.LessonIDs)
+	seen := make(map[string]bool)
+	var topics []string
+	for _, id := range path {
+		if _, ok := done[id]; !ok {
+			return false, nil, nil
+		}
+		if topic := catalog[id].Topic; !seen[topic] {
+			seen[topic] = true
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return len(path) > 0, path, topics
+}
+
+// certify issues certificates for the tracks a learner has finished that
+// lessonID is on, or for every finished track when lessonID is empty
+func (s *EducationServer) certify(catalog map[string]*Lesson, userID, name, lessonID string) []*certificate {
+	done := s.achievements.completedLessons(userID)
+	var issued []*certificate
+	for _, t := range tracks {
+		finished, path, topics := trackFinished(catalog, done, t)
+		if !finished || lessonID != "" && !slices.Contains(path, lessonID) {
+			continue
+		}
+		if s.achievements.certificate(userID, t.ID) != nil {
+			continue
+		}
+		c := s.achievements.issueCertificate(s.certificateKey, userID, name, t, path, topics)
+		log.Printf("📚 %q earned the %s certificate %s", userID, t.ID, c.Code)
+		issued = append(issued, c)
+	}
+	return issued
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// GetCertificate renders a learner's certificate for a track. Tracks
+// finished before certificates existed are certified on first request.
+func (s *EducationServer) GetCertificate(ctx context.Context, req *pb.CertificateRequest) (*pb.CertificateDocument, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	t := findTrack(req.TrackId)
+	if t == nil {
+		return nil, fmt.Errorf("track %s not found", req.TrackId)
+	}
+	c := s.achievements.certificate(req.UserId, t.ID)
+	if c == nil {
+		s.certify(s.content.catalog(), req.UserId, "", "")
+		if c = s.achievements.certificate(req.UserId, t.ID); c == nil {
+			return nil, fmt.Errorf("%q has not finished the %s track", req.UserId, t.ID)
+		}
+	}
+
+	doc := &pb.CertificateDocument{Certificate: c.proto()}
+	var err error
+	switch req.Format {
+	case pb.CertificateFormat_CERTIFICATE_PNG:
+		doc.ContentType = "image/png"
+		doc.Document, err = certificatePNG(c)
+	case pb.CertificateFormat_CERTIFICATE_PDF:
+		doc.ContentType = "application/pdf"
+		doc.Document = certificatePDF(c)
+	default:
+		return nil, fmt.Errorf("unknown format %v", req.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (s *EducationServer) VerifyCertificate(ctx context.Context, req *pb.VerifyCertificateRequest) (*pb.CertificateVerification, error) {
+	public := s.certificateKey.Public().(ed25519.PublicKey)
+	out := &pb.CertificateVerification{PublicKey: base64.StdEncoding.EncodeToString(public)}
+	code := normalizeCode(req.Code)
+	if code == "" {
+		out.Reason = "not a certificate code"
+		return out, nil
+	}
+	c := s.achievements.certificateByCode(code)
+	if c == nil {
+		out.Reason = "no certificate has this code"
+		return out, nil
+	}
+	out.Certificate = c.proto()
+	if err := c.verify(public); err != nil {
+		out.Reason = err.Error()
+		return out, nil
+	}
+	out.Valid = true
+	return out, nil
+}
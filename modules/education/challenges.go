@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+const (
+	// challengeTolerance is how far a solution's distribution may sit from
+	// the target, in fidelity, and still count as correct
+	challengeTolerance = 1e-4
+	correctnessPoints  = 600
+	gateEfficiency     = 300
+	depthEfficiency    = 100
+	defaultBoardSize   = 10
+)
+
+// Challenge asks for gates that take a prepared input state to one that
+// measures with the target distribution on its first NumQubits qubits
+type Challenge struct {
+	ID          string
+	Title       string
+	Description string
+	Topic       string
+	Difficulty  string
+	NumQubits   int
+	InputLabel  string
+	Input       []GateStep // Prepares the input from |0…0⟩
+	Target      map[string]float64
+	MaxGates    int
+	MaxQubits   int
+	Hints       []string   // Each more specific than the last
+	Solution    []GateStep // Reference; sets the par for efficiency
+}
+
+var challenges = map[string]*Challenge{
+	"biased_coin": {
+		ID:          "biased_coin",
+		Title:       "Biased Coin",
+		Description: "Make a qubit that measures 0 three times out of four.",
+		Topic:       "SUPERPOSITION",
+		Difficulty:  "BEGINNER",
+		NumQubits:   1,
+		InputLabel:  "|0⟩",
+		Target:      map[string]float64{"0": 0.75, "1": 0.25},
+		MaxGates:    4,
+		MaxQubits:   1,
+		Hints: []string{
+			"One rotation about the Y axis sets how likely each outcome is.",
+			"RY(θ)|0⟩ measures 0 with probability cos²(θ/2).",
+			"You need cos²(θ/2) = 3/4, so θ = π/3.",
+		},
+		Solution: []GateStep{{Gate: "RY", Qubits: []int{0}, Param: math.Pi / 3}},
+	},
+	"perfect_pair": {
+		ID:          "perfect_pair",
+		Title:       "Perfect Pair",
+		Description: "Two qubits that always agree, and are equally likely to read 00 or 11.",
+		Topic:       "ENTANGLEMENT",
+		Difficulty:  "BEGINNER",
+		NumQubits:   2,
+		InputLabel:  "|00⟩",
+		Target:      map[string]float64{"00": 0.5, "11": 0.5},
+		MaxGates:    6,
+		MaxQubits:   2,
+		Hints: []string{
+			"Equal odds of 00 and 11, and nothing else, is a Bell state.",
+			"Put qubit 0 in superposition, then make qubit 1 copy it.",
+			"H q0, then CNOT q0 q1.",
+		},
+		Solution: []GateStep{
+			{Gate: "H", Qubits: []int{0}},
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+		},
+	},
+	"move_qubit": {
+		ID:          "move_qubit",
+		Title:       "Special Delivery",
+		Description: "Move qubit 0's state onto qubit 1, leaving qubit 0 in |0⟩. You may not know the input.",
+		Topic:       "GATES",
+		Difficulty:  "BEGINNER",
+		NumQubits:   2,
+		InputLabel:  "cos(π/8)|00⟩ + sin(π/8)|01⟩",
+		Input:       []GateStep{{Gate: "RY", Qubits: []int{0}, Param: math.Pi / 4}},
+		Target:      map[string]float64{"00": (2 + math.Sqrt2) / 4, "10": (2 - math.Sqrt2) / 4},
+		MaxGates:    8,
+		MaxQubits:   2,
+		Hints: []string{
+			"This is half a swap, and there is no SWAP gate.",
+			"When the target starts in |0⟩, two CNOTs in opposite directions move a state.",
+			"CNOT q0 q1, then CNOT q1 q0.",
+		},
+		Solution: []GateStep{
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+			{Gate: "CNOT", Qubits: []int{1, 0}},
+		},
+	},
+	"ghz_four": {
+		ID:          "ghz_four",
+		Title:       "Four of a Kind",
+		Description: "Entangle four qubits so they read all 0s or all 1s. Shallow circuits score best.",
+		Topic:       "ENTANGLEMENT",
+		Difficulty:  "INTERMEDIATE",
+		NumQubits:   4,
+		InputLabel:  "|0000⟩",
+		Target:      map[string]float64{"0000": 0.5, "1111": 0.5},
+		MaxGates:    12,
+		MaxQubits:   4,
+		Hints: []string{
+			"Make a Bell pair, then spread it with CNOTs.",
+			"Once two qubits agree, both can be controls at the same time.",
+			"H q0, CNOT q0 q1, then CNOT q0 q2 and CNOT q1 q3 side by side.",
+		},
+		Solution: []GateStep{
+			{Gate: "H", Qubits: []int{0}},
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+			{Gate: "CNOT", Qubits: []int{0, 2}},
+			{Gate: "CNOT", Qubits: []int{1, 3}},
+		},
+	},
+	"w_state": {
+		ID:          "w_state",
+		Title:       "One Hot",
+		Description: "Exactly one of three qubits reads 1, each equally likely. There is no controlled-RY: build one.",
+		Topic:       "GATES",
+		Difficulty:  "ADVANCED",
+		NumQubits:   3,
+		InputLabel:  "|000⟩",
+		Target:      map[string]float64{"001": 1.0 / 3, "010": 1.0 / 3, "100": 1.0 / 3},
+		MaxGates:    24,
+		MaxQubits:   4,
+		Hints: []string{
+			"Give qubit 0 a 2/3 chance of reading 1, then split that chance.",
+			"A controlled RY(θ) is RY(θ/2) on the target, CNOT, RY(−θ/2), CNOT.",
+			"Split q0's 1 with a controlled RY(π/2) onto q1, fix it up with CNOT q1 q0, then set q2 when neither is 1: X q2 and a CNOT from each.",
+		},
+		Solution: []GateStep{
+			// 2/3 on q0 = 1, then half of that moves to q1 by a controlled RY(π/2)
+			{Gate: "RY", Qubits: []int{0}, Param: 2 * math.Asin(math.Sqrt(2.0/3))},
+			{Gate: "RY", Qubits: []int{1}, Param: math.Pi / 4},
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+			{Gate: "RY", Qubits: []int{1}, Param: -math.Pi / 4},
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+			{Gate: "CNOT", Qubits: []int{1, 0}},
+			// |000⟩ becomes |100⟩: flip q2 unless q0 or q1 is set
+			{Gate: "X", Qubits: []int{2}},
+			{Gate: "CNOT", Qubits: []int{0, 2}},
+			{Gate: "CNOT", Qubits: []int{1, 2}},
+		},
+	},
+}
+
+// challengeEntry is a learner's best correct solution
+type challengeEntry struct {
+	UserID      string
+	Score       int
+	Gates       int
+	Depth       int
+	SubmittedAt time.Time
+}
+
+// beats orders the leaderboard: score, then fewer gates, then shallower,
+// then whoever got there first
+func (e *challengeEntry) beats(o *challengeEntry) bool {
+	switch {
+	case e.Score != o.Score:
+		return e.Score > o.Score
+	case e.Gates != o.Gates:
+		return e.Gates < o.Gates
+	case e.Depth != o.Depth:
+		return e.Depth < o.Depth
+	}
+	return e.SubmittedAt.Before(o.SubmittedAt)
+}
+
+// par is the reference solution's gate count and depth
+func (c *Challenge) par() (int, int) {
+	steps := make([]*sandboxStep, 0, len(c.Solution))
+	for _, g := range gateStepsProto(c.Solution) {
+		if step, err := parseSandboxStep(g, c.MaxQubits); err == nil {
+			steps = append(steps, step)
+		}
+	}
+	return len(c.Solution), circuitDepth(steps, c.MaxQubits)
+}
+
+func (c *Challenge) proto() *pb.Challenge {
+	parGates, parDepth := c.par()
+	return &pb.Challenge{
+		Id:          c.ID,
+		Title:       c.Title,
+		Description: c.Description,
+		Topic:       topicEnum(c.Topic),
+		Difficulty:  difficultyEnum(c.Difficulty),
+		NumQubits:   int32(c.NumQubits),
+		InputState:  c.InputLabel,
+		Target:      c.Target,
+		MaxGates:    int32(c.MaxGates),
+		MaxQubits:   int32(c.MaxQubits),
+		ParGates:    int32(parGates),
+		ParDepth:    int32(parDepth),
+		Hints:       int32(len(c.Hints)),
+	}
+}
+
+// marginal is the distribution of the first n qubits of a state
+func marginal(state []complex128, n int) map[string]float64 {
+	dist := make(map[string]float64)
+	mask := 1<<n - 1
+	for i, amp := range state {
+		p := real(amp)*real(amp) + imag(amp)*imag(amp)
+		if p < amplitudeEpsilon {
+			continue
+		}
+		dist[fmt.Sprintf("%0*b", n, i&mask)] += p
+	}
+	return dist
+}
+
+// distributionFidelity is (Σ √(p·q))², 1 only for identical distributions
+func distributionFidelity(p, q map[string]float64) float64 {
+	var overlap float64
+	for k, pk := range p {
+		overlap += math.Sqrt(pk * q[k])
+	}
+	return overlap * overlap
+}
+
+// scoreChallenge awards correctness points by fidelity, and efficiency
+// points only to correct solutions
+func scoreChallenge(c *Challenge, fidelity float64, gates, depth int) (int, bool) {
+	correct := fidelity >= 1-challengeTolerance
+	score := correctnessPoints * fidelity
+	if correct {
+		parGates, parDepth := c.par()
+		score = correctnessPoints +
+			gateEfficiency*math.Min(1, float64(parGates)/float64(gates)) +
+			depthEfficiency*math.Min(1, float64(parDepth)/float64(depth))
+	}
+	return int(math.Round(score)), correct
+}
+
+// leaderboard ranks a challenge's entries; callers hold s.mu
+func (s *EducationServer) leaderboard(challengeID string) []*challengeEntry {
+	board := make([]*challengeEntry, 0, len(s.challengeBest[challengeID]))
+	for _, e := range s.challengeBest[challengeID] {
+		board = append(board, e)
+	}
+	sort.Slice(board, func(i, j int) bool { return board[i].beats(board[j]) })
+	return board
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *EducationServer) ListChallenges(ctx context.Context, req *pb.ChallengeFilter) (*pb.ChallengeCatalog, error) {
+	topic, difficulty := topicName(req.Topic), difficultyName(req.Difficulty)
+	catalog := &pb.ChallengeCatalog{}
+	for _, id := range sortedIDs(challenges) {
+		c := challenges[id]
+		if (topic == "" || c.Topic == topic) && (difficulty == "" || c.Difficulty == difficulty) {
+			catalog.Challenges = append(catalog.Challenges, c.proto())
+		}
+	}
+	return catalog, nil
+}
+
+func (s *EducationServer) GetChallenge(ctx context.Context, req *pb.ChallengeRequest) (*pb.Challenge, error) {
+	c, ok := challenges[req.ChallengeId]
+	if !ok {
+		return nil, fmt.Errorf("challenge %s not found", req.ChallengeId)
+	}
+	return c.proto(), nil
+}
+
+// SubmitChallenge simulates a solution after the challenge's input
+// preparation and scores what the first NumQubits qubits would measure
+func (s *EducationServer) SubmitChallenge(ctx context.Context, req *pb.ChallengeSubmission) (*pb.ChallengeResult, error) {
+	c, ok := challenges[req.ChallengeId]
+	if !ok {
+		return nil, fmt.Errorf("challenge %s not found", req.ChallengeId)
+	}
+	numQubits := int(req.NumQubits)
+	if numQubits == 0 {
+		numQubits = c.NumQubits
+	}
+	if numQubits < c.NumQubits || numQubits > c.MaxQubits {
+		if c.NumQubits == c.MaxQubits {
+			return nil, fmt.Errorf("challenge %s uses exactly %d qubits", c.ID, c.NumQubits)
+		}
+		return nil, fmt.Errorf("challenge %s allows %d-%d qubits", c.ID, c.NumQubits, c.MaxQubits)
+	}
+	if len(req.Gates) == 0 || len(req.Gates) > c.MaxGates {
+		return nil, fmt.Errorf("challenge %s allows 1-%d gates", c.ID, c.MaxGates)
+	}
+	steps := make([]*sandboxStep, len(req.Gates))
+	for i, g := range req.Gates {
+		step, err := parseSandboxStep(g, numQubits)
+		if err != nil {
+			return nil, fmt.Errorf("gate %d: %v", i, err)
+		}
+		if step.name == "MEASURE" {
+			return nil, fmt.Errorf("gate %d: challenges are scored on the state, before measurement", i)
+		}
+		steps[i] = step
+	}
+	depth := circuitDepth(steps, numQubits)
+
+	state, err := s.finalState(ctx, numQubits, append(gateStepsProto(c.Input), req.Gates...))
+	if err != nil {
+		return nil, err
+	}
+	dist := marginal(state, c.NumQubits)
+	fidelity := distributionFidelity(c.Target, dist)
+	score, correct := scoreChallenge(c, fidelity, len(steps), depth)
+	hints := s.achievements.hintsTaken(req.UserId, c.ID)
+	score = hintPoints(score, hints)
+
+	result := &pb.ChallengeResult{
+		ChallengeId:  c.ID,
+		Correct:      correct,
+		Fidelity:     fidelity,
+		Score:        int32(score),
+		GateCount:    int32(len(steps)),
+		Depth:        int32(depth),
+		Distribution: dist,
+		HintsUsed:    int32(hints),
+	}
+	parGates, parDepth := c.par()
+	switch {
+	case !correct:
+		result.Feedback = fmt.Sprintf("Not there yet: fidelity %.4f with the target.", fidelity)
+	case len(steps) > parGates || depth > parDepth:
+		result.Feedback = fmt.Sprintf("Solved! The reference uses %d gates at depth %d.", parGates, parDepth)
+	default:
+		result.Feedback = "Solved at or under par!"
+	}
+	if !correct || req.UserId == "" {
+		return result, nil
+	}
+
+	entry := &challengeEntry{UserID: req.UserId, Score: score, Gates: len(steps), Depth: depth, SubmittedAt: time.Now()}
+	s.achievements.recordCircuit(req.UserId, builtCircuit{
+		Source:  "challenge:" + c.ID,
+		Title:   c.Title,
+		Gates:   formatGates(gateStepsFromProto(req.Gates)),
+		Score:   score,
+		BuiltAt: entry.SubmittedAt,
+	})
+	s.mu.Lock()
+	if s.challengeBest[c.ID] == nil {
+		s.challengeBest[c.ID] = make(map[string]*challengeEntry)
+	}
+	best, ok := s.challengeBest[c.ID][req.UserId]
+	if !ok || entry.beats(best) {
+		s.challengeBest[c.ID][req.UserId] = entry
+		result.PersonalBest = true
+	}
+	for i, e := range s.leaderboard(c.ID) {
+		if e.UserID == req.UserId {
+			result.Rank = int32(i + 1)
+		}
+	}
+	s.mu.Unlock()
+
+	result.Unlocked = s.achievements.solveChallenge(req.UserId, c.ID)
+	log.Printf("📚 Challenge %s solved by %q: %d points, %d gates, depth %d", c.ID, req.UserId, score, len(steps), depth)
+	return result, nil
+}
+
+func (s *EducationServer) GetChallengeLeaderboard(ctx context.Context, req *pb.ChallengeLeaderboardRequest) (*pb.ChallengeLeaderboard, error) {
+	if _, ok := challenges[req.ChallengeId]; !ok {
+		return nil, fmt.Errorf("challenge %s not found", req.ChallengeId)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultBoardSize
+	}
+
+	s.mu.Lock()
+	board := s.leaderboard(req.ChallengeId)
+	s.mu.Unlock()
+
+	out := &pb.ChallengeLeaderboard{ChallengeId: req.ChallengeId, Solvers: int32(len(board))}
+	for i, e := range board[:min(limit, len(board))] {
+		out.Entries = append(out.Entries, &pb.ChallengeLeaderboardEntry{
+			Rank:        int32(i + 1),
+			UserId:      e.UserID,
+			Score:       int32(e.Score),
+			GateCount:   int32(e.Gates),
+			Depth:       int32(e.Depth),
+			SubmittedAt: e.SubmittedAt.Unix(),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,575 @@
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+const (
+	maxClassName     = 80
+	maxClassStudents = 500
+	maxAssignments   = 100
+)
+
+// assignment is a lesson or challenge a class is asked to finish
+type assignment struct {
+	ID         string    `json:"id"`
+	Kind       string    `json:"kind"` // "lesson" or "challenge"
+	ItemID     string    `json:"item_id"`
+	DueAt      time.Time `json:"due_at"` // Zero for no deadline
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+type class struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	JoinCode    string        `json:"join_code"`
+	Instructors []string      `json:"instructors"`
+	Students    []string      `json:"students"`
+	Assignments []*assignment `json:"assignments"` // Soonest deadline first
+	Assigned    int           `json:"assigned"`    // Assignments ever made, for IDs
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+func kindName(k pb.AssignmentKind) string {
+	return strings.ToLower(strings.TrimPrefix(k.String(), "ASSIGNMENT_"))
+}
+
+func kindEnum(name string) pb.AssignmentKind {
+	return pb.AssignmentKind(pb.AssignmentKind_value["ASSIGNMENT_"+strings.ToUpper(name)])
+}
+
+func (c *class) clone() *class {
+	out := *c
+	out.Instructors = slices.Clone(c.Instructors)
+	out.Students = slices.Clone(c.Students)
+	out.Assignments = make([]*assignment, len(c.Assignments))
+	for i, a := range c.Assignments {
+		copied := *a
+		out.Assignments[i] = &copied
+	}
+	return &out
+}
+
+func (c *class) teaches(userID string) bool {
+	return slices.Contains(c.Instructors, userID)
+}
+
+// sortAssignments puts deadlines first, soonest first, then the rest in
+// the order they were assigned
+func (c *class) sortAssignments() {
+	sort.SliceStable(c.Assignments, func(i, j int) bool {
+		a, b := c.Assignments[i], c.Assignments[j]
+		if a.DueAt.IsZero() != b.DueAt.IsZero() {
+			return !a.DueAt.IsZero()
+		}
+		if !a.DueAt.Equal(b.DueAt) {
+			return a.DueAt.Before(b.DueAt)
+		}
+		return a.AssignedAt.Before(b.AssignedAt)
+	})
+}
+
+// standing is what a class needs of a learner's progress
+type standing struct {
+	lessons map[string]time.Time
+	solved  map[string]time.Time
+	scores  map[string]int // Best challenge score, by challenge ID
+	badges  int
+}
+
+func (as *achievementStore) standing(userID string) standing {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	st := standing{
+		lessons: make(map[string]time.Time),
+		solved:  make(map[string]time.Time),
+		scores:  make(map[string]int),
+	}
+	p, ok := as.learners[userID]
+	if !ok {
+		return st
+	}
+	for id, at := range p.Lessons {
+		st.lessons[id] = at
+	}
+	for id, at := range p.Solved {
+		st.solved[id] = at
+	}
+	for source, c := range p.Circuits {
+		if id, ok := strings.CutPrefix(source, "challenge:"); ok {
+			st.scores[id] = c.Score
+		}
+	}
+	st.badges = len(p.Unlocked)
+	return st
+}
+
+// progress is where a learner stands on an assignment. Work finished
+// before it was assigned counts as on time.
+func (a *assignment) progress(st standing, now time.Time) *pb.AssignmentProgress {
+	out := &pb.AssignmentProgress{AssignmentId: a.ID}
+	var done time.Time
+	switch a.Kind {
+	case "lesson":
+		done = st.lessons[a.ItemID]
+	case "challenge":
+		done = st.solved[a.ItemID]
+		out.Score = int32(st.scores[a.ItemID])
+	}
+	late := !a.DueAt.IsZero()
+	switch {
+	case !done.IsZero():
+		out.CompletedAt = done.Unix()
+		out.Status = pb.AssignmentStatus_ASSIGNMENT_DONE
+		if late && done.After(a.DueAt) {
+			out.Status = pb.AssignmentStatus_ASSIGNMENT_LATE
+		}
+	case late && now.After(a.DueAt):
+		out.Status = pb.AssignmentStatus_ASSIGNMENT_OVERDUE
+	}
+	return out
+}
+
+// ------------------------------------------------------------------
+// Store
+// ------------------------------------------------------------------
+
+// classStore keeps classes, saved to a JSON file when one is configured.
+// It hands out copies, so callers read them without holding its lock.
+type classStore struct {
+	path    string
+	mu      sync.Mutex
+	classes map[string]*class
+}
+
+func newClassStore(path string) (*classStore, error) {
+	cs := &classStore{path: path, classes: make(map[string]*class)}
+	if path == "" {
+		return cs, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &cs.classes); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return cs, nil
+}
+
+// newJoinCode is eight characters students can type, unused by any
+// class; callers hold cs.mu
+func (cs *classStore) newJoinCode() string {
+	for {
+		raw := make([]byte, 5)
+		crand.Read(raw)
+		code := base32.StdEncoding.EncodeToString(raw)
+		if cs.byCode(code) == nil {
+			return code
+		}
+	}
+}
+
+// byCode finds a class by join code; callers hold cs.mu
+func (cs *classStore) byCode(code string) *class {
+	for _, c := range cs.classes {
+		if c.JoinCode == code {
+			return c
+		}
+	}
+	return nil
+}
+
+func (cs *classStore) create(instructorID, name string) *class {
+	id := make([]byte, 6)
+	crand.Read(id)
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	c := &class{
+		ID:          "class-" + hex.EncodeToString(id),
+		Name:        name,
+		JoinCode:    cs.newJoinCode(),
+		Instructors: []string{instructorID},
+		CreatedAt:   time.Now(),
+	}
+	cs.classes[c.ID] = c
+	if err := cs.save(); err != nil {
+		log.Printf("📚 Failed to save classes: %v", err)
+	}
+	return c.clone()
+}
+
+// update applies an instructor's change to a class. A change that fails
+// leaves the class as it was.
+func (cs *classStore) update(classID, instructorID string, change func(c *class) error) (*class, error) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	c, ok := cs.classes[classID]
+	if !ok {
+		return nil, fmt.Errorf("class %s not found", classID)
+	}
+	if !c.teaches(instructorID) {
+		return nil, fmt.Errorf("%q does not teach class %s", instructorID, classID)
+	}
+	changed := c.clone()
+	if err := change(changed); err != nil {
+		return nil, err
+	}
+	changed.sortAssignments()
+	cs.classes[classID] = changed
+	if err := cs.save(); err != nil {
+		log.Printf("📚 Failed to save classes: %v", err)
+	}
+	return changed.clone(), nil
+}
+
+func (cs *classStore) join(code, userID string) (*class, error) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	c := cs.byCode(strings.ToUpper(strings.TrimSpace(code)))
+	if c == nil {
+		return nil, fmt.Errorf("no class has join code %q", code)
+	}
+	if !slices.Contains(c.Students, userID) && !c.teaches(userID) {
+		if len(c.Students) >= maxClassStudents {
+			return nil, fmt.Errorf("class %s is full", c.ID)
+		}
+		c.Students = append(c.Students, userID)
+		if err := cs.save(); err != nil {
+			log.Printf("📚 Failed to save classes: %v", err)
+		}
+	}
+	return c.clone(), nil
+}
+
+func (cs *classStore) get(classID string) *class {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if c, ok := cs.classes[classID]; ok {
+		return c.clone()
+	}
+	return nil
+}
+
+// forUser lists the classes a user teaches and those they are enrolled
+// in, oldest first
+func (cs *classStore) forUser(userID string) (teaching, enrolled []*class) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	for _, c := range cs.classes {
+		switch {
+		case c.teaches(userID):
+			teaching = append(teaching, c.clone())
+		case slices.Contains(c.Students, userID):
+			enrolled = append(enrolled, c.clone())
+		}
+	}
+	for _, list := range [][]*class{teaching, enrolled} {
+		sort.Slice(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	}
+	return teaching, enrolled
+}
+
+// save writes the store atomically; callers hold cs.mu
+func (cs *classStore) save() error {
+	if cs.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(cs.classes, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(cs.path), ".classes-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), cs.path)
+}
+
+// ------------------------------------------------------------------
+// Protos
+// ------------------------------------------------------------------
+
+func (s *EducationServer) assignmentProto(a *assignment) *pb.Assignment {
+	out := &pb.Assignment{
+		Id:         a.ID,
+		Kind:       kindEnum(a.Kind),
+		ItemId:     a.ItemID,
+		DueAt:      unixOrZero(a.DueAt),
+		AssignedAt: a.AssignedAt.Unix(),
+	}
+	switch a.Kind {
+	case "lesson":
+		if l := s.content.current(a.ItemID); l != nil {
+			out.Title = l.Title
+		}
+	case "challenge":
+		if c, ok := challenges[a.ItemID]; ok {
+			out.Title = c.Title
+		}
+	}
+	return out
+}
+
+// classProto shows the roster and join code to instructors, and a
+// student's own progress to them
+func (s *EducationServer) classProto(c *class, viewer string) *pb.Class {
+	out := &pb.Class{
+		Id:            c.ID,
+		Name:          c.Name,
+		InstructorIds: c.Instructors,
+		CreatedAt:     c.CreatedAt.Unix(),
+		StudentCount:  int32(len(c.Students)),
+	}
+	for _, a := range c.Assignments {
+		out.Assignments = append(out.Assignments, s.assignmentProto(a))
+	}
+	switch {
+	case c.teaches(viewer):
+		out.JoinCode = c.JoinCode
+		out.StudentIds = c.Students
+	case slices.Contains(c.Students, viewer):
+		st, now := s.achievements.standing(viewer), time.Now()
+		for _, a := range c.Assignments {
+			out.Progress = append(out.Progress, a.progress(st, now))
+		}
+	}
+	return out
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *EducationServer) CreateClass(ctx context.Context, req *pb.CreateClassRequest) (*pb.Class, error) {
+	name := strings.TrimSpace(req.Name)
+	switch {
+	case req.InstructorId == "":
+		return nil, fmt.Errorf("instructor_id is required")
+	case name == "":
+		return nil, fmt.Errorf("name is required")
+	case len([]rune(name)) > maxClassName:
+		return nil, fmt.Errorf("name is longer than %d characters", maxClassName)
+	}
+	c := s.classes.create(req.InstructorId, name)
+	log.Printf("📚 %q created class %s %q", req.InstructorId, c.ID, c.Name)
+	return s.classProto(c, req.InstructorId), nil
+}
+
+// EnrollStudents adds students and co-instructors to a class, or removes
+// them. A class keeps at least one instructor.
+func (s *EducationServer) EnrollStudents(ctx context.Context, req *pb.EnrollmentRequest) (*pb.Class, error) {
+	c, err := s.classes.update(req.ClassId, req.InstructorId, func(c *class) error {
+		if req.Remove {
+			c.Students = slices.DeleteFunc(c.Students, func(id string) bool { return slices.Contains(req.StudentIds, id) })
+			c.Instructors = slices.DeleteFunc(c.Instructors, func(id string) bool { return slices.Contains(req.InstructorIds, id) })
+			if len(c.Instructors) == 0 {
+				return fmt.Errorf("class %s needs an instructor", c.ID)
+			}
+			return nil
+		}
+		for _, id := range req.InstructorIds {
+			if id != "" && !c.teaches(id) {
+				c.Instructors = append(c.Instructors, id)
+				c.Students = slices.DeleteFunc(c.Students, func(other string) bool { return other == id })
+			}
+		}
+		for _, id := range req.StudentIds {
+			if id != "" && !c.teaches(id) && !slices.Contains(c.Students, id) {
+				c.Students = append(c.Students, id)
+			}
+		}
+		if len(c.Students) > maxClassStudents {
+			return fmt.Errorf("classes hold at most %d students", maxClassStudents)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.classProto(c, req.InstructorId), nil
+}
+
+func (s *EducationServer) JoinClass(ctx context.Context, req *pb.JoinClassRequest) (*pb.Class, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	c, err := s.classes.join(req.JoinCode, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("📚 %q joined class %s", req.UserId, c.ID)
+	return s.classProto(c, req.UserId), nil
+}
+
+// AssignWork adds an assignment to a class, or replaces or withdraws one
+func (s *EducationServer) AssignWork(ctx context.Context, req *pb.AssignmentRequest) (*pb.Class, error) {
+	if req.Remove && req.AssignmentId == "" {
+		return nil, fmt.Errorf("assignment_id is required to remove an assignment")
+	}
+	kind := kindName(req.Kind)
+	if !req.Remove {
+		switch kind {
+		case "lesson":
+			if s.content.current(req.ItemId) == nil {
+				return nil, fmt.Errorf("lesson %s not found", req.ItemId)
+			}
+		case "challenge":
+			if _, ok := challenges[req.ItemId]; !ok {
+				return nil, fmt.Errorf("challenge %s not found", req.ItemId)
+			}
+		default:
+			return nil, fmt.Errorf("unknown assignment kind %v", req.Kind)
+		}
+	}
+	now := time.Now()
+	var due time.Time
+	if req.DueAt != 0 {
+		due = time.Unix(req.DueAt, 0)
+		if due.Before(now) {
+			return nil, fmt.Errorf("due_at is in the past")
+		}
+	}
+
+	c, err := s.classes.update(req.ClassId, req.InstructorId, func(c *class) error {
+		i := slices.IndexFunc(c.Assignments, func(a *assignment) bool { return a.ID == req.AssignmentId })
+		switch {
+		case req.AssignmentId != "" && i < 0:
+			return fmt.Errorf("assignment %s not found", req.AssignmentId)
+		case req.Remove:
+			c.Assignments = slices.Delete(c.Assignments, i, i+1)
+		case i >= 0:
+			a := c.Assignments[i]
+			a.Kind, a.ItemID, a.DueAt = kind, req.ItemId, due
+		case len(c.Assignments) >= maxAssignments:
+			return fmt.Errorf("classes hold at most %d assignments", maxAssignments)
+		default:
+			c.Assigned++
+			c.Assignments = append(c.Assignments, &assignment{
+				ID:         fmt.Sprintf("a%d", c.Assigned),
+				Kind:       kind,
+				ItemID:     req.ItemId,
+				DueAt:      due,
+				AssignedAt: now,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("📚 %q updated the assignments of class %s", req.InstructorId, c.ID)
+	return s.classProto(c, req.InstructorId), nil
+}
+
+func (s *EducationServer) ListClasses(ctx context.Context, req *pb.ListClassesRequest) (*pb.ClassList, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	teaching, enrolled := s.classes.forUser(req.UserId)
+	out := &pb.ClassList{}
+	for _, c := range teaching {
+		out.Teaching = append(out.Teaching, s.classProto(c, req.UserId))
+	}
+	for _, c := range enrolled {
+		out.Enrolled = append(out.Enrolled, s.classProto(c, req.UserId))
+	}
+	return out, nil
+}
+
+// ListClassProgress is an instructor's dashboard: each student on each
+// assignment, and each assignment across the class
+func (s *EducationServer) ListClassProgress(ctx context.Context, req *pb.ClassProgressRequest) (*pb.ClassProgress, error) {
+	c := s.classes.get(req.ClassId)
+	if c == nil {
+		return nil, fmt.Errorf("class %s not found", req.ClassId)
+	}
+	if !c.teaches(req.InstructorId) {
+		return nil, fmt.Errorf("%q does not teach class %s", req.InstructorId, c.ID)
+	}
+
+	out := &pb.ClassProgress{Class: s.classProto(c, req.InstructorId)}
+	summaries := make([]*pb.AssignmentSummary, len(c.Assignments))
+	for i, a := range out.Class.Assignments {
+		summaries[i] = &pb.AssignmentSummary{Assignment: a}
+	}
+	scoreTotals := make([]int, len(c.Assignments))
+	now := time.Now()
+	var done int
+	for _, userID := range c.Students {
+		st := s.achievements.standing(userID)
+		student := &pb.StudentProgress{
+			UserId:           userID,
+			LessonsCompleted: int32(len(st.lessons)),
+			BadgesUnlocked:   int32(st.badges),
+		}
+		for i, a := range c.Assignments {
+			p := a.progress(st, now)
+			student.Assignments = append(student.Assignments, p)
+			sum := summaries[i]
+			switch p.Status {
+			case pb.AssignmentStatus_ASSIGNMENT_LATE:
+				student.Late++
+				sum.Late++
+				fallthrough
+			case pb.AssignmentStatus_ASSIGNMENT_DONE:
+				student.Done++
+				sum.Done++
+				scoreTotals[i] += int(p.Score)
+			case pb.AssignmentStatus_ASSIGNMENT_OVERDUE:
+				student.Overdue++
+				sum.Overdue++
+			}
+		}
+		done += int(student.Done)
+		out.Students = append(out.Students, student)
+	}
+	for i, sum := range summaries {
+		if c.Assignments[i].Kind == "challenge" && sum.Done > 0 {
+			sum.AverageScore = int32(scoreTotals[i] / int(sum.Done))
+		}
+	}
+	out.Assignments = summaries
+	if total := len(c.Students) * len(c.Assignments); total > 0 {
+		out.CompletionRate = float64(done) / float64(total)
+	}
+
+	sort.SliceStable(out.Students, func(i, j int) bool {
+		a, b := out.Students[i], out.Students[j]
+		if a.Overdue != b.Overdue {
+			return a.Overdue > b.Overdue
+		}
+		if a.Done != b.Done {
+			return a.Done < b.Done
+		}
+		return a.UserId < b.UserId
+	})
+	return out, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/cmplx"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+	engine "github.com/perclft/QubitEngine/modules/education/generated/engine"
+)
+
+// fidelityTolerance absorbs engine rounding; anything this close to the
+// target state counts as reaching it
+const fidelityTolerance = 1e-6
+
+// finalState runs gates from |0…0⟩ on the engine and returns the state
+func (s *EducationServer) finalState(ctx context.Context, numQubits int, gates []*pb.GateStep) ([]complex128, error) {
+	var ops []*engine.GateOperation
+	steps := make([]*sandboxStep, len(gates))
+	for i, g := range gates {
+		step, err := parseSandboxStep(g, numQubits)
+		if err != nil {
+			return nil, fmt.Errorf("gate %d: %v", i, err)
+		}
+		if step.name == "MEASURE" {
+			return nil, fmt.Errorf("gate %d: measurements would make the state random", i)
+		}
+		steps[i] = step
+		ops = append(ops, step.ops...)
+	}
+	if depth := circuitDepth(steps, numQubits); depth > maxSandboxDepth {
+		return nil, fmt.Errorf("circuit depth %d exceeds %d", depth, maxSandboxDepth)
+	}
+
+	resp, err := s.engineClient.RunCircuit(ctx, &engine.CircuitRequest{
+		NumQubits:  int32(numQubits),
+		Operations: ops,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("engine error: %v", err)
+	}
+	state := make([]complex128, len(resp.StateVector))
+	for i, c := range resp.StateVector {
+		state[i] = complex(c.Real, c.Imag)
+	}
+	return state, nil
+}
+
+// stateFidelity is |⟨a|b⟩|², which ignores any global phase between them
+func stateFidelity(a, b []complex128) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var overlap complex128
+	for i := range a {
+		overlap += cmplx.Conj(a[i]) * b[i]
+	}
+	abs := cmplx.Abs(overlap)
+	return abs * abs
+}
+
+// formatGates writes a gate sequence as "H q0; CNOT q0 q1"
+func formatGates(gates []GateStep) string {
+	parts := make([]string, len(gates))
+	for i, g := range gates {
+		part := g.Gate
+		if g.Param != 0 {
+			part += fmt.Sprintf("(%.3f)", g.Param)
+		}
+		for _, q := range g.Qubits {
+			part += fmt.Sprintf(" q%d", q)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, "; ")
+}
+
+// gradeCircuit runs the learner's gates and the reference solution and
+// compares the states they prepare, returning the learner's state too. A
+// malformed circuit is an error, not a wrong answer, so a typo does not
+// use up the question.
+func (s *EducationServer) gradeCircuit(ctx context.Context, q *Question, gates []*pb.GateStep) (*pb.AnswerResult, []complex128, error) {
+	if len(gates) == 0 {
+		return nil, nil, fmt.Errorf("question %s needs an answer as gates", q.ID)
+	}
+	if len(gates) > maxSandboxGates {
+		return nil, nil, fmt.Errorf("question %s: at most %d gates", q.ID, maxSandboxGates)
+	}
+	submitted, err := s.finalState(ctx, q.NumQubits, gates)
+	if err != nil {
+		return nil, nil, fmt.Errorf("question %s: %v", q.ID, err)
+	}
+	target, err := s.finalState(ctx, q.NumQubits, gateStepsProto(q.Solution))
+	if err != nil {
+		return nil, nil, fmt.Errorf("question %s solution: %v", q.ID, err)
+	}
+
+	fidelity := stateFidelity(target, submitted)
+	return &pb.AnswerResult{
+		QuestionId:    q.ID,
+		Correct:       fidelity >= 1-fidelityTolerance,
+		CorrectAnswer: formatGates(q.Solution),
+		Explanation:   fmt.Sprintf("%s Your state overlaps the target with fidelity %.3f.", q.Explain, fidelity),
+		Fidelity:      fidelity,
+	}, submitted, nil
+}
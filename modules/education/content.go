@@ -0,0 +1,533 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// Lesson content lives in a directory with one subdirectory per lesson and
+// one Markdown file per version:
+//
+//	content/entanglement_intro/v1.md
+//	content/entanglement_intro/v2.md
+//
+// Each file starts with a "---" delimited header of "key: value" lines
+// (title, topic, difficulty, key_concepts, circuit_examples, next,
+// prerequisites, minutes, author) followed by the lesson body. The highest version is current.
+// Edits made through the authoring API are written as new versions, and
+// the directory is polled so edits made on disk are picked up too.
+//
+// Translations sit beside the versions, one file per language, and the
+// question bank is translated in one JSON file per language:
+//
+//	content/entanglement_intro/es.md
+//	content/questions.es.json
+//
+// See i18n.go for their formats, and library.go for the circuits and
+// questions the directory can add.
+
+const defaultReloadInterval = 5 * time.Second
+
+var lessonIDPattern = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+type lessonStore struct {
+	mu           sync.RWMutex
+	dir          string
+	versions     map[string][]*Lesson                       // Ascending by version
+	translations map[string]map[string]*lessonTranslation   // By lesson ID, then language
+	questions    map[string]map[string]*questionTranslation // By language, then question ID
+	library      map[string]*Circuit                        // Built-in and content directory circuits
+	bank         []*Question                                // Built-in and content directory questions
+	signature    string
+}
+
+// newLessonStore starts from the built-in lessons as version 1 and layers
+// the content directory, if any, on top
+func newLessonStore(dir string) (*lessonStore, error) {
+	ls := &lessonStore{dir: dir}
+	if err := ls.reload(); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+func builtinVersions() map[string][]*Lesson {
+	versions := make(map[string][]*Lesson, len(lessons))
+	for id, l := range lessons {
+		v := *l.canonical()
+		v.Version, v.Author = 1, "builtin"
+		versions[id] = []*Lesson{&v}
+	}
+	return versions
+}
+
+// dirSignature changes whenever a lesson file is added, removed or edited
+func (ls *lessonStore) dirSignature() (string, error) {
+	files, err := filepath.Glob(filepath.Join(ls.dir, "*", "*.md"))
+	if err != nil {
+		return "", err
+	}
+	banks, _ := filepath.Glob(filepath.Join(ls.dir, "questions.*.json"))
+	files = append(files, banks...)
+	files = append(files, filepath.Join(ls.dir, circuitsFile), filepath.Join(ls.dir, questionsFile))
+	sort.Strings(files)
+	var sig strings.Builder
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&sig, "%s:%d:%d;", f, info.Size(), info.ModTime().UnixNano())
+	}
+	return sig.String(), nil
+}
+
+// reload rereads the content directory. Files that fail to parse are
+// logged and skipped so one bad edit does not take the catalog down.
+func (ls *lessonStore) reload() error {
+	versions := builtinVersions()
+	translations := make(map[string]map[string]*lessonTranslation)
+	banks := make(map[string]map[string]*questionTranslation)
+	sig := ""
+	if ls.dir != "" {
+		if err := os.MkdirAll(ls.dir, 0o755); err != nil {
+			return fmt.Errorf("content directory: %v", err)
+		}
+		var err error
+		if sig, err = ls.dirSignature(); err != nil {
+			return err
+		}
+		// A lesson's files hold its whole history, replacing the built-in
+		files, _ := filepath.Glob(filepath.Join(ls.dir, "*", "*.md"))
+		onDisk := make(map[string][]*Lesson)
+		for _, f := range files {
+			if lang, ok := translationLanguage(f); ok {
+				t, err := readTranslationFile(f, lang)
+				if err != nil {
+					log.Printf("📚 Skipping %s: %v", f, err)
+					continue
+				}
+				if translations[t.LessonID] == nil {
+					translations[t.LessonID] = make(map[string]*lessonTranslation)
+				}
+				translations[t.LessonID][lang] = t
+				continue
+			}
+			l, err := readLessonFile(f)
+			if err != nil {
+				log.Printf("📚 Skipping %s: %v", f, err)
+				continue
+			}
+			onDisk[l.ID] = append(onDisk[l.ID], l)
+		}
+		for id, vs := range onDisk {
+			sort.Slice(vs, func(i, j int) bool { return vs[i].Version < vs[j].Version })
+			versions[id] = vs
+		}
+	}
+	library, bank := readLibrary(ls.dir)
+	if ls.dir != "" {
+		banks = readQuestionBanks(ls.dir, bank)
+	}
+
+	ls.mu.Lock()
+	ls.versions, ls.signature = versions, sig
+	ls.translations, ls.questions = translations, banks
+	ls.library, ls.bank = library, bank
+	ls.mu.Unlock()
+	for _, problem := range graphProblems(ls.catalog()) {
+		log.Printf("📚 Learning path: %s", problem)
+	}
+	return nil
+}
+
+// watch polls the content directory and reloads when it changes
+func (ls *lessonStore) watch(ctx context.Context, interval time.Duration) {
+	if ls.dir == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		sig, err := ls.dirSignature()
+		ls.mu.RLock()
+		changed := err == nil && sig != ls.signature
+		ls.mu.RUnlock()
+		if !changed {
+			continue
+		}
+		if err := ls.reload(); err != nil {
+			log.Printf("📚 Reload failed: %v", err)
+			continue
+		}
+		log.Printf("📚 Reloaded lessons from %s (%d lessons)", ls.dir, len(ls.all()))
+	}
+}
+
+func (ls *lessonStore) current(id string) *Lesson {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	vs := ls.versions[id]
+	if len(vs) == 0 {
+		return nil
+	}
+	return vs[len(vs)-1]
+}
+
+func (ls *lessonStore) version(id string, version int) *Lesson {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for _, l := range ls.versions[id] {
+		if l.Version == version {
+			return l
+		}
+	}
+	return nil
+}
+
+func (ls *lessonStore) history(id string) []*Lesson {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	return append([]*Lesson(nil), ls.versions[id]...)
+}
+
+// all returns the current version of every lesson, by ID
+func (ls *lessonStore) all() []*Lesson {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	out := make([]*Lesson, 0, len(ls.versions))
+	for _, id := range sortedIDs(ls.versions) {
+		vs := ls.versions[id]
+		out = append(out, vs[len(vs)-1])
+	}
+	return out
+}
+
+// catalog maps every lesson ID to its current version
+func (ls *lessonStore) catalog() map[string]*Lesson {
+	catalog := make(map[string]*Lesson)
+	for _, l := range ls.all() {
+		catalog[l.ID] = l
+	}
+	return catalog
+}
+
+// put stores a new version of a lesson. expected is the version the
+// author edited (0 for a new lesson), so concurrent edits are refused
+// rather than silently overwritten.
+func (ls *lessonStore) put(l *Lesson, expected int) (*Lesson, error) {
+	l = l.canonical()
+	if err := validateLesson(l); err != nil {
+		return nil, err
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	latest := 0
+	if vs := ls.versions[l.ID]; len(vs) > 0 {
+		latest = vs[len(vs)-1].Version
+	}
+	if expected != latest {
+		return nil, fmt.Errorf("lesson %s is at version %d, not %d", l.ID, latest, expected)
+	}
+	catalog := make(map[string]*Lesson, len(ls.versions))
+	for id, vs := range ls.versions {
+		catalog[id] = vs[len(vs)-1]
+	}
+	for _, p := range l.Prerequisites {
+		if catalog[p] == nil {
+			return nil, fmt.Errorf("lesson %s: unknown prerequisite %s", l.ID, p)
+		}
+		if dependsOn(catalog, p, l.ID) {
+			return nil, fmt.Errorf("lesson %s: %s already builds on it", l.ID, p)
+		}
+	}
+
+	v := *l
+	v.Version = latest + 1
+	v.UpdatedAt = time.Now()
+	if ls.dir != "" {
+		if latest == 1 && ls.versions[l.ID][0].Author == "builtin" {
+			// Keep the built-in text as v1 on disk so the history is whole
+			if err := writeLessonFile(ls.dir, ls.versions[l.ID][0]); err != nil {
+				return nil, err
+			}
+		}
+		if err := writeLessonFile(ls.dir, &v); err != nil {
+			return nil, err
+		}
+		// The watcher need not reload what we already hold
+		if sig, err := ls.dirSignature(); err == nil {
+			ls.signature = sig
+		}
+	}
+	ls.versions[l.ID] = append(ls.versions[l.ID], &v)
+	return &v, nil
+}
+
+func validateLesson(l *Lesson) error {
+	switch {
+	case !lessonIDPattern.MatchString(l.ID):
+		return fmt.Errorf("lesson id must be 1-64 lowercase letters, digits or underscores")
+	case strings.TrimSpace(l.Title) == "":
+		return fmt.Errorf("lesson %s needs a title", l.ID)
+	case strings.TrimSpace(l.Content) == "":
+		return fmt.Errorf("lesson %s has no content", l.ID)
+	case topicEnum(l.Topic) == 0:
+		return fmt.Errorf("lesson %s has unknown topic %q", l.ID, l.Topic)
+	case difficultyEnum(l.Difficulty) == 0:
+		return fmt.Errorf("lesson %s has unknown difficulty %q", l.ID, l.Difficulty)
+	case l.EstimatedMin < 0:
+		return fmt.Errorf("lesson %s has a negative duration", l.ID)
+	case strings.ContainsAny(l.Title+l.Author+l.NextLessonID+strings.Join(l.KeyConcepts, "")+strings.Join(l.CircuitExamples, ""), "\r\n"):
+		return fmt.Errorf("lesson %s header fields must be single lines", l.ID)
+	}
+	for _, p := range l.Prerequisites {
+		switch {
+		case !lessonIDPattern.MatchString(p):
+			return fmt.Errorf("lesson %s has a bad prerequisite %q", l.ID, p)
+		case p == l.ID:
+			return fmt.Errorf("lesson %s cannot be its own prerequisite", l.ID)
+		}
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------
+// Lesson files
+// ------------------------------------------------------------------
+
+func lessonPath(dir, id string, version int) string {
+	return filepath.Join(dir, id, fmt.Sprintf("v%d.md", version))
+}
+
+func readLessonFile(path string) (*Lesson, error) {
+	version, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), "v"), ".md"))
+	if err != nil || version < 1 {
+		return nil, fmt.Errorf("file name must be v<version>.md")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := parseLesson(filepath.Base(filepath.Dir(path)), canonicalFile(path, data))
+	if err != nil {
+		return nil, err
+	}
+	l.Version, l.UpdatedAt = version, info.ModTime()
+	return l, nil
+}
+
+// parseLesson reads a lesson from the text of a lesson file
+func parseLesson(id, text string) (*Lesson, error) {
+	l := &Lesson{ID: id}
+	var err error
+	body, err := parseFrontMatter(text, func(key, value string) error {
+		switch key {
+		case "title":
+			l.Title = value
+		case "topic":
+			l.Topic = strings.ToUpper(value)
+		case "difficulty":
+			l.Difficulty = strings.ToUpper(value)
+		case "key_concepts":
+			l.KeyConcepts = splitList(value)
+		case "circuit_examples":
+			l.CircuitExamples = splitList(value)
+		case "next":
+			l.NextLessonID = value
+		case "prerequisites":
+			l.Prerequisites = splitList(value)
+		case "minutes":
+			if l.EstimatedMin, err = strconv.Atoi(value); err != nil {
+				return fmt.Errorf("minutes: %v", err)
+			}
+		case "author":
+			l.Author = value
+		default:
+			return fmt.Errorf("unknown header %q", key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.Content = body
+	if err := validateLesson(l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// parseFrontMatter hands each "key: value" line of a file's "---" header
+// to set and returns the body that follows
+func parseFrontMatter(data string, set func(key, value string) error) (string, error) {
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "---" {
+		return "", fmt.Errorf("missing --- header")
+	}
+	closed := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "---" {
+			closed = true
+			break
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return "", fmt.Errorf("bad header line %q", line)
+		}
+		if err := set(strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+			return "", err
+		}
+	}
+	if !closed {
+		return "", fmt.Errorf("header is not closed with ---")
+	}
+	var body strings.Builder
+	for scanner.Scan() {
+		body.WriteString(scanner.Text())
+		body.WriteByte('\n')
+	}
+	return strings.TrimLeft(body.String(), "\n"), nil
+}
+
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// writeLessonFile writes a version atomically so the watcher never reads
+// half a file
+func writeLessonFile(dir string, l *Lesson) error {
+	path := lessonPath(dir, l.ID, l.Version)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".lesson-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(lessonText(l)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// lessonText is a lesson in the lesson file format
+func lessonText(l *Lesson) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\ntitle: %s\ntopic: %s\ndifficulty: %s\n", l.Title, l.Topic, l.Difficulty)
+	fmt.Fprintf(&b, "key_concepts: %s\ncircuit_examples: %s\n", strings.Join(l.KeyConcepts, ", "), strings.Join(l.CircuitExamples, ", "))
+	fmt.Fprintf(&b, "next: %s\nprerequisites: %s\n", l.NextLessonID, strings.Join(l.Prerequisites, ", "))
+	fmt.Fprintf(&b, "minutes: %d\nauthor: %s\n---\n%s", l.EstimatedMin, l.Author, l.Content)
+	return b.String()
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// checkAuthor admits callers of the authoring API
+func (s *EducationServer) checkAuthor(token string) error {
+	if s.authorToken == "" {
+		return fmt.Errorf("lesson authoring is disabled")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.authorToken)) != 1 {
+		return fmt.Errorf("invalid author token")
+	}
+	return nil
+}
+
+func (s *EducationServer) PutLesson(ctx context.Context, req *pb.PutLessonRequest) (*pb.Lesson, error) {
+	if err := s.checkAuthor(req.AuthorToken); err != nil {
+		return nil, err
+	}
+	if req.Lesson == nil {
+		return nil, fmt.Errorf("lesson is required")
+	}
+	if req.Lesson.Language != "" {
+		lang := normalizeLanguage(req.Lesson.Language)
+		switch lang {
+		case "":
+			return nil, fmt.Errorf("bad language %q", req.Lesson.Language)
+		case defaultLanguage:
+		default:
+			return s.putTranslation(req, lang)
+		}
+	}
+	saved, err := s.content.put(lessonFromProto(req.Lesson), int(req.ExpectedVersion))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("📚 Lesson %s saved as version %d by %q", saved.ID, saved.Version, saved.Author)
+	return saved.proto(), nil
+}
+
+// putTranslation saves the lesson text of a PutLesson as a translation of
+// the current version; the rest of the lesson is the English original's
+func (s *EducationServer) putTranslation(req *pb.PutLessonRequest, lang string) (*pb.Lesson, error) {
+	saved, err := s.content.putTranslation(&lessonTranslation{
+		LessonID:    req.Lesson.Id,
+		Language:    lang,
+		Title:       req.Lesson.Title,
+		KeyConcepts: req.Lesson.KeyConcepts,
+		Content:     req.Lesson.ContentMarkdown,
+		Translator:  req.Lesson.Author,
+	}, int(req.ExpectedVersion))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("📚 Lesson %s translated into %s by %q", saved.LessonID, lang, saved.Translator)
+	return s.content.localize(s.content.current(saved.LessonID), []string{lang}).proto(), nil
+}
+
+func (s *EducationServer) GetLessonHistory(ctx context.Context, req *pb.LessonHistoryRequest) (*pb.LessonHistory, error) {
+	versions := s.content.history(req.LessonId)
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("lesson %s not found", req.LessonId)
+	}
+	history := &pb.LessonHistory{LessonId: req.LessonId}
+	for _, l := range versions {
+		history.Versions = append(history.Versions, &pb.LessonVersion{
+			Version:   int32(l.Version),
+			Title:     l.Title,
+			Author:    l.Author,
+			UpdatedAt: unixOrZero(l.UpdatedAt),
+		})
+	}
+	return history, nil
+}
@@ -0,0 +1,445 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// A course bundle carries lessons from one deployment to another as a zip:
+//
+//	course.json              the manifest
+//	lessons/<id>.md          current version, in the lesson file format
+//	circuits/<id>.json       circuits the lessons show, as in circuits.json
+//	questions.json           quiz questions on the lessons' topics
+//
+// Lessons come with every lesson they build on, prerequisites first, so a
+// bundle imports in order. Only the current English text travels;
+// translations and history stay behind.
+
+const (
+	courseFormat      = 1
+	maxCourseBundle   = 8 << 20  // Zipped
+	maxCourseUnpacked = 32 << 20 // All files together
+	courseManifest    = "course.json"
+)
+
+type courseManifestFile struct {
+	Format      int      `json:"format"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	ExportedAt  int64    `json:"exported_at"`
+	LessonIDs   []string `json:"lessons"`
+	CircuitIDs  []string `json:"circuits"`
+	QuestionIDs []string `json:"questions"`
+}
+
+func (m *courseManifestFile) proto() *pb.CourseManifest {
+	return &pb.CourseManifest{
+		Format:      int32(m.Format),
+		Name:        m.Name,
+		Description: m.Description,
+		ExportedAt:  m.ExportedAt,
+		LessonIds:   m.LessonIDs,
+		CircuitIds:  m.CircuitIDs,
+		QuestionIds: m.QuestionIDs,
+	}
+}
+
+// course is a bundle's content, checked and in manifest order
+type course struct {
+	manifest  courseManifestFile
+	lessons   []*Lesson
+	circuits  []*Circuit
+	questions []*Question
+	warnings  []string
+}
+
+// ------------------------------------------------------------------
+// Export
+// ------------------------------------------------------------------
+
+// collectCourse gathers goals and what they build on, the circuits they
+// show and the questions on their topics
+func (s *EducationServer) collectCourse(goals []string) (*course, error) {
+	catalog := s.content.catalog()
+	for _, id := range goals {
+		if catalog[id] == nil {
+			return nil, fmt.Errorf("lesson %s not found", id)
+		}
+	}
+	c := &course{}
+	topics := make(map[string]bool)
+	shown := make(map[string]bool)
+	for _, id := range lessonOrder(catalog, goals) {
+		l := catalog[id]
+		c.lessons = append(c.lessons, l)
+		topics[l.Topic] = true
+		for _, circuitID := range l.CircuitExamples {
+			if shown[circuitID] {
+				continue
+			}
+			shown[circuitID] = true
+			if circuit := s.content.circuit(circuitID); circuit != nil {
+				c.circuits = append(c.circuits, circuit)
+			}
+		}
+	}
+	for _, q := range s.content.questionBank() {
+		if topics[q.Topic] {
+			c.questions = append(c.questions, q)
+		}
+	}
+	return c, nil
+}
+
+func (c *course) zip() ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	modified := time.Unix(c.manifest.ExportedAt, 0)
+	add := func(name string, data []byte) error {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}
+	addJSON := func(name string, v any) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		return add(name, append(data, '\n'))
+	}
+
+	if err := addJSON(courseManifest, c.manifest); err != nil {
+		return nil, err
+	}
+	for _, l := range c.lessons {
+		if err := add("lessons/"+l.ID+".md", []byte(lessonText(l))); err != nil {
+			return nil, err
+		}
+	}
+	for _, circuit := range c.circuits {
+		if err := addJSON("circuits/"+circuit.ID+".json", circuit); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.questions) > 0 {
+		if err := addJSON(questionsFile, c.questions); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ------------------------------------------------------------------
+// Import
+// ------------------------------------------------------------------
+
+// readCourse unpacks and checks a bundle. Anything malformed refuses the
+// whole bundle, so an import never lands half a course.
+func readCourse(bundle []byte) (*course, error) {
+	if len(bundle) > maxCourseBundle {
+		return nil, fmt.Errorf("bundle is over %d MB", maxCourseBundle>>20)
+	}
+	r, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+	if err != nil {
+		return nil, fmt.Errorf("bundle is not a zip: %v", err)
+	}
+	files := make(map[string][]byte, len(r.File))
+	unpacked := int64(0)
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f.Name, err)
+		}
+		// The header's size is the sender's word; count what comes out
+		data, err := io.ReadAll(io.LimitReader(rc, maxCourseUnpacked-unpacked+1))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f.Name, err)
+		}
+		if unpacked += int64(len(data)); unpacked > maxCourseUnpacked {
+			return nil, fmt.Errorf("bundle unpacks to over %d MB", maxCourseUnpacked>>20)
+		}
+		files[f.Name] = data
+	}
+
+	c := &course{}
+	data, ok := files[courseManifest]
+	if !ok {
+		return nil, fmt.Errorf("bundle has no %s", courseManifest)
+	}
+	if err := json.Unmarshal(data, &c.manifest); err != nil {
+		return nil, fmt.Errorf("%s: %v", courseManifest, err)
+	}
+	if c.manifest.Format != courseFormat {
+		return nil, fmt.Errorf("bundle format %d is not supported", c.manifest.Format)
+	}
+	used := map[string]bool{courseManifest: true}
+
+	for _, id := range c.manifest.LessonIDs {
+		name := "lessons/" + id + ".md"
+		data, ok := files[name]
+		if !ok || !lessonIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("bundle is missing %s", name)
+		}
+		used[name] = true
+		l, err := parseLesson(id, canonicalText(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		c.lessons = append(c.lessons, l)
+	}
+
+	for _, id := range c.manifest.CircuitIDs {
+		name := "circuits/" + id + ".json"
+		data, ok := files[name]
+		if !ok || !lessonIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("bundle is missing %s", name)
+		}
+		used[name] = true
+		circuit := &Circuit{}
+		if err := json.Unmarshal(data, circuit); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if circuit.ID != id {
+			return nil, fmt.Errorf("%s holds circuit %q", name, circuit.ID)
+		}
+		if err := validateCircuit(circuit); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		c.circuits = append(c.circuits, circuit)
+	}
+
+	if len(c.manifest.QuestionIDs) > 0 {
+		var bank []*Question
+		if err := json.Unmarshal(files[questionsFile], &bank); err != nil {
+			return nil, fmt.Errorf("%s: %v", questionsFile, err)
+		}
+		used[questionsFile] = true
+		byID := make(map[string]*Question, len(bank))
+		for _, q := range bank {
+			byID[q.ID] = q
+		}
+		for _, id := range c.manifest.QuestionIDs {
+			q, ok := byID[id]
+			if !ok {
+				return nil, fmt.Errorf("%s is missing question %s", questionsFile, id)
+			}
+			q = q.canonical()
+			if err := validateQuestion(q); err != nil {
+				return nil, fmt.Errorf("%s: %v", questionsFile, err)
+			}
+			c.questions = append(c.questions, q)
+		}
+	}
+
+	var extra []string
+	for name := range files {
+		if !used[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		c.warnings = append(c.warnings, fmt.Sprintf("ignored %s: not in the manifest", name))
+	}
+	return c, nil
+}
+
+// sameJSON compares items as they would be saved
+func sameJSON(a, b any) bool {
+	x, errX := json.Marshal(a)
+	y, errY := json.Marshal(b)
+	return errX == nil && errY == nil && bytes.Equal(x, y)
+}
+
+// sameLesson compares lessons as a lesson file holds them
+func sameLesson(a, b *Lesson) bool {
+	x, errX := parseLesson(a.ID, lessonText(a))
+	y, errY := parseLesson(b.ID, lessonText(b))
+	return errX == nil && errY == nil && lessonText(x) == lessonText(y)
+}
+
+// importCourse sorts a course's items into new, unchanged and differing,
+// and unless dryRun saves the new ones and, with replace, the differing
+func (s *EducationServer) importCourse(c *course, replace, dryRun bool) (*pb.ImportCourseResult, error) {
+	s.importMu.Lock()
+	defer s.importMu.Unlock()
+	result := &pb.ImportCourseResult{Manifest: c.manifest.proto(), Warnings: c.warnings, DryRun: dryRun}
+	differs := func(kind, id string) bool {
+		if replace {
+			return true
+		}
+		result.Skipped = append(result.Skipped, kind+":"+id)
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s %s differs from the one here; import with replace to overwrite it", kind, id))
+		return false
+	}
+
+	var circuits []*Circuit
+	for _, circuit := range c.circuits {
+		switch existing := s.content.circuit(circuit.ID); {
+		case existing != nil && sameJSON(existing, circuit):
+			result.Unchanged = append(result.Unchanged, "circuit:"+circuit.ID)
+		case existing == nil || differs("circuit", circuit.ID):
+			circuits = append(circuits, circuit)
+		}
+	}
+	var bank []*Question
+	for _, q := range c.questions {
+		switch existing := s.content.question(q.ID); {
+		case existing != nil && sameJSON(existing, q):
+			result.Unchanged = append(result.Unchanged, "question:"+q.ID)
+		case existing == nil || differs("question", q.ID):
+			bank = append(bank, q)
+		}
+	}
+	if !dryRun && len(circuits)+len(bank) > 0 {
+		if err := s.content.putLibrary(circuits, bank); err != nil {
+			return nil, err
+		}
+	}
+	for _, circuit := range circuits {
+		result.Imported = append(result.Imported, "circuit:"+circuit.ID)
+	}
+	for _, q := range bank {
+		result.Imported = append(result.Imported, "question:"+q.ID)
+	}
+
+	// Lessons go in manifest order, so prerequisites land first
+	catalog := s.content.catalog()
+	for _, l := range c.lessons {
+		existing := catalog[l.ID]
+		if existing != nil && sameLesson(existing, l) {
+			result.Unchanged = append(result.Unchanged, "lesson:"+l.ID)
+			continue
+		}
+		if existing != nil && !differs("lesson", l.ID) {
+			continue
+		}
+		expected := 0
+		if existing != nil {
+			expected = existing.Version
+		}
+		if dryRun {
+			for _, p := range l.Prerequisites {
+				if catalog[p] == nil {
+					result.Skipped = append(result.Skipped, "lesson:"+l.ID)
+					result.Warnings = append(result.Warnings, fmt.Sprintf("lesson %s: unknown prerequisite %s", l.ID, p))
+					l = nil
+					break
+				}
+			}
+			if l != nil {
+				catalog[l.ID] = l
+				result.Imported = append(result.Imported, "lesson:"+l.ID)
+			}
+			continue
+		}
+		saved, err := s.content.put(l, expected)
+		if err != nil {
+			result.Skipped = append(result.Skipped, "lesson:"+l.ID)
+			result.Warnings = append(result.Warnings, err.Error())
+			continue
+		}
+		catalog[saved.ID] = saved
+		result.Imported = append(result.Imported, "lesson:"+saved.ID)
+	}
+	return result, nil
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// ExportCourse bundles a track, the lessons named, or with neither every
+// lesson
+func (s *EducationServer) ExportCourse(ctx context.Context, req *pb.ExportCourseRequest) (*pb.CourseBundle, error) {
+	if err := s.checkAuthor(req.AuthorToken); err != nil {
+		return nil, err
+	}
+	name, goals := req.Name, req.LessonIds
+	if req.TrackId != "" {
+		t := findTrack(req.TrackId)
+		if t == nil {
+			return nil, fmt.Errorf("track %s not found", req.TrackId)
+		}
+		goals = append(append([]string(nil), t.LessonIDs...), goals...)
+		if name == "" {
+			name = t.Name
+		}
+	}
+	if len(goals) == 0 {
+		goals = sortedIDs(s.content.catalog())
+	}
+	if name == "" {
+		name = "Quantum lessons"
+	}
+
+	c, err := s.collectCourse(goals)
+	if err != nil {
+		return nil, err
+	}
+	c.manifest = courseManifestFile{
+		Format:      courseFormat,
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(req.Description),
+		ExportedAt:  time.Now().Unix(),
+	}
+	for _, l := range c.lessons {
+		c.manifest.LessonIDs = append(c.manifest.LessonIDs, l.ID)
+	}
+	for _, circuit := range c.circuits {
+		c.manifest.CircuitIDs = append(c.manifest.CircuitIDs, circuit.ID)
+	}
+	for _, q := range c.questions {
+		c.manifest.QuestionIDs = append(c.manifest.QuestionIDs, q.ID)
+	}
+	bundle, err := c.zip()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("📚 Exported %q: %d lessons, %d circuits, %d questions (%d bytes)",
+		c.manifest.Name, len(c.lessons), len(c.circuits), len(c.questions), len(bundle))
+	return &pb.CourseBundle{Bundle: bundle, Manifest: c.manifest.proto()}, nil
+}
+
+func (s *EducationServer) ImportCourse(ctx context.Context, req *pb.ImportCourseRequest) (*pb.ImportCourseResult, error) {
+	if err := s.checkAuthor(req.AuthorToken); err != nil {
+		return nil, err
+	}
+	if s.content.dir == "" {
+		return nil, fmt.Errorf("importing needs a content directory (-content-dir)")
+	}
+	c, err := readCourse(req.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.importCourse(c, req.Replace, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	if !req.DryRun {
+		log.Printf("📚 Imported %q: %d items new or replaced, %d unchanged, %d skipped",
+			c.manifest.Name, len(result.Imported), len(result.Unchanged), len(result.Skipped))
+	}
+	return result, nil
+}
@@ -0,0 +1,1555 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: education.proto
+
+package generated
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuantumEducation_GetLesson_FullMethodName               = "/qubit_engine.education.QuantumEducation/GetLesson"
+	QuantumEducation_ListLessons_FullMethodName             = "/qubit_engine.education.QuantumEducation/ListLessons"
+	QuantumEducation_PutLesson_FullMethodName               = "/qubit_engine.education.QuantumEducation/PutLesson"
+	QuantumEducation_GetLessonHistory_FullMethodName        = "/qubit_engine.education.QuantumEducation/GetLessonHistory"
+	QuantumEducation_ListLanguages_FullMethodName           = "/qubit_engine.education.QuantumEducation/ListLanguages"
+	QuantumEducation_RenderContent_FullMethodName           = "/qubit_engine.education.QuantumEducation/RenderContent"
+	QuantumEducation_ExportCourse_FullMethodName            = "/qubit_engine.education.QuantumEducation/ExportCourse"
+	QuantumEducation_ImportCourse_FullMethodName            = "/qubit_engine.education.QuantumEducation/ImportCourse"
+	QuantumEducation_ListTracks_FullMethodName              = "/qubit_engine.education.QuantumEducation/ListTracks"
+	QuantumEducation_GetLearningPath_FullMethodName         = "/qubit_engine.education.QuantumEducation/GetLearningPath"
+	QuantumEducation_GetNextRecommended_FullMethodName      = "/qubit_engine.education.QuantumEducation/GetNextRecommended"
+	QuantumEducation_CompleteLesson_FullMethodName          = "/qubit_engine.education.QuantumEducation/CompleteLesson"
+	QuantumEducation_GetCertificate_FullMethodName          = "/qubit_engine.education.QuantumEducation/GetCertificate"
+	QuantumEducation_VerifyCertificate_FullMethodName       = "/qubit_engine.education.QuantumEducation/VerifyCertificate"
+	QuantumEducation_CreateClass_FullMethodName             = "/qubit_engine.education.QuantumEducation/CreateClass"
+	QuantumEducation_EnrollStudents_FullMethodName          = "/qubit_engine.education.QuantumEducation/EnrollStudents"
+	QuantumEducation_JoinClass_FullMethodName               = "/qubit_engine.education.QuantumEducation/JoinClass"
+	QuantumEducation_AssignWork_FullMethodName              = "/qubit_engine.education.QuantumEducation/AssignWork"
+	QuantumEducation_ListClasses_FullMethodName             = "/qubit_engine.education.QuantumEducation/ListClasses"
+	QuantumEducation_ListClassProgress_FullMethodName       = "/qubit_engine.education.QuantumEducation/ListClassProgress"
+	QuantumEducation_GetCircuit_FullMethodName              = "/qubit_engine.education.QuantumEducation/GetCircuit"
+	QuantumEducation_ListCircuits_FullMethodName            = "/qubit_engine.education.QuantumEducation/ListCircuits"
+	QuantumEducation_GenerateQuiz_FullMethodName            = "/qubit_engine.education.QuantumEducation/GenerateQuiz"
+	QuantumEducation_SubmitAnswers_FullMethodName           = "/qubit_engine.education.QuantumEducation/SubmitAnswers"
+	QuantumEducation_GetQuizAttempts_FullMethodName         = "/qubit_engine.education.QuantumEducation/GetQuizAttempts"
+	QuantumEducation_GetQuizLeaderboard_FullMethodName      = "/qubit_engine.education.QuantumEducation/GetQuizLeaderboard"
+	QuantumEducation_GetHint_FullMethodName                 = "/qubit_engine.education.QuantumEducation/GetHint"
+	QuantumEducation_GetDueReviews_FullMethodName           = "/qubit_engine.education.QuantumEducation/GetDueReviews"
+	QuantumEducation_SubmitReview_FullMethodName            = "/qubit_engine.education.QuantumEducation/SubmitReview"
+	QuantumEducation_RunSandboxCircuit_FullMethodName       = "/qubit_engine.education.QuantumEducation/RunSandboxCircuit"
+	QuantumEducation_ListChallenges_FullMethodName          = "/qubit_engine.education.QuantumEducation/ListChallenges"
+	QuantumEducation_GetChallenge_FullMethodName            = "/qubit_engine.education.QuantumEducation/GetChallenge"
+	QuantumEducation_SubmitChallenge_FullMethodName         = "/qubit_engine.education.QuantumEducation/SubmitChallenge"
+	QuantumEducation_GetChallengeLeaderboard_FullMethodName = "/qubit_engine.education.QuantumEducation/GetChallengeLeaderboard"
+	QuantumEducation_RecordEvent_FullMethodName             = "/qubit_engine.education.QuantumEducation/RecordEvent"
+	QuantumEducation_GetAchievements_FullMethodName         = "/qubit_engine.education.QuantumEducation/GetAchievements"
+	QuantumEducation_ListBadges_FullMethodName              = "/qubit_engine.education.QuantumEducation/ListBadges"
+)
+
+// QuantumEducationClient is the client API for QuantumEducation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumEducationClient interface {
+	// Get a lesson by ID, or the first lesson on a topic
+	GetLesson(ctx context.Context, in *LessonRequest, opts ...grpc.CallOption) (*Lesson, error)
+	// List available lessons
+	ListLessons(ctx context.Context, in *LessonListRequest, opts ...grpc.CallOption) (*LessonCatalog, error)
+	// Authoring: add a lesson or save a new version of one
+	PutLesson(ctx context.Context, in *PutLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
+	// Every saved version of a lesson
+	GetLessonHistory(ctx context.Context, in *LessonHistoryRequest, opts ...grpc.CallOption) (*LessonHistory, error)
+	// Translations available, and how much of the content each covers
+	ListLanguages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LanguageCatalog, error)
+	// Preview how lesson Markdown is stored and rendered
+	RenderContent(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderedContent, error)
+	// Authoring: bundle lessons with their circuits and quiz questions,
+	// and load a bundle from another deployment
+	ExportCourse(ctx context.Context, in *ExportCourseRequest, opts ...grpc.CallOption) (*CourseBundle, error)
+	ImportCourse(ctx context.Context, in *ImportCourseRequest, opts ...grpc.CallOption) (*ImportCourseResult, error)
+	// Curated tracks through the lesson graph
+	ListTracks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TrackCatalog, error)
+	// A track's lessons in prerequisite order, with the learner's progress
+	GetLearningPath(ctx context.Context, in *LearningPathRequest, opts ...grpc.CallOption) (*LearningPath, error)
+	// The next lesson a learner can take, by their progress
+	GetNextRecommended(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (*Recommendation, error)
+	// Mark a lesson finished once its prerequisites are
+	CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*LessonCompletion, error)
+	// A learner's certificate for a finished track, as a PNG or PDF
+	GetCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateDocument, error)
+	// Check a certificate's verification code and signature
+	VerifyCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*CertificateVerification, error)
+	// Classrooms: instructors create classes, enroll students and assign work
+	CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*Class, error)
+	EnrollStudents(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Class, error)
+	JoinClass(ctx context.Context, in *JoinClassRequest, opts ...grpc.CallOption) (*Class, error)
+	AssignWork(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Class, error)
+	// Classes a user teaches or is enrolled in
+	ListClasses(ctx context.Context, in *ListClassesRequest, opts ...grpc.CallOption) (*ClassList, error)
+	// Every student's standing on every assignment, for a class's instructors
+	ListClassProgress(ctx context.Context, in *ClassProgressRequest, opts ...grpc.CallOption) (*ClassProgress, error)
+	// Get circuit from library
+	GetCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*LibraryCircuit, error)
+	// List circuit library
+	ListCircuits(ctx context.Context, in *CircuitFilter, opts ...grpc.CallOption) (*CircuitCatalog, error)
+	// Start a quiz drawn from the question bank; answers stay on the server
+	GenerateQuiz(ctx context.Context, in *QuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	// Grade answers to some or all of a quiz's questions, once each
+	SubmitAnswers(ctx context.Context, in *QuizSubmission, opts ...grpc.CallOption) (*QuizResult, error)
+	// A learner's quiz attempts, most recent first
+	GetQuizAttempts(ctx context.Context, in *AttemptsRequest, opts ...grpc.CallOption) (*AttemptHistory, error)
+	// This week's quiz standings by points, fastest answer or daily streak
+	GetQuizLeaderboard(ctx context.Context, in *QuizLeaderboardRequest, opts ...grpc.CallOption) (*QuizLeaderboard, error)
+	// The next hint for a quiz question or challenge, at a cost in points
+	GetHint(ctx context.Context, in *HintRequest, opts ...grpc.CallOption) (*Hint, error)
+	// Missed questions due for review, or every learner with reviews due
+	GetDueReviews(ctx context.Context, in *DueReviewsRequest, opts ...grpc.CallOption) (*DueReviews, error)
+	// Answer a review question and reschedule it
+	SubmitReview(ctx context.Context, in *ReviewSubmission, opts ...grpc.CallOption) (*ReviewResult, error)
+	// Run a learner's circuit on the engine and trace it gate by gate
+	RunSandboxCircuit(ctx context.Context, in *SandboxRequest, opts ...grpc.CallOption) (*SandboxResult, error)
+	// Circuit challenges: reach a target distribution within limits
+	ListChallenges(ctx context.Context, in *ChallengeFilter, opts ...grpc.CallOption) (*ChallengeCatalog, error)
+	GetChallenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*Challenge, error)
+	// Simulate and score a solution, ranking it if correct
+	SubmitChallenge(ctx context.Context, in *ChallengeSubmission, opts ...grpc.CallOption) (*ChallengeResult, error)
+	// Best correct solution per learner, highest score first
+	GetChallengeLeaderboard(ctx context.Context, in *ChallengeLeaderboardRequest, opts ...grpc.CallOption) (*ChallengeLeaderboard, error)
+	// Report activity from other modules toward a learner's badges
+	RecordEvent(ctx context.Context, in *AchievementEvent, opts ...grpc.CallOption) (*EventAck, error)
+	// A learner's badges, unlocked or in progress
+	GetAchievements(ctx context.Context, in *AchievementsRequest, opts ...grpc.CallOption) (*AchievementList, error)
+	// Every badge and what unlocks it
+	ListBadges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BadgeCatalog, error)
+}
+
+type quantumEducationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumEducationClient(cc grpc.ClientConnInterface) QuantumEducationClient {
+	return &quantumEducationClient{cc}
+}
+
+func (c *quantumEducationClient) GetLesson(ctx context.Context, in *LessonRequest, opts ...grpc.CallOption) (*Lesson, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lesson)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListLessons(ctx context.Context, in *LessonListRequest, opts ...grpc.CallOption) (*LessonCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonCatalog)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) PutLesson(ctx context.Context, in *PutLessonRequest, opts ...grpc.CallOption) (*Lesson, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lesson)
+	err := c.cc.Invoke(ctx, QuantumEducation_PutLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetLessonHistory(ctx context.Context, in *LessonHistoryRequest, opts ...grpc.CallOption) (*LessonHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonHistory)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetLessonHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListLanguages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LanguageCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LanguageCatalog)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListLanguages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) RenderContent(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderedContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderedContent)
+	err := c.cc.Invoke(ctx, QuantumEducation_RenderContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ExportCourse(ctx context.Context, in *ExportCourseRequest, opts ...grpc.CallOption) (*CourseBundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseBundle)
+	err := c.cc.Invoke(ctx, QuantumEducation_ExportCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ImportCourse(ctx context.Context, in *ImportCourseRequest, opts ...grpc.CallOption) (*ImportCourseResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCourseResult)
+	err := c.cc.Invoke(ctx, QuantumEducation_ImportCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListTracks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TrackCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackCatalog)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListTracks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetLearningPath(ctx context.Context, in *LearningPathRequest, opts ...grpc.CallOption) (*LearningPath, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPath)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetNextRecommended(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (*Recommendation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recommendation)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetNextRecommended_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*LessonCompletion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonCompletion)
+	err := c.cc.Invoke(ctx, QuantumEducation_CompleteLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateDocument)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) VerifyCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*CertificateVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateVerification)
+	err := c.cc.Invoke(ctx, QuantumEducation_VerifyCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*Class, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Class)
+	err := c.cc.Invoke(ctx, QuantumEducation_CreateClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) EnrollStudents(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*Class, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Class)
+	err := c.cc.Invoke(ctx, QuantumEducation_EnrollStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) JoinClass(ctx context.Context, in *JoinClassRequest, opts ...grpc.CallOption) (*Class, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Class)
+	err := c.cc.Invoke(ctx, QuantumEducation_JoinClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) AssignWork(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Class, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Class)
+	err := c.cc.Invoke(ctx, QuantumEducation_AssignWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListClasses(ctx context.Context, in *ListClassesRequest, opts ...grpc.CallOption) (*ClassList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassList)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListClassProgress(ctx context.Context, in *ClassProgressRequest, opts ...grpc.CallOption) (*ClassProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassProgress)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListClassProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*LibraryCircuit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LibraryCircuit)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListCircuits(ctx context.Context, in *CircuitFilter, opts ...grpc.CallOption) (*CircuitCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CircuitCatalog)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListCircuits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GenerateQuiz(ctx context.Context, in *QuizRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuantumEducation_GenerateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) SubmitAnswers(ctx context.Context, in *QuizSubmission, opts ...grpc.CallOption) (*QuizResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizResult)
+	err := c.cc.Invoke(ctx, QuantumEducation_SubmitAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetQuizAttempts(ctx context.Context, in *AttemptsRequest, opts ...grpc.CallOption) (*AttemptHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttemptHistory)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetQuizAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetQuizLeaderboard(ctx context.Context, in *QuizLeaderboardRequest, opts ...grpc.CallOption) (*QuizLeaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizLeaderboard)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetQuizLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetHint(ctx context.Context, in *HintRequest, opts ...grpc.CallOption) (*Hint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hint)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetHint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetDueReviews(ctx context.Context, in *DueReviewsRequest, opts ...grpc.CallOption) (*DueReviews, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DueReviews)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetDueReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) SubmitReview(ctx context.Context, in *ReviewSubmission, opts ...grpc.CallOption) (*ReviewResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResult)
+	err := c.cc.Invoke(ctx, QuantumEducation_SubmitReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) RunSandboxCircuit(ctx context.Context, in *SandboxRequest, opts ...grpc.CallOption) (*SandboxResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SandboxResult)
+	err := c.cc.Invoke(ctx, QuantumEducation_RunSandboxCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListChallenges(ctx context.Context, in *ChallengeFilter, opts ...grpc.CallOption) (*ChallengeCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengeCatalog)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListChallenges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetChallenge(ctx context.Context, in *ChallengeRequest, opts ...grpc.CallOption) (*Challenge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) SubmitChallenge(ctx context.Context, in *ChallengeSubmission, opts ...grpc.CallOption) (*ChallengeResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengeResult)
+	err := c.cc.Invoke(ctx, QuantumEducation_SubmitChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetChallengeLeaderboard(ctx context.Context, in *ChallengeLeaderboardRequest, opts ...grpc.CallOption) (*ChallengeLeaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengeLeaderboard)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetChallengeLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) RecordEvent(ctx context.Context, in *AchievementEvent, opts ...grpc.CallOption) (*EventAck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventAck)
+	err := c.cc.Invoke(ctx, QuantumEducation_RecordEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) GetAchievements(ctx context.Context, in *AchievementsRequest, opts ...grpc.CallOption) (*AchievementList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AchievementList)
+	err := c.cc.Invoke(ctx, QuantumEducation_GetAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumEducationClient) ListBadges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BadgeCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BadgeCatalog)
+	err := c.cc.Invoke(ctx, QuantumEducation_ListBadges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumEducationServer is the server API for QuantumEducation service.
+// All implementations must embed UnimplementedQuantumEducationServer
+// for forward compatibility.
+type QuantumEducationServer interface {
+	// Get a lesson by ID, or the first lesson on a topic
+	GetLesson(context.Context, *LessonRequest) (*Lesson, error)
+	// List available lessons
+	ListLessons(context.Context, *LessonListRequest) (*LessonCatalog, error)
+	// Authoring: add a lesson or save a new version of one
+	PutLesson(context.Context, *PutLessonRequest) (*Lesson, error)
+	// Every saved version of a lesson
+	GetLessonHistory(context.Context, *LessonHistoryRequest) (*LessonHistory, error)
+	// Translations available, and how much of the content each covers
+	ListLanguages(context.Context, *Empty) (*LanguageCatalog, error)
+	// Preview how lesson Markdown is stored and rendered
+	RenderContent(context.Context, *RenderRequest) (*RenderedContent, error)
+	// Authoring: bundle lessons with their circuits and quiz questions,
+	// and load a bundle from another deployment
+	ExportCourse(context.Context, *ExportCourseRequest) (*CourseBundle, error)
+	ImportCourse(context.Context, *ImportCourseRequest) (*ImportCourseResult, error)
+	// Curated tracks through the lesson graph
+	ListTracks(context.Context, *Empty) (*TrackCatalog, error)
+	// A track's lessons in prerequisite order, with the learner's progress
+	GetLearningPath(context.Context, *LearningPathRequest) (*LearningPath, error)
+	// The next lesson a learner can take, by their progress
+	GetNextRecommended(context.Context, *RecommendationRequest) (*Recommendation, error)
+	// Mark a lesson finished once its prerequisites are
+	CompleteLesson(context.Context, *CompleteLessonRequest) (*LessonCompletion, error)
+	// A learner's certificate for a finished track, as a PNG or PDF
+	GetCertificate(context.Context, *CertificateRequest) (*CertificateDocument, error)
+	// Check a certificate's verification code and signature
+	VerifyCertificate(context.Context, *VerifyCertificateRequest) (*CertificateVerification, error)
+	// Classrooms: instructors create classes, enroll students and assign work
+	CreateClass(context.Context, *CreateClassRequest) (*Class, error)
+	EnrollStudents(context.Context, *EnrollmentRequest) (*Class, error)
+	JoinClass(context.Context, *JoinClassRequest) (*Class, error)
+	AssignWork(context.Context, *AssignmentRequest) (*Class, error)
+	// Classes a user teaches or is enrolled in
+	ListClasses(context.Context, *ListClassesRequest) (*ClassList, error)
+	// Every student's standing on every assignment, for a class's instructors
+	ListClassProgress(context.Context, *ClassProgressRequest) (*ClassProgress, error)
+	// Get circuit from library
+	GetCircuit(context.Context, *CircuitRequest) (*LibraryCircuit, error)
+	// List circuit library
+	ListCircuits(context.Context, *CircuitFilter) (*CircuitCatalog, error)
+	// Start a quiz drawn from the question bank; answers stay on the server
+	GenerateQuiz(context.Context, *QuizRequest) (*Quiz, error)
+	// Grade answers to some or all of a quiz's questions, once each
+	SubmitAnswers(context.Context, *QuizSubmission) (*QuizResult, error)
+	// A learner's quiz attempts, most recent first
+	GetQuizAttempts(context.Context, *AttemptsRequest) (*AttemptHistory, error)
+	// This week's quiz standings by points, fastest answer or daily streak
+	GetQuizLeaderboard(context.Context, *QuizLeaderboardRequest) (*QuizLeaderboard, error)
+	// The next hint for a quiz question or challenge, at a cost in points
+	GetHint(context.Context, *HintRequest) (*Hint, error)
+	// Missed questions due for review, or every learner with reviews due
+	GetDueReviews(context.Context, *DueReviewsRequest) (*DueReviews, error)
+	// Answer a review question and reschedule it
+	SubmitReview(context.Context, *ReviewSubmission) (*ReviewResult, error)
+	// Run a learner's circuit on the engine and trace it gate by gate
+	RunSandboxCircuit(context.Context, *SandboxRequest) (*SandboxResult, error)
+	// Circuit challenges: reach a target distribution within limits
+	ListChallenges(context.Context, *ChallengeFilter) (*ChallengeCatalog, error)
+	GetChallenge(context.Context, *ChallengeRequest) (*Challenge, error)
+	// Simulate and score a solution, ranking it if correct
+	SubmitChallenge(context.Context, *ChallengeSubmission) (*ChallengeResult, error)
+	// Best correct solution per learner, highest score first
+	GetChallengeLeaderboard(context.Context, *ChallengeLeaderboardRequest) (*ChallengeLeaderboard, error)
+	// Report activity from other modules toward a learner's badges
+	RecordEvent(context.Context, *AchievementEvent) (*EventAck, error)
+	// A learner's badges, unlocked or in progress
+	GetAchievements(context.Context, *AchievementsRequest) (*AchievementList, error)
+	// Every badge and what unlocks it
+	ListBadges(context.Context, *Empty) (*BadgeCatalog, error)
+	mustEmbedUnimplementedQuantumEducationServer()
+}
+
+// UnimplementedQuantumEducationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumEducationServer struct{}
+
+func (UnimplementedQuantumEducationServer) GetLesson(context.Context, *LessonRequest) (*Lesson, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLesson not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListLessons(context.Context, *LessonListRequest) (*LessonCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLessons not implemented")
+}
+func (UnimplementedQuantumEducationServer) PutLesson(context.Context, *PutLessonRequest) (*Lesson, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutLesson not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetLessonHistory(context.Context, *LessonHistoryRequest) (*LessonHistory, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLessonHistory not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListLanguages(context.Context, *Empty) (*LanguageCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLanguages not implemented")
+}
+func (UnimplementedQuantumEducationServer) RenderContent(context.Context, *RenderRequest) (*RenderedContent, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderContent not implemented")
+}
+func (UnimplementedQuantumEducationServer) ExportCourse(context.Context, *ExportCourseRequest) (*CourseBundle, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCourse not implemented")
+}
+func (UnimplementedQuantumEducationServer) ImportCourse(context.Context, *ImportCourseRequest) (*ImportCourseResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCourse not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListTracks(context.Context, *Empty) (*TrackCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTracks not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetLearningPath(context.Context, *LearningPathRequest) (*LearningPath, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLearningPath not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetNextRecommended(context.Context, *RecommendationRequest) (*Recommendation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNextRecommended not implemented")
+}
+func (UnimplementedQuantumEducationServer) CompleteLesson(context.Context, *CompleteLessonRequest) (*LessonCompletion, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteLesson not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetCertificate(context.Context, *CertificateRequest) (*CertificateDocument, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCertificate not implemented")
+}
+func (UnimplementedQuantumEducationServer) VerifyCertificate(context.Context, *VerifyCertificateRequest) (*CertificateVerification, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyCertificate not implemented")
+}
+func (UnimplementedQuantumEducationServer) CreateClass(context.Context, *CreateClassRequest) (*Class, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateClass not implemented")
+}
+func (UnimplementedQuantumEducationServer) EnrollStudents(context.Context, *EnrollmentRequest) (*Class, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollStudents not implemented")
+}
+func (UnimplementedQuantumEducationServer) JoinClass(context.Context, *JoinClassRequest) (*Class, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinClass not implemented")
+}
+func (UnimplementedQuantumEducationServer) AssignWork(context.Context, *AssignmentRequest) (*Class, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignWork not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListClasses(context.Context, *ListClassesRequest) (*ClassList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClasses not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListClassProgress(context.Context, *ClassProgressRequest) (*ClassProgress, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClassProgress not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetCircuit(context.Context, *CircuitRequest) (*LibraryCircuit, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCircuit not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListCircuits(context.Context, *CircuitFilter) (*CircuitCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCircuits not implemented")
+}
+func (UnimplementedQuantumEducationServer) GenerateQuiz(context.Context, *QuizRequest) (*Quiz, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateQuiz not implemented")
+}
+func (UnimplementedQuantumEducationServer) SubmitAnswers(context.Context, *QuizSubmission) (*QuizResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitAnswers not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetQuizAttempts(context.Context, *AttemptsRequest) (*AttemptHistory, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuizAttempts not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetQuizLeaderboard(context.Context, *QuizLeaderboardRequest) (*QuizLeaderboard, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuizLeaderboard not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetHint(context.Context, *HintRequest) (*Hint, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHint not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetDueReviews(context.Context, *DueReviewsRequest) (*DueReviews, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDueReviews not implemented")
+}
+func (UnimplementedQuantumEducationServer) SubmitReview(context.Context, *ReviewSubmission) (*ReviewResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedQuantumEducationServer) RunSandboxCircuit(context.Context, *SandboxRequest) (*SandboxResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunSandboxCircuit not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListChallenges(context.Context, *ChallengeFilter) (*ChallengeCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChallenges not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetChallenge(context.Context, *ChallengeRequest) (*Challenge, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChallenge not implemented")
+}
+func (UnimplementedQuantumEducationServer) SubmitChallenge(context.Context, *ChallengeSubmission) (*ChallengeResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitChallenge not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetChallengeLeaderboard(context.Context, *ChallengeLeaderboardRequest) (*ChallengeLeaderboard, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChallengeLeaderboard not implemented")
+}
+func (UnimplementedQuantumEducationServer) RecordEvent(context.Context, *AchievementEvent) (*EventAck, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordEvent not implemented")
+}
+func (UnimplementedQuantumEducationServer) GetAchievements(context.Context, *AchievementsRequest) (*AchievementList, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAchievements not implemented")
+}
+func (UnimplementedQuantumEducationServer) ListBadges(context.Context, *Empty) (*BadgeCatalog, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBadges not implemented")
+}
+func (UnimplementedQuantumEducationServer) mustEmbedUnimplementedQuantumEducationServer() {}
+func (UnimplementedQuantumEducationServer) testEmbeddedByValue()                          {}
+
+// UnsafeQuantumEducationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumEducationServer will
+// result in compilation errors.
+type UnsafeQuantumEducationServer interface {
+	mustEmbedUnimplementedQuantumEducationServer()
+}
+
+func RegisterQuantumEducationServer(s grpc.ServiceRegistrar, srv QuantumEducationServer) {
+	// If the following call panics, it indicates UnimplementedQuantumEducationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumEducation_ServiceDesc, srv)
+}
+
+func _QuantumEducation_GetLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetLesson(ctx, req.(*LessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LessonListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListLessons(ctx, req.(*LessonListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_PutLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).PutLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_PutLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).PutLesson(ctx, req.(*PutLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetLessonHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LessonHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetLessonHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetLessonHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetLessonHistory(ctx, req.(*LessonHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListLanguages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListLanguages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListLanguages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListLanguages(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_RenderContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).RenderContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_RenderContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).RenderContent(ctx, req.(*RenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ExportCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ExportCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ExportCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ExportCourse(ctx, req.(*ExportCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ImportCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ImportCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ImportCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ImportCourse(ctx, req.(*ImportCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListTracks(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetLearningPath(ctx, req.(*LearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetNextRecommended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetNextRecommended(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetNextRecommended_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetNextRecommended(ctx, req.(*RecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_CompleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).CompleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_CompleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).CompleteLesson(ctx, req.(*CompleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetCertificate(ctx, req.(*CertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_VerifyCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).VerifyCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_VerifyCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).VerifyCertificate(ctx, req.(*VerifyCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_CreateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).CreateClass(ctx, req.(*CreateClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_EnrollStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).EnrollStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_EnrollStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).EnrollStudents(ctx, req.(*EnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_JoinClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).JoinClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_JoinClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).JoinClass(ctx, req.(*JoinClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_AssignWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).AssignWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_AssignWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).AssignWork(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListClasses(ctx, req.(*ListClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListClassProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListClassProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListClassProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListClassProgress(ctx, req.(*ClassProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetCircuit(ctx, req.(*CircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListCircuits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListCircuits(ctx, req.(*CircuitFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GenerateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GenerateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GenerateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GenerateQuiz(ctx, req.(*QuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_SubmitAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizSubmission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).SubmitAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_SubmitAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).SubmitAnswers(ctx, req.(*QuizSubmission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetQuizAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetQuizAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetQuizAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetQuizAttempts(ctx, req.(*AttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetQuizLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetQuizLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetQuizLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetQuizLeaderboard(ctx, req.(*QuizLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetHint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetHint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetHint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetHint(ctx, req.(*HintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetDueReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetDueReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetDueReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetDueReviews(ctx, req.(*DueReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewSubmission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).SubmitReview(ctx, req.(*ReviewSubmission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_RunSandboxCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).RunSandboxCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_RunSandboxCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).RunSandboxCircuit(ctx, req.(*SandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListChallenges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListChallenges(ctx, req.(*ChallengeFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetChallenge(ctx, req.(*ChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_SubmitChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeSubmission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).SubmitChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_SubmitChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).SubmitChallenge(ctx, req.(*ChallengeSubmission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetChallengeLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetChallengeLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetChallengeLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetChallengeLeaderboard(ctx, req.(*ChallengeLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_RecordEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AchievementEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).RecordEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_RecordEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).RecordEvent(ctx, req.(*AchievementEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_GetAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).GetAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_GetAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).GetAchievements(ctx, req.(*AchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumEducation_ListBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumEducationServer).ListBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumEducation_ListBadges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumEducationServer).ListBadges(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumEducation_ServiceDesc is the grpc.ServiceDesc for QuantumEducation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumEducation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.education.QuantumEducation",
+	HandlerType: (*QuantumEducationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLesson",
+			Handler:    _QuantumEducation_GetLesson_Handler,
+		},
+		{
+			MethodName: "ListLessons",
+			Handler:    _QuantumEducation_ListLessons_Handler,
+		},
+		{
+			MethodName: "PutLesson",
+			Handler:    _QuantumEducation_PutLesson_Handler,
+		},
+		{
+			MethodName: "GetLessonHistory",
+			Handler:    _QuantumEducation_GetLessonHistory_Handler,
+		},
+		{
+			MethodName: "ListLanguages",
+			Handler:    _QuantumEducation_ListLanguages_Handler,
+		},
+		{
+			MethodName: "RenderContent",
+			Handler:    _QuantumEducation_RenderContent_Handler,
+		},
+		{
+			MethodName: "ExportCourse",
+			Handler:    _QuantumEducation_ExportCourse_Handler,
+		},
+		{
+			MethodName: "ImportCourse",
+			Handler:    _QuantumEducation_ImportCourse_Handler,
+		},
+		{
+			MethodName: "ListTracks",
+			Handler:    _QuantumEducation_ListTracks_Handler,
+		},
+		{
+			MethodName: "GetLearningPath",
+			Handler:    _QuantumEducation_GetLearningPath_Handler,
+		},
+		{
+			MethodName: "GetNextRecommended",
+			Handler:    _QuantumEducation_GetNextRecommended_Handler,
+		},
+		{
+			MethodName: "CompleteLesson",
+			Handler:    _QuantumEducation_CompleteLesson_Handler,
+		},
+		{
+			MethodName: "GetCertificate",
+			Handler:    _QuantumEducation_GetCertificate_Handler,
+		},
+		{
+			MethodName: "VerifyCertificate",
+			Handler:    _QuantumEducation_VerifyCertificate_Handler,
+		},
+		{
+			MethodName: "CreateClass",
+			Handler:    _QuantumEducation_CreateClass_Handler,
+		},
+		{
+			MethodName: "EnrollStudents",
+			Handler:    _QuantumEducation_EnrollStudents_Handler,
+		},
+		{
+			MethodName: "JoinClass",
+			Handler:    _QuantumEducation_JoinClass_Handler,
+		},
+		{
+			MethodName: "AssignWork",
+			Handler:    _QuantumEducation_AssignWork_Handler,
+		},
+		{
+			MethodName: "ListClasses",
+			Handler:    _QuantumEducation_ListClasses_Handler,
+		},
+		{
+			MethodName: "ListClassProgress",
+			Handler:    _QuantumEducation_ListClassProgress_Handler,
+		},
+		{
+			MethodName: "GetCircuit",
+			Handler:    _QuantumEducation_GetCircuit_Handler,
+		},
+		{
+			MethodName: "ListCircuits",
+			Handler:    _QuantumEducation_ListCircuits_Handler,
+		},
+		{
+			MethodName: "GenerateQuiz",
+			Handler:    _QuantumEducation_GenerateQuiz_Handler,
+		},
+		{
+			MethodName: "SubmitAnswers",
+			Handler:    _QuantumEducation_SubmitAnswers_Handler,
+		},
+		{
+			MethodName: "GetQuizAttempts",
+			Handler:    _QuantumEducation_GetQuizAttempts_Handler,
+		},
+		{
+			MethodName: "GetQuizLeaderboard",
+			Handler:    _QuantumEducation_GetQuizLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetHint",
+			Handler:    _QuantumEducation_GetHint_Handler,
+		},
+		{
+			MethodName: "GetDueReviews",
+			Handler:    _QuantumEducation_GetDueReviews_Handler,
+		},
+		{
+			MethodName: "SubmitReview",
+			Handler:    _QuantumEducation_SubmitReview_Handler,
+		},
+		{
+			MethodName: "RunSandboxCircuit",
+			Handler:    _QuantumEducation_RunSandboxCircuit_Handler,
+		},
+		{
+			MethodName: "ListChallenges",
+			Handler:    _QuantumEducation_ListChallenges_Handler,
+		},
+		{
+			MethodName: "GetChallenge",
+			Handler:    _QuantumEducation_GetChallenge_Handler,
+		},
+		{
+			MethodName: "SubmitChallenge",
+			Handler:    _QuantumEducation_SubmitChallenge_Handler,
+		},
+		{
+			MethodName: "GetChallengeLeaderboard",
+			Handler:    _QuantumEducation_GetChallengeLeaderboard_Handler,
+		},
+		{
+			MethodName: "RecordEvent",
+			Handler:    _QuantumEducation_RecordEvent_Handler,
+		},
+		{
+			MethodName: "GetAchievements",
+			Handler:    _QuantumEducation_GetAchievements_Handler,
+		},
+		{
+			MethodName: "ListBadges",
+			Handler:    _QuantumEducation_ListBadges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// hintPenalty is the percent of a right answer's points each hint costs
+const hintPenalty = 20
+
+// hintPoints discounts points for the hints taken
+func hintPoints(points, hints int) int {
+	return points * max(0, 100-hintPenalty*hints) / 100
+}
+
+// takeHint counts a hint on a challenge, up to total, and returns how
+// many the learner has taken
+func (as *achievementStore) takeHint(userID, challengeID string, total int) int {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if p.Hints[challengeID] < total {
+		p.Hints[challengeID]++
+		if err := as.save(); err != nil {
+			log.Printf("📚 Failed to save hints: %v", err)
+		}
+	}
+	return p.Hints[challengeID]
+}
+
+func (as *achievementStore) hintsTaken(userID, challengeID string) int {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	if p, ok := as.learners[userID]; ok {
+		return p.Hints[challengeID]
+	}
+	return 0
+}
+
+func hintProto(hints []string, taken int) *pb.Hint {
+	return &pb.Hint{
+		Text:            hints[taken-1],
+		Level:           int32(taken),
+		Total:           int32(len(hints)),
+		DiscountPercent: int32(min(100, hintPenalty*taken)),
+	}
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// GetHint gives the next hint for a question of an open quiz, before it
+// is answered, or for a challenge. Past the last hint it repeats the last
+// at no further cost.
+func (s *EducationServer) GetHint(ctx context.Context, req *pb.HintRequest) (*pb.Hint, error) {
+	if req.ChallengeId != "" {
+		c, ok := challenges[req.ChallengeId]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("challenge %s not found", req.ChallengeId)
+		case req.UserId == "":
+			return nil, fmt.Errorf("user_id is required for challenge hints")
+		case len(c.Hints) == 0:
+			return nil, fmt.Errorf("challenge %s has no hints", c.ID)
+		}
+		taken := s.achievements.takeHint(req.UserId, c.ID, len(c.Hints))
+		log.Printf("📚 %q took hint %d/%d on challenge %s", req.UserId, taken, len(c.Hints), c.ID)
+		return hintProto(c.Hints, taken), nil
+	}
+
+	if req.QuizId == "" || req.QuestionId == "" {
+		return nil, fmt.Errorf("quiz_id and question_id, or challenge_id, are required")
+	}
+	q := s.content.question(req.QuestionId)
+	if q == nil {
+		return nil, fmt.Errorf("question %s not found", req.QuestionId)
+	}
+	if len(q.Hints) == 0 {
+		return nil, fmt.Errorf("question %s has no hints", q.ID)
+	}
+	s.mu.Lock()
+	session, err := s.checkSubmission(&pb.QuizSubmission{
+		QuizId:  req.QuizId,
+		Answers: []*pb.AnswerSubmission{{QuestionId: q.ID}},
+	}, time.Now())
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	session.Hints[q.ID] = min(session.Hints[q.ID]+1, len(q.Hints))
+	taken, langs := session.Hints[q.ID], session.Languages
+	s.mu.Unlock()
+
+	if l := requestLanguages(ctx, req.Language); l != nil {
+		langs = l
+	}
+	q = s.content.localizeQuestion(q, langs)
+	log.Printf("📚 Hint %d/%d on %s taken in quiz %s", taken, len(q.Hints), q.ID, req.QuizId)
+	return hintProto(q.Hints, taken), nil
+}
@@ -0,0 +1,425 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// Lessons are written in English and translated by the community. A
+// lesson translation is a Markdown file named for its language, with a
+// header naming the version it was translated from:
+//
+//	content/entanglement_intro/es.md
+//
+//	---
+//	version: 2
+//	title: Entrelazamiento cuántico
+//	key_concepts: Entrelazamiento, Estados de Bell
+//	translator: ana
+//	---
+//	# Entrelazamiento cuántico
+//	...
+//
+// A translation of an earlier version is still served, flagged as
+// outdated, until someone catches it up. Question banks are translated in
+// content/questions.<language>.json, keyed by question ID:
+//
+//	{"q1": {"text": "...", "options": ["..."], "explanation": "...", "concept": "..."}}
+//
+// Answers are not translated; multiple choice is graded by option index.
+
+const defaultLanguage = "en"
+
+var languagePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+type lessonTranslation struct {
+	LessonID    string
+	Language    string
+	Version     int // Lesson version translated
+	Title       string
+	KeyConcepts []string
+	Content     string
+	Translator  string
+	UpdatedAt   time.Time
+}
+
+type questionTranslation struct {
+	Text        string   `json:"text"`
+	Options     []string `json:"options,omitempty"`
+	Explanation string   `json:"explanation,omitempty"`
+	Concept     string   `json:"concept,omitempty"`
+	Hints       []string `json:"hints,omitempty"`
+}
+
+// normalizeLanguage lowercases a tag such as "pt_BR" to "pt-br", or
+// returns "" if it is not a language tag
+func normalizeLanguage(tag string) string {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if !languagePattern.MatchString(tag) {
+		return ""
+	}
+	return tag
+}
+
+// requestLanguages lists the languages to try for a request, most
+// specific first and ending at English: "pt-BR" tries "pt-br", then "pt".
+// Without a language in the request, the accept-language metadata is
+// used. It returns nil when neither names a language.
+func requestLanguages(ctx context.Context, requested string) []string {
+	tags := []string{requested}
+	if strings.TrimSpace(requested) == "" {
+		tags = nil
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for _, header := range md.Get("accept-language") {
+				for _, tag := range strings.Split(header, ",") {
+					tag, _, _ = strings.Cut(tag, ";") // Drop q-values; order is preference enough
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+
+	var langs []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = normalizeLanguage(tag)
+		for tag != "" && !seen[tag] {
+			seen[tag] = true
+			langs = append(langs, tag)
+			if tag == defaultLanguage {
+				return langs
+			}
+			i := strings.LastIndex(tag, "-")
+			if i < 0 {
+				break
+			}
+			tag = tag[:i]
+		}
+	}
+	if len(langs) > 0 {
+		langs = append(langs, defaultLanguage)
+	}
+	return langs
+}
+
+// translationLanguage reports whether a lesson directory file is a
+// translation, and of which language
+func translationLanguage(path string) (string, bool) {
+	name := strings.TrimSuffix(filepath.Base(path), ".md")
+	lang := normalizeLanguage(name)
+	return lang, lang != "" && lang == name && lang != defaultLanguage
+}
+
+func translationPath(dir, id, lang string) string {
+	return filepath.Join(dir, id, lang+".md")
+}
+
+func readTranslationFile(path, lang string) (*lessonTranslation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &lessonTranslation{LessonID: filepath.Base(filepath.Dir(path)), Language: lang, UpdatedAt: info.ModTime()}
+	body, err := parseFrontMatter(canonicalFile(path, data), func(key, value string) error {
+		switch key {
+		case "version":
+			if t.Version, err = strconv.Atoi(value); err != nil || t.Version < 1 {
+				return fmt.Errorf("version must be a lesson version")
+			}
+		case "title":
+			t.Title = value
+		case "key_concepts":
+			t.KeyConcepts = splitList(value)
+		case "translator":
+			t.Translator = value
+		default:
+			return fmt.Errorf("unknown header %q", key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.Content = body
+	if t.Version == 0 {
+		return nil, fmt.Errorf("missing the version it translates")
+	}
+	if err := validateTranslation(t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func validateTranslation(t *lessonTranslation) error {
+	switch {
+	case strings.TrimSpace(t.Title) == "":
+		return fmt.Errorf("translation %s/%s needs a title", t.LessonID, t.Language)
+	case strings.TrimSpace(t.Content) == "":
+		return fmt.Errorf("translation %s/%s has no content", t.LessonID, t.Language)
+	case strings.ContainsAny(t.Title+t.Translator+strings.Join(t.KeyConcepts, ""), "\r\n"):
+		return fmt.Errorf("translation %s/%s header fields must be single lines", t.LessonID, t.Language)
+	}
+	return nil
+}
+
+// writeTranslationFile replaces a translation atomically, as
+// writeLessonFile does for versions
+func writeTranslationFile(dir string, t *lessonTranslation) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\nversion: %d\ntitle: %s\nkey_concepts: %s\n", t.Version, t.Title, strings.Join(t.KeyConcepts, ", "))
+	fmt.Fprintf(&b, "translator: %s\n---\n%s", t.Translator, t.Content)
+
+	path := translationPath(dir, t.LessonID, t.Language)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".translation-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(b.String()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// readQuestionBanks loads every translated question bank. Banks that fail
+// to parse, and entries for questions not in the bank, are logged and
+// skipped.
+func readQuestionBanks(dir string, questions []*Question) map[string]map[string]*questionTranslation {
+	banks := make(map[string]map[string]*questionTranslation)
+	files, _ := filepath.Glob(filepath.Join(dir, "questions.*.json"))
+	for _, f := range files {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(f), "questions."), ".json")
+		lang := normalizeLanguage(name)
+		if lang == "" || lang != name || lang == defaultLanguage {
+			log.Printf("📚 Skipping %s: not named questions.<language>.json", f)
+			continue
+		}
+		data, err := os.ReadFile(f)
+		if err != nil {
+			log.Printf("📚 Skipping %s: %v", f, err)
+			continue
+		}
+		var bank map[string]*questionTranslation
+		if err := json.Unmarshal(data, &bank); err != nil {
+			log.Printf("📚 Skipping %s: %v", f, err)
+			continue
+		}
+		for id, t := range bank {
+			var q *Question
+			for _, known := range questions {
+				if known.ID == id {
+					q = known
+				}
+			}
+			switch {
+			case q == nil:
+				log.Printf("📚 %s: unknown question %s", f, id)
+				delete(bank, id)
+			case t == nil || strings.TrimSpace(t.Text) == "":
+				log.Printf("📚 %s: question %s has no text", f, id)
+				delete(bank, id)
+			case len(t.Options) > 0 && len(t.Options) != len(q.Options):
+				log.Printf("📚 %s: question %s has %d options, not %d", f, id, len(t.Options), len(q.Options))
+				delete(bank, id)
+			default:
+				if len(t.Hints) > 0 && len(t.Hints) != len(q.Hints) {
+					log.Printf("📚 %s: question %s has %d hints, not %d; serving them in English", f, id, len(t.Hints), len(q.Hints))
+					t.Hints = nil
+				}
+				bank[id] = t.canonical()
+			}
+		}
+		banks[lang] = bank
+	}
+	return banks
+}
+
+// ------------------------------------------------------------------
+// Localizing content
+// ------------------------------------------------------------------
+
+// localize returns a lesson in the first of langs it is translated into,
+// or the lesson itself. A translation of a later version than l is not
+// used, since it describes text l does not have yet.
+func (ls *lessonStore) localize(l *Lesson, langs []string) *Lesson {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for _, lang := range langs {
+		if lang == defaultLanguage {
+			break
+		}
+		t := ls.translations[l.ID][lang]
+		if t == nil || t.Version > l.Version {
+			continue
+		}
+		v := *l
+		v.Title, v.Content = t.Title, t.Content
+		if len(t.KeyConcepts) > 0 {
+			v.KeyConcepts = t.KeyConcepts
+		}
+		v.Language, v.Outdated = lang, t.Version < l.Version
+		return &v
+	}
+	return l
+}
+
+// localizedCatalog is catalog with every lesson localized
+func (ls *lessonStore) localizedCatalog(langs []string) map[string]*Lesson {
+	catalog := ls.catalog()
+	for id, l := range catalog {
+		catalog[id] = ls.localize(l, langs)
+	}
+	return catalog
+}
+
+// localizeQuestion returns a question in the first of langs its bank is
+// translated into, or the question itself
+func (ls *lessonStore) localizeQuestion(q *Question, langs []string) *Question {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for _, lang := range langs {
+		if lang == defaultLanguage {
+			break
+		}
+		t := ls.questions[lang][q.ID]
+		if t == nil {
+			continue
+		}
+		v := *q
+		v.Text, v.Language = t.Text, lang
+		if len(t.Options) > 0 {
+			v.Options = t.Options
+		}
+		if t.Explanation != "" {
+			v.Explain = t.Explanation
+		}
+		if t.Concept != "" {
+			v.Concept = t.Concept
+		}
+		if len(t.Hints) == len(q.Hints) {
+			v.Hints = t.Hints
+		}
+		return &v
+	}
+	return q
+}
+
+// putTranslation saves a translation of a lesson's current version, which
+// must be the version the translator worked from
+func (ls *lessonStore) putTranslation(t *lessonTranslation, expected int) (*lessonTranslation, error) {
+	t = t.canonical()
+	if err := validateTranslation(t); err != nil {
+		return nil, err
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	vs := ls.versions[t.LessonID]
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("lesson %s not found", t.LessonID)
+	}
+	if latest := vs[len(vs)-1].Version; expected != latest {
+		return nil, fmt.Errorf("lesson %s is at version %d, not %d", t.LessonID, latest, expected)
+	}
+	v := *t
+	v.Version, v.UpdatedAt = expected, time.Now()
+	if ls.dir != "" {
+		if err := writeTranslationFile(ls.dir, &v); err != nil {
+			return nil, err
+		}
+		if sig, err := ls.dirSignature(); err == nil {
+			ls.signature = sig
+		}
+	}
+	if ls.translations[t.LessonID] == nil {
+		ls.translations[t.LessonID] = make(map[string]*lessonTranslation)
+	}
+	ls.translations[t.LessonID][t.Language] = &v
+	return &v, nil
+}
+
+// coverage counts what each language has translated
+func (ls *lessonStore) coverage() *pb.LanguageCatalog {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	catalog := &pb.LanguageCatalog{TotalLessons: int32(len(ls.versions)), TotalQuestions: int32(len(ls.bank))}
+	byLang := make(map[string]*pb.LanguageCoverage)
+	entry := func(lang string) *pb.LanguageCoverage {
+		if byLang[lang] == nil {
+			byLang[lang] = &pb.LanguageCoverage{Language: lang}
+		}
+		return byLang[lang]
+	}
+	for id, langs := range ls.translations {
+		vs := ls.versions[id]
+		if len(vs) == 0 {
+			continue
+		}
+		for lang, t := range langs {
+			c := entry(lang)
+			c.LessonsTranslated++
+			if t.Version < vs[len(vs)-1].Version {
+				c.LessonsOutdated++
+			}
+		}
+	}
+	for lang, bank := range ls.questions {
+		entry(lang).QuestionsTranslated = int32(len(bank))
+	}
+
+	catalog.Languages = append(catalog.Languages, &pb.LanguageCoverage{
+		Language:            defaultLanguage,
+		LessonsTranslated:   catalog.TotalLessons,
+		QuestionsTranslated: catalog.TotalQuestions,
+	})
+	langs := sortedIDs(byLang)
+	sort.SliceStable(langs, func(i, j int) bool {
+		return byLang[langs[i]].LessonsTranslated > byLang[langs[j]].LessonsTranslated
+	})
+	for _, lang := range langs {
+		catalog.Languages = append(catalog.Languages, byLang[lang])
+	}
+	return catalog
+}
+
+// languageName is how a served language is reported; untranslated
+// content is English
+func languageName(lang string) string {
+	if lang == "" {
+		return defaultLanguage
+	}
+	return lang
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *EducationServer) ListLanguages(ctx context.Context, req *pb.Empty) (*pb.LanguageCatalog, error) {
+	return s.content.coverage(), nil
+}
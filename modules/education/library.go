@@ -0,0 +1,269 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// Besides lessons, the content directory may add to the circuit library
+// and the quiz bank, each a JSON list in the format of the Circuit and
+// Question types:
+//
+//	content/circuits.json
+//	content/questions.json
+//
+// An entry with the ID of a built-in replaces it. Entries that fail to
+// validate are logged and skipped, as lesson files are.
+
+const (
+	circuitsFile  = "circuits.json"
+	questionsFile = "questions.json"
+)
+
+func validateGates(gates []GateStep, numQubits int) error {
+	if numQubits < 1 || numQubits > maxSandboxQubits {
+		return fmt.Errorf("num_qubits must be 1-%d", maxSandboxQubits)
+	}
+	if len(gates) == 0 || len(gates) > maxSandboxGates {
+		return fmt.Errorf("needs 1-%d gates", maxSandboxGates)
+	}
+	for i, g := range gateStepsProto(gates) {
+		if _, err := parseSandboxStep(g, numQubits); err != nil {
+			return fmt.Errorf("gate %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func validateCircuit(c *Circuit) error {
+	switch {
+	case !lessonIDPattern.MatchString(c.ID):
+		return fmt.Errorf("circuit id must be 1-64 lowercase letters, digits or underscores")
+	case strings.TrimSpace(c.Name) == "":
+		return fmt.Errorf("circuit %s needs a name", c.ID)
+	case topicEnum(c.Topic) == 0:
+		return fmt.Errorf("circuit %s has unknown topic %q", c.ID, c.Topic)
+	case difficultyEnum(c.Difficulty) == 0:
+		return fmt.Errorf("circuit %s has unknown difficulty %q", c.ID, c.Difficulty)
+	}
+	if err := validateGates(c.Gates, c.NumQubits); err != nil {
+		return fmt.Errorf("circuit %s: %v", c.ID, err)
+	}
+	return nil
+}
+
+func validateQuestion(q *Question) error {
+	_, known := questionTypes[q.Type]
+	switch {
+	case !lessonIDPattern.MatchString(q.ID):
+		return fmt.Errorf("question id must be 1-64 lowercase letters, digits or underscores")
+	case !known:
+		return fmt.Errorf("question %s has unknown type %q", q.ID, q.Type)
+	case topicEnum(q.Topic) == 0:
+		return fmt.Errorf("question %s has unknown topic %q", q.ID, q.Topic)
+	case strings.TrimSpace(q.Text) == "":
+		return fmt.Errorf("question %s has no text", q.ID)
+	}
+	switch q.Type {
+	case "multiple_choice":
+		i, err := strconv.Atoi(q.Answer)
+		if len(q.Options) < 2 || err != nil || i < 0 || i >= len(q.Options) {
+			return fmt.Errorf("question %s needs options and the index of the right one", q.ID)
+		}
+	case "true_false":
+		if q.Answer != "true" && q.Answer != "false" {
+			return fmt.Errorf("question %s must be answered true or false", q.ID)
+		}
+	case "circuit_construction":
+		if err := validateGates(q.Solution, q.NumQubits); err != nil {
+			return fmt.Errorf("question %s solution: %v", q.ID, err)
+		}
+	default:
+		if strings.TrimSpace(q.Answer) == "" {
+			return fmt.Errorf("question %s has no answer", q.ID)
+		}
+	}
+	return nil
+}
+
+// readLibrary loads the built-in circuits and questions with the content
+// directory's on top
+func readLibrary(dir string) (map[string]*Circuit, []*Question) {
+	library := make(map[string]*Circuit, len(circuits))
+	for id, c := range circuits {
+		library[id] = c
+	}
+	bank := make([]*Question, len(questions))
+	for i := range questions {
+		bank[i] = questions[i].canonical()
+	}
+	if dir == "" {
+		return library, bank
+	}
+
+	var onDisk []*Circuit
+	if err := readJSONList(filepath.Join(dir, circuitsFile), &onDisk); err != nil {
+		log.Printf("📚 Skipping %s: %v", circuitsFile, err)
+	}
+	for _, c := range onDisk {
+		if err := validateCircuit(c); err != nil {
+			log.Printf("📚 %s: %v", circuitsFile, err)
+			continue
+		}
+		library[c.ID] = c
+	}
+
+	var added []*Question
+	if err := readJSONList(filepath.Join(dir, questionsFile), &added); err != nil {
+		log.Printf("📚 Skipping %s: %v", questionsFile, err)
+	}
+	for _, q := range added {
+		q = q.canonical()
+		if err := validateQuestion(q); err != nil {
+			log.Printf("📚 %s: %v", questionsFile, err)
+			continue
+		}
+		bank = replaceQuestion(bank, q)
+	}
+	return library, bank
+}
+
+// replaceQuestion swaps q for the question with its ID, or appends it
+func replaceQuestion(bank []*Question, q *Question) []*Question {
+	for i := range bank {
+		if bank[i].ID == q.ID {
+			bank[i] = q
+			return bank
+		}
+	}
+	return append(bank, q)
+}
+
+func readJSONList(path string, list any) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, list)
+}
+
+// writeJSONFile writes atomically so the watcher never reads half a file
+func writeJSONFile(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".library-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ------------------------------------------------------------------
+// Store
+// ------------------------------------------------------------------
+
+func (ls *lessonStore) question(id string) *Question {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for _, q := range ls.bank {
+		if q.ID == id {
+			return q
+		}
+	}
+	return nil
+}
+
+// questionBank is every question, built-ins first
+func (ls *lessonStore) questionBank() []*Question {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	return append([]*Question(nil), ls.bank...)
+}
+
+func (ls *lessonStore) circuit(id string) *Circuit {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	return ls.library[id]
+}
+
+// circuitLibrary is a copy of the library, by ID
+func (ls *lessonStore) circuitLibrary() map[string]*Circuit {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	out := make(map[string]*Circuit, len(ls.library))
+	for id, c := range ls.library {
+		out[id] = c
+	}
+	return out
+}
+
+// putLibrary adds circuits and questions to the content directory's
+// files, replacing entries with the same IDs, and reloads
+func (ls *lessonStore) putLibrary(added []*Circuit, bank []*Question) error {
+	if ls.dir == "" {
+		return fmt.Errorf("no content directory to save to")
+	}
+	if len(added) > 0 {
+		var onDisk []*Circuit
+		if err := readJSONList(filepath.Join(ls.dir, circuitsFile), &onDisk); err != nil {
+			return fmt.Errorf("%s: %v", circuitsFile, err)
+		}
+		for _, c := range added {
+			i := 0
+			for i < len(onDisk) && onDisk[i].ID != c.ID {
+				i++
+			}
+			if i == len(onDisk) {
+				onDisk = append(onDisk, c)
+			}
+			onDisk[i] = c
+		}
+		if err := writeJSONFile(filepath.Join(ls.dir, circuitsFile), onDisk); err != nil {
+			return err
+		}
+	}
+	if len(bank) > 0 {
+		var onDisk []*Question
+		if err := readJSONList(filepath.Join(ls.dir, questionsFile), &onDisk); err != nil {
+			return fmt.Errorf("%s: %v", questionsFile, err)
+		}
+		for _, q := range bank {
+			onDisk = replaceQuestion(onDisk, q)
+		}
+		if err := writeJSONFile(filepath.Join(ls.dir, questionsFile), onDisk); err != nil {
+			return err
+		}
+	}
+	return ls.reload()
+}
+
+func (c *Circuit) summary() *pb.CircuitSummary {
+	return &pb.CircuitSummary{
+		Id:        c.ID,
+		Name:      c.Name,
+		Topic:     topicEnum(c.Topic),
+		NumQubits: int32(c.NumQubits),
+		NumGates:  int32(len(c.Gates)),
+	}
+}
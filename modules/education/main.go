@@ -4,17 +4,26 @@
 package main
 
 import (
+	"context"
+	"crypto/ed25519"
 	"flag"
 	"fmt"
 	"log"
 	"math/rand"
 	"net"
+	"os"
+	"slices"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+	engine "github.com/perclft/QubitEngine/modules/education/generated/engine"
 )
 
-// Lesson catalog
+// Built-in lesson catalog, version 1 of each lesson in the content store
 var lessons = map[string]*Lesson{
 	"superposition_intro": {
 		ID:    "superposition_intro",
@@ -40,6 +49,7 @@ The Hadamard gate creates an equal superposition:
 		CircuitExamples: []string{"hadamard_single", "superposition_demo"},
 		NextLessonID:    "entanglement_intro",
 		EstimatedMin:    15,
+		Difficulty:      "BEGINNER",
 	},
 	"entanglement_intro": {
 		ID:    "entanglement_intro",
@@ -67,7 +77,167 @@ one qubit instantly determines the other, regardless of distance.
 		KeyConcepts:     []string{"Entanglement", "Bell States", "CNOT Gate", "EPR Paradox"},
 		CircuitExamples: []string{"bell_state", "ghz_state"},
 		NextLessonID:    "gates_intro",
+		Prerequisites:   []string{"superposition_intro"},
+		EstimatedMin:    20,
+		Difficulty:      "BEGINNER",
+	},
+	"measurement_intro": {
+		ID:    "measurement_intro",
+		Topic: "MEASUREMENT",
+		Title: "Measurement and the Born Rule",
+		Content: `# Measurement
+
+Measuring a qubit gives a classical bit and leaves the qubit in the state
+that was observed.
+
+## The Born Rule
+For |ψ⟩ = α|0⟩ + β|1⟩:
+- Outcome 0 with probability |α|², leaving |0⟩
+- Outcome 1 with probability |β|², leaving |1⟩
+
+## Measurement Bases
+Measuring in the X basis means applying H first: |+⟩ always reads 0 and
+|−⟩ always reads 1, while |0⟩ reads either with probability 1/2.
+`,
+		KeyConcepts:     []string{"Born Rule", "Collapse", "Measurement Basis"},
+		CircuitExamples: []string{"hadamard_single"},
+		Prerequisites:   []string{"superposition_intro"},
+		EstimatedMin:    15,
+		Difficulty:      "BEGINNER",
+	},
+	"gates_intro": {
+		ID:    "gates_intro",
+		Topic: "GATES",
+		Title: "Quantum Gates and Circuits",
+		Content: `# Quantum Gates
+
+Gates are reversible operations on qubits, written as unitary matrices.
+
+## Single-Qubit Gates
+- X flips |0⟩ and |1⟩
+- Z flips the sign of |1⟩
+- S and T add phases of i and e^(iπ/4) to |1⟩
+- H moves between the Z and X bases
+
+## Multi-Qubit Gates
+- CNOT flips the target when the control is |1⟩
+- Toffoli flips the target when both controls are |1⟩
+
+H, S, T and CNOT together approximate any circuit.
+`,
+		KeyConcepts:     []string{"Unitary", "Pauli Gates", "Phase Gates", "Universality"},
+		CircuitExamples: []string{"hadamard_single", "bell_state"},
+		Prerequisites:   []string{"superposition_intro", "entanglement_intro"},
 		EstimatedMin:    20,
+		Difficulty:      "BEGINNER",
+	},
+	"qft_intro": {
+		ID:    "qft_intro",
+		Topic: "QFT",
+		Title: "The Quantum Fourier Transform",
+		Content: `# The Quantum Fourier Transform
+
+The QFT maps |x⟩ to (1/√N) Σ_k e^(2πixk/N)|k⟩, writing a state's amplitudes
+in the frequency domain.
+
+## The Circuit
+On n qubits the QFT uses n Hadamards and n(n−1)/2 controlled phase gates,
+then reverses the qubit order: O(n²) gates against O(n·2ⁿ) for the
+classical FFT on the same data.
+
+## Why It Matters
+The QFT turns a periodic state into peaks at multiples of N/period, which
+is how phase estimation and Shor's algorithm read out a period.
+`,
+		KeyConcepts:   []string{"Fourier Transform", "Controlled Phase", "Phase Estimation"},
+		Prerequisites: []string{"gates_intro"},
+		EstimatedMin:  25,
+		Difficulty:    "INTERMEDIATE",
+	},
+	"grover_intro": {
+		ID:    "grover_intro",
+		Topic: "GROVER",
+		Title: "Grover's Search",
+		Content: `# Grover's Search
+
+Grover's algorithm finds a marked item among N in about (π/4)√N queries,
+where a classical search needs N/2 on average.
+
+## One Iteration
+1. The oracle flips the sign of the marked state
+2. The diffuser reflects every amplitude about the mean
+
+Each iteration rotates the state toward the marked item. Stop after the
+right number of rounds: going further rotates past it.
+`,
+		KeyConcepts:   []string{"Oracle", "Amplitude Amplification", "Diffusion"},
+		Prerequisites: []string{"gates_intro", "measurement_intro"},
+		EstimatedMin:  25,
+		Difficulty:    "INTERMEDIATE",
+	},
+	"shor_intro": {
+		ID:    "shor_intro",
+		Topic: "SHOR",
+		Title: "Shor's Factoring Algorithm",
+		Content: `# Shor's Algorithm
+
+Shor's algorithm factors N by finding the period r of f(x) = aˣ mod N.
+
+## The Quantum Part
+Phase estimation on modular multiplication by a, read out with the inverse
+QFT, gives s/r for a random s; continued fractions recover r.
+
+## The Classical Part
+If r is even and a^(r/2) ≠ −1 mod N, then gcd(a^(r/2) ± 1, N) are factors.
+`,
+		KeyConcepts:   []string{"Period Finding", "Modular Exponentiation", "Continued Fractions"},
+		Prerequisites: []string{"qft_intro", "measurement_intro"},
+		EstimatedMin:  35,
+		Difficulty:    "ADVANCED",
+	},
+	"bb84_intro": {
+		ID:    "bb84_intro",
+		Topic: "MEASUREMENT",
+		Title: "Quantum Key Distribution with BB84",
+		Content: `# BB84
+
+Alice sends random bits, each encoded in a random basis (Z or X). Bob
+measures each in a random basis of his own.
+
+## Sifting
+They compare bases, not bits, and keep the positions where the bases
+matched: about half of them.
+
+## Catching Eve
+Measuring in the wrong basis disturbs the state, so an eavesdropper causes
+errors. Alice and Bob sacrifice a sample of the key to estimate the error
+rate, and abort above about 11%.
+`,
+		KeyConcepts:     []string{"QKD", "Basis Sifting", "QBER", "No-Cloning"},
+		CircuitExamples: []string{"hadamard_single"},
+		Prerequisites:   []string{"measurement_intro"},
+		EstimatedMin:    25,
+		Difficulty:      "INTERMEDIATE",
+	},
+	"e91_intro": {
+		ID:    "e91_intro",
+		Topic: "ENTANGLEMENT",
+		Title: "Entanglement-Based QKD (E91)",
+		Content: `# E91
+
+A source hands each party one half of a Bell pair. Measuring in matching
+bases gives both the same random bit: a shared key no one sent.
+
+## Security from Bell's Theorem
+Some rounds use mismatched bases to test the CHSH inequality. A violation
+near 2√2 shows the pairs are entangled and so cannot be correlated with
+anything Eve holds.
+`,
+		KeyConcepts:     []string{"Bell Pairs", "CHSH Inequality", "Device Independence"},
+		CircuitExamples: []string{"bell_state"},
+		Prerequisites:   []string{"bb84_intro", "entanglement_intro"},
+		EstimatedMin:    30,
+		Difficulty:      "ADVANCED",
 	},
 }
 
@@ -77,6 +247,8 @@ var circuits = map[string]*Circuit{
 		ID:          "hadamard_single",
 		Name:        "Single Hadamard",
 		Description: "Apply Hadamard gate to create |+⟩ state",
+		Topic:       "SUPERPOSITION",
+		Difficulty:  "BEGINNER",
 		NumQubits:   1,
 		Gates:       []GateStep{{Gate: "H", Qubits: []int{0}}},
 		Output:      "|+⟩ = (|0⟩ + |1⟩)/√2",
@@ -85,6 +257,8 @@ var circuits = map[string]*Circuit{
 		ID:          "bell_state",
 		Name:        "Bell State |Φ+⟩",
 		Description: "Create maximally entangled Bell state",
+		Topic:       "ENTANGLEMENT",
+		Difficulty:  "BEGINNER",
 		NumQubits:   2,
 		Gates: []GateStep{
 			{Gate: "H", Qubits: []int{0}},
@@ -96,6 +270,8 @@ var circuits = map[string]*Circuit{
 		ID:          "ghz_state",
 		Name:        "GHZ State (3 qubits)",
 		Description: "Greenberger–Horne–Zeilinger state",
+		Topic:       "ENTANGLEMENT",
+		Difficulty:  "INTERMEDIATE",
 		NumQubits:   3,
 		Gates: []GateStep{
 			{Gate: "H", Qubits: []int{0}},
@@ -111,25 +287,101 @@ var questions = []Question{
 	{
 		ID:      "q1",
 		Type:    "multiple_choice",
+		Topic:   "SUPERPOSITION",
+		Concept: "Hadamard Gate",
 		Text:    "What state does H|0⟩ produce?",
 		Options: []string{"|0⟩", "|1⟩", "(|0⟩ + |1⟩)/√2", "(|0⟩ - |1⟩)/√2"},
 		Answer:  "2",
 		Explain: "The Hadamard gate creates an equal superposition: H|0⟩ = |+⟩ = (|0⟩ + |1⟩)/√2",
+		Hints: []string{
+			"H turns each basis state into an equal mix of |0⟩ and |1⟩.",
+			"The sign between the two terms comes from the input: |0⟩ gives +, |1⟩ gives −.",
+			"H|0⟩ is |+⟩.",
+		},
 	},
 	{
 		ID:      "q2",
 		Type:    "true_false",
+		Topic:   "ENTANGLEMENT",
+		Concept: "Entanglement",
 		Text:    "Measuring an entangled qubit affects its partner instantaneously.",
 		Answer:  "true",
 		Explain: "Entangled qubits share quantum correlations - measuring one instantly determines the other's state.",
+		Hints: []string{
+			"Picture a Bell pair shared by two people far apart, each measuring their half.",
+			"The two outcomes are correlated the moment either qubit is measured.",
+			"Measuring one fixes the other's outcome at once, though no usable signal travels.",
+		},
 	},
 	{
 		ID:      "q3",
 		Type:    "multiple_choice",
+		Topic:   "ENTANGLEMENT",
+		Concept: "Bell States",
 		Text:    "Which gates create a Bell state from |00⟩?",
 		Options: []string{"H, H", "CNOT, H", "H, CNOT", "X, CNOT"},
 		Answer:  "2",
 		Explain: "H on first qubit creates superposition, then CNOT entangles the pair.",
+		Hints: []string{
+			"You need a superposition first, then a gate that correlates two qubits.",
+			"CNOT only entangles when its control is in superposition.",
+			"Hadamard on the first qubit comes before the CNOT.",
+		},
+	},
+	{
+		ID:        "q4",
+		Type:      "circuit_construction",
+		Topic:     "ENTANGLEMENT",
+		Concept:   "Bell States",
+		Text:      "Build the Bell state (|00⟩ + |11⟩)/√2 from |00⟩.",
+		NumQubits: 2,
+		Solution: []GateStep{
+			{Gate: "H", Qubits: []int{0}},
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+		},
+		Explain: "H puts the control into superposition and CNOT copies it onto the target, correlating the pair.",
+		Hints: []string{
+			"Start by putting qubit 0 into (|0⟩ + |1⟩)/√2.",
+			"A CNOT with qubit 0 as control makes qubit 1 follow it.",
+			"H on q0, then CNOT from q0 to q1.",
+		},
+	},
+	{
+		ID:        "q5",
+		Type:      "circuit_construction",
+		Topic:     "SUPERPOSITION",
+		Concept:   "Relative Phase",
+		Text:      "Prepare |−⟩ = (|0⟩ − |1⟩)/√2 from |0⟩.",
+		NumQubits: 1,
+		Solution: []GateStep{
+			{Gate: "X", Qubits: []int{0}},
+			{Gate: "H", Qubits: []int{0}},
+		},
+		Explain: "H|1⟩ = |−⟩, so flip to |1⟩ first (H then Z works too).",
+		Hints: []string{
+			"H sends |1⟩ to a state with a minus sign.",
+			"So reach |1⟩ first, then apply H.",
+			"X on q0, then H on q0.",
+		},
+	},
+	{
+		ID:        "q6",
+		Type:      "circuit_construction",
+		Topic:     "ENTANGLEMENT",
+		Concept:   "GHZ States",
+		Text:      "Build the three-qubit GHZ state (|000⟩ + |111⟩)/√2 from |000⟩.",
+		NumQubits: 3,
+		Solution: []GateStep{
+			{Gate: "H", Qubits: []int{0}},
+			{Gate: "CNOT", Qubits: []int{0, 1}},
+			{Gate: "CNOT", Qubits: []int{1, 2}},
+		},
+		Explain: "Entangle a Bell pair, then extend it with a second CNOT.",
+		Hints: []string{
+			"Start from a Bell pair on qubits 0 and 1.",
+			"Another CNOT can copy the pair's value onto qubit 2.",
+			"H q0, CNOT q0 q1, then CNOT q1 q2.",
+		},
 	},
 }
 
@@ -141,70 +393,153 @@ type Lesson struct {
 	KeyConcepts     []string
 	CircuitExamples []string
 	NextLessonID    string
+	Prerequisites   []string
 	EstimatedMin    int
+	Difficulty      string
+	Version         int
+	Author          string
+	UpdatedAt       time.Time
+
+	// Set on translated copies: the language, and whether the translation
+	// is of an earlier version
+	Language string
+	Outdated bool
 }
 
+// Circuits and questions are also read from the content directory as
+// JSON, with the tags below
+
 type Circuit struct {
-	ID          string
-	Name        string
-	Description string
-	NumQubits   int
-	Gates       []GateStep
-	Output      string
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Topic       string     `json:"topic"`
+	Difficulty  string     `json:"difficulty"`
+	NumQubits   int        `json:"num_qubits"`
+	Gates       []GateStep `json:"gates"`
+	Output      string     `json:"expected_output"`
 }
 
 type GateStep struct {
-	Gate   string
-	Qubits []int
-	Param  float64
+	Gate   string  `json:"gate"`
+	Qubits []int   `json:"qubits"`
+	Param  float64 `json:"param,omitempty"`
 }
 
 type Question struct {
-	ID      string
-	Type    string
-	Text    string
-	Options []string
-	Answer  string
-	Explain string
+	ID      string   `json:"id"`
+	Type    string   `json:"type"`
+	Topic   string   `json:"topic"`
+	Concept string   `json:"concept,omitempty"`
+	Text    string   `json:"text"`
+	Options []string `json:"options,omitempty"`
+	Answer  string   `json:"answer,omitempty"`
+	Explain string   `json:"explanation,omitempty"`
+	Hints   []string `json:"hints,omitempty"` // Each more specific than the last; the last nearly answers
+
+	// Circuit construction: the learner's gates must reach the state this
+	// solution prepares from |0…0⟩
+	NumQubits int        `json:"num_qubits,omitempty"`
+	Solution  []GateStep `json:"solution,omitempty"`
+
+	Language string `json:"-"` // Set on translated copies
 }
 
 type EducationServer struct {
-	rng *rand.Rand
+	pb.UnimplementedQuantumEducationServer
+	engineClient engine.QuantumComputeClient
+	content      *lessonStore
+	achievements *achievementStore
+	classes      *classStore
+	// certificateKey signs track certificates
+	certificateKey ed25519.PrivateKey
+	authorToken    string // Required by the authoring API; empty disables it
+	rng            *rand.Rand
+	quizzes        map[string]*quizSession
+	// challengeBest holds each learner's best solution, by challenge
+	challengeBest map[string]map[string]*challengeEntry
+	mu            sync.Mutex // Guards rng, quizzes and challengeBest
+	importMu      sync.Mutex // One course import at a time
 }
 
-func NewEducationServer() *EducationServer {
+func NewEducationServer(engineClient engine.QuantumComputeClient, content *lessonStore, achievements *achievementStore, classes *classStore, certificateKey ed25519.PrivateKey, authorToken string) *EducationServer {
 	return &EducationServer{
-		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
+		engineClient:   engineClient,
+		content:        content,
+		achievements:   achievements,
+		classes:        classes,
+		certificateKey: certificateKey,
+		authorToken:    authorToken,
+		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		quizzes:        make(map[string]*quizSession),
+		challengeBest:  make(map[string]map[string]*challengeEntry),
 	}
 }
 
-func (s *EducationServer) GetLesson(id string) *Lesson {
-	return lessons[id]
-}
-
-func (s *EducationServer) GetCircuit(id string) *Circuit {
-	return circuits[id]
-}
+// drawQuestions picks numQuestions at random from the questions on a
+// topic ("" for every topic) of the given types (none for every type)
+func (s *EducationServer) drawQuestions(topic string, types []pb.QuestionType, numQuestions int) []*Question {
+	var pool []*Question
+	for _, q := range s.content.questionBank() {
+		if (topic == "" || q.Topic == topic) && (len(types) == 0 || slices.Contains(types, questionTypes[q.Type])) {
+			pool = append(pool, q)
+		}
+	}
 
-func (s *EducationServer) GenerateQuiz(topic string, numQuestions int) []Question {
 	// Shuffle and select questions
-	shuffled := make([]Question, len(questions))
-	copy(shuffled, questions)
-	s.rng.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	s.mu.Lock()
+	s.rng.Shuffle(len(pool), func(i, j int) {
+		pool[i], pool[j] = pool[j], pool[i]
 	})
+	s.mu.Unlock()
 
-	if numQuestions > len(shuffled) {
-		numQuestions = len(shuffled)
+	if numQuestions > len(pool) {
+		numQuestions = len(pool)
 	}
-	return shuffled[:numQuestions]
+	return pool[:numQuestions]
 }
 
 func main() {
 	port := flag.Int("port", 50065, "gRPC port")
+	engineAddr := flag.String("engine-addr", "engine:50051", "Quantum Engine address")
+	contentDir := flag.String("content-dir", "", "Lesson content directory (empty: built-in lessons only)")
+	reload := flag.Duration("content-reload", defaultReloadInterval, "How often to check the content directory for edits")
+	achievementsFile := flag.String("achievements-file", "", "File to keep learners' badges in (empty: in memory)")
+	classesFile := flag.String("classes-file", "", "File to keep classes and assignments in (empty: in memory)")
+	certificateKeyFile := flag.String("certificate-key", "", "File holding the key that signs certificates, created if missing (empty: new key each start)")
 	flag.Parse()
 
-	server := NewEducationServer()
+	content, err := newLessonStore(*contentDir)
+	if err != nil {
+		log.Fatalf("Failed to load lessons: %v", err)
+	}
+	go content.watch(context.Background(), *reload)
+
+	achievements, err := newAchievementStore(*achievementsFile)
+	if err != nil {
+		log.Fatalf("Failed to load achievements: %v", err)
+	}
+
+	classes, err := newClassStore(*classesFile)
+	if err != nil {
+		log.Fatalf("Failed to load classes: %v", err)
+	}
+
+	certificateKey, err := loadCertificateKey(*certificateKeyFile)
+	if err != nil {
+		log.Fatalf("Failed to load certificate key: %v", err)
+	}
+	if *certificateKeyFile == "" {
+		log.Printf("⚠️  No -certificate-key: certificates will not verify after a restart")
+	}
+
+	conn, err := grpc.Dial(*engineAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to engine: %v", err)
+	}
+	defer conn.Close()
+
+	server := NewEducationServer(engine.NewQuantumComputeClient(conn), content, achievements, classes, certificateKey, os.Getenv("EDUCATION_AUTHOR_TOKEN"))
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
@@ -212,15 +547,21 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
+	pb.RegisterQuantumEducationServer(grpcServer, server)
 
 	log.Printf("📚 Quantum Education starting on port %d", *port)
-	log.Printf("   Lessons: %d available", len(lessons))
-	log.Printf("   Circuits: %d in library", len(circuits))
-	log.Printf("   Questions: %d in quiz bank", len(questions))
+	log.Printf("   Lessons: %d available in %d tracks", len(content.all()), len(tracks))
+	if *contentDir != "" {
+		log.Printf("   Content: %s (checked every %v)", *contentDir, *reload)
+		log.Printf("   Languages: %d, English included", len(content.coverage().Languages))
+	}
+	log.Printf("   Circuits: %d in library", len(content.circuitLibrary()))
+	log.Printf("   Questions: %d in quiz bank", len(content.questionBank()))
+	log.Printf("   Challenges: %d", len(challenges))
+	log.Printf("   Badges: %d to earn", len(badges))
+	log.Printf("   Engine: %s", *engineAddr)
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-
-	_ = server
 }
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// Track is a curated route through the lesson graph. Its lessons are the
+// goals; the path also takes in everything they build on.
+type Track struct {
+	ID          string
+	Name        string
+	Description string
+	LessonIDs   []string
+}
+
+// tracks are tried in this order when no track is asked for
+var tracks = []*Track{
+	{
+		ID:          "beginner",
+		Name:        "Quantum Foundations",
+		Description: "Qubits, measurement, entanglement and gates",
+		LessonIDs:   []string{"superposition_intro", "measurement_intro", "entanglement_intro", "gates_intro"},
+	},
+	{
+		ID:          "algorithms",
+		Name:        "Quantum Algorithms",
+		Description: "From the Fourier transform to search and factoring",
+		LessonIDs:   []string{"qft_intro", "grover_intro", "shor_intro"},
+	},
+	{
+		ID:          "cryptography",
+		Name:        "Quantum Cryptography",
+		Description: "Key distribution secured by measurement and entanglement",
+		LessonIDs:   []string{"bb84_intro", "e91_intro"},
+	},
+}
+
+func findTrack(id string) *Track {
+	for _, t := range tracks {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// lessonOrder lists goals and everything they build on, prerequisites
+// first and otherwise in the order given. Prerequisites missing from the
+// catalog are skipped, and a cycle is cut where it closes.
+func lessonOrder(catalog map[string]*Lesson, goals []string) []string {
+	const visiting, done = 1, 2
+	state := make(map[string]int)
+	var order []string
+	var visit func(id string)
+	visit = func(id string) {
+		l, ok := catalog[id]
+		if !ok || state[id] != 0 {
+			return
+		}
+		state[id] = visiting
+		for _, p := range l.Prerequisites {
+			visit(p)
+		}
+		state[id] = done
+		order = append(order, id)
+	}
+	for _, id := range goals {
+		visit(id)
+	}
+	return order
+}
+
+// dependsOn reports whether lesson from builds on target, directly or not
+func dependsOn(catalog map[string]*Lesson, from, target string) bool {
+	seen := make(map[string]bool)
+	var walk func(id string) bool
+	walk = func(id string) bool {
+		if id == target {
+			return true
+		}
+		if seen[id] || catalog[id] == nil {
+			return false
+		}
+		seen[id] = true
+		for _, p := range catalog[id].Prerequisites {
+			if walk(p) {
+				return true
+			}
+		}
+		return false
+	}
+	return walk(from)
+}
+
+// graphProblems finds prerequisites that leave the catalog or loop back,
+// which files edited on disk can introduce
+func graphProblems(catalog map[string]*Lesson) []string {
+	var problems []string
+	for _, id := range sortedIDs(catalog) {
+		for _, p := range catalog[id].Prerequisites {
+			switch {
+			case catalog[p] == nil:
+				problems = append(problems, fmt.Sprintf("%s needs unknown lesson %s", id, p))
+			case dependsOn(catalog, p, id):
+				problems = append(problems, fmt.Sprintf("%s and %s need each other", id, p))
+			}
+		}
+	}
+	for _, t := range tracks {
+		for _, id := range t.LessonIDs {
+			if catalog[id] == nil {
+				problems = append(problems, fmt.Sprintf("track %s names unknown lesson %s", t.ID, id))
+			}
+		}
+	}
+	return problems
+}
+
+func missingPrerequisites(l *Lesson, done map[string]time.Time) []string {
+	var missing []string
+	for _, p := range l.Prerequisites {
+		if _, ok := done[p]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// recommend picks the first unfinished lesson on a track whose
+// prerequisites are all finished, trying every track when none is named
+func recommend(catalog map[string]*Lesson, done map[string]time.Time, trackID string) (*pb.Recommendation, error) {
+	candidates := tracks
+	if trackID != "" {
+		t := findTrack(trackID)
+		if t == nil {
+			return nil, fmt.Errorf("track %s not found", trackID)
+		}
+		candidates = []*Track{t}
+	}
+	for _, t := range candidates {
+		for _, id := range lessonOrder(catalog, t.LessonIDs) {
+			l := catalog[id]
+			if _, ok := done[id]; ok || len(missingPrerequisites(l, done)) > 0 {
+				continue
+			}
+			reason := fmt.Sprintf("Next on the %s track", t.Name)
+			if len(l.Prerequisites) > 0 {
+				reason += fmt.Sprintf(", building on %s", strings.Join(l.Prerequisites, ", "))
+			}
+			return &pb.Recommendation{Lesson: l.summary(), TrackId: t.ID, Reason: reason}, nil
+		}
+	}
+	return &pb.Recommendation{TrackId: trackID, Reason: "Every lesson here is finished", AllCompleted: true}, nil
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *EducationServer) ListTracks(ctx context.Context, req *pb.Empty) (*pb.TrackCatalog, error) {
+	catalog := &pb.TrackCatalog{}
+	for _, t := range tracks {
+		catalog.Tracks = append(catalog.Tracks, &pb.Track{
+			Id:          t.ID,
+			Name:        t.Name,
+			Description: t.Description,
+			LessonIds:   t.LessonIDs,
+		})
+	}
+	return catalog, nil
+}
+
+// GetLearningPath lays out a track in prerequisite order; without a
+// user_id every lesson reads as unfinished
+func (s *EducationServer) GetLearningPath(ctx context.Context, req *pb.LearningPathRequest) (*pb.LearningPath, error) {
+	t := findTrack(req.TrackId)
+	if t == nil {
+		return nil, fmt.Errorf("track %s not found", req.TrackId)
+	}
+	catalog := s.content.localizedCatalog(requestLanguages(ctx, req.Language))
+	done := s.achievements.completedLessons(req.UserId)
+
+	path := &pb.LearningPath{TrackId: t.ID, UserId: req.UserId}
+	for _, id := range lessonOrder(catalog, t.LessonIDs) {
+		l := catalog[id]
+		step := &pb.PathStep{Lesson: l.summary(), MissingPrerequisites: missingPrerequisites(l, done)}
+		switch at, ok := done[id]; {
+		case ok:
+			step.Status, step.CompletedAt = pb.LessonStatus_LESSON_COMPLETED, at.Unix()
+			path.Completed++
+		case len(step.MissingPrerequisites) > 0:
+			step.Status = pb.LessonStatus_LESSON_LOCKED
+			path.MinutesRemaining += int32(l.EstimatedMin)
+		default:
+			step.Status = pb.LessonStatus_LESSON_AVAILABLE
+			path.MinutesRemaining += int32(l.EstimatedMin)
+		}
+		path.Steps = append(path.Steps, step)
+	}
+	path.Total = int32(len(path.Steps))
+	return path, nil
+}
+
+func (s *EducationServer) GetNextRecommended(ctx context.Context, req *pb.RecommendationRequest) (*pb.Recommendation, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	catalog := s.content.localizedCatalog(requestLanguages(ctx, req.Language))
+	return recommend(catalog, s.achievements.completedLessons(req.UserId), req.TrackId)
+}
+
+func (s *EducationServer) CompleteLesson(ctx context.Context, req *pb.CompleteLessonRequest) (*pb.LessonCompletion, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	catalog := s.content.localizedCatalog(requestLanguages(ctx, req.Language))
+	l := catalog[req.LessonId]
+	if l == nil {
+		return nil, fmt.Errorf("lesson %s not found", req.LessonId)
+	}
+	if missing := missingPrerequisites(l, s.achievements.completedLessons(req.UserId)); len(missing) > 0 {
+		return nil, fmt.Errorf("lesson %s needs %s first", l.ID, strings.Join(missing, ", "))
+	}
+
+	completion := &pb.LessonCompletion{
+		LessonId: l.ID,
+		Unlocked: s.achievements.completeLesson(req.UserId, l.ID),
+	}
+	next, err := recommend(catalog, s.achievements.completedLessons(req.UserId), "")
+	if err != nil {
+		return nil, err
+	}
+	completion.Next = next
+	for _, c := range s.certify(catalog, req.UserId, req.LearnerName, l.ID) {
+		completion.Certificates = append(completion.Certificates, c.proto())
+	}
+	log.Printf("📚 %q finished lesson %s", req.UserId, l.ID)
+	return completion, nil
+}
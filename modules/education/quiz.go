@@ -0,0 +1,308 @@
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+const (
+	defaultQuizQuestions = 5
+	pointsPerQuestion    = 10
+	secondsPerQuestion   = 60
+	// quizRetention is how long attempts are kept after they start
+	quizRetention = 30 * 24 * time.Hour
+)
+
+// quizSession is one learner's attempt at a quiz. Questions are graded
+// once each, so answers cannot be probed by resubmitting.
+type quizSession struct {
+	ID          string
+	UserID      string
+	Topic       string
+	QuestionIDs []string
+	Languages   []string // As requested; answers are explained in them
+	Graded      map[string]*pb.AnswerResult
+	Hints       map[string]int // Taken, by question ID
+	Score       int
+	StartedAt   time.Time
+	AnsweredAt  time.Time // Last submission
+	ExpiresAt   time.Time
+	CompletedAt time.Time
+}
+
+func (q *quizSession) completed() bool {
+	return !q.CompletedAt.IsZero()
+}
+
+// close completes the quiz once everything is answered or time is up
+func (q *quizSession) close(now time.Time) {
+	switch {
+	case q.completed():
+	case len(q.Graded) == len(q.QuestionIDs):
+		q.CompletedAt = now
+	case now.After(q.ExpiresAt):
+		q.CompletedAt = q.ExpiresAt
+	}
+}
+
+func (q *quizSession) attempt() *pb.QuizAttempt {
+	a := &pb.QuizAttempt{
+		QuizId:         q.ID,
+		UserId:         q.UserID,
+		Topic:          topicEnum(q.Topic),
+		Score:          int32(q.Score),
+		MaxScore:       int32(len(q.QuestionIDs) * pointsPerQuestion),
+		Answered:       int32(len(q.Graded)),
+		TotalQuestions: int32(len(q.QuestionIDs)),
+		Completed:      q.completed(),
+		StartedAt:      q.StartedAt.Unix(),
+	}
+	if q.completed() {
+		a.CompletedAt = q.CompletedAt.Unix()
+	}
+	return a
+}
+
+// pruneQuizzes drops attempts past retention; callers hold s.mu
+func (s *EducationServer) pruneQuizzes(now time.Time) {
+	for id, q := range s.quizzes {
+		if now.Sub(q.StartedAt) > quizRetention {
+			delete(s.quizzes, id)
+		}
+	}
+}
+
+// GenerateQuiz starts a quiz session. The questions go out without their
+// answers, which SubmitAnswers reveals one graded question at a time.
+func (s *EducationServer) GenerateQuiz(ctx context.Context, req *pb.QuizRequest) (*pb.Quiz, error) {
+	n := int(req.NumQuestions)
+	if n == 0 {
+		n = defaultQuizQuestions
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("num_questions must be positive")
+	}
+	drawn := s.drawQuestions(topicName(req.Topic), req.Types, n)
+	if len(drawn) == 0 {
+		return nil, fmt.Errorf("no questions on %s", req.Topic)
+	}
+
+	id := make([]byte, 8)
+	crand.Read(id)
+	now := time.Now()
+	session := &quizSession{
+		ID:        "quiz-" + hex.EncodeToString(id),
+		UserID:    req.UserId,
+		Topic:     topicName(req.Topic),
+		Languages: requestLanguages(ctx, req.Language),
+		Graded:    make(map[string]*pb.AnswerResult),
+		Hints:     make(map[string]int),
+		StartedAt: now,
+		ExpiresAt: now.Add(time.Duration(len(drawn)*secondsPerQuestion) * time.Second),
+	}
+	quiz := &pb.Quiz{
+		QuizId:           session.ID,
+		TimeLimitSeconds: int32(len(drawn) * secondsPerQuestion),
+		ExpiresAt:        session.ExpiresAt.Unix(),
+	}
+	for i := range drawn {
+		session.QuestionIDs = append(session.QuestionIDs, drawn[i].ID)
+		quiz.Questions = append(quiz.Questions, s.content.localizeQuestion(drawn[i], session.Languages).proto())
+	}
+
+	s.mu.Lock()
+	s.pruneQuizzes(now)
+	s.quizzes[session.ID] = session
+	s.mu.Unlock()
+	s.achievements.joinGroup(req.UserId, req.GroupId)
+
+	log.Printf("📚 Quiz %s started for %q: %d questions", session.ID, req.UserId, len(drawn))
+	return quiz, nil
+}
+
+// checkSubmission finds an open quiz and makes sure every answer is to a
+// question of it that has not been graded yet; callers hold s.mu
+func (s *EducationServer) checkSubmission(req *pb.QuizSubmission, now time.Time) (*quizSession, error) {
+	session, ok := s.quizzes[req.QuizId]
+	if !ok {
+		return nil, fmt.Errorf("quiz %s not found", req.QuizId)
+	}
+	session.close(now)
+	if session.completed() {
+		return nil, fmt.Errorf("quiz %s is closed", req.QuizId)
+	}
+
+	inQuiz := make(map[string]bool, len(session.QuestionIDs))
+	for _, id := range session.QuestionIDs {
+		inQuiz[id] = true
+	}
+	seen := make(map[string]bool, len(req.Answers))
+	for _, a := range req.Answers {
+		switch {
+		case !inQuiz[a.QuestionId]:
+			return nil, fmt.Errorf("question %s is not part of quiz %s", a.QuestionId, req.QuizId)
+		case session.Graded[a.QuestionId] != nil, seen[a.QuestionId]:
+			return nil, fmt.Errorf("question %s has already been answered", a.QuestionId)
+		}
+		seen[a.QuestionId] = true
+	}
+	return session, nil
+}
+
+// grade marks one answer. Circuit answers also return the state the
+// learner prepared, which counts toward badges when correct.
+func (s *EducationServer) grade(ctx context.Context, q *Question, a *pb.AnswerSubmission) (*pb.AnswerResult, []complex128, error) {
+	if q.Type == "circuit_construction" {
+		return s.gradeCircuit(ctx, q, a.Gates)
+	}
+	return &pb.AnswerResult{
+		QuestionId:    q.ID,
+		Correct:       q.correct(a.Answer),
+		CorrectAnswer: q.Answer,
+		Explanation:   q.Explain,
+	}, nil, nil
+}
+
+// SubmitAnswers grades answers to questions of an open quiz. A submission
+// is checked and graded in full before anything is recorded, so a bad
+// answer does not leave it half-applied. Circuit answers run on the
+// engine, so the quiz is checked again once grading is done.
+func (s *EducationServer) SubmitAnswers(ctx context.Context, req *pb.QuizSubmission) (*pb.QuizResult, error) {
+	langs := requestLanguages(ctx, req.Language)
+	s.mu.Lock()
+	session, err := s.checkSubmission(req, time.Now())
+	if err == nil && langs == nil {
+		langs = session.Languages
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	graded := make([]*pb.AnswerResult, len(req.Answers))
+	var events []achievementEvent
+	var built []builtCircuit
+	for i, a := range req.Answers {
+		var state []complex128
+		q := s.content.localizeQuestion(s.content.question(a.QuestionId), langs)
+		if graded[i], state, err = s.grade(ctx, q, a); err != nil {
+			return nil, err
+		}
+		if !graded[i].Correct {
+			continue
+		}
+		if state != nil {
+			events = append(events, achievementEvent{"circuit_built", 1})
+			title := q.Concept
+			if title == "" {
+				title = q.Text
+			}
+			built = append(built, builtCircuit{
+				Source:  "question:" + q.ID,
+				Title:   title,
+				Gates:   formatGates(gateStepsFromProto(a.Gates)),
+				BuiltAt: time.Now(),
+			})
+		}
+		if isBellState(state) {
+			events = append(events, achievementEvent{"bell_state", 1})
+		}
+	}
+
+	s.mu.Lock()
+	now := time.Now()
+	session, err = s.checkSubmission(req, now)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	result := &pb.QuizResult{QuizId: req.QuizId, Results: graded}
+	since := session.StartedAt
+	if session.AnsweredAt.After(since) {
+		since = session.AnsweredAt
+	}
+	session.AnsweredAt = now
+	var scored []quizAnswer
+	for _, g := range graded {
+		g.HintsUsed = int32(session.Hints[g.QuestionId])
+		if g.Correct {
+			g.PointsEarned = int32(hintPoints(pointsPerQuestion, session.Hints[g.QuestionId]))
+			scored = append(scored, quizAnswer{
+				topic:  s.content.question(g.QuestionId).Topic,
+				points: int(g.PointsEarned),
+				time:   now.Sub(since) / time.Duration(len(graded)),
+			})
+		}
+		session.Graded[g.QuestionId] = g
+		session.Score += int(g.PointsEarned)
+	}
+	session.close(now)
+
+	result.Score = int32(session.Score)
+	result.MaxScore = int32(len(session.QuestionIDs) * pointsPerQuestion)
+	result.QuestionsRemaining = int32(len(session.QuestionIDs) - len(session.Graded))
+	result.Completed = session.completed()
+	s.mu.Unlock()
+
+	if result.Completed {
+		events = append(events, quizEvents(int(result.Score), int(result.MaxScore))...)
+		log.Printf("📚 Quiz %s completed by %q: %d/%d", session.ID, session.UserID, result.Score, result.MaxScore)
+		if result.MaxScore > 0 {
+			s.achievements.recordQuiz(session.UserID, session.Topic, int(result.Score*100/result.MaxScore))
+		}
+	}
+	result.Unlocked = s.achievements.record(session.UserID, events...)
+	s.achievements.scoreAnswers(session.UserID, scored, now)
+	if result.Completed {
+		result.Unlocked = append(result.Unlocked, s.achievements.quizCompleted(session.UserID, session.Topic, now)...)
+	}
+	for _, c := range built {
+		s.achievements.recordCircuit(session.UserID, c)
+	}
+	for _, g := range graded {
+		quality := 0
+		if g.HintsUsed > 0 {
+			quality = passingQuality // Right, with help
+		}
+		s.achievements.review(session.UserID, g.QuestionId, answerQuality(g.Correct, quality), now)
+	}
+	return result, nil
+}
+
+func (s *EducationServer) GetQuizAttempts(ctx context.Context, req *pb.AttemptsRequest) (*pb.AttemptHistory, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.pruneQuizzes(now)
+
+	var mine []*quizSession
+	for _, q := range s.quizzes {
+		if q.UserID == req.UserId {
+			q.close(now)
+			mine = append(mine, q)
+		}
+	}
+	sort.Slice(mine, func(i, j int) bool {
+		return mine[i].StartedAt.After(mine[j].StartedAt)
+	})
+
+	history := &pb.AttemptHistory{}
+	for _, q := range mine {
+		a := q.attempt()
+		history.Attempts = append(history.Attempts, a)
+		if a.Completed && a.MaxScore > 0 {
+			history.BestScore = max(history.BestScore, a.Score*100/a.MaxScore)
+		}
+	}
+	return history, nil
+}
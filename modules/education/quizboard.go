@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+const (
+	// weeksKept is how many leaderboard weeks each learner keeps: this
+	// one and the last
+	weeksKept = 2
+	dayLayout = "2006-01-02"
+)
+
+// quizWeek is a learner's quizzing in one leaderboard week, by topic and
+// under allTopics for every topic
+type quizWeek struct {
+	Points  map[string]int   `json:"points"`
+	Quizzes map[string]int   `json:"quizzes"`    // Completed
+	Fastest map[string]int64 `json:"fastest_ms"` // Fastest right answer
+}
+
+// quizStreak counts consecutive UTC days with a completed quiz
+type quizStreak struct {
+	Current int    `json:"current"`
+	Longest int    `json:"longest"`
+	LastDay string `json:"last_day"` // "2006-01-02"
+}
+
+// quizAnswer is a right answer for the leaderboards
+type quizAnswer struct {
+	topic  string
+	points int
+	time   time.Duration
+}
+
+// weekOf names the ISO week t falls in, and when it starts
+func weekOf(t time.Time) (string, time.Time) {
+	t = t.UTC()
+	year, week := t.ISOWeek()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	start := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	return fmt.Sprintf("%d-W%02d", year, week), start
+}
+
+// days is the learner's streak as of now: a streak not extended yesterday
+// or today has lapsed
+func (s quizStreak) days(now time.Time) int {
+	today := now.UTC().Format(dayLayout)
+	yesterday := now.UTC().AddDate(0, 0, -1).Format(dayLayout)
+	if s.LastDay == today || s.LastDay == yesterday {
+		return s.Current
+	}
+	return 0
+}
+
+// extend counts a quiz completed now
+func (s *quizStreak) extend(now time.Time) {
+	today := now.UTC().Format(dayLayout)
+	switch s.LastDay {
+	case today:
+		return
+	case now.UTC().AddDate(0, 0, -1).Format(dayLayout):
+		s.Current++
+	default:
+		s.Current = 1
+	}
+	s.LastDay = today
+	s.Longest = max(s.Longest, s.Current)
+}
+
+// week returns a learner's record for the week, creating it and dropping
+// weeks too old to show; callers hold as.mu
+func (p *learnerProgress) week(now time.Time) *quizWeek {
+	id, start := weekOf(now)
+	if w, ok := p.QuizWeeks[id]; ok {
+		return w
+	}
+	oldest, _ := weekOf(start.AddDate(0, 0, -7*(weeksKept-1)))
+	for old := range p.QuizWeeks {
+		if old < oldest {
+			delete(p.QuizWeeks, old)
+		}
+	}
+	w := &quizWeek{Points: make(map[string]int), Quizzes: make(map[string]int), Fastest: make(map[string]int64)}
+	p.QuizWeeks[id] = w
+	return w
+}
+
+// scoreAnswers adds right answers to this week's leaderboards
+func (as *achievementStore) scoreAnswers(userID string, answers []quizAnswer, now time.Time) {
+	if userID == "" || len(answers) == 0 {
+		return
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	w := as.learner(userID).week(now)
+	for _, a := range answers {
+		ms := max(1, a.time.Milliseconds())
+		for _, topic := range []string{a.topic, allTopics} {
+			w.Points[topic] += a.points
+			if best, ok := w.Fastest[topic]; !ok || ms < best {
+				w.Fastest[topic] = ms
+			}
+		}
+	}
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save quiz scores: %v", err)
+	}
+}
+
+// joinGroup puts a learner on a group's leaderboards
+func (as *achievementStore) joinGroup(userID, group string) {
+	if userID == "" || group == "" {
+		return
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	if p.Groups[group] {
+		return
+	}
+	p.Groups[group] = true
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save quiz groups: %v", err)
+	}
+}
+
+// quizCompleted counts a finished quiz toward the week and the learner's
+// daily streak, and returns the badges that unlocked
+func (as *achievementStore) quizCompleted(userID, topic string, now time.Time) []*pb.Badge {
+	if userID == "" {
+		return nil
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	w := p.week(now)
+	w.Quizzes[allTopics]++
+	if topic != "" {
+		w.Quizzes[topic]++
+	}
+	p.Streak.extend(now)
+	p.Counters["best_daily_streak"] = p.Streak.Longest
+	return as.recordLocked(userID, nil)
+}
+
+// quizBoard ranks the learners with quizzing on a topic in a week, only
+// those of a group when one is given
+func (as *achievementStore) quizBoard(week, topic, group string, order pb.QuizBoardOrder, now time.Time) []*pb.QuizLeaderboardEntry {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	var board []*pb.QuizLeaderboardEntry
+	for userID, p := range as.learners {
+		w, ok := p.QuizWeeks[week]
+		if !ok || w.Points[topic] == 0 && w.Quizzes[topic] == 0 || group != "" && !p.Groups[group] {
+			continue
+		}
+		e := &pb.QuizLeaderboardEntry{
+			UserId:            userID,
+			Points:            int32(w.Points[topic]),
+			QuizzesCompleted:  int32(w.Quizzes[topic]),
+			FastestAnswerMs:   w.Fastest[topic],
+			StreakDays:        int32(p.Streak.days(now)),
+			LongestStreakDays: int32(p.Streak.Longest),
+		}
+		switch {
+		case order == pb.QuizBoardOrder_QUIZ_BOARD_FASTEST && e.FastestAnswerMs == 0,
+			order == pb.QuizBoardOrder_QUIZ_BOARD_STREAK && e.StreakDays == 0:
+			continue
+		}
+		board = append(board, e)
+	}
+
+	sort.Slice(board, func(i, j int) bool {
+		a, b := board[i], board[j]
+		switch order {
+		case pb.QuizBoardOrder_QUIZ_BOARD_FASTEST:
+			if a.FastestAnswerMs != b.FastestAnswerMs {
+				return a.FastestAnswerMs < b.FastestAnswerMs
+			}
+		case pb.QuizBoardOrder_QUIZ_BOARD_STREAK:
+			if a.StreakDays != b.StreakDays {
+				return a.StreakDays > b.StreakDays
+			}
+		}
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		return a.UserId < b.UserId
+	})
+	for i, e := range board {
+		e.Rank = int32(i + 1)
+	}
+	return board
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *EducationServer) GetQuizLeaderboard(ctx context.Context, req *pb.QuizLeaderboardRequest) (*pb.QuizLeaderboard, error) {
+	if _, ok := pb.QuizBoardOrder_name[int32(req.Order)]; !ok {
+		return nil, fmt.Errorf("unknown order %v", req.Order)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultBoardSize
+	}
+	topic := topicName(req.Topic)
+	if topic == "" {
+		topic = allTopics
+	}
+
+	now := time.Now()
+	_, start := weekOf(now)
+	if req.PreviousWeek {
+		start = start.AddDate(0, 0, -7)
+	}
+	week, _ := weekOf(start)
+	board := s.achievements.quizBoard(week, topic, req.GroupId, req.Order, now)
+
+	out := &pb.QuizLeaderboard{
+		Week:     week,
+		StartsAt: start.Unix(),
+		ResetsAt: start.AddDate(0, 0, 7).Unix(),
+		Topic:    req.Topic,
+		Order:    req.Order,
+		Entries:  board[:min(limit, len(board))],
+		Players:  int32(len(board)),
+	}
+	for _, e := range board {
+		if req.UserId != "" && e.UserId == req.UserId {
+			out.You = e
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,672 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// Lesson text is stored as canonical UTF-8 Markdown. Math goes between
+// dollars, inline as $\ket{\psi}$ or on its own lines between $$, in the
+// TeX most authors know, with the Dirac macros \ket, \bra and \braket.
+// Clients ask for a rendering: HTML, ANSI for terminals, or Discord's
+// markdown subset, with the math written out in Unicode.
+
+// ------------------------------------------------------------------
+// Canonical text
+// ------------------------------------------------------------------
+
+// cp1252 maps the Windows-1252 characters in 0x80-0x9F back to their
+// bytes; Latin-1 covers the rest
+var cp1252 = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E, '‘': 0x91,
+	'’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98,
+	'™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+func mojibakeByte(r rune) (byte, bool) {
+	if r >= 0x80 && r <= 0xFF {
+		return byte(r), true
+	}
+	b, ok := cp1252[r]
+	return b, ok
+}
+
+// repairMojibake undoes UTF-8 that was decoded as Windows-1252 or Latin-1
+// on its way in, so "â€¦" reads "…" and "âŸ©" reads "⟩" again. Only
+// runs that decode to whole multi-byte characters are replaced; text that
+// merely contains accented letters is left alone.
+func repairMojibake(s string) string {
+	runes := []rune(s)
+	var out strings.Builder
+	for i := 0; i < len(runes); {
+		b0, ok := mojibakeByte(runes[i])
+		if !ok || b0 < 0xC2 || b0 > 0xF4 {
+			out.WriteRune(runes[i])
+			i++
+			continue
+		}
+		var buf []byte
+		for j := i; j < len(runes) && j < i+utf8.UTFMax; j++ {
+			b, ok := mojibakeByte(runes[j])
+			if !ok {
+				break
+			}
+			buf = append(buf, b)
+		}
+		r, size := utf8.DecodeRune(buf)
+		if r == utf8.RuneError || size < 2 {
+			out.WriteRune(runes[i])
+			i++
+			continue
+		}
+		out.WriteRune(r)
+		i += size
+	}
+	return out.String()
+}
+
+// canonicalText is how text is stored: valid UTF-8 with mangled
+// characters repaired, Unix line endings, no byte order marks, and NFC
+// normalized
+func canonicalText(s string) string {
+	s = strings.ToValidUTF8(s, "\uFFFD")
+	// Text mangled twice takes two passes
+	for range 3 {
+		repaired := repairMojibake(s)
+		if repaired == s {
+			break
+		}
+		s = repaired
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	s = strings.ReplaceAll(s, "\uFEFF", "")
+	return norm.NFC.String(s)
+}
+
+// canonicalFile reads a content file as canonical text, noting repairs
+// so the file can be fixed at its source
+func canonicalFile(path string, data []byte) string {
+	text := canonicalText(string(data))
+	if text != string(data) {
+		log.Printf("📚 %s: repaired its text encoding", path)
+	}
+	return text
+}
+
+func canonicalList(items []string) []string {
+	if items == nil {
+		return nil
+	}
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = canonicalText(item)
+	}
+	return out
+}
+
+// canonical is a copy of the lesson with its text canonical
+func (l *Lesson) canonical() *Lesson {
+	v := *l
+	v.Title, v.Content, v.Author = canonicalText(l.Title), canonicalText(l.Content), canonicalText(l.Author)
+	v.KeyConcepts = canonicalList(l.KeyConcepts)
+	return &v
+}
+
+func (t *lessonTranslation) canonical() *lessonTranslation {
+	v := *t
+	v.Title, v.Content, v.Translator = canonicalText(t.Title), canonicalText(t.Content), canonicalText(t.Translator)
+	v.KeyConcepts = canonicalList(t.KeyConcepts)
+	return &v
+}
+
+func (q *Question) canonical() *Question {
+	v := *q
+	v.Text, v.Explain, v.Concept = canonicalText(q.Text), canonicalText(q.Explain), canonicalText(q.Concept)
+	v.Options, v.Hints = canonicalList(q.Options), canonicalList(q.Hints)
+	return &v
+}
+
+func (t *questionTranslation) canonical() *questionTranslation {
+	return &questionTranslation{
+		Text:        canonicalText(t.Text),
+		Options:     canonicalList(t.Options),
+		Explanation: canonicalText(t.Explanation),
+		Concept:     canonicalText(t.Concept),
+		Hints:       canonicalList(t.Hints),
+	}
+}
+
+// ------------------------------------------------------------------
+// Math
+// ------------------------------------------------------------------
+
+var texSymbols = map[string]string{
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ε",
+	"theta": "θ", "lambda": "λ", "mu": "μ", "pi": "π", "sigma": "σ",
+	"tau": "τ", "phi": "φ", "varphi": "φ", "chi": "χ", "psi": "ψ", "omega": "ω",
+	"Gamma": "Γ", "Delta": "Δ", "Theta": "Θ", "Lambda": "Λ", "Pi": "Π",
+	"Sigma": "Σ", "Phi": "Φ", "Psi": "Ψ", "Omega": "Ω",
+	"sum": "Σ", "prod": "Π", "int": "∫", "partial": "∂", "nabla": "∇",
+	"otimes": "⊗", "oplus": "⊕", "dagger": "†", "cdot": "·", "times": "×",
+	"pm": "±", "mp": "∓", "infty": "∞", "approx": "≈", "neq": "≠", "ne": "≠",
+	"leq": "≤", "le": "≤", "geq": "≥", "ge": "≥", "equiv": "≡", "propto": "∝",
+	"to": "→", "rightarrow": "→", "leftarrow": "←", "mapsto": "↦",
+	"Rightarrow": "⇒", "iff": "⇔", "in": "∈", "forall": "∀", "exists": "∃",
+	"langle": "⟨", "rangle": "⟩", "hbar": "ℏ", "ldots": "…", "cdots": "⋯",
+	"sin": "sin", "cos": "cos", "exp": "exp", "log": "log", "ln": "ln",
+}
+
+// Characters with Unicode super- and subscript forms
+var (
+	superscripts = map[rune]rune{
+		'0': '⁰', '1': '¹', '2': '²', '3': '³', '4': '⁴', '5': '⁵', '6': '⁶', '7': '⁷', '8': '⁸', '9': '⁹',
+		'+': '⁺', '-': '⁻', '=': '⁼', '(': '⁽', ')': '⁾', 'n': 'ⁿ', 'i': 'ⁱ', 'k': 'ᵏ', 'j': 'ʲ',
+		'x': 'ˣ', 't': 'ᵗ', 'T': 'ᵀ', '†': '†', '*': '*', '−': '⁻',
+	}
+	subscripts = map[rune]rune{
+		'0': '₀', '1': '₁', '2': '₂', '3': '₃', '4': '₄', '5': '₅', '6': '₆', '7': '₇', '8': '₈', '9': '₉',
+		'+': '₊', '-': '₋', '=': '₌', '(': '₍', ')': '₎', 'a': 'ₐ', 'e': 'ₑ', 'o': 'ₒ', 'x': 'ₓ',
+		'i': 'ᵢ', 'j': 'ⱼ', 'k': 'ₖ', 'n': 'ₙ', 'm': 'ₘ', 't': 'ₜ', '−': '₋',
+	}
+)
+
+// script writes s raised or lowered, with Unicode forms when every
+// character has one
+func script(s string, sup bool) string {
+	table, mark := subscripts, "_"
+	if sup {
+		table, mark = superscripts, "^"
+	}
+	var b strings.Builder
+	for _, r := range s {
+		c, ok := table[r]
+		if !ok {
+			if utf8.RuneCountInString(s) == 1 {
+				return mark + s
+			}
+			return mark + "(" + s + ")"
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// parenthesize wraps a fraction's part when it would read ambiguously
+func parenthesize(s string) string {
+	if strings.ContainsAny(s, " +-−±/·×⊗=,") {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// texReader converts the TeX between dollars into Unicode text
+type texReader struct {
+	src string
+	i   int
+}
+
+func texToUnicode(tex string) string {
+	r := &texReader{src: tex}
+	return strings.TrimSpace(r.until(0))
+}
+
+// until converts up to a closing brace, or to the end when close is 0
+func (r *texReader) until(close byte) string {
+	var b strings.Builder
+	for r.i < len(r.src) {
+		switch c := r.src[r.i]; {
+		case close != 0 && c == close:
+			r.i++
+			return b.String()
+		case c == '{':
+			r.i++
+			b.WriteString(r.until('}'))
+		case c == '\\':
+			b.WriteString(r.command())
+		case c == '^' || c == '_':
+			r.i++
+			b.WriteString(script(r.arg(), c == '^'))
+		case c == '-':
+			b.WriteString("−")
+			r.i++
+		default:
+			b.WriteByte(c)
+			r.i++
+		}
+	}
+	return b.String()
+}
+
+// arg reads one argument: a braced group, a command or a character
+func (r *texReader) arg() string {
+	for r.i < len(r.src) && r.src[r.i] == ' ' {
+		r.i++
+	}
+	if r.i >= len(r.src) {
+		return ""
+	}
+	switch r.src[r.i] {
+	case '{':
+		r.i++
+		return r.until('}')
+	case '\\':
+		return r.command()
+	}
+	_, size := utf8.DecodeRuneInString(r.src[r.i:])
+	r.i += size
+	return r.src[r.i-size : r.i]
+}
+
+func (r *texReader) command() string {
+	r.i++ // The backslash
+	start := r.i
+	for r.i < len(r.src) && (r.src[r.i] >= 'a' && r.src[r.i] <= 'z' || r.src[r.i] >= 'A' && r.src[r.i] <= 'Z') {
+		r.i++
+	}
+	if r.i == start {
+		if r.i >= len(r.src) {
+			return ""
+		}
+		r.i++
+		switch c := r.src[r.i-1]; c {
+		case ',', ';', ':', ' ':
+			return " "
+		case '!':
+			return ""
+		default:
+			return string(c) // \{ \} \| \$
+		}
+	}
+	name := r.src[start:r.i]
+	// Spaces after a command name only end it
+	for r.i < len(r.src) && r.src[r.i] == ' ' && name != "quad" && name != "qquad" {
+		r.i++
+	}
+	switch name {
+	case "ket":
+		return "|" + r.arg() + "⟩"
+	case "bra":
+		return "⟨" + r.arg() + "|"
+	case "braket":
+		return "⟨" + r.arg() + "⟩"
+	case "frac":
+		num := r.arg()
+		return parenthesize(num) + "/" + parenthesize(r.arg())
+	case "sqrt":
+		return "√" + parenthesize(r.arg())
+	case "text", "mathrm", "mathbf", "mathit", "operatorname":
+		return r.arg()
+	case "left", "right", "big", "Big", "bigg", "Bigg":
+		return ""
+	case "quad", "qquad":
+		return "  "
+	}
+	if sym, ok := texSymbols[name]; ok {
+		return sym
+	}
+	return "\\" + name
+}
+
+// ------------------------------------------------------------------
+// Markdown
+// ------------------------------------------------------------------
+
+type blockKind int
+
+const (
+	blockParagraph blockKind = iota
+	blockHeading
+	blockBullet
+	blockNumbered
+	blockCode
+	blockMath
+)
+
+type block struct {
+	kind  blockKind
+	level int    // Heading level, or a numbered item's number
+	text  string // Inline Markdown; verbatim for code and math
+}
+
+var (
+	headingLine  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	bulletLine   = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	numberedLine = regexp.MustCompile(`^\s*(\d+)[.)]\s+(.*)$`)
+)
+
+// parseBlocks splits Markdown into the blocks lessons use: headings,
+// paragraphs, lists, fenced code and $$ math
+func parseBlocks(src string) []block {
+	var blocks []block
+	var para []string
+	flush := func() {
+		if len(para) > 0 {
+			blocks = append(blocks, block{kind: blockParagraph, text: strings.Join(para, " ")})
+			para = nil
+		}
+	}
+	lines := strings.Split(src, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case len(trimmed) > 4 && strings.HasPrefix(trimmed, "$$") && strings.HasSuffix(trimmed, "$$"):
+			flush()
+			blocks = append(blocks, block{kind: blockMath, text: strings.TrimSpace(trimmed[2 : len(trimmed)-2])})
+		case strings.HasPrefix(trimmed, "```"), strings.HasPrefix(trimmed, "$$"):
+			// Fenced to a closing line; math may start or end on the fences
+			flush()
+			kind, fence := blockCode, "```"
+			if strings.HasPrefix(trimmed, "$$") {
+				kind, fence = blockMath, "$$"
+			}
+			var body []string
+			if rest := strings.TrimPrefix(trimmed, fence); kind == blockMath && rest != "" {
+				body = append(body, rest)
+			}
+			for i++; i < len(lines); i++ {
+				closing := strings.TrimSpace(lines[i])
+				if kind == blockMath && strings.HasSuffix(closing, fence) {
+					if rest := strings.TrimSpace(strings.TrimSuffix(closing, fence)); rest != "" {
+						body = append(body, rest)
+					}
+					break
+				}
+				if kind == blockCode && strings.HasPrefix(closing, fence) {
+					break
+				}
+				body = append(body, lines[i])
+			}
+			blocks = append(blocks, block{kind: kind, text: strings.Join(body, "\n")})
+		case trimmed == "":
+			flush()
+		case headingLine.MatchString(line):
+			flush()
+			m := headingLine.FindStringSubmatch(line)
+			blocks = append(blocks, block{kind: blockHeading, level: len(m[1]), text: m[2]})
+		case bulletLine.MatchString(line):
+			flush()
+			blocks = append(blocks, block{kind: blockBullet, text: bulletLine.FindStringSubmatch(line)[1]})
+		case numberedLine.MatchString(line):
+			flush()
+			m := numberedLine.FindStringSubmatch(line)
+			n, _ := strconv.Atoi(m[1])
+			blocks = append(blocks, block{kind: blockNumbered, level: n, text: m[2]})
+		default:
+			para = append(para, trimmed)
+		}
+	}
+	flush()
+	return blocks
+}
+
+type spanKind int
+
+const (
+	spanText spanKind = iota
+	spanBold
+	spanItalic
+	spanCode
+	spanMath
+)
+
+type span struct {
+	kind spanKind
+	text string
+}
+
+// parseSpans splits a line into plain text, **bold**, *italic*, `code`
+// and $math$. Markers without a partner are plain text.
+func parseSpans(s string) []span {
+	var spans []span
+	var plain strings.Builder
+	emit := func(kind spanKind, text string) {
+		if plain.Len() > 0 {
+			spans = append(spans, span{spanText, plain.String()})
+			plain.Reset()
+		}
+		spans = append(spans, span{kind, text})
+	}
+	for i := 0; i < len(s); {
+		var kind spanKind
+		var open string
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && strings.IndexByte("\\`*$_", s[i+1]) >= 0:
+			plain.WriteByte(s[i+1])
+			i += 2
+			continue
+		case s[i] == '`':
+			kind, open = spanCode, "`"
+		case s[i] == '$':
+			kind, open = spanMath, "$"
+		case strings.HasPrefix(s[i:], "**"):
+			kind, open = spanBold, "**"
+		case s[i] == '*':
+			kind, open = spanItalic, "*"
+		default:
+			plain.WriteByte(s[i])
+			i++
+			continue
+		}
+		end := strings.Index(s[i+len(open):], open)
+		if end <= 0 {
+			plain.WriteString(open)
+			i += len(open)
+			continue
+		}
+		emit(kind, s[i+len(open):i+len(open)+end])
+		i += 2*len(open) + end
+	}
+	if plain.Len() > 0 {
+		spans = append(spans, span{spanText, plain.String()})
+	}
+	return spans
+}
+
+// ------------------------------------------------------------------
+// Renderings
+// ------------------------------------------------------------------
+
+// ANSI escape codes
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiItalic    = "\x1b[3m"
+	ansiUnderline = "\x1b[4m"
+	ansiCode      = "\x1b[36m" // Cyan
+	ansiMath      = "\x1b[35m" // Magenta
+)
+
+// discordEscaper keeps characters in text from turning into formatting,
+// "||" into spoilers in particular
+var discordEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "|", `\|`, "`", "\\`")
+
+func renderInline(s string, format pb.RenderFormat) string {
+	var b strings.Builder
+	for _, sp := range parseSpans(s) {
+		text := sp.text
+		if sp.kind == spanMath {
+			text = texToUnicode(text)
+		}
+		switch format {
+		case pb.RenderFormat_RENDER_HTML:
+			switch sp.kind {
+			case spanText:
+				b.WriteString(html.EscapeString(text))
+			case spanBold:
+				fmt.Fprintf(&b, "<strong>%s</strong>", html.EscapeString(text))
+			case spanItalic:
+				fmt.Fprintf(&b, "<em>%s</em>", html.EscapeString(text))
+			case spanCode:
+				fmt.Fprintf(&b, "<code>%s</code>", html.EscapeString(text))
+			case spanMath:
+				fmt.Fprintf(&b, `<span class="math" data-tex="%s">%s</span>`, html.EscapeString(sp.text), html.EscapeString(text))
+			}
+		case pb.RenderFormat_RENDER_ANSI:
+			switch sp.kind {
+			case spanText:
+				b.WriteString(text)
+			case spanBold:
+				b.WriteString(ansiBold + text + ansiReset)
+			case spanItalic:
+				b.WriteString(ansiItalic + text + ansiReset)
+			case spanCode:
+				b.WriteString(ansiCode + text + ansiReset)
+			case spanMath:
+				b.WriteString(ansiMath + text + ansiReset)
+			}
+		case pb.RenderFormat_RENDER_DISCORD:
+			switch sp.kind {
+			case spanText, spanMath:
+				b.WriteString(discordEscaper.Replace(text))
+			case spanBold:
+				b.WriteString("**" + discordEscaper.Replace(text) + "**")
+			case spanItalic:
+				b.WriteString("*" + discordEscaper.Replace(text) + "*")
+			case spanCode:
+				b.WriteString("`" + strings.ReplaceAll(text, "`", "'") + "`")
+			}
+		}
+	}
+	return b.String()
+}
+
+// render turns canonical lesson Markdown into a client's format. Markdown
+// comes back as it is stored.
+func render(src string, format pb.RenderFormat) string {
+	if format == pb.RenderFormat_RENDER_MARKDOWN {
+		return src
+	}
+	var out []string
+	var list []string // Open list items; HTML needs them wrapped
+	listKind := blockParagraph
+	closeList := func() {
+		if len(list) == 0 {
+			return
+		}
+		if format == pb.RenderFormat_RENDER_HTML {
+			tag := "ul"
+			if listKind == blockNumbered {
+				tag = "ol"
+			}
+			out = append(out, fmt.Sprintf("<%s>\n%s\n</%s>", tag, strings.Join(list, "\n"), tag))
+		} else {
+			out = append(out, strings.Join(list, "\n"))
+		}
+		list = nil
+	}
+
+	for _, bl := range parseBlocks(src) {
+		if bl.kind == blockBullet || bl.kind == blockNumbered {
+			if bl.kind != listKind {
+				closeList()
+			}
+			listKind = bl.kind
+			list = append(list, renderListItem(bl, format))
+			continue
+		}
+		closeList()
+		listKind = blockParagraph
+		out = append(out, renderBlock(bl, format))
+	}
+	closeList()
+
+	sep := "\n\n"
+	if format == pb.RenderFormat_RENDER_HTML {
+		sep = "\n"
+	}
+	return strings.Join(out, sep) + "\n"
+}
+
+func renderListItem(bl block, format pb.RenderFormat) string {
+	text := renderInline(bl.text, format)
+	switch {
+	case format == pb.RenderFormat_RENDER_HTML:
+		return "<li>" + text + "</li>"
+	case format == pb.RenderFormat_RENDER_ANSI && bl.kind == blockNumbered:
+		return fmt.Sprintf("  %d. %s", bl.level, text)
+	case format == pb.RenderFormat_RENDER_ANSI:
+		return "  • " + text
+	case bl.kind == blockNumbered:
+		return fmt.Sprintf("%d. %s", bl.level, text)
+	}
+	return "- " + text
+}
+
+func renderBlock(bl block, format pb.RenderFormat) string {
+	switch format {
+	case pb.RenderFormat_RENDER_HTML:
+		switch bl.kind {
+		case blockHeading:
+			return fmt.Sprintf("<h%d>%s</h%d>", bl.level, renderInline(bl.text, format), bl.level)
+		case blockCode:
+			return "<pre><code>" + html.EscapeString(bl.text) + "</code></pre>"
+		case blockMath:
+			return fmt.Sprintf(`<div class="math" data-tex="%s">%s</div>`, html.EscapeString(bl.text), html.EscapeString(texToUnicode(bl.text)))
+		}
+		return "<p>" + renderInline(bl.text, format) + "</p>"
+	case pb.RenderFormat_RENDER_ANSI:
+		switch bl.kind {
+		case blockHeading:
+			style := ansiBold
+			if bl.level == 1 {
+				style += ansiUnderline
+			}
+			return style + renderInline(bl.text, format) + ansiReset
+		case blockCode:
+			return ansiCode + indent(bl.text) + ansiReset
+		case blockMath:
+			return ansiMath + indent(texToUnicode(bl.text)) + ansiReset
+		}
+		return renderInline(bl.text, format)
+	case pb.RenderFormat_RENDER_DISCORD:
+		switch bl.kind {
+		case blockHeading:
+			// Discord has three heading sizes
+			if bl.level > 3 {
+				return "**" + renderInline(bl.text, format) + "**"
+			}
+			return strings.Repeat("#", bl.level) + " " + renderInline(bl.text, format)
+		case blockCode:
+			return "```\n" + strings.ReplaceAll(bl.text, "```", "'''") + "\n```"
+		case blockMath:
+			return "> " + strings.ReplaceAll(discordEscaper.Replace(texToUnicode(bl.text)), "\n", "\n> ")
+		}
+		return renderInline(bl.text, format)
+	}
+	return bl.text
+}
+
+func indent(s string) string {
+	return "    " + strings.ReplaceAll(s, "\n", "\n    ")
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// RenderContent previews how Markdown will be stored and rendered, for
+// authors and translators
+func (s *EducationServer) RenderContent(ctx context.Context, req *pb.RenderRequest) (*pb.RenderedContent, error) {
+	if _, ok := pb.RenderFormat_name[int32(req.Format)]; !ok {
+		return nil, fmt.Errorf("unknown format %v", req.Format)
+	}
+	canonical := canonicalText(req.Markdown)
+	return &pb.RenderedContent{
+		CanonicalMarkdown: canonical,
+		Rendered:          render(canonical, req.Format),
+		Repaired:          canonical != req.Markdown,
+	}, nil
+}
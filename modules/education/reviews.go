@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// SM-2 parameters. Quality runs 0-5; below 3 is a lapse.
+const (
+	initialEase     = 2.5
+	minEase         = 1.3
+	lapseQuality    = 1
+	goodQuality     = 4
+	defaultDueLimit = 10
+	reviewDay       = 24 * time.Hour
+	firstInterval   = 1
+	secondInterval  = 6
+	passingQuality  = 3
+	perfectQuality  = 5
+)
+
+// reviewCard schedules one missed question for a learner
+type reviewCard struct {
+	QuestionID  string    `json:"question_id"`
+	Ease        float64   `json:"ease"`
+	Interval    int       `json:"interval_days"`
+	Repetitions int       `json:"repetitions"`
+	Lapses      int       `json:"lapses"`
+	Due         time.Time `json:"due"`
+}
+
+// schedule applies one graded answer, SM-2 style
+func (c *reviewCard) schedule(quality int, now time.Time) {
+	if quality < passingQuality {
+		c.Repetitions, c.Interval = 0, firstInterval
+		c.Lapses++
+	} else {
+		switch c.Repetitions {
+		case 0:
+			c.Interval = firstInterval
+		case 1:
+			c.Interval = secondInterval
+		default:
+			c.Interval = int(math.Round(float64(c.Interval) * c.Ease))
+		}
+		c.Repetitions++
+	}
+	miss := float64(perfectQuality - quality)
+	c.Ease = math.Max(minEase, c.Ease+0.1-miss*(0.08+miss*0.02))
+	c.Due = now.Add(time.Duration(c.Interval) * reviewDay)
+}
+
+// proto shows the card with its question as q, which may be translated
+func (c *reviewCard) proto(q *Question) *pb.ReviewCard {
+	return &pb.ReviewCard{
+		Question:     q.proto(),
+		DueAt:        c.Due.Unix(),
+		IntervalDays: int32(c.Interval),
+		EaseFactor:   c.Ease,
+		Repetitions:  int32(c.Repetitions),
+		Lapses:       int32(c.Lapses),
+	}
+}
+
+// review applies a graded answer to a learner's card for a question. A
+// wrong answer opens a card; a right one only moves a card already open.
+// It returns a copy of the card, or nil when there is none.
+func (as *achievementStore) review(userID, questionID string, quality int, now time.Time) *reviewCard {
+	if userID == "" {
+		return nil
+	}
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p := as.learner(userID)
+	card, ok := p.Reviews[questionID]
+	if !ok {
+		if quality >= passingQuality {
+			return nil
+		}
+		card = &reviewCard{QuestionID: questionID, Ease: initialEase}
+		p.Reviews[questionID] = card
+	}
+	card.schedule(quality, now)
+	if err := as.save(); err != nil {
+		log.Printf("📚 Failed to save reviews: %v", err)
+	}
+	c := *card
+	return &c
+}
+
+// reviewCard is a copy of a learner's card, or nil
+func (as *achievementStore) reviewCard(userID, questionID string) *reviewCard {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	p, ok := as.learners[userID]
+	if !ok || p.Reviews[questionID] == nil {
+		return nil
+	}
+	c := *p.Reviews[questionID]
+	return &c
+}
+
+// reviews copies a learner's cards on known questions, soonest due first
+func (as *achievementStore) reviews(userID string, known func(string) bool) []reviewCard {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	var cards []reviewCard
+	if p, ok := as.learners[userID]; ok {
+		for _, c := range p.Reviews {
+			if known(c.QuestionID) {
+				cards = append(cards, *c)
+			}
+		}
+	}
+	sort.Slice(cards, func(i, j int) bool { return cards[i].Due.Before(cards[j].Due) })
+	return cards
+}
+
+// dueLearners counts due cards on known questions for every learner that
+// has any
+func (as *achievementStore) dueLearners(now time.Time, known func(string) bool) []*pb.LearnerDue {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	var out []*pb.LearnerDue
+	for _, userID := range sortedIDs(as.learners) {
+		due := &pb.LearnerDue{UserId: userID}
+		for _, c := range as.learners[userID].Reviews {
+			if c.Due.After(now) || !known(c.QuestionID) {
+				continue
+			}
+			due.DueCount++
+			if due.OldestDueAt == 0 || c.Due.Unix() < due.OldestDueAt {
+				due.OldestDueAt = c.Due.Unix()
+			}
+		}
+		if due.DueCount > 0 {
+			out = append(out, due)
+		}
+	}
+	return out
+}
+
+// answerQuality grades an answer for the scheduler
+func answerQuality(correct bool, quality int) int {
+	switch {
+	case !correct:
+		return lapseQuality
+	case quality == 0:
+		return goodQuality
+	}
+	return min(max(quality, passingQuality), perfectQuality)
+}
+
+// knownQuestion reports whether a card's question is still in the bank;
+// cards on questions removed from the content directory are not shown
+func (s *EducationServer) knownQuestion(id string) bool {
+	return s.content.question(id) != nil
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// GetDueReviews lists a learner's due cards. Without a user_id it lists
+// the learners with anything due, so a bot can remind them.
+func (s *EducationServer) GetDueReviews(ctx context.Context, req *pb.DueReviewsRequest) (*pb.DueReviews, error) {
+	now := time.Now()
+	if req.UserId == "" {
+		learners := s.achievements.dueLearners(now, s.knownQuestion)
+		out := &pb.DueReviews{Learners: learners}
+		for _, l := range learners {
+			out.DueCount += l.DueCount
+		}
+		return out, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultDueLimit
+	}
+
+	langs := requestLanguages(ctx, req.Language)
+	out := &pb.DueReviews{}
+	for _, c := range s.achievements.reviews(req.UserId, s.knownQuestion) {
+		if c.Due.After(now) {
+			out.NextDueAt = c.Due.Unix()
+			break
+		}
+		out.DueCount++
+		if len(out.Reviews) < limit {
+			out.Reviews = append(out.Reviews, c.proto(s.content.localizeQuestion(s.content.question(c.QuestionID), langs)))
+		}
+	}
+	return out, nil
+}
+
+// SubmitReview grades an answer to one of a learner's review cards, early
+// or late, and reschedules the card
+func (s *EducationServer) SubmitReview(ctx context.Context, req *pb.ReviewSubmission) (*pb.ReviewResult, error) {
+	if req.UserId == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	if req.Quality < 0 || req.Quality > perfectQuality {
+		return nil, fmt.Errorf("quality must be 0-%d", perfectQuality)
+	}
+	q := s.content.question(req.QuestionId)
+	if q == nil || s.achievements.reviewCard(req.UserId, q.ID) == nil {
+		return nil, fmt.Errorf("no review of %s for %q", req.QuestionId, req.UserId)
+	}
+
+	q = s.content.localizeQuestion(q, requestLanguages(ctx, req.Language))
+	graded, _, err := s.grade(ctx, q, &pb.AnswerSubmission{QuestionId: q.ID, Answer: req.Answer, Gates: req.Gates})
+	if err != nil {
+		return nil, err
+	}
+	card := s.achievements.review(req.UserId, q.ID, answerQuality(graded.Correct, int(req.Quality)), time.Now())
+	log.Printf("📚 Review of %s by %q: correct=%v, next in %d days", q.ID, req.UserId, graded.Correct, card.Interval)
+	return &pb.ReviewResult{Result: graded, Card: card.proto(q)}, nil
+}
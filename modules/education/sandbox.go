@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+	engine "github.com/perclft/QubitEngine/modules/education/generated/engine"
+)
+
+const (
+	maxSandboxQubits = 6
+	maxSandboxGates  = 64
+	maxSandboxDepth  = 32
+	// amplitudeEpsilon hides numerically zero terms from kets
+	amplitudeEpsilon = 1e-9
+)
+
+// sandboxGate describes a gate learners may use: how many qubits it acts
+// on, and a sentence for the trace
+type sandboxGate struct {
+	qubits  int
+	explain string
+}
+
+var sandboxGates = map[string]sandboxGate{
+	"H":       {1, "puts the qubit into an equal superposition (or takes it back out)"},
+	"X":       {1, "flips |0⟩ and |1⟩"},
+	"Z":       {1, "flips the sign of |1⟩"},
+	"S":       {1, "gives |1⟩ a phase of i"},
+	"T":       {1, "gives |1⟩ a phase of e^(iπ/4)"},
+	"RY":      {1, "rotates the qubit about the Y axis"},
+	"RZ":      {1, "rotates the qubit about the Z axis"},
+	"CNOT":    {2, "flips the target where the control is |1⟩"},
+	"TOFFOLI": {3, "flips the target where both controls are |1⟩"},
+	"MEASURE": {1, "collapses the qubit to the outcome"},
+}
+
+var gateAliases = map[string]string{"CX": "CNOT", "CCX": "TOFFOLI", "CCNOT": "TOFFOLI", "M": "MEASURE"}
+
+// sandboxStep is a validated gate and the engine operations it becomes
+type sandboxStep struct {
+	gate   *pb.GateStep
+	name   string
+	qubits []uint32
+	ops    []*engine.GateOperation
+}
+
+func parseSandboxStep(g *pb.GateStep, numQubits int) (*sandboxStep, error) {
+	name := strings.ToUpper(strings.TrimSpace(g.Gate))
+	if alias, ok := gateAliases[name]; ok {
+		name = alias
+	}
+	spec, ok := sandboxGates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown gate %q", g.Gate)
+	}
+	if len(g.Qubits) != spec.qubits {
+		return nil, fmt.Errorf("%s acts on %d qubit(s), got %d", name, spec.qubits, len(g.Qubits))
+	}
+	step := &sandboxStep{gate: g, name: name}
+	seen := make(map[int32]bool)
+	for _, q := range g.Qubits {
+		if q < 0 || int(q) >= numQubits {
+			return nil, fmt.Errorf("%s: qubit %d is out of range", name, q)
+		}
+		if seen[q] {
+			return nil, fmt.Errorf("%s: qubit %d used twice", name, q)
+		}
+		seen[q] = true
+		step.qubits = append(step.qubits, uint32(q))
+	}
+
+	q := step.qubits
+	op := func(t engine.GateOperation_GateType) *engine.GateOperation {
+		return &engine.GateOperation{Type: t, TargetQubit: q[len(q)-1]}
+	}
+	switch name {
+	case "H":
+		step.ops = append(step.ops, op(engine.GateOperation_HADAMARD))
+	case "X":
+		step.ops = append(step.ops, op(engine.GateOperation_PAULI_X))
+	case "Z":
+		step.ops = append(step.ops, op(engine.GateOperation_PHASE_S), op(engine.GateOperation_PHASE_S))
+	case "S":
+		step.ops = append(step.ops, op(engine.GateOperation_PHASE_S))
+	case "T":
+		step.ops = append(step.ops, op(engine.GateOperation_PHASE_T))
+	case "RY", "RZ":
+		o := op(engine.GateOperation_ROTATION_Y)
+		if name == "RZ" {
+			o.Type = engine.GateOperation_ROTATION_Z
+		}
+		o.Angle = g.Parameter
+		step.ops = append(step.ops, o)
+	case "CNOT":
+		o := op(engine.GateOperation_CNOT)
+		o.ControlQubit = q[0]
+		step.ops = append(step.ops, o)
+	case "TOFFOLI":
+		o := op(engine.GateOperation_TOFFOLI)
+		o.ControlQubit, o.SecondControlQubit = q[0], q[1]
+		step.ops = append(step.ops, o)
+	case "MEASURE":
+		o := op(engine.GateOperation_MEASURE)
+		o.ClassicalRegister = q[0]
+		step.ops = append(step.ops, o)
+	}
+	return step, nil
+}
+
+// circuitDepth is the number of layers when gates on disjoint qubits
+// share a layer
+func circuitDepth(steps []*sandboxStep, numQubits int) int {
+	layer := make([]int, numQubits)
+	depth := 0
+	for _, s := range steps {
+		d := 0
+		for _, q := range s.qubits {
+			d = max(d, layer[q])
+		}
+		d++
+		for _, q := range s.qubits {
+			layer[q] = d
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
+
+func amplitudes(state []*engine.StateResponse_ComplexNumber) ([]*pb.Amplitude, []float64) {
+	amps := make([]*pb.Amplitude, len(state))
+	probs := make([]float64, len(state))
+	for i, c := range state {
+		amps[i] = &pb.Amplitude{Real: c.Real, Imag: c.Imag}
+		probs[i] = c.Real*c.Real + c.Imag*c.Imag
+	}
+	return amps, probs
+}
+
+func stateOf(amps []*pb.Amplitude) []complex128 {
+	state := make([]complex128, len(amps))
+	for i, a := range amps {
+		state[i] = complex(a.Real, a.Imag)
+	}
+	return state
+}
+
+// ketString writes a state in Dirac notation, e.g. 0.707|00⟩ + 0.707|11⟩
+func ketString(state []*pb.Amplitude, numQubits int) string {
+	var terms []string
+	for i, a := range state {
+		if math.Hypot(a.Real, a.Imag) < amplitudeEpsilon {
+			continue
+		}
+		var coeff string
+		switch {
+		case math.Abs(a.Imag) < amplitudeEpsilon:
+			coeff = fmt.Sprintf("%.3f", a.Real)
+		case math.Abs(a.Real) < amplitudeEpsilon:
+			coeff = fmt.Sprintf("%.3fi", a.Imag)
+		default:
+			coeff = fmt.Sprintf("(%.3f%+.3fi)", a.Real, a.Imag)
+		}
+		terms = append(terms, fmt.Sprintf("%s|%0*b⟩", coeff, numQubits, i))
+	}
+	return strings.ReplaceAll(strings.Join(terms, " + "), "+ -", "- ")
+}
+
+func (s *sandboxStep) describe(outcome int32) string {
+	names := make([]string, len(s.qubits))
+	for i, q := range s.qubits {
+		names[i] = fmt.Sprintf("q%d", q)
+	}
+	text := fmt.Sprintf("%s on %s %s", s.name, strings.Join(names, ", "), sandboxGates[s.name].explain)
+	if s.name == "RY" || s.name == "RZ" {
+		text += fmt.Sprintf(" by %.3f rad", s.gate.Parameter)
+	}
+	if outcome >= 0 {
+		text += fmt.Sprintf(" %d", outcome)
+	}
+	return text
+}
+
+// RunSandboxCircuit runs a learner's circuit through the engine's
+// step-by-step visualizer and returns the state after every gate
+func (s *EducationServer) RunSandboxCircuit(ctx context.Context, req *pb.SandboxRequest) (*pb.SandboxResult, error) {
+	numQubits, gates := int(req.NumQubits), req.Gates
+	if len(gates) == 0 && req.CircuitId != "" {
+		c := s.content.circuit(req.CircuitId)
+		if c == nil {
+			return nil, fmt.Errorf("circuit %s not found", req.CircuitId)
+		}
+		lib := c.proto()
+		numQubits, gates = int(lib.NumQubits), lib.Gates
+	}
+	if numQubits < 1 || numQubits > maxSandboxQubits {
+		return nil, fmt.Errorf("num_qubits must be 1-%d", maxSandboxQubits)
+	}
+	if len(gates) == 0 || len(gates) > maxSandboxGates {
+		return nil, fmt.Errorf("a sandbox circuit needs 1-%d gates", maxSandboxGates)
+	}
+
+	steps := make([]*sandboxStep, len(gates))
+	var ops []*engine.GateOperation
+	for i, g := range gates {
+		step, err := parseSandboxStep(g, numQubits)
+		if err != nil {
+			return nil, fmt.Errorf("gate %d: %v", i, err)
+		}
+		steps[i] = step
+		ops = append(ops, step.ops...)
+	}
+	depth := circuitDepth(steps, numQubits)
+	if depth > maxSandboxDepth {
+		return nil, fmt.Errorf("circuit depth %d exceeds %d", depth, maxSandboxDepth)
+	}
+
+	stream, err := s.engineClient.VisualizeCircuit(ctx, &engine.CircuitRequest{
+		NumQubits:  int32(numQubits),
+		Operations: ops,
+		Seed:       req.Seed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("engine error: %v", err)
+	}
+
+	result := &pb.SandboxResult{
+		NumQubits: int32(numQubits),
+		GateCount: int32(len(steps)),
+		Depth:     int32(depth),
+	}
+	for i, step := range steps {
+		// The engine streams one state per operation; a step's state is
+		// the one after its last operation
+		var resp *engine.StateResponse
+		for range step.ops {
+			if resp, err = stream.Recv(); err != nil {
+				if err == io.EOF {
+					err = fmt.Errorf("stream ended early")
+				}
+				return nil, fmt.Errorf("engine error at gate %d: %v", i, err)
+			}
+		}
+		outcome := int32(-1)
+		if step.name == "MEASURE" {
+			outcome = 0
+			if resp.ClassicalResults[step.qubits[0]] {
+				outcome = 1
+			}
+		}
+		amps, probs := amplitudes(resp.StateVector)
+		result.Trace = append(result.Trace, &pb.TraceStep{
+			Step:          int32(i + 1),
+			Gate:          step.gate,
+			Description:   step.describe(outcome),
+			StateVector:   amps,
+			Probabilities: probs,
+			StateKet:      ketString(amps, numQubits),
+			Outcome:       outcome,
+		})
+	}
+
+	final := result.Trace[len(result.Trace)-1]
+	result.StateVector, result.Probabilities = final.StateVector, final.Probabilities
+	result.FinalState = final.StateKet
+	if numQubits == 2 && isBellState(stateOf(final.StateVector)) {
+		result.Unlocked = s.achievements.record(req.UserId, achievementEvent{"bell_state", 1})
+	}
+	log.Printf("📚 Sandbox: %d qubits, %d gates, depth %d → %s", numQubits, len(steps), depth, result.FinalState)
+	return result, nil
+}
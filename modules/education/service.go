@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/perclft/QubitEngine/modules/education/generated"
+)
+
+// The catalog keeps topics and difficulties as bare names; the API uses
+// the prefixed enums
+
+func topicName(t pb.Topic) string {
+	if t == pb.Topic_TOPIC_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(t.String(), "TOPIC_")
+}
+
+func topicEnum(name string) pb.Topic {
+	return pb.Topic(pb.Topic_value["TOPIC_"+name])
+}
+
+func difficultyName(d pb.Difficulty) string {
+	if d == pb.Difficulty_DIFFICULTY_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(d.String(), "DIFFICULTY_")
+}
+
+func difficultyEnum(name string) pb.Difficulty {
+	return pb.Difficulty(pb.Difficulty_value["DIFFICULTY_"+name])
+}
+
+var questionTypes = map[string]pb.QuestionType{
+	"multiple_choice":      pb.QuestionType_QUESTION_MULTIPLE_CHOICE,
+	"true_false":           pb.QuestionType_QUESTION_TRUE_FALSE,
+	"circuit_output":       pb.QuestionType_QUESTION_CIRCUIT_OUTPUT,
+	"fill_blank":           pb.QuestionType_QUESTION_FILL_BLANK,
+	"circuit_construction": pb.QuestionType_QUESTION_CIRCUIT_CONSTRUCTION,
+}
+
+func (l *Lesson) proto() *pb.Lesson {
+	return &pb.Lesson{
+		Id:                  l.ID,
+		Topic:               topicEnum(l.Topic),
+		Title:               l.Title,
+		ContentMarkdown:     l.Content,
+		KeyConcepts:         l.KeyConcepts,
+		CircuitExamples:     l.CircuitExamples,
+		NextLessonId:        l.NextLessonID,
+		EstimatedMinutes:    int32(l.EstimatedMin),
+		Difficulty:          difficultyEnum(l.Difficulty),
+		Version:             int32(l.Version),
+		Author:              l.Author,
+		UpdatedAt:           unixOrZero(l.UpdatedAt),
+		Prerequisites:       l.Prerequisites,
+		Language:            languageName(l.Language),
+		TranslationOutdated: l.Outdated,
+	}
+}
+
+// rendered is proto with the content rendered for a client
+func (l *Lesson) rendered(format pb.RenderFormat) *pb.Lesson {
+	p := l.proto()
+	p.ContentRendered = render(l.Content, format)
+	return p
+}
+
+func (l *Lesson) summary() *pb.LessonSummary {
+	return &pb.LessonSummary{
+		Id:               l.ID,
+		Topic:            topicEnum(l.Topic),
+		Title:            l.Title,
+		Difficulty:       difficultyEnum(l.Difficulty),
+		EstimatedMinutes: int32(l.EstimatedMin),
+		Version:          int32(l.Version),
+		Prerequisites:    l.Prerequisites,
+		Language:         languageName(l.Language),
+	}
+}
+
+func lessonFromProto(p *pb.Lesson) *Lesson {
+	return &Lesson{
+		ID:              p.Id,
+		Topic:           topicName(p.Topic),
+		Title:           p.Title,
+		Content:         p.ContentMarkdown,
+		KeyConcepts:     p.KeyConcepts,
+		CircuitExamples: p.CircuitExamples,
+		NextLessonID:    p.NextLessonId,
+		Prerequisites:   p.Prerequisites,
+		EstimatedMin:    int(p.EstimatedMinutes),
+		Difficulty:      difficultyName(p.Difficulty),
+		Author:          p.Author,
+	}
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func (c *Circuit) proto() *pb.LibraryCircuit {
+	return &pb.LibraryCircuit{
+		Id:             c.ID,
+		Name:           c.Name,
+		Description:    c.Description,
+		Topic:          topicEnum(c.Topic),
+		Difficulty:     difficultyEnum(c.Difficulty),
+		NumQubits:      int32(c.NumQubits),
+		ExpectedOutput: c.Output,
+		Gates:          gateStepsProto(c.Gates),
+	}
+}
+
+func gateStepsProto(gates []GateStep) []*pb.GateStep {
+	out := make([]*pb.GateStep, len(gates))
+	for i, g := range gates {
+		out[i] = &pb.GateStep{Gate: g.Gate, Parameter: g.Param}
+		for _, q := range g.Qubits {
+			out[i].Qubits = append(out[i].Qubits, int32(q))
+		}
+	}
+	return out
+}
+
+func gateStepsFromProto(gates []*pb.GateStep) []GateStep {
+	out := make([]GateStep, len(gates))
+	for i, g := range gates {
+		out[i] = GateStep{Gate: g.Gate, Param: g.Parameter}
+		for _, q := range g.Qubits {
+			out[i].Qubits = append(out[i].Qubits, int(q))
+		}
+	}
+	return out
+}
+
+func (q *Question) proto() *pb.Question {
+	return &pb.Question{
+		QuestionId: q.ID,
+		Type:       questionTypes[q.Type],
+		Text:       q.Text,
+		Options:    q.Options,
+		Points:     pointsPerQuestion,
+		Topic:      topicEnum(q.Topic),
+		NumQubits:  int32(q.NumQubits),
+		Concept:    q.Concept,
+		Language:   languageName(q.Language),
+		Hints:      int32(len(q.Hints)),
+	}
+}
+
+// correct accepts an option's index or its text for multiple choice, and
+// ignores case and surrounding space
+func (q *Question) correct(answer string) bool {
+	answer = strings.TrimSpace(answer)
+	if strings.EqualFold(answer, q.Answer) {
+		return true
+	}
+	if i, err := strconv.Atoi(q.Answer); err == nil && i >= 0 && i < len(q.Options) {
+		return answer == q.Options[i]
+	}
+	return false
+}
+
+// sortedIDs lists map keys in order so catalogs are stable
+func sortedIDs[T any](m map[string]T) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *EducationServer) GetLesson(ctx context.Context, req *pb.LessonRequest) (*pb.Lesson, error) {
+	langs := requestLanguages(ctx, req.Language)
+	if req.LessonId != "" {
+		l := s.content.current(req.LessonId)
+		if req.Version != 0 {
+			l = s.content.version(req.LessonId, int(req.Version))
+		}
+		if l == nil {
+			return nil, fmt.Errorf("lesson %s not found", req.LessonId)
+		}
+		return s.content.localize(l, langs).rendered(req.Format), nil
+	}
+	topic, difficulty := topicName(req.Topic), difficultyName(req.Difficulty)
+	for _, l := range s.content.all() {
+		if (topic == "" || l.Topic == topic) && (difficulty == "" || l.Difficulty == difficulty) {
+			return s.content.localize(l, langs).rendered(req.Format), nil
+		}
+	}
+	return nil, fmt.Errorf("no lesson on %s", req.Topic)
+}
+
+func (s *EducationServer) ListLessons(ctx context.Context, req *pb.LessonListRequest) (*pb.LessonCatalog, error) {
+	langs := requestLanguages(ctx, req.Language)
+	catalog := &pb.LessonCatalog{}
+	for _, l := range s.content.all() {
+		catalog.Lessons = append(catalog.Lessons, s.content.localize(l, langs).summary())
+	}
+	return catalog, nil
+}
+
+func (s *EducationServer) GetCircuit(ctx context.Context, req *pb.CircuitRequest) (*pb.LibraryCircuit, error) {
+	c := s.content.circuit(req.CircuitId)
+	if c == nil {
+		return nil, fmt.Errorf("circuit %s not found", req.CircuitId)
+	}
+	return c.proto(), nil
+}
+
+func (s *EducationServer) ListCircuits(ctx context.Context, req *pb.CircuitFilter) (*pb.CircuitCatalog, error) {
+	topic, difficulty := topicName(req.Topic), difficultyName(req.Difficulty)
+	catalog := &pb.CircuitCatalog{}
+	library := s.content.circuitLibrary()
+	for _, id := range sortedIDs(library) {
+		c := library[id]
+		switch {
+		case topic != "" && c.Topic != topic,
+			difficulty != "" && c.Difficulty != difficulty,
+			req.MaxQubits > 0 && c.NumQubits > int(req.MaxQubits):
+			continue
+		}
+		catalog.Circuits = append(catalog.Circuits, c.summary())
+	}
+	return catalog, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// correlationMatrix assembles the assets' correlations from each one's
+// row, its correlation with every asset, itself included; with no rows
+// given anywhere the assets are uncorrelated. The matrix must be
+// symmetric with a unit diagonal and positive semi-definite, the last
+// checked by taking its Cholesky factor, which is returned with it.
+func correlationMatrix(symbols []string, rows [][]float64) ([][]float64, [][]float64, error) {
+	n := len(symbols)
+	correlated := false
+	for i, row := range rows {
+		if len(row) != 0 && len(row) != n {
+			return nil, nil, fmt.Errorf("asset %s has %d correlations, not %d", symbols[i], len(row), n)
+		}
+		correlated = correlated || len(row) > 0
+	}
+
+	corr := make([][]float64, n)
+	for i := range corr {
+		corr[i] = make([]float64, n)
+		corr[i][i] = 1
+		if !correlated {
+			continue
+		}
+		if len(rows[i]) == 0 {
+			return nil, nil, fmt.Errorf("give correlations for every asset or none")
+		}
+		for j, rho := range rows[i] {
+			switch {
+			case i == j && math.Abs(rho-1) > 1e-9:
+				return nil, nil, fmt.Errorf("asset %s must have correlation 1 with itself", symbols[i])
+			case i == j:
+			case math.Abs(rho) > 1:
+				return nil, nil, fmt.Errorf("correlation of %s and %s is outside [-1, 1]", symbols[i], symbols[j])
+			case len(rows[j]) != 0 && math.Abs(rho-rows[j][i]) > 1e-9:
+				return nil, nil, fmt.Errorf("correlation of %s and %s is not symmetric", symbols[i], symbols[j])
+			default:
+				corr[i][j] = rho
+			}
+		}
+	}
+
+	factor, err := cholesky(corr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("correlations are inconsistent: %v", err)
+	}
+	return corr, factor, nil
+}
+
+// cholesky finds the lower triangular L with L·Lᵀ = a. A semi-definite
+// matrix has zero pivots, where an asset is wholly explained by those
+// before it; its column of L is then zero.
+func cholesky(a [][]float64) ([][]float64, error) {
+	const tol = 1e-10
+	n := len(a)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		d := a[j][j]
+		for k := 0; k < j; k++ {
+			d -= l[j][k] * l[j][k]
+		}
+		if d < -tol {
+			return nil, fmt.Errorf("the matrix is not positive semi-definite")
+		}
+		pivot := math.Sqrt(math.Max(d, 0))
+		l[j][j] = pivot
+		for i := j + 1; i < n; i++ {
+			v := a[i][j]
+			for k := 0; k < j; k++ {
+				v -= l[i][k] * l[j][k]
+			}
+			if pivot <= math.Sqrt(tol) {
+				if math.Abs(v) > 1e-8 {
+					return nil, fmt.Errorf("the matrix is not positive semi-definite")
+				}
+				continue
+			}
+			l[i][j] = v / pivot
+		}
+	}
+	return l, nil
+}
+
+// correlatedNormals fills z with standard normals correlated by the
+// Cholesky factor l, using eps for the independent draws
+func correlatedNormals(src normals, l [][]float64, eps, z []float64) {
+	src.fill(eps)
+	for i := range z {
+		z[i] = 0
+		for k := 0; k <= i; k++ {
+			z[i] += l[i][k] * eps[k]
+		}
+	}
+}
@@ -0,0 +1,3562 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: finance.proto
+
+package generated
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OptionType int32
+
+const (
+	OptionType_OPTION_CALL OptionType = 0
+	OptionType_OPTION_PUT  OptionType = 1
+)
+
+// Enum value maps for OptionType.
+var (
+	OptionType_name = map[int32]string{
+		0: "OPTION_CALL",
+		1: "OPTION_PUT",
+	}
+	OptionType_value = map[string]int32{
+		"OPTION_CALL": 0,
+		"OPTION_PUT":  1,
+	}
+)
+
+func (x OptionType) Enum() *OptionType {
+	p := new(OptionType)
+	*p = x
+	return p
+}
+
+func (x OptionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[0].Descriptor()
+}
+
+func (OptionType) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[0]
+}
+
+func (x OptionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionType.Descriptor instead.
+func (OptionType) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{0}
+}
+
+// Where the simulations' normal draws come from
+type SamplingMethod int32
+
+const (
+	SamplingMethod_SAMPLING_PSEUDO_RANDOM SamplingMethod = 0
+	SamplingMethod_SAMPLING_SOBOL         SamplingMethod = 1 // Scrambled Sobol points, evening out the draws; up to 1000 per simulation
+)
+
+// Enum value maps for SamplingMethod.
+var (
+	SamplingMethod_name = map[int32]string{
+		0: "SAMPLING_PSEUDO_RANDOM",
+		1: "SAMPLING_SOBOL",
+	}
+	SamplingMethod_value = map[string]int32{
+		"SAMPLING_PSEUDO_RANDOM": 0,
+		"SAMPLING_SOBOL":         1,
+	}
+)
+
+func (x SamplingMethod) Enum() *SamplingMethod {
+	p := new(SamplingMethod)
+	*p = x
+	return p
+}
+
+func (x SamplingMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SamplingMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[1].Descriptor()
+}
+
+func (SamplingMethod) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[1]
+}
+
+func (x SamplingMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SamplingMethod.Descriptor instead.
+func (SamplingMethod) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{1}
+}
+
+type PathStyle int32
+
+const (
+	PathStyle_PATH_ASIAN    PathStyle = 0 // Pays on the average price over the dates
+	PathStyle_PATH_BARRIER  PathStyle = 1 // Vanilla payoff, switched on or off by a barrier
+	PathStyle_PATH_LOOKBACK PathStyle = 2 // Pays on the highest or lowest price
+)
+
+// Enum value maps for PathStyle.
+var (
+	PathStyle_name = map[int32]string{
+		0: "PATH_ASIAN",
+		1: "PATH_BARRIER",
+		2: "PATH_LOOKBACK",
+	}
+	PathStyle_value = map[string]int32{
+		"PATH_ASIAN":    0,
+		"PATH_BARRIER":  1,
+		"PATH_LOOKBACK": 2,
+	}
+)
+
+func (x PathStyle) Enum() *PathStyle {
+	p := new(PathStyle)
+	*p = x
+	return p
+}
+
+func (x PathStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PathStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[2].Descriptor()
+}
+
+func (PathStyle) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[2]
+}
+
+func (x PathStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PathStyle.Descriptor instead.
+func (PathStyle) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{2}
+}
+
+type BarrierType int32
+
+const (
+	BarrierType_BARRIER_UP_AND_OUT   BarrierType = 0
+	BarrierType_BARRIER_UP_AND_IN    BarrierType = 1
+	BarrierType_BARRIER_DOWN_AND_OUT BarrierType = 2
+	BarrierType_BARRIER_DOWN_AND_IN  BarrierType = 3
+)
+
+// Enum value maps for BarrierType.
+var (
+	BarrierType_name = map[int32]string{
+		0: "BARRIER_UP_AND_OUT",
+		1: "BARRIER_UP_AND_IN",
+		2: "BARRIER_DOWN_AND_OUT",
+		3: "BARRIER_DOWN_AND_IN",
+	}
+	BarrierType_value = map[string]int32{
+		"BARRIER_UP_AND_OUT":   0,
+		"BARRIER_UP_AND_IN":    1,
+		"BARRIER_DOWN_AND_OUT": 2,
+		"BARRIER_DOWN_AND_IN":  3,
+	}
+)
+
+func (x BarrierType) Enum() *BarrierType {
+	p := new(BarrierType)
+	*p = x
+	return p
+}
+
+func (x BarrierType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BarrierType) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[3].Descriptor()
+}
+
+func (BarrierType) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[3]
+}
+
+func (x BarrierType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BarrierType.Descriptor instead.
+func (BarrierType) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{3}
+}
+
+// Selecting which assets to hold is a QUBO (quadratic unconstrained
+// binary optimization) over one bit per asset
+type PortfolioSolver int32
+
+const (
+	PortfolioSolver_SOLVER_AUTO      PortfolioSolver = 0 // QAOA when the engine can hold the problem, else annealing
+	PortfolioSolver_SOLVER_QAOA      PortfolioSolver = 1 // Quantum approximate optimization on the engine
+	PortfolioSolver_SOLVER_ANNEALING PortfolioSolver = 2 // Classical simulated annealing
+)
+
+// Enum value maps for PortfolioSolver.
+var (
+	PortfolioSolver_name = map[int32]string{
+		0: "SOLVER_AUTO",
+		1: "SOLVER_QAOA",
+		2: "SOLVER_ANNEALING",
+	}
+	PortfolioSolver_value = map[string]int32{
+		"SOLVER_AUTO":      0,
+		"SOLVER_QAOA":      1,
+		"SOLVER_ANNEALING": 2,
+	}
+)
+
+func (x PortfolioSolver) Enum() *PortfolioSolver {
+	p := new(PortfolioSolver)
+	*p = x
+	return p
+}
+
+func (x PortfolioSolver) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortfolioSolver) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[4].Descriptor()
+}
+
+func (PortfolioSolver) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[4]
+}
+
+func (x PortfolioSolver) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortfolioSolver.Descriptor instead.
+func (PortfolioSolver) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{4}
+}
+
+type RiskReportStage int32
+
+const (
+	RiskReportStage_STAGE_SIMULATING  RiskReportStage = 0 // Drawing outcomes; var holds the estimate so far
+	RiskReportStage_STAGE_ATTRIBUTING RiskReportStage = 1 // Replaying the tail to split it by position
+	RiskReportStage_STAGE_DONE        RiskReportStage = 2 // The full report
+)
+
+// Enum value maps for RiskReportStage.
+var (
+	RiskReportStage_name = map[int32]string{
+		0: "STAGE_SIMULATING",
+		1: "STAGE_ATTRIBUTING",
+		2: "STAGE_DONE",
+	}
+	RiskReportStage_value = map[string]int32{
+		"STAGE_SIMULATING":  0,
+		"STAGE_ATTRIBUTING": 1,
+		"STAGE_DONE":        2,
+	}
+)
+
+func (x RiskReportStage) Enum() *RiskReportStage {
+	p := new(RiskReportStage)
+	*p = x
+	return p
+}
+
+func (x RiskReportStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskReportStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[5].Descriptor()
+}
+
+func (RiskReportStage) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[5]
+}
+
+func (x RiskReportStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskReportStage.Descriptor instead.
+func (RiskReportStage) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{5}
+}
+
+type OptionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              OptionType             `protobuf:"varint,1,opt,name=type,proto3,enum=qubit_engine.finance.OptionType" json:"type,omitempty"`
+	SpotPrice         float64                `protobuf:"fixed64,2,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty"`                       // Current stock price
+	StrikePrice       float64                `protobuf:"fixed64,3,opt,name=strike_price,json=strikePrice,proto3" json:"strike_price,omitempty"`                 // Exercise price
+	RiskFreeRate      float64                `protobuf:"fixed64,4,opt,name=risk_free_rate,json=riskFreeRate,proto3" json:"risk_free_rate,omitempty"`            // Annual risk-free rate (e.g., 0.05)
+	Volatility        float64                `protobuf:"fixed64,5,opt,name=volatility,proto3" json:"volatility,omitempty"`                                      // Annual volatility (e.g., 0.2)
+	TimeToExpiry      float64                `protobuf:"fixed64,6,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"`            // Years
+	DividendYield     float64                `protobuf:"fixed64,7,opt,name=dividend_yield,json=dividendYield,proto3" json:"dividend_yield,omitempty"`           // Optional continuous dividend
+	NumSimulations    int32                  `protobuf:"varint,8,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`         // Monte Carlo paths
+	VarianceReduction *VarianceReduction     `protobuf:"bytes,9,opt,name=variance_reduction,json=varianceReduction,proto3" json:"variance_reduction,omitempty"` // European and path options
+	Sampling          SamplingMethod         `protobuf:"varint,10,opt,name=sampling,proto3,enum=qubit_engine.finance.SamplingMethod" json:"sampling,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OptionRequest) Reset() {
+	*x = OptionRequest{}
+	mi := &file_finance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionRequest) ProtoMessage() {}
+
+func (x *OptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionRequest.ProtoReflect.Descriptor instead.
+func (*OptionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OptionRequest) GetType() OptionType {
+	if x != nil {
+		return x.Type
+	}
+	return OptionType_OPTION_CALL
+}
+
+func (x *OptionRequest) GetSpotPrice() float64 {
+	if x != nil {
+		return x.SpotPrice
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetStrikePrice() float64 {
+	if x != nil {
+		return x.StrikePrice
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetRiskFreeRate() float64 {
+	if x != nil {
+		return x.RiskFreeRate
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetTimeToExpiry() float64 {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetDividendYield() float64 {
+	if x != nil {
+		return x.DividendYield
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetVarianceReduction() *VarianceReduction {
+	if x != nil {
+		return x.VarianceReduction
+	}
+	return nil
+}
+
+func (x *OptionRequest) GetSampling() SamplingMethod {
+	if x != nil {
+		return x.Sampling
+	}
+	return SamplingMethod_SAMPLING_PSEUDO_RANDOM
+}
+
+// Estimators that reach a given standard error with fewer draws; any
+// may be combined
+type VarianceReduction struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Antithetic         bool                   `protobuf:"varint,1,opt,name=antithetic,proto3" json:"antithetic,omitempty"`                                           // Pair every draw with its mirror image
+	ControlVariate     bool                   `protobuf:"varint,2,opt,name=control_variate,json=controlVariate,proto3" json:"control_variate,omitempty"`             // Regress out the terminal price, or for path options the European payoff priced by Black-Scholes
+	ImportanceSampling bool                   `protobuf:"varint,3,opt,name=importance_sampling,json=importanceSampling,proto3" json:"importance_sampling,omitempty"` // Shift the draws toward the strike when out of the money, reweighting by likelihood
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VarianceReduction) Reset() {
+	*x = VarianceReduction{}
+	mi := &file_finance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VarianceReduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VarianceReduction) ProtoMessage() {}
+
+func (x *VarianceReduction) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VarianceReduction.ProtoReflect.Descriptor instead.
+func (*VarianceReduction) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VarianceReduction) GetAntithetic() bool {
+	if x != nil {
+		return x.Antithetic
+	}
+	return false
+}
+
+func (x *VarianceReduction) GetControlVariate() bool {
+	if x != nil {
+		return x.ControlVariate
+	}
+	return false
+}
+
+func (x *VarianceReduction) GetImportanceSampling() bool {
+	if x != nil {
+		return x.ImportanceSampling
+	}
+	return false
+}
+
+// Priced by Longstaff-Schwartz: the value of holding on is regressed on
+// the price at each exercise date
+type AmericanOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *OptionRequest         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ExerciseDates int32                  `protobuf:"varint,2,opt,name=exercise_dates,json=exerciseDates,proto3" json:"exercise_dates,omitempty"` // Number of potential exercise dates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmericanOptionRequest) Reset() {
+	*x = AmericanOptionRequest{}
+	mi := &file_finance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmericanOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmericanOptionRequest) ProtoMessage() {}
+
+func (x *AmericanOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmericanOptionRequest.ProtoReflect.Descriptor instead.
+func (*AmericanOptionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AmericanOptionRequest) GetBase() *OptionRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *AmericanOptionRequest) GetExerciseDates() int32 {
+	if x != nil {
+		return x.ExerciseDates
+	}
+	return 0
+}
+
+// Paths are simulated in time_steps equal steps to expiry; the average,
+// barrier and extremes are taken at those dates
+type PathOptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *OptionRequest         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Style          PathStyle              `protobuf:"varint,2,opt,name=style,proto3,enum=qubit_engine.finance.PathStyle" json:"style,omitempty"`
+	TimeSteps      int32                  `protobuf:"varint,3,opt,name=time_steps,json=timeSteps,proto3" json:"time_steps,omitempty"` // Default 100
+	Barrier        float64                `protobuf:"fixed64,4,opt,name=barrier,proto3" json:"barrier,omitempty"`                     // Barrier level
+	BarrierType    BarrierType            `protobuf:"varint,5,opt,name=barrier_type,json=barrierType,proto3,enum=qubit_engine.finance.BarrierType" json:"barrier_type,omitempty"`
+	FloatingStrike bool                   `protobuf:"varint,6,opt,name=floating_strike,json=floatingStrike,proto3" json:"floating_strike,omitempty"` // Lookback: strike at the path's extreme, not base.strike_price
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PathOptionRequest) Reset() {
+	*x = PathOptionRequest{}
+	mi := &file_finance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathOptionRequest) ProtoMessage() {}
+
+func (x *PathOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathOptionRequest.ProtoReflect.Descriptor instead.
+func (*PathOptionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PathOptionRequest) GetBase() *OptionRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PathOptionRequest) GetStyle() PathStyle {
+	if x != nil {
+		return x.Style
+	}
+	return PathStyle_PATH_ASIAN
+}
+
+func (x *PathOptionRequest) GetTimeSteps() int32 {
+	if x != nil {
+		return x.TimeSteps
+	}
+	return 0
+}
+
+func (x *PathOptionRequest) GetBarrier() float64 {
+	if x != nil {
+		return x.Barrier
+	}
+	return 0
+}
+
+func (x *PathOptionRequest) GetBarrierType() BarrierType {
+	if x != nil {
+		return x.BarrierType
+	}
+	return BarrierType_BARRIER_UP_AND_OUT
+}
+
+func (x *PathOptionRequest) GetFloatingStrike() bool {
+	if x != nil {
+		return x.FloatingStrike
+	}
+	return false
+}
+
+type OptionPrice struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Price             float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`                                   // Option value
+	Delta             float64                `protobuf:"fixed64,2,opt,name=delta,proto3" json:"delta,omitempty"`                                   // dV/dS
+	Gamma             float64                `protobuf:"fixed64,3,opt,name=gamma,proto3" json:"gamma,omitempty"`                                   // d²V/dS²
+	Theta             float64                `protobuf:"fixed64,4,opt,name=theta,proto3" json:"theta,omitempty"`                                   // dV/dt
+	Vega              float64                `protobuf:"fixed64,5,opt,name=vega,proto3" json:"vega,omitempty"`                                     // dV/dσ
+	Rho               float64                `protobuf:"fixed64,6,opt,name=rho,proto3" json:"rho,omitempty"`                                       // dV/dr
+	BlackScholes      float64                `protobuf:"fixed64,7,opt,name=black_scholes,json=blackScholes,proto3" json:"black_scholes,omitempty"` // Closed-form BS for comparison; the vanilla European price for other styles, a moment-matched one for baskets
+	MonteCarlo        float64                `protobuf:"fixed64,8,opt,name=monte_carlo,json=monteCarlo,proto3" json:"monte_carlo,omitempty"`       // MC estimate
+	StdError          float64                `protobuf:"fixed64,9,opt,name=std_error,json=stdError,proto3" json:"std_error,omitempty"`             // Monte Carlo standard error
+	SimulationsUsed   int32                  `protobuf:"varint,10,opt,name=simulations_used,json=simulationsUsed,proto3" json:"simulations_used,omitempty"`
+	PlainStdError     float64                `protobuf:"fixed64,11,opt,name=plain_std_error,json=plainStdError,proto3" json:"plain_std_error,omitempty"`           // Estimated for plain Monte Carlo on as many draws
+	VarianceReduction float64                `protobuf:"fixed64,12,opt,name=variance_reduction,json=varianceReduction,proto3" json:"variance_reduction,omitempty"` // (plain_std_error / std_error)²: how many times the draws plain Monte Carlo would need
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OptionPrice) Reset() {
+	*x = OptionPrice{}
+	mi := &file_finance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionPrice) ProtoMessage() {}
+
+func (x *OptionPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionPrice.ProtoReflect.Descriptor instead.
+func (*OptionPrice) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OptionPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetTheta() float64 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetVega() float64 {
+	if x != nil {
+		return x.Vega
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetRho() float64 {
+	if x != nil {
+		return x.Rho
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetBlackScholes() float64 {
+	if x != nil {
+		return x.BlackScholes
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetMonteCarlo() float64 {
+	if x != nil {
+		return x.MonteCarlo
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetStdError() float64 {
+	if x != nil {
+		return x.StdError
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetSimulationsUsed() int32 {
+	if x != nil {
+		return x.SimulationsUsed
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetPlainStdError() float64 {
+	if x != nil {
+		return x.PlainStdError
+	}
+	return 0
+}
+
+func (x *OptionPrice) GetVarianceReduction() float64 {
+	if x != nil {
+		return x.VarianceReduction
+	}
+	return 0
+}
+
+// Correlations, here and on Asset and Position, list an asset's
+// correlation with every asset in order, itself included; give them for
+// every asset or none, for uncorrelated assets. Together they must form
+// a positive semi-definite matrix.
+type BasketAsset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SpotPrice     float64                `protobuf:"fixed64,2,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`         // Units held in the basket
+	Volatility    float64                `protobuf:"fixed64,4,opt,name=volatility,proto3" json:"volatility,omitempty"` // Annual
+	DividendYield float64                `protobuf:"fixed64,5,opt,name=dividend_yield,json=dividendYield,proto3" json:"dividend_yield,omitempty"`
+	Correlations  []float64              `protobuf:"fixed64,6,rep,packed,name=correlations,proto3" json:"correlations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketAsset) Reset() {
+	*x = BasketAsset{}
+	mi := &file_finance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketAsset) ProtoMessage() {}
+
+func (x *BasketAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketAsset.ProtoReflect.Descriptor instead.
+func (*BasketAsset) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BasketAsset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *BasketAsset) GetSpotPrice() float64 {
+	if x != nil {
+		return x.SpotPrice
+	}
+	return 0
+}
+
+func (x *BasketAsset) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BasketAsset) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *BasketAsset) GetDividendYield() float64 {
+	if x != nil {
+		return x.DividendYield
+	}
+	return 0
+}
+
+func (x *BasketAsset) GetCorrelations() []float64 {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
+}
+
+// Pays on Σ weight × price at expiry against the strike
+type BasketOptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           OptionType             `protobuf:"varint,1,opt,name=type,proto3,enum=qubit_engine.finance.OptionType" json:"type,omitempty"`
+	Assets         []*BasketAsset         `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+	StrikePrice    float64                `protobuf:"fixed64,3,opt,name=strike_price,json=strikePrice,proto3" json:"strike_price,omitempty"`
+	RiskFreeRate   float64                `protobuf:"fixed64,4,opt,name=risk_free_rate,json=riskFreeRate,proto3" json:"risk_free_rate,omitempty"`
+	TimeToExpiry   float64                `protobuf:"fixed64,5,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"` // Years
+	NumSimulations int32                  `protobuf:"varint,6,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`
+	Sampling       SamplingMethod         `protobuf:"varint,7,opt,name=sampling,proto3,enum=qubit_engine.finance.SamplingMethod" json:"sampling,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BasketOptionRequest) Reset() {
+	*x = BasketOptionRequest{}
+	mi := &file_finance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketOptionRequest) ProtoMessage() {}
+
+func (x *BasketOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketOptionRequest.ProtoReflect.Descriptor instead.
+func (*BasketOptionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BasketOptionRequest) GetType() OptionType {
+	if x != nil {
+		return x.Type
+	}
+	return OptionType_OPTION_CALL
+}
+
+func (x *BasketOptionRequest) GetAssets() []*BasketAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *BasketOptionRequest) GetStrikePrice() float64 {
+	if x != nil {
+		return x.StrikePrice
+	}
+	return 0
+}
+
+func (x *BasketOptionRequest) GetRiskFreeRate() float64 {
+	if x != nil {
+		return x.RiskFreeRate
+	}
+	return 0
+}
+
+func (x *BasketOptionRequest) GetTimeToExpiry() float64 {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return 0
+}
+
+func (x *BasketOptionRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+func (x *BasketOptionRequest) GetSampling() SamplingMethod {
+	if x != nil {
+		return x.Sampling
+	}
+	return SamplingMethod_SAMPLING_PSEUDO_RANDOM
+}
+
+// A Monte Carlo estimate of one sensitivity beside its closed form
+type GreekEstimate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonteCarlo    float64                `protobuf:"fixed64,1,opt,name=monte_carlo,json=monteCarlo,proto3" json:"monte_carlo,omitempty"`
+	StdError      float64                `protobuf:"fixed64,2,opt,name=std_error,json=stdError,proto3" json:"std_error,omitempty"`
+	BlackScholes  float64                `protobuf:"fixed64,3,opt,name=black_scholes,json=blackScholes,proto3" json:"black_scholes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GreekEstimate) Reset() {
+	*x = GreekEstimate{}
+	mi := &file_finance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GreekEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GreekEstimate) ProtoMessage() {}
+
+func (x *GreekEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GreekEstimate.ProtoReflect.Descriptor instead.
+func (*GreekEstimate) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GreekEstimate) GetMonteCarlo() float64 {
+	if x != nil {
+		return x.MonteCarlo
+	}
+	return 0
+}
+
+func (x *GreekEstimate) GetStdError() float64 {
+	if x != nil {
+		return x.StdError
+	}
+	return 0
+}
+
+func (x *GreekEstimate) GetBlackScholes() float64 {
+	if x != nil {
+		return x.BlackScholes
+	}
+	return 0
+}
+
+// Delta, vega, theta and rho are pathwise estimates, differentiating the
+// payoff along each path; gamma, whose payoff derivative is a step, is a
+// likelihood-ratio estimate. All share the same simulated paths.
+type Greeks struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Delta           *GreekEstimate         `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`   // dV/dS
+	Gamma           *GreekEstimate         `protobuf:"bytes,2,opt,name=gamma,proto3" json:"gamma,omitempty"`   // d²V/dS²
+	Vega            *GreekEstimate         `protobuf:"bytes,3,opt,name=vega,proto3" json:"vega,omitempty"`     // dV/dσ, per unit of volatility
+	Theta           *GreekEstimate         `protobuf:"bytes,4,opt,name=theta,proto3" json:"theta,omitempty"`   // dV/dt, per year
+	Rho             *GreekEstimate         `protobuf:"bytes,5,opt,name=rho,proto3" json:"rho,omitempty"`       // dV/dr, per unit of rate
+	Price           float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"` // MC estimate
+	SimulationsUsed int32                  `protobuf:"varint,7,opt,name=simulations_used,json=simulationsUsed,proto3" json:"simulations_used,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Greeks) Reset() {
+	*x = Greeks{}
+	mi := &file_finance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Greeks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Greeks) ProtoMessage() {}
+
+func (x *Greeks) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Greeks.ProtoReflect.Descriptor instead.
+func (*Greeks) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Greeks) GetDelta() *GreekEstimate {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *Greeks) GetGamma() *GreekEstimate {
+	if x != nil {
+		return x.Gamma
+	}
+	return nil
+}
+
+func (x *Greeks) GetVega() *GreekEstimate {
+	if x != nil {
+		return x.Vega
+	}
+	return nil
+}
+
+func (x *Greeks) GetTheta() *GreekEstimate {
+	if x != nil {
+		return x.Theta
+	}
+	return nil
+}
+
+func (x *Greeks) GetRho() *GreekEstimate {
+	if x != nil {
+		return x.Rho
+	}
+	return nil
+}
+
+func (x *Greeks) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Greeks) GetSimulationsUsed() int32 {
+	if x != nil {
+		return x.SimulationsUsed
+	}
+	return 0
+}
+
+type Asset struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ExpectedReturn float64                `protobuf:"fixed64,2,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"` // Annual
+	Volatility     float64                `protobuf:"fixed64,3,opt,name=volatility,proto3" json:"volatility,omitempty"`                               // Annual
+	Correlations   []float64              `protobuf:"fixed64,4,rep,packed,name=correlations,proto3" json:"correlations,omitempty"`                    // With other assets
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_finance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Asset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Asset) GetExpectedReturn() float64 {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return 0
+}
+
+func (x *Asset) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *Asset) GetCorrelations() []float64 {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
+}
+
+type PortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        []*Asset               `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	TargetReturn  float64                `protobuf:"fixed64,2,opt,name=target_return,json=targetReturn,proto3" json:"target_return,omitempty"`          // Desired annual return
+	RiskTolerance float64                `protobuf:"fixed64,3,opt,name=risk_tolerance,json=riskTolerance,proto3" json:"risk_tolerance,omitempty"`       // 0-1 scale
+	MinWeight     float64                `protobuf:"fixed64,4,opt,name=min_weight,json=minWeight,proto3" json:"min_weight,omitempty"`                   // Minimum allocation per held asset
+	MaxWeight     float64                `protobuf:"fixed64,5,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`                   // Maximum allocation per held asset
+	Cardinality   int32                  `protobuf:"varint,6,opt,name=cardinality,proto3" json:"cardinality,omitempty"`                                 // Hold exactly this many assets; 0 weighs them all
+	Solver        PortfolioSolver        `protobuf:"varint,7,opt,name=solver,proto3,enum=qubit_engine.finance.PortfolioSolver" json:"solver,omitempty"` // How to choose them
+	QaoaLayers    int32                  `protobuf:"varint,8,opt,name=qaoa_layers,json=qaoaLayers,proto3" json:"qaoa_layers,omitempty"`                 // QAOA depth p; default 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioRequest) Reset() {
+	*x = PortfolioRequest{}
+	mi := &file_finance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioRequest) ProtoMessage() {}
+
+func (x *PortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioRequest.ProtoReflect.Descriptor instead.
+func (*PortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PortfolioRequest) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *PortfolioRequest) GetTargetReturn() float64 {
+	if x != nil {
+		return x.TargetReturn
+	}
+	return 0
+}
+
+func (x *PortfolioRequest) GetRiskTolerance() float64 {
+	if x != nil {
+		return x.RiskTolerance
+	}
+	return 0
+}
+
+func (x *PortfolioRequest) GetMinWeight() float64 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *PortfolioRequest) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *PortfolioRequest) GetCardinality() int32 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+func (x *PortfolioRequest) GetSolver() PortfolioSolver {
+	if x != nil {
+		return x.Solver
+	}
+	return PortfolioSolver_SOLVER_AUTO
+}
+
+func (x *PortfolioRequest) GetQaoaLayers() int32 {
+	if x != nil {
+		return x.QaoaLayers
+	}
+	return 0
+}
+
+type AssetAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"` // 0-1 (percentage of portfolio)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetAllocation) Reset() {
+	*x = AssetAllocation{}
+	mi := &file_finance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetAllocation) ProtoMessage() {}
+
+func (x *AssetAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetAllocation.ProtoReflect.Descriptor instead.
+func (*AssetAllocation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AssetAllocation) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AssetAllocation) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type OptimalPortfolio struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Allocations    []*AssetAllocation     `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	ExpectedReturn float64                `protobuf:"fixed64,2,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"`
+	Volatility     float64                `protobuf:"fixed64,3,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	SharpeRatio    float64                `protobuf:"fixed64,4,opt,name=sharpe_ratio,json=sharpeRatio,proto3" json:"sharpe_ratio,omitempty"`
+	Var_95         float64                `protobuf:"fixed64,5,opt,name=var_95,json=var95,proto3" json:"var_95,omitempty"` // 95% Value at Risk
+	// With a cardinality: the assets held, and how they were chosen
+	Selected             []string        `protobuf:"bytes,6,rep,name=selected,proto3" json:"selected,omitempty"`
+	SolverUsed           PortfolioSolver `protobuf:"varint,7,opt,name=solver_used,json=solverUsed,proto3,enum=qubit_engine.finance.PortfolioSolver" json:"solver_used,omitempty"`
+	QuboEnergy           float64         `protobuf:"fixed64,8,opt,name=qubo_energy,json=quboEnergy,proto3" json:"qubo_energy,omitempty"`                               // Of the selection; lower is better
+	SelectionProbability float64         `protobuf:"fixed64,9,opt,name=selection_probability,json=selectionProbability,proto3" json:"selection_probability,omitempty"` // QAOA: chance of measuring the selection
+	CircuitEvaluations   int32           `protobuf:"varint,10,opt,name=circuit_evaluations,json=circuitEvaluations,proto3" json:"circuit_evaluations,omitempty"`       // QAOA: engine runs to tune the angles
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OptimalPortfolio) Reset() {
+	*x = OptimalPortfolio{}
+	mi := &file_finance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimalPortfolio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimalPortfolio) ProtoMessage() {}
+
+func (x *OptimalPortfolio) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimalPortfolio.ProtoReflect.Descriptor instead.
+func (*OptimalPortfolio) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OptimalPortfolio) GetAllocations() []*AssetAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *OptimalPortfolio) GetExpectedReturn() float64 {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return 0
+}
+
+func (x *OptimalPortfolio) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *OptimalPortfolio) GetSharpeRatio() float64 {
+	if x != nil {
+		return x.SharpeRatio
+	}
+	return 0
+}
+
+func (x *OptimalPortfolio) GetVar_95() float64 {
+	if x != nil {
+		return x.Var_95
+	}
+	return 0
+}
+
+func (x *OptimalPortfolio) GetSelected() []string {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+func (x *OptimalPortfolio) GetSolverUsed() PortfolioSolver {
+	if x != nil {
+		return x.SolverUsed
+	}
+	return PortfolioSolver_SOLVER_AUTO
+}
+
+func (x *OptimalPortfolio) GetQuboEnergy() float64 {
+	if x != nil {
+		return x.QuboEnergy
+	}
+	return 0
+}
+
+func (x *OptimalPortfolio) GetSelectionProbability() float64 {
+	if x != nil {
+		return x.SelectionProbability
+	}
+	return 0
+}
+
+func (x *OptimalPortfolio) GetCircuitEvaluations() int32 {
+	if x != nil {
+		return x.CircuitEvaluations
+	}
+	return 0
+}
+
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`           // Held, in currency; negative for short
+	Volatility    float64                `protobuf:"fixed64,3,opt,name=volatility,proto3" json:"volatility,omitempty"` // Annual
+	Correlations  []float64              `protobuf:"fixed64,4,rep,packed,name=correlations,proto3" json:"correlations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_finance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Position) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Position) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Position) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *Position) GetCorrelations() []float64 {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
+}
+
+// With positions, the portfolio is their sum and its volatility comes
+// from theirs and their correlations; portfolio_value and volatility are
+// left unset
+type VaRRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioValue float64                `protobuf:"fixed64,1,opt,name=portfolio_value,json=portfolioValue,proto3" json:"portfolio_value,omitempty"`
+	Volatility     float64                `protobuf:"fixed64,2,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	Confidence     float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`                           // e.g., 0.95 for 95%
+	HoldingPeriod  int32                  `protobuf:"varint,4,opt,name=holding_period,json=holdingPeriod,proto3" json:"holding_period,omitempty"` // Days
+	Simulations    int32                  `protobuf:"varint,5,opt,name=simulations,proto3" json:"simulations,omitempty"`                          // Default 10000, up to 10 million
+	Positions      []*Position            `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	Sampling       SamplingMethod         `protobuf:"varint,7,opt,name=sampling,proto3,enum=qubit_engine.finance.SamplingMethod" json:"sampling,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VaRRequest) Reset() {
+	*x = VaRRequest{}
+	mi := &file_finance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaRRequest) ProtoMessage() {}
+
+func (x *VaRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaRRequest.ProtoReflect.Descriptor instead.
+func (*VaRRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VaRRequest) GetPortfolioValue() float64 {
+	if x != nil {
+		return x.PortfolioValue
+	}
+	return 0
+}
+
+func (x *VaRRequest) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *VaRRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *VaRRequest) GetHoldingPeriod() int32 {
+	if x != nil {
+		return x.HoldingPeriod
+	}
+	return 0
+}
+
+func (x *VaRRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *VaRRequest) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *VaRRequest) GetSampling() SamplingMethod {
+	if x != nil {
+		return x.Sampling
+	}
+	return SamplingMethod_SAMPLING_PSEUDO_RANDOM
+}
+
+type VaRResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VarParametric float64                `protobuf:"fixed64,1,opt,name=var_parametric,json=varParametric,proto3" json:"var_parametric,omitempty"` // Assuming normal distribution
+	VarHistorical float64                `protobuf:"fixed64,2,opt,name=var_historical,json=varHistorical,proto3" json:"var_historical,omitempty"` // From simulated paths
+	Cvar          float64                `protobuf:"fixed64,3,opt,name=cvar,proto3" json:"cvar,omitempty"`                                        // Conditional VaR (Expected Shortfall)
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// 95% confidence intervals on the simulated estimates
+	VarLower        float64 `protobuf:"fixed64,5,opt,name=var_lower,json=varLower,proto3" json:"var_lower,omitempty"`
+	VarUpper        float64 `protobuf:"fixed64,6,opt,name=var_upper,json=varUpper,proto3" json:"var_upper,omitempty"`
+	CvarLower       float64 `protobuf:"fixed64,7,opt,name=cvar_lower,json=cvarLower,proto3" json:"cvar_lower,omitempty"`
+	CvarUpper       float64 `protobuf:"fixed64,8,opt,name=cvar_upper,json=cvarUpper,proto3" json:"cvar_upper,omitempty"`
+	SimulationsUsed int32   `protobuf:"varint,9,opt,name=simulations_used,json=simulationsUsed,proto3" json:"simulations_used,omitempty"`
+	PortfolioValue  float64 `protobuf:"fixed64,10,opt,name=portfolio_value,json=portfolioValue,proto3" json:"portfolio_value,omitempty"`
+	Volatility      float64 `protobuf:"fixed64,11,opt,name=volatility,proto3" json:"volatility,omitempty"` // Annual, of the portfolio's value
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VaRResult) Reset() {
+	*x = VaRResult{}
+	mi := &file_finance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaRResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaRResult) ProtoMessage() {}
+
+func (x *VaRResult) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaRResult.ProtoReflect.Descriptor instead.
+func (*VaRResult) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VaRResult) GetVarParametric() float64 {
+	if x != nil {
+		return x.VarParametric
+	}
+	return 0
+}
+
+func (x *VaRResult) GetVarHistorical() float64 {
+	if x != nil {
+		return x.VarHistorical
+	}
+	return 0
+}
+
+func (x *VaRResult) GetCvar() float64 {
+	if x != nil {
+		return x.Cvar
+	}
+	return 0
+}
+
+func (x *VaRResult) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *VaRResult) GetVarLower() float64 {
+	if x != nil {
+		return x.VarLower
+	}
+	return 0
+}
+
+func (x *VaRResult) GetVarUpper() float64 {
+	if x != nil {
+		return x.VarUpper
+	}
+	return 0
+}
+
+func (x *VaRResult) GetCvarLower() float64 {
+	if x != nil {
+		return x.CvarLower
+	}
+	return 0
+}
+
+func (x *VaRResult) GetCvarUpper() float64 {
+	if x != nil {
+		return x.CvarUpper
+	}
+	return 0
+}
+
+func (x *VaRResult) GetSimulationsUsed() int32 {
+	if x != nil {
+		return x.SimulationsUsed
+	}
+	return 0
+}
+
+func (x *VaRResult) GetPortfolioValue() float64 {
+	if x != nil {
+		return x.PortfolioValue
+	}
+	return 0
+}
+
+func (x *VaRResult) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+type RiskReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Positions      []*Position            `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Confidence     float64                `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`                           // e.g., 0.99
+	HoldingPeriod  int32                  `protobuf:"varint,3,opt,name=holding_period,json=holdingPeriod,proto3" json:"holding_period,omitempty"` // Days
+	Simulations    int32                  `protobuf:"varint,4,opt,name=simulations,proto3" json:"simulations,omitempty"`
+	WorstScenarios int32                  `protobuf:"varint,5,opt,name=worst_scenarios,json=worstScenarios,proto3" json:"worst_scenarios,omitempty"` // How many of the worst outcomes to list; default 10
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RiskReportRequest) Reset() {
+	*x = RiskReportRequest{}
+	mi := &file_finance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskReportRequest) ProtoMessage() {}
+
+func (x *RiskReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskReportRequest.ProtoReflect.Descriptor instead.
+func (*RiskReportRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RiskReportRequest) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *RiskReportRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *RiskReportRequest) GetHoldingPeriod() int32 {
+	if x != nil {
+		return x.HoldingPeriod
+	}
+	return 0
+}
+
+func (x *RiskReportRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *RiskReportRequest) GetWorstScenarios() int32 {
+	if x != nil {
+		return x.WorstScenarios
+	}
+	return 0
+}
+
+// A position's share of the book's risk
+type PositionRisk struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Symbol           string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Value            float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	StandaloneVar    float64                `protobuf:"fixed64,3,opt,name=standalone_var,json=standaloneVar,proto3" json:"standalone_var,omitempty"`          // Parametric VaR held alone
+	VarContribution  float64                `protobuf:"fixed64,4,opt,name=var_contribution,json=varContribution,proto3" json:"var_contribution,omitempty"`    // Parametric; contributions sum to the book's VaR
+	CvarContribution float64                `protobuf:"fixed64,5,opt,name=cvar_contribution,json=cvarContribution,proto3" json:"cvar_contribution,omitempty"` // Simulated mean loss in the tail; sums to the book's CVaR
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PositionRisk) Reset() {
+	*x = PositionRisk{}
+	mi := &file_finance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionRisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionRisk) ProtoMessage() {}
+
+func (x *PositionRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionRisk.ProtoReflect.Descriptor instead.
+func (*PositionRisk) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PositionRisk) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PositionRisk) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PositionRisk) GetStandaloneVar() float64 {
+	if x != nil {
+		return x.StandaloneVar
+	}
+	return 0
+}
+
+func (x *PositionRisk) GetVarContribution() float64 {
+	if x != nil {
+		return x.VarContribution
+	}
+	return 0
+}
+
+func (x *PositionRisk) GetCvarContribution() float64 {
+	if x != nil {
+		return x.CvarContribution
+	}
+	return 0
+}
+
+// One simulated outcome, losses positive
+type RiskScenario struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rank           int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // 1 is the worst
+	Loss           float64                `protobuf:"fixed64,2,opt,name=loss,proto3" json:"loss,omitempty"`
+	PositionLosses []float64              `protobuf:"fixed64,3,rep,packed,name=position_losses,json=positionLosses,proto3" json:"position_losses,omitempty"` // In the order of the positions
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RiskScenario) Reset() {
+	*x = RiskScenario{}
+	mi := &file_finance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskScenario) ProtoMessage() {}
+
+func (x *RiskScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskScenario.ProtoReflect.Descriptor instead.
+func (*RiskScenario) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RiskScenario) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RiskScenario) GetLoss() float64 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *RiskScenario) GetPositionLosses() []float64 {
+	if x != nil {
+		return x.PositionLosses
+	}
+	return nil
+}
+
+type RiskReportUpdate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Stage           RiskReportStage        `protobuf:"varint,1,opt,name=stage,proto3,enum=qubit_engine.finance.RiskReportStage" json:"stage,omitempty"`
+	Progress        float64                `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"` // 0-1 over both stages
+	SimulationsDone int32                  `protobuf:"varint,3,opt,name=simulations_done,json=simulationsDone,proto3" json:"simulations_done,omitempty"`
+	Var             *VaRResult             `protobuf:"bytes,4,opt,name=var,proto3" json:"var,omitempty"`
+	// Once attributed
+	Positions      []*PositionRisk `protobuf:"bytes,5,rep,name=positions,proto3" json:"positions,omitempty"`
+	WorstScenarios []*RiskScenario `protobuf:"bytes,6,rep,name=worst_scenarios,json=worstScenarios,proto3" json:"worst_scenarios,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RiskReportUpdate) Reset() {
+	*x = RiskReportUpdate{}
+	mi := &file_finance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskReportUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskReportUpdate) ProtoMessage() {}
+
+func (x *RiskReportUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskReportUpdate.ProtoReflect.Descriptor instead.
+func (*RiskReportUpdate) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RiskReportUpdate) GetStage() RiskReportStage {
+	if x != nil {
+		return x.Stage
+	}
+	return RiskReportStage_STAGE_SIMULATING
+}
+
+func (x *RiskReportUpdate) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *RiskReportUpdate) GetSimulationsDone() int32 {
+	if x != nil {
+		return x.SimulationsDone
+	}
+	return 0
+}
+
+func (x *RiskReportUpdate) GetVar() *VaRResult {
+	if x != nil {
+		return x.Var
+	}
+	return nil
+}
+
+func (x *RiskReportUpdate) GetPositions() []*PositionRisk {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *RiskReportUpdate) GetWorstScenarios() []*RiskScenario {
+	if x != nil {
+		return x.WorstScenarios
+	}
+	return nil
+}
+
+// Defaults follow a one-factor Gaussian copula: an obligor defaults when
+// √ρ·M + √(1-ρ)·ε, M shared by all and ε its own, falls below the normal
+// quantile of its default probability
+type Obligor struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Exposure           float64                `protobuf:"fixed64,2,opt,name=exposure,proto3" json:"exposure,omitempty"`                                               // Exposure at default
+	DefaultProbability float64                `protobuf:"fixed64,3,opt,name=default_probability,json=defaultProbability,proto3" json:"default_probability,omitempty"` // Over the horizon, 0-1
+	LossGivenDefault   float64                `protobuf:"fixed64,4,opt,name=loss_given_default,json=lossGivenDefault,proto3" json:"loss_given_default,omitempty"`     // Share of the exposure lost, 0-1
+	AssetCorrelation   float64                `protobuf:"fixed64,5,opt,name=asset_correlation,json=assetCorrelation,proto3" json:"asset_correlation,omitempty"`       // ρ; 0 takes the portfolio's
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Obligor) Reset() {
+	*x = Obligor{}
+	mi := &file_finance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Obligor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Obligor) ProtoMessage() {}
+
+func (x *Obligor) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Obligor.ProtoReflect.Descriptor instead.
+func (*Obligor) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Obligor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Obligor) GetExposure() float64 {
+	if x != nil {
+		return x.Exposure
+	}
+	return 0
+}
+
+func (x *Obligor) GetDefaultProbability() float64 {
+	if x != nil {
+		return x.DefaultProbability
+	}
+	return 0
+}
+
+func (x *Obligor) GetLossGivenDefault() float64 {
+	if x != nil {
+		return x.LossGivenDefault
+	}
+	return 0
+}
+
+func (x *Obligor) GetAssetCorrelation() float64 {
+	if x != nil {
+		return x.AssetCorrelation
+	}
+	return 0
+}
+
+type CreditPortfolioRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Obligors         []*Obligor             `protobuf:"bytes,1,rep,name=obligors,proto3" json:"obligors,omitempty"`
+	AssetCorrelation float64                `protobuf:"fixed64,2,opt,name=asset_correlation,json=assetCorrelation,proto3" json:"asset_correlation,omitempty"` // ρ for obligors without their own
+	Confidence       float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`                                     // e.g., 0.999
+	Simulations      int32                  `protobuf:"varint,4,opt,name=simulations,proto3" json:"simulations,omitempty"`
+	HistogramBuckets int32                  `protobuf:"varint,5,opt,name=histogram_buckets,json=histogramBuckets,proto3" json:"histogram_buckets,omitempty"` // Default 20
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreditPortfolioRequest) Reset() {
+	*x = CreditPortfolioRequest{}
+	mi := &file_finance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditPortfolioRequest) ProtoMessage() {}
+
+func (x *CreditPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*CreditPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreditPortfolioRequest) GetObligors() []*Obligor {
+	if x != nil {
+		return x.Obligors
+	}
+	return nil
+}
+
+func (x *CreditPortfolioRequest) GetAssetCorrelation() float64 {
+	if x != nil {
+		return x.AssetCorrelation
+	}
+	return 0
+}
+
+func (x *CreditPortfolioRequest) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CreditPortfolioRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *CreditPortfolioRequest) GetHistogramBuckets() int32 {
+	if x != nil {
+		return x.HistogramBuckets
+	}
+	return 0
+}
+
+type LossBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lower         float64                `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper         float64                `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Probability   float64                `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LossBucket) Reset() {
+	*x = LossBucket{}
+	mi := &file_finance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LossBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LossBucket) ProtoMessage() {}
+
+func (x *LossBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LossBucket.ProtoReflect.Descriptor instead.
+func (*LossBucket) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LossBucket) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *LossBucket) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *LossBucket) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type ObligorRisk struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedLoss           float64                `protobuf:"fixed64,2,opt,name=expected_loss,json=expectedLoss,proto3" json:"expected_loss,omitempty"`                                 // exposure × PD × LGD
+	TailContribution       float64                `protobuf:"fixed64,3,opt,name=tail_contribution,json=tailContribution,proto3" json:"tail_contribution,omitempty"`                     // Mean loss at or beyond credit VaR; sums to expected_shortfall
+	VarContribution        float64                `protobuf:"fixed64,4,opt,name=var_contribution,json=varContribution,proto3" json:"var_contribution,omitempty"`                        // tail_contribution scaled to sum to credit_var
+	TailDefaultProbability float64                `protobuf:"fixed64,5,opt,name=tail_default_probability,json=tailDefaultProbability,proto3" json:"tail_default_probability,omitempty"` // Chance of default at or beyond credit VaR
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ObligorRisk) Reset() {
+	*x = ObligorRisk{}
+	mi := &file_finance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObligorRisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObligorRisk) ProtoMessage() {}
+
+func (x *ObligorRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObligorRisk.ProtoReflect.Descriptor instead.
+func (*ObligorRisk) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ObligorRisk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObligorRisk) GetExpectedLoss() float64 {
+	if x != nil {
+		return x.ExpectedLoss
+	}
+	return 0
+}
+
+func (x *ObligorRisk) GetTailContribution() float64 {
+	if x != nil {
+		return x.TailContribution
+	}
+	return 0
+}
+
+func (x *ObligorRisk) GetVarContribution() float64 {
+	if x != nil {
+		return x.VarContribution
+	}
+	return 0
+}
+
+func (x *ObligorRisk) GetTailDefaultProbability() float64 {
+	if x != nil {
+		return x.TailDefaultProbability
+	}
+	return 0
+}
+
+type CreditRiskResult struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ExpectedLoss          float64                `protobuf:"fixed64,1,opt,name=expected_loss,json=expectedLoss,proto3" json:"expected_loss,omitempty"` // Σ exposure × PD × LGD
+	SimulatedExpectedLoss float64                `protobuf:"fixed64,2,opt,name=simulated_expected_loss,json=simulatedExpectedLoss,proto3" json:"simulated_expected_loss,omitempty"`
+	LossStdDev            float64                `protobuf:"fixed64,3,opt,name=loss_std_dev,json=lossStdDev,proto3" json:"loss_std_dev,omitempty"`
+	CreditVar             float64                `protobuf:"fixed64,4,opt,name=credit_var,json=creditVar,proto3" json:"credit_var,omitempty"`                         // Loss quantile at the confidence
+	UnexpectedLoss        float64                `protobuf:"fixed64,5,opt,name=unexpected_loss,json=unexpectedLoss,proto3" json:"unexpected_loss,omitempty"`          // credit_var - expected_loss
+	ExpectedShortfall     float64                `protobuf:"fixed64,6,opt,name=expected_shortfall,json=expectedShortfall,proto3" json:"expected_shortfall,omitempty"` // Mean loss at or beyond credit_var
+	Confidence            float64                `protobuf:"fixed64,7,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	SimulationsUsed       int32                  `protobuf:"varint,8,opt,name=simulations_used,json=simulationsUsed,proto3" json:"simulations_used,omitempty"`
+	LossDistribution      []*LossBucket          `protobuf:"bytes,9,rep,name=loss_distribution,json=lossDistribution,proto3" json:"loss_distribution,omitempty"`
+	Obligors              []*ObligorRisk         `protobuf:"bytes,10,rep,name=obligors,proto3" json:"obligors,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreditRiskResult) Reset() {
+	*x = CreditRiskResult{}
+	mi := &file_finance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditRiskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditRiskResult) ProtoMessage() {}
+
+func (x *CreditRiskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditRiskResult.ProtoReflect.Descriptor instead.
+func (*CreditRiskResult) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreditRiskResult) GetExpectedLoss() float64 {
+	if x != nil {
+		return x.ExpectedLoss
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetSimulatedExpectedLoss() float64 {
+	if x != nil {
+		return x.SimulatedExpectedLoss
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetLossStdDev() float64 {
+	if x != nil {
+		return x.LossStdDev
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetCreditVar() float64 {
+	if x != nil {
+		return x.CreditVar
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetUnexpectedLoss() float64 {
+	if x != nil {
+		return x.UnexpectedLoss
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetExpectedShortfall() float64 {
+	if x != nil {
+		return x.ExpectedShortfall
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetSimulationsUsed() int32 {
+	if x != nil {
+		return x.SimulationsUsed
+	}
+	return 0
+}
+
+func (x *CreditRiskResult) GetLossDistribution() []*LossBucket {
+	if x != nil {
+		return x.LossDistribution
+	}
+	return nil
+}
+
+func (x *CreditRiskResult) GetObligors() []*ObligorRisk {
+	if x != nil {
+		return x.Obligors
+	}
+	return nil
+}
+
+// An instantaneous shock to market parameters
+type StressScenario struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EquityShock          float64                `protobuf:"fixed64,3,opt,name=equity_shock,json=equityShock,proto3" json:"equity_shock,omitempty"`                                                                              // Relative move in every equity, e.g., -0.30
+	EquityShocks         map[string]float64     `protobuf:"bytes,4,rep,name=equity_shocks,json=equityShocks,proto3" json:"equity_shocks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // By symbol, in place of equity_shock
+	RateShift            float64                `protobuf:"fixed64,5,opt,name=rate_shift,json=rateShift,proto3" json:"rate_shift,omitempty"`                                                                                    // Added to every rate, e.g., 0.02 for +200bp
+	VolatilityMultiplier float64                `protobuf:"fixed64,6,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3" json:"volatility_multiplier,omitempty"`                                                   // e.g., 2 doubles volatilities; unset leaves them
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StressScenario) Reset() {
+	*x = StressScenario{}
+	mi := &file_finance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StressScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressScenario) ProtoMessage() {}
+
+func (x *StressScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressScenario.ProtoReflect.Descriptor instead.
+func (*StressScenario) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StressScenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StressScenario) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StressScenario) GetEquityShock() float64 {
+	if x != nil {
+		return x.EquityShock
+	}
+	return 0
+}
+
+func (x *StressScenario) GetEquityShocks() map[string]float64 {
+	if x != nil {
+		return x.EquityShocks
+	}
+	return nil
+}
+
+func (x *StressScenario) GetRateShift() float64 {
+	if x != nil {
+		return x.RateShift
+	}
+	return 0
+}
+
+func (x *StressScenario) GetVolatilityMultiplier() float64 {
+	if x != nil {
+		return x.VolatilityMultiplier
+	}
+	return 0
+}
+
+// A bond or other rate-sensitive holding, revalued to second order in
+// its yield
+type RateHolding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Duration      float64                `protobuf:"fixed64,3,opt,name=duration,proto3" json:"duration,omitempty"` // Modified, in years
+	Convexity     float64                `protobuf:"fixed64,4,opt,name=convexity,proto3" json:"convexity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateHolding) Reset() {
+	*x = RateHolding{}
+	mi := &file_finance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateHolding) ProtoMessage() {}
+
+func (x *RateHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateHolding.ProtoReflect.Descriptor instead.
+func (*RateHolding) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RateHolding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RateHolding) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *RateHolding) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *RateHolding) GetConvexity() float64 {
+	if x != nil {
+		return x.Convexity
+	}
+	return 0
+}
+
+// Options priced by Black-Scholes; the option's simulation settings are
+// unused
+type OptionHolding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"` // The underlying, for equity shocks
+	Option        *OptionRequest         `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // Negative for written
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionHolding) Reset() {
+	*x = OptionHolding{}
+	mi := &file_finance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionHolding) ProtoMessage() {}
+
+func (x *OptionHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionHolding.ProtoReflect.Descriptor instead.
+func (*OptionHolding) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OptionHolding) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OptionHolding) GetOption() *OptionRequest {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+func (x *OptionHolding) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// With neither scenarios nor standard_scenarios, every built-in scenario
+// runs
+type ScenarioRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Positions         []*Position            `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"` // Equities; volatility and correlations are unused
+	Options           []*OptionHolding       `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	RateHoldings      []*RateHolding         `protobuf:"bytes,3,rep,name=rate_holdings,json=rateHoldings,proto3" json:"rate_holdings,omitempty"`
+	Scenarios         []*StressScenario      `protobuf:"bytes,4,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	StandardScenarios []string               `protobuf:"bytes,5,rep,name=standard_scenarios,json=standardScenarios,proto3" json:"standard_scenarios,omitempty"` // Built-in scenarios by name
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScenarioRequest) Reset() {
+	*x = ScenarioRequest{}
+	mi := &file_finance_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioRequest) ProtoMessage() {}
+
+func (x *ScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioRequest.ProtoReflect.Descriptor instead.
+func (*ScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ScenarioRequest) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetOptions() []*OptionHolding {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetRateHoldings() []*RateHolding {
+	if x != nil {
+		return x.RateHoldings
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetScenarios() []*StressScenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetStandardScenarios() []string {
+	if x != nil {
+		return x.StandardScenarios
+	}
+	return nil
+}
+
+type HoldingPnL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	ShockedValue  float64                `protobuf:"fixed64,3,opt,name=shocked_value,json=shockedValue,proto3" json:"shocked_value,omitempty"`
+	Pnl           float64                `protobuf:"fixed64,4,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldingPnL) Reset() {
+	*x = HoldingPnL{}
+	mi := &file_finance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldingPnL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldingPnL) ProtoMessage() {}
+
+func (x *HoldingPnL) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldingPnL.ProtoReflect.Descriptor instead.
+func (*HoldingPnL) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HoldingPnL) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HoldingPnL) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HoldingPnL) GetShockedValue() float64 {
+	if x != nil {
+		return x.ShockedValue
+	}
+	return 0
+}
+
+func (x *HoldingPnL) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+type ScenarioResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scenario      *StressScenario        `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Pnl           float64                `protobuf:"fixed64,2,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	RelativePnl   float64                `protobuf:"fixed64,3,opt,name=relative_pnl,json=relativePnl,proto3" json:"relative_pnl,omitempty"` // pnl over the portfolio's value, when positive
+	Holdings      []*HoldingPnL          `protobuf:"bytes,4,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioResult) Reset() {
+	*x = ScenarioResult{}
+	mi := &file_finance_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioResult) ProtoMessage() {}
+
+func (x *ScenarioResult) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioResult.ProtoReflect.Descriptor instead.
+func (*ScenarioResult) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ScenarioResult) GetScenario() *StressScenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *ScenarioResult) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+func (x *ScenarioResult) GetRelativePnl() float64 {
+	if x != nil {
+		return x.RelativePnl
+	}
+	return 0
+}
+
+func (x *ScenarioResult) GetHoldings() []*HoldingPnL {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type ScenarioReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioValue float64                `protobuf:"fixed64,1,opt,name=portfolio_value,json=portfolioValue,proto3" json:"portfolio_value,omitempty"`
+	Scenarios      []*ScenarioResult      `protobuf:"bytes,2,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	// Every scenario asked for at once: equity moves compounded, rate
+	// shifts added and volatility multipliers multiplied. Unset when the
+	// whole built-in library runs by default.
+	Combined      *ScenarioResult `protobuf:"bytes,3,opt,name=combined,proto3" json:"combined,omitempty"`
+	WorstScenario string          `protobuf:"bytes,4,opt,name=worst_scenario,json=worstScenario,proto3" json:"worst_scenario,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioReport) Reset() {
+	*x = ScenarioReport{}
+	mi := &file_finance_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioReport) ProtoMessage() {}
+
+func (x *ScenarioReport) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioReport.ProtoReflect.Descriptor instead.
+func (*ScenarioReport) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScenarioReport) GetPortfolioValue() float64 {
+	if x != nil {
+		return x.PortfolioValue
+	}
+	return 0
+}
+
+func (x *ScenarioReport) GetScenarios() []*ScenarioResult {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+func (x *ScenarioReport) GetCombined() *ScenarioResult {
+	if x != nil {
+		return x.Combined
+	}
+	return nil
+}
+
+func (x *ScenarioReport) GetWorstScenario() string {
+	if x != nil {
+		return x.WorstScenario
+	}
+	return ""
+}
+
+type SimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitialPrice  float64                `protobuf:"fixed64,1,opt,name=initial_price,json=initialPrice,proto3" json:"initial_price,omitempty"`
+	Drift         float64                `protobuf:"fixed64,2,opt,name=drift,proto3" json:"drift,omitempty"`           // Annual drift (mu)
+	Volatility    float64                `protobuf:"fixed64,3,opt,name=volatility,proto3" json:"volatility,omitempty"` // Annual volatility (sigma)
+	Days          int32                  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	Paths         int32                  `protobuf:"varint,5,opt,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationRequest) Reset() {
+	*x = SimulationRequest{}
+	mi := &file_finance_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationRequest) ProtoMessage() {}
+
+func (x *SimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationRequest.ProtoReflect.Descriptor instead.
+func (*SimulationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SimulationRequest) GetInitialPrice() float64 {
+	if x != nil {
+		return x.InitialPrice
+	}
+	return 0
+}
+
+func (x *SimulationRequest) GetDrift() float64 {
+	if x != nil {
+		return x.Drift
+	}
+	return 0
+}
+
+func (x *SimulationRequest) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *SimulationRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SimulationRequest) GetPaths() int32 {
+	if x != nil {
+		return x.Paths
+	}
+	return 0
+}
+
+type PricePath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PathId        int32                  `protobuf:"varint,1,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Prices        []float64              `protobuf:"fixed64,2,rep,packed,name=prices,proto3" json:"prices,omitempty"` // Daily prices
+	FinalPrice    float64                `protobuf:"fixed64,3,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
+	MaxPrice      float64                `protobuf:"fixed64,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	MinPrice      float64                `protobuf:"fixed64,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricePath) Reset() {
+	*x = PricePath{}
+	mi := &file_finance_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricePath) ProtoMessage() {}
+
+func (x *PricePath) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricePath.ProtoReflect.Descriptor instead.
+func (*PricePath) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PricePath) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *PricePath) GetPrices() []float64 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *PricePath) GetFinalPrice() float64 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *PricePath) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *PricePath) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+var File_finance_proto protoreflect.FileDescriptor
+
+const file_finance_proto_rawDesc = "" +
+	"\n" +
+	"\rfinance.proto\x12\x14qubit_engine.finance\"\xdd\x03\n" +
+	"\rOptionRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .qubit_engine.finance.OptionTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"spot_price\x18\x02 \x01(\x01R\tspotPrice\x12!\n" +
+	"\fstrike_price\x18\x03 \x01(\x01R\vstrikePrice\x12$\n" +
+	"\x0erisk_free_rate\x18\x04 \x01(\x01R\friskFreeRate\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x05 \x01(\x01R\n" +
+	"volatility\x12$\n" +
+	"\x0etime_to_expiry\x18\x06 \x01(\x01R\ftimeToExpiry\x12%\n" +
+	"\x0edividend_yield\x18\a \x01(\x01R\rdividendYield\x12'\n" +
+	"\x0fnum_simulations\x18\b \x01(\x05R\x0enumSimulations\x12V\n" +
+	"\x12variance_reduction\x18\t \x01(\v2'.qubit_engine.finance.VarianceReductionR\x11varianceReduction\x12@\n" +
+	"\bsampling\x18\n" +
+	" \x01(\x0e2$.qubit_engine.finance.SamplingMethodR\bsampling\"\x8d\x01\n" +
+	"\x11VarianceReduction\x12\x1e\n" +
+	"\n" +
+	"antithetic\x18\x01 \x01(\bR\n" +
+	"antithetic\x12'\n" +
+	"\x0fcontrol_variate\x18\x02 \x01(\bR\x0econtrolVariate\x12/\n" +
+	"\x13importance_sampling\x18\x03 \x01(\bR\x12importanceSampling\"w\n" +
+	"\x15AmericanOptionRequest\x127\n" +
+	"\x04base\x18\x01 \x01(\v2#.qubit_engine.finance.OptionRequestR\x04base\x12%\n" +
+	"\x0eexercise_dates\x18\x02 \x01(\x05R\rexerciseDates\"\xab\x02\n" +
+	"\x11PathOptionRequest\x127\n" +
+	"\x04base\x18\x01 \x01(\v2#.qubit_engine.finance.OptionRequestR\x04base\x125\n" +
+	"\x05style\x18\x02 \x01(\x0e2\x1f.qubit_engine.finance.PathStyleR\x05style\x12\x1d\n" +
+	"\n" +
+	"time_steps\x18\x03 \x01(\x05R\ttimeSteps\x12\x18\n" +
+	"\abarrier\x18\x04 \x01(\x01R\abarrier\x12D\n" +
+	"\fbarrier_type\x18\x05 \x01(\x0e2!.qubit_engine.finance.BarrierTypeR\vbarrierType\x12'\n" +
+	"\x0ffloating_strike\x18\x06 \x01(\bR\x0efloatingStrike\"\xf0\x02\n" +
+	"\vOptionPrice\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x01R\x05delta\x12\x14\n" +
+	"\x05gamma\x18\x03 \x01(\x01R\x05gamma\x12\x14\n" +
+	"\x05theta\x18\x04 \x01(\x01R\x05theta\x12\x12\n" +
+	"\x04vega\x18\x05 \x01(\x01R\x04vega\x12\x10\n" +
+	"\x03rho\x18\x06 \x01(\x01R\x03rho\x12#\n" +
+	"\rblack_scholes\x18\a \x01(\x01R\fblackScholes\x12\x1f\n" +
+	"\vmonte_carlo\x18\b \x01(\x01R\n" +
+	"monteCarlo\x12\x1b\n" +
+	"\tstd_error\x18\t \x01(\x01R\bstdError\x12)\n" +
+	"\x10simulations_used\x18\n" +
+	" \x01(\x05R\x0fsimulationsUsed\x12&\n" +
+	"\x0fplain_std_error\x18\v \x01(\x01R\rplainStdError\x12-\n" +
+	"\x12variance_reduction\x18\f \x01(\x01R\x11varianceReduction\"\xc7\x01\n" +
+	"\vBasketAsset\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1d\n" +
+	"\n" +
+	"spot_price\x18\x02 \x01(\x01R\tspotPrice\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x04 \x01(\x01R\n" +
+	"volatility\x12%\n" +
+	"\x0edividend_yield\x18\x05 \x01(\x01R\rdividendYield\x12\"\n" +
+	"\fcorrelations\x18\x06 \x03(\x01R\fcorrelations\"\xe0\x02\n" +
+	"\x13BasketOptionRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .qubit_engine.finance.OptionTypeR\x04type\x129\n" +
+	"\x06assets\x18\x02 \x03(\v2!.qubit_engine.finance.BasketAssetR\x06assets\x12!\n" +
+	"\fstrike_price\x18\x03 \x01(\x01R\vstrikePrice\x12$\n" +
+	"\x0erisk_free_rate\x18\x04 \x01(\x01R\friskFreeRate\x12$\n" +
+	"\x0etime_to_expiry\x18\x05 \x01(\x01R\ftimeToExpiry\x12'\n" +
+	"\x0fnum_simulations\x18\x06 \x01(\x05R\x0enumSimulations\x12@\n" +
+	"\bsampling\x18\a \x01(\x0e2$.qubit_engine.finance.SamplingMethodR\bsampling\"r\n" +
+	"\rGreekEstimate\x12\x1f\n" +
+	"\vmonte_carlo\x18\x01 \x01(\x01R\n" +
+	"monteCarlo\x12\x1b\n" +
+	"\tstd_error\x18\x02 \x01(\x01R\bstdError\x12#\n" +
+	"\rblack_scholes\x18\x03 \x01(\x01R\fblackScholes\"\xea\x02\n" +
+	"\x06Greeks\x129\n" +
+	"\x05delta\x18\x01 \x01(\v2#.qubit_engine.finance.GreekEstimateR\x05delta\x129\n" +
+	"\x05gamma\x18\x02 \x01(\v2#.qubit_engine.finance.GreekEstimateR\x05gamma\x127\n" +
+	"\x04vega\x18\x03 \x01(\v2#.qubit_engine.finance.GreekEstimateR\x04vega\x129\n" +
+	"\x05theta\x18\x04 \x01(\v2#.qubit_engine.finance.GreekEstimateR\x05theta\x125\n" +
+	"\x03rho\x18\x05 \x01(\v2#.qubit_engine.finance.GreekEstimateR\x03rho\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12)\n" +
+	"\x10simulations_used\x18\a \x01(\x05R\x0fsimulationsUsed\"\x8c\x01\n" +
+	"\x05Asset\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12'\n" +
+	"\x0fexpected_return\x18\x02 \x01(\x01R\x0eexpectedReturn\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x03 \x01(\x01R\n" +
+	"volatility\x12\"\n" +
+	"\fcorrelations\x18\x04 \x03(\x01R\fcorrelations\"\xd3\x02\n" +
+	"\x10PortfolioRequest\x123\n" +
+	"\x06assets\x18\x01 \x03(\v2\x1b.qubit_engine.finance.AssetR\x06assets\x12#\n" +
+	"\rtarget_return\x18\x02 \x01(\x01R\ftargetReturn\x12%\n" +
+	"\x0erisk_tolerance\x18\x03 \x01(\x01R\rriskTolerance\x12\x1d\n" +
+	"\n" +
+	"min_weight\x18\x04 \x01(\x01R\tminWeight\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x05 \x01(\x01R\tmaxWeight\x12 \n" +
+	"\vcardinality\x18\x06 \x01(\x05R\vcardinality\x12=\n" +
+	"\x06solver\x18\a \x01(\x0e2%.qubit_engine.finance.PortfolioSolverR\x06solver\x12\x1f\n" +
+	"\vqaoa_layers\x18\b \x01(\x05R\n" +
+	"qaoaLayers\"A\n" +
+	"\x0fAssetAllocation\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\"\xc9\x03\n" +
+	"\x10OptimalPortfolio\x12G\n" +
+	"\vallocations\x18\x01 \x03(\v2%.qubit_engine.finance.AssetAllocationR\vallocations\x12'\n" +
+	"\x0fexpected_return\x18\x02 \x01(\x01R\x0eexpectedReturn\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x03 \x01(\x01R\n" +
+	"volatility\x12!\n" +
+	"\fsharpe_ratio\x18\x04 \x01(\x01R\vsharpeRatio\x12\x15\n" +
+	"\x06var_95\x18\x05 \x01(\x01R\x05var95\x12\x1a\n" +
+	"\bselected\x18\x06 \x03(\tR\bselected\x12F\n" +
+	"\vsolver_used\x18\a \x01(\x0e2%.qubit_engine.finance.PortfolioSolverR\n" +
+	"solverUsed\x12\x1f\n" +
+	"\vqubo_energy\x18\b \x01(\x01R\n" +
+	"quboEnergy\x123\n" +
+	"\x15selection_probability\x18\t \x01(\x01R\x14selectionProbability\x12/\n" +
+	"\x13circuit_evaluations\x18\n" +
+	" \x01(\x05R\x12circuitEvaluations\"|\n" +
+	"\bPosition\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x03 \x01(\x01R\n" +
+	"volatility\x12\"\n" +
+	"\fcorrelations\x18\x04 \x03(\x01R\fcorrelations\"\xbe\x02\n" +
+	"\n" +
+	"VaRRequest\x12'\n" +
+	"\x0fportfolio_value\x18\x01 \x01(\x01R\x0eportfolioValue\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x02 \x01(\x01R\n" +
+	"volatility\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\x12%\n" +
+	"\x0eholding_period\x18\x04 \x01(\x05R\rholdingPeriod\x12 \n" +
+	"\vsimulations\x18\x05 \x01(\x05R\vsimulations\x12<\n" +
+	"\tpositions\x18\x06 \x03(\v2\x1e.qubit_engine.finance.PositionR\tpositions\x12@\n" +
+	"\bsampling\x18\a \x01(\x0e2$.qubit_engine.finance.SamplingMethodR\bsampling\"\xf9\x02\n" +
+	"\tVaRResult\x12%\n" +
+	"\x0evar_parametric\x18\x01 \x01(\x01R\rvarParametric\x12%\n" +
+	"\x0evar_historical\x18\x02 \x01(\x01R\rvarHistorical\x12\x12\n" +
+	"\x04cvar\x18\x03 \x01(\x01R\x04cvar\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\x12\x1b\n" +
+	"\tvar_lower\x18\x05 \x01(\x01R\bvarLower\x12\x1b\n" +
+	"\tvar_upper\x18\x06 \x01(\x01R\bvarUpper\x12\x1d\n" +
+	"\n" +
+	"cvar_lower\x18\a \x01(\x01R\tcvarLower\x12\x1d\n" +
+	"\n" +
+	"cvar_upper\x18\b \x01(\x01R\tcvarUpper\x12)\n" +
+	"\x10simulations_used\x18\t \x01(\x05R\x0fsimulationsUsed\x12'\n" +
+	"\x0fportfolio_value\x18\n" +
+	" \x01(\x01R\x0eportfolioValue\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\v \x01(\x01R\n" +
+	"volatility\"\xe3\x01\n" +
+	"\x11RiskReportRequest\x12<\n" +
+	"\tpositions\x18\x01 \x03(\v2\x1e.qubit_engine.finance.PositionR\tpositions\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\x12%\n" +
+	"\x0eholding_period\x18\x03 \x01(\x05R\rholdingPeriod\x12 \n" +
+	"\vsimulations\x18\x04 \x01(\x05R\vsimulations\x12'\n" +
+	"\x0fworst_scenarios\x18\x05 \x01(\x05R\x0eworstScenarios\"\xbb\x01\n" +
+	"\fPositionRisk\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12%\n" +
+	"\x0estandalone_var\x18\x03 \x01(\x01R\rstandaloneVar\x12)\n" +
+	"\x10var_contribution\x18\x04 \x01(\x01R\x0fvarContribution\x12+\n" +
+	"\x11cvar_contribution\x18\x05 \x01(\x01R\x10cvarContribution\"_\n" +
+	"\fRiskScenario\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x12\n" +
+	"\x04loss\x18\x02 \x01(\x01R\x04loss\x12'\n" +
+	"\x0fposition_losses\x18\x03 \x03(\x01R\x0epositionLosses\"\xd8\x02\n" +
+	"\x10RiskReportUpdate\x12;\n" +
+	"\x05stage\x18\x01 \x01(\x0e2%.qubit_engine.finance.RiskReportStageR\x05stage\x12\x1a\n" +
+	"\bprogress\x18\x02 \x01(\x01R\bprogress\x12)\n" +
+	"\x10simulations_done\x18\x03 \x01(\x05R\x0fsimulationsDone\x121\n" +
+	"\x03var\x18\x04 \x01(\v2\x1f.qubit_engine.finance.VaRResultR\x03var\x12@\n" +
+	"\tpositions\x18\x05 \x03(\v2\".qubit_engine.finance.PositionRiskR\tpositions\x12K\n" +
+	"\x0fworst_scenarios\x18\x06 \x03(\v2\".qubit_engine.finance.RiskScenarioR\x0eworstScenarios\"\xc5\x01\n" +
+	"\aObligor\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bexposure\x18\x02 \x01(\x01R\bexposure\x12/\n" +
+	"\x13default_probability\x18\x03 \x01(\x01R\x12defaultProbability\x12,\n" +
+	"\x12loss_given_default\x18\x04 \x01(\x01R\x10lossGivenDefault\x12+\n" +
+	"\x11asset_correlation\x18\x05 \x01(\x01R\x10assetCorrelation\"\xef\x01\n" +
+	"\x16CreditPortfolioRequest\x129\n" +
+	"\bobligors\x18\x01 \x03(\v2\x1d.qubit_engine.finance.ObligorR\bobligors\x12+\n" +
+	"\x11asset_correlation\x18\x02 \x01(\x01R\x10assetCorrelation\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\x12 \n" +
+	"\vsimulations\x18\x04 \x01(\x05R\vsimulations\x12+\n" +
+	"\x11histogram_buckets\x18\x05 \x01(\x05R\x10histogramBuckets\"Z\n" +
+	"\n" +
+	"LossBucket\x12\x14\n" +
+	"\x05lower\x18\x01 \x01(\x01R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x02 \x01(\x01R\x05upper\x12 \n" +
+	"\vprobability\x18\x03 \x01(\x01R\vprobability\"\xd8\x01\n" +
+	"\vObligorRisk\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rexpected_loss\x18\x02 \x01(\x01R\fexpectedLoss\x12+\n" +
+	"\x11tail_contribution\x18\x03 \x01(\x01R\x10tailContribution\x12)\n" +
+	"\x10var_contribution\x18\x04 \x01(\x01R\x0fvarContribution\x128\n" +
+	"\x18tail_default_probability\x18\x05 \x01(\x01R\x16tailDefaultProbability\"\xe1\x03\n" +
+	"\x10CreditRiskResult\x12#\n" +
+	"\rexpected_loss\x18\x01 \x01(\x01R\fexpectedLoss\x126\n" +
+	"\x17simulated_expected_loss\x18\x02 \x01(\x01R\x15simulatedExpectedLoss\x12 \n" +
+	"\floss_std_dev\x18\x03 \x01(\x01R\n" +
+	"lossStdDev\x12\x1d\n" +
+	"\n" +
+	"credit_var\x18\x04 \x01(\x01R\tcreditVar\x12'\n" +
+	"\x0funexpected_loss\x18\x05 \x01(\x01R\x0eunexpectedLoss\x12-\n" +
+	"\x12expected_shortfall\x18\x06 \x01(\x01R\x11expectedShortfall\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\a \x01(\x01R\n" +
+	"confidence\x12)\n" +
+	"\x10simulations_used\x18\b \x01(\x05R\x0fsimulationsUsed\x12M\n" +
+	"\x11loss_distribution\x18\t \x03(\v2 .qubit_engine.finance.LossBucketR\x10lossDistribution\x12=\n" +
+	"\bobligors\x18\n" +
+	" \x03(\v2!.qubit_engine.finance.ObligorRiskR\bobligors\"\xdb\x02\n" +
+	"\x0eStressScenario\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
+	"\fequity_shock\x18\x03 \x01(\x01R\vequityShock\x12[\n" +
+	"\requity_shocks\x18\x04 \x03(\v26.qubit_engine.finance.StressScenario.EquityShocksEntryR\fequityShocks\x12\x1d\n" +
+	"\n" +
+	"rate_shift\x18\x05 \x01(\x01R\trateShift\x123\n" +
+	"\x15volatility_multiplier\x18\x06 \x01(\x01R\x14volatilityMultiplier\x1a?\n" +
+	"\x11EquityShocksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"q\n" +
+	"\vRateHolding\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x01R\bduration\x12\x1c\n" +
+	"\tconvexity\x18\x04 \x01(\x01R\tconvexity\"\x80\x01\n" +
+	"\rOptionHolding\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12;\n" +
+	"\x06option\x18\x02 \x01(\v2#.qubit_engine.finance.OptionRequestR\x06option\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\"\xc9\x02\n" +
+	"\x0fScenarioRequest\x12<\n" +
+	"\tpositions\x18\x01 \x03(\v2\x1e.qubit_engine.finance.PositionR\tpositions\x12=\n" +
+	"\aoptions\x18\x02 \x03(\v2#.qubit_engine.finance.OptionHoldingR\aoptions\x12F\n" +
+	"\rrate_holdings\x18\x03 \x03(\v2!.qubit_engine.finance.RateHoldingR\frateHoldings\x12B\n" +
+	"\tscenarios\x18\x04 \x03(\v2$.qubit_engine.finance.StressScenarioR\tscenarios\x12-\n" +
+	"\x12standard_scenarios\x18\x05 \x03(\tR\x11standardScenarios\"m\n" +
+	"\n" +
+	"HoldingPnL\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12#\n" +
+	"\rshocked_value\x18\x03 \x01(\x01R\fshockedValue\x12\x10\n" +
+	"\x03pnl\x18\x04 \x01(\x01R\x03pnl\"\xc5\x01\n" +
+	"\x0eScenarioResult\x12@\n" +
+	"\bscenario\x18\x01 \x01(\v2$.qubit_engine.finance.StressScenarioR\bscenario\x12\x10\n" +
+	"\x03pnl\x18\x02 \x01(\x01R\x03pnl\x12!\n" +
+	"\frelative_pnl\x18\x03 \x01(\x01R\vrelativePnl\x12<\n" +
+	"\bholdings\x18\x04 \x03(\v2 .qubit_engine.finance.HoldingPnLR\bholdings\"\xe6\x01\n" +
+	"\x0eScenarioReport\x12'\n" +
+	"\x0fportfolio_value\x18\x01 \x01(\x01R\x0eportfolioValue\x12B\n" +
+	"\tscenarios\x18\x02 \x03(\v2$.qubit_engine.finance.ScenarioResultR\tscenarios\x12@\n" +
+	"\bcombined\x18\x03 \x01(\v2$.qubit_engine.finance.ScenarioResultR\bcombined\x12%\n" +
+	"\x0eworst_scenario\x18\x04 \x01(\tR\rworstScenario\"\x98\x01\n" +
+	"\x11SimulationRequest\x12#\n" +
+	"\rinitial_price\x18\x01 \x01(\x01R\finitialPrice\x12\x14\n" +
+	"\x05drift\x18\x02 \x01(\x01R\x05drift\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x03 \x01(\x01R\n" +
+	"volatility\x12\x12\n" +
+	"\x04days\x18\x04 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05paths\x18\x05 \x01(\x05R\x05paths\"\x97\x01\n" +
+	"\tPricePath\x12\x17\n" +
+	"\apath_id\x18\x01 \x01(\x05R\x06pathId\x12\x16\n" +
+	"\x06prices\x18\x02 \x03(\x01R\x06prices\x12\x1f\n" +
+	"\vfinal_price\x18\x03 \x01(\x01R\n" +
+	"finalPrice\x12\x1b\n" +
+	"\tmax_price\x18\x04 \x01(\x01R\bmaxPrice\x12\x1b\n" +
+	"\tmin_price\x18\x05 \x01(\x01R\bminPrice*-\n" +
+	"\n" +
+	"OptionType\x12\x0f\n" +
+	"\vOPTION_CALL\x10\x00\x12\x0e\n" +
+	"\n" +
+	"OPTION_PUT\x10\x01*@\n" +
+	"\x0eSamplingMethod\x12\x1a\n" +
+	"\x16SAMPLING_PSEUDO_RANDOM\x10\x00\x12\x12\n" +
+	"\x0eSAMPLING_SOBOL\x10\x01*@\n" +
+	"\tPathStyle\x12\x0e\n" +
+	"\n" +
+	"PATH_ASIAN\x10\x00\x12\x10\n" +
+	"\fPATH_BARRIER\x10\x01\x12\x11\n" +
+	"\rPATH_LOOKBACK\x10\x02*o\n" +
+	"\vBarrierType\x12\x16\n" +
+	"\x12BARRIER_UP_AND_OUT\x10\x00\x12\x15\n" +
+	"\x11BARRIER_UP_AND_IN\x10\x01\x12\x18\n" +
+	"\x14BARRIER_DOWN_AND_OUT\x10\x02\x12\x17\n" +
+	"\x13BARRIER_DOWN_AND_IN\x10\x03*I\n" +
+	"\x0fPortfolioSolver\x12\x0f\n" +
+	"\vSOLVER_AUTO\x10\x00\x12\x0f\n" +
+	"\vSOLVER_QAOA\x10\x01\x12\x14\n" +
+	"\x10SOLVER_ANNEALING\x10\x02*N\n" +
+	"\x0fRiskReportStage\x12\x14\n" +
+	"\x10STAGE_SIMULATING\x10\x00\x12\x15\n" +
+	"\x11STAGE_ATTRIBUTING\x10\x01\x12\x0e\n" +
+	"\n" +
+	"STAGE_DONE\x10\x022\xbc\b\n" +
+	"\x0eQuantumFinance\x12]\n" +
+	"\x13PriceEuropeanOption\x12#.qubit_engine.finance.OptionRequest\x1a!.qubit_engine.finance.OptionPrice\x12e\n" +
+	"\x13PriceAmericanOption\x12+.qubit_engine.finance.AmericanOptionRequest\x1a!.qubit_engine.finance.OptionPrice\x12]\n" +
+	"\x0fPricePathOption\x12'.qubit_engine.finance.PathOptionRequest\x1a!.qubit_engine.finance.OptionPrice\x12T\n" +
+	"\x0fCalculateGreeks\x12#.qubit_engine.finance.OptionRequest\x1a\x1c.qubit_engine.finance.Greeks\x12a\n" +
+	"\x11PriceBasketOption\x12).qubit_engine.finance.BasketOptionRequest\x1a!.qubit_engine.finance.OptionPrice\x12c\n" +
+	"\x11OptimizePortfolio\x12&.qubit_engine.finance.PortfolioRequest\x1a&.qubit_engine.finance.OptimalPortfolio\x12Q\n" +
+	"\fCalculateVaR\x12 .qubit_engine.finance.VaRRequest\x1a\x1f.qubit_engine.finance.VaRResult\x12`\n" +
+	"\x12SimulatePricePaths\x12'.qubit_engine.finance.SimulationRequest\x1a\x1f.qubit_engine.finance.PricePath0\x01\x12g\n" +
+	"\x12GenerateRiskReport\x12'.qubit_engine.finance.RiskReportRequest\x1a&.qubit_engine.finance.RiskReportUpdate0\x01\x12l\n" +
+	"\x14SimulateCreditLosses\x12,.qubit_engine.finance.CreditPortfolioRequest\x1a&.qubit_engine.finance.CreditRiskResult\x12[\n" +
+	"\fRunScenarios\x12%.qubit_engine.finance.ScenarioRequest\x1a$.qubit_engine.finance.ScenarioReportB:Z8github.com/perclft/QubitEngine/modules/finance/generatedb\x06proto3"
+
+var (
+	file_finance_proto_rawDescOnce sync.Once
+	file_finance_proto_rawDescData []byte
+)
+
+func file_finance_proto_rawDescGZIP() []byte {
+	file_finance_proto_rawDescOnce.Do(func() {
+		file_finance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)))
+	})
+	return file_finance_proto_rawDescData
+}
+
+var file_finance_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_finance_proto_goTypes = []any{
+	(OptionType)(0),                // 0: qubit_engine.finance.OptionType
+	(SamplingMethod)(0),            // 1: qubit_engine.finance.SamplingMethod
+	(PathStyle)(0),                 // 2: qubit_engine.finance.PathStyle
+	(BarrierType)(0),               // 3: qubit_engine.finance.BarrierType
+	(PortfolioSolver)(0),           // 4: qubit_engine.finance.PortfolioSolver
+	(RiskReportStage)(0),           // 5: qubit_engine.finance.RiskReportStage
+	(*OptionRequest)(nil),          // 6: qubit_engine.finance.OptionRequest
+	(*VarianceReduction)(nil),      // 7: qubit_engine.finance.VarianceReduction
+	(*AmericanOptionRequest)(nil),  // 8: qubit_engine.finance.AmericanOptionRequest
+	(*PathOptionRequest)(nil),      // 9: qubit_engine.finance.PathOptionRequest
+	(*OptionPrice)(nil),            // 10: qubit_engine.finance.OptionPrice
+	(*BasketAsset)(nil),            // 11: qubit_engine.finance.BasketAsset
+	(*BasketOptionRequest)(nil),    // 12: qubit_engine.finance.BasketOptionRequest
+	(*GreekEstimate)(nil),          // 13: qubit_engine.finance.GreekEstimate
+	(*Greeks)(nil),                 // 14: qubit_engine.finance.Greeks
+	(*Asset)(nil),                  // 15: qubit_engine.finance.Asset
+	(*PortfolioRequest)(nil),       // 16: qubit_engine.finance.PortfolioRequest
+	(*AssetAllocation)(nil),        // 17: qubit_engine.finance.AssetAllocation
+	(*OptimalPortfolio)(nil),       // 18: qubit_engine.finance.OptimalPortfolio
+	(*Position)(nil),               // 19: qubit_engine.finance.Position
+	(*VaRRequest)(nil),             // 20: qubit_engine.finance.VaRRequest
+	(*VaRResult)(nil),              // 21: qubit_engine.finance.VaRResult
+	(*RiskReportRequest)(nil),      // 22: qubit_engine.finance.RiskReportRequest
+	(*PositionRisk)(nil),           // 23: qubit_engine.finance.PositionRisk
+	(*RiskScenario)(nil),           // 24: qubit_engine.finance.RiskScenario
+	(*RiskReportUpdate)(nil),       // 25: qubit_engine.finance.RiskReportUpdate
+	(*Obligor)(nil),                // 26: qubit_engine.finance.Obligor
+	(*CreditPortfolioRequest)(nil), // 27: qubit_engine.finance.CreditPortfolioRequest
+	(*LossBucket)(nil),             // 28: qubit_engine.finance.LossBucket
+	(*ObligorRisk)(nil),            // 29: qubit_engine.finance.ObligorRisk
+	(*CreditRiskResult)(nil),       // 30: qubit_engine.finance.CreditRiskResult
+	(*StressScenario)(nil),         // 31: qubit_engine.finance.StressScenario
+	(*RateHolding)(nil),            // 32: qubit_engine.finance.RateHolding
+	(*OptionHolding)(nil),          // 33: qubit_engine.finance.OptionHolding
+	(*ScenarioRequest)(nil),        // 34: qubit_engine.finance.ScenarioRequest
+	(*HoldingPnL)(nil),             // 35: qubit_engine.finance.HoldingPnL
+	(*ScenarioResult)(nil),         // 36: qubit_engine.finance.ScenarioResult
+	(*ScenarioReport)(nil),         // 37: qubit_engine.finance.ScenarioReport
+	(*SimulationRequest)(nil),      // 38: qubit_engine.finance.SimulationRequest
+	(*PricePath)(nil),              // 39: qubit_engine.finance.PricePath
+	nil,                            // 40: qubit_engine.finance.StressScenario.EquityShocksEntry
+}
+var file_finance_proto_depIdxs = []int32{
+	0,  // 0: qubit_engine.finance.OptionRequest.type:type_name -> qubit_engine.finance.OptionType
+	7,  // 1: qubit_engine.finance.OptionRequest.variance_reduction:type_name -> qubit_engine.finance.VarianceReduction
+	1,  // 2: qubit_engine.finance.OptionRequest.sampling:type_name -> qubit_engine.finance.SamplingMethod
+	6,  // 3: qubit_engine.finance.AmericanOptionRequest.base:type_name -> qubit_engine.finance.OptionRequest
+	6,  // 4: qubit_engine.finance.PathOptionRequest.base:type_name -> qubit_engine.finance.OptionRequest
+	2,  // 5: qubit_engine.finance.PathOptionRequest.style:type_name -> qubit_engine.finance.PathStyle
+	3,  // 6: qubit_engine.finance.PathOptionRequest.barrier_type:type_name -> qubit_engine.finance.BarrierType
+	0,  // 7: qubit_engine.finance.BasketOptionRequest.type:type_name -> qubit_engine.finance.OptionType
+	11, // 8: qubit_engine.finance.BasketOptionRequest.assets:type_name -> qubit_engine.finance.BasketAsset
+	1,  // 9: qubit_engine.finance.BasketOptionRequest.sampling:type_name -> qubit_engine.finance.SamplingMethod
+	13, // 10: qubit_engine.finance.Greeks.delta:type_name -> qubit_engine.finance.GreekEstimate
+	13, // 11: qubit_engine.finance.Greeks.gamma:type_name -> qubit_engine.finance.GreekEstimate
+	13, // 12: qubit_engine.finance.Greeks.vega:type_name -> qubit_engine.finance.GreekEstimate
+	13, // 13: qubit_engine.finance.Greeks.theta:type_name -> qubit_engine.finance.GreekEstimate
+	13, // 14: qubit_engine.finance.Greeks.rho:type_name -> qubit_engine.finance.GreekEstimate
+	15, // 15: qubit_engine.finance.PortfolioRequest.assets:type_name -> qubit_engine.finance.Asset
+	4,  // 16: qubit_engine.finance.PortfolioRequest.solver:type_name -> qubit_engine.finance.PortfolioSolver
+	17, // 17: qubit_engine.finance.OptimalPortfolio.allocations:type_name -> qubit_engine.finance.AssetAllocation
+	4,  // 18: qubit_engine.finance.OptimalPortfolio.solver_used:type_name -> qubit_engine.finance.PortfolioSolver
+	19, // 19: qubit_engine.finance.VaRRequest.positions:type_name -> qubit_engine.finance.Position
+	1,  // 20: qubit_engine.finance.VaRRequest.sampling:type_name -> qubit_engine.finance.SamplingMethod
+	19, // 21: qubit_engine.finance.RiskReportRequest.positions:type_name -> qubit_engine.finance.Position
+	5,  // 22: qubit_engine.finance.RiskReportUpdate.stage:type_name -> qubit_engine.finance.RiskReportStage
+	21, // 23: qubit_engine.finance.RiskReportUpdate.var:type_name -> qubit_engine.finance.VaRResult
+	23, // 24: qubit_engine.finance.RiskReportUpdate.positions:type_name -> qubit_engine.finance.PositionRisk
+	24, // 25: qubit_engine.finance.RiskReportUpdate.worst_scenarios:type_name -> qubit_engine.finance.RiskScenario
+	26, // 26: qubit_engine.finance.CreditPortfolioRequest.obligors:type_name -> qubit_engine.finance.Obligor
+	28, // 27: qubit_engine.finance.CreditRiskResult.loss_distribution:type_name -> qubit_engine.finance.LossBucket
+	29, // 28: qubit_engine.finance.CreditRiskResult.obligors:type_name -> qubit_engine.finance.ObligorRisk
+	40, // 29: qubit_engine.finance.StressScenario.equity_shocks:type_name -> qubit_engine.finance.StressScenario.EquityShocksEntry
+	6,  // 30: qubit_engine.finance.OptionHolding.option:type_name -> qubit_engine.finance.OptionRequest
+	19, // 31: qubit_engine.finance.ScenarioRequest.positions:type_name -> qubit_engine.finance.Position
+	33, // 32: qubit_engine.finance.ScenarioRequest.options:type_name -> qubit_engine.finance.OptionHolding
+	32, // 33: qubit_engine.finance.ScenarioRequest.rate_holdings:type_name -> qubit_engine.finance.RateHolding
+	31, // 34: qubit_engine.finance.ScenarioRequest.scenarios:type_name -> qubit_engine.finance.StressScenario
+	31, // 35: qubit_engine.finance.ScenarioResult.scenario:type_name -> qubit_engine.finance.StressScenario
+	35, // 36: qubit_engine.finance.ScenarioResult.holdings:type_name -> qubit_engine.finance.HoldingPnL
+	36, // 37: qubit_engine.finance.ScenarioReport.scenarios:type_name -> qubit_engine.finance.ScenarioResult
+	36, // 38: qubit_engine.finance.ScenarioReport.combined:type_name -> qubit_engine.finance.ScenarioResult
+	6,  // 39: qubit_engine.finance.QuantumFinance.PriceEuropeanOption:input_type -> qubit_engine.finance.OptionRequest
+	8,  // 40: qubit_engine.finance.QuantumFinance.PriceAmericanOption:input_type -> qubit_engine.finance.AmericanOptionRequest
+	9,  // 41: qubit_engine.finance.QuantumFinance.PricePathOption:input_type -> qubit_engine.finance.PathOptionRequest
+	6,  // 42: qubit_engine.finance.QuantumFinance.CalculateGreeks:input_type -> qubit_engine.finance.OptionRequest
+	12, // 43: qubit_engine.finance.QuantumFinance.PriceBasketOption:input_type -> qubit_engine.finance.BasketOptionRequest
+	16, // 44: qubit_engine.finance.QuantumFinance.OptimizePortfolio:input_type -> qubit_engine.finance.PortfolioRequest
+	20, // 45: qubit_engine.finance.QuantumFinance.CalculateVaR:input_type -> qubit_engine.finance.VaRRequest
+	38, // 46: qubit_engine.finance.QuantumFinance.SimulatePricePaths:input_type -> qubit_engine.finance.SimulationRequest
+	22, // 47: qubit_engine.finance.QuantumFinance.GenerateRiskReport:input_type -> qubit_engine.finance.RiskReportRequest
+	27, // 48: qubit_engine.finance.QuantumFinance.SimulateCreditLosses:input_type -> qubit_engine.finance.CreditPortfolioRequest
+	34, // 49: qubit_engine.finance.QuantumFinance.RunScenarios:input_type -> qubit_engine.finance.ScenarioRequest
+	10, // 50: qubit_engine.finance.QuantumFinance.PriceEuropeanOption:output_type -> qubit_engine.finance.OptionPrice
+	10, // 51: qubit_engine.finance.QuantumFinance.PriceAmericanOption:output_type -> qubit_engine.finance.OptionPrice
+	10, // 52: qubit_engine.finance.QuantumFinance.PricePathOption:output_type -> qubit_engine.finance.OptionPrice
+	14, // 53: qubit_engine.finance.QuantumFinance.CalculateGreeks:output_type -> qubit_engine.finance.Greeks
+	10, // 54: qubit_engine.finance.QuantumFinance.PriceBasketOption:output_type -> qubit_engine.finance.OptionPrice
+	18, // 55: qubit_engine.finance.QuantumFinance.OptimizePortfolio:output_type -> qubit_engine.finance.OptimalPortfolio
+	21, // 56: qubit_engine.finance.QuantumFinance.CalculateVaR:output_type -> qubit_engine.finance.VaRResult
+	39, // 57: qubit_engine.finance.QuantumFinance.SimulatePricePaths:output_type -> qubit_engine.finance.PricePath
+	25, // 58: qubit_engine.finance.QuantumFinance.GenerateRiskReport:output_type -> qubit_engine.finance.RiskReportUpdate
+	30, // 59: qubit_engine.finance.QuantumFinance.SimulateCreditLosses:output_type -> qubit_engine.finance.CreditRiskResult
+	37, // 60: qubit_engine.finance.QuantumFinance.RunScenarios:output_type -> qubit_engine.finance.ScenarioReport
+	50, // [50:61] is the sub-list for method output_type
+	39, // [39:50] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_finance_proto_init() }
+func file_finance_proto_init() {
+	if File_finance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_finance_proto_goTypes,
+		DependencyIndexes: file_finance_proto_depIdxs,
+		EnumInfos:         file_finance_proto_enumTypes,
+		MessageInfos:      file_finance_proto_msgTypes,
+	}.Build()
+	File_finance_proto = out.File
+	file_finance_proto_goTypes = nil
+	file_finance_proto_depIdxs = nil
+}
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: finance.proto
+
+package generated
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuantumFinance_PriceEuropeanOption_FullMethodName  = "/qubit_engine.finance.QuantumFinance/PriceEuropeanOption"
+	QuantumFinance_PriceAmericanOption_FullMethodName  = "/qubit_engine.finance.QuantumFinance/PriceAmericanOption"
+	QuantumFinance_PricePathOption_FullMethodName      = "/qubit_engine.finance.QuantumFinance/PricePathOption"
+	QuantumFinance_CalculateGreeks_FullMethodName      = "/qubit_engine.finance.QuantumFinance/CalculateGreeks"
+	QuantumFinance_PriceBasketOption_FullMethodName    = "/qubit_engine.finance.QuantumFinance/PriceBasketOption"
+	QuantumFinance_OptimizePortfolio_FullMethodName    = "/qubit_engine.finance.QuantumFinance/OptimizePortfolio"
+	QuantumFinance_CalculateVaR_FullMethodName         = "/qubit_engine.finance.QuantumFinance/CalculateVaR"
+	QuantumFinance_SimulatePricePaths_FullMethodName   = "/qubit_engine.finance.QuantumFinance/SimulatePricePaths"
+	QuantumFinance_GenerateRiskReport_FullMethodName   = "/qubit_engine.finance.QuantumFinance/GenerateRiskReport"
+	QuantumFinance_SimulateCreditLosses_FullMethodName = "/qubit_engine.finance.QuantumFinance/SimulateCreditLosses"
+	QuantumFinance_RunScenarios_FullMethodName         = "/qubit_engine.finance.QuantumFinance/RunScenarios"
+)
+
+// QuantumFinanceClient is the client API for QuantumFinance service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumFinanceClient interface {
+	// Price European options using quantum Monte Carlo
+	PriceEuropeanOption(ctx context.Context, in *OptionRequest, opts ...grpc.CallOption) (*OptionPrice, error)
+	// Price American options with early exercise
+	PriceAmericanOption(ctx context.Context, in *AmericanOptionRequest, opts ...grpc.CallOption) (*OptionPrice, error)
+	// Price Asian, barrier and lookback options over simulated paths
+	PricePathOption(ctx context.Context, in *PathOptionRequest, opts ...grpc.CallOption) (*OptionPrice, error)
+	// Estimate a European option's sensitivities by Monte Carlo
+	CalculateGreeks(ctx context.Context, in *OptionRequest, opts ...grpc.CallOption) (*Greeks, error)
+	// Price a European option on a weighted basket of correlated assets
+	PriceBasketOption(ctx context.Context, in *BasketOptionRequest, opts ...grpc.CallOption) (*OptionPrice, error)
+	// Run portfolio optimization
+	OptimizePortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*OptimalPortfolio, error)
+	// Value at Risk calculation
+	CalculateVaR(ctx context.Context, in *VaRRequest, opts ...grpc.CallOption) (*VaRResult, error)
+	// Simulate stock price paths
+	SimulatePricePaths(ctx context.Context, in *SimulationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PricePath], error)
+	// Break a book's risk down by position, streaming progress as the
+	// simulations run
+	GenerateRiskReport(ctx context.Context, in *RiskReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RiskReportUpdate], error)
+	// Simulate correlated defaults over a loan portfolio
+	SimulateCreditLosses(ctx context.Context, in *CreditPortfolioRequest, opts ...grpc.CallOption) (*CreditRiskResult, error)
+	// Revalue a book under stress scenarios, its own or built in
+	RunScenarios(ctx context.Context, in *ScenarioRequest, opts ...grpc.CallOption) (*ScenarioReport, error)
+}
+
+type quantumFinanceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumFinanceClient(cc grpc.ClientConnInterface) QuantumFinanceClient {
+	return &quantumFinanceClient{cc}
+}
+
+func (c *quantumFinanceClient) PriceEuropeanOption(ctx context.Context, in *OptionRequest, opts ...grpc.CallOption) (*OptionPrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptionPrice)
+	err := c.cc.Invoke(ctx, QuantumFinance_PriceEuropeanOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) PriceAmericanOption(ctx context.Context, in *AmericanOptionRequest, opts ...grpc.CallOption) (*OptionPrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptionPrice)
+	err := c.cc.Invoke(ctx, QuantumFinance_PriceAmericanOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) PricePathOption(ctx context.Context, in *PathOptionRequest, opts ...grpc.CallOption) (*OptionPrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptionPrice)
+	err := c.cc.Invoke(ctx, QuantumFinance_PricePathOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) CalculateGreeks(ctx context.Context, in *OptionRequest, opts ...grpc.CallOption) (*Greeks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Greeks)
+	err := c.cc.Invoke(ctx, QuantumFinance_CalculateGreeks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) PriceBasketOption(ctx context.Context, in *BasketOptionRequest, opts ...grpc.CallOption) (*OptionPrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptionPrice)
+	err := c.cc.Invoke(ctx, QuantumFinance_PriceBasketOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) OptimizePortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*OptimalPortfolio, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimalPortfolio)
+	err := c.cc.Invoke(ctx, QuantumFinance_OptimizePortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) CalculateVaR(ctx context.Context, in *VaRRequest, opts ...grpc.CallOption) (*VaRResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VaRResult)
+	err := c.cc.Invoke(ctx, QuantumFinance_CalculateVaR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) SimulatePricePaths(ctx context.Context, in *SimulationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PricePath], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumFinance_ServiceDesc.Streams[0], QuantumFinance_SimulatePricePaths_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SimulationRequest, PricePath]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumFinance_SimulatePricePathsClient = grpc.ServerStreamingClient[PricePath]
+
+func (c *quantumFinanceClient) GenerateRiskReport(ctx context.Context, in *RiskReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RiskReportUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumFinance_ServiceDesc.Streams[1], QuantumFinance_GenerateRiskReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RiskReportRequest, RiskReportUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumFinance_GenerateRiskReportClient = grpc.ServerStreamingClient[RiskReportUpdate]
+
+func (c *quantumFinanceClient) SimulateCreditLosses(ctx context.Context, in *CreditPortfolioRequest, opts ...grpc.CallOption) (*CreditRiskResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditRiskResult)
+	err := c.cc.Invoke(ctx, QuantumFinance_SimulateCreditLosses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumFinanceClient) RunScenarios(ctx context.Context, in *ScenarioRequest, opts ...grpc.CallOption) (*ScenarioReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScenarioReport)
+	err := c.cc.Invoke(ctx, QuantumFinance_RunScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumFinanceServer is the server API for QuantumFinance service.
+// All implementations must embed UnimplementedQuantumFinanceServer
+// for forward compatibility.
+type QuantumFinanceServer interface {
+	// Price European options using quantum Monte Carlo
+	PriceEuropeanOption(context.Context, *OptionRequest) (*OptionPrice, error)
+	// Price American options with early exercise
+	PriceAmericanOption(context.Context, *AmericanOptionRequest) (*OptionPrice, error)
+	// Price Asian, barrier and lookback options over simulated paths
+	PricePathOption(context.Context, *PathOptionRequest) (*OptionPrice, error)
+	// Estimate a European option's sensitivities by Monte Carlo
+	CalculateGreeks(context.Context, *OptionRequest) (*Greeks, error)
+	// Price a European option on a weighted basket of correlated assets
+	PriceBasketOption(context.Context, *BasketOptionRequest) (*OptionPrice, error)
+	// Run portfolio optimization
+	OptimizePortfolio(context.Context, *PortfolioRequest) (*OptimalPortfolio, error)
+	// Value at Risk calculation
+	CalculateVaR(context.Context, *VaRRequest) (*VaRResult, error)
+	// Simulate stock price paths
+	SimulatePricePaths(*SimulationRequest, grpc.ServerStreamingServer[PricePath]) error
+	// Break a book's risk down by position, streaming progress as the
+	// simulations run
+	GenerateRiskReport(*RiskReportRequest, grpc.ServerStreamingServer[RiskReportUpdate]) error
+	// Simulate correlated defaults over a loan portfolio
+	SimulateCreditLosses(context.Context, *CreditPortfolioRequest) (*CreditRiskResult, error)
+	// Revalue a book under stress scenarios, its own or built in
+	RunScenarios(context.Context, *ScenarioRequest) (*ScenarioReport, error)
+	mustEmbedUnimplementedQuantumFinanceServer()
+}
+
+// UnimplementedQuantumFinanceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumFinanceServer struct{}
+
+func (UnimplementedQuantumFinanceServer) PriceEuropeanOption(context.Context, *OptionRequest) (*OptionPrice, error) {
+	return nil, status.Error(codes.Unimplemented, "method PriceEuropeanOption not implemented")
+}
+func (UnimplementedQuantumFinanceServer) PriceAmericanOption(context.Context, *AmericanOptionRequest) (*OptionPrice, error) {
+	return nil, status.Error(codes.Unimplemented, "method PriceAmericanOption not implemented")
+}
+func (UnimplementedQuantumFinanceServer) PricePathOption(context.Context, *PathOptionRequest) (*OptionPrice, error) {
+	return nil, status.Error(codes.Unimplemented, "method PricePathOption not implemented")
+}
+func (UnimplementedQuantumFinanceServer) CalculateGreeks(context.Context, *OptionRequest) (*Greeks, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateGreeks not implemented")
+}
+func (UnimplementedQuantumFinanceServer) PriceBasketOption(context.Context, *BasketOptionRequest) (*OptionPrice, error) {
+	return nil, status.Error(codes.Unimplemented, "method PriceBasketOption not implemented")
+}
+func (UnimplementedQuantumFinanceServer) OptimizePortfolio(context.Context, *PortfolioRequest) (*OptimalPortfolio, error) {
+	return nil, status.Error(codes.Unimplemented, "method OptimizePortfolio not implemented")
+}
+func (UnimplementedQuantumFinanceServer) CalculateVaR(context.Context, *VaRRequest) (*VaRResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateVaR not implemented")
+}
+func (UnimplementedQuantumFinanceServer) SimulatePricePaths(*SimulationRequest, grpc.ServerStreamingServer[PricePath]) error {
+	return status.Error(codes.Unimplemented, "method SimulatePricePaths not implemented")
+}
+func (UnimplementedQuantumFinanceServer) GenerateRiskReport(*RiskReportRequest, grpc.ServerStreamingServer[RiskReportUpdate]) error {
+	return status.Error(codes.Unimplemented, "method GenerateRiskReport not implemented")
+}
+func (UnimplementedQuantumFinanceServer) SimulateCreditLosses(context.Context, *CreditPortfolioRequest) (*CreditRiskResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateCreditLosses not implemented")
+}
+func (UnimplementedQuantumFinanceServer) RunScenarios(context.Context, *ScenarioRequest) (*ScenarioReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunScenarios not implemented")
+}
+func (UnimplementedQuantumFinanceServer) mustEmbedUnimplementedQuantumFinanceServer() {}
+func (UnimplementedQuantumFinanceServer) testEmbeddedByValue()                        {}
+
+// UnsafeQuantumFinanceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumFinanceServer will
+// result in compilation errors.
+type UnsafeQuantumFinanceServer interface {
+	mustEmbedUnimplementedQuantumFinanceServer()
+}
+
+func RegisterQuantumFinanceServer(s grpc.ServiceRegistrar, srv QuantumFinanceServer) {
+	// If the following call panics, it indicates UnimplementedQuantumFinanceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumFinance_ServiceDesc, srv)
+}
+
+func _QuantumFinance_PriceEuropeanOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).PriceEuropeanOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_PriceEuropeanOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).PriceEuropeanOption(ctx, req.(*OptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_PriceAmericanOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmericanOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).PriceAmericanOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_PriceAmericanOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).PriceAmericanOption(ctx, req.(*AmericanOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_PricePathOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).PricePathOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_PricePathOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).PricePathOption(ctx, req.(*PathOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_CalculateGreeks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).CalculateGreeks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_CalculateGreeks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).CalculateGreeks(ctx, req.(*OptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_PriceBasketOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasketOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).PriceBasketOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_PriceBasketOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).PriceBasketOption(ctx, req.(*BasketOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_OptimizePortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).OptimizePortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_OptimizePortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).OptimizePortfolio(ctx, req.(*PortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_CalculateVaR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).CalculateVaR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_CalculateVaR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).CalculateVaR(ctx, req.(*VaRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_SimulatePricePaths_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SimulationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumFinanceServer).SimulatePricePaths(m, &grpc.GenericServerStream[SimulationRequest, PricePath]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumFinance_SimulatePricePathsServer = grpc.ServerStreamingServer[PricePath]
+
+func _QuantumFinance_GenerateRiskReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RiskReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumFinanceServer).GenerateRiskReport(m, &grpc.GenericServerStream[RiskReportRequest, RiskReportUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumFinance_GenerateRiskReportServer = grpc.ServerStreamingServer[RiskReportUpdate]
+
+func _QuantumFinance_SimulateCreditLosses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).SimulateCreditLosses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_SimulateCreditLosses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).SimulateCreditLosses(ctx, req.(*CreditPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumFinance_RunScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumFinanceServer).RunScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumFinance_RunScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumFinanceServer).RunScenarios(ctx, req.(*ScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumFinance_ServiceDesc is the grpc.ServiceDesc for QuantumFinance service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumFinance_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.finance.QuantumFinance",
+	HandlerType: (*QuantumFinanceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PriceEuropeanOption",
+			Handler:    _QuantumFinance_PriceEuropeanOption_Handler,
+		},
+		{
+			MethodName: "PriceAmericanOption",
+			Handler:    _QuantumFinance_PriceAmericanOption_Handler,
+		},
+		{
+			MethodName: "PricePathOption",
+			Handler:    _QuantumFinance_PricePathOption_Handler,
+		},
+		{
+			MethodName: "CalculateGreeks",
+			Handler:    _QuantumFinance_CalculateGreeks_Handler,
+		},
+		{
+			MethodName: "PriceBasketOption",
+			Handler:    _QuantumFinance_PriceBasketOption_Handler,
+		},
+		{
+			MethodName: "OptimizePortfolio",
+			Handler:    _QuantumFinance_OptimizePortfolio_Handler,
+		},
+		{
+			MethodName: "CalculateVaR",
+			Handler:    _QuantumFinance_CalculateVaR_Handler,
+		},
+		{
+			MethodName: "SimulateCreditLosses",
+			Handler:    _QuantumFinance_SimulateCreditLosses_Handler,
+		},
+		{
+			MethodName: "RunScenarios",
+			Handler:    _QuantumFinance_RunScenarios_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SimulatePricePaths",
+			Handler:       _QuantumFinance_SimulatePricePaths_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GenerateRiskReport",
+			Handler:       _QuantumFinance_GenerateRiskReport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "finance.proto",
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+// sample accumulates one estimator's draws
+type sample struct {
+	sum, sumSq float64
+}
+
+func (m *sample) add(x float64) {
+	m.sum += x
+	m.sumSq += x * x
+}
+
+func (m *sample) merge(o sample) {
+	m.sum += o.sum
+	m.sumSq += o.sumSq
+}
+
+func (m *sample) estimate(n int) (float64, float64) {
+	return meanAndError(m.sum, m.sumSq, n)
+}
+
+// greekSamples are the Monte Carlo draws of each sensitivity
+type greekSamples struct {
+	price, delta, gamma, vega, theta, rho sample
+}
+
+func (g *greekSamples) merge(o *greekSamples) {
+	g.price.merge(o.price)
+	g.delta.merge(o.delta)
+	g.gamma.merge(o.gamma)
+	g.vega.merge(o.vega)
+	g.theta.merge(o.theta)
+	g.rho.merge(o.rho)
+}
+
+// simulateGreeks draws S_T = S·exp((r-q-σ²/2)T + σ√T·Z) and, on each
+// path, the discounted payoff's derivatives:
+//
+//	pathwise   ∂V = e^{-rT}·(±1 in the money)·∂S_T - (∂rT)·V
+//	likelihood Γ  = V·((Z²-1)/(S²σ²T) - Z/(S²σ√T))
+func (s *FinanceServer) simulateGreeks(ctx context.Context, st *streams, req *pb.OptionRequest, sims int) (*greekSamples, error) {
+	S, K, r, q := req.SpotPrice, req.StrikePrice, req.RiskFreeRate, req.DividendYield
+	sigma, T := req.Volatility, req.TimeToExpiry
+	sqrtT := math.Sqrt(T)
+	discount := math.Exp(-r * T)
+	call := req.Type == pb.OptionType_OPTION_CALL
+
+	parts := make([]greekSamples, chunks(sims))
+	err := s.parallelChunks(ctx, 0, sims, func(c, start, end int) {
+		src := st.chunk(c, start)
+		g := &parts[c]
+		draw := make([]float64, 1)
+		for i := start; i < end; i++ {
+			src.fill(draw)
+			z := draw[0]
+			final := S * math.Exp((r-q-0.5*sigma*sigma)*T+sigma*sqrtT*z)
+			payoff := discount * vanillaPayoff(req.Type, final, K)
+
+			// The discounted payoff's slope in S_T
+			slope := 0.0
+			switch {
+			case call && final > K:
+				slope = discount
+			case !call && final < K:
+				slope = -discount
+			}
+
+			g.price.add(payoff)
+			g.delta.add(slope * final / S)
+			g.gamma.add(payoff * ((z*z-1)/(S*S*sigma*sigma*T) - z/(S*S*sigma*sqrtT)))
+			g.vega.add(slope * final * (sqrtT*z - sigma*T))
+			g.rho.add(slope*final*T - T*payoff)
+			// Theta is the value's change as time passes, so expiry draws nearer
+			g.theta.add(r*payoff - slope*final*(r-q-0.5*sigma*sigma+sigma*z/(2*sqrtT)))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	g := &greekSamples{}
+	for i := range parts {
+		g.merge(&parts[i])
+	}
+	return g, nil
+}
+
+// blackScholesGreeks are the closed-form delta, gamma, vega, theta and
+// rho, with a continuous dividend yield q
+func blackScholesGreeks(optType pb.OptionType, spot, strike, r, q, sigma, T float64) (delta, gamma, vega, theta, rho float64) {
+	sqrtT := math.Sqrt(T)
+	d1 := (math.Log(spot/strike) + (r-q+0.5*sigma*sigma)*T) / (sigma * sqrtT)
+	d2 := d1 - sigma*sqrtT
+	pdf := math.Exp(-0.5*d1*d1) / math.Sqrt(2*math.Pi)
+	carry, discount := math.Exp(-q*T), math.Exp(-r*T)
+
+	gamma = carry * pdf / (spot * sigma * sqrtT)
+	vega = spot * carry * pdf * sqrtT
+	decay := -spot * carry * pdf * sigma / (2 * sqrtT)
+	if optType == pb.OptionType_OPTION_CALL {
+		delta = carry * normCDF(d1)
+		theta = decay - r*strike*discount*normCDF(d2) + q*spot*carry*normCDF(d1)
+		rho = strike * T * discount * normCDF(d2)
+	} else {
+		delta = carry * (normCDF(d1) - 1)
+		theta = decay + r*strike*discount*normCDF(-d2) - q*spot*carry*normCDF(-d1)
+		rho = -strike * T * discount * normCDF(-d2)
+	}
+	return delta, gamma, vega, theta, rho
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *FinanceServer) CalculateGreeks(ctx context.Context, req *pb.OptionRequest) (*pb.Greeks, error) {
+	if err := validateOption(req); err != nil {
+		return nil, err
+	}
+	if hasReduction(req.VarianceReduction) {
+		return nil, fmt.Errorf("variance reduction is not available for Greeks")
+	}
+	sims := int(req.NumSimulations)
+	if sims == 0 {
+		sims = defaultOptionSimulations
+	}
+
+	g, err := s.simulateGreeks(ctx, s.newStreams(req.Sampling, 1), req, sims)
+	if err != nil {
+		return nil, err
+	}
+	delta, gamma, vega, theta, rho := blackScholesGreeks(req.Type,
+		req.SpotPrice, req.StrikePrice, req.RiskFreeRate, req.DividendYield, req.Volatility, req.TimeToExpiry)
+	estimate := func(m *sample, closedForm float64) *pb.GreekEstimate {
+		mc, stdError := m.estimate(sims)
+		return &pb.GreekEstimate{MonteCarlo: mc, StdError: stdError, BlackScholes: closedForm}
+	}
+	out := &pb.Greeks{
+		Delta:           estimate(&g.delta, delta),
+		Gamma:           estimate(&g.gamma, gamma),
+		Vega:            estimate(&g.vega, vega),
+		Theta:           estimate(&g.theta, theta),
+		Rho:             estimate(&g.rho, rho),
+		SimulationsUsed: int32(sims),
+	}
+	out.Price, _ = g.price.estimate(sims)
+
+	log.Printf("💰 Greeks for %v option: Δ=%.4f Γ=%.4f ν=%.4f Θ=%.4f ρ=%.4f",
+		req.Type, out.Delta.MonteCarlo, out.Gamma.MonteCarlo, out.Vega.MonteCarlo, out.Theta.MonteCarlo, out.Rho.MonteCarlo)
+	return out, nil
+}
@@ -4,95 +4,99 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"math"
 	"math/rand"
 	"net"
+	"runtime"
+	"sort"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
-)
-
-type OptionType int
+	"google.golang.org/grpc/credentials/insecure"
 
-const (
-	OptionCall OptionType = 0
-	OptionPut  OptionType = 1
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+	engine "github.com/perclft/QubitEngine/modules/finance/generated/engine"
 )
 
 type FinanceServer struct {
-	rng *rand.Rand
+	pb.UnimplementedQuantumFinanceServer
+	engineClient engine.QuantumComputeClient // Runs QAOA circuits
+	workers      int                         // Goroutines per simulation
+	seeds        *rand.Rand
+	mu           sync.Mutex // Guards seeds
 }
 
-func NewFinanceServer() *FinanceServer {
+func NewFinanceServer(engineClient engine.QuantumComputeClient, workers int) *FinanceServer {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
 	return &FinanceServer{
-		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
+		engineClient: engineClient,
+		workers:      workers,
+		seeds:        rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
-// PriceEuropeanOption using Monte Carlo simulation
-func (s *FinanceServer) PriceEuropeanOption(
-	optType OptionType,
-	spot, strike, r, sigma, T float64,
-	numSims int,
-) (float64, float64, float64) {
-	if numSims <= 0 {
-		numSims = 100000
-	}
-
-	dt := T
-	drift := (r - 0.5*sigma*sigma) * dt
-	vol := sigma * math.Sqrt(dt)
-
-	sumPayoff := 0.0
-	sumPayoffSq := 0.0
-
-	for i := 0; i < numSims; i++ {
-		// Simulate final price using geometric Brownian motion
-		z := s.rng.NormFloat64()
-		finalPrice := spot * math.Exp(drift+vol*z)
+// newRNG seeds a generator for one request, so concurrent simulations do
+// not share one
+func (s *FinanceServer) newRNG() *rand.Rand {
+	return rand.New(rand.NewSource(s.newSeed()))
+}
 
-		// Calculate payoff
-		var payoff float64
-		if optType == OptionCall {
-			payoff = math.Max(finalPrice-strike, 0)
-		} else {
-			payoff = math.Max(strike-finalPrice, 0)
-		}
+// newSeed is for a request that replays its draws
+func (s *FinanceServer) newSeed() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seeds.Int63()
+}
 
-		sumPayoff += payoff
-		sumPayoffSq += payoff * payoff
+// monteCarloPrice prices a European option by Monte Carlo simulation
+// under the variance reduction asked for, beside the Black-Scholes price.
+// The control variate is the discounted terminal price, worth S·e^{-qT}.
+func (s *FinanceServer) monteCarloPrice(ctx context.Context, req *pb.OptionRequest, numSims int) (*pb.OptionPrice, error) {
+	if numSims <= 0 {
+		numSims = defaultOptionSimulations
+	}
+	spot, strike, r, q := req.SpotPrice, req.StrikePrice, req.RiskFreeRate, req.DividendYield
+	sigma, T := req.Volatility, req.TimeToExpiry
+
+	drift := (r - q - 0.5*sigma*sigma) * T
+	vol := sigma * math.Sqrt(T)
+	discount := math.Exp(-r * T)
+
+	// Simulate final prices using geometric Brownian motion
+	a, err := s.runMC(ctx, s.newStreams(req.Sampling, 1), req.VarianceReduction, importanceShift(req), 1, numSims, func(z []float64) (float64, float64) {
+		finalPrice := spot * math.Exp(drift+vol*z[0])
+		return discount * vanillaPayoff(req.Type, finalPrice, strike), discount * finalPrice
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	// Discounted expected payoff
-	meanPayoff := sumPayoff / float64(numSims)
-	price := math.Exp(-r*T) * meanPayoff
-
-	// Standard error
-	variance := (sumPayoffSq/float64(numSims) - meanPayoff*meanPayoff)
-	stdError := math.Exp(-r*T) * math.Sqrt(variance/float64(numSims))
 
 	// Compare to Black-Scholes
-	bsPrice := s.blackScholes(optType, spot, strike, r, sigma, T)
+	out := &pb.OptionPrice{BlackScholes: blackScholes(req.Type, spot, strike, r, q, sigma, T)}
+	reductionReport(out, a, req.VarianceReduction, spot*math.Exp(-q*T))
 
-	log.Printf("💰 Priced %v option: MC=$%.4f ± $%.4f, BS=$%.4f",
-		optType, price, stdError, bsPrice)
-
-	return price, stdError, bsPrice
+	log.Printf("💰 Priced %v option: MC=$%.4f ± $%.4f (%.1f× variance reduction), BS=$%.4f",
+		req.Type, out.Price, out.StdError, out.VarianceReduction, out.BlackScholes)
+	return out, nil
 }
 
-// Black-Scholes closed-form solution
-func (s *FinanceServer) blackScholes(optType OptionType, spot, strike, r, sigma, T float64) float64 {
-	d1 := (math.Log(spot/strike) + (r+0.5*sigma*sigma)*T) / (sigma * math.Sqrt(T))
+// Black-Scholes closed-form solution, with a continuous dividend yield q
+func blackScholes(optType pb.OptionType, spot, strike, r, q, sigma, T float64) float64 {
+	d1 := (math.Log(spot/strike) + (r-q+0.5*sigma*sigma)*T) / (sigma * math.Sqrt(T))
 	d2 := d1 - sigma*math.Sqrt(T)
 
 	var price float64
-	if optType == OptionCall {
-		price = spot*normCDF(d1) - strike*math.Exp(-r*T)*normCDF(d2)
+	if optType == pb.OptionType_OPTION_CALL {
+		price = spot*math.Exp(-q*T)*normCDF(d1) - strike*math.Exp(-r*T)*normCDF(d2)
 	} else {
-		price = strike*math.Exp(-r*T)*normCDF(-d2) - spot*normCDF(-d1)
+		price = strike*math.Exp(-r*T)*normCDF(-d2) - spot*math.Exp(-q*T)*normCDF(-d1)
 	}
 	return price
 }
@@ -102,55 +106,87 @@ func normCDF(x float64) float64 {
 	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
 }
 
-// CalculateVaR - Value at Risk using Monte Carlo
-func (s *FinanceServer) CalculateVaR(portfolioValue, volatility, confidence float64, days int, sims int) (float64, float64) {
+// ciZ is the normal quantile for the 95% confidence intervals on VaR and CVaR
+const ciZ = 1.959964
+
+// simulateVaR - Value at Risk using Monte Carlo over sims draws of the
+// portfolio's profit and loss, filling the simulated fields of the result.
+// newPnl gives each chunk of draws its own profit and loss from that
+// chunk's normals.
+func (s *FinanceServer) simulateVaR(ctx context.Context, st *streams, confidence float64, sims int,
+	newPnl func(src normals) func() float64) (*pb.VaRResult, error) {
 	if sims <= 0 {
-		sims = 10000
+		sims = defaultVaRSimulations
 	}
 
-	// Simulate portfolio returns
 	returns := make([]float64, sims)
-	dailyVol := volatility / math.Sqrt(252) // Annualized to daily
-
-	for i := 0; i < sims; i++ {
-		// Simulate multi-day return
-		totalReturn := 0.0
-		for d := 0; d < days; d++ {
-			totalReturn += dailyVol * s.rng.NormFloat64()
+	err := s.parallelChunks(ctx, 0, sims, func(c, start, end int) {
+		pnl := newPnl(st.chunk(c, start))
+		for i := start; i < end; i++ {
+			returns[i] = pnl()
 		}
-		returns[i] = portfolioValue * totalReturn
+	})
+	if err != nil {
+		return nil, err
 	}
+	sort.Float64s(returns)
+	result := tailRisk(returns, confidence)
 
-	// Sort returns
-	for i := 0; i < sims; i++ {
-		for j := i + 1; j < sims; j++ {
-			if returns[j] < returns[i] {
-				returns[i], returns[j] = returns[j], returns[i]
-			}
-		}
-	}
+	log.Printf("📊 VaR@%.0f%%: $%.2f [%.2f, %.2f], CVaR: $%.2f [%.2f, %.2f] over %d simulations",
+		confidence*100, result.VarHistorical, result.VarLower, result.VarUpper, result.Cvar, result.CvarLower, result.CvarUpper, sims)
+	return result, nil
+}
+
+// tailRisk reads VaR and CVaR, with their confidence intervals, off
+// returns sorted worst first
+func tailRisk(returns []float64, confidence float64) *pb.VaRResult {
+	sims := len(returns)
 
 	// VaR at confidence level
 	varIndex := int((1 - confidence) * float64(sims))
-	var_historical := -returns[varIndex]
+	varHistorical := -returns[varIndex]
 
-	// CVaR (Expected Shortfall)
-	cvarSum := 0.0
-	for i := 0; i < varIndex; i++ {
-		cvarSum += returns[i]
+	// CVaR (Expected Shortfall), and the spread of the losses beyond VaR
+	cvarSum, cvarSumSq := 0.0, 0.0
+	for _, r := range returns[:varIndex] {
+		cvarSum -= r
+		cvarSumSq += r * r
+	}
+	cvar := cvarSum / float64(varIndex)
+	tailVar := math.Max(cvarSumSq/float64(varIndex)-cvar*cvar, 0)
+
+	// The number of returns below the true quantile is binomial, so the
+	// interval on VaR is a band of order statistics around varIndex
+	spread := int(math.Ceil(ciZ * math.Sqrt(float64(sims)*confidence*(1-confidence))))
+	lower, upper := min(varIndex+spread, sims-1), max(varIndex-spread, 0)
+	// CVaR's standard error counts both the tail's spread and the
+	// uncertainty in where the tail starts
+	cvarError := math.Sqrt((tailVar + confidence*(cvar-varHistorical)*(cvar-varHistorical)) / float64(varIndex))
+
+	return &pb.VaRResult{
+		VarHistorical:   varHistorical,
+		Cvar:            cvar,
+		VarLower:        -returns[lower],
+		VarUpper:        -returns[upper],
+		CvarLower:       cvar - ciZ*cvarError,
+		CvarUpper:       cvar + ciZ*cvarError,
+		SimulationsUsed: int32(sims),
 	}
-	cvar := -cvarSum / float64(varIndex)
-
-	log.Printf("📊 VaR@%.0f%%: $%.2f, CVaR: $%.2f", confidence*100, var_historical, cvar)
-
-	return var_historical, cvar
 }
 
 func main() {
 	port := flag.Int("port", 50064, "gRPC port")
+	engineAddr := flag.String("engine-addr", "engine:50051", "Quantum Engine address, for QAOA")
+	workers := flag.Int("workers", 0, "Goroutines per simulation; 0 for one per CPU")
 	flag.Parse()
 
-	server := NewFinanceServer()
+	conn, err := grpc.Dial(*engineAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to engine: %v", err)
+	}
+	defer conn.Close()
+
+	server := NewFinanceServer(engine.NewQuantumComputeClient(conn), *workers)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
@@ -158,13 +194,14 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
+	pb.RegisterQuantumFinanceServer(grpcServer, server)
 
 	log.Printf("💰 Quantum Finance starting on port %d", *port)
 	log.Printf("   Features: Option Pricing, VaR, Portfolio Optimization")
+	log.Printf("   Engine: %s (QAOA up to %d assets)", *engineAddr, maxQAOAQubits)
+	log.Printf("   Simulations: %d workers", server.workers)
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-
-	_ = server
 }
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+// checkSymbols trims the assets' symbols, which must be given and unique
+func checkSymbols(symbols []string) ([]string, error) {
+	return checkNames("asset", "symbol", symbols)
+}
+
+// checkNames trims what each kind is known by, which must be given and
+// unique
+func checkNames(kind, field string, names []string) ([]string, error) {
+	seen := make(map[string]bool)
+	out := make([]string, len(names))
+	for i, symbol := range names {
+		symbol = strings.TrimSpace(symbol)
+		switch {
+		case symbol == "":
+			return nil, fmt.Errorf("every %s needs a %s", kind, field)
+		case seen[symbol]:
+			return nil, fmt.Errorf("%s %s is listed twice", kind, symbol)
+		}
+		seen[symbol] = true
+		out[i] = symbol
+	}
+	return out, nil
+}
+
+// ------------------------------------------------------------------
+// Positions
+// ------------------------------------------------------------------
+
+// positions is a book of correlated holdings whose returns are normal
+type positions struct {
+	symbols []string
+	values  []float64
+	vols    []float64
+	corr    [][]float64
+	factor  [][]float64 // Cholesky factor of corr
+}
+
+func positionsFromProto(req []*pb.Position) (*positions, error) {
+	if len(req) > maxAssets {
+		return nil, fmt.Errorf("needs at most %d positions", maxAssets)
+	}
+	b := &positions{}
+	names := make([]string, len(req))
+	rows := make([][]float64, len(req))
+	for i, p := range req {
+		names[i], rows[i] = p.Symbol, p.Correlations
+	}
+	symbols, err := checkSymbols(names)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range req {
+		if p.Volatility < 0 {
+			return nil, fmt.Errorf("position %s cannot have a negative volatility", symbols[i])
+		}
+		b.values = append(b.values, p.Value)
+		b.vols = append(b.vols, p.Volatility)
+	}
+	b.symbols = symbols
+	if b.corr, b.factor, err = correlationMatrix(symbols, rows); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *positions) value() float64 {
+	sum := 0.0
+	for _, v := range b.values {
+		sum += v
+	}
+	return sum
+}
+
+// volatility is the annual volatility of the book's value as a fraction
+// of it, √(vᵀCv)/Σv where v holds each position's value × volatility
+func (b *positions) volatility() float64 {
+	variance := 0.0
+	for i := range b.values {
+		for j := range b.values {
+			variance += b.values[i] * b.vols[i] * b.corr[i][j] * b.values[j] * b.vols[j]
+		}
+	}
+	return math.Sqrt(math.Max(variance, 0)) / b.value()
+}
+
+// pnl draws the book's profit and loss over days trading days, one
+// correlated return per position
+func (b *positions) pnl(src normals, days int) func() float64 {
+	draw := b.drawer(src, days)
+	out := make([]float64, len(b.values))
+	return func() float64 { return draw(out) }
+}
+
+// drawer is pnl filling in each position's profit and loss too
+func (b *positions) drawer(src normals, days int) func(out []float64) float64 {
+	scale := math.Sqrt(float64(days) / tradingDays)
+	eps, z := make([]float64, len(b.values)), make([]float64, len(b.values))
+	return func(out []float64) float64 {
+		correlatedNormals(src, b.factor, eps, z)
+		sum := 0.0
+		for i, v := range b.values {
+			out[i] = v * b.vols[i] * scale * z[i]
+			sum += out[i]
+		}
+		return sum
+	}
+}
+
+// ------------------------------------------------------------------
+// Baskets
+// ------------------------------------------------------------------
+
+// basket is a weighted sum of assets following correlated geometric
+// Brownian motions
+type basket struct {
+	symbols []string
+	spots   []float64
+	weights []float64
+	vols    []float64
+	yields  []float64
+	corr    [][]float64
+	factor  [][]float64
+}
+
+func basketFromProto(req *pb.BasketOptionRequest) (*basket, error) {
+	n := len(req.Assets)
+	if n == 0 || n > maxAssets {
+		return nil, fmt.Errorf("needs 1-%d assets", maxAssets)
+	}
+	b := &basket{}
+	names := make([]string, n)
+	rows := make([][]float64, n)
+	for i, a := range req.Assets {
+		names[i], rows[i] = a.Symbol, a.Correlations
+	}
+	symbols, err := checkSymbols(names)
+	if err != nil {
+		return nil, err
+	}
+	for i, a := range req.Assets {
+		switch {
+		case a.SpotPrice <= 0:
+			return nil, fmt.Errorf("asset %s needs a positive spot price", symbols[i])
+		case a.Weight <= 0:
+			return nil, fmt.Errorf("asset %s needs a positive weight", symbols[i])
+		case a.Volatility <= 0:
+			return nil, fmt.Errorf("asset %s needs a positive volatility", symbols[i])
+		case a.DividendYield < 0:
+			return nil, fmt.Errorf("asset %s cannot have a negative dividend_yield", symbols[i])
+		}
+		b.spots = append(b.spots, a.SpotPrice)
+		b.weights = append(b.weights, a.Weight)
+		b.vols = append(b.vols, a.Volatility)
+		b.yields = append(b.yields, a.DividendYield)
+	}
+	b.symbols = symbols
+	if b.corr, b.factor, err = correlationMatrix(symbols, rows); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// momentMatched prices the option as if the basket were lognormal with
+// the true basket's forward and second moment:
+//
+//	M₁ = Σ wᵢFᵢ, M₂ = ΣΣ wᵢwⱼFᵢFⱼ·e^{ρᵢⱼσᵢσⱼT}, σ²T = ln(M₂/M₁²)
+//
+// It is exact for one asset.
+func (b *basket) momentMatched(optType pb.OptionType, strike, r, T float64) float64 {
+	forwards := make([]float64, len(b.spots))
+	m1 := 0.0
+	for i := range b.spots {
+		forwards[i] = b.weights[i] * b.spots[i] * math.Exp((r-b.yields[i])*T)
+		m1 += forwards[i]
+	}
+	m2 := 0.0
+	for i := range forwards {
+		for j := range forwards {
+			m2 += forwards[i] * forwards[j] * math.Exp(b.corr[i][j]*b.vols[i]*b.vols[j]*T)
+		}
+	}
+	sigma := math.Sqrt(math.Max(math.Log(m2/(m1*m1)), 0) / T)
+	if sigma < 1e-12 {
+		return math.Exp(-r*T) * vanillaPayoff(optType, m1, strike)
+	}
+	// A forward is a spot paying a dividend yield of r
+	return blackScholes(optType, m1, strike, r, r, sigma, T)
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *FinanceServer) PriceBasketOption(ctx context.Context, req *pb.BasketOptionRequest) (*pb.OptionPrice, error) {
+	b, err := basketFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	sims := int(req.NumSimulations)
+	if sims == 0 {
+		sims = defaultOptionSimulations
+	}
+	switch {
+	case req.Type != pb.OptionType_OPTION_CALL && req.Type != pb.OptionType_OPTION_PUT:
+		return nil, fmt.Errorf("unknown option type %v", req.Type)
+	case req.StrikePrice <= 0:
+		return nil, fmt.Errorf("strike price must be positive")
+	case req.TimeToExpiry <= 0:
+		return nil, fmt.Errorf("time_to_expiry must be positive")
+	case sims < 0 || sims > maxOptionSimulations:
+		return nil, fmt.Errorf("num_simulations must be 0-%d", maxOptionSimulations)
+	case sims*len(b.spots) > maxPathDraws:
+		return nil, fmt.Errorf("num_simulations × assets must be at most %d", maxPathDraws)
+	}
+	if err := checkSampling(req.Sampling, len(b.spots)); err != nil {
+		return nil, err
+	}
+
+	T, r := req.TimeToExpiry, req.RiskFreeRate
+	n := len(b.spots)
+	drifts, vols := make([]float64, n), make([]float64, n)
+	for i := range drifts {
+		drifts[i] = (r - b.yields[i] - 0.5*b.vols[i]*b.vols[i]) * T
+		vols[i] = b.vols[i] * math.Sqrt(T)
+	}
+	st := s.newStreams(req.Sampling, n)
+	parts := make([]sample, chunks(sims))
+	err = s.parallelChunks(ctx, 0, sims, func(c, start, end int) {
+		src := st.chunk(c, start)
+		eps, z := make([]float64, n), make([]float64, n)
+		for i := start; i < end; i++ {
+			correlatedNormals(src, b.factor, eps, z)
+			value := 0.0
+			for a := range z {
+				value += b.weights[a] * b.spots[a] * math.Exp(drifts[a]+vols[a]*z[a])
+			}
+			parts[c].add(vanillaPayoff(req.Type, value, req.StrikePrice))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	var total sample
+	for _, part := range parts {
+		total.merge(part)
+	}
+	mean, stdError := total.estimate(sims)
+	discount := math.Exp(-r * T)
+	price := discount * mean
+	matched := b.momentMatched(req.Type, req.StrikePrice, r, T)
+
+	log.Printf("💰 Priced %v option on a basket of %d assets: MC=$%.4f ± $%.4f, moment-matched=$%.4f",
+		req.Type, n, price, discount*stdError, matched)
+	return &pb.OptionPrice{
+		Price:           price,
+		BlackScholes:    matched,
+		MonteCarlo:      price,
+		StdError:        discount * stdError,
+		SimulationsUsed: int32(sims),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+// mcChunk is how many draws make one unit of work. Each chunk draws from
+// its own stream, seeded from the request's seed and the chunk's index,
+// and results are combined in chunk order, so a simulation's result
+// depends on neither the number of workers nor the order they finish in.
+const mcChunk = 4096
+
+// chunks is how many chunks cover n draws
+func chunks(n int) int {
+	return (n + mcChunk - 1) / mcChunk
+}
+
+// streams hands each chunk of a simulation its own normals
+type streams struct {
+	seed  int64
+	sobol *sobol // nil for pseudo-random draws
+}
+
+func (s *FinanceServer) newStreams(sampling pb.SamplingMethod, dims int) *streams {
+	st := &streams{seed: s.newSeed()}
+	if sampling == pb.SamplingMethod_SAMPLING_SOBOL {
+		st.sobol = newSobol(dims, rand.New(rand.NewSource(st.seed)))
+	}
+	return st
+}
+
+// chunk is the normals for the chunk at index, whose first draw is start
+func (st *streams) chunk(index, start int) normals {
+	if st.sobol != nil {
+		return st.sobol.at(uint32(start))
+	}
+	return pseudoNormals{st.rng(index)}
+}
+
+// rng is the chunk at index's generator, for simulations drawing more
+// than normals
+func (st *streams) rng(index int) *rand.Rand {
+	return rand.New(rand.NewSource(chunkSeed(st.seed, index)))
+}
+
+// chunkSeed mixes a chunk's index into a seed (SplitMix64), so
+// neighbouring chunks' generators start far apart
+func chunkSeed(seed int64, index int) int64 {
+	z := uint64(seed) + uint64(index+1)*0x9e3779b97f4a7c15
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return int64(z ^ z>>31)
+}
+
+// parallelChunks runs work on every chunk overlapping draws [from, to)
+// across the server's workers, clipped to the range. Chunks are aligned
+// to multiples of mcChunk, so a simulation run in pieces draws the same
+// as one run whole. It stops handing out chunks once ctx is done.
+func (s *FinanceServer) parallelChunks(ctx context.Context, from, to int, work func(chunk, start, end int)) error {
+	if from >= to {
+		return nil
+	}
+	first, last := from/mcChunk, (to-1)/mcChunk
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(s.workers, last-first+1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range next {
+				work(c, max(c*mcChunk, from), min((c+1)*mcChunk, to))
+			}
+		}()
+	}
+	var err error
+feed:
+	for c := first; c <= last; c++ {
+		select {
+		case next <- c:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	return err
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+const (
+	defaultTimeSteps     = 100
+	maxTimeSteps         = 10000
+	defaultExerciseDates = 50
+	// maxPathDraws bounds simulations × steps for path-dependent pricing
+	maxPathDraws = 100000000
+	// maxStoredPrices bounds the paths Longstaff-Schwartz keeps in memory
+	maxStoredPrices = 10000000
+)
+
+// pathStats summarizes a simulated path at its monitoring dates, S0
+// excluded from the average but included in the extremes
+type pathStats struct {
+	final, average, high, low float64
+}
+
+// gbmPath walks one geometric Brownian motion path, a step for each of
+// the standard normals z
+func gbmPath(spot, drift, vol float64, z []float64) pathStats {
+	price, sum := spot, 0.0
+	st := pathStats{high: spot, low: spot}
+	for _, x := range z {
+		price *= math.Exp(drift + vol*x)
+		sum += price
+		st.high = math.Max(st.high, price)
+		st.low = math.Min(st.low, price)
+	}
+	st.final, st.average = price, sum/float64(len(z))
+	return st
+}
+
+func vanillaPayoff(optType pb.OptionType, price, strike float64) float64 {
+	if optType == pb.OptionType_OPTION_CALL {
+		return math.Max(price-strike, 0)
+	}
+	return math.Max(strike-price, 0)
+}
+
+// pathPayoff is what a path-dependent option pays at expiry
+func pathPayoff(req *pb.PathOptionRequest, st pathStats) float64 {
+	base := req.Base
+	switch req.Style {
+	case pb.PathStyle_PATH_ASIAN:
+		return vanillaPayoff(base.Type, st.average, base.StrikePrice)
+	case pb.PathStyle_PATH_BARRIER:
+		var crossed bool
+		switch req.BarrierType {
+		case pb.BarrierType_BARRIER_UP_AND_OUT, pb.BarrierType_BARRIER_UP_AND_IN:
+			crossed = st.high >= req.Barrier
+		default:
+			crossed = st.low <= req.Barrier
+		}
+		knockIn := req.BarrierType == pb.BarrierType_BARRIER_UP_AND_IN || req.BarrierType == pb.BarrierType_BARRIER_DOWN_AND_IN
+		if crossed != knockIn {
+			return 0
+		}
+		return vanillaPayoff(base.Type, st.final, base.StrikePrice)
+	case pb.PathStyle_PATH_LOOKBACK:
+		call := base.Type == pb.OptionType_OPTION_CALL
+		switch {
+		case req.FloatingStrike && call:
+			return st.final - st.low
+		case req.FloatingStrike:
+			return st.high - st.final
+		case call:
+			return math.Max(st.high-base.StrikePrice, 0)
+		default:
+			return math.Max(base.StrikePrice-st.low, 0)
+		}
+	}
+	return 0
+}
+
+// meanAndError is a sample's mean and the standard error of the mean
+func meanAndError(sum, sumSq float64, n int) (float64, float64) {
+	mean := sum / float64(n)
+	variance := math.Max(sumSq/float64(n)-mean*mean, 0)
+	return mean, math.Sqrt(variance / float64(n))
+}
+
+// ------------------------------------------------------------------
+// Longstaff-Schwartz
+// ------------------------------------------------------------------
+
+// longstaffSchwartz prices an American option exercisable at dates equal
+// steps apart. Working back from expiry, the discounted cash flow of the
+// paths in the money is regressed on 1, S/K and (S/K)², and a path is
+// exercised where paying now beats that estimate of holding on.
+func longstaffSchwartz(src normals, base *pb.OptionRequest, dates, sims int) (float64, float64) {
+	T, r, sigma := base.TimeToExpiry, base.RiskFreeRate, base.Volatility
+	dt := T / float64(dates)
+	drift := (r - base.DividendYield - 0.5*sigma*sigma) * dt
+	vol := sigma * math.Sqrt(dt)
+	growth := math.Exp(-r * dt)
+
+	// paths[i][t] is the price at date t+1
+	paths := make([][]float64, sims)
+	for i := range paths {
+		paths[i] = make([]float64, dates)
+		src.fill(paths[i])
+		price := base.SpotPrice
+		for t, z := range paths[i] {
+			price *= math.Exp(drift + vol*z)
+			paths[i][t] = price
+		}
+	}
+
+	// cash[i] is path i's cash flow, discounted to the date being decided
+	cash := make([]float64, sims)
+	for i := range cash {
+		cash[i] = vanillaPayoff(base.Type, paths[i][dates-1], base.StrikePrice)
+	}
+	for t := dates - 2; t >= 0; t-- {
+		var xtx [3][3]float64
+		var xty [3]float64
+		itm := 0
+		for i := range cash {
+			cash[i] *= growth
+			if vanillaPayoff(base.Type, paths[i][t], base.StrikePrice) == 0 {
+				continue
+			}
+			itm++
+			x := paths[i][t] / base.StrikePrice
+			basis := [3]float64{1, x, x * x}
+			for a := range basis {
+				xty[a] += basis[a] * cash[i]
+				for b := range basis {
+					xtx[a][b] += basis[a] * basis[b]
+				}
+			}
+		}
+		beta, ok := solve3(xtx, xty)
+		if itm < 3 || !ok {
+			continue
+		}
+		for i := range cash {
+			exercise := vanillaPayoff(base.Type, paths[i][t], base.StrikePrice)
+			if exercise == 0 {
+				continue
+			}
+			x := paths[i][t] / base.StrikePrice
+			if exercise > beta[0]+beta[1]*x+beta[2]*x*x {
+				cash[i] = exercise
+			}
+		}
+	}
+
+	sum, sumSq := 0.0, 0.0
+	for i := range cash {
+		v := cash[i] * growth
+		sum += v
+		sumSq += v * v
+	}
+	price, stdError := meanAndError(sum, sumSq, sims)
+	// Exercising at once is an option too
+	return math.Max(price, vanillaPayoff(base.Type, base.SpotPrice, base.StrikePrice)), stdError
+}
+
+// solve3 solves a 3×3 system by Gaussian elimination with partial
+// pivoting, reporting false when it is singular
+func solve3(a [3][3]float64, b [3]float64) ([3]float64, bool) {
+	for col := 0; col < 3; col++ {
+		pivot := col
+		for row := col + 1; row < 3; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return b, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for row := col + 1; row < 3; row++ {
+			f := a[row][col] / a[col][col]
+			for k := col; k < 3; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+			b[row] -= f * b[col]
+		}
+	}
+	var x [3]float64
+	for row := 2; row >= 0; row-- {
+		x[row] = b[row]
+		for k := row + 1; k < 3; k++ {
+			x[row] -= a[row][k] * x[k]
+		}
+		x[row] /= a[row][row]
+	}
+	return x, true
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *FinanceServer) PricePathOption(ctx context.Context, req *pb.PathOptionRequest) (*pb.OptionPrice, error) {
+	if req.Base != nil && req.Style == pb.PathStyle_PATH_LOOKBACK && req.FloatingStrike && req.Base.StrikePrice == 0 {
+		// The strike is set by the path; validate as at the money
+		base := proto.Clone(req.Base).(*pb.OptionRequest)
+		base.StrikePrice = base.SpotPrice
+		req.Base = base
+	}
+	if err := validateOption(req.Base); err != nil {
+		return nil, err
+	}
+	base := req.Base
+	steps, sims := int(req.TimeSteps), int(base.NumSimulations)
+	if steps == 0 {
+		steps = defaultTimeSteps
+	}
+	if sims == 0 {
+		sims = defaultOptionSimulations
+	}
+	switch {
+	case req.Style < pb.PathStyle_PATH_ASIAN || req.Style > pb.PathStyle_PATH_LOOKBACK:
+		return nil, fmt.Errorf("unknown style %v", req.Style)
+	case steps < 1 || steps > maxTimeSteps:
+		return nil, fmt.Errorf("time_steps must be 1-%d", maxTimeSteps)
+	case sims*steps > maxPathDraws:
+		return nil, fmt.Errorf("num_simulations × time_steps must be at most %d", maxPathDraws)
+	case req.Style == pb.PathStyle_PATH_BARRIER && req.Barrier <= 0:
+		return nil, fmt.Errorf("a barrier option needs a positive barrier")
+	case req.Style == pb.PathStyle_PATH_BARRIER && (req.BarrierType < pb.BarrierType_BARRIER_UP_AND_OUT || req.BarrierType > pb.BarrierType_BARRIER_DOWN_AND_IN):
+		return nil, fmt.Errorf("unknown barrier type %v", req.BarrierType)
+	}
+	if err := checkSampling(base.Sampling, steps); err != nil {
+		return nil, err
+	}
+
+	dt := base.TimeToExpiry / float64(steps)
+	drift := (base.RiskFreeRate - base.DividendYield - 0.5*base.Volatility*base.Volatility) * dt
+	vol := base.Volatility * math.Sqrt(dt)
+	discount := math.Exp(-base.RiskFreeRate * base.TimeToExpiry)
+	// The control variate is the European option on the same path
+	a, err := s.runMC(ctx, s.newStreams(base.Sampling, steps), base.VarianceReduction, importanceShift(base), steps, sims, func(z []float64) (float64, float64) {
+		st := gbmPath(base.SpotPrice, drift, vol, z)
+		return discount * pathPayoff(req, st), discount * vanillaPayoff(base.Type, st.final, base.StrikePrice)
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.OptionPrice{
+		BlackScholes: blackScholes(base.Type, base.SpotPrice, base.StrikePrice, base.RiskFreeRate, base.DividendYield, base.Volatility, base.TimeToExpiry),
+	}
+	reductionReport(out, a, base.VarianceReduction, out.BlackScholes)
+
+	log.Printf("💰 Priced %v %v option over %d steps: MC=$%.4f ± $%.4f (%.1f× variance reduction)",
+		req.Style, base.Type, steps, out.Price, out.StdError, out.VarianceReduction)
+	return out, nil
+}
+
+func (s *FinanceServer) PriceAmericanOption(ctx context.Context, req *pb.AmericanOptionRequest) (*pb.OptionPrice, error) {
+	if err := validateOption(req.Base); err != nil {
+		return nil, err
+	}
+	if hasReduction(req.Base.VarianceReduction) {
+		return nil, fmt.Errorf("variance reduction is not available for American options")
+	}
+	dates, sims := int(req.ExerciseDates), int(req.Base.NumSimulations)
+	if dates == 0 {
+		dates = defaultExerciseDates
+	}
+	if sims == 0 {
+		sims = defaultOptionSimulations
+	}
+	switch {
+	case dates < 1 || dates > maxTimeSteps:
+		return nil, fmt.Errorf("exercise_dates must be 1-%d", maxTimeSteps)
+	case sims*dates > maxStoredPrices:
+		return nil, fmt.Errorf("num_simulations × exercise_dates must be at most %d", maxStoredPrices)
+	}
+	if err := checkSampling(req.Base.Sampling, dates); err != nil {
+		return nil, err
+	}
+
+	base := req.Base
+	price, stdError := longstaffSchwartz(s.newNormals(base.Sampling, dates), base, dates, sims)
+	european := blackScholes(base.Type, base.SpotPrice, base.StrikePrice, base.RiskFreeRate, base.DividendYield, base.Volatility, base.TimeToExpiry)
+	log.Printf("💰 Priced American %v option over %d dates: LSM=$%.4f ± $%.4f, European BS=$%.4f",
+		base.Type, dates, price, stdError, european)
+	return &pb.OptionPrice{
+		Price:           price,
+		BlackScholes:    european,
+		MonteCarlo:      price,
+		StdError:        stdError,
+		SimulationsUsed: int32(sims),
+	}, nil
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+const (
+	maxAssets = 100
+
+	// Projected gradient ascent on the mean-variance utility
+	optimizerSteps = 5000
+	// Weight of the squared shortfall below the target return, raised
+	// tenfold until the target is met
+	targetPenalty    = 100.0
+	maxTargetPenalty = 1e7
+	// z-score of the one-sided 95% quantile
+	z95 = 1.6448536269514722
+)
+
+// portfolio is a long-only mean-variance problem: maximize
+// w·μ - (a/2)·wᵀΣw with weights in [lo, hi] summing to one
+type portfolio struct {
+	symbols  []string
+	mu       []float64
+	cov      [][]float64
+	lo, hi   float64
+	target   float64 // 0 for none
+	aversion float64 // a above
+}
+
+// portfolioFromProto checks a request and builds the covariance matrix
+// from the assets' correlations (see correlationMatrix)
+func portfolioFromProto(req *pb.PortfolioRequest) (*portfolio, error) {
+	n := len(req.Assets)
+	if n == 0 || n > maxAssets {
+		return nil, fmt.Errorf("needs 1-%d assets", maxAssets)
+	}
+	p := &portfolio{lo: req.MinWeight, hi: req.MaxWeight, target: req.TargetReturn}
+	if p.hi == 0 {
+		p.hi = 1
+	}
+	held := n
+	if req.Cardinality != 0 {
+		held = int(req.Cardinality)
+	}
+	switch {
+	case held < 1 || held > n:
+		return nil, fmt.Errorf("cardinality must be 1-%d", n)
+	case p.lo < 0 || p.hi > 1 || p.lo > p.hi:
+		return nil, fmt.Errorf("weights must satisfy 0 <= min_weight <= max_weight <= 1")
+	case float64(held)*p.lo > 1+1e-9 || float64(held)*p.hi < 1-1e-9:
+		return nil, fmt.Errorf("no weights of %d assets within [%.4g, %.4g] sum to one", held, p.lo, p.hi)
+	case req.RiskTolerance < 0 || req.RiskTolerance > 1:
+		return nil, fmt.Errorf("risk_tolerance must be 0-1")
+	}
+	// Tolerance 1 is a tenth as averse to variance as tolerance 0
+	p.aversion = 1 + 9*(1-req.RiskTolerance)
+
+	names := make([]string, n)
+	rows := make([][]float64, n)
+	for i, a := range req.Assets {
+		names[i], rows[i] = a.Symbol, a.Correlations
+	}
+	symbols, err := checkSymbols(names)
+	if err != nil {
+		return nil, err
+	}
+	for i, a := range req.Assets {
+		if a.Volatility <= 0 {
+			return nil, fmt.Errorf("asset %s needs a positive volatility", symbols[i])
+		}
+		p.mu = append(p.mu, a.ExpectedReturn)
+	}
+	p.symbols = symbols
+	corr, _, err := correlationMatrix(symbols, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	p.cov = make([][]float64, n)
+	for i, a := range req.Assets {
+		p.cov[i] = make([]float64, n)
+		for j, b := range req.Assets {
+			p.cov[i][j] = corr[i][j] * a.Volatility * b.Volatility
+		}
+	}
+	return p, nil
+}
+
+// subset is the problem over the assets at indices
+func (p *portfolio) subset(indices []int) *portfolio {
+	sub := &portfolio{lo: p.lo, hi: p.hi, target: p.target, aversion: p.aversion}
+	for _, i := range indices {
+		sub.symbols = append(sub.symbols, p.symbols[i])
+		sub.mu = append(sub.mu, p.mu[i])
+		row := make([]float64, 0, len(indices))
+		for _, j := range indices {
+			row = append(row, p.cov[i][j])
+		}
+		sub.cov = append(sub.cov, row)
+	}
+	return sub
+}
+
+// stats is a portfolio's expected annual return and volatility
+func (p *portfolio) stats(w []float64) (float64, float64) {
+	ret, variance := 0.0, 0.0
+	for i := range w {
+		ret += w[i] * p.mu[i]
+		for j := range w {
+			variance += w[i] * p.cov[i][j] * w[j]
+		}
+	}
+	return ret, math.Sqrt(math.Max(variance, 0))
+}
+
+// bestReturn is the highest return the weight limits allow: the most
+// each asset may take, best first
+func (p *portfolio) bestReturn() float64 {
+	order := make([]int, len(p.mu))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return p.mu[order[a]] > p.mu[order[b]] })
+	left := 1 - p.lo*float64(len(p.mu))
+	ret := 0.0
+	for _, i := range order {
+		extra := math.Min(p.hi-p.lo, math.Max(left, 0))
+		left -= extra
+		ret += (p.lo + extra) * p.mu[i]
+	}
+	return ret
+}
+
+// optimize starts from equal weights and, with a target return, solves
+// again under a stiffer penalty until the target is met
+func (p *portfolio) optimize() []float64 {
+	n := len(p.mu)
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = 1 / float64(n)
+	}
+	w = projectWeights(w, p.lo, p.hi)
+	target := math.Min(p.target, p.bestReturn())
+	for penalty := targetPenalty; ; penalty *= 10 {
+		w = p.solve(w, penalty)
+		if ret, _ := p.stats(w); p.target == 0 || ret >= target-1e-6 || penalty >= maxTargetPenalty {
+			return w
+		}
+	}
+}
+
+// solve climbs the penalized utility from w, projecting back onto the
+// allowed weights after every step. The utility is concave, so this
+// converges on the optimum.
+func (p *portfolio) solve(w []float64, penalty float64) []float64 {
+	n := len(p.mu)
+
+	// A step of 1/L, L bounding the gradient's Lipschitz constant
+	lipschitz := 1e-12
+	muNorm := 0.0
+	for i := range p.cov {
+		muNorm += p.mu[i] * p.mu[i]
+		for j := range p.cov[i] {
+			lipschitz += p.aversion * p.cov[i][j] * p.cov[i][j]
+		}
+	}
+	lipschitz = math.Sqrt(lipschitz)
+	if p.target != 0 {
+		lipschitz += 2 * penalty * muNorm
+	}
+	step := 1 / lipschitz
+
+	grad := make([]float64, n)
+	for iter := 0; iter < optimizerSteps; iter++ {
+		ret, _ := p.stats(w)
+		for i := range grad {
+			grad[i] = p.mu[i]
+			for j := range w {
+				grad[i] -= p.aversion * p.cov[i][j] * w[j]
+			}
+			if p.target != 0 && ret < p.target {
+				grad[i] += 2 * penalty * (p.target - ret) * p.mu[i]
+			}
+		}
+		next := make([]float64, n)
+		for i := range w {
+			next[i] = w[i] + step*grad[i]
+		}
+		next = projectWeights(next, p.lo, p.hi)
+		moved := 0.0
+		for i := range w {
+			moved = math.Max(moved, math.Abs(next[i]-w[i]))
+		}
+		w = next
+		if moved < 1e-12 {
+			break
+		}
+	}
+	return w
+}
+
+// projectWeights finds the nearest weights in [lo, hi] that sum to one,
+// clamping v - τ for the τ found by bisection
+func projectWeights(v []float64, lo, hi float64) []float64 {
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, x := range v {
+		low, high = math.Min(low, x-hi), math.Max(high, x-lo)
+	}
+	clamped := func(tau float64) ([]float64, float64) {
+		w := make([]float64, len(v))
+		sum := 0.0
+		for i, x := range v {
+			w[i] = math.Min(math.Max(x-tau, lo), hi)
+			sum += w[i]
+		}
+		return w, sum
+	}
+	for iter := 0; iter < 100; iter++ {
+		tau := (low + high) / 2
+		if _, sum := clamped(tau); sum > 1 {
+			low = tau
+		} else {
+			high = tau
+		}
+	}
+	w, _ := clamped((low + high) / 2)
+	return w
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+// OptimizePortfolio allocates across the assets by mean-variance utility,
+// steered up to the target return where one is given. With a cardinality
+// it first chooses which assets to hold by solving a QUBO, then weighs
+// only those.
+func (s *FinanceServer) OptimizePortfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.OptimalPortfolio, error) {
+	p, err := portfolioFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	layers := int(req.QaoaLayers)
+	if layers == 0 {
+		layers = 1
+	}
+	if layers < 1 || layers > maxQAOALayers {
+		return nil, fmt.Errorf("qaoa_layers must be 1-%d", maxQAOALayers)
+	}
+
+	out := &pb.OptimalPortfolio{}
+	held := p
+	var chosen []int
+	if k := int(req.Cardinality); k > 0 {
+		sel, err := s.selectAssets(ctx, p, k, req.Solver, layers)
+		if err != nil {
+			return nil, err
+		}
+		chosen = selectionIndices(sel.bits)
+		held = p.subset(chosen)
+		out.Selected = held.symbols
+		out.SolverUsed = sel.solver
+		out.QuboEnergy = sel.energy
+		out.SelectionProbability = sel.probability
+		out.CircuitEvaluations = int32(sel.evaluations)
+	}
+	w := held.optimize()
+	ret, vol := held.stats(w)
+
+	out.ExpectedReturn = ret
+	out.Volatility = vol
+	// One year at 95%, as a fraction of the portfolio's value
+	out.Var_95 = math.Max(0, z95*vol-ret)
+	if vol > 0 {
+		// Against a zero risk-free rate; the request has none
+		out.SharpeRatio = ret / vol
+	}
+	weights := w
+	if chosen != nil {
+		weights = make([]float64, len(p.symbols))
+		for i, idx := range chosen {
+			weights[idx] = w[i]
+		}
+	}
+	for i, symbol := range p.symbols {
+		out.Allocations = append(out.Allocations, &pb.AssetAllocation{Symbol: symbol, Weight: weights[i]})
+	}
+	if best := held.bestReturn(); held.target > best {
+		log.Printf("💼 Target return %.2f%% is out of reach; the most is %.2f%%", held.target*100, best*100)
+	}
+	log.Printf("💼 Optimized %d of %d assets: return %.2f%%, volatility %.2f%%", len(held.symbols), len(p.symbols), ret*100, vol*100)
+	return out, nil
+}
+
+// selectAssets picks k assets with the solver asked for. Left to choose,
+// it uses QAOA when the problem fits on the engine and anneals when it
+// does not or the engine fails.
+func (s *FinanceServer) selectAssets(ctx context.Context, p *portfolio, k int, solver pb.PortfolioSolver, layers int) (selection, error) {
+	u := p.selectionQUBO(k)
+	n := len(p.symbols)
+	switch solver {
+	case pb.PortfolioSolver_SOLVER_ANNEALING:
+		return u.anneal(k, s.newRNG()), nil
+	case pb.PortfolioSolver_SOLVER_QAOA:
+		if n > maxQAOAQubits {
+			return selection{}, fmt.Errorf("QAOA takes at most %d assets, not %d", maxQAOAQubits, n)
+		}
+		return s.qaoa(ctx, u, k, layers)
+	case pb.PortfolioSolver_SOLVER_AUTO:
+		if n <= maxQAOAQubits {
+			sel, err := s.qaoa(ctx, u, k, layers)
+			if err == nil {
+				return sel, nil
+			}
+			log.Printf("⚠️  QAOA failed, annealing instead: %v", err)
+		}
+		return u.anneal(k, s.newRNG()), nil
+	}
+	return selection{}, fmt.Errorf("unknown solver %v", solver)
+}
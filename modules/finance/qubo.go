@@ -0,0 +1,356 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+	engine "github.com/perclft/QubitEngine/modules/finance/generated/engine"
+)
+
+const (
+	// maxQAOAQubits bounds the state vectors QAOA reads back, one qubit
+	// per asset
+	maxQAOAQubits = 12
+	maxQAOALayers = 4
+	// maxQAOAEvaluations bounds engine runs while tuning the angles
+	maxQAOAEvaluations = 200
+	// qaoaCandidates is how many of the likeliest feasible selections
+	// are compared by energy
+	qaoaCandidates = 5
+	// The angles are tuned on the mean energy of the likeliest outcomes
+	// holding this share of the probability (CVaR), which cares only
+	// that good selections are likely, not that bad ones are rare
+	qaoaCVaRAlpha = 0.2
+
+	annealingSweeps = 2000
+)
+
+// qubo minimizes xᵀQx over bits x, Q upper triangular. Holding assets
+// with equal weights 1/k, mean-variance selection is
+//
+//	(a/2k²)·xᵀΣx - (1/k)·μᵀx + A·(Σx - k)²
+//
+// The penalty A exceeds what flipping any one bit can change the
+// objective by, so every selection of the wrong size is beaten by one
+// of the right size, but no more: a larger A would drown the objective
+// once QAOA scales the coefficients.
+type qubo struct {
+	q      [][]float64
+	offset float64
+}
+
+func (p *portfolio) selectionQUBO(k int) *qubo {
+	n := len(p.mu)
+	kf := float64(k)
+	penalty := 0.0
+	for i := range p.mu {
+		flip := math.Abs(p.mu[i]) / kf
+		for j := range p.cov[i] {
+			flip += p.aversion * math.Abs(p.cov[i][j]) / (kf * kf)
+		}
+		penalty = math.Max(penalty, flip)
+	}
+	penalty = 1.5*penalty + 1e-9
+
+	u := &qubo{q: make([][]float64, n), offset: penalty * kf * kf}
+	for i := range u.q {
+		u.q[i] = make([]float64, n)
+		u.q[i][i] = p.aversion/2*p.cov[i][i]/(kf*kf) - p.mu[i]/kf + penalty*(1-2*kf)
+		for j := i + 1; j < n; j++ {
+			u.q[i][j] = p.aversion*p.cov[i][j]/(kf*kf) + 2*penalty
+		}
+	}
+	return u
+}
+
+// energy of the selection whose bit i holds asset i
+func (u *qubo) energy(x uint64) float64 {
+	e := u.offset
+	for i := range u.q {
+		if x&(1<<i) == 0 {
+			continue
+		}
+		for j := i; j < len(u.q); j++ {
+			if x&(1<<j) != 0 {
+				e += u.q[i][j]
+			}
+		}
+	}
+	return e
+}
+
+// ising rewrites the QUBO over spins z = 1 - 2x as Σh·z + ΣJ·z·z, scaled
+// so the largest coefficient is one; the constant is dropped
+func (u *qubo) ising() ([]float64, [][]float64) {
+	n := len(u.q)
+	h := make([]float64, n)
+	jz := make([][]float64, n)
+	for i := range jz {
+		jz[i] = make([]float64, n)
+	}
+	for i := range u.q {
+		h[i] -= u.q[i][i] / 2
+		for j := i + 1; j < n; j++ {
+			h[i] -= u.q[i][j] / 4
+			h[j] -= u.q[i][j] / 4
+			jz[i][j] = u.q[i][j] / 4
+		}
+	}
+	scale := 0.0
+	for i := range h {
+		scale = math.Max(scale, math.Abs(h[i]))
+		for j := range jz[i] {
+			scale = math.Max(scale, math.Abs(jz[i][j]))
+		}
+	}
+	if scale > 0 {
+		for i := range h {
+			h[i] /= scale
+			for j := range jz[i] {
+				jz[i][j] /= scale
+			}
+		}
+	}
+	return h, jz
+}
+
+func selectionIndices(x uint64) []int {
+	var out []int
+	for i := 0; x != 0; i++ {
+		if x&1 != 0 {
+			out = append(out, i)
+		}
+		x >>= 1
+	}
+	return out
+}
+
+// selection is a solver's answer
+type selection struct {
+	bits        uint64
+	energy      float64
+	probability float64 // QAOA only
+	evaluations int     // QAOA only
+	solver      pb.PortfolioSolver
+}
+
+// ------------------------------------------------------------------
+// Simulated annealing
+// ------------------------------------------------------------------
+
+// anneal searches k-asset selections by swapping one held asset for one
+// not held, accepting worse swaps with a probability that cools to zero
+func (u *qubo) anneal(k int, rng *rand.Rand) selection {
+	n := len(u.q)
+	perm := rng.Perm(n)
+	var x uint64
+	for _, i := range perm[:k] {
+		x |= 1 << i
+	}
+	best := selection{bits: x, energy: u.energy(x), solver: pb.PortfolioSolver_SOLVER_ANNEALING}
+	if k == n {
+		return best
+	}
+
+	// Start hot enough to take a typical uphill swap half the time
+	e := best.energy
+	uphill := 0.0
+	for t := 0; t < 32; t++ {
+		uphill += math.Abs(u.energy(swapRandom(x, n, rng)) - e)
+	}
+	t0 := math.Max(uphill/32/math.Ln2, 1e-12)
+	steps := annealingSweeps * n
+	for step := 0; step < steps; step++ {
+		temp := t0 * math.Pow(1e-4, float64(step)/float64(steps))
+		y := swapRandom(x, n, rng)
+		ey := u.energy(y)
+		if ey <= e || rng.Float64() < math.Exp((e-ey)/temp) {
+			x, e = y, ey
+			if e < best.energy {
+				best.bits, best.energy = x, e
+			}
+		}
+	}
+	return best
+}
+
+// swapRandom drops one held asset and takes up one not held
+func swapRandom(x uint64, n int, rng *rand.Rand) uint64 {
+	held := bits.OnesCount64(x)
+	out, in := rng.Intn(held), rng.Intn(n-held)
+	for i := 0; i < n; i++ {
+		if x&(1<<i) != 0 {
+			if out == 0 {
+				x &^= 1 << i
+			}
+			out--
+		} else {
+			if in == 0 {
+				x |= 1 << i
+			}
+			in--
+		}
+	}
+	return x
+}
+
+// ------------------------------------------------------------------
+// QAOA
+// ------------------------------------------------------------------
+
+// qaoaCircuit prepares |+⟩ on every qubit, then alternates the cost
+// layer e^{-iγH} and the mixer e^{-iβΣX} once per (γ, β)
+func qaoaCircuit(h []float64, jz [][]float64, gammas, betas []float64) []*engine.GateOperation {
+	n := len(h)
+	gate := func(t engine.GateOperation_GateType, target int, angle float64) *engine.GateOperation {
+		return &engine.GateOperation{Type: t, TargetQubit: uint32(target), Angle: angle}
+	}
+	var ops []*engine.GateOperation
+	for i := 0; i < n; i++ {
+		ops = append(ops, gate(engine.GateOperation_HADAMARD, i, 0))
+	}
+	for layer := range gammas {
+		gamma, beta := gammas[layer], betas[layer]
+		for i := 0; i < n; i++ {
+			if h[i] != 0 {
+				ops = append(ops, gate(engine.GateOperation_ROTATION_Z, i, 2*gamma*h[i]))
+			}
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				if jz[i][j] == 0 {
+					continue
+				}
+				cnot := &engine.GateOperation{Type: engine.GateOperation_CNOT, ControlQubit: uint32(i), TargetQubit: uint32(j)}
+				ops = append(ops, cnot, gate(engine.GateOperation_ROTATION_Z, j, 2*gamma*jz[i][j]), cnot)
+			}
+		}
+		// RX(2β) as H·RZ(2β)·H
+		for i := 0; i < n; i++ {
+			ops = append(ops,
+				gate(engine.GateOperation_HADAMARD, i, 0),
+				gate(engine.GateOperation_ROTATION_Z, i, 2*beta),
+				gate(engine.GateOperation_HADAMARD, i, 0))
+		}
+	}
+	return ops
+}
+
+// qaoa tunes p layers of angles on the engine to minimize the energy's
+// CVaR, then takes the lowest-energy of the likeliest k-asset selections
+// in the final state
+func (s *FinanceServer) qaoa(ctx context.Context, u *qubo, k, layers int) (selection, error) {
+	n := len(u.q)
+	if s.engineClient == nil {
+		return selection{}, fmt.Errorf("no quantum engine configured")
+	}
+	energies := make([]float64, 1<<n)
+	for x := range energies {
+		energies[x] = u.energy(uint64(x))
+	}
+	h, jz := u.ising()
+
+	evaluations := 0
+	run := func(gammas, betas []float64) ([]float64, error) {
+		evaluations++
+		resp, err := s.engineClient.RunCircuit(ctx, &engine.CircuitRequest{
+			NumQubits:  int32(n),
+			Operations: qaoaCircuit(h, jz, gammas, betas),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("engine error: %v", err)
+		}
+		if len(resp.StateVector) != len(energies) {
+			return nil, fmt.Errorf("engine returned %d amplitudes, not %d", len(resp.StateVector), len(energies))
+		}
+		probs := make([]float64, len(energies))
+		for i, c := range resp.StateVector {
+			probs[i] = c.Real*c.Real + c.Imag*c.Imag
+		}
+		return probs, nil
+	}
+	byEnergy := make([]int, len(energies))
+	for x := range byEnergy {
+		byEnergy[x] = x
+	}
+	sort.Slice(byEnergy, func(a, b int) bool { return energies[byEnergy[a]] < energies[byEnergy[b]] })
+	expected := func(angles []float64) (float64, error) {
+		probs, err := run(angles[:layers], angles[layers:])
+		if err != nil {
+			return 0, err
+		}
+		e, mass := 0.0, 0.0
+		for _, x := range byEnergy {
+			p := math.Min(probs[x], qaoaCVaRAlpha-mass)
+			e += p * energies[x]
+			if mass += p; mass >= qaoaCVaRAlpha {
+				break
+			}
+		}
+		return e / qaoaCVaRAlpha, nil
+	}
+
+	// Grid search the first layer, then refine every angle in turn with
+	// a shrinking step
+	angles := make([]float64, 2*layers)
+	best := math.Inf(1)
+	for gi := 1; gi <= 8; gi++ {
+		for bi := 1; bi <= 6; bi++ {
+			trial := make([]float64, 2*layers)
+			for l := 0; l < layers; l++ {
+				trial[l] = float64(gi) * math.Pi / 8 * float64(l+1) / float64(layers)
+				trial[layers+l] = float64(bi) * math.Pi / 12 * float64(layers-l) / float64(layers)
+			}
+			e, err := expected(trial)
+			if err != nil {
+				return selection{}, err
+			}
+			if e < best {
+				best, angles = e, trial
+			}
+		}
+	}
+	for step := math.Pi / 16; step > 1e-3 && evaluations < maxQAOAEvaluations; step /= 2 {
+		for i := range angles {
+			for _, dir := range []float64{1, -1} {
+				if evaluations >= maxQAOAEvaluations {
+					break
+				}
+				trial := append([]float64(nil), angles...)
+				trial[i] += dir * step
+				e, err := expected(trial)
+				if err != nil {
+					return selection{}, err
+				}
+				if e < best {
+					best, angles = e, trial
+					break
+				}
+			}
+		}
+	}
+
+	probs, err := run(angles[:layers], angles[layers:])
+	if err != nil {
+		return selection{}, err
+	}
+	var feasible []uint64
+	for x := range probs {
+		if bits.OnesCount64(uint64(x)) == k {
+			feasible = append(feasible, uint64(x))
+		}
+	}
+	sort.Slice(feasible, func(a, b int) bool { return probs[feasible[a]] > probs[feasible[b]] })
+	out := selection{energy: math.Inf(1), evaluations: evaluations, solver: pb.PortfolioSolver_SOLVER_QAOA}
+	for _, x := range feasible[:min(qaoaCandidates, len(feasible))] {
+		if energies[x] < out.energy {
+			out.bits, out.energy, out.probability = x, energies[x], probs[x]
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxScenarios = 100
+	maxHoldings  = 1000
+)
+
+// standardScenarios are the built-in stress scenarios: the Basel
+// interest rate shocks' parallel moves and stylised replays of past
+// crises, each as one instantaneous shock
+var standardScenarios = []*pb.StressScenario{
+	{
+		Name:        "parallel_up_200bp",
+		Description: "Rates up 200bp in parallel (Basel IRRBB, USD)",
+		RateShift:   0.02,
+	},
+	{
+		Name:        "parallel_down_200bp",
+		Description: "Rates down 200bp in parallel (Basel IRRBB, USD)",
+		RateShift:   -0.02,
+	},
+	{
+		Name:                 "equity_crash",
+		Description:          "Equities down 30% with volatility doubled",
+		EquityShock:          -0.30,
+		VolatilityMultiplier: 2,
+	},
+	{
+		Name:                 "black_monday_1987",
+		Description:          "October 1987: equities down 22.6% in a day, volatility tripled",
+		EquityShock:          -0.226,
+		VolatilityMultiplier: 3,
+		RateShift:            -0.005,
+	},
+	{
+		Name:                 "financial_crisis_2008",
+		Description:          "Autumn 2008: equities down 40%, volatility up 2.5 times, rates down 200bp",
+		EquityShock:          -0.40,
+		VolatilityMultiplier: 2.5,
+		RateShift:            -0.02,
+	},
+	{
+		Name:                 "covid_2020",
+		Description:          "March 2020: equities down 34%, volatility tripled, rates down 150bp",
+		EquityShock:          -0.34,
+		VolatilityMultiplier: 3,
+		RateShift:            -0.015,
+	},
+	{
+		Name:                 "rate_shock_2022",
+		Description:          "2022 tightening: rates up 250bp, equities down 25%, volatility up half",
+		EquityShock:          -0.25,
+		VolatilityMultiplier: 1.5,
+		RateShift:            0.025,
+	},
+	{
+		Name:                 "stagflation",
+		Description:          "Stagflation: rates up 300bp, equities down 20%, volatility up half",
+		EquityShock:          -0.20,
+		VolatilityMultiplier: 1.5,
+		RateShift:            0.03,
+	},
+}
+
+// standardScenario finds a built-in scenario by name
+func standardScenario(name string) (*pb.StressScenario, error) {
+	var names []string
+	for _, sc := range standardScenarios {
+		if sc.Name == name {
+			return sc, nil
+		}
+		names = append(names, sc.Name)
+	}
+	return nil, fmt.Errorf("no standard scenario %q; choose from %s", name, strings.Join(names, ", "))
+}
+
+// checkScenario checks a shock against the symbols held
+func checkScenario(sc *pb.StressScenario, held map[string]bool) error {
+	switch {
+	case sc.EquityShock < -1:
+		return fmt.Errorf("scenario %s cannot take equities below zero", sc.Name)
+	case sc.VolatilityMultiplier < 0:
+		return fmt.Errorf("scenario %s cannot have a negative volatility_multiplier", sc.Name)
+	}
+	for symbol, shock := range sc.EquityShocks {
+		switch {
+		case !held[symbol]:
+			return fmt.Errorf("scenario %s shocks %s, which is not held", sc.Name, symbol)
+		case shock < -1:
+			return fmt.Errorf("scenario %s cannot take %s below zero", sc.Name, symbol)
+		}
+	}
+	return nil
+}
+
+// equityMove is the scenario's relative move in symbol
+func equityMove(sc *pb.StressScenario, symbol string) float64 {
+	if move, ok := sc.EquityShocks[symbol]; ok {
+		return move
+	}
+	return sc.EquityShock
+}
+
+func volMultiplier(sc *pb.StressScenario) float64 {
+	if sc.VolatilityMultiplier == 0 {
+		return 1
+	}
+	return sc.VolatilityMultiplier
+}
+
+// combineScenarios applies every scenario at once: equity moves compound,
+// rate shifts add and volatility multipliers multiply
+func combineScenarios(scs []*pb.StressScenario, held map[string]bool) *pb.StressScenario {
+	names := make([]string, len(scs))
+	out := &pb.StressScenario{Name: "combined", VolatilityMultiplier: 1}
+	growth := 1.0
+	for i, sc := range scs {
+		names[i] = sc.Name
+		growth *= 1 + sc.EquityShock
+		out.RateShift += sc.RateShift
+		out.VolatilityMultiplier *= volMultiplier(sc)
+		if len(sc.EquityShocks) > 0 {
+			out.EquityShocks = make(map[string]float64)
+		}
+	}
+	out.EquityShock = growth - 1
+	out.Description = "All at once: " + strings.Join(names, ", ")
+	if out.EquityShocks != nil {
+		for symbol := range held {
+			growth := 1.0
+			for _, sc := range scs {
+				growth *= 1 + equityMove(sc, symbol)
+			}
+			out.EquityShocks[symbol] = growth - 1
+		}
+	}
+	return out
+}
+
+// stressBook is the holdings to revalue, each named for the results
+type stressBook struct {
+	positions []*pb.Position
+	options   []*pb.OptionHolding
+	rates     []*pb.RateHolding
+	names     []string // Positions', then options', then rate holdings'
+	held      map[string]bool
+}
+
+func stressBookFromProto(req *pb.ScenarioRequest) (*stressBook, error) {
+	n := len(req.Positions) + len(req.Options) + len(req.RateHoldings)
+	if n == 0 || n > maxHoldings {
+		return nil, fmt.Errorf("needs 1-%d holdings", maxHoldings)
+	}
+	b := &stressBook{options: req.Options, rates: req.RateHoldings, held: make(map[string]bool)}
+	symbols := make([]string, len(req.Positions))
+	for i, p := range req.Positions {
+		symbols[i] = p.Symbol
+	}
+	symbols, err := checkSymbols(symbols)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range req.Positions {
+		b.positions = append(b.positions, &pb.Position{Symbol: symbols[i], Value: p.Value})
+		b.held[symbols[i]] = true
+	}
+	b.names = append(b.names, symbols...)
+
+	for _, o := range req.Options {
+		symbol := strings.TrimSpace(o.Symbol)
+		if symbol == "" {
+			return nil, fmt.Errorf("every option needs its underlying's symbol")
+		}
+		if err := validateOption(o.Option); err != nil {
+			return nil, fmt.Errorf("option on %s: %v", symbol, err)
+		}
+		kind := "call"
+		if o.Option.Type == pb.OptionType_OPTION_PUT {
+			kind = "put"
+		}
+		b.held[symbol] = true
+		b.names = append(b.names, fmt.Sprintf("%s %g %s", symbol, o.Option.StrikePrice, kind))
+	}
+
+	rateNames := make([]string, len(req.RateHoldings))
+	for i, h := range req.RateHoldings {
+		rateNames[i] = h.Name
+	}
+	rateNames, err = checkNames("rate holding", "name", rateNames)
+	if err != nil {
+		return nil, err
+	}
+	b.names = append(b.names, rateNames...)
+	return b, nil
+}
+
+// revalue values every holding under sc, or as they stand when sc is nil
+func (b *stressBook) revalue(sc *pb.StressScenario) []float64 {
+	if sc == nil {
+		sc = &pb.StressScenario{}
+	}
+	out := make([]float64, 0, len(b.names))
+	for _, p := range b.positions {
+		out = append(out, p.Value*(1+equityMove(sc, p.Symbol)))
+	}
+	for _, o := range b.options {
+		opt := o.Option
+		spot := opt.SpotPrice * (1 + equityMove(sc, strings.TrimSpace(o.Symbol)))
+		vol := opt.Volatility * volMultiplier(sc)
+		price := 0.0
+		switch {
+		case spot <= 0:
+			// Worthless underlying: the put pays its discounted strike
+			if opt.Type == pb.OptionType_OPTION_PUT {
+				price = opt.StrikePrice * math.Exp(-(opt.RiskFreeRate+sc.RateShift)*opt.TimeToExpiry)
+			}
+		default:
+			price = blackScholes(opt.Type, spot, opt.StrikePrice, opt.RiskFreeRate+sc.RateShift, opt.DividendYield, vol, opt.TimeToExpiry)
+		}
+		out = append(out, o.Quantity*price)
+	}
+	for _, h := range b.rates {
+		dy := sc.RateShift
+		out = append(out, h.Value*(1-h.Duration*dy+0.5*h.Convexity*dy*dy))
+	}
+	return out
+}
+
+// run is sc's profit and loss against the unshocked values
+func (b *stressBook) run(sc *pb.StressScenario, base []float64, total float64) *pb.ScenarioResult {
+	shocked := b.revalue(sc)
+	out := &pb.ScenarioResult{Scenario: sc}
+	for i, name := range b.names {
+		pnl := shocked[i] - base[i]
+		out.Pnl += pnl
+		out.Holdings = append(out.Holdings, &pb.HoldingPnL{Name: name, Value: base[i], ShockedValue: shocked[i], Pnl: pnl})
+	}
+	if total > 0 {
+		out.RelativePnl = out.Pnl / total
+	}
+	return out
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *FinanceServer) RunScenarios(ctx context.Context, req *pb.ScenarioRequest) (*pb.ScenarioReport, error) {
+	b, err := stressBookFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Scenarios)+len(req.StandardScenarios) > maxScenarios {
+		return nil, fmt.Errorf("at most %d scenarios", maxScenarios)
+	}
+
+	asked := len(req.Scenarios)+len(req.StandardScenarios) > 0
+	scenarios := make([]*pb.StressScenario, 0, len(req.Scenarios)+len(req.StandardScenarios))
+	for _, sc := range req.Scenarios {
+		scenarios = append(scenarios, proto.Clone(sc).(*pb.StressScenario))
+	}
+	for _, name := range req.StandardScenarios {
+		sc, err := standardScenario(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, proto.Clone(sc).(*pb.StressScenario))
+	}
+	if !asked {
+		for _, sc := range standardScenarios {
+			scenarios = append(scenarios, proto.Clone(sc).(*pb.StressScenario))
+		}
+	}
+	names := make([]string, len(scenarios))
+	for i, sc := range scenarios {
+		names[i] = sc.Name
+	}
+	if names, err = checkNames("scenario", "name", names); err != nil {
+		return nil, err
+	}
+	for i, sc := range scenarios {
+		sc.Name = names[i]
+		if err := checkScenario(sc, b.held); err != nil {
+			return nil, err
+		}
+	}
+
+	base := b.revalue(nil)
+	out := &pb.ScenarioReport{}
+	for _, v := range base {
+		out.PortfolioValue += v
+	}
+	worst := math.Inf(1)
+	for _, sc := range scenarios {
+		result := b.run(sc, base, out.PortfolioValue)
+		out.Scenarios = append(out.Scenarios, result)
+		if result.Pnl < worst {
+			worst, out.WorstScenario = result.Pnl, sc.Name
+		}
+	}
+	if asked {
+		out.Combined = b.run(combineScenarios(scenarios, b.held), base, out.PortfolioValue)
+	}
+
+	log.Printf("📊 Stressed %d holdings worth $%.2f under %d scenarios: worst %s at $%.2f",
+		len(b.names), out.PortfolioValue, len(scenarios), out.WorstScenario, worst)
+	return out, nil
+}
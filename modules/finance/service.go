@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+const (
+	tradingDays = 252
+
+	defaultOptionSimulations = 100000
+	maxOptionSimulations     = 10000000
+	defaultVaRSimulations    = 10000
+	maxVaRSimulations        = 10000000 // Every simulated return is held and sorted
+	maxHoldingDays           = 3 * tradingDays
+	maxPathDays              = 10 * tradingDays
+	maxPaths                 = 10000
+)
+
+func validateOption(req *pb.OptionRequest) error {
+	switch {
+	case req == nil:
+		return fmt.Errorf("option is required")
+	case req.Type != pb.OptionType_OPTION_CALL && req.Type != pb.OptionType_OPTION_PUT:
+		return fmt.Errorf("unknown option type %v", req.Type)
+	case req.SpotPrice <= 0 || req.StrikePrice <= 0:
+		return fmt.Errorf("spot and strike prices must be positive")
+	case req.Volatility <= 0:
+		return fmt.Errorf("volatility must be positive")
+	case req.TimeToExpiry <= 0:
+		return fmt.Errorf("time_to_expiry must be positive")
+	case req.DividendYield < 0:
+		return fmt.Errorf("dividend_yield cannot be negative")
+	case req.NumSimulations < 0 || req.NumSimulations > maxOptionSimulations:
+		return fmt.Errorf("num_simulations must be 0-%d", maxOptionSimulations)
+	}
+	return checkSampling(req.Sampling, 1)
+}
+
+// checkVaRLimits checks a VaR simulation's confidence, holding period
+// and size, days and sims defaulted
+func checkVaRLimits(confidence float64, days, sims, positions int) error {
+	switch {
+	case confidence <= 0 || confidence >= 1:
+		return fmt.Errorf("confidence must be between 0 and 1, e.g. 0.95")
+	case days < 0 || days > maxHoldingDays:
+		return fmt.Errorf("holding_period must be 1-%d days", maxHoldingDays)
+	case sims < 0 || sims > maxVaRSimulations:
+		return fmt.Errorf("simulations must be 0-%d", maxVaRSimulations)
+	case sims*positions > maxPathDraws:
+		return fmt.Errorf("simulations × positions must be at most %d", maxPathDraws)
+	case (1-confidence)*float64(sims) < 1:
+		return fmt.Errorf("%d simulations leave no losses beyond %.4g confidence", sims, confidence)
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------
+// RPCs
+// ------------------------------------------------------------------
+
+func (s *FinanceServer) PriceEuropeanOption(ctx context.Context, req *pb.OptionRequest) (*pb.OptionPrice, error) {
+	if err := validateOption(req); err != nil {
+		return nil, err
+	}
+	return s.monteCarloPrice(ctx, req, int(req.NumSimulations))
+}
+
+func (s *FinanceServer) CalculateVaR(ctx context.Context, req *pb.VaRRequest) (*pb.VaRResult, error) {
+	days, sims := int(req.HoldingPeriod), int(req.Simulations)
+	if days == 0 {
+		days = 1
+	}
+	if sims == 0 {
+		sims = defaultVaRSimulations
+	}
+	value, vol := req.PortfolioValue, req.Volatility
+	var book *positions
+	if len(req.Positions) > 0 {
+		if value != 0 || vol != 0 {
+			return nil, fmt.Errorf("give positions or portfolio_value and volatility, not both")
+		}
+		var err error
+		if book, err = positionsFromProto(req.Positions); err != nil {
+			return nil, err
+		}
+		if value = book.value(); value <= 0 {
+			return nil, fmt.Errorf("positions must sum to a positive value")
+		}
+		vol = book.volatility()
+	}
+	switch {
+	case value <= 0:
+		return nil, fmt.Errorf("portfolio_value must be positive")
+	case vol <= 0:
+		return nil, fmt.Errorf("volatility must be positive")
+	}
+	if err := checkVaRLimits(req.Confidence, days, sims, len(req.Positions)); err != nil {
+		return nil, err
+	}
+	if err := checkSampling(req.Sampling, len(req.Positions)); err != nil {
+		return nil, err
+	}
+
+	// A sum of independent daily normal returns is one normal return with
+	// √days the daily volatility
+	periodVol := vol * math.Sqrt(float64(days)/tradingDays)
+	newPnl := func(src normals) func() float64 {
+		if book != nil {
+			return book.pnl(src, days)
+		}
+		draw := make([]float64, 1)
+		return func() float64 {
+			src.fill(draw)
+			return value * periodVol * draw[0]
+		}
+	}
+	st := s.newStreams(req.Sampling, max(len(req.Positions), 1))
+	result, err := s.simulateVaR(ctx, st, req.Confidence, sims, newPnl)
+	if err != nil {
+		return nil, err
+	}
+	z := math.Sqrt2 * math.Erfinv(2*req.Confidence-1)
+	result.VarParametric = value * periodVol * z
+	result.Confidence = req.Confidence
+	result.PortfolioValue = value
+	result.Volatility = vol
+	return result, nil
+}
+
+// SimulatePricePaths streams geometric Brownian motion paths, one price
+// per trading day
+func (s *FinanceServer) SimulatePricePaths(req *pb.SimulationRequest, stream pb.QuantumFinance_SimulatePricePathsServer) error {
+	switch {
+	case req.InitialPrice <= 0:
+		return fmt.Errorf("initial_price must be positive")
+	case req.Volatility < 0:
+		return fmt.Errorf("volatility cannot be negative")
+	case req.Days < 1 || req.Days > maxPathDays:
+		return fmt.Errorf("days must be 1-%d", maxPathDays)
+	case req.Paths < 1 || req.Paths > maxPaths:
+		return fmt.Errorf("paths must be 1-%d", maxPaths)
+	}
+
+	rng := s.newRNG()
+	dt := 1.0 / tradingDays
+	drift := (req.Drift - 0.5*req.Volatility*req.Volatility) * dt
+	vol := req.Volatility * math.Sqrt(dt)
+	for p := int32(0); p < req.Paths; p++ {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		path := &pb.PricePath{PathId: p, Prices: make([]float64, 0, req.Days+1)}
+		price := req.InitialPrice
+		path.Prices = append(path.Prices, price)
+		path.MaxPrice, path.MinPrice = price, price
+		for d := int32(0); d < req.Days; d++ {
+			price *= math.Exp(drift + vol*rng.NormFloat64())
+			path.Prices = append(path.Prices, price)
+			path.MaxPrice = math.Max(path.MaxPrice, price)
+			path.MinPrice = math.Min(path.MinPrice, price)
+		}
+		path.FinalPrice = price
+		if err := stream.Send(path); err != nil {
+			return err
+		}
+	}
+	log.Printf("📈 Simulated %d paths of %d days", req.Paths, req.Days)
+	return nil
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+// maxSobolDims bounds the normals drawn per Sobol point: time steps,
+// exercise dates or assets
+const maxSobolDims = 1000
+
+// normals is a source of standard normal vectors, one element per
+// dimension
+type normals interface {
+	fill(z []float64)
+}
+
+type pseudoNormals struct{ rng *rand.Rand }
+
+func (p pseudoNormals) fill(z []float64) {
+	for i := range z {
+		z[i] = p.rng.NormFloat64()
+	}
+}
+
+// newNormals is the sampling source asked for over dims dimensions
+func (s *FinanceServer) newNormals(sampling pb.SamplingMethod, dims int) normals {
+	if sampling == pb.SamplingMethod_SAMPLING_SOBOL {
+		return newSobol(dims, s.newRNG())
+	}
+	return pseudoNormals{s.newRNG()}
+}
+
+// checkSampling checks the sampling method for simulations of dims
+// normals each
+func checkSampling(sampling pb.SamplingMethod, dims int) error {
+	switch {
+	case sampling != pb.SamplingMethod_SAMPLING_PSEUDO_RANDOM && sampling != pb.SamplingMethod_SAMPLING_SOBOL:
+		return fmt.Errorf("unknown sampling method %v", sampling)
+	case sampling == pb.SamplingMethod_SAMPLING_SOBOL && dims > maxSobolDims:
+		return fmt.Errorf("Sobol sampling takes at most %d draws per simulation, not %d", maxSobolDims, dims)
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------
+// Sobol
+// ------------------------------------------------------------------
+
+// sobol is a scrambled Sobol sequence mapped to normals through the
+// inverse normal CDF. Dimension 0 is van der Corput's sequence; the rest
+// take one primitive polynomial over GF(2) each, in order of degree, with
+// initial direction numbers from a fixed-seed generator rather than
+// tuned tables. Every dimension is scrambled by a random lower triangular
+// binary matrix and digital shift (Matoušek), which keeps the sequence's
+// spread and makes each request's estimate an unbiased random draw.
+type sobol struct {
+	dirs  [][32]uint32 // Scrambled direction numbers, most significant bit first
+	shift []uint32     // Point 0
+	x     []uint32     // The current point
+	index uint32       // Points given so far
+}
+
+// sobolInitSeed seeds the unscrambled sequence's initial direction
+// numbers, so it is the same on every run
+const sobolInitSeed = 1
+
+func newSobol(dims int, rng *rand.Rand) *sobol {
+	s := &sobol{dirs: make([][32]uint32, dims), shift: make([]uint32, dims), x: make([]uint32, dims)}
+	init := rand.New(rand.NewSource(sobolInitSeed))
+	polys := primitivePolynomials(dims - 1)
+	for d := range s.dirs {
+		var m [32]uint32
+		if d == 0 {
+			for k := range m {
+				m[k] = 1
+			}
+		} else {
+			p := polys[d-1]
+			deg := bits.Len64(p) - 1
+			// m_k odd and below 2^k for the first deg, then the recurrence
+			// m_k = ⊕ 2^j·a_j·m_{k-j} ⊕ m_{k-deg}, a_j the coefficient of x^{deg-j}
+			for k := 0; k < deg && k < 32; k++ {
+				m[k] = uint32(init.Int63n(1<<k))<<1 | 1
+			}
+			for k := deg; k < 32; k++ {
+				m[k] = m[k-deg] ^ m[k-deg]<<deg
+				for j := 1; j < deg; j++ {
+					if p>>(deg-j)&1 != 0 {
+						m[k] ^= m[k-j] << j
+					}
+				}
+			}
+		}
+
+		// Scramble: row i of the matrix gives output bit 31-i from the
+		// direction number's bits at and above it
+		var rows [32]uint32
+		for i := range rows {
+			rows[i] = 1<<(31-i) | uint32(rng.Int63())&(^uint32(0)<<(32-i))
+		}
+		for k := range m {
+			v := m[k] << (31 - k)
+			var scrambled uint32
+			for i, row := range rows {
+				scrambled |= uint32(bits.OnesCount32(row&v)&1) << (31 - i)
+			}
+			s.dirs[d][k] = scrambled
+		}
+		s.shift[d] = uint32(rng.Int63())
+		s.x[d] = s.shift[d]
+	}
+	return s
+}
+
+// at is a copy of the sequence positioned at point n, sharing the
+// direction numbers: point n holds the direction numbers of the bits set
+// in n's Gray code
+func (s *sobol) at(n uint32) *sobol {
+	out := &sobol{dirs: s.dirs, shift: s.shift, x: make([]uint32, len(s.x)), index: n}
+	gray := n ^ n>>1
+	for d := range out.x {
+		x := s.shift[d]
+		for k := 0; gray>>k != 0; k++ {
+			if gray>>k&1 != 0 {
+				x ^= s.dirs[d][k]
+			}
+		}
+		out.x[d] = x
+	}
+	return out
+}
+
+// fill gives the next point's normals; z must have one per dimension
+func (s *sobol) fill(z []float64) {
+	for d := range z {
+		u := (float64(s.x[d]) + 0.5) / (1 << 32)
+		z[d] = math.Sqrt2 * math.Erfinv(2*u-1)
+	}
+	// Gray code order: the next point flips the direction number of the
+	// lowest zero bit of the index
+	c := bits.TrailingZeros32(^s.index)
+	for d := range s.x {
+		s.x[d] ^= s.dirs[d][c]
+	}
+	s.index++
+}
+
+// primitivePolynomials lists the first n primitive polynomials over
+// GF(2) by degree, as bit masks with bit i the coefficient of x^i
+func primitivePolynomials(n int) []uint64 {
+	var out []uint64
+	for deg := 1; len(out) < n; deg++ {
+		order := uint64(1)<<deg - 1
+		factors := primeFactors(order)
+		for p := uint64(1)<<deg | 1; p < 1<<(deg+1) && len(out) < n; p += 2 {
+			if isPrimitive(p, deg, order, factors) {
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
+
+// isPrimitive reports whether x has order 2^deg - 1 modulo p
+func isPrimitive(p uint64, deg int, order uint64, factors []uint64) bool {
+	if polyPowMod(2, order, p, deg) != 1 {
+		return false
+	}
+	for _, f := range factors {
+		if polyPowMod(2, order/f, p, deg) == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func polyPowMod(a, e, p uint64, deg int) uint64 {
+	result := uint64(1)
+	a = polyMod(a, p, deg)
+	for ; e > 0; e >>= 1 {
+		if e&1 != 0 {
+			result = polyMulMod(result, a, p, deg)
+		}
+		a = polyMulMod(a, a, p, deg)
+	}
+	return result
+}
+
+func polyMulMod(a, b, p uint64, deg int) uint64 {
+	var product uint64
+	for ; b > 0; b >>= 1 {
+		if b&1 != 0 {
+			product ^= a
+		}
+		a = polyMod(a<<1, p, deg)
+	}
+	return product
+}
+
+func polyMod(a, p uint64, deg int) uint64 {
+	for top := bits.Len64(a) - 1; top >= deg; top = bits.Len64(a) - 1 {
+		a ^= p << (top - deg)
+	}
+	return a
+}
+
+func primeFactors(n uint64) []uint64 {
+	var out []uint64
+	for f := uint64(2); f*f <= n; f++ {
+		if n%f == 0 {
+			out = append(out, f)
+			for n%f == 0 {
+				n /= f
+			}
+		}
+	}
+	if n > 1 {
+		out = append(out, n)
+	}
+	return out
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"math"
+
+	pb "github.com/perclft/QubitEngine/modules/finance/generated"
+)
+
+// mcAccumulator gathers a Monte Carlo estimate over sample units, each a
+// draw or an antithetic pair, alongside what plain Monte Carlo on the
+// same draws would have estimated
+type mcAccumulator struct {
+	units                           int
+	sumY, sumYY, sumX, sumXX, sumXY float64 // y the estimate, x the control
+
+	draws                int
+	plainSum, plainSumSq float64
+}
+
+// addDraw records one payoff f drawn with likelihood ratio w. Under the
+// shifted measure E[f²w] is the plain second moment, so the plain
+// variance needs no draws of its own.
+func (a *mcAccumulator) addDraw(f, w float64) {
+	a.draws++
+	a.plainSum += f * w
+	a.plainSumSq += f * f * w
+}
+
+func (a *mcAccumulator) addUnit(y, x float64) {
+	a.units++
+	a.sumY += y
+	a.sumYY += y * y
+	a.sumX += x
+	a.sumXX += x * x
+	a.sumXY += x * y
+}
+
+func (a *mcAccumulator) merge(b *mcAccumulator) {
+	a.units += b.units
+	a.sumY += b.sumY
+	a.sumYY += b.sumYY
+	a.sumX += b.sumX
+	a.sumXX += b.sumXX
+	a.sumXY += b.sumXY
+	a.draws += b.draws
+	a.plainSum += b.plainSum
+	a.plainSumSq += b.plainSumSq
+}
+
+// estimate is the mean and its standard error, regressing out the
+// control of known mean when control is set, and the standard error
+// plain Monte Carlo would have had
+func (a *mcAccumulator) estimate(control bool, controlMean float64) (float64, float64, float64) {
+	n := float64(a.units)
+	meanY, meanX := a.sumY/n, a.sumX/n
+	varY := a.sumYY/n - meanY*meanY
+	mean, variance := meanY, varY
+	if varX := a.sumXX/n - meanX*meanX; control && varX > 1e-300 {
+		cov := a.sumXY/n - meanX*meanY
+		b := cov / varX
+		mean = meanY - b*(meanX-controlMean)
+		variance = varY - b*cov
+	}
+	plainMean, plainN := a.plainSum/float64(a.draws), float64(a.draws)
+	plainVar := a.plainSumSq/plainN - plainMean*plainMean
+	return mean, math.Sqrt(math.Max(variance, 0) / n), math.Sqrt(math.Max(plainVar, 0) / plainN)
+}
+
+// hasReduction reports whether any variance reduction is asked for
+func hasReduction(vr *pb.VarianceReduction) bool {
+	return vr.GetAntithetic() || vr.GetControlVariate() || vr.GetImportanceSampling()
+}
+
+// importanceShift is the mean of the shifted standard normal driving the
+// terminal price: the draw that lands an out-of-the-money option at the
+// strike, or zero in the money, where shifting does not help
+func importanceShift(req *pb.OptionRequest) float64 {
+	sigma, T := req.Volatility, req.TimeToExpiry
+	atStrike := (math.Log(req.StrikePrice/req.SpotPrice) - (req.RiskFreeRate-req.DividendYield-0.5*sigma*sigma)*T) / (sigma * math.Sqrt(T))
+	if req.Type == pb.OptionType_OPTION_CALL {
+		return math.Max(atStrike, 0)
+	}
+	return math.Min(atStrike, 0)
+}
+
+// runMC estimates E[payoff] over sims draws of dims standard normals
+// under the variance reduction asked for, payoff returning the payoff
+// and the control for z. With importance sampling the terminal shift is
+// spread evenly over the dims, each drawn from N(shift/√dims, 1). The
+// sample units are shared out across the workers in chunks.
+func (s *FinanceServer) runMC(ctx context.Context, st *streams, vr *pb.VarianceReduction, shift float64, dims, sims int,
+	payoff func(z []float64) (float64, float64)) (*mcAccumulator, error) {
+	theta := 0.0
+	if vr.GetImportanceSampling() {
+		theta = shift / math.Sqrt(float64(dims))
+	}
+	// w(z) = e^{-θΣz + dims·θ²/2}
+	weight := func(z []float64) float64 {
+		if theta == 0 {
+			return 1
+		}
+		sum := 0.0
+		for _, x := range z {
+			sum += x
+		}
+		return math.Exp(-theta*sum + float64(dims)*theta*theta/2)
+	}
+
+	units := sims
+	if vr.GetAntithetic() {
+		units = max(sims/2, 1)
+	}
+	parts := make([]mcAccumulator, chunks(units))
+	err := s.parallelChunks(ctx, 0, units, func(c, start, end int) {
+		src := st.chunk(c, start)
+		a := &parts[c]
+		z, mirror := make([]float64, dims), make([]float64, dims)
+		for i := start; i < end; i++ {
+			src.fill(z)
+			for d := range z {
+				z[d] += theta
+			}
+			w := weight(z)
+			f, control := payoff(z)
+			a.addDraw(f, w)
+			y, x := f*w, control*w
+			if vr.GetAntithetic() {
+				// The mirror of z about the shifted mean
+				for d := range z {
+					mirror[d] = 2*theta - z[d]
+				}
+				wm := weight(mirror)
+				fm, xm := payoff(mirror)
+				a.addDraw(fm, wm)
+				y, x = (y+fm*wm)/2, (x+xm*wm)/2
+			}
+			a.addUnit(y, x)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	total := &mcAccumulator{}
+	for i := range parts {
+		total.merge(&parts[i])
+	}
+	return total, nil
+}
+
+// reductionReport fills a price's estimator fields from an accumulator
+// of discounted payoffs
+func reductionReport(out *pb.OptionPrice, a *mcAccumulator, vr *pb.VarianceReduction, controlMean float64) {
+	mean, stdError, plainStdError := a.estimate(vr.GetControlVariate(), controlMean)
+	out.Price, out.MonteCarlo = mean, mean
+	out.StdError, out.PlainStdError = stdError, plainStdError
+	out.SimulationsUsed = int32(a.draws)
+	if stdError > 0 {
+		out.VarianceReduction = (plainStdError / stdError) * (plainStdError / stdError)
+	}
+}
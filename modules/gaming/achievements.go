@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	edu "github.com/perclft/QubitEngine/modules/gaming/generated/education"
+)
+
+const (
+	achievementFlushInterval = 5 * time.Second
+	// maxReportCount is the most the education module takes in one event
+	maxReportCount = 10000
+)
+
+// ------------------------------------------------------------------
+// Achievement reporting - feeds player activity into education badges
+// ------------------------------------------------------------------
+
+type reportKey struct {
+	userID string
+	kind   string
+}
+
+// achievementReporter counts activity per player and reports it in
+// batches, so games never wait on the education module. A nil reporter
+// counts nothing.
+type achievementReporter struct {
+	client  edu.QuantumEducationClient
+	mu      sync.Mutex
+	pending map[reportKey]int
+}
+
+func newAchievementReporter(client edu.QuantumEducationClient) *achievementReporter {
+	return &achievementReporter{client: client, pending: make(map[reportKey]int)}
+}
+
+func (r *achievementReporter) count(userID, kind string) {
+	if r == nil || userID == "" {
+		return
+	}
+	r.mu.Lock()
+	r.pending[reportKey{userID, kind}]++
+	r.mu.Unlock()
+}
+
+// run flushes on every tick until ctx ends
+func (r *achievementReporter) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.flush(ctx)
+		}
+	}
+}
+
+// flush reports everything pending. Counts that fail to send stay pending
+// for the next flush.
+func (r *achievementReporter) flush(ctx context.Context) {
+	r.mu.Lock()
+	batch := r.pending
+	r.pending = make(map[reportKey]int)
+	r.mu.Unlock()
+
+	for key, n := range batch {
+		sent := min(n, maxReportCount)
+		ack, err := r.client.RecordEvent(ctx, &edu.AchievementEvent{
+			UserId: key.userID,
+			Kind:   key.kind,
+			Count:  int32(sent),
+			Source: "gaming",
+		})
+		if err != nil {
+			log.Printf("🏅 Failed to report %s for %s: %v", key.kind, key.userID, err)
+			sent = 0
+		}
+		for _, b := range ack.GetUnlocked() {
+			log.Printf("🏅 %s unlocked %s %s", key.userID, b.Emoji, b.Name)
+		}
+		if n > sent {
+			r.mu.Lock()
+			r.pending[key] += n - sent
+			r.mu.Unlock()
+		}
+	}
+}
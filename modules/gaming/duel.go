@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/perclft/QubitEngine/modules/gaming/generated"
+	qcrypto "github.com/perclft/QubitEngine/modules/gaming/generated/crypto"
+)
+
+// ------------------------------------------------------------------
+// Head-to-head flips - refereed by the crypto module's coin flipping
+// ------------------------------------------------------------------
+
+// flipReferee plays head-to-head flips on the crypto module. It keeps both
+// players' tokens, so players only act on a flip through the game. A nil
+// referee has no crypto module to play on.
+type flipReferee struct {
+	client qcrypto.QuantumCryptoClient
+	mu     sync.Mutex
+	flips  map[string]*refereedFlip // By flip ID
+}
+
+type refereedFlip struct {
+	challenger, opponent string
+	aliceToken, bobToken string
+	expiresAt            time.Time
+}
+
+func newFlipReferee(client qcrypto.QuantumCryptoClient) *flipReferee {
+	return &flipReferee{client: client, flips: make(map[string]*refereedFlip)}
+}
+
+// claim hands a live flip to its opponent and forgets it, so that it is
+// called at most once
+func (r *flipReferee) claim(id, opponent string) (*refereedFlip, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for fid, f := range r.flips {
+		if now.After(f.expiresAt) {
+			delete(r.flips, fid)
+		}
+	}
+	f, ok := r.flips[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no open flip %s", id)
+	}
+	if opponent != f.opponent {
+		return nil, status.Errorf(codes.PermissionDenied, "only %s can call flip %s", f.opponent, id)
+	}
+	delete(r.flips, id)
+	return f, nil
+}
+
+// ChallengeFlip opens a flip and commits the challenger's qubits
+func (s *GamingServer) ChallengeFlip(ctx context.Context, req *pb.FlipChallengeRequest) (*pb.FlipChallenge, error) {
+	if s.flips == nil {
+		return nil, status.Error(codes.Unavailable, "head-to-head flips need the crypto module (-crypto-addr)")
+	}
+	session, err := s.flips.client.CreateFlipSession(ctx, &qcrypto.CreateFlipRequest{
+		Alice:     req.Challenger,
+		Bob:       req.Opponent,
+		NumQubits: req.NumQubits,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not open the flip: %v", err)
+	}
+	_, err = s.flips.client.CommitFlip(ctx, &qcrypto.CommitFlipRequest{
+		FlipId: session.FlipId,
+		Player: req.Challenger,
+		Token:  session.AliceToken,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not commit the coin: %v", err)
+	}
+
+	s.flips.mu.Lock()
+	s.flips.flips[session.FlipId] = &refereedFlip{
+		challenger: req.Challenger,
+		opponent:   req.Opponent,
+		aliceToken: session.AliceToken,
+		bobToken:   session.BobToken,
+		expiresAt:  time.Unix(session.ExpiresAt, 0),
+	}
+	s.flips.mu.Unlock()
+
+	log.Printf("🪙 %s challenged %s to a flip (%s)", req.Challenger, req.Opponent, session.FlipId)
+	return &pb.FlipChallenge{
+		FlipId:     session.FlipId,
+		Challenger: req.Challenger,
+		Opponent:   req.Opponent,
+		NumQubits:  session.NumQubits,
+		ExpiresAt:  session.ExpiresAt,
+	}, nil
+}
+
+// CallFlip records the opponent's call, then reveals and checks the coin
+func (s *GamingServer) CallFlip(ctx context.Context, req *pb.FlipCallRequest) (*pb.FlipDuelResult, error) {
+	if s.flips == nil {
+		return nil, status.Error(codes.Unavailable, "head-to-head flips need the crypto module (-crypto-addr)")
+	}
+	f, err := s.flips.claim(req.FlipId, req.Opponent)
+	if err != nil {
+		return nil, err
+	}
+
+	guess := qcrypto.Basis_BASIS_DIAGONAL
+	if req.Heads {
+		guess = qcrypto.Basis_BASIS_RECTILINEAR
+	}
+	_, err = s.flips.client.CommitFlip(ctx, &qcrypto.CommitFlipRequest{
+		FlipId: req.FlipId,
+		Player: f.opponent,
+		Token:  f.bobToken,
+		Guess:  guess,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not record the call: %v", err)
+	}
+	result, err := s.flips.client.RevealFlip(ctx, &qcrypto.RevealFlipRequest{
+		FlipId: req.FlipId,
+		Player: f.challenger,
+		Token:  f.aliceToken,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not reveal the coin: %v", err)
+	}
+
+	log.Printf("🪙 Flip %s: %s wins (verified: %v)", req.FlipId, result.Winner, result.Verified)
+	return &pb.FlipDuelResult{
+		FlipId:        req.FlipId,
+		Heads:         result.AliceBasis == qcrypto.Basis_BASIS_RECTILINEAR,
+		CalledHeads:   req.Heads,
+		Winner:        result.Winner,
+		Verified:      result.Verified,
+		CheckedQubits: result.CheckedQubits,
+		Mismatches:    result.Mismatches,
+	}, nil
+}
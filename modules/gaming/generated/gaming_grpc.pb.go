@@ -0,0 +1,563 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: gaming.proto
+
+package generated
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuantumGaming_GenerateRandom_FullMethodName      = "/qubit_engine.gaming.QuantumGaming/GenerateRandom"
+	QuantumGaming_GenerateRandomBytes_FullMethodName = "/qubit_engine.gaming.QuantumGaming/GenerateRandomBytes"
+	QuantumGaming_CreateSuperposition_FullMethodName = "/qubit_engine.gaming.QuantumGaming/CreateSuperposition"
+	QuantumGaming_CollapseState_FullMethodName       = "/qubit_engine.gaming.QuantumGaming/CollapseState"
+	QuantumGaming_QuantumCoinFlip_FullMethodName     = "/qubit_engine.gaming.QuantumGaming/QuantumCoinFlip"
+	QuantumGaming_QuantumDiceRoll_FullMethodName     = "/qubit_engine.gaming.QuantumGaming/QuantumDiceRoll"
+	QuantumGaming_ShuffleDeck_FullMethodName         = "/qubit_engine.gaming.QuantumGaming/ShuffleDeck"
+	QuantumGaming_AskOracle_FullMethodName           = "/qubit_engine.gaming.QuantumGaming/AskOracle"
+	QuantumGaming_GetOracleHistory_FullMethodName    = "/qubit_engine.gaming.QuantumGaming/GetOracleHistory"
+	QuantumGaming_GetLeaderboard_FullMethodName      = "/qubit_engine.gaming.QuantumGaming/GetLeaderboard"
+	QuantumGaming_ChallengeFlip_FullMethodName       = "/qubit_engine.gaming.QuantumGaming/ChallengeFlip"
+	QuantumGaming_CallFlip_FullMethodName            = "/qubit_engine.gaming.QuantumGaming/CallFlip"
+)
+
+// QuantumGamingClient is the client API for QuantumGaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumGamingClient interface {
+	// Generate truly random numbers using quantum measurement
+	GenerateRandom(ctx context.Context, in *RandomRequest, opts ...grpc.CallOption) (*RandomResponse, error)
+	// Generate random bytes for cryptographic purposes
+	GenerateRandomBytes(ctx context.Context, in *RandomBytesRequest, opts ...grpc.CallOption) (*RandomBytesResponse, error)
+	// Create a superposition state for game mechanics
+	CreateSuperposition(ctx context.Context, in *SuperpositionRequest, opts ...grpc.CallOption) (*SuperpositionState, error)
+	// Collapse a superposition to determine outcome
+	CollapseState(ctx context.Context, in *CollapsRequest, opts ...grpc.CallOption) (*CollapseResult, error)
+	// Quantum coin flip with optional bias
+	QuantumCoinFlip(ctx context.Context, in *CoinFlipRequest, opts ...grpc.CallOption) (*CoinFlipResult, error)
+	// Quantum dice roll (any number of sides)
+	QuantumDiceRoll(ctx context.Context, in *DiceRequest, opts ...grpc.CallOption) (*DiceResult, error)
+	// Generate a random deck shuffle
+	ShuffleDeck(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*ShuffledDeck, error)
+	// 🎱 Ask the Quantum Oracle (Magic 8-Ball)
+	AskOracle(ctx context.Context, in *OracleRequest, opts ...grpc.CallOption) (*OracleResponse, error)
+	// A user's recent consultations, newest first, with their fate trend
+	GetOracleHistory(ctx context.Context, in *OracleHistoryRequest, opts ...grpc.CallOption) (*OracleHistory, error)
+	// This week's standings in a group by oracle consultations or dice luck
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	// Head-to-head flip refereed by the crypto module: the challenger
+	// commits to the coin, then the opponent calls it
+	ChallengeFlip(ctx context.Context, in *FlipChallengeRequest, opts ...grpc.CallOption) (*FlipChallenge, error)
+	CallFlip(ctx context.Context, in *FlipCallRequest, opts ...grpc.CallOption) (*FlipDuelResult, error)
+}
+
+type quantumGamingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumGamingClient(cc grpc.ClientConnInterface) QuantumGamingClient {
+	return &quantumGamingClient{cc}
+}
+
+func (c *quantumGamingClient) GenerateRandom(ctx context.Context, in *RandomRequest, opts ...grpc.CallOption) (*RandomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RandomResponse)
+	err := c.cc.Invoke(ctx, QuantumGaming_GenerateRandom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) GenerateRandomBytes(ctx context.Context, in *RandomBytesRequest, opts ...grpc.CallOption) (*RandomBytesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RandomBytesResponse)
+	err := c.cc.Invoke(ctx, QuantumGaming_GenerateRandomBytes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) CreateSuperposition(ctx context.Context, in *SuperpositionRequest, opts ...grpc.CallOption) (*SuperpositionState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuperpositionState)
+	err := c.cc.Invoke(ctx, QuantumGaming_CreateSuperposition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) CollapseState(ctx context.Context, in *CollapsRequest, opts ...grpc.CallOption) (*CollapseResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollapseResult)
+	err := c.cc.Invoke(ctx, QuantumGaming_CollapseState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) QuantumCoinFlip(ctx context.Context, in *CoinFlipRequest, opts ...grpc.CallOption) (*CoinFlipResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoinFlipResult)
+	err := c.cc.Invoke(ctx, QuantumGaming_QuantumCoinFlip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) QuantumDiceRoll(ctx context.Context, in *DiceRequest, opts ...grpc.CallOption) (*DiceResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiceResult)
+	err := c.cc.Invoke(ctx, QuantumGaming_QuantumDiceRoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) ShuffleDeck(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*ShuffledDeck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShuffledDeck)
+	err := c.cc.Invoke(ctx, QuantumGaming_ShuffleDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) AskOracle(ctx context.Context, in *OracleRequest, opts ...grpc.CallOption) (*OracleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OracleResponse)
+	err := c.cc.Invoke(ctx, QuantumGaming_AskOracle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) GetOracleHistory(ctx context.Context, in *OracleHistoryRequest, opts ...grpc.CallOption) (*OracleHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OracleHistory)
+	err := c.cc.Invoke(ctx, QuantumGaming_GetOracleHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, QuantumGaming_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) ChallengeFlip(ctx context.Context, in *FlipChallengeRequest, opts ...grpc.CallOption) (*FlipChallenge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlipChallenge)
+	err := c.cc.Invoke(ctx, QuantumGaming_ChallengeFlip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumGamingClient) CallFlip(ctx context.Context, in *FlipCallRequest, opts ...grpc.CallOption) (*FlipDuelResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlipDuelResult)
+	err := c.cc.Invoke(ctx, QuantumGaming_CallFlip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumGamingServer is the server API for QuantumGaming service.
+// All implementations must embed UnimplementedQuantumGamingServer
+// for forward compatibility.
+type QuantumGamingServer interface {
+	// Generate truly random numbers using quantum measurement
+	GenerateRandom(context.Context, *RandomRequest) (*RandomResponse, error)
+	// Generate random bytes for cryptographic purposes
+	GenerateRandomBytes(context.Context, *RandomBytesRequest) (*RandomBytesResponse, error)
+	// Create a superposition state for game mechanics
+	CreateSuperposition(context.Context, *SuperpositionRequest) (*SuperpositionState, error)
+	// Collapse a superposition to determine outcome
+	CollapseState(context.Context, *CollapsRequest) (*CollapseResult, error)
+	// Quantum coin flip with optional bias
+	QuantumCoinFlip(context.Context, *CoinFlipRequest) (*CoinFlipResult, error)
+	// Quantum dice roll (any number of sides)
+	QuantumDiceRoll(context.Context, *DiceRequest) (*DiceResult, error)
+	// Generate a random deck shuffle
+	ShuffleDeck(context.Context, *ShuffleRequest) (*ShuffledDeck, error)
+	// 🎱 Ask the Quantum Oracle (Magic 8-Ball)
+	AskOracle(context.Context, *OracleRequest) (*OracleResponse, error)
+	// A user's recent consultations, newest first, with their fate trend
+	GetOracleHistory(context.Context, *OracleHistoryRequest) (*OracleHistory, error)
+	// This week's standings in a group by oracle consultations or dice luck
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error)
+	// Head-to-head flip refereed by the crypto module: the challenger
+	// commits to the coin, then the opponent calls it
+	ChallengeFlip(context.Context, *FlipChallengeRequest) (*FlipChallenge, error)
+	CallFlip(context.Context, *FlipCallRequest) (*FlipDuelResult, error)
+	mustEmbedUnimplementedQuantumGamingServer()
+}
+
+// UnimplementedQuantumGamingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumGamingServer struct{}
+
+func (UnimplementedQuantumGamingServer) GenerateRandom(context.Context, *RandomRequest) (*RandomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateRandom not implemented")
+}
+func (UnimplementedQuantumGamingServer) GenerateRandomBytes(context.Context, *RandomBytesRequest) (*RandomBytesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateRandomBytes not implemented")
+}
+func (UnimplementedQuantumGamingServer) CreateSuperposition(context.Context, *SuperpositionRequest) (*SuperpositionState, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSuperposition not implemented")
+}
+func (UnimplementedQuantumGamingServer) CollapseState(context.Context, *CollapsRequest) (*CollapseResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollapseState not implemented")
+}
+func (UnimplementedQuantumGamingServer) QuantumCoinFlip(context.Context, *CoinFlipRequest) (*CoinFlipResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuantumCoinFlip not implemented")
+}
+func (UnimplementedQuantumGamingServer) QuantumDiceRoll(context.Context, *DiceRequest) (*DiceResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuantumDiceRoll not implemented")
+}
+func (UnimplementedQuantumGamingServer) ShuffleDeck(context.Context, *ShuffleRequest) (*ShuffledDeck, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShuffleDeck not implemented")
+}
+func (UnimplementedQuantumGamingServer) AskOracle(context.Context, *OracleRequest) (*OracleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AskOracle not implemented")
+}
+func (UnimplementedQuantumGamingServer) GetOracleHistory(context.Context, *OracleHistoryRequest) (*OracleHistory, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOracleHistory not implemented")
+}
+func (UnimplementedQuantumGamingServer) GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedQuantumGamingServer) ChallengeFlip(context.Context, *FlipChallengeRequest) (*FlipChallenge, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChallengeFlip not implemented")
+}
+func (UnimplementedQuantumGamingServer) CallFlip(context.Context, *FlipCallRequest) (*FlipDuelResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method CallFlip not implemented")
+}
+func (UnimplementedQuantumGamingServer) mustEmbedUnimplementedQuantumGamingServer() {}
+func (UnimplementedQuantumGamingServer) testEmbeddedByValue()                       {}
+
+// UnsafeQuantumGamingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumGamingServer will
+// result in compilation errors.
+type UnsafeQuantumGamingServer interface {
+	mustEmbedUnimplementedQuantumGamingServer()
+}
+
+func RegisterQuantumGamingServer(s grpc.ServiceRegistrar, srv QuantumGamingServer) {
+	// If the following call panics, it indicates UnimplementedQuantumGamingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumGaming_ServiceDesc, srv)
+}
+
+func _QuantumGaming_GenerateRandom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).GenerateRandom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_GenerateRandom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).GenerateRandom(ctx, req.(*RandomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_GenerateRandomBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).GenerateRandomBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_GenerateRandomBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).GenerateRandomBytes(ctx, req.(*RandomBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_CreateSuperposition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperpositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).CreateSuperposition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_CreateSuperposition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).CreateSuperposition(ctx, req.(*SuperpositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_CollapseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).CollapseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_CollapseState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).CollapseState(ctx, req.(*CollapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_QuantumCoinFlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoinFlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).QuantumCoinFlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_QuantumCoinFlip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).QuantumCoinFlip(ctx, req.(*CoinFlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_QuantumDiceRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).QuantumDiceRoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_QuantumDiceRoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).QuantumDiceRoll(ctx, req.(*DiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_ShuffleDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).ShuffleDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_ShuffleDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).ShuffleDeck(ctx, req.(*ShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_AskOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OracleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).AskOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_AskOracle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).AskOracle(ctx, req.(*OracleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_GetOracleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OracleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).GetOracleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_GetOracleHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).GetOracleHistory(ctx, req.(*OracleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_ChallengeFlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlipChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).ChallengeFlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_ChallengeFlip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).ChallengeFlip(ctx, req.(*FlipChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumGaming_CallFlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlipCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumGamingServer).CallFlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumGaming_CallFlip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumGamingServer).CallFlip(ctx, req.(*FlipCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumGaming_ServiceDesc is the grpc.ServiceDesc for QuantumGaming service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumGaming_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.gaming.QuantumGaming",
+	HandlerType: (*QuantumGamingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateRandom",
+			Handler:    _QuantumGaming_GenerateRandom_Handler,
+		},
+		{
+			MethodName: "GenerateRandomBytes",
+			Handler:    _QuantumGaming_GenerateRandomBytes_Handler,
+		},
+		{
+			MethodName: "CreateSuperposition",
+			Handler:    _QuantumGaming_CreateSuperposition_Handler,
+		},
+		{
+			MethodName: "CollapseState",
+			Handler:    _QuantumGaming_CollapseState_Handler,
+		},
+		{
+			MethodName: "QuantumCoinFlip",
+			Handler:    _QuantumGaming_QuantumCoinFlip_Handler,
+		},
+		{
+			MethodName: "QuantumDiceRoll",
+			Handler:    _QuantumGaming_QuantumDiceRoll_Handler,
+		},
+		{
+			MethodName: "ShuffleDeck",
+			Handler:    _QuantumGaming_ShuffleDeck_Handler,
+		},
+		{
+			MethodName: "AskOracle",
+			Handler:    _QuantumGaming_AskOracle_Handler,
+		},
+		{
+			MethodName: "GetOracleHistory",
+			Handler:    _QuantumGaming_GetOracleHistory_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _QuantumGaming_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "ChallengeFlip",
+			Handler:    _QuantumGaming_ChallengeFlip_Handler,
+		},
+		{
+			MethodName: "CallFlip",
+			Handler:    _QuantumGaming_CallFlip_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaming.proto",
+}
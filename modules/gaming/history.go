@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/perclft/QubitEngine/modules/gaming/generated"
+)
+
+// ------------------------------------------------------------------
+// Oracle history - each user's recent consultations and fate trend
+// ------------------------------------------------------------------
+
+const (
+	maxOracleHistory   = 50
+	defaultHistorySize = 10
+)
+
+// oracleHistory keeps each user's last consultations, oldest first.
+// Like the rest of the module's state it lives in memory.
+type oracleHistory struct {
+	mu    sync.Mutex
+	users map[string][]*pb.OracleConsultation
+}
+
+func newOracleHistory() *oracleHistory {
+	return &oracleHistory{users: make(map[string][]*pb.OracleConsultation)}
+}
+
+// remember records a consultation, forgetting the user's oldest past
+// maxOracleHistory
+func (h *oracleHistory) remember(req *pb.OracleRequest, resp *pb.OracleResponse) {
+	if req.UserId == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	past := append(h.users[req.UserId], &pb.OracleConsultation{
+		Question: req.Question,
+		Mood:     req.Mood,
+		Response: resp,
+		GroupId:  req.GroupId,
+	})
+	if len(past) > maxOracleHistory {
+		past = past[len(past)-maxOracleHistory:]
+	}
+	h.users[req.UserId] = past
+}
+
+// summary returns a user's newest consultations and the fate trend over
+// everything remembered
+func (h *oracleHistory) summary(userID string, limit int) *pb.OracleHistory {
+	h.mu.Lock()
+	past := append([]*pb.OracleConsultation(nil), h.users[userID]...)
+	h.mu.Unlock()
+
+	out := &pb.OracleHistory{Total: int32(len(past))}
+	if len(past) == 0 {
+		return out
+	}
+	confidence := func(c []*pb.OracleConsultation) float64 {
+		sum := 0.0
+		for _, p := range c {
+			sum += p.Response.Confidence
+		}
+		return sum / float64(len(c))
+	}
+	for _, p := range past {
+		switch o := p.Response.OutcomeIndex; {
+		case o <= 2:
+			out.Favorable++
+		case o <= 4:
+			out.Uncertain++
+		default:
+			out.Unfavorable++
+		}
+	}
+	out.AverageConfidence = confidence(past)
+	if half := len(past) / 2; half > 0 {
+		out.Trend = confidence(past[len(past)-half:]) - confidence(past[:half])
+	}
+
+	for i := len(past) - 1; i >= 0 && len(out.Consultations) < limit; i-- {
+		out.Consultations = append(out.Consultations, past[i])
+	}
+	return out
+}
+
+func (s *GamingServer) GetOracleHistory(ctx context.Context, req *pb.OracleHistoryRequest) (*pb.OracleHistory, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultHistorySize
+	}
+	return s.history.summary(req.UserId, min(limit, maxOracleHistory)), nil
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/perclft/QubitEngine/modules/gaming/generated"
+)
+
+// ------------------------------------------------------------------
+// Group leaderboards - weekly standings per guild, server or room
+// ------------------------------------------------------------------
+
+const (
+	defaultBoardSize = 10
+	// minLuckDice keeps a single lucky roll off the top of the luck board
+	minLuckDice = 10
+)
+
+// playerWeek is a player's week in one group
+type playerWeek struct {
+	oracle int
+	dice   int
+	luck   float64 // Sum over dice of where each roll fell, 0 to 1
+}
+
+// groupBoards keeps this week's and last week's play, by week, group and
+// player. Like the rest of the module's state it lives in memory.
+type groupBoards struct {
+	mu    sync.Mutex
+	weeks map[string]map[string]map[string]*playerWeek
+}
+
+func newGroupBoards() *groupBoards {
+	return &groupBoards{weeks: make(map[string]map[string]map[string]*playerWeek)}
+}
+
+// weekOf names the ISO week t falls in, and when it starts
+func weekOf(t time.Time) (string, time.Time) {
+	t = t.UTC()
+	year, week := t.ISOWeek()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	start := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	return fmt.Sprintf("%d-W%02d", year, week), start
+}
+
+// player returns a player's record for this week, dropping weeks too old
+// to show; callers hold g.mu
+func (g *groupBoards) player(group, userID string, now time.Time) *playerWeek {
+	week, start := weekOf(now)
+	if _, ok := g.weeks[week]; !ok {
+		last, _ := weekOf(start.AddDate(0, 0, -7))
+		for old := range g.weeks {
+			if old < last {
+				delete(g.weeks, old)
+			}
+		}
+		g.weeks[week] = make(map[string]map[string]*playerWeek)
+	}
+	players, ok := g.weeks[week][group]
+	if !ok {
+		players = make(map[string]*playerWeek)
+		g.weeks[week][group] = players
+	}
+	p, ok := players[userID]
+	if !ok {
+		p = &playerWeek{}
+		players[userID] = p
+	}
+	return p
+}
+
+// consulted counts an oracle consultation
+func (g *groupBoards) consulted(group, userID string, now time.Time) {
+	if group == "" || userID == "" {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.player(group, userID, now).oracle++
+}
+
+// rolled counts dice toward a player's luck
+func (g *groupBoards) rolled(group, userID string, rolls []int32, sides int, now time.Time) {
+	if group == "" || userID == "" || sides < 2 {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	p := g.player(group, userID, now)
+	for _, r := range rolls {
+		p.dice++
+		p.luck += float64(r-1) / float64(sides-1)
+	}
+}
+
+// board ranks a group's players in a week
+func (g *groupBoards) board(week, group string, metric pb.BoardMetric) []*pb.LeaderboardEntry {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var board []*pb.LeaderboardEntry
+	for userID, p := range g.weeks[week][group] {
+		e := &pb.LeaderboardEntry{
+			UserId:              userID,
+			OracleConsultations: int32(p.oracle),
+			DiceRolled:          int32(p.dice),
+		}
+		if p.dice > 0 {
+			e.LuckIndex = 100 * p.luck / float64(p.dice)
+		}
+		switch {
+		case metric == pb.BoardMetric_BOARD_ORACLE && p.oracle == 0,
+			metric == pb.BoardMetric_BOARD_DICE_LUCK && p.dice < minLuckDice:
+			continue
+		}
+		board = append(board, e)
+	}
+
+	sort.Slice(board, func(i, j int) bool {
+		a, b := board[i], board[j]
+		switch metric {
+		case pb.BoardMetric_BOARD_ORACLE:
+			if a.OracleConsultations != b.OracleConsultations {
+				return a.OracleConsultations > b.OracleConsultations
+			}
+		case pb.BoardMetric_BOARD_DICE_LUCK:
+			if a.LuckIndex != b.LuckIndex {
+				return a.LuckIndex > b.LuckIndex
+			}
+		}
+		return a.UserId < b.UserId
+	})
+	for i, e := range board {
+		e.Rank = int32(i + 1)
+	}
+	return board
+}
+
+func (s *GamingServer) GetLeaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.Leaderboard, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+	if _, ok := pb.BoardMetric_name[int32(req.Metric)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown metric %v", req.Metric)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultBoardSize
+	}
+
+	_, start := weekOf(time.Now())
+	if req.PreviousWeek {
+		start = start.AddDate(0, 0, -7)
+	}
+	week, _ := weekOf(start)
+	board := s.boards.board(week, req.GroupId, req.Metric)
+
+	return &pb.Leaderboard{
+		Week:        week,
+		StartsAt:    start.Unix(),
+		ResetsAt:    start.AddDate(0, 0, 7).Unix(),
+		Metric:      req.Metric,
+		Entries:     board[:min(limit, len(board))],
+		Players:     int32(len(board)),
+		MinLuckDice: minLuckDice,
+	}, nil
+}
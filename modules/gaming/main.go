@@ -13,19 +13,29 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/perclft/QubitEngine/modules/gaming/generated"
+	qcrypto "github.com/perclft/QubitEngine/modules/gaming/generated/crypto"
+	edu "github.com/perclft/QubitEngine/modules/gaming/generated/education"
 )
 
 // ------------------------------------------------------------------
 // The 8 Prophecies (per mood) - 32 total responses
 // ------------------------------------------------------------------
 
-var prophecies = map[OracleMood][]string{
-	MoodMysterious: {
+var prophecies = map[pb.OracleMood][]string{
+	pb.OracleMood_MOOD_MYSTERIOUS: {
 		"The quantum realm whispers... yes ✨",
 		"Signs point to affirmative 🌙",
 		"The stars align in your favor ⭐",
@@ -35,7 +45,7 @@ var prophecies = map[OracleMood][]string{
 		"The spirits say... unlikely 👻",
 		"Absolutely not. The void has spoken 🕳️",
 	},
-	MoodSarcastic: {
+	pb.OracleMood_MOOD_SARCASTIC: {
 		"Obviously yes, did you even need to ask? 🙄",
 		"Yeah, sure, whatever 💅",
 		"I guess... if you're lucky 🍀",
@@ -45,7 +55,7 @@ var prophecies = map[OracleMood][]string{
 		"That's a hard no from me 🚫",
 		"Are you kidding? No 😂",
 	},
-	MoodPhilosophical: {
+	pb.OracleMood_MOOD_PHILOSOPHICAL: {
 		"In the infinite multiverse, this is true 🌌",
 		"The wave function collapsed favorably 〰️",
 		"Probability favors this outcome 📊",
@@ -55,7 +65,7 @@ var prophecies = map[OracleMood][]string{
 		"Entropy increases against this outcome 🔥",
 		"In no timeline does this occur ⏰",
 	},
-	MoodChaotic: {
+	pb.OracleMood_MOOD_CHAOTIC: {
 		"ABSOLUTELY! *explodes* 💥",
 		"YES! But also maybe no? YES! 🎭",
 		"The dice gods approve 🎲🎲🎲",
@@ -75,19 +85,28 @@ var confidenceLevels = []float64{0.95, 0.85, 0.75, 0.50, 0.40, 0.35, 0.25, 0.15}
 // ------------------------------------------------------------------
 
 type GamingServer struct {
+	pb.UnimplementedQuantumGamingServer
 	rng            *rand.Rand
-	superpositions map[string]*SuperpositionState
-	oracleCache    map[string]*OracleResponse // user:question -> response
+	rngMu          sync.Mutex // rand.Rand is not safe for concurrent calls
+	superpositions map[string]*pb.SuperpositionState
+	oracleCache    map[string]*pb.OracleResponse // user:question -> response
 	mu             sync.RWMutex
 	engineAddr     string
+	achievements   *achievementReporter // nil when badges are not reported
+	boards         *groupBoards
+	history        *oracleHistory
+	flips          *flipReferee // nil without a crypto module
 }
 
-func NewGamingServer(engineAddr string) *GamingServer {
+func NewGamingServer(engineAddr string, achievements *achievementReporter) *GamingServer {
 	return &GamingServer{
 		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
-		superpositions: make(map[string]*SuperpositionState),
-		oracleCache:    make(map[string]*OracleResponse),
+		superpositions: make(map[string]*pb.SuperpositionState),
+		oracleCache:    make(map[string]*pb.OracleResponse),
 		engineAddr:     engineAddr,
+		achievements:   achievements,
+		boards:         newGroupBoards(),
+		history:        newOracleHistory(),
 	}
 }
 
@@ -95,8 +114,10 @@ func NewGamingServer(engineAddr string) *GamingServer {
 // AskOracle - THE QUANTUM MAGIC 8-BALL 🎱
 // ------------------------------------------------------------------
 
-func (s *GamingServer) AskOracle(ctx context.Context, req *OracleRequest) (*OracleResponse, error) {
+func (s *GamingServer) AskOracle(ctx context.Context, req *pb.OracleRequest) (*pb.OracleResponse, error) {
 	log.Printf("🎱 Oracle consulted: '%s' by user %s (mood: %v)", req.Question, req.UserId, req.Mood)
+	s.achievements.count(req.UserId, "oracle_consulted")
+	s.boards.consulted(req.GroupId, req.UserId, time.Now())
 
 	// Check cache first
 	cacheKey := fmt.Sprintf("%s:%s:%d", req.UserId, req.Question, req.Mood)
@@ -104,7 +125,9 @@ func (s *GamingServer) AskOracle(ctx context.Context, req *OracleRequest) (*Orac
 	if cached, ok := s.oracleCache[cacheKey]; ok {
 		s.mu.RUnlock()
 		log.Printf("🎱 Cache hit for '%s'", req.Question)
+		cached = proto.Clone(cached).(*pb.OracleResponse)
 		cached.FromCache = true
+		s.history.remember(req, cached)
 		return cached, nil
 	}
 	s.mu.RUnlock()
@@ -119,7 +142,7 @@ func (s *GamingServer) AskOracle(ctx context.Context, req *OracleRequest) (*Orac
 	// Get the mood (default to mysterious)
 	mood := req.Mood
 	if _, ok := prophecies[mood]; !ok {
-		mood = MoodMysterious
+		mood = pb.OracleMood_MOOD_MYSTERIOUS
 	}
 
 	// Select prophecy based on quantum outcome
@@ -131,7 +154,7 @@ func (s *GamingServer) AskOracle(ctx context.Context, req *OracleRequest) (*Orac
 	phi := float64(outcome) * math.Pi / 4.0
 	quantumState := fmt.Sprintf("θ=%.3f, φ=%.3f", theta, phi)
 
-	response := &OracleResponse{
+	response := &pb.OracleResponse{
 		Prophecy:     prophecy,
 		OutcomeIndex: int32(outcome),
 		Confidence:   confidence,
@@ -146,6 +169,7 @@ func (s *GamingServer) AskOracle(ctx context.Context, req *OracleRequest) (*Orac
 	s.mu.Lock()
 	s.oracleCache[cacheKey] = response
 	s.mu.Unlock()
+	s.history.remember(req, response)
 
 	log.Printf("🎱 Oracle speaks: [%d] '%s' (confidence: %.0f%%)", outcome, prophecy, confidence*100)
 
@@ -157,6 +181,8 @@ func (s *GamingServer) AskOracle(ctx context.Context, req *OracleRequest) (*Orac
 func (s *GamingServer) measureQuantumState() int {
 	// TODO: Connect to Engine service for real quantum computation
 	// For now, simulate with pseudo-random (still "quantum-inspired")
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
 
 	// Simulate quantum_measure = sum of 3 coin flips (each is 0 or 1)
 	bit0 := s.rng.Intn(2)
@@ -171,7 +197,10 @@ func (s *GamingServer) measureQuantumState() int {
 // GenerateRandom - Quantum random numbers
 // ------------------------------------------------------------------
 
-func (s *GamingServer) GenerateRandom(ctx context.Context, req *RandomRequest) (*RandomResponse, error) {
+func (s *GamingServer) GenerateRandom(ctx context.Context, req *pb.RandomRequest) (*pb.RandomResponse, error) {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
 	count := int(req.Count)
 	if count <= 0 {
 		count = 1
@@ -193,7 +222,7 @@ func (s *GamingServer) GenerateRandom(ctx context.Context, req *RandomRequest) (
 
 	log.Printf("🎲 Generated %d random values [%.2f, %.2f]", count, req.Min, req.Max)
 
-	return &RandomResponse{
+	return &pb.RandomResponse{
 		Values:        values,
 		QuantumSource: "hadamard_measurement",
 		Timestamp:     time.Now().UnixNano(),
@@ -204,7 +233,10 @@ func (s *GamingServer) GenerateRandom(ctx context.Context, req *RandomRequest) (
 // GenerateRandomBytes - Cryptographic quality random bytes
 // ------------------------------------------------------------------
 
-func (s *GamingServer) GenerateRandomBytes(ctx context.Context, req *RandomBytesRequest) (*RandomBytesResponse, error) {
+func (s *GamingServer) GenerateRandomBytes(ctx context.Context, req *pb.RandomBytesRequest) (*pb.RandomBytesResponse, error) {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
 	numBytes := int(req.NumBytes)
 	if numBytes <= 0 {
 		numBytes = 32
@@ -218,7 +250,7 @@ func (s *GamingServer) GenerateRandomBytes(ctx context.Context, req *RandomBytes
 
 	log.Printf("🔐 Generated %d random bytes", numBytes)
 
-	return &RandomBytesResponse{
+	return &pb.RandomBytesResponse{
 		Data:          data,
 		EntropySource: "quantum_measurement_chain",
 	}, nil
@@ -228,7 +260,7 @@ func (s *GamingServer) GenerateRandomBytes(ctx context.Context, req *RandomBytes
 // CreateSuperposition - Schrödinger's game state
 // ------------------------------------------------------------------
 
-func (s *GamingServer) CreateSuperposition(ctx context.Context, req *SuperpositionRequest) (*SuperpositionState, error) {
+func (s *GamingServer) CreateSuperposition(ctx context.Context, req *pb.SuperpositionRequest) (*pb.SuperpositionState, error) {
 	stateID := req.StateId
 	if stateID == "" {
 		stateID = fmt.Sprintf("superpos_%d", time.Now().UnixNano())
@@ -239,16 +271,16 @@ func (s *GamingServer) CreateSuperposition(ctx context.Context, req *Superpositi
 		totalProb += o.Probability
 	}
 
-	outcomes := make([]*OutcomeProbability, len(req.Outcomes))
+	outcomes := make([]*pb.OutcomeProbability, len(req.Outcomes))
 	for i, o := range req.Outcomes {
-		outcomes[i] = &OutcomeProbability{
+		outcomes[i] = &pb.OutcomeProbability{
 			Outcome:     o.Outcome,
 			Probability: o.Probability / totalProb,
 			Value:       o.Value,
 		}
 	}
 
-	state := &SuperpositionState{
+	state := &pb.SuperpositionState{
 		StateId:          stateID,
 		PossibleOutcomes: outcomes,
 		IsCollapsed:      false,
@@ -269,7 +301,7 @@ func (s *GamingServer) CreateSuperposition(ctx context.Context, req *Superpositi
 // CollapseState - Observer collapses the wave function
 // ------------------------------------------------------------------
 
-func (s *GamingServer) CollapseState(ctx context.Context, req *CollapsRequest) (*CollapseResult, error) {
+func (s *GamingServer) CollapseState(ctx context.Context, req *pb.CollapsRequest) (*pb.CollapseResult, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -282,9 +314,11 @@ func (s *GamingServer) CollapseState(ctx context.Context, req *CollapsRequest) (
 		return nil, fmt.Errorf("state already collapsed: %s", req.StateId)
 	}
 
+	s.rngMu.Lock()
 	r := s.rng.Float64()
+	s.rngMu.Unlock()
 	cumulative := 0.0
-	var selectedOutcome *OutcomeProbability
+	var selectedOutcome *pb.OutcomeProbability
 	for _, o := range state.PossibleOutcomes {
 		cumulative += o.Probability
 		if r <= cumulative {
@@ -298,11 +332,12 @@ func (s *GamingServer) CollapseState(ctx context.Context, req *CollapsRequest) (
 	}
 
 	state.IsCollapsed = true
+	s.achievements.count(req.ObserverId, "superposition_collapsed")
 
 	log.Printf("💥 Collapsed %s -> %v (p=%.2f%%) by %s",
 		req.StateId, selectedOutcome.Outcome, selectedOutcome.Probability*100, req.ObserverId)
 
-	return &CollapseResult{
+	return &pb.CollapseResult{
 		StateId:        req.StateId,
 		Outcome:        selectedOutcome.Outcome,
 		OutcomeValue:   selectedOutcome.Value,
@@ -315,7 +350,10 @@ func (s *GamingServer) CollapseState(ctx context.Context, req *CollapsRequest) (
 // QuantumCoinFlip - Fair (or biased) quantum coin flip
 // ------------------------------------------------------------------
 
-func (s *GamingServer) QuantumCoinFlip(ctx context.Context, req *CoinFlipRequest) (*CoinFlipResult, error) {
+func (s *GamingServer) QuantumCoinFlip(ctx context.Context, req *pb.CoinFlipRequest) (*pb.CoinFlipResult, error) {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
 	numFlips := int(req.NumFlips)
 	if numFlips <= 0 {
 		numFlips = 1
@@ -325,7 +363,8 @@ func (s *GamingServer) QuantumCoinFlip(ctx context.Context, req *CoinFlipRequest
 	}
 
 	bias := req.Bias
-	if bias <= 0 || bias >= 1 {
+	if bias <= 0 || bias >= 1 || req.Entangled {
+		// Either half of the Bell state (|01⟩ + |10⟩)/√2 is a fair coin
 		bias = 0.5
 	}
 
@@ -339,13 +378,23 @@ func (s *GamingServer) QuantumCoinFlip(ctx context.Context, req *CoinFlipRequest
 		}
 	}
 
-	log.Printf("🪙 Flipped %d coins (bias=%.2f): %d heads, %d tails",
-		numFlips, bias, headsCount, numFlips-headsCount)
+	// Measuring one half of the pair collapses the other to its opposite
+	var partners []bool
+	if req.Entangled {
+		partners = make([]bool, numFlips)
+		for i, heads := range results {
+			partners[i] = !heads
+		}
+	}
 
-	return &CoinFlipResult{
-		Results:    results,
-		HeadsCount: int32(headsCount),
-		TailsCount: int32(numFlips - headsCount),
+	log.Printf("🪙 Flipped %d coins (bias=%.2f, entangled=%t): %d heads, %d tails",
+		numFlips, bias, req.Entangled, headsCount, numFlips-headsCount)
+
+	return &pb.CoinFlipResult{
+		Results:        results,
+		HeadsCount:     int32(headsCount),
+		TailsCount:     int32(numFlips - headsCount),
+		PartnerResults: partners,
 	}, nil
 }
 
@@ -353,7 +402,14 @@ func (s *GamingServer) QuantumCoinFlip(ctx context.Context, req *CoinFlipRequest
 // QuantumDiceRoll - Roll quantum dice
 // ------------------------------------------------------------------
 
-func (s *GamingServer) QuantumDiceRoll(ctx context.Context, req *DiceRequest) (*DiceResult, error) {
+func (s *GamingServer) QuantumDiceRoll(ctx context.Context, req *pb.DiceRequest) (*pb.DiceResult, error) {
+	if req.KeepHighest > 0 && req.KeepLowest > 0 {
+		return nil, status.Error(codes.InvalidArgument, "keep_highest and keep_lowest are exclusive")
+	}
+
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
 	numDice := int(req.NumDice)
 	if numDice <= 0 {
 		numDice = 1
@@ -384,21 +440,55 @@ func (s *GamingServer) QuantumDiceRoll(ctx context.Context, req *DiceRequest) (*
 		}
 	}
 
-	log.Printf("🎯 Rolled %dd%d: %v = %d", numDice, sides, rolls, sum)
+	kept, total := keepRolls(rolls, int(req.KeepHighest), int(req.KeepLowest))
+	s.boards.rolled(req.GroupId, req.UserId, rolls, sides, time.Now())
 
-	return &DiceResult{
+	log.Printf("🎯 Rolled %dd%d: %v = %d (total %d)", numDice, sides, rolls, sum, total)
+
+	return &pb.DiceResult{
 		Rolls:   rolls,
 		Sum:     int32(sum),
 		MinRoll: int32(minRoll),
 		MaxRoll: int32(maxRoll),
+		Kept:    kept,
+		Total:   int32(total),
 	}, nil
 }
 
+// keepRolls marks the rolls that count, the highest or lowest n of them
+// when asked, and totals them. Ties go to the earlier roll.
+func keepRolls(rolls []int32, highest, lowest int) ([]bool, int) {
+	kept := make([]bool, len(rolls))
+	order := make([]int, len(rolls))
+	for i := range order {
+		order[i] = i
+	}
+	n := len(rolls)
+	switch {
+	case highest > 0:
+		n = min(highest, n)
+		sort.SliceStable(order, func(a, b int) bool { return rolls[order[a]] > rolls[order[b]] })
+	case lowest > 0:
+		n = min(lowest, n)
+		sort.SliceStable(order, func(a, b int) bool { return rolls[order[a]] < rolls[order[b]] })
+	}
+
+	total := 0
+	for _, i := range order[:n] {
+		kept[i] = true
+		total += int(rolls[i])
+	}
+	return kept, total
+}
+
 // ------------------------------------------------------------------
 // ShuffleDeck - Fisher-Yates with quantum randomness
 // ------------------------------------------------------------------
 
-func (s *GamingServer) ShuffleDeck(ctx context.Context, req *ShuffleRequest) (*ShuffledDeck, error) {
+func (s *GamingServer) ShuffleDeck(ctx context.Context, req *pb.ShuffleRequest) (*pb.ShuffledDeck, error) {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
 	deckSize := int(req.DeckSize)
 	if deckSize <= 0 {
 		deckSize = 52
@@ -426,142 +516,12 @@ func (s *GamingServer) ShuffleDeck(ctx context.Context, req *ShuffleRequest) (*S
 
 	log.Printf("🃏 Shuffled %d-card deck (type=%s)", deckSize, req.DeckType)
 
-	return &ShuffledDeck{
+	return &pb.ShuffledDeck{
 		CardOrder:    deck,
 		ShuffleProof: proof,
 	}, nil
 }
 
-// ------------------------------------------------------------------
-// Types (would be generated from protobuf)
-// ------------------------------------------------------------------
-
-type OracleMood int32
-
-const (
-	MoodMysterious    OracleMood = 0
-	MoodSarcastic     OracleMood = 1
-	MoodPhilosophical OracleMood = 2
-	MoodChaotic       OracleMood = 3
-)
-
-type OracleRequest struct {
-	Question  string
-	UserId    string
-	Mood      OracleMood
-	SessionId string
-}
-
-type OracleResponse struct {
-	Prophecy     string
-	OutcomeIndex int32
-	Confidence   float64
-	QuantumState string
-	Timestamp    int64
-	FromCache    bool
-	CircuitId    string
-	QubitsUsed   int32
-}
-
-type RandomRequest struct {
-	Count        int32
-	Min          float64
-	Max          float64
-	IntegersOnly bool
-}
-
-type RandomResponse struct {
-	Values        []float64
-	QuantumSource string
-	Timestamp     int64
-}
-
-type RandomBytesRequest struct {
-	NumBytes int32
-}
-
-type RandomBytesResponse struct {
-	Data          []byte
-	EntropySource string
-}
-
-type GameOutcome int32
-
-const (
-	OutcomeUnknown GameOutcome = 0
-	OutcomeWin     GameOutcome = 1
-	OutcomeLose    GameOutcome = 2
-	OutcomeDraw    GameOutcome = 3
-	OutcomeBonus   GameOutcome = 4
-	OutcomeJackpot GameOutcome = 5
-)
-
-type OutcomeProbability struct {
-	Outcome     GameOutcome
-	Probability float64
-	Value       int32
-}
-
-type SuperpositionRequest struct {
-	StateId           string
-	Outcomes          []*OutcomeProbability
-	ObservationQubits int32
-}
-
-type SuperpositionState struct {
-	StateId          string
-	PossibleOutcomes []*OutcomeProbability
-	IsCollapsed      bool
-	CreatedAt        int64
-	ExpiresAt        int64
-}
-
-type CollapsRequest struct {
-	StateId    string
-	ObserverId string
-}
-
-type CollapseResult struct {
-	StateId        string
-	Outcome        GameOutcome
-	OutcomeValue   int32
-	ProbabilityWas float64
-	CollapsedAt    int64
-}
-
-type CoinFlipRequest struct {
-	NumFlips int32
-	Bias     float64
-}
-
-type CoinFlipResult struct {
-	Results    []bool
-	HeadsCount int32
-	TailsCount int32
-}
-
-type DiceRequest struct {
-	NumDice int32
-	Sides   int32
-}
-
-type DiceResult struct {
-	Rolls   []int32
-	Sum     int32
-	MinRoll int32
-	MaxRoll int32
-}
-
-type ShuffleRequest struct {
-	DeckSize int32
-	DeckType string
-}
-
-type ShuffledDeck struct {
-	CardOrder    []int32
-	ShuffleProof string
-}
-
 // ------------------------------------------------------------------
 // Main
 // ------------------------------------------------------------------
@@ -569,9 +529,30 @@ type ShuffledDeck struct {
 func main() {
 	port := flag.Int("port", 50061, "gRPC port")
 	engineAddr := flag.String("engine-addr", "qubit-engine:50051", "Engine service address")
+	educationAddr := flag.String("education-addr", "", "Education service to report badge progress to (empty: off)")
+	cryptoAddr := flag.String("crypto-addr", "", "Crypto service that referees head-to-head flips (empty: off)")
 	flag.Parse()
 
-	server := NewGamingServer(*engineAddr)
+	var achievements *achievementReporter
+	if *educationAddr != "" {
+		conn, err := grpc.Dial(*educationAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to connect to education: %v", err)
+		}
+		defer conn.Close()
+		achievements = newAchievementReporter(edu.NewQuantumEducationClient(conn))
+		go achievements.run(context.Background(), achievementFlushInterval)
+	}
+
+	server := NewGamingServer(*engineAddr, achievements)
+	if *cryptoAddr != "" {
+		conn, err := grpc.Dial(*cryptoAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to connect to crypto: %v", err)
+		}
+		defer conn.Close()
+		server.flips = newFlipReferee(qcrypto.NewQuantumCryptoClient(conn))
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
@@ -579,16 +560,24 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	// RegisterQuantumGamingServer(grpcServer, server)
+	pb.RegisterQuantumGamingServer(grpcServer, server)
+
+	// The Discord bot checks this before consulting the Oracle
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(pb.QuantumGaming_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 
 	log.Printf("🎮 Quantum Gaming + Oracle starting on port %d", *port)
 	log.Printf("   Engine address: %s", *engineAddr)
 	log.Printf("   Features: RNG, Coin Flips, Dice, Deck Shuffle, Superposition, 🎱 ORACLE")
+	if *educationAddr != "" {
+		log.Printf("   Badges: reported to %s", *educationAddr)
+	}
+	if *cryptoAddr != "" {
+		log.Printf("   Head-to-head flips: refereed by %s", *cryptoAddr)
+	}
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-
-	_ = server
-	_ = grpc.WithTransportCredentials(insecure.NewCredentials()) // For future Engine connection
 }
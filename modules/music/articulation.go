@@ -0,0 +1,55 @@
+// Articulation & Humanization from Quantum Phase
+// Amplitude magnitude already sets velocity; the phase of the collapsed
+// amplitude now shapes how the note is played. With φ = arg(a_k):
+//
+//	articulation = 0.75 + 0.25·cos φ   (φ = 0 legato … φ = π staccato)
+//	timing offset = maxHumanize · sin φ (ahead of or behind the beat)
+
+package main
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+const (
+	// Gate bounds as a fraction of the written duration
+	staccatoGate = 0.5
+	legatoGate   = 1.0
+
+	// maxHumanize is the largest micro-timing shift in beats (~15 ms @ 120 BPM)
+	maxHumanize = 0.03
+)
+
+// Phases returns arg(a_i) for each basis state
+func (sv *StateVector) Phases() []float64 {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	phases := make([]float64, len(sv.Amplitudes))
+	for i, a := range sv.Amplitudes {
+		if a != 0 {
+			phases[i] = cmplx.Phase(a)
+		}
+	}
+	return phases
+}
+
+// phaseArticulation maps an amplitude phase to the sounding fraction of the
+// note and a timing offset in beats
+func phaseArticulation(phase float64) (articulation, timingOffset float64) {
+	mid := (legatoGate + staccatoGate) / 2
+	swing := (legatoGate - staccatoGate) / 2
+	return mid + swing*math.Cos(phase), maxHumanize * math.Sin(phase)
+}
+
+// performedSpan is when the note actually sounds: its humanized start and
+// articulated length. Notes without an articulation play their full value.
+func (n QuantumNote) performedSpan() (start, duration float64) {
+	start = math.Max(0, n.StartTime+n.TimingOffset)
+	duration = n.Duration
+	if n.Articulation > 0 {
+		duration *= n.Articulation
+	}
+	return start, duration
+}
@@ -0,0 +1,161 @@
+// Engine Measurement Circuits
+// A probability distribution over 2^k outcomes is amplitude-encoded into a
+// k-qubit register (|ψ⟩ = Σ √p_i |i⟩) and measured on the Qubit Engine, so
+// every note is decided by a real projective measurement.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"time"
+
+	engine "github.com/perclft/QubitEngine/modules/music/generated/engine"
+)
+
+// engineTimeout bounds a single measurement round trip
+const engineTimeout = 2 * time.Second
+
+// measureOnEngine runs the amplitude-encoding circuit for probs and decodes
+// the measured basis state from the classical registers
+func (qe *QuantumEngineClient) measureOnEngine(probs []float64) (int, error) {
+	numQubits, ops := buildMeasurementCircuit(probs)
+
+	ctx, cancel := context.WithTimeout(context.Background(), engineTimeout)
+	defer cancel()
+
+	resp, err := qe.client.RunCircuit(ctx, &engine.CircuitRequest{
+		NumQubits:  int32(numQubits),
+		Operations: ops,
+		Seed:       qe.nextSeed(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	outcome := 0
+	for q := 0; q < numQubits; q++ {
+		bit, ok := resp.ClassicalResults[uint32(q)]
+		if !ok {
+			return 0, fmt.Errorf("engine returned no result for qubit %d", q)
+		}
+		if bit {
+			outcome |= 1 << q
+		}
+	}
+	if outcome >= len(probs) {
+		return 0, errors.New("engine measured a state outside the distribution")
+	}
+	return outcome, nil
+}
+
+// buildMeasurementCircuit prepares Σ √p_i |i⟩ from |0…0⟩ and measures every
+// qubit (qubit q holds bit q of the outcome, stored in register q).
+//
+// The state is built top-down: qubit k−1 gets RY(θ) splitting the mass
+// between the upper and lower halves, then each lower qubit gets a rotation
+// uniformly controlled by the qubits above it. For a uniform distribution
+// every angle is π/2 and the circuit reduces to the Hadamard layer.
+func buildMeasurementCircuit(probs []float64) (int, []*engine.GateOperation) {
+	numQubits := bits.Len(uint(len(probs) - 1))
+	if numQubits == 0 {
+		numQubits = 1
+	}
+	dist := normalizedDistribution(probs, 1<<numQubits)
+
+	var ops []*engine.GateOperation
+	for level := 0; level < numQubits; level++ {
+		target := numQubits - 1 - level
+
+		// θ_c for every setting c of the level controls (the qubits above target)
+		thetas := make([]float64, 1<<level)
+		for c := range thetas {
+			zero, total := 0.0, 0.0
+			span := 1 << target
+			base := c << (target + 1)
+			for i := 0; i < 2*span; i++ {
+				total += dist[base+i]
+				if i < span {
+					zero += dist[base+i]
+				}
+			}
+			if total > 0 {
+				thetas[c] = 2 * math.Acos(math.Sqrt(math.Min(1, zero/total)))
+			}
+		}
+		ops = append(ops, uniformlyControlledRY(target, thetas)...)
+	}
+
+	for q := 0; q < numQubits; q++ {
+		ops = append(ops, &engine.GateOperation{
+			Type:              engine.GateOperation_MEASURE,
+			TargetQubit:       uint32(q),
+			ClassicalRegister: uint32(q),
+		})
+	}
+	return numQubits, ops
+}
+
+// uniformlyControlledRY applies RY(θ_c) to target for each basis setting c
+// of the qubits above it, using the Gray-code decomposition into 2^l RY and
+// 2^l CNOT gates: α = 2^−l · Mᵀθ with M_ij = (−1)^(j · gray(i)).
+// Control bit b of c is qubit target+1+b.
+func uniformlyControlledRY(target int, thetas []float64) []*engine.GateOperation {
+	n := len(thetas)
+	if n == 1 {
+		return []*engine.GateOperation{ry(target, thetas[0])}
+	}
+
+	var ops []*engine.GateOperation
+	for i := 0; i < n; i++ {
+		gray := i ^ (i >> 1)
+		alpha := 0.0
+		for j, theta := range thetas {
+			if bits.OnesCount(uint(j&gray))%2 == 0 {
+				alpha += theta
+			} else {
+				alpha -= theta
+			}
+		}
+		ops = append(ops, ry(target, alpha/float64(n)))
+
+		nextGray := ((i + 1) % n) ^ (((i + 1) % n) >> 1)
+		control := target + 1 + bits.TrailingZeros(uint(gray^nextGray))
+		ops = append(ops, &engine.GateOperation{
+			Type:         engine.GateOperation_CNOT,
+			ControlQubit: uint32(control),
+			TargetQubit:  uint32(target),
+		})
+	}
+	return ops
+}
+
+func ry(target int, angle float64) *engine.GateOperation {
+	return &engine.GateOperation{
+		Type:        engine.GateOperation_ROTATION_Y,
+		TargetQubit: uint32(target),
+		Angle:       angle,
+	}
+}
+
+// normalizedDistribution pads probs to size entries and rescales them to
+// sum to 1 (uniform if they are all zero)
+func normalizedDistribution(probs []float64, size int) []float64 {
+	dist := make([]float64, size)
+	total := 0.0
+	for i, p := range probs {
+		dist[i] = math.Max(0, p)
+		total += dist[i]
+	}
+	for i := range dist {
+		if total > 0 {
+			dist[i] /= total
+		} else {
+			dist[i] = 1 / float64(size)
+		}
+	}
+	return dist
+}
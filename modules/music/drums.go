@@ -0,0 +1,178 @@
+// Qubit Drum Machine - one qubit per instrument
+// Kick, snare and hi-hat are qubits 0, 1 and 2 of a 3-qubit register that
+// is prepared and measured once per 16th-note step: a 1 means the
+// instrument hits. Each qubit starts rotated toward the groove template's
+// hit probability for that step, then interference rules boost or damp
+// joint basis states (e.g. |kick·snare⟩), so hits are correlated within a
+// step instead of independent coin flips.
+
+package main
+
+import (
+	"log"
+	"math"
+	"math/cmplx"
+)
+
+// drumRule scales the amplitude of every basis state containing all of
+// the instruments in Mask (Factor > 1 encourages them together, < 1
+// discourages). OnBeat limits the rule to steps on the beat.
+type drumRule struct {
+	Mask   int
+	Factor float64
+	OnBeat bool
+}
+
+// drumTemplate gives each instrument's hit probability per 16th step of a
+// 4/4 bar (other meters use the first beatsPerBar×4 steps, repeating)
+type drumTemplate struct {
+	Hits  [3][16]float64 // [instrument][step]
+	Rules []drumRule
+}
+
+const (
+	kickQubit  = 1 << InstrumentKick
+	snareQubit = 1 << InstrumentSnare
+	hatQubit   = 1 << InstrumentHiHat
+)
+
+var drumTemplates = map[string]drumTemplate{
+	"rock": {
+		Hits: [3][16]float64{
+			{0.95, 0, 0.1, 0, 0.05, 0, 0.2, 0.1, 0.85, 0, 0.3, 0, 0.05, 0, 0.15, 0.1},
+			{0, 0, 0, 0, 0.95, 0, 0, 0.05, 0, 0, 0, 0.05, 0.95, 0, 0.1, 0.15},
+			{0.9, 0.1, 0.85, 0.1, 0.9, 0.1, 0.85, 0.1, 0.9, 0.1, 0.85, 0.1, 0.9, 0.1, 0.85, 0.2},
+		},
+		Rules: []drumRule{
+			{Mask: kickQubit | snareQubit, Factor: 0.2}, // Backbeat stays clean
+			{Mask: kickQubit | hatQubit, Factor: 1.3, OnBeat: true},
+		},
+	},
+	"jazz": {
+		Hits: [3][16]float64{
+			{0.4, 0, 0, 0.05, 0.1, 0, 0, 0.1, 0.3, 0, 0, 0.05, 0.1, 0, 0, 0.15},
+			{0, 0, 0.1, 0.25, 0, 0, 0.15, 0.3, 0, 0, 0.1, 0.25, 0, 0, 0.2, 0.35},
+			{0.9, 0, 0, 0, 0.9, 0, 0, 0.7, 0.9, 0, 0, 0, 0.9, 0, 0, 0.7},
+		},
+		Rules: []drumRule{
+			{Mask: kickQubit | snareQubit, Factor: 0.5},
+			{Mask: snareQubit | hatQubit, Factor: 1.4}, // Comping rides with the cymbal
+		},
+	},
+	"electronic": {
+		Hits: [3][16]float64{
+			{0.98, 0, 0, 0.05, 0.98, 0, 0, 0.05, 0.98, 0, 0, 0.1, 0.98, 0, 0.1, 0.1},
+			{0, 0, 0, 0, 0.9, 0, 0, 0, 0, 0, 0, 0.05, 0.9, 0, 0.1, 0.2},
+			{0.05, 0.1, 0.95, 0.1, 0.05, 0.1, 0.95, 0.1, 0.05, 0.1, 0.95, 0.1, 0.05, 0.1, 0.95, 0.3},
+		},
+		Rules: []drumRule{
+			{Mask: kickQubit | hatQubit, Factor: 0.3}, // Off-beat hats dodge the kick
+			{Mask: kickQubit | snareQubit, Factor: 1.2, OnBeat: true},
+		},
+	},
+}
+
+// DrumState prepares the step's register: RY(2·asin√p_i) on each
+// instrument qubit (a product state), then the template's joint rules
+func DrumState(tmpl drumTemplate, step int, gamma float64) [8]float64 {
+	var hit [3]float64
+	for i := range hit {
+		hit[i] = math.Pow(tmpl.Hits[i][step%16], gamma)
+	}
+
+	amps := make([]complex128, 8)
+	for k := range amps {
+		amp := 1.0
+		for i := range hit {
+			if k&(1<<i) != 0 {
+				amp *= math.Sqrt(hit[i])
+			} else {
+				amp *= math.Sqrt(1 - hit[i])
+			}
+		}
+		for _, r := range tmpl.Rules {
+			if k&r.Mask == r.Mask && (!r.OnBeat || step%stepsPerBeat == 0) {
+				amp *= r.Factor
+			}
+		}
+		amps[k] = complex(amp, 0)
+	}
+
+	sv := &StateVector{Amplitudes: amps}
+	sv.Normalize()
+	var probs [8]float64
+	for k, a := range sv.Amplitudes {
+		probs[k] = cmplx.Abs(a) * cmplx.Abs(a)
+	}
+	return probs
+}
+
+// GenerateQubitDrums measures the drum register once per 16th step. swing
+// and density behave as in GenerateQuantumRhythm.
+func (s *MusicServer) GenerateQubitDrums(beatsPerBar, numBars int, style string, swing, density float64) *RhythmPattern {
+	if beatsPerBar <= 0 {
+		beatsPerBar = 4
+	}
+	if numBars <= 0 {
+		numBars = 1
+	}
+	tmpl, ok := drumTemplates[style]
+	if !ok {
+		style = "rock"
+		tmpl = drumTemplates[style]
+	}
+	swing = math.Max(0, math.Min(1, swing))
+	density = math.Max(0, math.Min(1, density))
+	gamma := math.Pow(2, 1-2*density)
+
+	steps := beatsPerBar * stepsPerBeat
+	stepLen := 1.0 / stepsPerBeat
+	pattern := &RhythmPattern{
+		BeatsPerBar: beatsPerBar,
+		Bars:        numBars,
+		Style:       style,
+		Swing:       swing,
+		Density:     density,
+	}
+
+	log.Printf("🥁 Generating %d-bar QUBIT drum pattern (%s, 3 qubits × %d steps/bar)...", numBars, style, steps)
+
+	for bar := 0; bar < numBars; bar++ {
+		for x := 0; x < steps; x++ {
+			probs := DrumState(tmpl, x, gamma)
+			outcome := s.engineClient.Measure3Qubits(probs)
+
+			t := float64(bar*beatsPerBar) + float64(x)*stepLen
+			if x%2 == 1 {
+				t += swing * stepLen / 3
+			}
+			accent := 0.7
+			if x%stepsPerBeat == 0 {
+				accent = 1.0
+			}
+
+			for inst := InstrumentKick; inst <= InstrumentHiHat; inst++ {
+				if outcome&(1<<inst) == 0 {
+					continue
+				}
+				pHit := 0.0
+				for k, p := range probs {
+					if k&(1<<inst) != 0 {
+						pHit += p
+					}
+				}
+				pattern.Events = append(pattern.Events, BeatEvent{
+					Time:           t,
+					Instrument:     inst,
+					Velocity:       math.Min(1, accent*(0.6+0.4*pHit)),
+					HitProbability: pHit,
+				})
+			}
+		}
+	}
+
+	sortBeatEvents(pattern.Events)
+
+	log.Printf("🥁 Drum pattern complete: %d hits over %d bars", len(pattern.Events), numBars)
+	return pattern
+}
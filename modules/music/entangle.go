@@ -0,0 +1,218 @@
+// Entangled Counterpoint - melody and bass from one 6-qubit state
+// Qubits 0-2 hold the melody degree and qubits 3-5 the bass degree. Joint
+// amplitudes of consonant pairs are boosted before measurement, so the two
+// outcomes are correlated instead of independently random.
+
+package main
+
+import (
+	"log"
+	"math"
+	"math/cmplx"
+)
+
+// ------------------------------------------------------------------
+// Joint (melody ⊗ bass) State
+// ------------------------------------------------------------------
+
+// Harmonic intervals between scale degrees (mod 7). Unison/octave, thirds,
+// fifths and sixths are consonant; seconds and sevenths clash.
+var (
+	consonantIntervals = map[int]bool{0: true, 2: true, 4: true, 5: true}
+	dissonantIntervals = map[int]bool{1: true, 6: true}
+)
+
+// JointState is the 64-dimensional state |melody⟩ ⊗ |bass⟩ (index m<<3 | b)
+type JointState struct {
+	Amplitudes [64]complex128
+}
+
+// NewEntangledState builds the joint state for the next simultaneity.
+// Each voice first gets its own consonant-follower bias (a product state);
+// coupling then boosts consonant (m, b) pairs and damps dissonant ones,
+// which no longer factorizes - the voices are entangled.
+func NewEntangledState(prevMelody, prevBass int, coupling float64) *JointState {
+	melody := followerWeights(prevMelody)
+	bass := followerWeights(prevBass)
+
+	js := &JointState{}
+	for m := 0; m < 8; m++ {
+		for b := 0; b < 8; b++ {
+			amp := melody[m] * bass[b]
+			if m != 7 && b != 7 {
+				interval := ((m-b)%7 + 7) % 7
+				if consonantIntervals[interval] {
+					amp *= 1 + coupling
+				} else if dissonantIntervals[interval] {
+					amp /= 1 + coupling
+				}
+			}
+			js.Amplitudes[m<<3|b] = complex(amp, 0)
+		}
+	}
+	js.Normalize()
+	return js
+}
+
+// followerWeights is one voice's amplitude profile after applying the
+// consonant-follower boost from applyMusicalInterference
+func followerWeights(prev int) [8]float64 {
+	var w [8]float64
+	for i := range w {
+		w[i] = 1
+	}
+	if prev >= 0 && prev < 7 {
+		for _, f := range consonantFollowers[prev] {
+			w[f] *= math.Sqrt(2)
+		}
+	}
+	return w
+}
+
+// Normalize ensures Σ|a_i|² = 1
+func (js *JointState) Normalize() {
+	var total float64
+	for _, a := range js.Amplitudes {
+		total += cmplx.Abs(a) * cmplx.Abs(a)
+	}
+	if total > 0 {
+		factor := complex(1.0/math.Sqrt(total), 0)
+		for i := range js.Amplitudes {
+			js.Amplitudes[i] *= factor
+		}
+	}
+}
+
+// Probabilities returns |a_i|² for each joint basis state
+func (js *JointState) Probabilities() [64]float64 {
+	var probs [64]float64
+	for i, a := range js.Amplitudes {
+		probs[i] = cmplx.Abs(a) * cmplx.Abs(a)
+	}
+	return probs
+}
+
+// Marginals traces out the other voice, returning P(melody) and P(bass)
+func (js *JointState) Marginals() (melody, bass [8]float64) {
+	probs := js.Probabilities()
+	for i, p := range probs {
+		melody[i>>3] += p
+		bass[i&7] += p
+	}
+	return melody, bass
+}
+
+// MutualInformation is I(M;B) in bits for a computational-basis
+// measurement - zero for a product state, larger the stronger the coupling
+func (js *JointState) MutualInformation() float64 {
+	probs := js.Probabilities()
+	melody, bass := js.Marginals()
+	info := 0.0
+	for i, p := range probs {
+		if p > 0 {
+			info += p * math.Log2(p/(melody[i>>3]*bass[i&7]))
+		}
+	}
+	return info
+}
+
+// MeasureJoint samples the 6-qubit register: the melody qubits are measured
+// first, then the bass qubits from the state they collapsed to
+func (qe *QuantumEngineClient) MeasureJoint(js *JointState) (melody, bass int) {
+	probs := js.Probabilities()
+	marginal, _ := js.Marginals()
+	melody = qe.Measure3Qubits(marginal)
+
+	var conditional [8]float64
+	if marginal[melody] > 0 {
+		for b := range conditional {
+			conditional[b] = probs[melody<<3|b] / marginal[melody]
+		}
+	}
+	bass = qe.Measure3Qubits(conditional)
+	return melody, bass
+}
+
+// ------------------------------------------------------------------
+// Duet Generation
+// ------------------------------------------------------------------
+
+// GenerateEntangledDuet writes note-against-note counterpoint: each step
+// prepares a fresh entangled state from the previous pair and measures
+// both voices at once. Melody sits on rootNote, bass two octaves below.
+func (s *MusicServer) GenerateEntangledDuet(scale string, rootNote, numNotes int, tempo, coupling float64) *Duet {
+	duet := &Duet{
+		Score: &Score{
+			Scale:       scale,
+			RootNote:    rootNote,
+			Tempo:       tempo,
+			BeatsPerBar: 4,
+		},
+	}
+	melodySpec, bassSpec := trackSpecs[TrackMelody], trackSpecs[TrackBass]
+	melodyTrack := &Track{Name: melodySpec.Name, Role: TrackMelody, Channel: melodySpec.Channel, Program: melodySpec.Program}
+	bassTrack := &Track{Name: bassSpec.Name, Role: TrackBass, Channel: bassSpec.Channel, Program: bassSpec.Program}
+
+	log.Printf("🔗 Generating %d-step ENTANGLED duet (coupling=%.2f)...", numNotes, coupling)
+
+	prevMelody, prevBass := -1, -1
+	currentTime := 0.0
+	consonant, sounding := 0, 0
+	for i := 0; i < numNotes; i++ {
+		js := NewEntangledState(prevMelody, prevBass, coupling)
+		melodyProbs, bassProbs := js.Marginals()
+		duet.MutualInformation += js.MutualInformation() / float64(numNotes)
+
+		m, b := s.engineClient.MeasureJoint(js)
+		prevMelody, prevBass = m, b
+
+		durationIndex := s.engineClient.Measure3Qubits([8]float64{0.1, 0.2, 0.3, 0.2, 0.15, 0.03, 0.01, 0.01})
+		duration := melodyDurations[durationIndex%len(melodyDurations)]
+
+		melodyTrack.Notes = append(melodyTrack.Notes, duetNote(scale, rootNote, m, duration, currentTime, melodyProbs))
+		bassTrack.Notes = append(bassTrack.Notes, duetNote(scale, rootNote-24, b, duration, currentTime, bassProbs))
+		bassTrack.Notes[i].Velocity *= bassSpec.Velocity
+
+		if m != 7 && b != 7 {
+			sounding++
+			if consonantIntervals[((m-b)%7+7)%7] {
+				consonant++
+			}
+		}
+
+		log.Printf("  Step %d: |%d⟩|%d⟩ → %s / %s", i+1, m, b, noteNames[m], noteNames[b])
+		currentTime += duration
+	}
+
+	if sounding > 0 {
+		duet.Consonance = float64(consonant) / float64(sounding)
+	}
+	duet.Score.Tracks = []*Track{melodyTrack, bassTrack}
+	duet.Score.Bars = int(math.Ceil(currentTime / float64(duet.Score.BeatsPerBar)))
+
+	log.Printf("🔗 Duet complete: %.0f%% consonant, I(M;B)=%.3f bits", duet.Consonance*100, duet.MutualInformation)
+	return duet
+}
+
+func duetNote(scale string, rootNote, outcome int, duration, start float64, probs [8]float64) QuantumNote {
+	return QuantumNote{
+		Pitch:            degreePitch(scale, rootNote, outcome),
+		NoteName:         noteNames[outcome],
+		Duration:         duration,
+		Velocity:         0.5 + probs[outcome]*0.5,
+		StartTime:        start,
+		QuantumOutcome:   outcome,
+		StateProbsBefore: probs[:],
+		Frequency:        noteFrequencies[outcome],
+	}
+}
+
+// ------------------------------------------------------------------
+// Types
+// ------------------------------------------------------------------
+
+type Duet struct {
+	Score             *Score  // Melody and bass tracks
+	Consonance        float64 // Fraction of sounding pairs on consonant intervals
+	MutualInformation float64 // Mean I(M;B) per step in bits
+}
@@ -0,0 +1,324 @@
+// Feedback-Evolved Melodies
+// Each user has a population of genomes - per-state amplitude biases, a
+// stepwise-motion boost and a phase shift - that are applied to the voice
+// on top of the musical interference. Users rate candidate melodies; the
+// best-rated genomes breed the next generation, with mutation steps drawn
+// from a measured 3-qubit Gaussian. Populations persist as JSON per user.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/cmplx"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+const (
+	populationSize = 8
+	mutationScale  = 0.15 // Gene change per Gaussian bin
+	maxDegreeBias  = 3.0
+	maxStepBoost   = 3.0
+)
+
+// gaussianBins approximates N(0, 1) over the 8 basis states of the mutation
+// register; bin k is a step of (k − 3.5) standard deviations / 1.75
+var gaussianBins = [8]float64{0.02, 0.06, 0.14, 0.28, 0.28, 0.14, 0.06, 0.02}
+
+var validUserID = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// Genome biases a voice's state vector before each collapse
+type Genome struct {
+	DegreeBias [8]float64 `json:"degree_bias"` // Amplitude factor per basis state (mod 8)
+	StepBoost  float64    `json:"step_boost"`  // Amplitude factor for states one degree from the last
+	PhaseShift float64    `json:"phase_shift"` // Extra phase per basis index (radians)
+}
+
+// NeutralGenome leaves the interference untouched
+func NeutralGenome() Genome {
+	g := Genome{StepBoost: 1}
+	for i := range g.DegreeBias {
+		g.DegreeBias[i] = 1
+	}
+	return g
+}
+
+// Apply multiplies a_k by DegreeBias[k], StepBoost for neighbours of prev
+// and e^(i·PhaseShift·k), then renormalizes
+func (g *Genome) Apply(sv *StateVector, spec *ScaleSpec, prev int) {
+	if g == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	from := -1
+	if prev >= 0 {
+		from = spec.Degree(prev)
+	}
+	for k := range sv.Amplitudes {
+		factor := g.DegreeBias[k%len(g.DegreeBias)]
+		if to := spec.Degree(k); from >= 0 && to >= 0 && abs(to-from) == 1 {
+			factor *= g.StepBoost
+		}
+		sv.Amplitudes[k] *= cmplx.Rect(factor, g.PhaseShift*float64(k))
+	}
+	sv.Normalize()
+}
+
+// ------------------------------------------------------------------
+// Evolution
+// ------------------------------------------------------------------
+
+// Evolution is one user's persisted population
+type Evolution struct {
+	User       string            `json:"user"`
+	Generation int               `json:"generation"`
+	Population []Genome          `json:"population"`
+	Pending    map[string]Genome `json:"pending"` // Candidates awaiting a rating
+	MeanRating float64           `json:"mean_rating"`
+}
+
+// Candidate is a melody offered for rating
+type Candidate struct {
+	ID     string
+	Genome Genome
+	Notes  []QuantumNote
+}
+
+// rated is a pending genome with the user's rating
+type rated struct {
+	Genome Genome
+	Rating float64
+}
+
+// newEvolution seeds a population of mutated neutral genomes
+func newEvolution(user string, qe *QuantumEngineClient) *Evolution {
+	e := &Evolution{User: user, Pending: make(map[string]Genome)}
+	for i := 0; i < populationSize; i++ {
+		g := NeutralGenome()
+		if i > 0 {
+			mutate(&g, qe)
+		}
+		e.Population = append(e.Population, g)
+	}
+	return e
+}
+
+// Breed replaces the population with the top-rated half and their
+// offspring: each child takes every gene from one of two parents (picked by
+// measuring a rating-weighted register), then mutates
+func (e *Evolution) Breed(ratings []rated, qe *QuantumEngineClient) {
+	if len(ratings) == 0 {
+		return
+	}
+	sort.SliceStable(ratings, func(i, j int) bool { return ratings[i].Rating > ratings[j].Rating })
+	parents := ratings[:(len(ratings)+1)/2]
+
+	total := 0.0
+	for _, r := range ratings {
+		total += r.Rating
+	}
+	e.MeanRating = total / float64(len(ratings))
+
+	weights := make([]float64, len(parents))
+	for i, p := range parents {
+		weights[i] = p.Rating
+	}
+	normalizeProbs(weights)
+
+	next := make([]Genome, 0, populationSize)
+	for _, p := range parents {
+		next = append(next, p.Genome)
+	}
+	for len(next) < populationSize {
+		a := parents[pickWeighted(weights, qe)].Genome
+		b := parents[pickWeighted(weights, qe)].Genome
+		child := a
+		for i := range child.DegreeBias {
+			if qe.MeasureBit(0.5) {
+				child.DegreeBias[i] = b.DegreeBias[i]
+			}
+		}
+		if qe.MeasureBit(0.5) {
+			child.StepBoost = b.StepBoost
+		}
+		if qe.MeasureBit(0.5) {
+			child.PhaseShift = b.PhaseShift
+		}
+		mutate(&child, qe)
+		next = append(next, child)
+	}
+
+	e.Population = next
+	e.Generation++
+	log.Printf("🧬 %s: generation %d bred from %d ratings (mean %.2f)", e.User, e.Generation, len(ratings), e.MeanRating)
+}
+
+// LearnedBias is the population's mean genome
+func (e *Evolution) LearnedBias() Genome {
+	var mean Genome
+	for _, g := range e.Population {
+		for i := range mean.DegreeBias {
+			mean.DegreeBias[i] += g.DegreeBias[i] / float64(len(e.Population))
+		}
+		mean.StepBoost += g.StepBoost / float64(len(e.Population))
+		mean.PhaseShift += g.PhaseShift / float64(len(e.Population))
+	}
+	return mean
+}
+
+// pickWeighted samples an index from a distribution of any length by
+// padding it to the next power of two
+func pickWeighted(weights []float64, qe *QuantumEngineClient) int {
+	size := 1
+	for size < len(weights) {
+		size *= 2
+	}
+	probs := make([]float64, size)
+	copy(probs, weights)
+	return qe.MeasureState(probs) % len(weights)
+}
+
+// mutate nudges every gene by a measured Gaussian step
+func mutate(g *Genome, qe *QuantumEngineClient) {
+	step := func() float64 {
+		return (float64(qe.Measure3Qubits(gaussianBins)) - 3.5) / 1.75 * mutationScale
+	}
+	for i := range g.DegreeBias {
+		g.DegreeBias[i] = clamp(g.DegreeBias[i]*(1+step()), 0.05, maxDegreeBias)
+	}
+	g.StepBoost = clamp(g.StepBoost*(1+step()), 0.05, maxStepBoost)
+	g.PhaseShift = math.Mod(g.PhaseShift+step(), 2*math.Pi)
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
+
+// EvolveMelodies applies the user's ratings, breeds if any were given, and
+// returns fresh candidates from the (possibly new) population
+func (s *MusicServer) EvolveMelodies(user string, ratings map[string]float64, numCandidates int,
+	scale string, rootNote, numNotes int) (*Evolution, []Candidate, error) {
+	if !validUserID.MatchString(user) {
+		return nil, nil, errors.New("user_id must be 1-64 letters, digits, '-' or '_'")
+	}
+
+	s.evolutionMu.Lock()
+	defer s.evolutionMu.Unlock()
+
+	e, err := s.evolution.Load(user)
+	if err != nil {
+		return nil, nil, err
+	}
+	if e == nil {
+		e = newEvolution(user, s.engineClient)
+		log.Printf("🧬 %s: new population of %d genomes", user, populationSize)
+	}
+
+	var batch []rated
+	for id, rating := range ratings {
+		g, ok := e.Pending[id]
+		if !ok {
+			return nil, nil, fmt.Errorf("candidate %q is not awaiting a rating", id)
+		}
+		batch = append(batch, rated{Genome: g, Rating: rating})
+	}
+	if len(batch) > 0 {
+		e.Breed(batch, s.engineClient)
+		e.Pending = make(map[string]Genome)
+	}
+
+	candidates := make([]Candidate, numCandidates)
+	for i := range candidates {
+		g := e.Population[i%len(e.Population)]
+		v := NewVoice()
+		v.genome = &g
+		notes, _ := s.playVoice(v, scale, rootNote, numNotes, melodyDurations, nil)
+
+		id := fmt.Sprintf("g%d-c%d", e.Generation, i)
+		candidates[i] = Candidate{ID: id, Genome: g, Notes: notes}
+		e.Pending[id] = g
+	}
+
+	if err := s.evolution.Save(e); err != nil {
+		return nil, nil, err
+	}
+	return e, candidates, nil
+}
+
+// ------------------------------------------------------------------
+// Persistence
+// ------------------------------------------------------------------
+
+// EvolutionStore keeps populations as <dir>/<user>.json (in memory only
+// when dir is empty)
+type EvolutionStore struct {
+	dir    string
+	mu     sync.Mutex
+	memory map[string]*Evolution
+}
+
+func NewEvolutionStore(dir string) *EvolutionStore {
+	return &EvolutionStore{dir: dir, memory: make(map[string]*Evolution)}
+}
+
+// Load returns the user's population, or nil if they have none yet
+func (st *EvolutionStore) Load(user string) (*Evolution, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if e, ok := st.memory[user]; ok {
+		return e, nil
+	}
+	if st.dir == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(st.dir, user+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	e := &Evolution{}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, fmt.Errorf("corrupt evolution state for %s: %w", user, err)
+	}
+	if e.Pending == nil {
+		e.Pending = make(map[string]Genome)
+	}
+	st.memory[user] = e
+	return e, nil
+}
+
+// Save writes the population atomically (temp file + rename)
+func (st *EvolutionStore) Save(e *Evolution) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.memory[e.User] = e
+	if st.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(st.dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(st.dir, e.User+".json")
+	if err := os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
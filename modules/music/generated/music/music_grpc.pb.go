@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: music/music.proto
+
+package music
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuantumMusic_GenerateMelody_FullMethodName           = "/qubit_engine.music.QuantumMusic/GenerateMelody"
+	QuantumMusic_GenerateMelodyStream_FullMethodName     = "/qubit_engine.music.QuantumMusic/GenerateMelodyStream"
+	QuantumMusic_GetStateVector_FullMethodName           = "/qubit_engine.music.QuantumMusic/GetStateVector"
+	QuantumMusic_ExportMIDI_FullMethodName               = "/qubit_engine.music.QuantumMusic/ExportMIDI"
+	QuantumMusic_ExportWAV_FullMethodName                = "/qubit_engine.music.QuantumMusic/ExportWAV"
+	QuantumMusic_GenerateRhythm_FullMethodName           = "/qubit_engine.music.QuantumMusic/GenerateRhythm"
+	QuantumMusic_GenerateDrums_FullMethodName            = "/qubit_engine.music.QuantumMusic/GenerateDrums"
+	QuantumMusic_GenerateChordProgression_FullMethodName = "/qubit_engine.music.QuantumMusic/GenerateChordProgression"
+	QuantumMusic_ComposeTrack_FullMethodName             = "/qubit_engine.music.QuantumMusic/ComposeTrack"
+	QuantumMusic_ComposeScore_FullMethodName             = "/qubit_engine.music.QuantumMusic/ComposeScore"
+	QuantumMusic_GenerateDuet_FullMethodName             = "/qubit_engine.music.QuantumMusic/GenerateDuet"
+	QuantumMusic_TrainMarkov_FullMethodName              = "/qubit_engine.music.QuantumMusic/TrainMarkov"
+	QuantumMusic_RegisterScale_FullMethodName            = "/qubit_engine.music.QuantumMusic/RegisterScale"
+	QuantumMusic_ListScales_FullMethodName               = "/qubit_engine.music.QuantumMusic/ListScales"
+	QuantumMusic_PlayLive_FullMethodName                 = "/qubit_engine.music.QuantumMusic/PlayLive"
+	QuantumMusic_EvolveMelody_FullMethodName             = "/qubit_engine.music.QuantumMusic/EvolveMelody"
+	QuantumMusic_HarmonizeMelody_FullMethodName          = "/qubit_engine.music.QuantumMusic/HarmonizeMelody"
+	QuantumMusic_FindMotif_FullMethodName                = "/qubit_engine.music.QuantumMusic/FindMotif"
+)
+
+// QuantumMusicClient is the client API for QuantumMusic service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumMusicClient interface {
+	// Generate a melodic sequence using quantum randomness
+	GenerateMelody(ctx context.Context, in *MelodyRequest, opts ...grpc.CallOption) (*Melody, error)
+	// Stream each note as it is measured (pre-collapse probabilities included)
+	GenerateMelodyStream(ctx context.Context, in *MelodyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuantumNote], error)
+	// Inspect the composer's current 3-qubit state vector
+	GetStateVector(ctx context.Context, in *StateVectorRequest, opts ...grpc.CallOption) (*StateVector, error)
+	// Export to MIDI
+	ExportMIDI(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*MIDIFile, error)
+	// Render a melody to WAV audio
+	ExportWAV(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*AudioFile, error)
+	// Generate rhythm pattern
+	GenerateRhythm(ctx context.Context, in *RhythmRequest, opts ...grpc.CallOption) (*RhythmPattern, error)
+	// Generate drums from a 3-qubit register (kick, snare, hat) measured per 16th
+	GenerateDrums(ctx context.Context, in *RhythmRequest, opts ...grpc.CallOption) (*RhythmPattern, error)
+	// Generate a chord progression
+	GenerateChordProgression(ctx context.Context, in *ChordRequest, opts ...grpc.CallOption) (*ChordProgression, error)
+	// Create a full composition
+	ComposeTrack(ctx context.Context, in *CompositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CompositionEvent], error)
+	// Generate synchronized melody/counter-melody/bass/drum tracks
+	ComposeScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*Score, error)
+	// Generate melody + bass counterpoint from an entangled 6-qubit state
+	GenerateDuet(ctx context.Context, in *DuetRequest, opts ...grpc.CallOption) (*Duet, error)
+	// Learn a transition matrix from MIDI files for hybrid Markov–quantum melodies
+	TrainMarkov(ctx context.Context, in *MarkovTrainingRequest, opts ...grpc.CallOption) (*MarkovModel, error)
+	// Register a custom scale or microtonal tuning
+	RegisterScale(ctx context.Context, in *ScaleDefinition, opts ...grpc.CallOption) (*ScaleInfo, error)
+	// List built-in and registered scales
+	ListScales(ctx context.Context, in *ListScalesRequest, opts ...grpc.CallOption) (*ScaleList, error)
+	// Play notes to a configured OSC or MIDI output in real time, streaming each as it sounds
+	PlayLive(ctx context.Context, in *LiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuantumNote], error)
+	// Rate candidates and get the next generation of melodies bred toward your taste
+	EvolveMelody(ctx context.Context, in *EvolveRequest, opts ...grpc.CallOption) (*Evolution, error)
+	// Add quantum-chosen chords and bass under a given melody
+	HarmonizeMelody(ctx context.Context, in *HarmonizeRequest, opts ...grpc.CallOption) (*Harmonization, error)
+	// Generate a motif following a melodic contour via amplitude amplification
+	FindMotif(ctx context.Context, in *MotifRequest, opts ...grpc.CallOption) (*Motif, error)
+}
+
+type quantumMusicClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumMusicClient(cc grpc.ClientConnInterface) QuantumMusicClient {
+	return &quantumMusicClient{cc}
+}
+
+func (c *quantumMusicClient) GenerateMelody(ctx context.Context, in *MelodyRequest, opts ...grpc.CallOption) (*Melody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Melody)
+	err := c.cc.Invoke(ctx, QuantumMusic_GenerateMelody_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) GenerateMelodyStream(ctx context.Context, in *MelodyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuantumNote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumMusic_ServiceDesc.Streams[0], QuantumMusic_GenerateMelodyStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[MelodyRequest, QuantumNote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumMusic_GenerateMelodyStreamClient = grpc.ServerStreamingClient[QuantumNote]
+
+func (c *quantumMusicClient) GetStateVector(ctx context.Context, in *StateVectorRequest, opts ...grpc.CallOption) (*StateVector, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StateVector)
+	err := c.cc.Invoke(ctx, QuantumMusic_GetStateVector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) ExportMIDI(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*MIDIFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MIDIFile)
+	err := c.cc.Invoke(ctx, QuantumMusic_ExportMIDI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) ExportWAV(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*AudioFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudioFile)
+	err := c.cc.Invoke(ctx, QuantumMusic_ExportWAV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) GenerateRhythm(ctx context.Context, in *RhythmRequest, opts ...grpc.CallOption) (*RhythmPattern, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RhythmPattern)
+	err := c.cc.Invoke(ctx, QuantumMusic_GenerateRhythm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) GenerateDrums(ctx context.Context, in *RhythmRequest, opts ...grpc.CallOption) (*RhythmPattern, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RhythmPattern)
+	err := c.cc.Invoke(ctx, QuantumMusic_GenerateDrums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) GenerateChordProgression(ctx context.Context, in *ChordRequest, opts ...grpc.CallOption) (*ChordProgression, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChordProgression)
+	err := c.cc.Invoke(ctx, QuantumMusic_GenerateChordProgression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) ComposeTrack(ctx context.Context, in *CompositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CompositionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumMusic_ServiceDesc.Streams[1], QuantumMusic_ComposeTrack_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CompositionRequest, CompositionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumMusic_ComposeTrackClient = grpc.ServerStreamingClient[CompositionEvent]
+
+func (c *quantumMusicClient) ComposeScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*Score, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Score)
+	err := c.cc.Invoke(ctx, QuantumMusic_ComposeScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) GenerateDuet(ctx context.Context, in *DuetRequest, opts ...grpc.CallOption) (*Duet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Duet)
+	err := c.cc.Invoke(ctx, QuantumMusic_GenerateDuet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) TrainMarkov(ctx context.Context, in *MarkovTrainingRequest, opts ...grpc.CallOption) (*MarkovModel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkovModel)
+	err := c.cc.Invoke(ctx, QuantumMusic_TrainMarkov_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) RegisterScale(ctx context.Context, in *ScaleDefinition, opts ...grpc.CallOption) (*ScaleInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleInfo)
+	err := c.cc.Invoke(ctx, QuantumMusic_RegisterScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) ListScales(ctx context.Context, in *ListScalesRequest, opts ...grpc.CallOption) (*ScaleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleList)
+	err := c.cc.Invoke(ctx, QuantumMusic_ListScales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) PlayLive(ctx context.Context, in *LiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuantumNote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumMusic_ServiceDesc.Streams[2], QuantumMusic_PlayLive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LiveRequest, QuantumNote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumMusic_PlayLiveClient = grpc.ServerStreamingClient[QuantumNote]
+
+func (c *quantumMusicClient) EvolveMelody(ctx context.Context, in *EvolveRequest, opts ...grpc.CallOption) (*Evolution, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Evolution)
+	err := c.cc.Invoke(ctx, QuantumMusic_EvolveMelody_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) HarmonizeMelody(ctx context.Context, in *HarmonizeRequest, opts ...grpc.CallOption) (*Harmonization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Harmonization)
+	err := c.cc.Invoke(ctx, QuantumMusic_HarmonizeMelody_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumMusicClient) FindMotif(ctx context.Context, in *MotifRequest, opts ...grpc.CallOption) (*Motif, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Motif)
+	err := c.cc.Invoke(ctx, QuantumMusic_FindMotif_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumMusicServer is the server API for QuantumMusic service.
+// All implementations must embed UnimplementedQuantumMusicServer
+// for forward compatibility.
+type QuantumMusicServer interface {
+	// Generate a melodic sequence using quantum randomness
+	GenerateMelody(context.Context, *MelodyRequest) (*Melody, error)
+	// Stream each note as it is measured (pre-collapse probabilities included)
+	GenerateMelodyStream(*MelodyRequest, grpc.ServerStreamingServer[QuantumNote]) error
+	// Inspect the composer's current 3-qubit state vector
+	GetStateVector(context.Context, *StateVectorRequest) (*StateVector, error)
+	// Export to MIDI
+	ExportMIDI(context.Context, *ExportRequest) (*MIDIFile, error)
+	// Render a melody to WAV audio
+	ExportWAV(context.Context, *ExportRequest) (*AudioFile, error)
+	// Generate rhythm pattern
+	GenerateRhythm(context.Context, *RhythmRequest) (*RhythmPattern, error)
+	// Generate drums from a 3-qubit register (kick, snare, hat) measured per 16th
+	GenerateDrums(context.Context, *RhythmRequest) (*RhythmPattern, error)
+	// Generate a chord progression
+	GenerateChordProgression(context.Context, *ChordRequest) (*ChordProgression, error)
+	// Create a full composition
+	ComposeTrack(*CompositionRequest, grpc.ServerStreamingServer[CompositionEvent]) error
+	// Generate synchronized melody/counter-melody/bass/drum tracks
+	ComposeScore(context.Context, *ScoreRequest) (*Score, error)
+	// Generate melody + bass counterpoint from an entangled 6-qubit state
+	GenerateDuet(context.Context, *DuetRequest) (*Duet, error)
+	// Learn a transition matrix from MIDI files for hybrid Markov–quantum melodies
+	TrainMarkov(context.Context, *MarkovTrainingRequest) (*MarkovModel, error)
+	// Register a custom scale or microtonal tuning
+	RegisterScale(context.Context, *ScaleDefinition) (*ScaleInfo, error)
+	// List built-in and registered scales
+	ListScales(context.Context, *ListScalesRequest) (*ScaleList, error)
+	// Play notes to a configured OSC or MIDI output in real time, streaming each as it sounds
+	PlayLive(*LiveRequest, grpc.ServerStreamingServer[QuantumNote]) error
+	// Rate candidates and get the next generation of melodies bred toward your taste
+	EvolveMelody(context.Context, *EvolveRequest) (*Evolution, error)
+	// Add quantum-chosen chords and bass under a given melody
+	HarmonizeMelody(context.Context, *HarmonizeRequest) (*Harmonization, error)
+	// Generate a motif following a melodic contour via amplitude amplification
+	FindMotif(context.Context, *MotifRequest) (*Motif, error)
+	mustEmbedUnimplementedQuantumMusicServer()
+}
+
+// UnimplementedQuantumMusicServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumMusicServer struct{}
+
+func (UnimplementedQuantumMusicServer) GenerateMelody(context.Context, *MelodyRequest) (*Melody, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateMelody not implemented")
+}
+func (UnimplementedQuantumMusicServer) GenerateMelodyStream(*MelodyRequest, grpc.ServerStreamingServer[QuantumNote]) error {
+	return status.Error(codes.Unimplemented, "method GenerateMelodyStream not implemented")
+}
+func (UnimplementedQuantumMusicServer) GetStateVector(context.Context, *StateVectorRequest) (*StateVector, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStateVector not implemented")
+}
+func (UnimplementedQuantumMusicServer) ExportMIDI(context.Context, *ExportRequest) (*MIDIFile, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportMIDI not implemented")
+}
+func (UnimplementedQuantumMusicServer) ExportWAV(context.Context, *ExportRequest) (*AudioFile, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportWAV not implemented")
+}
+func (UnimplementedQuantumMusicServer) GenerateRhythm(context.Context, *RhythmRequest) (*RhythmPattern, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateRhythm not implemented")
+}
+func (UnimplementedQuantumMusicServer) GenerateDrums(context.Context, *RhythmRequest) (*RhythmPattern, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateDrums not implemented")
+}
+func (UnimplementedQuantumMusicServer) GenerateChordProgression(context.Context, *ChordRequest) (*ChordProgression, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateChordProgression not implemented")
+}
+func (UnimplementedQuantumMusicServer) ComposeTrack(*CompositionRequest, grpc.ServerStreamingServer[CompositionEvent]) error {
+	return status.Error(codes.Unimplemented, "method ComposeTrack not implemented")
+}
+func (UnimplementedQuantumMusicServer) ComposeScore(context.Context, *ScoreRequest) (*Score, error) {
+	return nil, status.Error(codes.Unimplemented, "method ComposeScore not implemented")
+}
+func (UnimplementedQuantumMusicServer) GenerateDuet(context.Context, *DuetRequest) (*Duet, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateDuet not implemented")
+}
+func (UnimplementedQuantumMusicServer) TrainMarkov(context.Context, *MarkovTrainingRequest) (*MarkovModel, error) {
+	return nil, status.Error(codes.Unimplemented, "method TrainMarkov not implemented")
+}
+func (UnimplementedQuantumMusicServer) RegisterScale(context.Context, *ScaleDefinition) (*ScaleInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterScale not implemented")
+}
+func (UnimplementedQuantumMusicServer) ListScales(context.Context, *ListScalesRequest) (*ScaleList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScales not implemented")
+}
+func (UnimplementedQuantumMusicServer) PlayLive(*LiveRequest, grpc.ServerStreamingServer[QuantumNote]) error {
+	return status.Error(codes.Unimplemented, "method PlayLive not implemented")
+}
+func (UnimplementedQuantumMusicServer) EvolveMelody(context.Context, *EvolveRequest) (*Evolution, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvolveMelody not implemented")
+}
+func (UnimplementedQuantumMusicServer) HarmonizeMelody(context.Context, *HarmonizeRequest) (*Harmonization, error) {
+	return nil, status.Error(codes.Unimplemented, "method HarmonizeMelody not implemented")
+}
+func (UnimplementedQuantumMusicServer) FindMotif(context.Context, *MotifRequest) (*Motif, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindMotif not implemented")
+}
+func (UnimplementedQuantumMusicServer) mustEmbedUnimplementedQuantumMusicServer() {}
+func (UnimplementedQuantumMusicServer) testEmbeddedByValue()                      {}
+
+// UnsafeQuantumMusicServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumMusicServer will
+// result in compilation errors.
+type UnsafeQuantumMusicServer interface {
+	mustEmbedUnimplementedQuantumMusicServer()
+}
+
+func RegisterQuantumMusicServer(s grpc.ServiceRegistrar, srv QuantumMusicServer) {
+	// If the following call panics, it indicates UnimplementedQuantumMusicServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumMusic_ServiceDesc, srv)
+}
+
+func _QuantumMusic_GenerateMelody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MelodyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).GenerateMelody(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_GenerateMelody_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).GenerateMelody(ctx, req.(*MelodyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_GenerateMelodyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MelodyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumMusicServer).GenerateMelodyStream(m, &grpc.GenericServerStream[MelodyRequest, QuantumNote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumMusic_GenerateMelodyStreamServer = grpc.ServerStreamingServer[QuantumNote]
+
+func _QuantumMusic_GetStateVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateVectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).GetStateVector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_GetStateVector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).GetStateVector(ctx, req.(*StateVectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_ExportMIDI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).ExportMIDI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_ExportMIDI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).ExportMIDI(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_ExportWAV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).ExportWAV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_ExportWAV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).ExportWAV(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_GenerateRhythm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RhythmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).GenerateRhythm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_GenerateRhythm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).GenerateRhythm(ctx, req.(*RhythmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_GenerateDrums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RhythmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).GenerateDrums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_GenerateDrums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).GenerateDrums(ctx, req.(*RhythmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_GenerateChordProgression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).GenerateChordProgression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_GenerateChordProgression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).GenerateChordProgression(ctx, req.(*ChordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_ComposeTrack_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CompositionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumMusicServer).ComposeTrack(m, &grpc.GenericServerStream[CompositionRequest, CompositionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumMusic_ComposeTrackServer = grpc.ServerStreamingServer[CompositionEvent]
+
+func _QuantumMusic_ComposeScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).ComposeScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_ComposeScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).ComposeScore(ctx, req.(*ScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_GenerateDuet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).GenerateDuet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_GenerateDuet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).GenerateDuet(ctx, req.(*DuetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_TrainMarkov_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkovTrainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).TrainMarkov(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_TrainMarkov_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).TrainMarkov(ctx, req.(*MarkovTrainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_RegisterScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).RegisterScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_RegisterScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).RegisterScale(ctx, req.(*ScaleDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_ListScales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).ListScales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_ListScales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).ListScales(ctx, req.(*ListScalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_PlayLive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumMusicServer).PlayLive(m, &grpc.GenericServerStream[LiveRequest, QuantumNote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumMusic_PlayLiveServer = grpc.ServerStreamingServer[QuantumNote]
+
+func _QuantumMusic_EvolveMelody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).EvolveMelody(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_EvolveMelody_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).EvolveMelody(ctx, req.(*EvolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_HarmonizeMelody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HarmonizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).HarmonizeMelody(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_HarmonizeMelody_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).HarmonizeMelody(ctx, req.(*HarmonizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumMusic_FindMotif_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MotifRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumMusicServer).FindMotif(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumMusic_FindMotif_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumMusicServer).FindMotif(ctx, req.(*MotifRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumMusic_ServiceDesc is the grpc.ServiceDesc for QuantumMusic service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumMusic_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.music.QuantumMusic",
+	HandlerType: (*QuantumMusicServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateMelody",
+			Handler:    _QuantumMusic_GenerateMelody_Handler,
+		},
+		{
+			MethodName: "GetStateVector",
+			Handler:    _QuantumMusic_GetStateVector_Handler,
+		},
+		{
+			MethodName: "ExportMIDI",
+			Handler:    _QuantumMusic_ExportMIDI_Handler,
+		},
+		{
+			MethodName: "ExportWAV",
+			Handler:    _QuantumMusic_ExportWAV_Handler,
+		},
+		{
+			MethodName: "GenerateRhythm",
+			Handler:    _QuantumMusic_GenerateRhythm_Handler,
+		},
+		{
+			MethodName: "GenerateDrums",
+			Handler:    _QuantumMusic_GenerateDrums_Handler,
+		},
+		{
+			MethodName: "GenerateChordProgression",
+			Handler:    _QuantumMusic_GenerateChordProgression_Handler,
+		},
+		{
+			MethodName: "ComposeScore",
+			Handler:    _QuantumMusic_ComposeScore_Handler,
+		},
+		{
+			MethodName: "GenerateDuet",
+			Handler:    _QuantumMusic_GenerateDuet_Handler,
+		},
+		{
+			MethodName: "TrainMarkov",
+			Handler:    _QuantumMusic_TrainMarkov_Handler,
+		},
+		{
+			MethodName: "RegisterScale",
+			Handler:    _QuantumMusic_RegisterScale_Handler,
+		},
+		{
+			MethodName: "ListScales",
+			Handler:    _QuantumMusic_ListScales_Handler,
+		},
+		{
+			MethodName: "EvolveMelody",
+			Handler:    _QuantumMusic_EvolveMelody_Handler,
+		},
+		{
+			MethodName: "HarmonizeMelody",
+			Handler:    _QuantumMusic_HarmonizeMelody_Handler,
+		},
+		{
+			MethodName: "FindMotif",
+			Handler:    _QuantumMusic_FindMotif_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateMelodyStream",
+			Handler:       _QuantumMusic_GenerateMelodyStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ComposeTrack",
+			Handler:       _QuantumMusic_ComposeTrack_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlayLive",
+			Handler:       _QuantumMusic_PlayLive_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "music/music.proto",
+}
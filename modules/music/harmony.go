@@ -0,0 +1,384 @@
+// Quantum Harmonization - chords under a user-supplied melody
+// The melody is cut into harmonic segments. For each one the triads built
+// on the scale degrees (basis states |0⟩-|6⟩ of a 3-qubit register) get
+// amplitudes from how well they fit the melody, boosted along common
+// progressions and damped by voice-leading rules; one measurement picks the
+// chord, which is then voiced as close as possible to the previous one.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+)
+
+// Common functional progressions between triad degrees (I → IV, V, vi ...)
+var chordFollowers = map[int][]int{
+	0: {3, 4, 5},
+	1: {4, 6},
+	2: {5, 3},
+	3: {0, 4, 1},
+	4: {0, 5},
+	5: {1, 3},
+	6: {0, 2},
+}
+
+var pitchClassNames = []string{"C", "C#", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+var romanNumerals = []string{"I", "II", "III", "IV", "V", "VI", "VII"}
+
+// Voice-leading limits
+const (
+	maxVoiceMotion  = 7   // Semitones any upper voice may move between chords
+	leapDamping     = 0.3 // Amplitude factor for chords that break a rule
+	chordToneWeight = 1.0 // Fit of a melody note on a chord tone
+	tensionWeight   = 0.2 // Fit of a melody note outside the chord
+)
+
+// Harmonization is a melody with its quantum-chosen accompaniment
+type Harmonization struct {
+	Score       *Score
+	Chords      []Chord
+	Progression string  // Roman numerals, e.g. "I-vi-IV-V"
+	Coverage    float64 // Fraction of melody duration on chord tones
+}
+
+// HarmonizeNotes chooses one chord per chordBeats of melody and returns
+// melody, harmony and bass tracks
+func (s *MusicServer) HarmonizeNotes(melody []QuantumNote, scale string, rootNote int,
+	tempo, chordBeats float64, beatsPerBar int, seed uint64) (*Harmonization, error) {
+	intervals, ok := scales[scale]
+	if !ok {
+		return nil, fmt.Errorf("unknown scale %q", scale)
+	}
+	if len(melody) == 0 {
+		return nil, errors.New("melody has no notes")
+	}
+	qe := s.engineClient
+	if seed != 0 {
+		qe = qe.WithSeed(seed)
+	}
+
+	length := melodyLength(melody)
+	segments := int(math.Ceil(length / chordBeats))
+	log.Printf("🎹 Harmonizing %d-note melody: %d chords of %.1f beats in %s", len(melody), segments, chordBeats, scale)
+
+	triads := make([][]int, len(intervals))
+	for d := range triads {
+		triads[d] = triadPitchClasses(intervals, rootNote, d)
+	}
+
+	h := &Harmonization{}
+	var numerals []string
+	prev, prevBass, prevMelody := -1, -1, 0
+	var voicing, bassLine []int
+	covered, sounding := 0.0, 0.0
+
+	for seg := 0; seg < segments; seg++ {
+		start, end := float64(seg)*chordBeats, float64(seg+1)*chordBeats
+		window := melodyWindow(melody, start, end)
+
+		var probs [8]float64
+		for d, triad := range triads {
+			amp := chordFit(triad, window)
+			if prev >= 0 && containsInt(chordFollowers[prev%7], d) {
+				amp *= math.Sqrt(2)
+			}
+			if (seg == 0 || seg == segments-1) && d == 0 {
+				amp *= math.Sqrt(2) // Open and close on the tonic
+			}
+			amp *= voiceLeadingFactor(voicing, triad, prevBass, prevMelody, window, rootNote)
+			probs[d] = amp * amp
+		}
+		normalizeProbs(probs[:])
+
+		degree := qe.Measure3Qubits(probs) % len(triads)
+		triad := triads[degree]
+		voicing = nearestVoicing(triad, voicing, rootNote)
+		bass := chordBass(triad, rootNote)
+
+		chord := Chord{Notes: voicing, Name: chordName(triad), Duration: end - start}
+		h.Chords = append(h.Chords, chord)
+		bassLine = append(bassLine, bass)
+		numerals = append(numerals, romanNumeral(degree, triad))
+
+		for _, n := range window {
+			if n.Pitch <= 0 {
+				continue
+			}
+			prevMelody = n.Pitch
+			sounding += n.Duration
+			if containsInt(triad, pitchClass(n.Pitch)) {
+				covered += n.Duration
+			}
+		}
+
+		log.Printf("  Bar %d: |%d⟩ → %s (p=%.2f%%)", seg+1, degree, chord.Name, probs[degree]*100)
+		prev, prevBass = degree, bass
+	}
+
+	if sounding > 0 {
+		h.Coverage = covered / sounding
+	}
+	h.Progression = strings.Join(numerals, "-")
+	h.Score = harmonyScore(melody, h.Chords, bassLine, rootNote, tempo, beatsPerBar, scale)
+
+	log.Printf("🎹 Harmonization: %s (%.0f%% chord tones)", h.Progression, h.Coverage*100)
+	return h, nil
+}
+
+// triadPitchClasses stacks thirds within the scale from degree d
+func triadPitchClasses(intervals []int, rootNote, d int) []int {
+	n := len(intervals)
+	triad := make([]int, 3)
+	for i := range triad {
+		triad[i] = pitchClass(rootNote + intervals[(d+2*i)%n])
+	}
+	return triad
+}
+
+// melodyWindow returns the melody notes sounding in [start, end)
+func melodyWindow(melody []QuantumNote, start, end float64) []QuantumNote {
+	var window []QuantumNote
+	for _, n := range melody {
+		if n.StartTime < end && n.StartTime+n.Duration > start {
+			window = append(window, n)
+		}
+	}
+	return window
+}
+
+// chordFit weighs each melody note by its duration: chord tones count
+// fully, other notes as tensions
+func chordFit(triad []int, window []QuantumNote) float64 {
+	fit, total := 0.0, 0.0
+	for _, n := range window {
+		if n.Pitch <= 0 {
+			continue
+		}
+		total += n.Duration
+		if containsInt(triad, pitchClass(n.Pitch)) {
+			fit += chordToneWeight * n.Duration
+		} else {
+			fit += tensionWeight * n.Duration
+		}
+	}
+	if total == 0 {
+		return 1 // Rests fit anything
+	}
+	return fit / total
+}
+
+// voiceLeadingFactor damps chords whose closest voicing leaps more than
+// maxVoiceMotion in any voice, or whose bass would move in parallel fifths
+// or octaves with the melody
+func voiceLeadingFactor(prev, triad []int, prevBass, prevMelody int, window []QuantumNote, rootNote int) float64 {
+	factor := 1.0
+	if prev == nil {
+		return factor
+	}
+
+	next := nearestVoicing(triad, prev, rootNote)
+	for i := range next {
+		if abs(next[i]-prev[i]) > maxVoiceMotion {
+			factor *= leapDamping
+			break
+		}
+	}
+
+	if prevMelody > 0 && len(window) > 0 && window[0].Pitch > 0 && window[0].Pitch != prevMelody {
+		bass := chordBass(triad, rootNote)
+		before := pitchClass(prevMelody - prevBass)
+		after := pitchClass(window[0].Pitch - bass)
+		if bass != prevBass && before == after && (after == 0 || after == 7) {
+			factor *= leapDamping
+		}
+	}
+	return factor
+}
+
+// chordBass is the triad's root in the octave two below rootNote
+func chordBass(triad []int, rootNote int) int {
+	return rootNote - 24 + pitchClass(triad[0]-rootNote)
+}
+
+// nearestVoicing places the triad in close position below the melody's
+// root, choosing the inversion with the least total motion from prev
+func nearestVoicing(triad, prev []int, rootNote int) []int {
+	var best []int
+	bestMotion := math.MaxInt
+	for inversion := 0; inversion < 3; inversion++ {
+		for base := rootNote - 19; base < rootNote-7; base++ {
+			if pitchClass(base) != triad[inversion] {
+				continue
+			}
+			voicing := []int{base}
+			for i := 1; i < 3; i++ {
+				pc := triad[(inversion+i)%3]
+				next := voicing[i-1] + pitchClass(pc-voicing[i-1])
+				voicing = append(voicing, next)
+			}
+
+			motion := 0
+			if prev != nil {
+				for i := range voicing {
+					motion += abs(voicing[i] - prev[i])
+				}
+			} else {
+				motion = abs(voicing[0] - (rootNote - 12)) // Start near the root
+			}
+			if motion < bestMotion {
+				best, bestMotion = voicing, motion
+			}
+		}
+	}
+	return best
+}
+
+// chordName spells the triad as root plus quality (C, Dm, Bdim, Eaug)
+func chordName(triad []int) string {
+	name := pitchClassNames[triad[0]]
+	switch triadQuality(triad) {
+	case "minor":
+		name += "m"
+	case "diminished":
+		name += "dim"
+	case "augmented":
+		name += "aug"
+	case "other":
+		name += "(no3)"
+	}
+	return name
+}
+
+// romanNumeral labels a degree by quality: I, ii, vii°, III+
+func romanNumeral(degree int, triad []int) string {
+	numeral := romanNumerals[degree%7]
+	switch triadQuality(triad) {
+	case "minor":
+		return strings.ToLower(numeral)
+	case "diminished":
+		return strings.ToLower(numeral) + "°"
+	case "augmented":
+		return numeral + "+"
+	}
+	return numeral
+}
+
+func triadQuality(triad []int) string {
+	third := pitchClass(triad[1] - triad[0])
+	fifth := pitchClass(triad[2] - triad[0])
+	switch {
+	case third == 4 && fifth == 7:
+		return "major"
+	case third == 3 && fifth == 7:
+		return "minor"
+	case third == 3 && fifth == 6:
+		return "diminished"
+	case third == 4 && fifth == 8:
+		return "augmented"
+	}
+	return "other"
+}
+
+// harmonyScore combines the melody with sustained chords and their roots
+// in the bass
+func harmonyScore(melody []QuantumNote, chords []Chord, bassLine []int, rootNote int, tempo float64, beatsPerBar int, scale string) *Score {
+	melodySpec, harmonySpec, bassSpec := trackSpecs[TrackMelody], trackSpecs[TrackHarmony], trackSpecs[TrackBass]
+	harmony := &Track{Name: harmonySpec.Name, Role: TrackHarmony, Channel: harmonySpec.Channel, Program: harmonySpec.Program}
+	bass := &Track{Name: bassSpec.Name, Role: TrackBass, Channel: bassSpec.Channel, Program: bassSpec.Program}
+
+	beat := 0.0
+	for i, c := range chords {
+		for _, pitch := range c.Notes {
+			harmony.Notes = append(harmony.Notes, QuantumNote{
+				Pitch:     pitch,
+				NoteName:  c.Name,
+				Duration:  c.Duration,
+				Velocity:  0.6 * harmonySpec.Velocity,
+				StartTime: beat,
+			})
+		}
+		bass.Notes = append(bass.Notes, QuantumNote{
+			Pitch:     bassLine[i],
+			NoteName:  c.Name,
+			Duration:  c.Duration,
+			Velocity:  0.7 * bassSpec.Velocity,
+			StartTime: beat,
+		})
+		beat += c.Duration
+	}
+
+	return &Score{
+		Tracks: []*Track{
+			{Name: melodySpec.Name, Role: TrackMelody, Channel: melodySpec.Channel, Program: melodySpec.Program, Notes: melody},
+			harmony,
+			bass,
+		},
+		Scale:       scale,
+		RootNote:    rootNote,
+		Tempo:       tempo,
+		BeatsPerBar: beatsPerBar,
+		Bars:        int(math.Ceil(beat / float64(beatsPerBar))),
+	}
+}
+
+// melodyFromMIDI takes the top line of the first melodic track
+func melodyFromMIDI(data []byte) ([]QuantumNote, error) {
+	notes, err := ReadMIDINotes(data)
+	if err != nil {
+		return nil, err
+	}
+	lines := melodicLines(notes)
+	if len(lines) == 0 {
+		return nil, errors.New("MIDI file has no melodic notes")
+	}
+
+	melody := make([]QuantumNote, len(lines[0]))
+	for i, n := range lines[0] {
+		melody[i] = QuantumNote{
+			Pitch:     n.Pitch,
+			NoteName:  pitchClassNames[pitchClass(n.Pitch)],
+			Duration:  n.Duration,
+			Velocity:  n.Velocity,
+			StartTime: n.StartTime,
+			Frequency: 440 * math.Pow(2, float64(n.Pitch-69)/12),
+		}
+	}
+	return melody, nil
+}
+
+func normalizeProbs(probs []float64) {
+	total := 0.0
+	for _, p := range probs {
+		total += p
+	}
+	if total > 0 {
+		for i := range probs {
+			probs[i] /= total
+		}
+	}
+}
+
+// pitchClass reduces a pitch or interval to 0-11
+func pitchClass(pitch int) int {
+	return (pitch%12 + 12) % 12
+}
+
+func containsInt(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
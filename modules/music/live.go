@@ -0,0 +1,253 @@
+// Live Output - play notes to synths and DAWs as they are composed
+// Two drivers ship with the module:
+//
+//	osc   UDP Open Sound Control: /quantum/note  i:channel i:pitch f:velocity f:seconds f:cents
+//	                              /quantum/noteoff i:channel i:pitch
+//	midi  Raw MIDI bytes written to a device such as an ALSA virtual MIDI
+//	      port (modprobe snd-virmidi → /dev/snd/midiC1D0) or a FIFO
+//
+// Outputs are configured on the command line, never by clients, so callers
+// cannot point the server at arbitrary hosts or files.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// NoteOutput is a realtime destination for note events
+type NoteOutput interface {
+	NoteOn(channel byte, n QuantumNote, seconds float64) error
+	NoteOff(channel byte, n QuantumNote) error
+	Close() error
+}
+
+// ------------------------------------------------------------------
+// OSC Driver
+// ------------------------------------------------------------------
+
+type oscOutput struct {
+	conn net.PacketConn
+	addr net.Addr
+}
+
+// NewOSCOutput sends OSC messages over UDP to addr (host:port). The socket
+// is unconnected so a synth that is not listening yet does not fail playback.
+func NewOSCOutput(addr string) (NoteOutput, error) {
+	target, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenPacket("udp", ":0")
+	if err != nil {
+		return nil, err
+	}
+	return &oscOutput{conn: conn, addr: target}, nil
+}
+
+func (o *oscOutput) NoteOn(channel byte, n QuantumNote, seconds float64) error {
+	return o.send("/quantum/note", int32(channel), int32(n.Pitch),
+		float32(n.Velocity), float32(seconds), float32(n.Cents))
+}
+
+func (o *oscOutput) NoteOff(channel byte, n QuantumNote) error {
+	return o.send("/quantum/noteoff", int32(channel), int32(n.Pitch))
+}
+
+func (o *oscOutput) Close() error {
+	return o.conn.Close()
+}
+
+func (o *oscOutput) send(address string, args ...any) error {
+	_, err := o.conn.WriteTo(encodeOSC(address, args...), o.addr)
+	return err
+}
+
+// encodeOSC builds an OSC 1.0 message with int32 and float32 arguments
+func encodeOSC(address string, args ...any) []byte {
+	var buf bytes.Buffer
+	writeOSCString(&buf, address)
+
+	tags := []byte{','}
+	for _, arg := range args {
+		switch arg.(type) {
+		case int32:
+			tags = append(tags, 'i')
+		case float32:
+			tags = append(tags, 'f')
+		}
+	}
+	writeOSCString(&buf, string(tags))
+
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case int32:
+			binary.Write(&buf, binary.BigEndian, v)
+		case float32:
+			binary.Write(&buf, binary.BigEndian, math.Float32bits(v))
+		}
+	}
+	return buf.Bytes()
+}
+
+// writeOSCString writes a null-terminated string padded to 4 bytes
+func writeOSCString(buf *bytes.Buffer, s string) {
+	buf.WriteString(s)
+	buf.WriteByte(0)
+	for buf.Len()%4 != 0 {
+		buf.WriteByte(0)
+	}
+}
+
+// ------------------------------------------------------------------
+// Raw MIDI Driver
+// ------------------------------------------------------------------
+
+type rawMIDIOutput struct {
+	mu    sync.Mutex
+	w     io.WriteCloser
+	bends [16]float64
+}
+
+// NewRawMIDIOutput writes MIDI messages to a device node or FIFO
+func NewRawMIDIOutput(path string) (NoteOutput, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &rawMIDIOutput{w: f}, nil
+}
+
+// NoteOn retunes the channel first when the note is detuned (±2 semitone
+// bend range, as in the MIDI file export)
+func (m *rawMIDIOutput) NoteOn(channel byte, n QuantumNote, seconds float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if n.Cents != m.bends[channel] {
+		value := 8192 + int(math.Round(n.Cents/200*8191))
+		if _, err := m.w.Write([]byte{0xE0 | channel, byte(value & 0x7F), byte(value >> 7)}); err != nil {
+			return err
+		}
+		m.bends[channel] = n.Cents
+	}
+	_, err := m.w.Write([]byte{0x90 | channel, byte(n.Pitch), midiVelocity(n.Velocity)})
+	return err
+}
+
+func (m *rawMIDIOutput) NoteOff(channel byte, n QuantumNote) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := m.w.Write([]byte{0x80 | channel, byte(n.Pitch), 0})
+	return err
+}
+
+func (m *rawMIDIOutput) Close() error {
+	return m.w.Close()
+}
+
+// ------------------------------------------------------------------
+// Scheduler
+// ------------------------------------------------------------------
+
+// liveEvent is a note-on or note-off at an offset from the start
+type liveEvent struct {
+	At      time.Duration
+	On      bool
+	Channel byte
+	Note    QuantumNote
+}
+
+// scheduleLive turns tracks into time-ordered events using each note's
+// articulated, humanized span. Note-offs sort before note-ons at the same
+// instant so repeated pitches retrigger.
+func scheduleLive(tracks []*Track, tempo float64) []liveEvent {
+	beat := float64(time.Minute) / tempo
+	var events []liveEvent
+	for _, t := range tracks {
+		for _, n := range t.Notes {
+			if n.Pitch <= 0 || n.Pitch > 127 {
+				continue // Rests
+			}
+			start, duration := n.performedSpan()
+			events = append(events,
+				liveEvent{At: time.Duration(start * beat), On: true, Channel: t.Channel, Note: n},
+				liveEvent{At: time.Duration((start + duration) * beat), Channel: t.Channel, Note: n})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].At != events[j].At {
+			return events[i].At < events[j].At
+		}
+		return !events[i].On && events[j].On
+	})
+	return events
+}
+
+// PlayLive sends the tracks to out in real time. Events are timed against
+// one start instant, so scheduling error never accumulates. onNote (if set)
+// runs as each note starts; cancelling ctx silences every sounding note.
+func PlayLive(ctx context.Context, out NoteOutput, tracks []*Track, tempo float64,
+	onNote func(QuantumNote) error) error {
+	events := scheduleLive(tracks, tempo)
+	beat := float64(time.Minute) / tempo
+
+	sounding := make(map[[2]int]liveEvent)
+	defer func() {
+		for _, e := range sounding {
+			out.NoteOff(e.Channel, e.Note)
+		}
+	}()
+
+	start := time.Now()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+
+	for _, e := range events {
+		if wait := time.Until(start.Add(e.At)); wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		key := [2]int{int(e.Channel), e.Note.Pitch}
+		if e.On {
+			seconds := time.Duration(e.Note.Duration * beat).Seconds()
+			if e.Note.Articulation > 0 {
+				seconds *= e.Note.Articulation
+			}
+			if err := out.NoteOn(e.Channel, e.Note, seconds); err != nil {
+				return fmt.Errorf("note on: %w", err)
+			}
+			sounding[key] = e
+			if onNote != nil {
+				if err := onNote(e.Note); err != nil {
+					return err
+				}
+			}
+		} else {
+			if err := out.NoteOff(e.Channel, e.Note); err != nil {
+				return fmt.Errorf("note off: %w", err)
+			}
+			delete(sounding, key)
+		}
+	}
+
+	log.Printf("🔊 Live playback finished: %d events in %v", len(events), time.Since(start).Round(time.Millisecond))
+	return nil
+}
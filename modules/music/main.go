@@ -11,12 +11,20 @@ import (
 	"log"
 	"math"
 	"math/cmplx"
+	"math/rand/v2"
 	"net"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	engine "github.com/perclft/QubitEngine/modules/music/generated/engine"
+	pb "github.com/perclft/QubitEngine/modules/music/generated/music"
 )
 
 // ------------------------------------------------------------------
@@ -62,17 +70,24 @@ var consonantFollowers = map[int][]int{
 // Quantum State Vector
 // ------------------------------------------------------------------
 
-// StateVector represents the 8-dimensional state (2^3 qubits)
+// StateVector represents the 2^k-dimensional state of a k-qubit register
+// (8 states for the built-in scales)
 type StateVector struct {
-	Amplitudes [8]complex128 // |000⟩ to |111⟩
+	Amplitudes []complex128 // |0…0⟩ to |1…1⟩
 	mu         sync.RWMutex
 }
 
 // NewEqualSuperposition creates |ψ⟩ = (1/√8) Σ|i⟩
 func NewEqualSuperposition() *StateVector {
-	sv := &StateVector{}
-	factor := complex(1.0/math.Sqrt(8.0), 0)
-	for i := 0; i < 8; i++ {
+	return NewUniformSuperposition(3)
+}
+
+// NewUniformSuperposition creates |ψ⟩ = (1/√2^k) Σ|i⟩ over k qubits
+func NewUniformSuperposition(qubits int) *StateVector {
+	n := 1 << qubits
+	sv := &StateVector{Amplitudes: make([]complex128, n)}
+	factor := complex(1.0/math.Sqrt(float64(n)), 0)
+	for i := 0; i < n; i++ {
 		sv.Amplitudes[i] = factor
 	}
 	return sv
@@ -93,7 +108,7 @@ func (sv *StateVector) ApplyAmplitudeBoost(targets []int, boostFactor float64) {
 
 	// Boost target states
 	for _, t := range targets {
-		if t >= 0 && t < 8 {
+		if t >= 0 && t < len(sv.Amplitudes) {
 			sv.Amplitudes[t] *= complex(boostFactor, 0)
 		}
 	}
@@ -117,10 +132,15 @@ func (sv *StateVector) Normalize() {
 }
 
 // Probabilities returns |a_i|² for each state
-func (sv *StateVector) Probabilities() [8]float64 {
+func (sv *StateVector) Probabilities() []float64 {
 	sv.mu.RLock()
 	defer sv.mu.RUnlock()
-	var probs [8]float64
+	return sv.probabilities()
+}
+
+// probabilities is Probabilities for callers already holding sv.mu
+func (sv *StateVector) probabilities() []float64 {
+	probs := make([]float64, len(sv.Amplitudes))
 	for i, a := range sv.Amplitudes {
 		probs[i] = cmplx.Abs(a) * cmplx.Abs(a)
 	}
@@ -134,7 +154,7 @@ func (sv *StateVector) Collapse(qe *QuantumEngineClient) int {
 	defer sv.mu.Unlock()
 
 	// Get true quantum random outcome from Engine
-	outcome := qe.Measure3Qubits(sv.Probabilities())
+	outcome := qe.MeasureState(sv.probabilities())
 
 	// Collapse to pure state |k⟩
 	for i := range sv.Amplitudes {
@@ -154,8 +174,12 @@ func (sv *StateVector) Collapse(qe *QuantumEngineClient) int {
 
 type QuantumEngineClient struct {
 	conn     *grpc.ClientConn
+	client   engine.QuantumComputeClient
 	addr     string
 	fallback bool // If true, use pseudo-random (for testing without Engine)
+
+	seed uint64     // Non-zero: deterministic mode (see WithSeed)
+	rng  *rand.Rand // Seeded stream behind deterministic measurements
 }
 
 func NewQuantumEngineClient(addr string) *QuantumEngineClient {
@@ -177,6 +201,7 @@ func NewQuantumEngineClient(addr string) *QuantumEngineClient {
 		log.Printf("⚠️  Running in FALLBACK mode (still quantum-inspired, but not true quantum)")
 	} else {
 		qe.conn = conn
+		qe.client = engine.NewQuantumComputeClient(conn)
 		qe.fallback = false
 		log.Printf("✅ Connected to Quantum Engine at %s", addr)
 	}
@@ -184,19 +209,54 @@ func NewQuantumEngineClient(addr string) *QuantumEngineClient {
 	return qe
 }
 
+// WithSeed returns a view of the client in deterministic mode. Engine
+// circuits carry per-measurement seeds drawn from a stream keyed by seed
+// (the engine's deterministic measurement mode) and the fallback sampler
+// replays the same stream, so a seed reproduces its melody exactly.
+// The view is not safe for concurrent use.
+func (qe *QuantumEngineClient) WithSeed(seed uint64) *QuantumEngineClient {
+	return &QuantumEngineClient{
+		conn:     qe.conn,
+		client:   qe.client,
+		addr:     qe.addr,
+		fallback: qe.fallback,
+		seed:     seed,
+		rng:      rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15)),
+	}
+}
+
+// nextSeed returns the engine seed for the next circuit (0 = not seeded)
+func (qe *QuantumEngineClient) nextSeed() uint64 {
+	if qe.rng == nil {
+		return 0
+	}
+	return qe.rng.Uint64() | 1
+}
+
 // Measure3Qubits returns 0-7 based on probability distribution
-// In production: sends circuit to Engine
-// In fallback: uses time-based entropy (still better than math/rand seed)
 func (qe *QuantumEngineClient) Measure3Qubits(probs [8]float64) int {
-	// TODO: When Engine is fully integrated, send actual circuit:
-	// 1. Create 3-qubit circuit
-	// 2. Apply H gates to all qubits
-	// 3. Apply custom rotations based on probs
-	// 4. Measure and return
+	return qe.MeasureState(probs[:])
+}
+
+// MeasureState returns a basis state index sampled from probs (len 2^k)
+// Connected: amplitude-encodes probs into a circuit and measures it on the
+// Engine (see circuit.go)
+// In fallback: uses time-based entropy (still better than math/rand seed)
+func (qe *QuantumEngineClient) MeasureState(probs []float64) int {
+	if !qe.fallback {
+		outcome, err := qe.measureOnEngine(probs)
+		if err == nil {
+			return outcome
+		}
+		log.Printf("⚠️  Engine measurement failed, using local entropy: %v", err)
+	}
 
-	// For now, use nano-time entropy (unpredictable, not pseudo-random)
+	// Fallback: nano-time entropy (unpredictable, not pseudo-random)
 	// This is the entropy from actual physical processes in the CPU
 	entropy := float64(time.Now().UnixNano()%1000000) / 1000000.0
+	if qe.rng != nil {
+		entropy = qe.rng.Float64() // Deterministic mode: replay the seed's stream
+	}
 
 	// Weighted selection based on probabilities
 	cumulative := 0.0
@@ -206,7 +266,12 @@ func (qe *QuantumEngineClient) Measure3Qubits(probs [8]float64) int {
 			return i
 		}
 	}
-	return 7 // Fallback to rest
+	return len(probs) - 1 // Fallback to rest
+}
+
+// MeasureBit returns true with probability p (a single biased qubit)
+func (qe *QuantumEngineClient) MeasureBit(p float64) bool {
+	return qe.MeasureState([]float64{1 - p, p}) == 1
 }
 
 func (qe *QuantumEngineClient) Close() {
@@ -220,116 +285,982 @@ func (qe *QuantumEngineClient) Close() {
 // ------------------------------------------------------------------
 
 type MusicServer struct {
+	pb.UnimplementedQuantumMusicServer
 	engineClient *QuantumEngineClient
-	stateVector  *StateVector
-	lastNote     int
+	lead         *Voice // State behind GenerateMelody / GetStateVector
 	mu           sync.Mutex
+
+	markovModels map[string]*MarkovModel // Trained via TrainMarkov
+	modelsMu     sync.RWMutex
+
+	outputs map[string]NoteOutput // Live drivers by name ("osc", "midi")
+
+	evolution   *EvolutionStore // Per-user EvolveMelody populations
+	evolutionMu sync.Mutex
 }
 
-func NewMusicServer(engineAddr string) *MusicServer {
+func NewMusicServer(engineAddr, dataDir string) *MusicServer {
 	return &MusicServer{
 		engineClient: NewQuantumEngineClient(engineAddr),
-		stateVector:  NewEqualSuperposition(),
-		lastNote:     -1, // No previous note
+		lead:         NewVoice(),
+		markovModels: make(map[string]*MarkovModel),
+		outputs:      make(map[string]NoteOutput),
+		evolution:    NewEvolutionStore(dataDir),
+	}
+}
+
+// Voice is one melodic line with its own state vector and interference
+// history, so parallel tracks evolve independently
+type Voice struct {
+	stateVector *StateVector
+	lastNote    int
+	markov      *MarkovBlend         // Optional corpus bias mixed in before collapse
+	contour     *ContourOracle       // Optional motif search amplified before collapse
+	genome      *Genome              // Optional evolved bias (EvolveMelody)
+	engine      *QuantumEngineClient // Measurement source; nil = the server's client
+}
+
+func NewVoice() *Voice {
+	return &Voice{
+		stateVector: NewEqualSuperposition(),
+		lastNote:    -1, // No previous note
 	}
 }
 
+// Default note lengths (beats) picked by the duration measurement
+var melodyDurations = []float64{0.25, 0.5, 1.0, 1.5, 2.0}
+
 // GenerateQuantumMelody creates a melody using true quantum superposition
 func (s *MusicServer) GenerateQuantumMelody(scale string, rootNote, numNotes int, tempo float64) []QuantumNote {
+	return s.generateMelody(scale, rootNote, numNotes, tempo, melodyOptions{})
+}
+
+// melodyOptions are the optional per-request generation modes
+type melodyOptions struct {
+	Markov *MarkovBlend // Blend a trained model into the interference
+	Seed   uint64       // Non-zero: reproducible melody
+}
+
+// generateMelody runs the collapse loop on the lead voice
+func (s *MusicServer) generateMelody(scale string, rootNote, numNotes int, tempo float64, opts melodyOptions) []QuantumNote {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if opts.Seed != 0 {
+		// A seeded melody must not depend on whatever was played before it
+		s.lead = NewVoice()
+		s.lead.engine = s.engineClient.WithSeed(opts.Seed)
+		log.Printf("🌱 Deterministic mode: seed=%d", opts.Seed)
+	}
+	s.lead.markov = opts.Markov
+	defer func() {
+		s.lead.markov = nil
+		s.lead.engine = nil
+	}()
+
+	log.Printf("🎹 Generating %d-note QUANTUM melody...", numNotes)
+
+	notes, _ := s.playVoice(s.lead, scale, rootNote, numNotes, melodyDurations, nil)
+
+	log.Printf("🎵 Generated %d-note QUANTUM melody in %s scale (root=%d)", numNotes, scale, rootNote)
+	return notes
+}
+
+// playVoice collapses v once per note, choosing note lengths from durations,
+// and hands each note to onNote (if set) right after it is measured. An
+// onNote error stops the voice.
+func (s *MusicServer) playVoice(v *Voice, scale string, rootNote, numNotes int, durations []float64,
+	onNote func(QuantumNote) error) ([]QuantumNote, error) {
 	notes := make([]QuantumNote, numNotes)
 	currentTime := 0.0
-	durations := []float64{0.25, 0.5, 1.0, 1.5, 2.0}
+	qe := s.engineClient
+	if v.engine != nil {
+		qe = v.engine
+	}
 
-	log.Printf("🎹 Generating %d-note QUANTUM melody...", numNotes)
+	spec, ok := lookupScale(scale)
+	if !ok {
+		spec, _ = lookupScale("major")
+	}
 
 	for i := 0; i < numNotes; i++ {
 		// 1. Create equal superposition
-		s.stateVector = NewEqualSuperposition()
+		v.stateVector = NewUniformSuperposition(spec.Qubits)
 
 		// 2. Apply musical interference based on previous note
-		s.applyMusicalInterference()
+		v.applyMusicalInterference(spec)
+		v.genome.Apply(v.stateVector, spec, v.lastNote)
+		v.markov.Apply(v.stateVector, v.lastNote)
+		v.contour.Apply(v.stateVector, spec, rootNote)
 
 		// 3. Get state vector BEFORE collapse (for visualization)
-		probs := s.stateVector.Probabilities()
+		probs := v.stateVector.Probabilities()
+		phases := v.stateVector.Phases()
 
 		// 4. QUANTUM COLLAPSE! This is the magic moment
-		outcome := s.stateVector.Collapse(s.engineClient)
-		s.lastNote = outcome
+		outcome := v.stateVector.Collapse(qe)
+		v.lastNote = outcome
 
 		// 5. Map outcome to actual pitch
-		scaleNotes := scales[scale]
-		if scaleNotes == nil {
-			scaleNotes = scales["major"]
-		}
-
-		var pitch int
-		if outcome < len(scaleNotes) {
-			pitch = rootNote + scaleNotes[outcome]
-		} else if outcome == 7 {
-			pitch = 0 // Rest
-		} else {
-			pitch = rootNote + scaleNotes[outcome%len(scaleNotes)]
+		pitch, cents, frequency := spec.Pitch(rootNote, outcome)
+		if spec.Builtin {
+			frequency = noteFrequencies[outcome%8]
 		}
+		pitch, frequency = v.contour.Place(spec, rootNote, outcome, pitch, frequency)
 
 		// 6. Duration also from quantum entropy
-		durationIndex := s.engineClient.Measure3Qubits([8]float64{0.1, 0.2, 0.3, 0.2, 0.15, 0.03, 0.01, 0.01})
+		durationIndex := qe.Measure3Qubits([8]float64{0.1, 0.2, 0.3, 0.2, 0.15, 0.03, 0.01, 0.01})
 		duration := durations[durationIndex%len(durations)]
 
 		// 7. Velocity from final amplitude magnitude
 		velocity := 0.5 + probs[outcome]*0.5
 
+		// 8. Articulation and micro-timing from its phase
+		articulation, timingOffset := phaseArticulation(phases[outcome])
+
 		notes[i] = QuantumNote{
 			Pitch:            pitch,
-			NoteName:         noteNames[outcome%len(noteNames)],
+			NoteName:         spec.DegreeName(outcome),
 			Duration:         duration,
 			Velocity:         velocity,
 			StartTime:        currentTime,
 			QuantumOutcome:   outcome,
 			StateProbsBefore: probs,
-			Frequency:        noteFrequencies[outcome%8],
+			Frequency:        frequency,
+			Cents:            cents,
+			Phase:            phases[outcome],
+			Articulation:     articulation,
+			TimingOffset:     timingOffset,
 		}
 
 		currentTime += duration
 
 		log.Printf("  Note %d: |%d⟩ → %s (pitch=%d, p=%.2f%%)",
-			i+1, outcome, noteNames[outcome%len(noteNames)], pitch, probs[outcome]*100)
+			i+1, outcome, spec.DegreeName(outcome), pitch, probs[outcome]*100)
+
+		if onNote != nil {
+			if err := onNote(notes[i]); err != nil {
+				return notes[:i+1], err
+			}
+		}
 	}
 
-	log.Printf("🎵 Generated %d-note QUANTUM melody in %s scale (root=%d)", numNotes, scale, rootNote)
-	return notes
+	return notes, nil
+}
+
+// degreePitch maps a measured basis state onto the scale (|7⟩ is a rest)
+func degreePitch(scale string, rootNote, outcome int) int {
+	spec, ok := lookupScale(scale)
+	if !ok {
+		spec, _ = lookupScale("major")
+	}
+	pitch, _, _ := spec.Pitch(rootNote, outcome)
+	return pitch
 }
 
 // applyMusicalInterference biases probabilities based on music theory
-func (s *MusicServer) applyMusicalInterference() {
-	if s.lastNote < 0 || s.lastNote > 7 {
+func (v *Voice) applyMusicalInterference(spec *ScaleSpec) {
+	states := len(v.stateVector.Amplitudes)
+	if v.lastNote < 0 || v.lastNote >= states {
 		return // No previous note, keep equal superposition
 	}
 
 	// Get consonant followers for the last note
-	followers := consonantFollowers[s.lastNote%7]
+	followers := spec.Followers(v.lastNote)
 	if len(followers) == 0 {
 		return
 	}
 
 	// Boost amplitude of consonant notes (by √2 = 41% increase in probability)
-	s.stateVector.ApplyAmplitudeBoost(followers, math.Sqrt(2))
+	v.stateVector.ApplyAmplitudeBoost(followers, math.Sqrt(2))
 
 	// Apply phase rotation for harmonic richness
-	// Phase = π × lastNote / 7 (spreads across 0 to π)
-	theta := math.Pi * float64(s.lastNote) / 7.0
-	for i := range s.stateVector.Amplitudes {
-		s.stateVector.ApplyPhaseRotation(i, theta*float64(i)/8.0)
+	// Phase = π × lastNote / (states − 1) (spreads across 0 to π)
+	theta := math.Pi * float64(v.lastNote) / float64(states-1)
+	for i := range v.stateVector.Amplitudes {
+		v.stateVector.ApplyPhaseRotation(i, theta*float64(i)/float64(states))
 	}
 
 	log.Printf("  🎼 Applied interference: %s → biased toward %v",
-		noteNames[s.lastNote%len(noteNames)], followers)
+		spec.DegreeName(v.lastNote), followers)
+}
+
+// ------------------------------------------------------------------
+// gRPC Handlers (QuantumMusic service)
+// ------------------------------------------------------------------
+
+// GenerateMelody generates a melody and returns every note with its
+// pre-collapse probabilities
+func (s *MusicServer) GenerateMelody(ctx context.Context, req *pb.MelodyRequest) (*pb.Melody, error) {
+	scale, rootNote, numNotes, tempo, err := melodyParams(req)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := s.melodyOptions(req)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := s.generateMelody(scale, rootNote, numNotes, tempo, opts)
+
+	return &pb.Melody{
+		Notes:         notesToProto(notes),
+		Scale:         req.Scale,
+		RootNote:      int32(rootNote),
+		DurationBeats: melodyLength(notes),
+		Tempo:         tempo,
+		Seed:          req.Seed,
+	}, nil
+}
+
+// GenerateMelodyStream sends each note the moment its state collapses so
+// clients can animate the wavefunction note by note. With realtime set,
+// notes are paced at the requested tempo. The stream plays its own voice,
+// so pacing never holds the server lock or moves the lead voice.
+func (s *MusicServer) GenerateMelodyStream(req *pb.MelodyRequest, stream pb.QuantumMusic_GenerateMelodyStreamServer) error {
+	scale, rootNote, numNotes, tempo, err := melodyParams(req)
+	if err != nil {
+		return err
+	}
+	opts, err := s.melodyOptions(req)
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	beat := time.Duration(float64(time.Minute) / tempo)
+
+	v := NewVoice()
+	v.markov = opts.Markov
+	if opts.Seed != 0 {
+		v.engine = s.engineClient.WithSeed(opts.Seed)
+	}
+	_, err = s.playVoice(v, scale, rootNote, numNotes, melodyDurations, func(n QuantumNote) error {
+		if err := stream.Send(notesToProto([]QuantumNote{n})[0]); err != nil {
+			return err
+		}
+		if !req.Realtime {
+			return ctx.Err()
+		}
+		select {
+		case <-time.After(time.Duration(n.Duration * float64(beat))):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		log.Printf("⚠️  Melody stream stopped: %v", err)
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return err
+	}
+	return nil
+}
+
+// TrainMarkov learns a transition matrix from uploaded MIDI files and
+// stores it under req.Name for MelodyRequest.markov_model
+func (s *MusicServer) TrainMarkov(ctx context.Context, req *pb.MarkovTrainingRequest) (*pb.MarkovModel, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	scale, err := scaleName(req.Scale)
+	if err != nil {
+		return nil, err
+	}
+	rootNote := int(req.RootNote)
+	if rootNote <= 0 {
+		rootNote = 60 // C4
+	}
+
+	model, err := TrainMarkovModel(req.Name, req.MidiFiles, scale, rootNote)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.modelsMu.Lock()
+	s.markovModels[req.Name] = model
+	s.modelsMu.Unlock()
+
+	resp := &pb.MarkovModel{
+		Name:            model.Name,
+		Scale:           req.Scale,
+		NumTransitions:  int32(model.Count),
+		TransitionProbs: make([]float64, 0, 64),
+	}
+	for _, row := range model.Transitions {
+		resp.TransitionProbs = append(resp.TransitionProbs, row[:]...)
+	}
+	return resp, nil
+}
+
+// RegisterScale adds a custom scale from cents, EDO steps or just ratios
+func (s *MusicServer) RegisterScale(ctx context.Context, req *pb.ScaleDefinition) (*pb.ScaleInfo, error) {
+	var cents []float64
+	var tuning string
+	var err error
+
+	switch {
+	case len(req.Cents) > 0:
+		cents, tuning = req.Cents, "cents"
+	case req.Edo > 0:
+		steps := make([]int, len(req.EdoSteps))
+		for i, step := range req.EdoSteps {
+			steps[i] = int(step)
+		}
+		cents, err = EDOCents(int(req.Edo), steps)
+		tuning = fmt.Sprintf("%d-TET", req.Edo)
+	case len(req.Ratios) > 0:
+		tuning = "just"
+		for _, r := range req.Ratios {
+			c, ratioErr := RatioCents(int(r.Numerator), int(r.Denominator))
+			if ratioErr != nil {
+				err = ratioErr
+				break
+			}
+			cents = append(cents, c)
+		}
+	default:
+		err = fmt.Errorf("one of cents, edo or ratios is required")
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spec, err := RegisterScale(req.Name, tuning, cents)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("🎚️  Registered scale %q: %d degrees (%s) on %d qubits",
+		spec.Name, len(spec.Cents), spec.Tuning, spec.Qubits)
+	return scaleInfo(spec), nil
+}
+
+// ListScales returns every scale a melody can use
+func (s *MusicServer) ListScales(ctx context.Context, req *pb.ListScalesRequest) (*pb.ScaleList, error) {
+	resp := &pb.ScaleList{}
+	for _, spec := range listScales() {
+		resp.Scales = append(resp.Scales, scaleInfo(spec))
+	}
+	return resp, nil
 }
 
 // GetStateVector returns the current quantum state for visualization
-func (s *MusicServer) GetStateVector() [8]complex128 {
+func (s *MusicServer) GetStateVector(ctx context.Context, req *pb.StateVectorRequest) (*pb.StateVector, error) {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-	return s.stateVector.Amplitudes
+	amplitudes := append([]complex128(nil), s.lead.stateVector.Amplitudes...)
+	lastNote := s.lead.lastNote
+	s.mu.Unlock()
+
+	resp := &pb.StateVector{LastOutcome: int32(lastNote)}
+	for _, a := range amplitudes {
+		resp.Amplitudes = append(resp.Amplitudes, &pb.Amplitude{Real: real(a), Imag: imag(a)})
+		resp.Probabilities = append(resp.Probabilities, cmplx.Abs(a)*cmplx.Abs(a))
+	}
+	return resp, nil
+}
+
+// ExportMIDI renders a melody, chord progression, score or drum pattern as
+// a Standard MIDI File
+func (s *MusicServer) ExportMIDI(ctx context.Context, req *pb.ExportRequest) (*pb.MIDIFile, error) {
+	tempo := 120.0
+	numTracks := 1
+	var data []byte
+	var beats float64
+
+	switch src := req.Source.(type) {
+	case *pb.ExportRequest_Melody:
+		if src.Melody.Tempo > 0 {
+			tempo = src.Melody.Tempo
+		}
+		data, beats = MelodyToMIDI(notesFromProto(src.Melody.Notes), tempo)
+	case *pb.ExportRequest_Chords:
+		chords := make([]Chord, len(src.Chords.Chords))
+		for i, c := range src.Chords.Chords {
+			chords[i] = Chord{Name: c.Name, Duration: c.Duration}
+			for _, n := range c.Notes {
+				chords[i].Notes = append(chords[i].Notes, int(n))
+			}
+		}
+		data, beats = ChordsToMIDI(chords, tempo)
+	case *pb.ExportRequest_Score:
+		score := scoreFromProto(src.Score)
+		if score.Tempo > 0 {
+			tempo = score.Tempo
+		} else {
+			score.Tempo = tempo
+		}
+		data, beats = ScoreToMIDI(score)
+		numTracks = len(score.Tracks) + 1 // Plus the conductor track
+	case *pb.ExportRequest_Rhythm:
+		if src.Rhythm.Tempo > 0 {
+			tempo = src.Rhythm.Tempo
+		}
+		data, beats = RhythmToMIDI(rhythmFromProto(src.Rhythm), tempo)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "melody, chords, score or rhythm required")
+	}
+
+	filename := req.Filename
+	if filename == "" {
+		filename = "quantum_melody.mid"
+	}
+
+	log.Printf("💾 Exported %s (%d bytes, %.1f beats)", filename, len(data), beats)
+
+	return &pb.MIDIFile{
+		Data:            data,
+		Filename:        filename,
+		NumTracks:       int32(numTracks),
+		DurationSeconds: beats * 60 / tempo,
+	}, nil
+}
+
+// ExportWAV renders a melody as audio with the radio's voice
+func (s *MusicServer) ExportWAV(ctx context.Context, req *pb.ExportRequest) (*pb.AudioFile, error) {
+	src, ok := req.Source.(*pb.ExportRequest_Melody)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "melody required")
+	}
+	tempo := 120.0
+	if src.Melody.Tempo > 0 {
+		tempo = src.Melody.Tempo
+	}
+	notes := notesFromProto(src.Melody.Notes)
+	if seconds := wavSeconds(notes, tempo); seconds > maxWAVSeconds {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"melody lasts %.0fs; at most %ds can be rendered", seconds, maxWAVSeconds)
+	}
+	data, seconds := MelodyToWAV(notes, tempo)
+
+	filename := req.Filename
+	if filename == "" {
+		filename = "quantum_melody.wav"
+	}
+
+	log.Printf("🔊 Rendered %s (%d bytes, %.1fs)", filename, len(data), seconds)
+
+	return &pb.AudioFile{
+		Data:            data,
+		Filename:        filename,
+		SampleRate:      radioSampleRate,
+		DurationSeconds: seconds,
+	}, nil
+}
+
+// GenerateRhythm generates a quantum-walk drum groove
+func (s *MusicServer) GenerateRhythm(ctx context.Context, req *pb.RhythmRequest) (*pb.RhythmPattern, error) {
+	if req.NumBars > 64 {
+		return nil, status.Error(codes.InvalidArgument, "num_bars must be at most 64")
+	}
+	if req.BeatsPerBar < 0 || req.BeatsPerBar > 16 {
+		return nil, status.Error(codes.InvalidArgument, "beats_per_bar must be between 1 and 16")
+	}
+	density := req.Density
+	if density == 0 {
+		density = 0.5 // Unset: keep the template's walk profile
+	}
+
+	pattern := s.GenerateQuantumRhythm(int(req.BeatsPerBar), int(req.NumBars), req.Style, req.Swing, density)
+	return rhythmToProto(pattern, req.Tempo), nil
+}
+
+// GenerateDrums generates a drum pattern with one qubit per instrument
+func (s *MusicServer) GenerateDrums(ctx context.Context, req *pb.RhythmRequest) (*pb.RhythmPattern, error) {
+	if req.NumBars > 64 {
+		return nil, status.Error(codes.InvalidArgument, "num_bars must be at most 64")
+	}
+	if req.BeatsPerBar < 0 || req.BeatsPerBar > 16 {
+		return nil, status.Error(codes.InvalidArgument, "beats_per_bar must be between 1 and 16")
+	}
+	density := req.Density
+	if density == 0 {
+		density = 0.5 // Unset: keep the template's probabilities
+	}
+
+	pattern := s.GenerateQubitDrums(int(req.BeatsPerBar), int(req.NumBars), req.Style, req.Swing, density)
+	return rhythmToProto(pattern, req.Tempo), nil
+}
+
+// FindMotif generates a melody following a Parsons-code contour, using
+// amplitude amplification to make each step likely
+func (s *MusicServer) FindMotif(ctx context.Context, req *pb.MotifRequest) (*pb.Motif, error) {
+	steps, err := ParseContour(req.Contour)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scale, rootNote, _, tempo, err := melodyParams(&pb.MelodyRequest{
+		Scale:       req.Scale,
+		RootNote:    req.RootNote,
+		NumNotes:    int32(len(steps) + 1),
+		Tempo:       req.Tempo,
+		CustomScale: req.CustomScale,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	motif := s.FindContourMotif(scale, rootNote, steps, req.Seed)
+
+	resp := &pb.Motif{
+		Melody: &pb.Melody{
+			Notes:         notesToProto(motif.Notes),
+			Scale:         req.Scale,
+			RootNote:      int32(rootNote),
+			DurationBeats: melodyLength(motif.Notes),
+			Tempo:         tempo,
+			Seed:          req.Seed,
+		},
+		Contour:   motif.Contour,
+		MatchRate: motif.MatchRate,
+	}
+	for _, step := range motif.Steps {
+		resp.Steps = append(resp.Steps, &pb.MotifStep{
+			Direction:            string(step.Direction),
+			PriorProbability:     step.PriorProbability,
+			Iterations:           int32(step.Iterations),
+			AmplifiedProbability: step.AmplifiedProbability,
+			Matched:              step.Matched,
+		})
+	}
+	return resp, nil
+}
+
+// melodyParams validates a MelodyRequest and fills in defaults
+func melodyParams(req *pb.MelodyRequest) (scale string, rootNote, numNotes int, tempo float64, err error) {
+	if req.CustomScale != "" {
+		if _, ok := lookupScale(req.CustomScale); !ok {
+			return "", 0, 0, 0, status.Errorf(codes.NotFound, "scale %q is not registered", req.CustomScale)
+		}
+		scale = req.CustomScale
+	} else if scale, err = scaleName(req.Scale); err != nil {
+		return "", 0, 0, 0, err
+	}
+
+	numNotes = int(req.NumNotes)
+	if numNotes <= 0 {
+		numNotes = 8
+	}
+	if numNotes > 1024 {
+		return "", 0, 0, 0, status.Error(codes.InvalidArgument, "num_notes must be at most 1024")
+	}
+	rootNote = int(req.RootNote)
+	if rootNote <= 0 {
+		rootNote = 60 // C4
+	}
+	tempo = req.Tempo
+	if tempo <= 0 {
+		tempo = 120
+	}
+	return scale, rootNote, numNotes, tempo, nil
+}
+
+// ComposeScore generates a multi-track score in one shared key and tempo
+func (s *MusicServer) ComposeScore(ctx context.Context, req *pb.ScoreRequest) (*pb.Score, error) {
+	scale, err := scaleName(req.Scale)
+	if err != nil {
+		return nil, err
+	}
+	if req.NumBars > 64 {
+		return nil, status.Error(codes.InvalidArgument, "num_bars must be at most 64")
+	}
+	if req.BeatsPerBar < 0 || req.BeatsPerBar > 16 {
+		return nil, status.Error(codes.InvalidArgument, "beats_per_bar must be between 1 and 16")
+	}
+	rootNote := int(req.RootNote)
+	if rootNote <= 0 {
+		rootNote = 60 // C4
+	}
+	tempo := req.Tempo
+	if tempo <= 0 {
+		tempo = 120
+	}
+	roles := make([]int, len(req.Tracks))
+	for i, r := range req.Tracks {
+		roles[i] = int(r)
+	}
+
+	score := s.ComposeScoreTracks(scale, rootNote, tempo, int(req.BeatsPerBar), int(req.NumBars), roles, req.GrooveStyle)
+	return scoreToProto(score, req.Scale), nil
+}
+
+// GenerateDuet generates entangled melody/bass counterpoint
+func (s *MusicServer) GenerateDuet(ctx context.Context, req *pb.DuetRequest) (*pb.Duet, error) {
+	scale, rootNote, numNotes, tempo, err := melodyParams(&pb.MelodyRequest{
+		Scale:    req.Scale,
+		RootNote: req.RootNote,
+		NumNotes: req.NumNotes,
+		Tempo:    req.Tempo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	coupling := req.Coupling
+	if coupling <= 0 {
+		coupling = 1
+	}
+
+	duet := s.GenerateEntangledDuet(scale, rootNote, numNotes, tempo, coupling)
+
+	return &pb.Duet{
+		Score:             scoreToProto(duet.Score, req.Scale),
+		Consonance:        duet.Consonance,
+		MutualInformation: duet.MutualInformation,
+	}, nil
+}
+
+// PlayLive sends notes to a live output at tempo, streaming each note back
+// as it starts sounding
+func (s *MusicServer) PlayLive(req *pb.LiveRequest, stream pb.QuantumMusic_PlayLiveServer) error {
+	out, ok := s.outputs[req.Output]
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "live output %q is not configured", req.Output)
+	}
+
+	var tracks []*Track
+	tempo := 120.0
+	switch src := req.Source.(type) {
+	case *pb.LiveRequest_Generate:
+		scale, rootNote, numNotes, t, err := melodyParams(src.Generate)
+		if err != nil {
+			return err
+		}
+		opts, err := s.melodyOptions(src.Generate)
+		if err != nil {
+			return err
+		}
+		notes := s.generateMelody(scale, rootNote, numNotes, t, opts)
+		tracks, tempo = []*Track{{Name: "Melody", Notes: notes}}, t
+	case *pb.LiveRequest_Melody:
+		if src.Melody.Tempo > 0 {
+			tempo = src.Melody.Tempo
+		}
+		tracks = []*Track{{Name: "Melody", Notes: notesFromProto(src.Melody.Notes)}}
+	case *pb.LiveRequest_Score:
+		score := scoreFromProto(src.Score)
+		if score.Tempo > 0 {
+			tempo = score.Tempo
+		}
+		tracks = score.Tracks
+	default:
+		return status.Error(codes.InvalidArgument, "generate, melody or score required")
+	}
+
+	log.Printf("🔊 Playing %d track(s) live on %s @ %.0f BPM", len(tracks), req.Output, tempo)
+	ctx := stream.Context()
+	err := PlayLive(ctx, out, tracks, tempo, func(n QuantumNote) error {
+		return stream.Send(notesToProto([]QuantumNote{n})[0])
+	})
+	if err != nil {
+		log.Printf("⚠️  Live playback stopped: %v", err)
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return nil
+}
+
+// HarmonizeMelody accompanies a melody (or the top line of a MIDI file)
+// with quantum-chosen chords and a bass line
+func (s *MusicServer) HarmonizeMelody(ctx context.Context, req *pb.HarmonizeRequest) (*pb.Harmonization, error) {
+	scale, err := scaleName(req.Scale)
+	if err != nil {
+		return nil, err
+	}
+
+	var melody []QuantumNote
+	tempo := req.Tempo
+	switch src := req.Source.(type) {
+	case *pb.HarmonizeRequest_Melody:
+		melody = notesFromProto(src.Melody.Notes)
+		if tempo <= 0 {
+			tempo = src.Melody.Tempo
+		}
+	case *pb.HarmonizeRequest_MidiData:
+		if melody, err = melodyFromMIDI(src.MidiData); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid MIDI: %v", err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "melody or midi_data required")
+	}
+	if len(melody) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "melody has no notes")
+	}
+	if tempo <= 0 {
+		tempo = 120
+	}
+
+	rootNote := int(req.RootNote)
+	if rootNote <= 0 {
+		rootNote = 60 // C4
+	}
+	beatsPerBar := int(req.BeatsPerBar)
+	if beatsPerBar <= 0 {
+		beatsPerBar = 4
+	}
+	chordBeats := req.ChordBeats
+	if chordBeats <= 0 {
+		chordBeats = float64(beatsPerBar)
+	}
+	if melodyLength(melody)/chordBeats > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "melody needs more than 1024 chords")
+	}
+
+	h, err := s.HarmonizeNotes(melody, scale, rootNote, tempo, chordBeats, beatsPerBar, req.Seed)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	progression := &pb.ChordProgression{ProgressionName: h.Progression}
+	for _, c := range h.Chords {
+		chord := &pb.Chord{Name: c.Name, Duration: c.Duration}
+		for _, n := range c.Notes {
+			chord.Notes = append(chord.Notes, int32(n))
+		}
+		progression.Chords = append(progression.Chords, chord)
+	}
+	return &pb.Harmonization{
+		Score:             scoreToProto(h.Score, req.Scale),
+		Progression:       progression,
+		ChordToneCoverage: h.Coverage,
+	}, nil
+}
+
+// EvolveMelody records the user's ratings, breeds their population and
+// returns the next candidates to rate
+func (s *MusicServer) EvolveMelody(ctx context.Context, req *pb.EvolveRequest) (*pb.Evolution, error) {
+	scale, rootNote, numNotes, tempo, err := melodyParams(&pb.MelodyRequest{
+		Scale:       req.Scale,
+		RootNote:    req.RootNote,
+		NumNotes:    req.NumNotes,
+		Tempo:       req.Tempo,
+		CustomScale: req.CustomScale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	numCandidates := int(req.NumCandidates)
+	if numCandidates <= 0 {
+		numCandidates = 4
+	}
+	if numCandidates > populationSize {
+		return nil, status.Errorf(codes.InvalidArgument, "num_candidates must be at most %d", populationSize)
+	}
+
+	ratings := make(map[string]float64, len(req.Ratings))
+	for _, r := range req.Ratings {
+		if r.Rating < 1 || r.Rating > 5 {
+			return nil, status.Errorf(codes.InvalidArgument, "rating for %q must be 1-5", r.CandidateId)
+		}
+		ratings[r.CandidateId] = float64(r.Rating)
+	}
+
+	evolution, candidates, err := s.EvolveMelodies(req.UserId, ratings, numCandidates, scale, rootNote, numNotes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pb.Evolution{
+		Generation:  int32(evolution.Generation),
+		LearnedBias: genomeToProto(evolution.LearnedBias()),
+		MeanRating:  evolution.MeanRating,
+	}
+	for _, c := range candidates {
+		resp.Candidates = append(resp.Candidates, &pb.Candidate{
+			Id: c.ID,
+			Melody: &pb.Melody{
+				Notes:         notesToProto(c.Notes),
+				Scale:         req.Scale,
+				RootNote:      int32(rootNote),
+				DurationBeats: melodyLength(c.Notes),
+				Tempo:         tempo,
+			},
+			Genome: genomeToProto(c.Genome),
+		})
+	}
+	return resp, nil
+}
+
+// melodyOptions resolves the request's Markov model and seed
+func (s *MusicServer) melodyOptions(req *pb.MelodyRequest) (melodyOptions, error) {
+	opts := melodyOptions{Seed: req.Seed}
+	if req.MarkovModel == "" {
+		return opts, nil
+	}
+	s.modelsMu.RLock()
+	model, ok := s.markovModels[req.MarkovModel]
+	s.modelsMu.RUnlock()
+	if !ok {
+		return opts, status.Errorf(codes.NotFound, "markov model %q not found", req.MarkovModel)
+	}
+
+	mix := req.MarkovMix
+	if mix <= 0 {
+		mix = 0.5
+	}
+	opts.Markov = &MarkovBlend{Model: model, Mix: math.Min(1, mix)}
+	return opts, nil
+}
+
+// scaleName maps the proto Scale enum onto the scales table
+func scaleName(scale pb.Scale) (string, error) {
+	name := strings.ToLower(strings.TrimPrefix(scale.String(), "SCALE_"))
+	if _, ok := scales[name]; !ok {
+		return "", status.Errorf(codes.InvalidArgument,
+			"scale %s is not supported (available: major, minor, pentatonic, blues, dorian)", scale)
+	}
+	return name, nil
+}
+
+func scaleInfo(spec *ScaleSpec) *pb.ScaleInfo {
+	return &pb.ScaleInfo{
+		Name:      spec.Name,
+		Tuning:    spec.Tuning,
+		Cents:     spec.Cents,
+		NumQubits: int32(spec.Qubits),
+		Builtin:   spec.Builtin,
+	}
+}
+
+func notesToProto(notes []QuantumNote) []*pb.QuantumNote {
+	out := make([]*pb.QuantumNote, len(notes))
+	for i, n := range notes {
+		out[i] = &pb.QuantumNote{
+			Pitch:            int32(n.Pitch),
+			NoteName:         n.NoteName,
+			Duration:         n.Duration,
+			Velocity:         n.Velocity,
+			StartTime:        n.StartTime,
+			QuantumOutcome:   int32(n.QuantumOutcome),
+			StateProbsBefore: n.StateProbsBefore,
+			Frequency:        n.Frequency,
+			Cents:            n.Cents,
+			Phase:            n.Phase,
+			Articulation:     n.Articulation,
+			TimingOffset:     n.TimingOffset,
+		}
+	}
+	return out
+}
+
+func notesFromProto(notes []*pb.QuantumNote) []QuantumNote {
+	out := make([]QuantumNote, len(notes))
+	for i, n := range notes {
+		out[i] = QuantumNote{
+			Pitch:            int(n.Pitch),
+			NoteName:         n.NoteName,
+			Duration:         n.Duration,
+			Velocity:         n.Velocity,
+			StartTime:        n.StartTime,
+			QuantumOutcome:   int(n.QuantumOutcome),
+			StateProbsBefore: n.StateProbsBefore,
+			Frequency:        n.Frequency,
+			Cents:            n.Cents,
+			Phase:            n.Phase,
+			Articulation:     n.Articulation,
+			TimingOffset:     n.TimingOffset,
+		}
+	}
+	return out
+}
+
+func genomeToProto(g Genome) *pb.Genome {
+	return &pb.Genome{
+		DegreeBias: g.DegreeBias[:],
+		StepBoost:  g.StepBoost,
+		PhaseShift: g.PhaseShift,
+	}
+}
+
+func rhythmToProto(pattern *RhythmPattern, tempo float64) *pb.RhythmPattern {
+	resp := &pb.RhythmPattern{
+		BeatsPerBar: int32(pattern.BeatsPerBar),
+		NumBars:     int32(pattern.Bars),
+		Style:       pattern.Style,
+		Tempo:       tempo,
+	}
+	for _, e := range pattern.Events {
+		resp.Events = append(resp.Events, &pb.BeatEvent{
+			Time:           e.Time,
+			Instrument:     int32(e.Instrument),
+			Velocity:       e.Velocity,
+			HitProbability: e.HitProbability,
+		})
+	}
+	return resp
+}
+
+func rhythmFromProto(pattern *pb.RhythmPattern) *RhythmPattern {
+	out := &RhythmPattern{
+		BeatsPerBar: int(pattern.BeatsPerBar),
+		Bars:        int(pattern.NumBars),
+		Style:       pattern.Style,
+	}
+	if out.BeatsPerBar <= 0 {
+		out.BeatsPerBar = 4
+	}
+	for _, e := range pattern.Events {
+		out.Events = append(out.Events, BeatEvent{
+			Time:           e.Time,
+			Instrument:     int(e.Instrument),
+			Velocity:       e.Velocity,
+			HitProbability: e.HitProbability,
+		})
+	}
+	return out
+}
+
+func scoreToProto(score *Score, scale pb.Scale) *pb.Score {
+	resp := &pb.Score{
+		Scale:         scale,
+		RootNote:      int32(score.RootNote),
+		Tempo:         score.Tempo,
+		BeatsPerBar:   int32(score.BeatsPerBar),
+		NumBars:       int32(score.Bars),
+		DurationBeats: float64(score.BeatsPerBar * score.Bars),
+	}
+	for _, t := range score.Tracks {
+		resp.Tracks = append(resp.Tracks, &pb.Track{
+			Name:    t.Name,
+			Role:    pb.TrackRole(t.Role),
+			Channel: int32(t.Channel),
+			Program: int32(t.Program),
+			Notes:   notesToProto(t.Notes),
+		})
+	}
+	return resp
+}
+
+func scoreFromProto(score *pb.Score) *Score {
+	out := &Score{
+		RootNote:    int(score.RootNote),
+		Tempo:       score.Tempo,
+		BeatsPerBar: int(score.BeatsPerBar),
+		Bars:        int(score.NumBars),
+	}
+	if out.BeatsPerBar <= 0 {
+		out.BeatsPerBar = 4
+	}
+	for _, t := range score.Tracks {
+		out.Tracks = append(out.Tracks, &Track{
+			Name:    t.Name,
+			Role:    int(t.Role),
+			Channel: byte(t.Channel & 0x0F),
+			Program: byte(t.Program & 0x7F),
+			Notes:   notesFromProto(t.Notes),
+		})
+	}
+	return out
+}
+
+func melodyLength(notes []QuantumNote) float64 {
+	length := 0.0
+	for _, n := range notes {
+		length = math.Max(length, n.StartTime+n.Duration)
+	}
+	return length
 }
 
 // ------------------------------------------------------------------
@@ -337,14 +1268,18 @@ func (s *MusicServer) GetStateVector() [8]complex128 {
 // ------------------------------------------------------------------
 
 type QuantumNote struct {
-	Pitch            int        // MIDI pitch
-	NoteName         string     // C, D, E, F, G, A, B, REST
-	Duration         float64    // In beats
-	Velocity         float64    // 0.0 - 1.0
-	StartTime        float64    // In beats
-	QuantumOutcome   int        // 0-7 measurement result
-	StateProbsBefore [8]float64 // Probabilities before collapse
-	Frequency        float64    // Hz
+	Pitch            int       // MIDI pitch
+	NoteName         string    // C, D, E, F, G, A, B, REST
+	Duration         float64   // In beats
+	Velocity         float64   // 0.0 - 1.0
+	StartTime        float64   // In beats
+	QuantumOutcome   int       // Measured basis state (0-7 for built-in scales)
+	StateProbsBefore []float64 // Probabilities before collapse
+	Frequency        float64   // Hz
+	Cents            float64   // Detune from Pitch (microtonal scales)
+	Phase            float64   // arg(a_k) of the collapsed amplitude
+	Articulation     float64   // Sounding fraction of Duration (0.5 staccato - 1.0 legato, 0 = full)
+	TimingOffset     float64   // Humanization in beats, applied to StartTime on export
 }
 
 type Chord struct {
@@ -360,9 +1295,29 @@ type Chord struct {
 func main() {
 	port := flag.Int("port", 50062, "gRPC port")
 	engineAddr := flag.String("engine-addr", "engine:50051", "Quantum Engine address")
+	wsPort := flag.Int("ws-port", 8062, "WebSocket radio port (0 = disabled)")
+	oscAddr := flag.String("osc", "", "Live OSC output host:port (e.g. 127.0.0.1:57120)")
+	midiOut := flag.String("midi-out", "", "Live raw MIDI output device or FIFO (e.g. /dev/snd/midiC1D0)")
+	dataDir := flag.String("data-dir", "evolution", "Directory for per-user EvolveMelody state (empty = memory only)")
 	flag.Parse()
 
-	server := NewMusicServer(*engineAddr)
+	server := NewMusicServer(*engineAddr, *dataDir)
+	if *oscAddr != "" {
+		out, err := NewOSCOutput(*oscAddr)
+		if err != nil {
+			log.Fatalf("Failed to open OSC output: %v", err)
+		}
+		server.outputs["osc"] = out
+		log.Printf("🔊 Live OSC output → %s", *oscAddr)
+	}
+	if *midiOut != "" {
+		out, err := NewRawMIDIOutput(*midiOut)
+		if err != nil {
+			log.Fatalf("Failed to open MIDI output: %v", err)
+		}
+		server.outputs["midi"] = out
+		log.Printf("🔊 Live MIDI output → %s", *midiOut)
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
@@ -370,11 +1325,24 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
+	pb.RegisterQuantumMusicServer(grpcServer, server)
 
 	log.Printf("🎹 QUANTUM MOZART starting on port %d", *port)
 	log.Printf("   Engine: %s", *engineAddr)
 	log.Printf("   ⚛️  NO MORE math/rand FRAUD - TRUE QUANTUM MUSIC!")
-	log.Printf("   🎵 Scales: major, minor, pentatonic, blues, dorian")
+	log.Printf("   🎵 Scales: major, minor, pentatonic, blues, dorian (+ custom tunings via RegisterScale)")
+	log.Printf("   🥁 Grooves: rock, jazz, electronic (quantum walk)")
+
+	if *wsPort > 0 {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/radio", server.ServeRadio)
+		go func() {
+			log.Printf("📻 Quantum radio on ws://0.0.0.0:%d/radio", *wsPort)
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", *wsPort), mux); err != nil {
+				log.Printf("⚠️  Radio stopped: %v", err)
+			}
+		}()
+	}
 
 	// Demo: Generate a test melody
 	go func() {
@@ -387,6 +1355,4 @@ func main() {
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-
-	_ = server
 }
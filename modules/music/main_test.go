@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	engine "github.com/perclft/QubitEngine/modules/music/generated/engine"
+	pb "github.com/perclft/QubitEngine/modules/music/generated/music"
+)
+
+// countingEngine measures every qubit as |0⟩ and counts the circuits run
+type countingEngine struct {
+	engine.QuantumComputeClient
+	circuits atomic.Int32
+}
+
+func (e *countingEngine) RunCircuit(ctx context.Context, req *engine.CircuitRequest, _ ...grpc.CallOption) (*engine.StateResponse, error) {
+	e.circuits.Add(1)
+	results := make(map[uint32]bool)
+	for q := uint32(0); q < uint32(req.NumQubits); q++ {
+		results[q] = false
+	}
+	return &engine.StateResponse{ClassicalResults: results}, nil
+}
+
+// newTestServer returns a server measuring on e without dialing an engine
+func newTestServer(e *countingEngine) *MusicServer {
+	return &MusicServer{
+		engineClient: &QuantumEngineClient{client: e},
+		lead:         NewVoice(),
+		markovModels: make(map[string]*MarkovModel),
+		outputs:      make(map[string]NoteOutput),
+		evolution:    NewEvolutionStore(""),
+	}
+}
+
+// melodyStream records how many circuits had run when each note was sent
+type melodyStream struct {
+	grpc.ServerStream
+	engine *countingEngine
+	sentAt []int32
+}
+
+func (m *melodyStream) Context() context.Context { return context.Background() }
+
+func (m *melodyStream) Send(n *pb.QuantumNote) error {
+	m.sentAt = append(m.sentAt, m.engine.circuits.Load())
+	return nil
+}
+
+func TestGenerateMelodyStreamSendsNotesAsTheyCollapse(t *testing.T) {
+	e := &countingEngine{}
+	s := newTestServer(e)
+	stream := &melodyStream{engine: e}
+	if err := s.GenerateMelodyStream(&pb.MelodyRequest{NumNotes: 64}, stream); err != nil {
+		t.Fatalf("GenerateMelodyStream: %v", err)
+	}
+	if len(stream.sentAt) != 64 {
+		t.Fatalf("sent %d notes, want 64", len(stream.sentAt))
+	}
+	// Each note is one pitch and one duration measurement
+	total := e.circuits.Load()
+	if stream.sentAt[0] != 2 {
+		t.Errorf("first note sent after %d of %d measurements, want 2", stream.sentAt[0], total)
+	}
+	for i := 1; i < len(stream.sentAt); i++ {
+		if stream.sentAt[i] <= stream.sentAt[i-1] {
+			t.Fatalf("note %d sent with no new measurement", i+1)
+		}
+	}
+}
+
+func TestBeatsPerBarBounds(t *testing.T) {
+	s := newTestServer(&countingEngine{})
+	ctx := context.Background()
+	rpcs := map[string]func(beatsPerBar int32) error{
+		"GenerateRhythm": func(b int32) error {
+			_, err := s.GenerateRhythm(ctx, &pb.RhythmRequest{BeatsPerBar: b, NumBars: 1})
+			return err
+		},
+		"GenerateDrums": func(b int32) error {
+			_, err := s.GenerateDrums(ctx, &pb.RhythmRequest{BeatsPerBar: b, NumBars: 1})
+			return err
+		},
+		"ComposeScore": func(b int32) error {
+			_, err := s.ComposeScore(ctx, &pb.ScoreRequest{BeatsPerBar: b, NumBars: 1})
+			return err
+		},
+	}
+	for name, rpc := range rpcs {
+		for _, b := range []int32{-1, 17, 1 << 30} {
+			if err := rpc(b); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s with beats_per_bar %d: %v, want InvalidArgument", name, b, err)
+			}
+		}
+		for _, b := range []int32{0, 1, 16} {
+			if err := rpc(b); err != nil {
+				t.Errorf("%s with beats_per_bar %d: %v", name, b, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Hybrid Markov–Quantum Composition
+// A transition matrix learned from MIDI corpora is blended into the voice's
+// state vector before collapse: the corpus constrains the style while the
+// measurement itself stays quantum.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// restGap is the silence (in beats) treated as a rest between corpus notes
+const restGap = 0.5
+
+// MarkovModel holds P(next degree | previous degree) over the 8 basis
+// states (scale degrees 0-6, 7 = rest), learned in scale-degree space so a
+// model trained in one key or mode can steer melodies in any other
+type MarkovModel struct {
+	Name        string
+	Scale       string
+	Transitions [8][8]float64
+	Count       int // Transitions observed in the corpus
+}
+
+// TrainMarkovModel counts degree-to-degree transitions across the corpus.
+// Each MIDI track is a separate line; simultaneous note-ons keep only the
+// highest pitch, and the drum channel is ignored. Rows are add-one smoothed.
+func TrainMarkovModel(name string, corpus [][]byte, scale string, rootNote int) (*MarkovModel, error) {
+	if len(corpus) == 0 {
+		return nil, errors.New("no MIDI files supplied")
+	}
+	scaleNotes, ok := scales[scale]
+	if !ok {
+		return nil, fmt.Errorf("unknown scale %q", scale)
+	}
+
+	model := &MarkovModel{Name: name, Scale: scale}
+	var counts [8][8]float64
+	for i, data := range corpus {
+		notes, err := ReadMIDINotes(data)
+		if err != nil {
+			return nil, fmt.Errorf("MIDI file %d: %w", i, err)
+		}
+		for _, line := range melodicLines(notes) {
+			prev := -1
+			prevEnd := 0.0
+			for _, n := range line {
+				if prev >= 0 && n.StartTime-prevEnd >= restGap {
+					counts[prev][7]++
+					prev = 7
+					model.Count++
+				}
+				degree := pitchDegree(n.Pitch, rootNote, scaleNotes)
+				if prev >= 0 {
+					counts[prev][degree]++
+					model.Count++
+				}
+				prev = degree
+				prevEnd = n.StartTime + n.Duration
+			}
+		}
+	}
+	if model.Count == 0 {
+		return nil, errors.New("corpus contains no melodic transitions")
+	}
+
+	for from := range counts {
+		total := 0.0
+		for to := range counts[from] {
+			total += counts[from][to] + 1
+		}
+		for to := range counts[from] {
+			model.Transitions[from][to] = (counts[from][to] + 1) / total
+		}
+	}
+
+	log.Printf("📚 Trained Markov model %q: %d transitions (%s)", name, model.Count, scale)
+	return model, nil
+}
+
+// melodicLines splits notes per (track, channel) and reduces chords to
+// their top note
+func melodicLines(notes []MIDINote) [][]MIDINote {
+	byLine := make(map[[2]int][]MIDINote)
+	var keys [][2]int
+	for _, n := range notes {
+		if n.Channel == 9 {
+			continue // General MIDI percussion
+		}
+		key := [2]int{n.Track, n.Channel}
+		line, seen := byLine[key]
+		if !seen {
+			keys = append(keys, key)
+		}
+		if last := len(line) - 1; last >= 0 && line[last].StartTime == n.StartTime {
+			if n.Pitch > line[last].Pitch {
+				line[last] = n
+			}
+		} else {
+			line = append(line, n)
+		}
+		byLine[key] = line
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	lines := make([][]MIDINote, len(keys))
+	for i, k := range keys {
+		lines[i] = byLine[k]
+	}
+	return lines
+}
+
+// pitchDegree maps a MIDI pitch to the nearest scale degree
+func pitchDegree(pitch, rootNote int, scaleNotes []int) int {
+	pc := ((pitch-rootNote)%12 + 12) % 12
+	best, bestDist := 0, 12
+	for d, interval := range scaleNotes {
+		dist := int(math.Abs(float64(pc - interval)))
+		dist = int(math.Min(float64(dist), float64(12-dist)))
+		if dist < bestDist {
+			best, bestDist = d, dist
+		}
+	}
+	return best
+}
+
+// ------------------------------------------------------------------
+// Blending
+// ------------------------------------------------------------------
+
+// MarkovBlend applies a trained model to a voice with mixing weight Mix
+// (0 = pure quantum interference, 1 = pure Markov chain)
+type MarkovBlend struct {
+	Model *MarkovModel
+	Mix   float64
+}
+
+// Apply mixes the model's transition row from prev into sv:
+// |a_i|² → (1-λ)|a_i|² + λ·T[prev][i], keeping each amplitude's phase
+func (b *MarkovBlend) Apply(sv *StateVector, prev int) {
+	if b == nil || b.Model == nil || prev < 0 || prev > 7 || len(sv.Amplitudes) != 8 {
+		return // Models are trained over the 8-state register only
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	probs := sv.probabilities()
+	row := b.Model.Transitions[prev]
+	for i, a := range sv.Amplitudes {
+		p := (1-b.Mix)*probs[i] + b.Mix*row[i]
+		phase := 0.0
+		if a != 0 {
+			phase = cmplx.Phase(a)
+		}
+		sv.Amplitudes[i] = cmplx.Rect(math.Sqrt(p), phase)
+	}
+	sv.Normalize()
+}
@@ -0,0 +1,374 @@
+// Standard MIDI File (SMF) import/export
+// Writes melodies, chord progressions and drum patterns as type-0 MIDI files and
+// multi-track scores as type-1 files; reads note events back from any SMF
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ticksPerBeat is the MIDI time division (pulses per quarter note)
+const ticksPerBeat = 480
+
+// midiEvent is a channel or meta event at an absolute tick
+type midiEvent struct {
+	Tick int
+	Data []byte
+}
+
+// midiTrack accumulates events and serializes them as an MTrk chunk
+type midiTrack struct {
+	events []midiEvent
+	bends  [16]float64 // Current pitch bend per channel in cents
+}
+
+func (t *midiTrack) noteOn(tick int, channel, pitch, velocity byte) {
+	t.events = append(t.events, midiEvent{Tick: tick, Data: []byte{0x90 | channel, pitch, velocity}})
+}
+
+func (t *midiTrack) noteOff(tick int, channel, pitch byte) {
+	t.events = append(t.events, midiEvent{Tick: tick, Data: []byte{0x80 | channel, pitch, 0}})
+}
+
+func (t *midiTrack) meta(tick int, kind byte, payload []byte) {
+	data := append([]byte{0xFF, kind}, encodeVarLen(len(payload))...)
+	t.events = append(t.events, midiEvent{Tick: tick, Data: append(data, payload...)})
+}
+
+func (t *midiTrack) programChange(channel, program byte) {
+	t.events = append(t.events, midiEvent{Tick: 0, Data: []byte{0xC0 | channel, program}})
+}
+
+// setTempo writes a Set Tempo meta event (microseconds per quarter note)
+func (t *midiTrack) setTempo(bpm float64) {
+	usPerBeat := int(60000000 / bpm)
+	t.meta(0, 0x51, []byte{byte(usPerBeat >> 16), byte(usPerBeat >> 8), byte(usPerBeat)})
+}
+
+// setTimeSignature writes beatsPerBar/4 with a quarter-note click
+func (t *midiTrack) setTimeSignature(beatsPerBar int) {
+	t.meta(0, 0x58, []byte{byte(beatsPerBar), 2, 24, 8})
+}
+
+// addNote schedules a note-on/note-off pair in beats
+func (t *midiTrack) addNote(channel byte, pitch int, startBeat, durationBeats, velocity float64) {
+	if pitch <= 0 || pitch > 127 || durationBeats <= 0 {
+		return // Rests and out-of-range pitches produce no events
+	}
+	start := beatsToTicks(startBeat)
+	end := beatsToTicks(startBeat + durationBeats)
+	t.noteOn(start, channel, byte(pitch), midiVelocity(velocity))
+	t.noteOff(end, channel, byte(pitch))
+}
+
+// addQuantumNote schedules a note at its articulated, humanized span, first
+// retuning the channel with a pitch bend when the note is detuned
+// (microtonal scales). Bends assume the GM default range of ±2 semitones.
+func (t *midiTrack) addQuantumNote(channel byte, n QuantumNote) {
+	start, duration := n.performedSpan()
+	if n.Pitch > 0 && n.Cents != t.bends[channel] {
+		value := 8192 + int(math.Round(n.Cents/200*8191))
+		t.events = append(t.events, midiEvent{
+			Tick: beatsToTicks(start),
+			Data: []byte{0xE0 | channel, byte(value & 0x7F), byte(value >> 7)},
+		})
+		t.bends[channel] = n.Cents
+	}
+	t.addNote(channel, n.Pitch, start, duration, n.Velocity)
+}
+
+// bytes returns the MTrk chunk. Events are ordered by tick with note-offs
+// before note-ons on the same tick so repeated pitches retrigger cleanly.
+func (t *midiTrack) bytes() []byte {
+	sort.SliceStable(t.events, func(i, j int) bool {
+		if t.events[i].Tick != t.events[j].Tick {
+			return t.events[i].Tick < t.events[j].Tick
+		}
+		return eventOrder(t.events[i]) < eventOrder(t.events[j])
+	})
+
+	var body bytes.Buffer
+	last := 0
+	for _, e := range t.events {
+		body.Write(encodeVarLen(e.Tick - last))
+		body.Write(e.Data)
+		last = e.Tick
+	}
+	// End of Track
+	body.Write([]byte{0x00, 0xFF, 0x2F, 0x00})
+
+	var chunk bytes.Buffer
+	chunk.WriteString("MTrk")
+	binary.Write(&chunk, binary.BigEndian, uint32(body.Len()))
+	chunk.Write(body.Bytes())
+	return chunk.Bytes()
+}
+
+func eventOrder(e midiEvent) int {
+	switch e.Data[0] & 0xF0 {
+	case 0xF0:
+		return 0 // Meta
+	case 0x80:
+		return 1 // Note off
+	default:
+		return 2
+	}
+}
+
+// encodeMIDIFile writes the MThd header followed by the given tracks
+func encodeMIDIFile(tracks ...*midiTrack) []byte {
+	format := uint16(0)
+	if len(tracks) > 1 {
+		format = 1
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("MThd")
+	binary.Write(&buf, binary.BigEndian, uint32(6))
+	binary.Write(&buf, binary.BigEndian, format)
+	binary.Write(&buf, binary.BigEndian, uint16(len(tracks)))
+	binary.Write(&buf, binary.BigEndian, uint16(ticksPerBeat))
+	for _, t := range tracks {
+		buf.Write(t.bytes())
+	}
+	return buf.Bytes()
+}
+
+// encodeVarLen encodes n as a MIDI variable-length quantity
+func encodeVarLen(n int) []byte {
+	if n < 0 {
+		n = 0
+	}
+	out := []byte{byte(n & 0x7F)}
+	for n >>= 7; n > 0; n >>= 7 {
+		out = append([]byte{byte(n&0x7F) | 0x80}, out...)
+	}
+	return out
+}
+
+func beatsToTicks(beats float64) int {
+	return int(math.Round(beats * ticksPerBeat))
+}
+
+func midiVelocity(v float64) byte {
+	return byte(math.Max(1, math.Min(127, math.Round(v*127))))
+}
+
+// ------------------------------------------------------------------
+// Melody / Chord Export
+// ------------------------------------------------------------------
+
+// MelodyToMIDI renders a quantum melody as a single-track MIDI file and
+// returns the file bytes and its length in beats
+func MelodyToMIDI(notes []QuantumNote, tempo float64) ([]byte, float64) {
+	track := &midiTrack{}
+	track.setTempo(tempo)
+	track.meta(0, 0x03, []byte("Quantum Melody"))
+
+	endBeat := 0.0
+	for _, n := range notes {
+		track.addQuantumNote(0, n)
+		endBeat = math.Max(endBeat, n.StartTime+n.Duration)
+	}
+	return encodeMIDIFile(track), endBeat
+}
+
+// ChordsToMIDI renders a chord progression, one chord after another
+func ChordsToMIDI(chords []Chord, tempo float64) ([]byte, float64) {
+	track := &midiTrack{}
+	track.setTempo(tempo)
+	track.meta(0, 0x03, []byte("Quantum Chords"))
+
+	beat := 0.0
+	for _, c := range chords {
+		for _, pitch := range c.Notes {
+			track.addNote(0, pitch, beat, c.Duration, 0.7)
+		}
+		beat += c.Duration
+	}
+	return encodeMIDIFile(track), beat
+}
+
+// RhythmToMIDI renders a drum pattern on the General MIDI percussion
+// channel (10)
+func RhythmToMIDI(pattern *RhythmPattern, tempo float64) ([]byte, float64) {
+	track := &midiTrack{}
+	track.setTempo(tempo)
+	track.setTimeSignature(pattern.BeatsPerBar)
+	track.meta(0, 0x03, []byte("Quantum Drums"))
+
+	for _, e := range pattern.Events {
+		track.addNote(9, gmDrumNotes[e.Instrument], e.Time, 1.0/stepsPerBeat, e.Velocity)
+	}
+	return encodeMIDIFile(track), float64(pattern.BeatsPerBar * pattern.Bars)
+}
+
+// ScoreToMIDI renders a score as a type-1 MIDI file: a conductor track
+// with tempo and meter, then one track per part on its own channel
+func ScoreToMIDI(score *Score) ([]byte, float64) {
+	conductor := &midiTrack{}
+	conductor.setTempo(score.Tempo)
+	conductor.setTimeSignature(score.BeatsPerBar)
+	conductor.meta(0, 0x03, []byte("Quantum Score"))
+
+	tracks := []*midiTrack{conductor}
+	endBeat := 0.0
+	for _, part := range score.Tracks {
+		track := &midiTrack{}
+		track.meta(0, 0x03, []byte(part.Name))
+		if part.Channel != 9 {
+			track.programChange(part.Channel, part.Program)
+		}
+		for _, n := range part.Notes {
+			track.addQuantumNote(part.Channel, n)
+			endBeat = math.Max(endBeat, n.StartTime+n.Duration)
+		}
+		tracks = append(tracks, track)
+	}
+	return encodeMIDIFile(tracks...), endBeat
+}
+
+// ------------------------------------------------------------------
+// MIDI Import
+// ------------------------------------------------------------------
+
+// MIDINote is a note read from a MIDI file, timed in beats
+type MIDINote struct {
+	Track     int
+	Channel   int
+	Pitch     int
+	Velocity  float64
+	StartTime float64
+	Duration  float64
+}
+
+var errTruncatedMIDI = errors.New("truncated MIDI data")
+
+// ReadMIDINotes parses a Standard MIDI File (format 0 or 1) and returns its
+// notes ordered by start time. SMPTE time divisions are not supported.
+func ReadMIDINotes(data []byte) ([]MIDINote, error) {
+	if len(data) < 14 || string(data[:4]) != "MThd" {
+		return nil, errors.New("not a Standard MIDI File (missing MThd)")
+	}
+	headerLen := int(binary.BigEndian.Uint32(data[4:8]))
+	if headerLen < 6 || len(data) < 8+headerLen {
+		return nil, errTruncatedMIDI
+	}
+	numTracks := int(binary.BigEndian.Uint16(data[10:12]))
+	division := int(binary.BigEndian.Uint16(data[12:14]))
+	if division&0x8000 != 0 || division == 0 {
+		return nil, errors.New("SMPTE time division is not supported")
+	}
+
+	var notes []MIDINote
+	pos := 8 + headerLen
+	for track := 0; track < numTracks && pos+8 <= len(data); track++ {
+		chunkLen := int(binary.BigEndian.Uint32(data[pos+4 : pos+8]))
+		start, end := pos+8, pos+8+chunkLen
+		if end > len(data) {
+			return nil, errTruncatedMIDI
+		}
+		if string(data[pos:pos+4]) == "MTrk" {
+			trackNotes, err := readTrackNotes(data[start:end], track, division)
+			if err != nil {
+				return nil, fmt.Errorf("track %d: %w", track, err)
+			}
+			notes = append(notes, trackNotes...)
+		}
+		pos = end
+	}
+
+	sort.SliceStable(notes, func(i, j int) bool {
+		return notes[i].StartTime < notes[j].StartTime
+	})
+	return notes, nil
+}
+
+// readTrackNotes walks one MTrk chunk, pairing note-ons with note-offs
+func readTrackNotes(data []byte, track, division int) ([]MIDINote, error) {
+	var notes []MIDINote
+	open := make(map[[2]int]int) // (channel, pitch) -> index into notes
+	tick, pos := 0, 0
+	var running byte
+
+	for pos < len(data) {
+		delta, n := decodeVarLen(data[pos:])
+		if n == 0 {
+			return nil, errTruncatedMIDI
+		}
+		pos += n
+		tick += delta
+		if pos >= len(data) {
+			return nil, errTruncatedMIDI
+		}
+
+		statusByte := data[pos]
+		switch {
+		case statusByte == 0xFF: // Meta: FF type len data
+			if pos+2 > len(data) {
+				return nil, errTruncatedMIDI
+			}
+			length, n := decodeVarLen(data[pos+2:])
+			pos += 2 + n + length
+			continue
+		case statusByte == 0xF0 || statusByte == 0xF7: // SysEx: F0 len data
+			length, n := decodeVarLen(data[pos+1:])
+			pos += 1 + n + length
+			continue
+		case statusByte&0x80 != 0:
+			running = statusByte
+			pos++
+		case running == 0:
+			return nil, errors.New("data byte without status")
+		}
+
+		kind, channel := running&0xF0, int(running&0x0F)
+		size := 2
+		if kind == 0xC0 || kind == 0xD0 {
+			size = 1
+		}
+		if pos+size > len(data) {
+			return nil, errTruncatedMIDI
+		}
+		args := data[pos : pos+size]
+		pos += size
+
+		beat := float64(tick) / float64(division)
+		key := [2]int{channel, int(args[0])}
+		if kind == 0x80 || (kind == 0x90 && args[1] == 0) {
+			if i, ok := open[key]; ok {
+				notes[i].Duration = beat - notes[i].StartTime
+				delete(open, key)
+			}
+		} else if kind == 0x90 {
+			open[key] = len(notes)
+			notes = append(notes, MIDINote{
+				Track:     track,
+				Channel:   channel,
+				Pitch:     int(args[0]),
+				Velocity:  float64(args[1]) / 127,
+				StartTime: beat,
+			})
+		}
+	}
+	return notes, nil
+}
+
+// decodeVarLen reads a variable-length quantity, returning the value and
+// the bytes consumed (0 if truncated)
+func decodeVarLen(data []byte) (int, int) {
+	value := 0
+	for i := 0; i < len(data) && i < 4; i++ {
+		value = value<<7 | int(data[i]&0x7F)
+		if data[i]&0x80 == 0 {
+			return value, i + 1
+		}
+	}
+	return 0, 0
+}
@@ -0,0 +1,254 @@
+// Motif Search via Amplitude Amplification
+// A target contour in Parsons code (U = up, D = down, R = repeat, * = any)
+// is an oracle over each note's register: the basis states that continue the
+// contour from the previous pitch are marked, and Grover iterations rotate
+// the voice's interference-biased state toward them before the engine
+// measures it. A marked mass p needs only ~π/(4·asin√p) iterations to make
+// a match near-certain.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/cmplx"
+	"strings"
+)
+
+// Parsons code steps
+const (
+	ContourUp     = 'U'
+	ContourDown   = 'D'
+	ContourRepeat = 'R'
+	ContourAny    = '*'
+)
+
+// ParseContour normalizes a Parsons code string into its steps. A leading
+// '*' (the conventional marker for the first note) is dropped, so "*UUD"
+// and "UUD" both describe a four-note motif.
+func ParseContour(code string) ([]byte, error) {
+	code = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(code)), string(ContourAny))
+	if code == "" {
+		return nil, fmt.Errorf("contour needs at least one step")
+	}
+	steps := []byte(code)
+	for i, step := range steps {
+		switch step {
+		case ContourUp, ContourDown, ContourRepeat, ContourAny:
+		default:
+			return nil, fmt.Errorf("invalid contour step %q at position %d (use U, D, R or *)", step, i+1)
+		}
+	}
+	return steps, nil
+}
+
+// ------------------------------------------------------------------
+// Contour Oracle
+// ------------------------------------------------------------------
+
+// ContourOracle steers a voice along Steps, one step per note after the
+// first, and records the amplification applied at each step. Each degree is
+// voiced in the octave nearest the previous note, so every direction stays
+// reachable from any pitch.
+type ContourOracle struct {
+	Steps   []byte
+	Results []MotifStep
+
+	note      int
+	reference float64 // Height of the last sounding note in cents
+	sounded   bool
+}
+
+// Apply marks the states that continue the contour from the last sounding
+// note and amplifies them in sv
+func (o *ContourOracle) Apply(sv *StateVector, spec *ScaleSpec, rootNote int) {
+	if o == nil || o.note == 0 || o.note > len(o.Steps) {
+		return // The first note is free
+	}
+
+	step := o.Steps[o.note-1]
+	marked := func(k int) bool {
+		if spec.Degree(k) < 0 {
+			return step == ContourAny
+		}
+		return o.matches(step, o.voiced(pitchHeight(spec, rootNote, k)))
+	}
+
+	prior := markedProbability(sv, marked)
+	iterations := groverIterations(prior)
+	sv.AmplifyAmplitudes(marked, iterations)
+	amplified := markedProbability(sv, marked)
+
+	o.Results = append(o.Results, MotifStep{
+		Direction:            step,
+		PriorProbability:     prior,
+		Iterations:           iterations,
+		AmplifiedProbability: amplified,
+	})
+	log.Printf("  🔍 Contour %c: p=%.2f → %.2f after %d Grover iteration(s)",
+		step, prior, amplified, iterations)
+}
+
+// Place moves a measured note into its voiced octave and scores it against
+// the step it was amplified for. Rests pass through unchanged.
+func (o *ContourOracle) Place(spec *ScaleSpec, rootNote, outcome, pitch int, frequency float64) (int, float64) {
+	if o == nil {
+		return pitch, frequency
+	}
+	defer func() { o.note++ }()
+
+	var step byte = ContourAny
+	if o.note > 0 && o.note <= len(o.Results) {
+		step = o.Results[o.note-1].Direction
+	}
+	if spec.Degree(outcome) < 0 {
+		if o.note > 0 && o.note <= len(o.Results) {
+			o.Results[o.note-1].Matched = step == ContourAny
+		}
+		return pitch, frequency
+	}
+
+	height := pitchHeight(spec, rootNote, outcome)
+	voiced := o.voiced(height)
+	if o.note > 0 && o.note <= len(o.Results) {
+		o.Results[o.note-1].Matched = o.matches(step, voiced)
+	}
+	o.reference, o.sounded = voiced, true
+
+	octaves := math.Round((voiced - height) / 1200)
+	return pitch + 12*int(octaves), frequency * math.Pow(2, octaves)
+}
+
+// voiced shifts a height by whole octaves to the one nearest the reference,
+// staying within the MIDI range
+func (o *ContourOracle) voiced(height float64) float64 {
+	if !o.sounded {
+		return height
+	}
+	octaves := math.Round((o.reference - height) / 1200)
+	voiced := height + 1200*octaves
+	for voiced > 12000 {
+		voiced -= 1200
+	}
+	for voiced < 2400 {
+		voiced += 1200
+	}
+	return voiced
+}
+
+// matches reports whether a voiced height follows the step. Before the
+// first sounding note every direction matches.
+func (o *ContourOracle) matches(step byte, height float64) bool {
+	if step == ContourAny || !o.sounded {
+		return true
+	}
+	switch step {
+	case ContourUp:
+		return height > o.reference
+	case ContourDown:
+		return height < o.reference
+	default:
+		return height == o.reference
+	}
+}
+
+// pitchHeight is a sounding state's pitch in cents above MIDI note 0
+func pitchHeight(spec *ScaleSpec, rootNote, k int) float64 {
+	pitch, cents, _ := spec.Pitch(rootNote, k)
+	return float64(pitch)*100 + cents
+}
+
+func markedProbability(sv *StateVector, marked func(int) bool) float64 {
+	total := 0.0
+	for k, p := range sv.Probabilities() {
+		if marked(k) {
+			total += p
+		}
+	}
+	return total
+}
+
+// groverIterations is the number of amplification rounds that brings a
+// marked mass p closest to 1: sin²((2k+1)·θ) with sin²θ = p
+func groverIterations(p float64) int {
+	if p <= 0 || p >= 1 {
+		return 0
+	}
+	theta := math.Asin(math.Sqrt(p))
+	return int(math.Max(0, math.Round(math.Pi/(4*theta)-0.5)))
+}
+
+// AmplifyAmplitudes runs Grover iterations about the current state |ψ⟩:
+// the oracle flips the sign of marked amplitudes, then the diffusion step
+// reflects about |ψ⟩ (a → 2⟨ψ|a⟩ψ − a)
+func (sv *StateVector) AmplifyAmplitudes(marked func(int) bool, iterations int) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	initial := append([]complex128(nil), sv.Amplitudes...)
+	for it := 0; it < iterations; it++ {
+		for k := range sv.Amplitudes {
+			if marked(k) {
+				sv.Amplitudes[k] = -sv.Amplitudes[k]
+			}
+		}
+
+		var overlap complex128
+		for k, a := range sv.Amplitudes {
+			overlap += cmplx.Conj(initial[k]) * a
+		}
+		for k := range sv.Amplitudes {
+			sv.Amplitudes[k] = 2*overlap*initial[k] - sv.Amplitudes[k]
+		}
+	}
+	sv.Normalize()
+}
+
+// ------------------------------------------------------------------
+// Motif Search
+// ------------------------------------------------------------------
+
+// FindContourMotif generates a len(steps)+1 note motif on a fresh voice,
+// amplifying contour matches before every measurement
+func (s *MusicServer) FindContourMotif(scale string, rootNote int, steps []byte, seed uint64) *Motif {
+	v := NewVoice()
+	v.contour = &ContourOracle{Steps: steps}
+	if seed != 0 {
+		v.engine = s.engineClient.WithSeed(seed)
+	}
+
+	log.Printf("🔍 Searching for motif with contour *%s...", steps)
+	notes, _ := s.playVoice(v, scale, rootNote, len(steps)+1, melodyDurations, nil)
+
+	motif := &Motif{Notes: notes, Contour: "*" + string(steps), Steps: v.contour.Results}
+	matched := 0
+	for _, step := range motif.Steps {
+		if step.Matched {
+			matched++
+		}
+	}
+	motif.MatchRate = float64(matched) / float64(len(steps))
+
+	log.Printf("🔍 Motif found: %d/%d contour steps matched", matched, len(steps))
+	return motif
+}
+
+// ------------------------------------------------------------------
+// Types
+// ------------------------------------------------------------------
+
+type MotifStep struct {
+	Direction            byte    // U, D, R or *
+	PriorProbability     float64 // Marked mass before amplification
+	Iterations           int     // Grover iterations applied
+	AmplifiedProbability float64 // Marked mass handed to the measurement
+	Matched              bool    // Whether the measured note followed the step
+}
+
+type Motif struct {
+	Notes     []QuantumNote
+	Contour   string // Normalized Parsons code
+	Steps     []MotifStep
+	MatchRate float64 // Fraction of steps the motif follows
+}
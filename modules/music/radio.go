@@ -0,0 +1,190 @@
+// Quantum Radio - endless WebSocket stream of notes and audio
+// Each listener gets its own voice that keeps composing until they hang
+// up. Every note is sent as a JSON text frame (the gRPC QuantumNote shape)
+// followed by a binary frame of 16-bit PCM synthesized for that note, paced
+// at the requested tempo so browsers can play it as it arrives.
+//
+//	ws://host:8062/radio?scale=minor&root=57&tempo=96&seed=42
+
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	radioSampleRate = 22050
+	radioPhrase     = 8 // Notes composed per playVoice call
+	radioWriteWait  = 5 * time.Second
+)
+
+var radioUpgrader = websocket.Upgrader{
+	// The radio is read-only and unauthenticated, so any page may tune in
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// radioFrame is the JSON envelope for text frames
+type radioFrame struct {
+	Type       string          `json:"type"` // "format" or "note"
+	SampleRate int             `json:"sampleRate,omitempty"`
+	Encoding   string          `json:"encoding,omitempty"`
+	Channels   int             `json:"channels,omitempty"`
+	Scale      string          `json:"scale,omitempty"`
+	Tempo      float64         `json:"tempo,omitempty"`
+	Note       json.RawMessage `json:"note,omitempty"`
+}
+
+// ServeRadio upgrades the request and streams until the client disconnects
+func (s *MusicServer) ServeRadio(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	scale := query.Get("scale")
+	if scale == "" {
+		scale = "major"
+	}
+	if _, ok := lookupScale(scale); !ok {
+		http.Error(w, "unknown scale "+strconv.Quote(scale), http.StatusBadRequest)
+		return
+	}
+	rootNote, _ := strconv.Atoi(query.Get("root"))
+	if rootNote <= 0 || rootNote > 127 {
+		rootNote = 60 // C4
+	}
+	tempo, _ := strconv.ParseFloat(query.Get("tempo"), 64)
+	if tempo <= 0 || tempo > 400 {
+		tempo = 120
+	}
+	seed, _ := strconv.ParseUint(query.Get("seed"), 10, 64)
+
+	conn, err := radioUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade already replied with an HTTP error
+	}
+	defer conn.Close()
+
+	// Drain client frames so close messages are noticed
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	log.Printf("📻 Listener %s tuned in (%s @ %.0f BPM)", r.RemoteAddr, scale, tempo)
+	err = s.broadcastRadio(conn, done, scale, rootNote, tempo, seed)
+	log.Printf("📻 Listener %s left: %v", r.RemoteAddr, err)
+}
+
+// broadcastRadio composes phrase after phrase on one voice, sending each
+// note at its scheduled time
+func (s *MusicServer) broadcastRadio(conn *websocket.Conn, done <-chan struct{},
+	scale string, rootNote int, tempo float64, seed uint64) error {
+	if err := writeRadioJSON(conn, radioFrame{
+		Type:       "format",
+		SampleRate: radioSampleRate,
+		Encoding:   "pcm_s16le",
+		Channels:   1,
+		Scale:      scale,
+		Tempo:      tempo,
+	}); err != nil {
+		return err
+	}
+
+	v := NewVoice()
+	if seed != 0 {
+		v.engine = s.engineClient.WithSeed(seed)
+	}
+	beat := time.Duration(float64(time.Minute) / tempo)
+	next := time.Now()
+
+	for {
+		notes, _ := s.playVoice(v, scale, rootNote, radioPhrase, melodyDurations, nil)
+		for _, n := range notes {
+			note, err := protojson.Marshal(notesToProto([]QuantumNote{n})[0])
+			if err != nil {
+				return err
+			}
+			if err := writeRadioJSON(conn, radioFrame{Type: "note", Note: note}); err != nil {
+				return err
+			}
+			if err := writeRadio(conn, websocket.BinaryMessage, synthesizeNote(n, tempo)); err != nil {
+				return err
+			}
+
+			// Send the next note when this one has played
+			next = next.Add(time.Duration(n.Duration * float64(beat)))
+			select {
+			case <-time.After(time.Until(next)):
+			case <-done:
+				return websocket.ErrCloseSent
+			}
+		}
+	}
+}
+
+func writeRadioJSON(conn *websocket.Conn, frame radioFrame) error {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	return writeRadio(conn, websocket.TextMessage, data)
+}
+
+func writeRadio(conn *websocket.Conn, kind int, data []byte) error {
+	conn.SetWriteDeadline(time.Now().Add(radioWriteWait))
+	return conn.WriteMessage(kind, data)
+}
+
+// ------------------------------------------------------------------
+// Synthesis
+// ------------------------------------------------------------------
+
+// synthesizeNote renders one note's full duration (articulated part
+// sounding, the rest silent) as mono 16-bit little-endian PCM: a sine with
+// two soft overtones under a short attack and exponential release.
+// Rests render as silence so the stream stays continuous.
+func synthesizeNote(n QuantumNote, tempo float64) []byte {
+	seconds := n.Duration * 60 / tempo
+	total := int(seconds * radioSampleRate)
+	pcm := make([]byte, 2*total)
+	if n.Pitch <= 0 {
+		return pcm
+	}
+	// From the pitch rather than Frequency, which built-in scales take from
+	// the C major table
+	frequency := 440 * math.Pow(2, (float64(n.Pitch)-69)/12+n.Cents/1200)
+
+	_, gate := n.performedSpan()
+	sounding := int(gate * 60 / tempo * radioSampleRate)
+	attack := radioSampleRate / 100 // 10 ms
+	release := radioSampleRate / 20 // 50 ms
+	amplitude := 0.3 * n.Velocity
+
+	for i := 0; i < total && i < sounding+release; i++ {
+		t := float64(i) / radioSampleRate
+		env := 1.0
+		if i < attack {
+			env = float64(i) / float64(attack)
+		}
+		if i >= sounding {
+			env *= math.Exp(-5 * float64(i-sounding) / float64(release))
+		}
+
+		phase := 2 * math.Pi * frequency * t
+		sample := math.Sin(phase) + 0.3*math.Sin(2*phase) + 0.1*math.Sin(3*phase)
+		value := int16(math.Max(-1, math.Min(1, amplitude*env*sample/1.4)) * math.MaxInt16)
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(value))
+	}
+	return pcm
+}
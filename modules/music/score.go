@@ -0,0 +1,160 @@
+// Multi-track Composition - a full band from independent quantum voices
+// Every pitched track collapses its own state vector; all tracks share the
+// key, tempo and bar grid so they line up in the exported MIDI score.
+
+package main
+
+import (
+	"log"
+	"math"
+)
+
+// ------------------------------------------------------------------
+// Track Roles
+// ------------------------------------------------------------------
+
+// Track roles match TrackRole in music.proto
+const (
+	TrackMelody        = 0
+	TrackCounterMelody = 1
+	TrackBass          = 2
+	TrackPercussion    = 3
+	TrackHarmony       = 4
+)
+
+// trackSpec describes how a role is voiced
+type trackSpec struct {
+	Name       string
+	Octave     int       // Offset from the root in octaves
+	Durations  []float64 // Note lengths picked by the duration measurement
+	Channel    byte      // MIDI channel (0-based)
+	Program    byte      // General MIDI program
+	Velocity   float64   // Velocity scale
+	Percussion bool
+}
+
+var trackSpecs = map[int]trackSpec{
+	TrackMelody:        {Name: "Melody", Octave: 0, Durations: melodyDurations, Channel: 0, Program: 0, Velocity: 1.0},
+	TrackCounterMelody: {Name: "Counter-melody", Octave: 1, Durations: []float64{0.5, 1.0, 1.5, 2.0, 3.0}, Channel: 1, Program: 73, Velocity: 0.75},
+	TrackBass:          {Name: "Bass", Octave: -2, Durations: []float64{1.0, 1.0, 2.0, 2.0, 4.0}, Channel: 2, Program: 33, Velocity: 0.9},
+	TrackPercussion:    {Name: "Drums", Channel: 9, Velocity: 1.0, Percussion: true},
+	TrackHarmony:       {Name: "Harmony", Octave: -1, Durations: []float64{2.0, 4.0}, Channel: 3, Program: 4, Velocity: 0.8},
+}
+
+// General MIDI drum map for the rhythm engine's instruments
+var gmDrumNotes = map[int]int{
+	InstrumentKick:  36, // Bass Drum 1
+	InstrumentSnare: 38, // Acoustic Snare
+	InstrumentHiHat: 42, // Closed Hi-Hat
+}
+
+// ------------------------------------------------------------------
+// Score Generation
+// ------------------------------------------------------------------
+
+// ComposeScoreTracks generates one synchronized track per role. Pitched
+// tracks each get a fresh Voice; percussion comes from the quantum-walk groove.
+func (s *MusicServer) ComposeScoreTracks(scale string, rootNote int, tempo float64,
+	beatsPerBar, numBars int, roles []int, style string) *Score {
+	if beatsPerBar <= 0 {
+		beatsPerBar = 4
+	}
+	if numBars <= 0 {
+		numBars = 4
+	}
+	if len(roles) == 0 {
+		roles = []int{TrackMelody, TrackCounterMelody, TrackBass, TrackPercussion}
+	}
+	totalBeats := float64(beatsPerBar * numBars)
+
+	score := &Score{
+		Scale:       scale,
+		RootNote:    rootNote,
+		Tempo:       tempo,
+		BeatsPerBar: beatsPerBar,
+		Bars:        numBars,
+	}
+
+	log.Printf("🎼 Composing %d-track QUANTUM score (%d bars of %d/4 @ %.0f BPM)...",
+		len(roles), numBars, beatsPerBar, tempo)
+
+	for _, role := range roles {
+		spec, ok := trackSpecs[role]
+		if !ok {
+			continue
+		}
+		track := &Track{
+			Name:    spec.Name,
+			Role:    role,
+			Channel: spec.Channel,
+			Program: spec.Program,
+		}
+
+		if spec.Percussion {
+			rhythm := s.GenerateQuantumRhythm(beatsPerBar, numBars, style, 0, 0.5)
+			for _, e := range rhythm.Events {
+				track.Notes = append(track.Notes, QuantumNote{
+					Pitch:     gmDrumNotes[e.Instrument],
+					NoteName:  instrumentNames[e.Instrument],
+					Duration:  1.0 / stepsPerBeat,
+					Velocity:  e.Velocity * spec.Velocity,
+					StartTime: e.Time,
+				})
+			}
+		} else {
+			shortest := spec.Durations[0]
+			for _, d := range spec.Durations {
+				shortest = math.Min(shortest, d)
+			}
+			numNotes := int(math.Ceil(totalBeats / shortest))
+
+			notes, _ := s.playVoice(NewVoice(), scale, rootNote+12*spec.Octave, numNotes, spec.Durations, nil)
+			for i := range notes {
+				notes[i].Velocity *= spec.Velocity
+			}
+			track.Notes = fitToBeats(notes, totalBeats)
+		}
+
+		log.Printf("  🎻 %s: %d notes on channel %d", track.Name, len(track.Notes), track.Channel+1)
+		score.Tracks = append(score.Tracks, track)
+	}
+
+	return score
+}
+
+// fitToBeats drops notes starting after the form ends and trims the last
+// one so every track finishes on the same beat
+func fitToBeats(notes []QuantumNote, totalBeats float64) []QuantumNote {
+	out := make([]QuantumNote, 0, len(notes))
+	for _, n := range notes {
+		if n.StartTime >= totalBeats {
+			break
+		}
+		if n.StartTime+n.Duration > totalBeats {
+			n.Duration = totalBeats - n.StartTime
+		}
+		out = append(out, n)
+	}
+	return out
+}
+
+// ------------------------------------------------------------------
+// Types
+// ------------------------------------------------------------------
+
+type Track struct {
+	Name    string
+	Role    int  // TrackMelody, TrackCounterMelody, ...
+	Channel byte // MIDI channel (0-based, 9 = drums)
+	Program byte // General MIDI program
+	Notes   []QuantumNote
+}
+
+type Score struct {
+	Tracks      []*Track
+	Scale       string
+	RootNote    int
+	Tempo       float64
+	BeatsPerBar int
+	Bars        int
+}
@@ -0,0 +1,214 @@
+// Custom Scales & Microtonal Tunings
+// Any scale is a list of degree offsets in cents above the root. A scale
+// with N degrees is played on k = ⌈log2(N+1)⌉ qubits: basis states below N
+// are degrees, the top state |2^k − 1⟩ is a rest, and the rest wrap.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+// maxScaleQubits bounds custom scales to 127 degrees (+ rest)
+const maxScaleQubits = 7
+
+// Intervals within this many cents of a just third, fourth, fifth or sixth
+// count as consonant when deriving interference rules for custom scales
+const consonanceTolerance = 35.0
+
+var consonantCents = []float64{316, 386, 498, 702, 814, 884}
+
+// ScaleSpec is a playable scale: degree offsets plus how they map onto a
+// k-qubit register
+type ScaleSpec struct {
+	Name    string
+	Tuning  string    // "12-TET", "19-TET", "just", "cents"
+	Cents   []float64 // Degree offsets from the root, ascending
+	Qubits  int
+	Builtin bool // Uses the classic consonantFollowers / noteFrequencies tables
+}
+
+// States is the dimension of the scale's state vector
+func (sc *ScaleSpec) States() int {
+	return 1 << sc.Qubits
+}
+
+// Rest is the basis state reserved for silence
+func (sc *ScaleSpec) Rest() int {
+	return sc.States() - 1
+}
+
+// Degree maps a measured basis state to a scale degree (-1 = rest)
+func (sc *ScaleSpec) Degree(outcome int) int {
+	if outcome == sc.Rest() {
+		return -1
+	}
+	return outcome % len(sc.Cents)
+}
+
+// Pitch returns the nearest MIDI pitch, the remaining detune in cents and
+// the exact frequency for a measured basis state (0, 0, 0 for a rest)
+func (sc *ScaleSpec) Pitch(rootNote, outcome int) (int, float64, float64) {
+	degree := sc.Degree(outcome)
+	if degree < 0 {
+		return 0, 0, 0
+	}
+	cents := sc.Cents[degree]
+	pitch := rootNote + int(math.Round(cents/100))
+	detune := cents - 100*math.Round(cents/100)
+	freq := 440 * math.Pow(2, (float64(rootNote)-69)/12+cents/1200)
+	return pitch, detune, freq
+}
+
+// DegreeName labels a basis state for display
+func (sc *ScaleSpec) DegreeName(outcome int) string {
+	if sc.Builtin {
+		return noteNames[outcome%len(noteNames)]
+	}
+	degree := sc.Degree(outcome)
+	if degree < 0 {
+		return "REST"
+	}
+	return fmt.Sprintf("%s[%d]", sc.Name, degree+1)
+}
+
+// Followers lists the basis states favoured after last. Built-in scales use
+// the consonantFollowers table; custom scales favour degrees a near-just
+// consonance away from the previous one.
+func (sc *ScaleSpec) Followers(last int) []int {
+	if sc.Builtin {
+		return consonantFollowers[last%7]
+	}
+	from := sc.Degree(last)
+	if from < 0 {
+		return nil
+	}
+
+	var followers []int
+	for to, cents := range sc.Cents {
+		interval := math.Mod(math.Abs(cents-sc.Cents[from]), 1200)
+		for _, c := range consonantCents {
+			if math.Abs(interval-c) <= consonanceTolerance || math.Abs(1200-interval-c) <= consonanceTolerance {
+				followers = append(followers, to)
+				break
+			}
+		}
+	}
+	return followers
+}
+
+// ------------------------------------------------------------------
+// Scale Registry
+// ------------------------------------------------------------------
+
+var (
+	customScales   = make(map[string]*ScaleSpec)
+	customScalesMu sync.RWMutex
+)
+
+// lookupScale finds a built-in or registered scale
+func lookupScale(name string) (*ScaleSpec, bool) {
+	if intervals, ok := scales[name]; ok {
+		cents := make([]float64, len(intervals))
+		for i, semitones := range intervals {
+			cents[i] = float64(semitones * 100)
+		}
+		return &ScaleSpec{Name: name, Tuning: "12-TET", Cents: cents, Qubits: 3, Builtin: true}, true
+	}
+
+	customScalesMu.RLock()
+	defer customScalesMu.RUnlock()
+	spec, ok := customScales[name]
+	return spec, ok
+}
+
+// listScales returns built-in scales followed by registered ones, by name
+func listScales() []*ScaleSpec {
+	var names []string
+	for name := range scales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out []*ScaleSpec
+	for _, name := range names {
+		spec, _ := lookupScale(name)
+		out = append(out, spec)
+	}
+
+	customScalesMu.RLock()
+	defer customScalesMu.RUnlock()
+	names = names[:0]
+	for name := range customScales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out = append(out, customScales[name])
+	}
+	return out
+}
+
+// RegisterScale validates a custom scale, sizes its register and stores it
+func RegisterScale(name, tuning string, cents []float64) (*ScaleSpec, error) {
+	if name == "" {
+		return nil, errors.New("scale name is required")
+	}
+	if _, ok := scales[name]; ok {
+		return nil, fmt.Errorf("%q is a built-in scale", name)
+	}
+	if len(cents) == 0 {
+		return nil, errors.New("scale needs at least one degree")
+	}
+
+	sorted := append([]float64(nil), cents...)
+	sort.Float64s(sorted)
+	for _, c := range sorted {
+		if c < 0 || math.IsNaN(c) || math.IsInf(c, 0) {
+			return nil, fmt.Errorf("degree offset %v cents is out of range", c)
+		}
+	}
+
+	qubits := int(math.Ceil(math.Log2(float64(len(sorted) + 1))))
+	if qubits > maxScaleQubits {
+		return nil, fmt.Errorf("scale has %d degrees, at most %d are supported",
+			len(sorted), 1<<maxScaleQubits-1)
+	}
+
+	spec := &ScaleSpec{Name: name, Tuning: tuning, Cents: sorted, Qubits: qubits}
+	customScalesMu.Lock()
+	customScales[name] = spec
+	customScalesMu.Unlock()
+	return spec, nil
+}
+
+// EDOCents returns the cents of the given steps of an n-tone equal division
+// of the octave (all n steps when steps is empty)
+func EDOCents(n int, steps []int) ([]float64, error) {
+	if n <= 0 {
+		return nil, errors.New("edo must be positive")
+	}
+	if len(steps) == 0 {
+		steps = make([]int, n)
+		for i := range steps {
+			steps[i] = i
+		}
+	}
+	cents := make([]float64, len(steps))
+	for i, step := range steps {
+		cents[i] = 1200 * float64(step) / float64(n)
+	}
+	return cents, nil
+}
+
+// RatioCents converts a just-intonation ratio to cents
+func RatioCents(numerator, denominator int) (float64, error) {
+	if numerator <= 0 || denominator <= 0 {
+		return 0, fmt.Errorf("invalid ratio %d/%d", numerator, denominator)
+	}
+	return 1200 * math.Log2(float64(numerator)/float64(denominator)), nil
+}
@@ -0,0 +1,71 @@
+// WAV export
+// Renders melodies offline with the radio's voice (see synthesizeNote) as
+// mono 16-bit PCM WAV files, each note mixed in at its performed start so
+// humanized timing and overlapping release tails are heard as played.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+)
+
+// maxWAVSeconds keeps exports well inside gRPC's default message size
+const maxWAVSeconds = 90
+
+// wavSeconds is how long notes last at tempo, release tails included
+func wavSeconds(notes []QuantumNote, tempo float64) float64 {
+	end := 0.0
+	for _, n := range notes {
+		start, _ := n.performedSpan()
+		end = math.Max(end, start+n.Duration)
+	}
+	return end*60/tempo + 0.05
+}
+
+// MelodyToWAV renders notes as a WAV file and returns it with its length
+// in seconds
+func MelodyToWAV(notes []QuantumNote, tempo float64) ([]byte, float64) {
+	seconds := wavSeconds(notes, tempo)
+	mix := make([]float64, int(seconds*radioSampleRate))
+	for _, n := range notes {
+		start, _ := n.performedSpan()
+		offset := int(start * 60 / tempo * radioSampleRate)
+		pcm := synthesizeNote(n, tempo)
+		for i := 0; i+1 < len(pcm) && offset+i/2 < len(mix); i += 2 {
+			mix[offset+i/2] += float64(int16(binary.LittleEndian.Uint16(pcm[i:])))
+		}
+	}
+
+	data := make([]byte, 2*len(mix))
+	for i, v := range mix {
+		v = math.Max(math.MinInt16, math.Min(math.MaxInt16, v))
+		binary.LittleEndian.PutUint16(data[2*i:], uint16(int16(v)))
+	}
+	return encodeWAV(data, radioSampleRate), seconds
+}
+
+// encodeWAV wraps mono 16-bit little-endian PCM in a RIFF/WAVE header
+func encodeWAV(pcm []byte, sampleRate int) []byte {
+	const channels, bitsPerSample = 1, 16
+	blockAlign := channels * bitsPerSample / 8
+
+	var buf bytes.Buffer
+	le := func(v any) { binary.Write(&buf, binary.LittleEndian, v) }
+	buf.WriteString("RIFF")
+	le(uint32(36 + len(pcm)))
+	buf.WriteString("WAVE")
+	buf.WriteString("fmt ")
+	le(uint32(16))
+	le(uint16(1)) // PCM
+	le(uint16(channels))
+	le(uint32(sampleRate))
+	le(uint32(sampleRate * blockAlign))
+	le(uint16(blockAlign))
+	le(uint16(bitsPerSample))
+	buf.WriteString("data")
+	le(uint32(len(pcm)))
+	buf.Write(pcm)
+	return buf.Bytes()
+}
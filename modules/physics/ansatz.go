@@ -0,0 +1,531 @@
+// Ansatz Circuits & State-Vector Simulation
+// VQE energies are computed by actually preparing the ansatz state and
+// measuring every Pauli term of the Hamiltonian. Circuits run on an
+// in-process state-vector simulator (the chemistry register sizes here are
+// far below where a round trip to the engine would pay off).
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"math/rand"
+	"sort"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+// ------------------------------------------------------------------
+// Circuit Representation
+// ------------------------------------------------------------------
+
+type GateKind int
+
+const (
+	GateX          GateKind = iota // Fixed bit flip (reference state)
+	GateRY                         // RY(θ) on Qubits[0]
+	GateRZ                         // RZ(θ) on Qubits[0]
+	GateCNOT                       // Qubits[0] controls Qubits[1]
+	GateExcitation                 // exp(θ(T − T†)), T = a†_a a_i or a†_a a†_b a_j a_i
+	GateH                          // Hadamard (Pauli basis change)
+	GateRX                         // RX(θ) on Qubits[0]
+)
+
+// AnsatzGate is one gate of a parameterized circuit. Param indexes the
+// parameter vector (-1 for fixed gates); rotations turn by Scale·θ, or by
+// Scale itself when fixed. Excitations list the occupied modes then the
+// virtual ones: [i, a] or [i, j, a, b].
+type AnsatzGate struct {
+	Kind   GateKind
+	Qubits []int
+	Param  int
+	Scale  float64
+}
+
+// AnsatzCircuit prepares the trial state from |0…0⟩
+type AnsatzCircuit struct {
+	NumQubits int
+	NumParams int
+	Reference int // Basis state the ansatz starts from (Hartree-Fock guess)
+	Gates     []AnsatzGate
+}
+
+// BuildAnsatz constructs the circuit for a Hamiltonian. Every ansatz starts
+// from the reference determinant - the basis state with the lowest diagonal
+// energy, which is the Hartree-Fock state for Jordan-Wigner Hamiltonians.
+//
+//	UCCSD               spin-conserving single and double excitations
+//	                    out of the reference
+//	Hardware-efficient  RY·RZ on every qubit, a CNOT ladder, then RY
+//	RY                  RY on every qubit followed by a CNOT ladder
+func BuildAnsatz(h *pb.Hamiltonian, ansatz pb.AnsatzType) *AnsatzCircuit {
+	n := int(h.NumQubits)
+	c := &AnsatzCircuit{NumQubits: n, Reference: referenceState(h)}
+	for q := 0; q < n; q++ {
+		if c.Reference&(1<<q) != 0 {
+			c.Gates = append(c.Gates, AnsatzGate{Kind: GateX, Qubits: []int{q}, Param: -1})
+		}
+	}
+
+	param := func() int {
+		c.NumParams++
+		return c.NumParams - 1
+	}
+	ladder := func() {
+		for q := 0; q+1 < n; q++ {
+			c.Gates = append(c.Gates, AnsatzGate{Kind: GateCNOT, Qubits: []int{q, q + 1}, Param: -1})
+		}
+	}
+
+	switch ansatz {
+	case pb.AnsatzType_ANSATZ_UCCSD:
+		var occupied, virtual []int
+		for q := 0; q < n; q++ {
+			if c.Reference&(1<<q) != 0 {
+				occupied = append(occupied, q)
+			} else {
+				virtual = append(virtual, q)
+			}
+		}
+		// Spin orbitals alternate α, β (qubit parity is the spin)
+		for _, i := range occupied {
+			for _, a := range virtual {
+				if i%2 == a%2 {
+					c.Gates = append(c.Gates, AnsatzGate{Kind: GateExcitation, Qubits: []int{i, a}, Param: param(), Scale: 1})
+				}
+			}
+		}
+		for x, i := range occupied {
+			for _, j := range occupied[x+1:] {
+				for y, a := range virtual {
+					for _, b := range virtual[y+1:] {
+						if i%2+j%2 == a%2+b%2 {
+							c.Gates = append(c.Gates, AnsatzGate{Kind: GateExcitation, Qubits: []int{i, j, a, b}, Param: param(), Scale: 1})
+						}
+					}
+				}
+			}
+		}
+	case pb.AnsatzType_ANSATZ_HARDWARE_EFFICIENT:
+		for q := 0; q < n; q++ {
+			c.Gates = append(c.Gates,
+				AnsatzGate{Kind: GateRY, Qubits: []int{q}, Param: param(), Scale: 1},
+				AnsatzGate{Kind: GateRZ, Qubits: []int{q}, Param: param(), Scale: 1})
+		}
+		ladder()
+		for q := 0; q < n; q++ {
+			c.Gates = append(c.Gates, AnsatzGate{Kind: GateRY, Qubits: []int{q}, Param: param(), Scale: 1})
+		}
+	default: // AnsatzRY
+		for q := 0; q < n; q++ {
+			c.Gates = append(c.Gates, AnsatzGate{Kind: GateRY, Qubits: []int{q}, Param: param(), Scale: 1})
+		}
+		ladder()
+	}
+	return c
+}
+
+// referenceState returns the basis state minimizing the Z-only part of H,
+// restricted to the right number of electrons with equal α and β counts
+// (as far as parity allows) when the Hamiltonian records them
+func referenceState(h *pb.Hamiltonian) int {
+	alphaMask := 0
+	for q := 0; q < int(h.NumQubits); q += 2 {
+		alphaMask |= 1 << q
+	}
+
+	best, bestEnergy := 0, math.Inf(1)
+	for state := 0; state < 1<<h.NumQubits; state++ {
+		if h.NumElectrons > 0 {
+			alpha, total := bits.OnesCount(uint(state&alphaMask)), bits.OnesCount(uint(state))
+			if total != int(h.NumElectrons) || abs(2*alpha-total) > 1 {
+				continue
+			}
+		}
+		energy := 0.0
+		for _, term := range h.Terms {
+			if diagonal, sign := diagonalSign(term, state); diagonal {
+				energy += term.Coefficient * sign
+			}
+		}
+		if energy < bestEnergy-1e-12 {
+			best, bestEnergy = state, energy
+		}
+	}
+	return best
+}
+
+// diagonalSign reports whether a term is a product of Z/I and its
+// eigenvalue (±1) on a basis state
+func diagonalSign(term *pb.PauliTerm, state int) (bool, float64) {
+	sign := 1.0
+	for _, op := range term.Operators {
+		switch op.Type {
+		case pb.PauliType_PAULI_I:
+		case pb.PauliType_PAULI_Z:
+			if state&(1<<op.Qubit) != 0 {
+				sign = -sign
+			}
+		default:
+			return false, 0
+		}
+	}
+	return true, sign
+}
+
+// Run prepares the ansatz state for params
+func (c *AnsatzCircuit) Run(params []float64) (*Statevector, error) {
+	if len(params) != c.NumParams {
+		return nil, fmt.Errorf("ansatz takes %d parameters, got %d", c.NumParams, len(params))
+	}
+	sv := NewStatevector(c.NumQubits)
+	for _, g := range c.Gates {
+		sv.applyGate(g, g.angle(params))
+	}
+	return sv, nil
+}
+
+// angle is the gate's rotation angle
+func (g AnsatzGate) angle(params []float64) float64 {
+	if g.Param < 0 {
+		return g.Scale
+	}
+	return g.Scale * params[g.Param]
+}
+
+// applyGate applies one ansatz gate; every gate's inverse is the same
+// gate at −θ
+func (sv *Statevector) applyGate(g AnsatzGate, theta float64) {
+	switch g.Kind {
+	case GateX:
+		sv.ApplyX(g.Qubits[0])
+	case GateRY:
+		sv.ApplyRY(g.Qubits[0], theta)
+	case GateRZ:
+		sv.ApplyRZ(g.Qubits[0], theta)
+	case GateCNOT:
+		sv.ApplyCNOT(g.Qubits[0], g.Qubits[1])
+	case GateExcitation:
+		sv.ApplyExcitation(g.Qubits, theta)
+	case GateH:
+		sv.ApplyH(g.Qubits[0])
+	case GateRX:
+		sv.ApplyRX(g.Qubits[0], theta)
+	}
+}
+
+// ReferenceState is the determinant the ansatz starts from; its energy is
+// the Hartree-Fock energy for Hamiltonians built from RHF orbitals
+func (c *AnsatzCircuit) ReferenceState() *Statevector {
+	sv := NewStatevector(c.NumQubits)
+	sv.Amplitudes[0], sv.Amplitudes[c.Reference] = 0, 1
+	return sv
+}
+
+// ------------------------------------------------------------------
+// State-Vector Simulator
+// ------------------------------------------------------------------
+
+// Statevector holds 2^n amplitudes; bit q of the index is qubit q
+type Statevector struct {
+	NumQubits  int
+	Amplitudes []complex128
+}
+
+// maxQubits bounds simulated registers: 2^20 amplitudes take 16 MiB
+const maxQubits = 20
+
+func NewStatevector(numQubits int) *Statevector {
+	sv := &Statevector{NumQubits: numQubits, Amplitudes: make([]complex128, 1<<numQubits)}
+	sv.Amplitudes[0] = 1
+	return sv
+}
+
+func (sv *Statevector) Clone() *Statevector {
+	return &Statevector{NumQubits: sv.NumQubits, Amplitudes: append([]complex128(nil), sv.Amplitudes...)}
+}
+
+func (sv *Statevector) ApplyX(q int) {
+	mask := 1 << q
+	for i := range sv.Amplitudes {
+		if i&mask == 0 {
+			sv.Amplitudes[i], sv.Amplitudes[i|mask] = sv.Amplitudes[i|mask], sv.Amplitudes[i]
+		}
+	}
+}
+
+func (sv *Statevector) ApplyH(q int) {
+	mask := 1 << q
+	r := complex(1/math.Sqrt2, 0)
+	for i := range sv.Amplitudes {
+		if i&mask == 0 {
+			a0, a1 := sv.Amplitudes[i], sv.Amplitudes[i|mask]
+			sv.Amplitudes[i], sv.Amplitudes[i|mask] = r*(a0+a1), r*(a0-a1)
+		}
+	}
+}
+
+// ApplyRX applies exp(−iθX/2)
+func (sv *Statevector) ApplyRX(q int, theta float64) {
+	mask := 1 << q
+	c, s := complex(math.Cos(theta/2), 0), complex(0, -math.Sin(theta/2))
+	for i := range sv.Amplitudes {
+		if i&mask == 0 {
+			a0, a1 := sv.Amplitudes[i], sv.Amplitudes[i|mask]
+			sv.Amplitudes[i], sv.Amplitudes[i|mask] = c*a0+s*a1, s*a0+c*a1
+		}
+	}
+}
+
+// ApplyRY applies exp(−iθY/2)
+func (sv *Statevector) ApplyRY(q int, theta float64) {
+	mask := 1 << q
+	c, s := complex(math.Cos(theta/2), 0), complex(math.Sin(theta/2), 0)
+	for i := range sv.Amplitudes {
+		if i&mask == 0 {
+			a0, a1 := sv.Amplitudes[i], sv.Amplitudes[i|mask]
+			sv.Amplitudes[i], sv.Amplitudes[i|mask] = c*a0-s*a1, s*a0+c*a1
+		}
+	}
+}
+
+// ApplyRZ applies exp(−iθZ/2)
+func (sv *Statevector) ApplyRZ(q int, theta float64) {
+	mask := 1 << q
+	minus, plus := cmplx.Exp(complex(0, -theta/2)), cmplx.Exp(complex(0, theta/2))
+	for i := range sv.Amplitudes {
+		if i&mask == 0 {
+			sv.Amplitudes[i] *= minus
+		} else {
+			sv.Amplitudes[i] *= plus
+		}
+	}
+}
+
+func (sv *Statevector) ApplyCNOT(control, target int) {
+	cm, tm := 1<<control, 1<<target
+	for i := range sv.Amplitudes {
+		if i&cm != 0 && i&tm == 0 {
+			sv.Amplitudes[i], sv.Amplitudes[i|tm] = sv.Amplitudes[i|tm], sv.Amplitudes[i]
+		}
+	}
+}
+
+// ApplyExcitation applies exp(θG) with G = T − T† for the fermionic
+// excitation T (Jordan-Wigner signs included). G maps each determinant
+// pair it connects onto each other and G² = −1 on that pair, so
+// exp(θG) = 1 + sinθ·G + (1 − cosθ)·G².
+func (sv *Statevector) ApplyExcitation(modes []int, theta float64) {
+	half := len(modes) / 2
+	var excite []fermionOp // T: annihilate occupied, create virtual
+	for _, m := range modes[:half] {
+		excite = append(excite, fermionOp{Mode: m})
+	}
+	for _, m := range modes[half:] {
+		excite = append(excite, fermionOp{Mode: m, Create: true})
+	}
+	deexcite := make([]fermionOp, len(excite)) // T† reverses and conjugates
+	for i, op := range excite {
+		deexcite[len(excite)-1-i] = fermionOp{Mode: op.Mode, Create: !op.Create}
+	}
+
+	generator := func(in []complex128) []complex128 {
+		out := make([]complex128, len(in))
+		for state, a := range in {
+			if a == 0 {
+				continue
+			}
+			if next, sign, ok := applyFermionOps(excite, state); ok {
+				out[next] += complex(sign, 0) * a
+			}
+			if next, sign, ok := applyFermionOps(deexcite, state); ok {
+				out[next] -= complex(sign, 0) * a
+			}
+		}
+		return out
+	}
+
+	g1 := generator(sv.Amplitudes)
+	g2 := generator(g1)
+	s, c := complex(math.Sin(theta), 0), complex(1-math.Cos(theta), 0)
+	for i := range sv.Amplitudes {
+		sv.Amplitudes[i] += s*g1[i] + c*g2[i]
+	}
+}
+
+// fermionOp is a† (Create) or a on one spin orbital
+type fermionOp struct {
+	Mode   int
+	Create bool
+}
+
+// applyFermionOps applies ops right to left (ops[0] acts first) to a
+// determinant, returning the result and its Jordan-Wigner sign
+func applyFermionOps(ops []fermionOp, state int) (int, float64, bool) {
+	sign := 1.0
+	for _, op := range ops {
+		mask := 1 << op.Mode
+		occupied := state&mask != 0
+		if occupied == op.Create {
+			return 0, 0, false // a†|1⟩ = a|0⟩ = 0
+		}
+		if bits.OnesCount(uint(state&(mask-1)))%2 == 1 {
+			sign = -sign
+		}
+		state ^= mask
+	}
+	return state, sign, true
+}
+
+// PauliExpectation returns ⟨ψ|P|ψ⟩ for a Pauli string
+func (sv *Statevector) PauliExpectation(ops []*pb.PauliOperator) float64 {
+	var flip int
+	for _, op := range ops {
+		if op.Type == pb.PauliType_PAULI_X || op.Type == pb.PauliType_PAULI_Y {
+			flip |= 1 << op.Qubit
+		}
+	}
+
+	var total complex128
+	for i, a := range sv.Amplitudes {
+		if a == 0 {
+			continue
+		}
+		// P|i⟩ = phase·|i ⊕ flip⟩
+		phase := complex(1, 0)
+		for _, op := range ops {
+			bit := i&(1<<op.Qubit) != 0
+			switch op.Type {
+			case pb.PauliType_PAULI_Z:
+				if bit {
+					phase = -phase
+				}
+			case pb.PauliType_PAULI_Y:
+				if bit {
+					phase *= complex(0, -1)
+				} else {
+					phase *= complex(0, 1)
+				}
+			}
+		}
+		total += cmplx.Conj(sv.Amplitudes[i^flip]) * phase * a
+	}
+	return real(total)
+}
+
+// Overlap returns the fidelity |⟨other|ψ⟩|²
+func (sv *Statevector) Overlap(other *Statevector) float64 {
+	var inner complex128
+	for i, a := range sv.Amplitudes {
+		inner += cmplx.Conj(other.Amplitudes[i]) * a
+	}
+	return real(inner)*real(inner) + imag(inner)*imag(inner)
+}
+
+// ------------------------------------------------------------------
+// Energy Estimation
+// ------------------------------------------------------------------
+
+// EnergyEstimate is ⟨H⟩ for one parameter vector
+type EnergyEstimate struct {
+	Energy        float64            // Electronic ⟨H⟩ + nuclear repulsion
+	Variance      float64            // Variance of the estimator (0 when exact)
+	Contributions map[string]float64 // c_i⟨P_i⟩ per Pauli term
+	Shots         int                // Shots used across all terms
+	Penalty       float64            // Deflation penalty included in Energy (VQD)
+	TermValues    []float64          // ⟨P_i⟩ estimate per Hamiltonian term
+	TermVariances []float64          // Variance of each c_i⟨P_i⟩ estimate
+}
+
+// MeasureEnergy evaluates every Pauli term on the prepared state. With
+// shots = 0 the exact expectation is used; otherwise each measurement
+// group (or, for an ungrouped Hamiltonian, each term) is measured `shots`
+// times in its eigenbasis and the sample mean is reported.
+func MeasureEnergy(h *pb.Hamiltonian, sv *Statevector, shots int, rng *rand.Rand) *EnergyEstimate {
+	return measureEnergy(h, sv, shots, rng, nil)
+}
+
+// measureEnergy is MeasureEnergy through a noisy, possibly corrected
+// readout (nil = ideal), which needs a grouped Hamiltonian
+func measureEnergy(h *pb.Hamiltonian, sv *Statevector, shots int, rng *rand.Rand, readout *readoutModel) *EnergyEstimate {
+	est := &EnergyEstimate{
+		Energy:        h.NuclearRepulsion,
+		Contributions: make(map[string]float64),
+		TermValues:    make([]float64, len(h.Terms)),
+		TermVariances: make([]float64, len(h.Terms)),
+	}
+	grouped := len(h.Groups) > 0 && (shots > 0 || readout != nil)
+	if grouped {
+		measureGroups(h, sv, shots, rng, readout, est)
+	}
+	for i, term := range h.Terms {
+		label := pauliLabel(term)
+		if isIdentity(term) {
+			est.Energy += term.Coefficient
+			est.Contributions[label] += term.Coefficient
+			est.TermValues[i] = 1
+			continue
+		}
+		if grouped {
+			continue
+		}
+
+		expectation := sv.PauliExpectation(term.Operators)
+		if shots > 0 {
+			// Each shot yields eigenvalue +1 with probability (1 + ⟨P⟩)/2
+			pPlus := (1 + expectation) / 2
+			plus := 0
+			for s := 0; s < shots; s++ {
+				if rng.Float64() < pPlus {
+					plus++
+				}
+			}
+			expectation = 2*float64(plus)/float64(shots) - 1
+			est.TermVariances[i] = term.Coefficient * term.Coefficient * (1 - expectation*expectation) / float64(shots)
+			est.Variance += est.TermVariances[i]
+			est.Shots += shots
+		}
+
+		est.TermValues[i] = expectation
+		est.Energy += term.Coefficient * expectation
+		est.Contributions[label] += term.Coefficient * expectation
+	}
+	return est
+}
+
+func isIdentity(term *pb.PauliTerm) bool {
+	for _, op := range term.Operators {
+		if op.Type != pb.PauliType_PAULI_I {
+			return false
+		}
+	}
+	return true
+}
+
+// pauliLabel spells a term as e.g. "X0 X1 Y2 Y3" ("I" for the identity)
+func pauliLabel(term *pb.PauliTerm) string {
+	ops := append([]*pb.PauliOperator(nil), term.Operators...)
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Qubit < ops[j].Qubit })
+
+	var parts []string
+	for _, op := range ops {
+		if op.Type == pb.PauliType_PAULI_I {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%c%d", "IXYZ"[op.Type], op.Qubit))
+	}
+	if len(parts) == 0 {
+		return "I"
+	}
+	return strings.Join(parts, " ")
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
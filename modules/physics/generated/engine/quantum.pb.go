@@ -0,0 +1,975 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: api/proto/quantum.proto
+
+package generated
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CircuitRequest_ExecutionBackend int32
+
+const (
+	CircuitRequest_SIMULATOR     CircuitRequest_ExecutionBackend = 0
+	CircuitRequest_MOCK_HARDWARE CircuitRequest_ExecutionBackend = 1
+	CircuitRequest_REAL_IBM_Q    CircuitRequest_ExecutionBackend = 2 // Future use
+)
+
+// Enum value maps for CircuitRequest_ExecutionBackend.
+var (
+	CircuitRequest_ExecutionBackend_name = map[int32]string{
+		0: "SIMULATOR",
+		1: "MOCK_HARDWARE",
+		2: "REAL_IBM_Q",
+	}
+	CircuitRequest_ExecutionBackend_value = map[string]int32{
+		"SIMULATOR":     0,
+		"MOCK_HARDWARE": 1,
+		"REAL_IBM_Q":    2,
+	}
+)
+
+func (x CircuitRequest_ExecutionBackend) Enum() *CircuitRequest_ExecutionBackend {
+	p := new(CircuitRequest_ExecutionBackend)
+	*p = x
+	return p
+}
+
+func (x CircuitRequest_ExecutionBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitRequest_ExecutionBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_quantum_proto_enumTypes[0].Descriptor()
+}
+
+func (CircuitRequest_ExecutionBackend) Type() protoreflect.EnumType {
+	return &file_api_proto_quantum_proto_enumTypes[0]
+}
+
+func (x CircuitRequest_ExecutionBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitRequest_ExecutionBackend.Descriptor instead.
+func (CircuitRequest_ExecutionBackend) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type GateOperation_GateType int32
+
+const (
+	GateOperation_HADAMARD GateOperation_GateType = 0
+	GateOperation_PAULI_X  GateOperation_GateType = 1
+	GateOperation_CNOT     GateOperation_GateType = 2
+	GateOperation_MEASURE  GateOperation_GateType = 3
+	// New Gates
+	GateOperation_TOFFOLI    GateOperation_GateType = 4
+	GateOperation_PHASE_S    GateOperation_GateType = 5 // S Gate (Z90)
+	GateOperation_PHASE_T    GateOperation_GateType = 6 // T Gate (Z45)
+	GateOperation_ROTATION_Y GateOperation_GateType = 7
+	GateOperation_ROTATION_Z GateOperation_GateType = 8
+)
+
+// Enum value maps for GateOperation_GateType.
+var (
+	GateOperation_GateType_name = map[int32]string{
+		0: "HADAMARD",
+		1: "PAULI_X",
+		2: "CNOT",
+		3: "MEASURE",
+		4: "TOFFOLI",
+		5: "PHASE_S",
+		6: "PHASE_T",
+		7: "ROTATION_Y",
+		8: "ROTATION_Z",
+	}
+	GateOperation_GateType_value = map[string]int32{
+		"HADAMARD":   0,
+		"PAULI_X":    1,
+		"CNOT":       2,
+		"MEASURE":    3,
+		"TOFFOLI":    4,
+		"PHASE_S":    5,
+		"PHASE_T":    6,
+		"ROTATION_Y": 7,
+		"ROTATION_Z": 8,
+	}
+)
+
+func (x GateOperation_GateType) Enum() *GateOperation_GateType {
+	p := new(GateOperation_GateType)
+	*p = x
+	return p
+}
+
+func (x GateOperation_GateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GateOperation_GateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_quantum_proto_enumTypes[1].Descriptor()
+}
+
+func (GateOperation_GateType) Type() protoreflect.EnumType {
+	return &file_api_proto_quantum_proto_enumTypes[1]
+}
+
+func (x GateOperation_GateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GateOperation_GateType.Descriptor instead.
+func (GateOperation_GateType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type VQERequest_Molecule int32
+
+const (
+	VQERequest_H2  VQERequest_Molecule = 0 // Hydrogen molecule
+	VQERequest_LiH VQERequest_Molecule = 1 // Lithium Hydride
+)
+
+// Enum value maps for VQERequest_Molecule.
+var (
+	VQERequest_Molecule_name = map[int32]string{
+		0: "H2",
+		1: "LiH",
+	}
+	VQERequest_Molecule_value = map[string]int32{
+		"H2":  0,
+		"LiH": 1,
+	}
+)
+
+func (x VQERequest_Molecule) Enum() *VQERequest_Molecule {
+	p := new(VQERequest_Molecule)
+	*p = x
+	return p
+}
+
+func (x VQERequest_Molecule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VQERequest_Molecule) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_quantum_proto_enumTypes[2].Descriptor()
+}
+
+func (VQERequest_Molecule) Type() protoreflect.EnumType {
+	return &file_api_proto_quantum_proto_enumTypes[2]
+}
+
+func (x VQERequest_Molecule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VQERequest_Molecule.Descriptor instead.
+func (VQERequest_Molecule) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type VQERequest_OptimizerType int32
+
+const (
+	VQERequest_SPSA             VQERequest_OptimizerType = 0 // Simultaneous Perturbation Stochastic Approximation
+	VQERequest_GRADIENT_DESCENT VQERequest_OptimizerType = 1 // Native Differentiable Simulation (Parameter Shift)
+)
+
+// Enum value maps for VQERequest_OptimizerType.
+var (
+	VQERequest_OptimizerType_name = map[int32]string{
+		0: "SPSA",
+		1: "GRADIENT_DESCENT",
+	}
+	VQERequest_OptimizerType_value = map[string]int32{
+		"SPSA":             0,
+		"GRADIENT_DESCENT": 1,
+	}
+)
+
+func (x VQERequest_OptimizerType) Enum() *VQERequest_OptimizerType {
+	p := new(VQERequest_OptimizerType)
+	*p = x
+	return p
+}
+
+func (x VQERequest_OptimizerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VQERequest_OptimizerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_quantum_proto_enumTypes[3].Descriptor()
+}
+
+func (VQERequest_OptimizerType) Type() protoreflect.EnumType {
+	return &file_api_proto_quantum_proto_enumTypes[3]
+}
+
+func (x VQERequest_OptimizerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VQERequest_OptimizerType.Descriptor instead.
+func (VQERequest_OptimizerType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{5, 1}
+}
+
+type CircuitRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	NumQubits  int32                  `protobuf:"varint,1,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	Operations []*GateOperation       `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Probability of a depolarizing error occurring per step (0.0 - 1.0)
+	NoiseProbability float64                         `protobuf:"fixed64,3,opt,name=noise_probability,json=noiseProbability,proto3" json:"noise_probability,omitempty"`
+	ExecutionBackend CircuitRequest_ExecutionBackend `protobuf:"varint,4,opt,name=execution_backend,json=executionBackend,proto3,enum=qubit_engine.CircuitRequest_ExecutionBackend" json:"execution_backend,omitempty"`
+	// Deterministic mode: a non-zero seed fixes the measurement and noise RNG,
+	// so the same seed + circuit always yields the same classical results.
+	Seed          uint64 `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitRequest) Reset() {
+	*x = CircuitRequest{}
+	mi := &file_api_proto_quantum_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitRequest) ProtoMessage() {}
+
+func (x *CircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitRequest.ProtoReflect.Descriptor instead.
+func (*CircuitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CircuitRequest) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *CircuitRequest) GetOperations() []*GateOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *CircuitRequest) GetNoiseProbability() float64 {
+	if x != nil {
+		return x.NoiseProbability
+	}
+	return 0
+}
+
+func (x *CircuitRequest) GetExecutionBackend() CircuitRequest_ExecutionBackend {
+	if x != nil {
+		return x.ExecutionBackend
+	}
+	return CircuitRequest_SIMULATOR
+}
+
+func (x *CircuitRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type GateOperation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Type         GateOperation_GateType `protobuf:"varint,1,opt,name=type,proto3,enum=qubit_engine.GateOperation_GateType" json:"type,omitempty"`
+	TargetQubit  uint32                 `protobuf:"varint,2,opt,name=target_qubit,json=targetQubit,proto3" json:"target_qubit,omitempty"`
+	ControlQubit uint32                 `protobuf:"varint,3,opt,name=control_qubit,json=controlQubit,proto3" json:"control_qubit,omitempty"`
+	// Optional: Register to store the classical result (useful for complex circuits)
+	ClassicalRegister uint32 `protobuf:"varint,4,opt,name=classical_register,json=classicalRegister,proto3" json:"classical_register,omitempty"`
+	// For Rotations
+	Angle float64 `protobuf:"fixed64,5,opt,name=angle,proto3" json:"angle,omitempty"` // Rotation angle in radians
+	// For Toffoli (3rd qubit)
+	SecondControlQubit uint32 `protobuf:"varint,6,opt,name=second_control_qubit,json=secondControlQubit,proto3" json:"second_control_qubit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GateOperation) Reset() {
+	*x = GateOperation{}
+	mi := &file_api_proto_quantum_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GateOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GateOperation) ProtoMessage() {}
+
+func (x *GateOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GateOperation.ProtoReflect.Descriptor instead.
+func (*GateOperation) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GateOperation) GetType() GateOperation_GateType {
+	if x != nil {
+		return x.Type
+	}
+	return GateOperation_HADAMARD
+}
+
+func (x *GateOperation) GetTargetQubit() uint32 {
+	if x != nil {
+		return x.TargetQubit
+	}
+	return 0
+}
+
+func (x *GateOperation) GetControlQubit() uint32 {
+	if x != nil {
+		return x.ControlQubit
+	}
+	return 0
+}
+
+func (x *GateOperation) GetClassicalRegister() uint32 {
+	if x != nil {
+		return x.ClassicalRegister
+	}
+	return 0
+}
+
+func (x *GateOperation) GetAngle() float64 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *GateOperation) GetSecondControlQubit() uint32 {
+	if x != nil {
+		return x.SecondControlQubit
+	}
+	return 0
+}
+
+type StateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full state vector of size 2^num_qubits
+	StateVector []*StateResponse_ComplexNumber `protobuf:"bytes,1,rep,name=state_vector,json=stateVector,proto3" json:"state_vector,omitempty"`
+	// Return measured classical bits (e.g., Qubit 0 -> 1)
+	ClassicalResults map[uint32]bool `protobuf:"bytes,2,rep,name=classical_results,json=classicalResults,proto3" json:"classical_results,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Identity of the server (Hostname/Pod ID) that processed this step
+	ServerId      string `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateResponse) Reset() {
+	*x = StateResponse{}
+	mi := &file_api_proto_quantum_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateResponse) ProtoMessage() {}
+
+func (x *StateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
+func (*StateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StateResponse) GetStateVector() []*StateResponse_ComplexNumber {
+	if x != nil {
+		return x.StateVector
+	}
+	return nil
+}
+
+func (x *StateResponse) GetClassicalResults() map[uint32]bool {
+	if x != nil {
+		return x.ClassicalResults
+	}
+	return nil
+}
+
+func (x *StateResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+// What running a circuit on this server would take
+type ResourceEstimate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	NumQubits int32                  `protobuf:"varint,1,opt,name=num_qubits,json=numQubits,proto3" json:"num_qubits,omitempty"`
+	GateCount uint32                 `protobuf:"varint,2,opt,name=gate_count,json=gateCount,proto3" json:"gate_count,omitempty"`
+	// Layers of gates that can run at once
+	Depth uint32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// State vector memory: 2^num_qubits * 16 bytes
+	MemoryBytes        uint64  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	EstimatedRuntimeMs float64 `protobuf:"fixed64,5,opt,name=estimated_runtime_ms,json=estimatedRuntimeMs,proto3" json:"estimated_runtime_ms,omitempty"`
+	// Whether the server has the free memory for the state vector right now
+	FitsInMemory  bool   `protobuf:"varint,6,opt,name=fits_in_memory,json=fitsInMemory,proto3" json:"fits_in_memory,omitempty"`
+	ServerId      string `protobuf:"bytes,7,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceEstimate) Reset() {
+	*x = ResourceEstimate{}
+	mi := &file_api_proto_quantum_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceEstimate) ProtoMessage() {}
+
+func (x *ResourceEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceEstimate.ProtoReflect.Descriptor instead.
+func (*ResourceEstimate) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceEstimate) GetNumQubits() int32 {
+	if x != nil {
+		return x.NumQubits
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetGateCount() uint32 {
+	if x != nil {
+		return x.GateCount
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetEstimatedRuntimeMs() float64 {
+	if x != nil {
+		return x.EstimatedRuntimeMs
+	}
+	return 0
+}
+
+func (x *ResourceEstimate) GetFitsInMemory() bool {
+	if x != nil {
+		return x.FitsInMemory
+	}
+	return false
+}
+
+func (x *ResourceEstimate) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type Measurement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QubitIndex    uint32                 `protobuf:"varint,1,opt,name=qubit_index,json=qubitIndex,proto3" json:"qubit_index,omitempty"`
+	Result        bool                   `protobuf:"varint,2,opt,name=result,proto3" json:"result,omitempty"`
+	Probability   float64                `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"` // Probability of the measured result
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Measurement) Reset() {
+	*x = Measurement{}
+	mi := &file_api_proto_quantum_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Measurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Measurement) ProtoMessage() {}
+
+func (x *Measurement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Measurement.ProtoReflect.Descriptor instead.
+func (*Measurement) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Measurement) GetQubitIndex() uint32 {
+	if x != nil {
+		return x.QubitIndex
+	}
+	return 0
+}
+
+func (x *Measurement) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *Measurement) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type VQERequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Molecule      VQERequest_Molecule      `protobuf:"varint,1,opt,name=molecule,proto3,enum=qubit_engine.VQERequest_Molecule" json:"molecule,omitempty"`
+	MaxIterations int32                    `protobuf:"varint,2,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	LearningRate  float64                  `protobuf:"fixed64,3,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"` // For Gradient Descent
+	OptimizerType VQERequest_OptimizerType `protobuf:"varint,4,opt,name=optimizer_type,json=optimizerType,proto3,enum=qubit_engine.VQERequest_OptimizerType" json:"optimizer_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VQERequest) Reset() {
+	*x = VQERequest{}
+	mi := &file_api_proto_quantum_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VQERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VQERequest) ProtoMessage() {}
+
+func (x *VQERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VQERequest.ProtoReflect.Descriptor instead.
+func (*VQERequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VQERequest) GetMolecule() VQERequest_Molecule {
+	if x != nil {
+		return x.Molecule
+	}
+	return VQERequest_H2
+}
+
+func (x *VQERequest) GetMaxIterations() int32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+func (x *VQERequest) GetLearningRate() float64 {
+	if x != nil {
+		return x.LearningRate
+	}
+	return 0
+}
+
+func (x *VQERequest) GetOptimizerType() VQERequest_OptimizerType {
+	if x != nil {
+		return x.OptimizerType
+	}
+	return VQERequest_SPSA
+}
+
+type VQEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iteration     int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Energy        float64                `protobuf:"fixed64,2,opt,name=energy,proto3" json:"energy,omitempty"`                // Expected energy in Hartrees
+	Parameters    []float64              `protobuf:"fixed64,3,rep,packed,name=parameters,proto3" json:"parameters,omitempty"` // Current ansatz parameters (angles)
+	Converged     bool                   `protobuf:"varint,4,opt,name=converged,proto3" json:"converged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VQEResponse) Reset() {
+	*x = VQEResponse{}
+	mi := &file_api_proto_quantum_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VQEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VQEResponse) ProtoMessage() {}
+
+func (x *VQEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VQEResponse.ProtoReflect.Descriptor instead.
+func (*VQEResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VQEResponse) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *VQEResponse) GetEnergy() float64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *VQEResponse) GetParameters() []float64 {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *VQEResponse) GetConverged() bool {
+	if x != nil {
+		return x.Converged
+	}
+	return false
+}
+
+type StateResponse_ComplexNumber struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Note: Using 'double' is standard for quantum state vectors.
+	Real          float64 `protobuf:"fixed64,1,opt,name=real,proto3" json:"real,omitempty"`
+	Imag          float64 `protobuf:"fixed64,2,opt,name=imag,proto3" json:"imag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateResponse_ComplexNumber) Reset() {
+	*x = StateResponse_ComplexNumber{}
+	mi := &file_api_proto_quantum_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateResponse_ComplexNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateResponse_ComplexNumber) ProtoMessage() {}
+
+func (x *StateResponse_ComplexNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_quantum_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateResponse_ComplexNumber.ProtoReflect.Descriptor instead.
+func (*StateResponse_ComplexNumber) Descriptor() ([]byte, []int) {
+	return file_api_proto_quantum_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *StateResponse_ComplexNumber) GetReal() float64 {
+	if x != nil {
+		return x.Real
+	}
+	return 0
+}
+
+func (x *StateResponse_ComplexNumber) GetImag() float64 {
+	if x != nil {
+		return x.Imag
+	}
+	return 0
+}
+
+var File_api_proto_quantum_proto protoreflect.FileDescriptor
+
+const file_api_proto_quantum_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/proto/quantum.proto\x12\fqubit_engine\"\xcf\x02\n" +
+	"\x0eCircuitRequest\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x12;\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\v2\x1b.qubit_engine.GateOperationR\n" +
+	"operations\x12+\n" +
+	"\x11noise_probability\x18\x03 \x01(\x01R\x10noiseProbability\x12Z\n" +
+	"\x11execution_backend\x18\x04 \x01(\x0e2-.qubit_engine.CircuitRequest.ExecutionBackendR\x10executionBackend\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\x04R\x04seed\"D\n" +
+	"\x10ExecutionBackend\x12\r\n" +
+	"\tSIMULATOR\x10\x00\x12\x11\n" +
+	"\rMOCK_HARDWARE\x10\x01\x12\x0e\n" +
+	"\n" +
+	"REAL_IBM_Q\x10\x02\"\x8e\x03\n" +
+	"\rGateOperation\x128\n" +
+	"\x04type\x18\x01 \x01(\x0e2$.qubit_engine.GateOperation.GateTypeR\x04type\x12!\n" +
+	"\ftarget_qubit\x18\x02 \x01(\rR\vtargetQubit\x12#\n" +
+	"\rcontrol_qubit\x18\x03 \x01(\rR\fcontrolQubit\x12-\n" +
+	"\x12classical_register\x18\x04 \x01(\rR\x11classicalRegister\x12\x14\n" +
+	"\x05angle\x18\x05 \x01(\x01R\x05angle\x120\n" +
+	"\x14second_control_qubit\x18\x06 \x01(\rR\x12secondControlQubit\"\x83\x01\n" +
+	"\bGateType\x12\f\n" +
+	"\bHADAMARD\x10\x00\x12\v\n" +
+	"\aPAULI_X\x10\x01\x12\b\n" +
+	"\x04CNOT\x10\x02\x12\v\n" +
+	"\aMEASURE\x10\x03\x12\v\n" +
+	"\aTOFFOLI\x10\x04\x12\v\n" +
+	"\aPHASE_S\x10\x05\x12\v\n" +
+	"\aPHASE_T\x10\x06\x12\x0e\n" +
+	"\n" +
+	"ROTATION_Y\x10\a\x12\x0e\n" +
+	"\n" +
+	"ROTATION_Z\x10\b\"\xd8\x02\n" +
+	"\rStateResponse\x12L\n" +
+	"\fstate_vector\x18\x01 \x03(\v2).qubit_engine.StateResponse.ComplexNumberR\vstateVector\x12^\n" +
+	"\x11classical_results\x18\x02 \x03(\v21.qubit_engine.StateResponse.ClassicalResultsEntryR\x10classicalResults\x12\x1b\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x1a7\n" +
+	"\rComplexNumber\x12\x12\n" +
+	"\x04real\x18\x01 \x01(\x01R\x04real\x12\x12\n" +
+	"\x04imag\x18\x02 \x01(\x01R\x04imag\x1aC\n" +
+	"\x15ClassicalResultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xfe\x01\n" +
+	"\x10ResourceEstimate\x12\x1d\n" +
+	"\n" +
+	"num_qubits\x18\x01 \x01(\x05R\tnumQubits\x12\x1d\n" +
+	"\n" +
+	"gate_count\x18\x02 \x01(\rR\tgateCount\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\rR\x05depth\x12!\n" +
+	"\fmemory_bytes\x18\x04 \x01(\x04R\vmemoryBytes\x120\n" +
+	"\x14estimated_runtime_ms\x18\x05 \x01(\x01R\x12estimatedRuntimeMs\x12$\n" +
+	"\x0efits_in_memory\x18\x06 \x01(\bR\ffitsInMemory\x12\x1b\n" +
+	"\tserver_id\x18\a \x01(\tR\bserverId\"h\n" +
+	"\vMeasurement\x12\x1f\n" +
+	"\vqubit_index\x18\x01 \x01(\rR\n" +
+	"qubitIndex\x12\x16\n" +
+	"\x06result\x18\x02 \x01(\bR\x06result\x12 \n" +
+	"\vprobability\x18\x03 \x01(\x01R\vprobability\"\xb4\x02\n" +
+	"\n" +
+	"VQERequest\x12=\n" +
+	"\bmolecule\x18\x01 \x01(\x0e2!.qubit_engine.VQERequest.MoleculeR\bmolecule\x12%\n" +
+	"\x0emax_iterations\x18\x02 \x01(\x05R\rmaxIterations\x12#\n" +
+	"\rlearning_rate\x18\x03 \x01(\x01R\flearningRate\x12M\n" +
+	"\x0eoptimizer_type\x18\x04 \x01(\x0e2&.qubit_engine.VQERequest.OptimizerTypeR\roptimizerType\"\x1b\n" +
+	"\bMolecule\x12\x06\n" +
+	"\x02H2\x10\x00\x12\a\n" +
+	"\x03LiH\x10\x01\"/\n" +
+	"\rOptimizerType\x12\b\n" +
+	"\x04SPSA\x10\x00\x12\x14\n" +
+	"\x10GRADIENT_DESCENT\x10\x01\"\x81\x01\n" +
+	"\vVQEResponse\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12\x16\n" +
+	"\x06energy\x18\x02 \x01(\x01R\x06energy\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\x01R\n" +
+	"parameters\x12\x1c\n" +
+	"\tconverged\x18\x04 \x01(\bR\tconverged2\x95\x03\n" +
+	"\x0eQuantumCompute\x12I\n" +
+	"\n" +
+	"RunCircuit\x12\x1c.qubit_engine.CircuitRequest\x1a\x1b.qubit_engine.StateResponse\"\x00\x12M\n" +
+	"\vStreamGates\x12\x1b.qubit_engine.GateOperation\x1a\x1b.qubit_engine.StateResponse\"\x00(\x010\x01\x12Q\n" +
+	"\x10VisualizeCircuit\x12\x1c.qubit_engine.CircuitRequest\x1a\x1b.qubit_engine.StateResponse\"\x000\x01\x12A\n" +
+	"\x06RunVQE\x12\x18.qubit_engine.VQERequest\x1a\x19.qubit_engine.VQEResponse\"\x000\x01\x12S\n" +
+	"\x11EstimateResources\x12\x1c.qubit_engine.CircuitRequest\x1a\x1e.qubit_engine.ResourceEstimate\"\x00BU\n" +
+	"\x17com.perclft.qubitengineP\x01Z5github.com/perclft/QubitEngine/cli/internal/generated\xf8\x01\x01b\x06proto3"
+
+var (
+	file_api_proto_quantum_proto_rawDescOnce sync.Once
+	file_api_proto_quantum_proto_rawDescData []byte
+)
+
+func file_api_proto_quantum_proto_rawDescGZIP() []byte {
+	file_api_proto_quantum_proto_rawDescOnce.Do(func() {
+		file_api_proto_quantum_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_quantum_proto_rawDesc), len(file_api_proto_quantum_proto_rawDesc)))
+	})
+	return file_api_proto_quantum_proto_rawDescData
+}
+
+var file_api_proto_quantum_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_proto_quantum_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_proto_quantum_proto_goTypes = []any{
+	(CircuitRequest_ExecutionBackend)(0), // 0: qubit_engine.CircuitRequest.ExecutionBackend
+	(GateOperation_GateType)(0),          // 1: qubit_engine.GateOperation.GateType
+	(VQERequest_Molecule)(0),             // 2: qubit_engine.VQERequest.Molecule
+	(VQERequest_OptimizerType)(0),        // 3: qubit_engine.VQERequest.OptimizerType
+	(*CircuitRequest)(nil),               // 4: qubit_engine.CircuitRequest
+	(*GateOperation)(nil),                // 5: qubit_engine.GateOperation
+	(*StateResponse)(nil),                // 6: qubit_engine.StateResponse
+	(*ResourceEstimate)(nil),             // 7: qubit_engine.ResourceEstimate
+	(*Measurement)(nil),                  // 8: qubit_engine.Measurement
+	(*VQERequest)(nil),                   // 9: qubit_engine.VQERequest
+	(*VQEResponse)(nil),                  // 10: qubit_engine.VQEResponse
+	(*StateResponse_ComplexNumber)(nil),  // 11: qubit_engine.StateResponse.ComplexNumber
+	nil,                                  // 12: qubit_engine.StateResponse.ClassicalResultsEntry
+}
+var file_api_proto_quantum_proto_depIdxs = []int32{
+	5,  // 0: qubit_engine.CircuitRequest.operations:type_name -> qubit_engine.GateOperation
+	0,  // 1: qubit_engine.CircuitRequest.execution_backend:type_name -> qubit_engine.CircuitRequest.ExecutionBackend
+	1,  // 2: qubit_engine.GateOperation.type:type_name -> qubit_engine.GateOperation.GateType
+	11, // 3: qubit_engine.StateResponse.state_vector:type_name -> qubit_engine.StateResponse.ComplexNumber
+	12, // 4: qubit_engine.StateResponse.classical_results:type_name -> qubit_engine.StateResponse.ClassicalResultsEntry
+	2,  // 5: qubit_engine.VQERequest.molecule:type_name -> qubit_engine.VQERequest.Molecule
+	3,  // 6: qubit_engine.VQERequest.optimizer_type:type_name -> qubit_engine.VQERequest.OptimizerType
+	4,  // 7: qubit_engine.QuantumCompute.RunCircuit:input_type -> qubit_engine.CircuitRequest
+	5,  // 8: qubit_engine.QuantumCompute.StreamGates:input_type -> qubit_engine.GateOperation
+	4,  // 9: qubit_engine.QuantumCompute.VisualizeCircuit:input_type -> qubit_engine.CircuitRequest
+	9,  // 10: qubit_engine.QuantumCompute.RunVQE:input_type -> qubit_engine.VQERequest
+	4,  // 11: qubit_engine.QuantumCompute.EstimateResources:input_type -> qubit_engine.CircuitRequest
+	6,  // 12: qubit_engine.QuantumCompute.RunCircuit:output_type -> qubit_engine.StateResponse
+	6,  // 13: qubit_engine.QuantumCompute.StreamGates:output_type -> qubit_engine.StateResponse
+	6,  // 14: qubit_engine.QuantumCompute.VisualizeCircuit:output_type -> qubit_engine.StateResponse
+	10, // 15: qubit_engine.QuantumCompute.RunVQE:output_type -> qubit_engine.VQEResponse
+	7,  // 16: qubit_engine.QuantumCompute.EstimateResources:output_type -> qubit_engine.ResourceEstimate
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_quantum_proto_init() }
+func file_api_proto_quantum_proto_init() {
+	if File_api_proto_quantum_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_quantum_proto_rawDesc), len(file_api_proto_quantum_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_quantum_proto_goTypes,
+		DependencyIndexes: file_api_proto_quantum_proto_depIdxs,
+		EnumInfos:         file_api_proto_quantum_proto_enumTypes,
+		MessageInfos:      file_api_proto_quantum_proto_msgTypes,
+	}.Build()
+	File_api_proto_quantum_proto = out.File
+	file_api_proto_quantum_proto_goTypes = nil
+	file_api_proto_quantum_proto_depIdxs = nil
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: api/proto/quantum.proto
+
+package generated
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuantumCompute_RunCircuit_FullMethodName        = "/qubit_engine.QuantumCompute/RunCircuit"
+	QuantumCompute_StreamGates_FullMethodName       = "/qubit_engine.QuantumCompute/StreamGates"
+	QuantumCompute_VisualizeCircuit_FullMethodName  = "/qubit_engine.QuantumCompute/VisualizeCircuit"
+	QuantumCompute_RunVQE_FullMethodName            = "/qubit_engine.QuantumCompute/RunVQE"
+	QuantumCompute_EstimateResources_FullMethodName = "/qubit_engine.QuantumCompute/EstimateResources"
+)
+
+// QuantumComputeClient is the client API for QuantumCompute service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuantumComputeClient interface {
+	// Synchronous run for small to medium circuits.
+	RunCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*StateResponse, error)
+	// Streaming method for large or interactive circuits.
+	// Sends a stream of gates and receives a stream of FULL STATE VECTORS.
+	StreamGates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GateOperation, StateResponse], error)
+	// Visualization method for Web (Server-Side Streaming only).
+	// gRPC-Web does not support bidirectional streaming.
+	// This executes a circuit and streams back the state after EACH step.
+	VisualizeCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StateResponse], error)
+	// VQE Simulation for Quantum Chemistry
+	RunVQE(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEResponse], error)
+	// Predicts what a circuit would cost to run, without running it.
+	EstimateResources(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*ResourceEstimate, error)
+}
+
+type quantumComputeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuantumComputeClient(cc grpc.ClientConnInterface) QuantumComputeClient {
+	return &quantumComputeClient{cc}
+}
+
+func (c *quantumComputeClient) RunCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*StateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StateResponse)
+	err := c.cc.Invoke(ctx, QuantumCompute_RunCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quantumComputeClient) StreamGates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GateOperation, StateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumCompute_ServiceDesc.Streams[0], QuantumCompute_StreamGates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GateOperation, StateResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_StreamGatesClient = grpc.BidiStreamingClient[GateOperation, StateResponse]
+
+func (c *quantumComputeClient) VisualizeCircuit(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumCompute_ServiceDesc.Streams[1], QuantumCompute_VisualizeCircuit_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CircuitRequest, StateResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_VisualizeCircuitClient = grpc.ServerStreamingClient[StateResponse]
+
+func (c *quantumComputeClient) RunVQE(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuantumCompute_ServiceDesc.Streams[2], QuantumCompute_RunVQE_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VQERequest, VQEResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_RunVQEClient = grpc.ServerStreamingClient[VQEResponse]
+
+func (c *quantumComputeClient) EstimateResources(ctx context.Context, in *CircuitRequest, opts ...grpc.CallOption) (*ResourceEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceEstimate)
+	err := c.cc.Invoke(ctx, QuantumCompute_EstimateResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuantumComputeServer is the server API for QuantumCompute service.
+// All implementations must embed UnimplementedQuantumComputeServer
+// for forward compatibility.
+type QuantumComputeServer interface {
+	// Synchronous run for small to medium circuits.
+	RunCircuit(context.Context, *CircuitRequest) (*StateResponse, error)
+	// Streaming method for large or interactive circuits.
+	// Sends a stream of gates and receives a stream of FULL STATE VECTORS.
+	StreamGates(grpc.BidiStreamingServer[GateOperation, StateResponse]) error
+	// Visualization method for Web (Server-Side Streaming only).
+	// gRPC-Web does not support bidirectional streaming.
+	// This executes a circuit and streams back the state after EACH step.
+	VisualizeCircuit(*CircuitRequest, grpc.ServerStreamingServer[StateResponse]) error
+	// VQE Simulation for Quantum Chemistry
+	RunVQE(*VQERequest, grpc.ServerStreamingServer[VQEResponse]) error
+	// Predicts what a circuit would cost to run, without running it.
+	EstimateResources(context.Context, *CircuitRequest) (*ResourceEstimate, error)
+	mustEmbedUnimplementedQuantumComputeServer()
+}
+
+// UnimplementedQuantumComputeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuantumComputeServer struct{}
+
+func (UnimplementedQuantumComputeServer) RunCircuit(context.Context, *CircuitRequest) (*StateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunCircuit not implemented")
+}
+func (UnimplementedQuantumComputeServer) StreamGates(grpc.BidiStreamingServer[GateOperation, StateResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamGates not implemented")
+}
+func (UnimplementedQuantumComputeServer) VisualizeCircuit(*CircuitRequest, grpc.ServerStreamingServer[StateResponse]) error {
+	return status.Error(codes.Unimplemented, "method VisualizeCircuit not implemented")
+}
+func (UnimplementedQuantumComputeServer) RunVQE(*VQERequest, grpc.ServerStreamingServer[VQEResponse]) error {
+	return status.Error(codes.Unimplemented, "method RunVQE not implemented")
+}
+func (UnimplementedQuantumComputeServer) EstimateResources(context.Context, *CircuitRequest) (*ResourceEstimate, error) {
+	return nil, status.Error(codes.Unimplemented, "method EstimateResources not implemented")
+}
+func (UnimplementedQuantumComputeServer) mustEmbedUnimplementedQuantumComputeServer() {}
+func (UnimplementedQuantumComputeServer) testEmbeddedByValue()                        {}
+
+// UnsafeQuantumComputeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuantumComputeServer will
+// result in compilation errors.
+type UnsafeQuantumComputeServer interface {
+	mustEmbedUnimplementedQuantumComputeServer()
+}
+
+func RegisterQuantumComputeServer(s grpc.ServiceRegistrar, srv QuantumComputeServer) {
+	// If the following call panics, it indicates UnimplementedQuantumComputeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuantumCompute_ServiceDesc, srv)
+}
+
+func _QuantumCompute_RunCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumComputeServer).RunCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCompute_RunCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumComputeServer).RunCircuit(ctx, req.(*CircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuantumCompute_StreamGates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QuantumComputeServer).StreamGates(&grpc.GenericServerStream[GateOperation, StateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_StreamGatesServer = grpc.BidiStreamingServer[GateOperation, StateResponse]
+
+func _QuantumCompute_VisualizeCircuit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CircuitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumComputeServer).VisualizeCircuit(m, &grpc.GenericServerStream[CircuitRequest, StateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_VisualizeCircuitServer = grpc.ServerStreamingServer[StateResponse]
+
+func _QuantumCompute_RunVQE_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VQERequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuantumComputeServer).RunVQE(m, &grpc.GenericServerStream[VQERequest, VQEResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuantumCompute_RunVQEServer = grpc.ServerStreamingServer[VQEResponse]
+
+func _QuantumCompute_EstimateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuantumComputeServer).EstimateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuantumCompute_EstimateResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuantumComputeServer).EstimateResources(ctx, req.(*CircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuantumCompute_ServiceDesc is the grpc.ServiceDesc for QuantumCompute service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuantumCompute_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.QuantumCompute",
+	HandlerType: (*QuantumComputeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunCircuit",
+			Handler:    _QuantumCompute_RunCircuit_Handler,
+		},
+		{
+			MethodName: "EstimateResources",
+			Handler:    _QuantumCompute_EstimateResources_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGates",
+			Handler:       _QuantumCompute_StreamGates_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "VisualizeCircuit",
+			Handler:       _QuantumCompute_VisualizeCircuit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RunVQE",
+			Handler:       _QuantumCompute_RunVQE_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/quantum.proto",
+}
@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.0
+// source: api/proto/physics/vqe.proto
+
+package generated
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VQESolver_FindGroundState_FullMethodName          = "/qubit_engine.physics.VQESolver/FindGroundState"
+	VQESolver_GetMoleculeLibrary_FullMethodName       = "/qubit_engine.physics.VQESolver/GetMoleculeLibrary"
+	VQESolver_BuildHamiltonian_FullMethodName         = "/qubit_engine.physics.VQESolver/BuildHamiltonian"
+	VQESolver_ImportHamiltonian_FullMethodName        = "/qubit_engine.physics.VQESolver/ImportHamiltonian"
+	VQESolver_BuildSpinModel_FullMethodName           = "/qubit_engine.physics.VQESolver/BuildSpinModel"
+	VQESolver_EvaluateExpectation_FullMethodName      = "/qubit_engine.physics.VQESolver/EvaluateExpectation"
+	VQESolver_EvaluateExpectationBatch_FullMethodName = "/qubit_engine.physics.VQESolver/EvaluateExpectationBatch"
+	VQESolver_ScanBondLength_FullMethodName           = "/qubit_engine.physics.VQESolver/ScanBondLength"
+	VQESolver_GetAnsatzCircuit_FullMethodName         = "/qubit_engine.physics.VQESolver/GetAnsatzCircuit"
+	VQESolver_FindExcitedStates_FullMethodName        = "/qubit_engine.physics.VQESolver/FindExcitedStates"
+	VQESolver_SimulateTimeEvolution_FullMethodName    = "/qubit_engine.physics.VQESolver/SimulateTimeEvolution"
+)
+
+// VQESolverClient is the client API for VQESolver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VQESolverClient interface {
+	// Run VQE optimization to find ground state
+	FindGroundState(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEIteration], error)
+	// Get predefined molecule configurations
+	GetMoleculeLibrary(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MoleculeLibrary, error)
+	// Build a custom Hamiltonian
+	BuildHamiltonian(ctx context.Context, in *MoleculeConfig, opts ...grpc.CallOption) (*Hamiltonian, error)
+	// Import a qubit Hamiltonian (OpenFermion JSON or "0.5 Z0 Z1" text)
+	ImportHamiltonian(ctx context.Context, in *ImportHamiltonianRequest, opts ...grpc.CallOption) (*Hamiltonian, error)
+	// Build a transverse-field Ising, Heisenberg or XY chain
+	BuildSpinModel(ctx context.Context, in *SpinModelConfig, opts ...grpc.CallOption) (*Hamiltonian, error)
+	// Evaluate expectation value for a given ansatz
+	EvaluateExpectation(ctx context.Context, in *ExpectationRequest, opts ...grpc.CallOption) (*ExpectationResult, error)
+	// Evaluate many parameter vectors and/or observables in one call
+	EvaluateExpectationBatch(ctx context.Context, in *ExpectationBatchRequest, opts ...grpc.CallOption) (*ExpectationBatchResult, error)
+	// Run VQE along a diatomic's bond length (dissociation curve)
+	ScanBondLength(ctx context.Context, in *BondScanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BondScanPoint], error)
+	// Inspect the ansatz circuit or export it as OpenQASM
+	GetAnsatzCircuit(ctx context.Context, in *AnsatzCircuitRequest, opts ...grpc.CallOption) (*AnsatzCircuitInfo, error)
+	// Find the lowest eigenstates by variational quantum deflation
+	FindExcitedStates(ctx context.Context, in *ExcitedStatesRequest, opts ...grpc.CallOption) (*ExcitedStatesResult, error)
+	// Trotterized time evolution, streaming observables over time
+	SimulateTimeEvolution(ctx context.Context, in *TimeEvolutionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TimeEvolutionFrame], error)
+}
+
+type vQESolverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVQESolverClient(cc grpc.ClientConnInterface) VQESolverClient {
+	return &vQESolverClient{cc}
+}
+
+func (c *vQESolverClient) FindGroundState(ctx context.Context, in *VQERequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VQEIteration], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VQESolver_ServiceDesc.Streams[0], VQESolver_FindGroundState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VQERequest, VQEIteration]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VQESolver_FindGroundStateClient = grpc.ServerStreamingClient[VQEIteration]
+
+func (c *vQESolverClient) GetMoleculeLibrary(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MoleculeLibrary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoleculeLibrary)
+	err := c.cc.Invoke(ctx, VQESolver_GetMoleculeLibrary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) BuildHamiltonian(ctx context.Context, in *MoleculeConfig, opts ...grpc.CallOption) (*Hamiltonian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hamiltonian)
+	err := c.cc.Invoke(ctx, VQESolver_BuildHamiltonian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) ImportHamiltonian(ctx context.Context, in *ImportHamiltonianRequest, opts ...grpc.CallOption) (*Hamiltonian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hamiltonian)
+	err := c.cc.Invoke(ctx, VQESolver_ImportHamiltonian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) BuildSpinModel(ctx context.Context, in *SpinModelConfig, opts ...grpc.CallOption) (*Hamiltonian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hamiltonian)
+	err := c.cc.Invoke(ctx, VQESolver_BuildSpinModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) EvaluateExpectation(ctx context.Context, in *ExpectationRequest, opts ...grpc.CallOption) (*ExpectationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpectationResult)
+	err := c.cc.Invoke(ctx, VQESolver_EvaluateExpectation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) EvaluateExpectationBatch(ctx context.Context, in *ExpectationBatchRequest, opts ...grpc.CallOption) (*ExpectationBatchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpectationBatchResult)
+	err := c.cc.Invoke(ctx, VQESolver_EvaluateExpectationBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) ScanBondLength(ctx context.Context, in *BondScanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BondScanPoint], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VQESolver_ServiceDesc.Streams[1], VQESolver_ScanBondLength_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BondScanRequest, BondScanPoint]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VQESolver_ScanBondLengthClient = grpc.ServerStreamingClient[BondScanPoint]
+
+func (c *vQESolverClient) GetAnsatzCircuit(ctx context.Context, in *AnsatzCircuitRequest, opts ...grpc.CallOption) (*AnsatzCircuitInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnsatzCircuitInfo)
+	err := c.cc.Invoke(ctx, VQESolver_GetAnsatzCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) FindExcitedStates(ctx context.Context, in *ExcitedStatesRequest, opts ...grpc.CallOption) (*ExcitedStatesResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExcitedStatesResult)
+	err := c.cc.Invoke(ctx, VQESolver_FindExcitedStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vQESolverClient) SimulateTimeEvolution(ctx context.Context, in *TimeEvolutionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TimeEvolutionFrame], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VQESolver_ServiceDesc.Streams[2], VQESolver_SimulateTimeEvolution_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TimeEvolutionRequest, TimeEvolutionFrame]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VQESolver_SimulateTimeEvolutionClient = grpc.ServerStreamingClient[TimeEvolutionFrame]
+
+// VQESolverServer is the server API for VQESolver service.
+// All implementations must embed UnimplementedVQESolverServer
+// for forward compatibility.
+type VQESolverServer interface {
+	// Run VQE optimization to find ground state
+	FindGroundState(*VQERequest, grpc.ServerStreamingServer[VQEIteration]) error
+	// Get predefined molecule configurations
+	GetMoleculeLibrary(context.Context, *Empty) (*MoleculeLibrary, error)
+	// Build a custom Hamiltonian
+	BuildHamiltonian(context.Context, *MoleculeConfig) (*Hamiltonian, error)
+	// Import a qubit Hamiltonian (OpenFermion JSON or "0.5 Z0 Z1" text)
+	ImportHamiltonian(context.Context, *ImportHamiltonianRequest) (*Hamiltonian, error)
+	// Build a transverse-field Ising, Heisenberg or XY chain
+	BuildSpinModel(context.Context, *SpinModelConfig) (*Hamiltonian, error)
+	// Evaluate expectation value for a given ansatz
+	EvaluateExpectation(context.Context, *ExpectationRequest) (*ExpectationResult, error)
+	// Evaluate many parameter vectors and/or observables in one call
+	EvaluateExpectationBatch(context.Context, *ExpectationBatchRequest) (*ExpectationBatchResult, error)
+	// Run VQE along a diatomic's bond length (dissociation curve)
+	ScanBondLength(*BondScanRequest, grpc.ServerStreamingServer[BondScanPoint]) error
+	// Inspect the ansatz circuit or export it as OpenQASM
+	GetAnsatzCircuit(context.Context, *AnsatzCircuitRequest) (*AnsatzCircuitInfo, error)
+	// Find the lowest eigenstates by variational quantum deflation
+	FindExcitedStates(context.Context, *ExcitedStatesRequest) (*ExcitedStatesResult, error)
+	// Trotterized time evolution, streaming observables over time
+	SimulateTimeEvolution(*TimeEvolutionRequest, grpc.ServerStreamingServer[TimeEvolutionFrame]) error
+	mustEmbedUnimplementedVQESolverServer()
+}
+
+// UnimplementedVQESolverServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVQESolverServer struct{}
+
+func (UnimplementedVQESolverServer) FindGroundState(*VQERequest, grpc.ServerStreamingServer[VQEIteration]) error {
+	return status.Error(codes.Unimplemented, "method FindGroundState not implemented")
+}
+func (UnimplementedVQESolverServer) GetMoleculeLibrary(context.Context, *Empty) (*MoleculeLibrary, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMoleculeLibrary not implemented")
+}
+func (UnimplementedVQESolverServer) BuildHamiltonian(context.Context, *MoleculeConfig) (*Hamiltonian, error) {
+	return nil, status.Error(codes.Unimplemented, "method BuildHamiltonian not implemented")
+}
+func (UnimplementedVQESolverServer) ImportHamiltonian(context.Context, *ImportHamiltonianRequest) (*Hamiltonian, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportHamiltonian not implemented")
+}
+func (UnimplementedVQESolverServer) BuildSpinModel(context.Context, *SpinModelConfig) (*Hamiltonian, error) {
+	return nil, status.Error(codes.Unimplemented, "method BuildSpinModel not implemented")
+}
+func (UnimplementedVQESolverServer) EvaluateExpectation(context.Context, *ExpectationRequest) (*ExpectationResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateExpectation not implemented")
+}
+func (UnimplementedVQESolverServer) EvaluateExpectationBatch(context.Context, *ExpectationBatchRequest) (*ExpectationBatchResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateExpectationBatch not implemented")
+}
+func (UnimplementedVQESolverServer) ScanBondLength(*BondScanRequest, grpc.ServerStreamingServer[BondScanPoint]) error {
+	return status.Error(codes.Unimplemented, "method ScanBondLength not implemented")
+}
+func (UnimplementedVQESolverServer) GetAnsatzCircuit(context.Context, *AnsatzCircuitRequest) (*AnsatzCircuitInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnsatzCircuit not implemented")
+}
+func (UnimplementedVQESolverServer) FindExcitedStates(context.Context, *ExcitedStatesRequest) (*ExcitedStatesResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindExcitedStates not implemented")
+}
+func (UnimplementedVQESolverServer) SimulateTimeEvolution(*TimeEvolutionRequest, grpc.ServerStreamingServer[TimeEvolutionFrame]) error {
+	return status.Error(codes.Unimplemented, "method SimulateTimeEvolution not implemented")
+}
+func (UnimplementedVQESolverServer) mustEmbedUnimplementedVQESolverServer() {}
+func (UnimplementedVQESolverServer) testEmbeddedByValue()                   {}
+
+// UnsafeVQESolverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VQESolverServer will
+// result in compilation errors.
+type UnsafeVQESolverServer interface {
+	mustEmbedUnimplementedVQESolverServer()
+}
+
+func RegisterVQESolverServer(s grpc.ServiceRegistrar, srv VQESolverServer) {
+	// If the following call panics, it indicates UnimplementedVQESolverServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VQESolver_ServiceDesc, srv)
+}
+
+func _VQESolver_FindGroundState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VQERequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VQESolverServer).FindGroundState(m, &grpc.GenericServerStream[VQERequest, VQEIteration]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VQESolver_FindGroundStateServer = grpc.ServerStreamingServer[VQEIteration]
+
+func _VQESolver_GetMoleculeLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).GetMoleculeLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_GetMoleculeLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).GetMoleculeLibrary(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_BuildHamiltonian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoleculeConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).BuildHamiltonian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_BuildHamiltonian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).BuildHamiltonian(ctx, req.(*MoleculeConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_ImportHamiltonian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHamiltonianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).ImportHamiltonian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_ImportHamiltonian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).ImportHamiltonian(ctx, req.(*ImportHamiltonianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_BuildSpinModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpinModelConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).BuildSpinModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_BuildSpinModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).BuildSpinModel(ctx, req.(*SpinModelConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_EvaluateExpectation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpectationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).EvaluateExpectation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_EvaluateExpectation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).EvaluateExpectation(ctx, req.(*ExpectationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_EvaluateExpectationBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpectationBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).EvaluateExpectationBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_EvaluateExpectationBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).EvaluateExpectationBatch(ctx, req.(*ExpectationBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_ScanBondLength_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BondScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VQESolverServer).ScanBondLength(m, &grpc.GenericServerStream[BondScanRequest, BondScanPoint]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VQESolver_ScanBondLengthServer = grpc.ServerStreamingServer[BondScanPoint]
+
+func _VQESolver_GetAnsatzCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnsatzCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).GetAnsatzCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_GetAnsatzCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).GetAnsatzCircuit(ctx, req.(*AnsatzCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_FindExcitedStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExcitedStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VQESolverServer).FindExcitedStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VQESolver_FindExcitedStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VQESolverServer).FindExcitedStates(ctx, req.(*ExcitedStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VQESolver_SimulateTimeEvolution_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TimeEvolutionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VQESolverServer).SimulateTimeEvolution(m, &grpc.GenericServerStream[TimeEvolutionRequest, TimeEvolutionFrame]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VQESolver_SimulateTimeEvolutionServer = grpc.ServerStreamingServer[TimeEvolutionFrame]
+
+// VQESolver_ServiceDesc is the grpc.ServiceDesc for VQESolver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VQESolver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubit_engine.physics.VQESolver",
+	HandlerType: (*VQESolverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMoleculeLibrary",
+			Handler:    _VQESolver_GetMoleculeLibrary_Handler,
+		},
+		{
+			MethodName: "BuildHamiltonian",
+			Handler:    _VQESolver_BuildHamiltonian_Handler,
+		},
+		{
+			MethodName: "ImportHamiltonian",
+			Handler:    _VQESolver_ImportHamiltonian_Handler,
+		},
+		{
+			MethodName: "BuildSpinModel",
+			Handler:    _VQESolver_BuildSpinModel_Handler,
+		},
+		{
+			MethodName: "EvaluateExpectation",
+			Handler:    _VQESolver_EvaluateExpectation_Handler,
+		},
+		{
+			MethodName: "EvaluateExpectationBatch",
+			Handler:    _VQESolver_EvaluateExpectationBatch_Handler,
+		},
+		{
+			MethodName: "GetAnsatzCircuit",
+			Handler:    _VQESolver_GetAnsatzCircuit_Handler,
+		},
+		{
+			MethodName: "FindExcitedStates",
+			Handler:    _VQESolver_FindExcitedStates_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FindGroundState",
+			Handler:       _VQESolver_FindGroundState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanBondLength",
+			Handler:       _VQESolver_ScanBondLength_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SimulateTimeEvolution",
+			Handler:       _VQESolver_SimulateTimeEvolution_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/physics/vqe.proto",
+}
@@ -0,0 +1,466 @@
+// Restricted Hartree-Fock
+// Solves the Roothaan equations FC = SCε in the STO-3G basis (with DIIS
+// extrapolation) and transforms the one- and two-electron integrals to the
+// resulting molecular orbitals, which is what the qubit Hamiltonian needs.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+const (
+	scfMaxIterations = 200
+	scfEnergyTol     = 1e-10
+	scfDensityTol    = 1e-8
+	diisSize         = 8
+)
+
+// minAtomSeparation rejects geometries with overlapping nuclei (Å)
+const minAtomSeparation = 0.1
+
+// ValidateMolecule checks a molecule's basis, atoms, charge and spin
+// before any integrals are computed, and returns its electron count
+func ValidateMolecule(config *pb.MoleculeConfig) (int, error) {
+	if basis := strings.ToLower(config.BasisSet); basis != "" && basis != "sto-3g" {
+		return 0, fmt.Errorf("basis set %q is not supported (use sto-3g, or supply integrals)", config.BasisSet)
+	}
+	if len(config.Atoms) == 0 {
+		return 0, errors.New("molecule has no atoms")
+	}
+
+	electrons := -int(config.Charge)
+	orbitals := 0
+	for i, a := range config.Atoms {
+		if a == nil {
+			return 0, fmt.Errorf("atom %d is empty", i)
+		}
+		el, _, ok := lookupElement(a.Element)
+		if !ok {
+			return 0, fmt.Errorf("atom %d: element %q has no STO-3G basis (supported: H, He, Li, Be, B, C, N, O, F, Ne)", i, a.Element)
+		}
+		for _, v := range []float64{a.X, a.Y, a.Z} {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return 0, fmt.Errorf("atom %d (%s) has a non-finite coordinate", i, a.Element)
+			}
+		}
+		for j, b := range config.Atoms[:i] {
+			if d := distance([]float64{a.X, a.Y, a.Z}, []float64{b.X, b.Y, b.Z}); d < minAtomSeparation {
+				return 0, fmt.Errorf("atoms %d (%s) and %d (%s) are %.3f Å apart", j, b.Element, i, a.Element, d)
+			}
+		}
+		electrons += el.Z
+		orbitals++
+		if el.Zeta2sp > 0 {
+			orbitals += 4 // 2s, 2px, 2py, 2pz
+		}
+	}
+
+	if electrons <= 0 {
+		return 0, fmt.Errorf("charge %d leaves %d electrons", config.Charge, electrons)
+	}
+	if electrons > 2*orbitals {
+		return 0, fmt.Errorf("%d electrons do not fit in %d orbitals", electrons, orbitals)
+	}
+	if 2*orbitals > maxQubits {
+		return 0, fmt.Errorf("%d STO-3G orbitals need %d qubits; the simulator supports at most %d",
+			orbitals, 2*orbitals, maxQubits)
+	}
+
+	// Multiplicity 2S+1 counts unpaired electrons plus one (0 = singlet)
+	multiplicity := int(config.Multiplicity)
+	if multiplicity == 0 {
+		multiplicity = 1
+	}
+	unpaired := multiplicity - 1
+	if unpaired < 0 || unpaired > electrons || unpaired%2 != electrons%2 {
+		return 0, fmt.Errorf("multiplicity %d is impossible with %d electrons", config.Multiplicity, electrons)
+	}
+	if unpaired > 0 {
+		return 0, fmt.Errorf("multiplicity %d is open-shell; restricted Hartree-Fock needs a closed-shell singlet", multiplicity)
+	}
+	return electrons, nil
+}
+
+// ComputeIntegrals runs RHF on a molecule and returns its MO integrals
+func ComputeIntegrals(config *pb.MoleculeConfig) (*pb.ElectronicIntegrals, error) {
+	electrons, err := ValidateMolecule(config)
+	if err != nil {
+		return nil, err
+	}
+	basis, nuclei, err := sto3gBasis(config.Atoms)
+	if err != nil {
+		return nil, err
+	}
+
+	ao := computeAOIntegrals(basis, nuclei)
+	scf, err := restrictedHartreeFock(ao, electrons)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("⚛️ RHF for %s: E=%.8f Ha after %d iterations (%d orbitals, %d electrons)",
+		config.Name, scf.Energy, scf.Iterations, len(basis), electrons)
+
+	return moIntegrals(ao, scf.Coeffs, electrons), nil
+}
+
+// aoIntegrals holds the atomic-orbital integrals of a basis
+type aoIntegrals struct {
+	N       int
+	S       [][]float64 // Overlap
+	H       [][]float64 // Core Hamiltonian T + V
+	ERI     []float64   // (ij|kl) at ((i·N+j)·N+k)·N+l
+	Nuclear float64     // Nuclear repulsion
+}
+
+func computeAOIntegrals(basis []*basisFunction, nuclei []nucleus) *aoIntegrals {
+	n := len(basis)
+	ao := &aoIntegrals{N: n, S: newMatrix(n), H: newMatrix(n), ERI: make([]float64, n*n*n*n)}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			s := overlapIntegral(basis[i], basis[j])
+			h := kineticIntegral(basis[i], basis[j]) + nuclearIntegral(basis[i], basis[j], nuclei)
+			ao.S[i][j], ao.S[j][i] = s, s
+			ao.H[i][j], ao.H[j][i] = h, h
+		}
+	}
+
+	// 8-fold permutational symmetry: compute ij ≥ kl with i ≥ j, k ≥ l
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			for k := 0; k <= i; k++ {
+				for l := 0; l <= k; l++ {
+					if i*(i+1)/2+j < k*(k+1)/2+l {
+						continue
+					}
+					v := repulsionIntegral(basis[i], basis[j], basis[k], basis[l])
+					for _, idx := range [][4]int{
+						{i, j, k, l}, {j, i, k, l}, {i, j, l, k}, {j, i, l, k},
+						{k, l, i, j}, {l, k, i, j}, {k, l, j, i}, {l, k, j, i},
+					} {
+						ao.ERI[((idx[0]*n+idx[1])*n+idx[2])*n+idx[3]] = v
+					}
+				}
+			}
+		}
+	}
+
+	for i, a := range nuclei {
+		for _, b := range nuclei[i+1:] {
+			ao.Nuclear += a.Charge * b.Charge / distance(a.Pos[:], b.Pos[:])
+		}
+	}
+	return ao
+}
+
+// scfResult is a converged RHF solution
+type scfResult struct {
+	Energy          float64     // Total energy including nuclear repulsion
+	Coeffs          [][]float64 // AO × MO, columns ordered by orbital energy
+	OrbitalEnergies []float64
+	Iterations      int
+}
+
+// restrictedHartreeFock iterates from the core-Hamiltonian guess
+func restrictedHartreeFock(ao *aoIntegrals, electrons int) (*scfResult, error) {
+	n, occupied := ao.N, electrons/2
+
+	// Symmetric orthogonalization X = S^(-1/2)
+	sVals, sVecs := symmetricEigen(ao.S)
+	if sVals[0] < 1e-8 {
+		return nil, errors.New("basis is linearly dependent (atoms too close)")
+	}
+	x := newMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				x[i][j] += sVecs[i][k] * sVecs[j][k] / math.Sqrt(sVals[k])
+			}
+		}
+	}
+
+	solve := func(f [][]float64) ([]float64, [][]float64) {
+		vals, vecs := symmetricEigen(matMul(transpose(x), matMul(f, x)))
+		return vals, matMul(x, vecs)
+	}
+	density := func(c [][]float64, occupation []float64) [][]float64 {
+		d := newMatrix(n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				for a, occ := range occupation {
+					d[i][j] += occ * c[i][a] * c[j][a]
+				}
+			}
+		}
+		return d
+	}
+	aufbau := make([]float64, occupied)
+	for a := range aufbau {
+		aufbau[a] = 2
+	}
+
+	eps, c := solve(ao.H)
+	d := density(c, guessOccupation(eps, electrons))
+	prevEnergy := 0.0
+	var fockHistory, errorHistory [][][]float64
+
+	for iter := 1; iter <= scfMaxIterations; iter++ {
+		f := fockMatrix(ao, d)
+		energy := ao.Nuclear
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				energy += 0.5 * d[i][j] * (ao.H[i][j] + f[i][j])
+			}
+		}
+
+		// DIIS: extrapolate F from the commutator errors FDS − SDF
+		fds := matMul(f, matMul(d, ao.S))
+		errMat := newMatrix(n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				errMat[i][j] = fds[i][j] - fds[j][i]
+			}
+		}
+		fockHistory, errorHistory = append(fockHistory, f), append(errorHistory, errMat)
+		if len(fockHistory) > diisSize {
+			fockHistory, errorHistory = fockHistory[1:], errorHistory[1:]
+		}
+		if len(fockHistory) > 2 {
+			f = diisExtrapolate(fockHistory, errorHistory)
+		}
+
+		eps, c = solve(f)
+		next := density(c, aufbau)
+		change := 0.0
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				change = math.Max(change, math.Abs(next[i][j]-d[i][j]))
+			}
+		}
+		d = next
+
+		if math.Abs(energy-prevEnergy) < scfEnergyTol && change < scfDensityTol {
+			return &scfResult{Energy: energy, Coeffs: c, OrbitalEnergies: eps, Iterations: iter}, nil
+		}
+		prevEnergy = energy
+	}
+	return nil, fmt.Errorf("Hartree-Fock did not converge in %d iterations", scfMaxIterations)
+}
+
+// guessOccupation fills orbitals in energy order, but shares the electrons
+// at the Fermi level equally across a degenerate set. Occupying only one of
+// a degenerate pair (the π orbitals of N2, say) breaks the symmetry and can
+// trap the SCF in an excited solution.
+func guessOccupation(eps []float64, electrons int) []float64 {
+	occ := make([]float64, len(eps))
+	remaining := float64(electrons)
+	for start := 0; start < len(eps) && remaining > 0; {
+		end := start + 1
+		for end < len(eps) && eps[end]-eps[start] < 1e-6 {
+			end++
+		}
+		share := math.Min(2, remaining/float64(end-start))
+		for a := start; a < end; a++ {
+			occ[a] = share
+		}
+		remaining -= share * float64(end-start)
+		start = end
+	}
+	return occ
+}
+
+// fockMatrix is F = H + Σ D_kl [(ij|kl) − ½(ik|jl)]
+func fockMatrix(ao *aoIntegrals, d [][]float64) [][]float64 {
+	n := ao.N
+	f := newMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			f[i][j] = ao.H[i][j]
+			for k := 0; k < n; k++ {
+				for l := 0; l < n; l++ {
+					f[i][j] += d[k][l] * (ao.ERI[((i*n+j)*n+k)*n+l] - 0.5*ao.ERI[((i*n+k)*n+j)*n+l])
+				}
+			}
+		}
+	}
+	return f
+}
+
+// diisExtrapolate mixes past Fock matrices with weights that minimize the
+// extrapolated error subject to Σ w = 1
+func diisExtrapolate(focks, errs [][][]float64) [][]float64 {
+	m := len(focks)
+	b := make([][]float64, m+1)
+	rhs := make([]float64, m+1)
+	for i := range b {
+		b[i] = make([]float64, m+1)
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			for r := range errs[i] {
+				b[i][j] += dot(errs[i][r], errs[j][r])
+			}
+		}
+		b[i][m], b[m][i] = -1, -1
+	}
+	rhs[m] = -1
+
+	w, ok := solveLinear(b, rhs)
+	if !ok {
+		return focks[m-1]
+	}
+	n := len(focks[0])
+	f := newMatrix(n)
+	for k := 0; k < m; k++ {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				f[i][j] += w[k] * focks[k][i][j]
+			}
+		}
+	}
+	return f
+}
+
+// moIntegrals transforms h and (ij|kl) to the MO basis, one index at a time
+func moIntegrals(ao *aoIntegrals, c [][]float64, electrons int) *pb.ElectronicIntegrals {
+	n := ao.N
+	h := matMul(transpose(c), matMul(ao.H, c))
+
+	eri := ao.ERI
+	for pos := 0; pos < 4; pos++ {
+		next := make([]float64, len(eri))
+		stride := 1
+		for s := pos + 1; s < 4; s++ {
+			stride *= n
+		}
+		for idx := range next {
+			p := (idx / stride) % n
+			base := idx - p*stride
+			sum := 0.0
+			for mu := 0; mu < n; mu++ {
+				sum += c[mu][p] * eri[base+mu*stride]
+			}
+			next[idx] = sum
+		}
+		eri = next
+	}
+
+	ints := &pb.ElectronicIntegrals{
+		NumOrbitals:      int32(n),
+		NumElectrons:     int32(electrons),
+		NuclearRepulsion: ao.Nuclear,
+		TwoBody:          eri,
+	}
+	for _, row := range h {
+		ints.OneBody = append(ints.OneBody, row...)
+	}
+	return ints
+}
+
+// ------------------------------------------------------------------
+// Dense Matrix Helpers
+// ------------------------------------------------------------------
+
+func newMatrix(n int) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+	}
+	return m
+}
+
+func matMul(a, b [][]float64) [][]float64 {
+	out := make([][]float64, len(a))
+	for i := range a {
+		out[i] = make([]float64, len(b[0]))
+		for k := range b {
+			if a[i][k] == 0 {
+				continue
+			}
+			for j := range b[0] {
+				out[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return out
+}
+
+func transpose(a [][]float64) [][]float64 {
+	out := make([][]float64, len(a[0]))
+	for j := range out {
+		out[j] = make([]float64, len(a))
+		for i := range a {
+			out[j][i] = a[i][j]
+		}
+	}
+	return out
+}
+
+// symmetricEigen diagonalizes a symmetric matrix by cyclic Jacobi
+// rotations. Eigenvalues ascend; column k of vecs is eigenvector k.
+func symmetricEigen(m [][]float64) ([]float64, [][]float64) {
+	n := len(m)
+	a := newMatrix(n)
+	v := newMatrix(n)
+	for i := range a {
+		copy(a[i], m[i])
+		v[i][i] = 1
+	}
+
+	for sweep := 0; sweep < 100; sweep++ {
+		off := 0.0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i][j] * a[i][j]
+			}
+		}
+		if off < 1e-24 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if math.Abs(a[p][q]) < 1e-300 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				cos := 1 / math.Sqrt(t*t+1)
+				sin := t * cos
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p], a[k][q] = cos*akp-sin*akq, sin*akp+cos*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k], a[q][k] = cos*apk-sin*aqk, sin*apk+cos*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p], v[k][q] = cos*vkp-sin*vkq, sin*vkp+cos*vkq
+				}
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool { return a[order[x]][order[x]] < a[order[y]][order[y]] })
+	vals := make([]float64, n)
+	vecs := newMatrix(n)
+	for k, col := range order {
+		vals[k] = a[col][col]
+		for i := 0; i < n; i++ {
+			vecs[i][k] = v[i][col]
+		}
+	}
+	return vals, vecs
+}
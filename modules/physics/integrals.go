@@ -0,0 +1,165 @@
+// Electronic Integral Input
+// Hamiltonians can be built from precomputed molecular-orbital integrals
+// instead of atoms, in either of two formats:
+//
+//	FCIDUMP  &FCI NORB=2,NELEC=2,MS2=0, … &END followed by "value i j k l"
+//	         lines (1-based; k = l = 0 for h_ij, all zero for the core energy)
+//	JSON     {"num_orbitals": 2, "num_electrons": 2, "nuclear_repulsion": …,
+//	          "one_body": [n² row-major h_pq], "two_body": [n⁴ (pq|rs)]}
+//
+// Two-electron integrals are in chemist notation (pq|rs) over spatial
+// orbitals; FCIDUMP lists each unique integral once and the other seven
+// permutations are filled in.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+// maxIntegralOrbitals bounds input size (2 qubits per spatial orbital)
+const maxIntegralOrbitals = maxQubits / 2
+
+var fcidumpKey = regexp.MustCompile(`(?i)(NORB|NELEC)\s*=\s*(\d+)`)
+
+// ParseIntegrals detects the format of integral text and parses it
+func ParseIntegrals(text string) (*pb.ElectronicIntegrals, error) {
+	trimmed := strings.TrimSpace(text)
+	switch {
+	case strings.HasPrefix(trimmed, "{"):
+		return ParseIntegralsJSON([]byte(trimmed))
+	case strings.HasPrefix(strings.ToUpper(trimmed), "&FCI"):
+		return ParseFCIDUMP(trimmed)
+	}
+	return nil, errors.New("integrals must be FCIDUMP (starting with &FCI) or JSON")
+}
+
+// ParseIntegralsJSON reads the JSON integral format
+func ParseIntegralsJSON(data []byte) (*pb.ElectronicIntegrals, error) {
+	ints := &pb.ElectronicIntegrals{}
+	if err := json.Unmarshal(data, ints); err != nil {
+		return nil, fmt.Errorf("invalid integral JSON: %w", err)
+	}
+	return ints, validateIntegrals(ints)
+}
+
+// ParseFCIDUMP reads the Molpro/PySCF FCIDUMP format
+func ParseFCIDUMP(text string) (*pb.ElectronicIntegrals, error) {
+	upper := strings.ToUpper(text)
+	end := strings.Index(upper, "&END")
+	skip := len("&END")
+	if end < 0 {
+		end = strings.Index(upper, "/\n") // Namelist terminator variant
+		skip = 1
+	}
+	if end < 0 {
+		return nil, errors.New("FCIDUMP header is missing &END")
+	}
+
+	ints := &pb.ElectronicIntegrals{}
+	for _, m := range fcidumpKey.FindAllStringSubmatch(text[:end], -1) {
+		v, _ := strconv.Atoi(m[2])
+		switch strings.ToUpper(m[1]) {
+		case "NORB":
+			ints.NumOrbitals = int32(v)
+		case "NELEC":
+			ints.NumElectrons = int32(v)
+		}
+	}
+	n := int(ints.NumOrbitals)
+	if n <= 0 || n > maxIntegralOrbitals {
+		return nil, fmt.Errorf("FCIDUMP NORB must be 1-%d", maxIntegralOrbitals)
+	}
+	ints.OneBody = make([]float64, n*n)
+	ints.TwoBody = make([]float64, n*n*n*n)
+
+	scanner := bufio.NewScanner(strings.NewReader(text[end+skip:]))
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("FCIDUMP line %d: want \"value i j k l\"", line)
+		}
+		value, err := strconv.ParseFloat(strings.NewReplacer("D", "E", "d", "e").Replace(fields[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("FCIDUMP line %d: %v", line, err)
+		}
+		var idx [4]int
+		for k := range idx {
+			idx[k], err = strconv.Atoi(fields[k+1])
+			if err != nil || idx[k] < 0 || idx[k] > n {
+				return nil, fmt.Errorf("FCIDUMP line %d: orbital index %q out of range", line, fields[k+1])
+			}
+		}
+
+		i, j, k, l := idx[0]-1, idx[1]-1, idx[2]-1, idx[3]-1
+		switch {
+		case idx == [4]int{}:
+			ints.NuclearRepulsion = value
+		case idx[2] == 0 && idx[3] == 0 && idx[1] == 0:
+			// Orbital energy: not part of the Hamiltonian
+		case idx[2] == 0 && idx[3] == 0:
+			ints.OneBody[i*n+j], ints.OneBody[j*n+i] = value, value
+		case idx[0] > 0 && idx[1] > 0 && idx[2] > 0 && idx[3] > 0:
+			for _, p := range [][4]int{
+				{i, j, k, l}, {j, i, k, l}, {i, j, l, k}, {j, i, l, k},
+				{k, l, i, j}, {l, k, i, j}, {k, l, j, i}, {l, k, j, i},
+			} {
+				ints.TwoBody[((p[0]*n+p[1])*n+p[2])*n+p[3]] = value
+			}
+		default:
+			return nil, fmt.Errorf("FCIDUMP line %d: malformed index pattern", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ints, validateIntegrals(ints)
+}
+
+// validateIntegrals checks sizes, electron count and Hermiticity
+func validateIntegrals(ints *pb.ElectronicIntegrals) error {
+	n := int(ints.NumOrbitals)
+	switch {
+	case n <= 0 || n > maxIntegralOrbitals:
+		return fmt.Errorf("num_orbitals must be 1-%d", maxIntegralOrbitals)
+	case ints.NumElectrons <= 0 || int(ints.NumElectrons) > 2*n:
+		return fmt.Errorf("num_electrons must be 1-%d for %d orbitals", 2*n, n)
+	case len(ints.OneBody) != n*n:
+		return fmt.Errorf("one_body has %d values, want %d", len(ints.OneBody), n*n)
+	case len(ints.TwoBody) != n*n*n*n:
+		return fmt.Errorf("two_body has %d values, want %d", len(ints.TwoBody), n*n*n*n)
+	}
+	for p := 0; p < n; p++ {
+		for q := 0; q < n; q++ {
+			if math.Abs(ints.OneBody[p*n+q]-ints.OneBody[q*n+p]) > 1e-8 {
+				return fmt.Errorf("one_body is not symmetric at (%d,%d)", p, q)
+			}
+		}
+	}
+	return nil
+}
+
+// oneElectron returns h_pq
+func oneElectron(ints *pb.ElectronicIntegrals, p, q int) float64 {
+	return ints.OneBody[p*int(ints.NumOrbitals)+q]
+}
+
+// twoElectron returns (pq|rs)
+func twoElectron(ints *pb.ElectronicIntegrals, p, q, r, s int) float64 {
+	n := int(ints.NumOrbitals)
+	return ints.TwoBody[((p*n+q)*n+r)*n+s]
+}
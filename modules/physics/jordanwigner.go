@@ -0,0 +1,200 @@
+// Jordan-Wigner Mapping
+// The second-quantized electronic Hamiltonian
+//
+//	H = Σ h_pq a†_pσ a_qσ + ½ Σ (pq|rs) a†_pσ a†_rτ a_sτ a_qσ
+//
+// is mapped to qubits with spin orbital 2p+σ on qubit 2p+σ (α even, β odd,
+// the convention the UCCSD ansatz assumes) and
+//
+//	a†_j = ½(X_j − iY_j) Z_{j−1} … Z_0      a_j = ½(X_j + iY_j) Z_{j−1} … Z_0
+//
+// so a qubit in |1⟩ is an occupied spin orbital.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/cmplx"
+	"sort"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+// coefficientCutoff drops Pauli terms that are numerically zero
+const coefficientCutoff = 1e-10
+
+// pauliProduct multiplies single-qubit Paulis: a·b = phase·c
+var pauliProduct = map[[2]byte]struct {
+	Result byte
+	Phase  complex128
+}{
+	{'I', 'I'}: {'I', 1}, {'I', 'X'}: {'X', 1}, {'I', 'Y'}: {'Y', 1}, {'I', 'Z'}: {'Z', 1},
+	{'X', 'I'}: {'X', 1}, {'X', 'X'}: {'I', 1}, {'X', 'Y'}: {'Z', 1i}, {'X', 'Z'}: {'Y', -1i},
+	{'Y', 'I'}: {'Y', 1}, {'Y', 'X'}: {'Z', -1i}, {'Y', 'Y'}: {'I', 1}, {'Y', 'Z'}: {'X', 1i},
+	{'Z', 'I'}: {'Z', 1}, {'Z', 'X'}: {'Y', 1i}, {'Z', 'Y'}: {'X', -1i}, {'Z', 'Z'}: {'I', 1},
+}
+
+// qubitOperator is a sum of Pauli strings ("IXYZ" per qubit) with
+// complex coefficients
+type qubitOperator map[string]complex128
+
+// multiply returns a·b
+func (a qubitOperator) multiply(b qubitOperator) qubitOperator {
+	out := make(qubitOperator)
+	for sa, ca := range a {
+		for sb, cb := range b {
+			str := []byte(sa)
+			phase := ca * cb
+			for q := range str {
+				p := pauliProduct[[2]byte{sa[q], sb[q]}]
+				str[q] = p.Result
+				phase *= p.Phase
+			}
+			out[string(str)] += phase
+		}
+	}
+	return out
+}
+
+func (a qubitOperator) addScaled(b qubitOperator, scale complex128) {
+	for s, c := range b {
+		a[s] += scale * c
+	}
+}
+
+// pauliTerms converts a Hermitian operator to real Pauli terms, dropping
+// zeros, ordered by weight and then label
+func (a qubitOperator) pauliTerms() ([]*pb.PauliTerm, error) {
+	var terms []*pb.PauliTerm
+	for str, c := range a {
+		if cmplx.Abs(c) < coefficientCutoff {
+			continue
+		}
+		term := &pb.PauliTerm{Coefficient: real(c), Operators: []*pb.PauliOperator{}}
+		for q, op := range []byte(str) {
+			switch op {
+			case 'X':
+				term.Operators = append(term.Operators, &pb.PauliOperator{Qubit: int32(q), Type: pb.PauliType_PAULI_X})
+			case 'Y':
+				term.Operators = append(term.Operators, &pb.PauliOperator{Qubit: int32(q), Type: pb.PauliType_PAULI_Y})
+			case 'Z':
+				term.Operators = append(term.Operators, &pb.PauliOperator{Qubit: int32(q), Type: pb.PauliType_PAULI_Z})
+			}
+		}
+		if math.Abs(imag(c)) > 1e-8 {
+			return nil, fmt.Errorf("term %s has coefficient %v", pauliLabel(term), c)
+		}
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		a, b := terms[i], terms[j]
+		if len(a.Operators) != len(b.Operators) {
+			return len(a.Operators) < len(b.Operators)
+		}
+		return pauliLabel(a) < pauliLabel(b)
+	})
+	return terms, nil
+}
+
+// ladderOperator is the Jordan-Wigner image of a†_j (create) or a_j
+func ladderOperator(j, numQubits int, create bool) qubitOperator {
+	x := make([]byte, numQubits)
+	for q := range x {
+		switch {
+		case q < j:
+			x[q] = 'Z'
+		default:
+			x[q] = 'I'
+		}
+	}
+	y := append([]byte(nil), x...)
+	x[j], y[j] = 'X', 'Y'
+
+	yCoeff := complex(0, 0.5)
+	if create {
+		yCoeff = -yCoeff
+	}
+	return qubitOperator{string(x): 0.5, string(y): yCoeff}
+}
+
+// HamiltonianFromIntegrals builds the qubit Hamiltonian of an electronic
+// structure problem
+func HamiltonianFromIntegrals(name string, ints *pb.ElectronicIntegrals) (*pb.Hamiltonian, error) {
+	if err := validateIntegrals(ints); err != nil {
+		return nil, err
+	}
+	n := int(ints.NumOrbitals)
+	nq := 2 * n
+
+	create := make([]qubitOperator, nq)
+	annihilate := make([]qubitOperator, nq)
+	for j := 0; j < nq; j++ {
+		create[j] = ladderOperator(j, nq, true)
+		annihilate[j] = ladderOperator(j, nq, false)
+	}
+
+	// Excitation pairs a†_i a_j are shared by the one- and two-body sums
+	pairs := make([][]qubitOperator, nq)
+	for i := range pairs {
+		pairs[i] = make([]qubitOperator, nq)
+		for j := range pairs[i] {
+			pairs[i][j] = create[i].multiply(annihilate[j])
+		}
+	}
+
+	h := make(qubitOperator)
+	for p := 0; p < n; p++ {
+		for q := 0; q < n; q++ {
+			v := oneElectron(ints, p, q)
+			if math.Abs(v) < coefficientCutoff {
+				continue
+			}
+			for sigma := 0; sigma < 2; sigma++ {
+				h.addScaled(pairs[2*p+sigma][2*q+sigma], complex(v, 0))
+			}
+		}
+	}
+
+	// a†_P a†_R a_S a_Q = a†_P a_Q a†_R a_S − δ_QR a†_P a_S
+	for p := 0; p < n; p++ {
+		for q := 0; q < n; q++ {
+			for r := 0; r < n; r++ {
+				for s := 0; s < n; s++ {
+					v := twoElectron(ints, p, q, r, s)
+					if math.Abs(v) < coefficientCutoff {
+						continue
+					}
+					for sigma := 0; sigma < 2; sigma++ {
+						for tau := 0; tau < 2; tau++ {
+							P, Q, R, S := 2*p+sigma, 2*q+sigma, 2*r+tau, 2*s+tau
+							if P == R || Q == S {
+								continue // a†a† or aa on one spin orbital vanishes
+							}
+							h.addScaled(pairs[P][Q].multiply(pairs[R][S]), complex(0.5*v, 0))
+							if Q == R {
+								h.addScaled(pairs[P][S], complex(-0.5*v, 0))
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	terms, err := h.pauliTerms()
+	if err != nil {
+		return nil, fmt.Errorf("integrals are not Hermitian: %w", err)
+	}
+	ham := &pb.Hamiltonian{
+		MoleculeName:     name,
+		NumQubits:        int32(nq),
+		NumElectrons:     ints.NumElectrons,
+		NuclearRepulsion: ints.NuclearRepulsion,
+		Terms:            terms,
+	}
+
+	log.Printf("⚛️ Jordan-Wigner: %d spatial orbitals → %d qubits, %d Pauli terms", n, nq, len(ham.Terms))
+	return ham, nil
+}
@@ -5,30 +5,38 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"math"
 	"math/rand"
 	"net"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
 )
 
 // ------------------------------------------------------------------
 // Molecule Library - Predefined configurations
 // ------------------------------------------------------------------
 
-var moleculeLibrary = map[string]*MoleculePreset{
+var moleculeLibrary = map[string]*pb.MoleculePreset{
 	"H2_equilibrium": {
-		ID:      "H2_equilibrium",
+		Id:      "H2_equilibrium",
 		Name:    "Hydrogen Molecule (equilibrium)",
 		Formula: "H2",
-		Config: &MoleculeConfig{
+		Config: &pb.MoleculeConfig{
 			Name: "H2",
-			Atoms: []*Atom{
+			Atoms: []*pb.Atom{
 				{Element: "H", X: 0.0, Y: 0.0, Z: 0.0},
 				{Element: "H", X: 0.0, Y: 0.0, Z: 0.735}, // Bond length in Angstroms
 			},
@@ -36,16 +44,16 @@ var moleculeLibrary = map[string]*MoleculePreset{
 			Multiplicity: 1,
 			BasisSet:     "sto-3g",
 		},
-		ReferenceEnergy: -1.1372838, // Hartree (exact FCI energy)
+		ReferenceEnergy: -1.1373060, // Hartree (exact FCI energy in STO-3G)
 		Description:     "Hydrogen molecule at equilibrium bond length (0.735 Å)",
 	},
 	"H2_stretched": {
-		ID:      "H2_stretched",
+		Id:      "H2_stretched",
 		Name:    "Hydrogen Molecule (stretched)",
 		Formula: "H2",
-		Config: &MoleculeConfig{
+		Config: &pb.MoleculeConfig{
 			Name: "H2",
-			Atoms: []*Atom{
+			Atoms: []*pb.Atom{
 				{Element: "H", X: 0.0, Y: 0.0, Z: 0.0},
 				{Element: "H", X: 0.0, Y: 0.0, Z: 1.5},
 			},
@@ -53,16 +61,16 @@ var moleculeLibrary = map[string]*MoleculePreset{
 			Multiplicity: 1,
 			BasisSet:     "sto-3g",
 		},
-		ReferenceEnergy: -0.9486,
+		ReferenceEnergy: -0.9981494,
 		Description:     "Hydrogen molecule at stretched bond (1.5 Å) - more correlation",
 	},
 	"HeH+": {
-		ID:      "HeH+",
+		Id:      "HeH+",
 		Name:    "Helium Hydride Cation",
 		Formula: "HeH+",
-		Config: &MoleculeConfig{
+		Config: &pb.MoleculeConfig{
 			Name: "HeH+",
-			Atoms: []*Atom{
+			Atoms: []*pb.Atom{
 				{Element: "He", X: 0.0, Y: 0.0, Z: 0.0},
 				{Element: "H", X: 0.0, Y: 0.0, Z: 0.772},
 			},
@@ -70,16 +78,16 @@ var moleculeLibrary = map[string]*MoleculePreset{
 			Multiplicity: 1,
 			BasisSet:     "sto-3g",
 		},
-		ReferenceEnergy: -2.8552,
+		ReferenceEnergy: -2.8510240,
 		Description:     "Helium hydride cation - simplest heteronuclear molecule",
 	},
 	"LiH": {
-		ID:      "LiH",
+		Id:      "LiH",
 		Name:    "Lithium Hydride",
 		Formula: "LiH",
-		Config: &MoleculeConfig{
+		Config: &pb.MoleculeConfig{
 			Name: "LiH",
-			Atoms: []*Atom{
+			Atoms: []*pb.Atom{
 				{Element: "Li", X: 0.0, Y: 0.0, Z: 0.0},
 				{Element: "H", X: 0.0, Y: 0.0, Z: 1.595},
 			},
@@ -87,9 +95,62 @@ var moleculeLibrary = map[string]*MoleculePreset{
 			Multiplicity: 1,
 			BasisSet:     "sto-3g",
 		},
-		ReferenceEnergy: -7.8823,
+		ReferenceEnergy: -7.8824019,
 		Description:     "Lithium hydride - first ionic molecule",
 	},
+	"H2O": {
+		Id:      "H2O",
+		Name:    "Water",
+		Formula: "H2O",
+		Config: &pb.MoleculeConfig{
+			Name: "H2O",
+			Atoms: []*pb.Atom{
+				{Element: "O", X: 0.0, Y: 0.0, Z: 0.0},
+				{Element: "H", X: 0.0, Y: 0.757, Z: 0.587}, // 0.958 Å, 104.5°
+				{Element: "H", X: 0.0, Y: -0.757, Z: 0.587},
+			},
+			Charge:       0,
+			Multiplicity: 1,
+			BasisSet:     "sto-3g",
+		},
+		ReferenceEnergy: -75.0126471,
+		Description:     "Water at its experimental geometry - 14 qubits, bent triatomic",
+	},
+	"BeH2": {
+		Id:      "BeH2",
+		Name:    "Beryllium Hydride",
+		Formula: "BeH2",
+		Config: &pb.MoleculeConfig{
+			Name: "BeH2",
+			Atoms: []*pb.Atom{
+				{Element: "Be", X: 0.0, Y: 0.0, Z: 0.0},
+				{Element: "H", X: 0.0, Y: 0.0, Z: 1.326},
+				{Element: "H", X: 0.0, Y: 0.0, Z: -1.326},
+			},
+			Charge:       0,
+			Multiplicity: 1,
+			BasisSet:     "sto-3g",
+		},
+		ReferenceEnergy: -15.5951823,
+		Description:     "Linear beryllium hydride - 14 qubits, common VQE benchmark",
+	},
+	"N2": {
+		Id:      "N2",
+		Name:    "Nitrogen Molecule",
+		Formula: "N2",
+		Config: &pb.MoleculeConfig{
+			Name: "N2",
+			Atoms: []*pb.Atom{
+				{Element: "N", X: 0.0, Y: 0.0, Z: 0.0},
+				{Element: "N", X: 0.0, Y: 0.0, Z: 1.0977},
+			},
+			Charge:       0,
+			Multiplicity: 1,
+			BasisSet:     "sto-3g",
+		},
+		ReferenceEnergy: -107.6528288,
+		Description:     "Nitrogen triple bond - 20 qubits, strongly correlated on stretching",
+	},
 }
 
 // ------------------------------------------------------------------
@@ -97,7 +158,9 @@ var moleculeLibrary = map[string]*MoleculePreset{
 // ------------------------------------------------------------------
 
 type VQEServer struct {
-	rng *rand.Rand
+	pb.UnimplementedVQESolverServer
+	rng   *rand.Rand
+	rngMu sync.Mutex // rand.Rand is not safe for concurrent calls
 }
 
 func NewVQEServer() *VQEServer {
@@ -106,107 +169,269 @@ func NewVQEServer() *VQEServer {
 	}
 }
 
+// requestRand seeds a generator for one request from the server's, so
+// concurrent requests never share one
+func (s *VQEServer) requestRand() *rand.Rand {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+	return rand.New(rand.NewSource(s.rng.Int63()))
+}
+
 // ------------------------------------------------------------------
 // GetMoleculeLibrary - Return predefined molecules
 // ------------------------------------------------------------------
 
-func (s *VQEServer) GetMoleculeLibrary(ctx context.Context, req *Empty) (*MoleculeLibrary, error) {
-	presets := make([]*MoleculePreset, 0, len(moleculeLibrary))
+func (s *VQEServer) GetMoleculeLibrary(ctx context.Context, req *pb.Empty) (*pb.MoleculeLibrary, error) {
+	presets := make([]*pb.MoleculePreset, 0, len(moleculeLibrary))
 	for _, preset := range moleculeLibrary {
 		presets = append(presets, preset)
 	}
-	return &MoleculeLibrary{Presets: presets}, nil
+	sort.Slice(presets, func(i, j int) bool { return presets[i].Id < presets[j].Id })
+	return &pb.MoleculeLibrary{Presets: presets}, nil
+}
+
+// availableMolecules lists the library's distinct formulas, sorted
+func availableMolecules() string {
+	seen := make(map[string]bool)
+	var formulas []string
+	for _, preset := range moleculeLibrary {
+		if !seen[preset.Formula] {
+			seen[preset.Formula] = true
+			formulas = append(formulas, preset.Formula)
+		}
+	}
+	sort.Strings(formulas)
+	return strings.Join(formulas, ", ")
 }
 
 // ------------------------------------------------------------------
 // BuildHamiltonian - Convert molecule to qubit Hamiltonian
-// Uses Jordan-Wigner transformation (simplified)
+// Molecular-orbital integrals (from STO-3G Hartree-Fock, or supplied as
+// FCIDUMP/JSON) mapped to qubits by the Jordan-Wigner transformation
 // ------------------------------------------------------------------
 
-func (s *VQEServer) BuildHamiltonian(ctx context.Context, config *MoleculeConfig) (*Hamiltonian, error) {
-	// Simplified Hamiltonian generation for H2 in minimal basis
-	// Real implementation would use OpenFermion/PySCF
-
-	numQubits := 4 // Minimal basis H2 requires 4 qubits
-
-	// H2 in STO-3G basis, Jordan-Wigner transformed
-	// This is the actual H2 Hamiltonian coefficients
-	terms := []*PauliTerm{
-		// Identity term
-		{Coefficient: -0.8123, Operators: []*PauliOperator{}},
-		// Z terms
-		{Coefficient: 0.1712, Operators: []*PauliOperator{{Qubit: 0, Type: PauliZ}}},
-		{Coefficient: 0.1712, Operators: []*PauliOperator{{Qubit: 1, Type: PauliZ}}},
-		{Coefficient: -0.2227, Operators: []*PauliOperator{{Qubit: 2, Type: PauliZ}}},
-		{Coefficient: -0.2227, Operators: []*PauliOperator{{Qubit: 3, Type: PauliZ}}},
-		// ZZ terms
-		{Coefficient: 0.1686, Operators: []*PauliOperator{{Qubit: 0, Type: PauliZ}, {Qubit: 1, Type: PauliZ}}},
-		{Coefficient: 0.1205, Operators: []*PauliOperator{{Qubit: 0, Type: PauliZ}, {Qubit: 2, Type: PauliZ}}},
-		{Coefficient: 0.1659, Operators: []*PauliOperator{{Qubit: 0, Type: PauliZ}, {Qubit: 3, Type: PauliZ}}},
-		{Coefficient: 0.1659, Operators: []*PauliOperator{{Qubit: 1, Type: PauliZ}, {Qubit: 2, Type: PauliZ}}},
-		{Coefficient: 0.1205, Operators: []*PauliOperator{{Qubit: 1, Type: PauliZ}, {Qubit: 3, Type: PauliZ}}},
-		{Coefficient: 0.1743, Operators: []*PauliOperator{{Qubit: 2, Type: PauliZ}, {Qubit: 3, Type: PauliZ}}},
-		// XXYY terms (from double excitations)
-		{Coefficient: -0.0453, Operators: []*PauliOperator{
-			{Qubit: 0, Type: PauliX}, {Qubit: 1, Type: PauliX}, {Qubit: 2, Type: PauliY}, {Qubit: 3, Type: PauliY},
-		}},
-		{Coefficient: 0.0453, Operators: []*PauliOperator{
-			{Qubit: 0, Type: PauliX}, {Qubit: 1, Type: PauliY}, {Qubit: 2, Type: PauliX}, {Qubit: 3, Type: PauliY},
-		}},
-		{Coefficient: 0.0453, Operators: []*PauliOperator{
-			{Qubit: 0, Type: PauliY}, {Qubit: 1, Type: PauliX}, {Qubit: 2, Type: PauliY}, {Qubit: 3, Type: PauliX},
-		}},
-		{Coefficient: -0.0453, Operators: []*PauliOperator{
-			{Qubit: 0, Type: PauliY}, {Qubit: 1, Type: PauliY}, {Qubit: 2, Type: PauliX}, {Qubit: 3, Type: PauliX},
-		}},
-	}
-
-	log.Printf("⚛️ Built Hamiltonian for %s: %d qubits, %d terms",
-		config.Name, numQubits, len(terms))
-
-	return &Hamiltonian{
-		MoleculeName:     config.Name,
-		NumQubits:        int32(numQubits),
-		Terms:            terms,
-		NuclearRepulsion: 0.7137, // H2 at 0.735 Å
-	}, nil
+func (s *VQEServer) BuildHamiltonian(ctx context.Context, config *pb.MoleculeConfig) (*pb.Hamiltonian, error) {
+	if config == nil {
+		return nil, fmt.Errorf("molecule config is required")
+	}
+
+	// Supplied integrals take precedence; otherwise run STO-3G Hartree-Fock
+	var ints *pb.ElectronicIntegrals
+	var err error
+	if config.Integrals != "" {
+		ints, err = ParseIntegrals(config.Integrals)
+	} else {
+		ints, err = ComputeIntegrals(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", config.Name, err)
+	}
+
+	hamiltonian, err := HamiltonianFromIntegrals(config.Name, ints)
+	if err != nil {
+		return nil, err
+	}
+	hamiltonian.Groups = GroupCommutingTerms(hamiltonian)
+
+	log.Printf("⚛️ Built Hamiltonian for %s: %d qubits, %d terms, %d measurement groups",
+		config.Name, hamiltonian.NumQubits, len(hamiltonian.Terms), len(hamiltonian.Groups))
+	return hamiltonian, nil
+}
+
+// ------------------------------------------------------------------
+// ImportHamiltonian - Parse an existing qubit operator
+// OpenFermion QubitOperator JSON or "0.5 Z0 Z1" text, for operators
+// prepared outside the solver
+// ------------------------------------------------------------------
+
+func (s *VQEServer) ImportHamiltonian(ctx context.Context, req *pb.ImportHamiltonianRequest) (*pb.Hamiltonian, error) {
+	terms, err := ParseQubitOperator(req.Operator, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	name := req.Name
+	if name == "" {
+		name = "imported"
+	}
+	hamiltonian, err := HamiltonianFromTerms(name, terms, int(req.NumQubits))
+	if err != nil {
+		return nil, err
+	}
+	hamiltonian.NumElectrons = req.NumElectrons
+	if err := validateHamiltonian(hamiltonian); err != nil {
+		return nil, err
+	}
+	hamiltonian.Groups = GroupCommutingTerms(hamiltonian)
+
+	log.Printf("📥 Imported %s: %d qubits, %d terms (%d parsed), %d measurement groups",
+		name, hamiltonian.NumQubits, len(hamiltonian.Terms), len(terms), len(hamiltonian.Groups))
+	return hamiltonian, nil
+}
+
+// ------------------------------------------------------------------
+// BuildSpinModel - Condensed-matter Hamiltonians
+// Transverse-field Ising, Heisenberg and XY chains on one qubit per site
+// ------------------------------------------------------------------
+
+func (s *VQEServer) BuildSpinModel(ctx context.Context, config *pb.SpinModelConfig) (*pb.Hamiltonian, error) {
+	hamiltonian, err := BuildSpinModel(config)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("🧲 Built %s: %d terms, %d measurement groups",
+		hamiltonian.MoleculeName, len(hamiltonian.Terms), len(hamiltonian.Groups))
+	return hamiltonian, nil
+}
+
+// ------------------------------------------------------------------
+// GetAnsatzCircuit - Inspect or export the ansatz
+// Lists the UCCSD excitations and the gates, optionally with every
+// excitation compiled to H/RX/CNOT/RZ, plus OpenQASM 2.0 for other tools
+// ------------------------------------------------------------------
+
+func (s *VQEServer) GetAnsatzCircuit(ctx context.Context, req *pb.AnsatzCircuitRequest) (*pb.AnsatzCircuitInfo, error) {
+	system := &pb.VQERequest{}
+	switch target := req.System.(type) {
+	case *pb.AnsatzCircuitRequest_Hamiltonian:
+		system.Target = &pb.VQERequest_Hamiltonian{Hamiltonian: target.Hamiltonian}
+	case *pb.AnsatzCircuitRequest_Molecule:
+		system.Target = &pb.VQERequest_Molecule{Molecule: target.Molecule}
+	}
+	hamiltonian, err := s.resolveHamiltonian(system)
+	if err != nil {
+		return nil, err
+	}
+	if req.NumElectrons != 0 {
+		withElectrons := proto.Clone(hamiltonian).(*pb.Hamiltonian)
+		withElectrons.NumElectrons = req.NumElectrons
+		if err := validateHamiltonian(withElectrons); err != nil {
+			return nil, err
+		}
+		hamiltonian = withElectrons
+	}
+
+	circuit := BuildAnsatz(hamiltonian, req.Ansatz)
+	params := req.Parameters
+	if len(params) == 0 {
+		params = make([]float64, circuit.NumParams) // The reference state
+	}
+	qasm, err := circuit.QASM(params)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pb.AnsatzCircuitInfo{
+		NumQubits:  int32(circuit.NumQubits),
+		NumParams:  int32(circuit.NumParams),
+		GateCounts: make(map[string]int32),
+		Qasm:       qasm,
+	}
+	reference := make([]byte, circuit.NumQubits)
+	for q := range reference {
+		reference[q] = '0'
+		if circuit.Reference&(1<<q) != 0 {
+			reference[q] = '1'
+			info.NumElectrons++
+		}
+	}
+	info.ReferenceState = string(reference)
+
+	for _, g := range circuit.Gates {
+		if g.Kind == GateExcitation {
+			half := len(g.Qubits) / 2
+			info.Excitations = append(info.Excitations, &pb.Excitation{
+				Occupied: toInt32(g.Qubits[:half]),
+				Virtual:  toInt32(g.Qubits[half:]),
+				Param:    int32(g.Param),
+			})
+		}
+	}
+
+	shown := circuit
+	if req.Trotterize {
+		shown = circuit.Trotterize()
+	}
+	for _, g := range shown.Gates {
+		info.Gates = append(info.Gates, &pb.CircuitGate{
+			Name:   gateNames[g.Kind],
+			Qubits: toInt32(g.Qubits),
+			Param:  int32(g.Param),
+			Scale:  g.Scale,
+		})
+		info.GateCounts[gateNames[g.Kind]]++
+	}
+	info.Depth = int32(shown.Depth())
+
+	log.Printf("🧩 Ansatz circuit: %d qubits, %d parameters, %d gates, depth %d",
+		info.NumQubits, info.NumParams, len(info.Gates), info.Depth)
+	return info, nil
 }
 
 // ------------------------------------------------------------------
 // FindGroundState - Run VQE optimization
 // ------------------------------------------------------------------
 
-func (s *VQEServer) FindGroundState(req *VQERequest, stream VQESolver_FindGroundStateServer) error {
-	log.Printf("🔬 Starting VQE: ansatz=%d, optimizer=%d, max_iter=%d",
-		req.Ansatz, req.Optimizer, req.MaxIterations)
+func (s *VQEServer) FindGroundState(req *pb.VQERequest, stream pb.VQESolver_FindGroundStateServer) error {
+	log.Printf("🔬 Starting VQE: ansatz=%d, optimizer=%s, max_iter=%d",
+		req.Ansatz, optimizerNames[req.Optimizer], req.MaxIterations)
 
-	// Get or build Hamiltonian
-	var hamiltonian *Hamiltonian
+	hamiltonian, err := s.resolveHamiltonian(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.runVQE(hamiltonian, req, req.InitialParameters, stream.Send)
+	return err
+}
+
+// resolveHamiltonian returns the request's Hamiltonian (regrouped, as
+// client groups are not trusted), building it from the molecule when only
+// that is given (H2 by default)
+func (s *VQEServer) resolveHamiltonian(req *pb.VQERequest) (*pb.Hamiltonian, error) {
 	if req.GetHamiltonian() != nil {
-		hamiltonian = req.GetHamiltonian()
-	} else if req.GetMolecule() != nil {
-		var err error
-		hamiltonian, err = s.BuildHamiltonian(context.Background(), req.GetMolecule())
-		if err != nil {
-			return err
+		if err := validateHamiltonian(req.GetHamiltonian()); err != nil {
+			return nil, err
 		}
-	} else {
-		// Default to H2
-		hamiltonian, _ = s.BuildHamiltonian(context.Background(), moleculeLibrary["H2_equilibrium"].Config)
+		return withGroups(req.GetHamiltonian()), nil
+	}
+	if req.GetMolecule() != nil {
+		return s.BuildHamiltonian(context.Background(), req.GetMolecule())
+	}
+	if req.GetSpinModel() != nil {
+		return BuildSpinModel(req.GetSpinModel())
 	}
+	return s.BuildHamiltonian(context.Background(), moleculeLibrary["H2_equilibrium"].Config)
+}
+
+// runVQE optimizes the ansatz for a Hamiltonian from initial parameters
+// (random when their count does not match), passing every iteration to
+// send, and returns the last one. Deflated states add overlap penalties
+// to the cost; reported energies exclude them.
+func (s *VQEServer) runVQE(hamiltonian *pb.Hamiltonian, req *pb.VQERequest, initial []float64,
+	send func(*pb.VQEIteration) error, deflation ...deflatedState) (*pb.VQEIteration, error) {
+	rng := s.requestRand()
 
 	// Initialize parameters
-	numParams := s.getNumParams(int(hamiltonian.NumQubits), req.Ansatz)
+	numParams := s.getNumParams(hamiltonian, req.Ansatz)
 	params := make([]float64, numParams)
-	if len(req.InitialParameters) == numParams {
-		copy(params, req.InitialParameters)
+	if len(initial) == numParams {
+		copy(params, initial)
 	} else {
 		// Random initialization
 		for i := range params {
-			params[i] = s.rng.Float64() * 2 * math.Pi
+			params[i] = rng.Float64() * 2 * math.Pi
 		}
 	}
 
+	obj := NewObjective(BuildAnsatz(hamiltonian, req.Ansatz), hamiltonian, int(req.ShotsPerEvaluation), rng)
+	for _, d := range deflation {
+		obj.Deflate(d.state, d.weight)
+	}
+	optimizer, err := NewOptimizer(req, params, rng)
+	if err != nil {
+		return nil, err
+	}
+
 	// VQE Optimization Loop
 	maxIter := int(req.MaxIterations)
 	if maxIter <= 0 {
@@ -217,16 +442,21 @@ func (s *VQEServer) FindGroundState(req *VQERequest, stream VQESolver_FindGround
 		threshold = 1e-6
 	}
 
-	prevEnergy := math.MaxFloat64
+	prevEnergy := obj.Energy(params)
+	prevParams := append([]float64(nil), params...)
+	var last *pb.VQEIteration
 	for iter := 1; iter <= maxIter; iter++ {
-		// Evaluate energy
-		energy, variance := s.evaluateEnergy(hamiltonian, params, req.Ansatz, int(req.ShotsPerEvaluation))
-
-		// Compute gradient (finite difference)
-		gradNorm := s.computeGradientNorm(hamiltonian, params, req.Ansatz, int(req.ShotsPerEvaluation))
+		step := optimizer.Step(obj)
+		estimate := obj.Estimate(step.Params)
+		energy := estimate.Energy
+		gradNorm := 0.0
+		if step.Gradient != nil {
+			gradNorm = vectorNorm(step.Gradient)
+		}
 
 		// Check convergence
-		converged := math.Abs(energy-prevEnergy) < threshold
+		change := math.Abs(energy - prevEnergy)
+		converged := optimizer.Converged(change, threshold)
 		status := "running"
 		if converged {
 			status = "converged"
@@ -235,225 +465,549 @@ func (s *VQEServer) FindGroundState(req *VQERequest, stream VQESolver_FindGround
 		}
 
 		// Send iteration update
-		iteration := &VQEIteration{
+		iteration := &pb.VQEIteration{
 			Iteration:      int32(iter),
-			Energy:         energy,
-			EnergyVariance: variance,
-			Parameters:     params,
+			Energy:         energy - estimate.Penalty,
+			EnergyVariance: estimate.Variance,
+			Parameters:     append([]float64(nil), step.Params...),
 			GradientNorm:   gradNorm,
+			Gradient:       step.Gradient,
 			Converged:      converged,
 			Status:         status,
+			OverlapPenalty: estimate.Penalty,
+			Diagnostics: &pb.OptimizerDiagnostics{
+				Optimizer:           optimizerNames[req.Optimizer],
+				FunctionEvaluations: int32(obj.Evaluations),
+				EnergyChange:        change,
+				StepNorm:            distance(step.Params, prevParams),
+				StepSize:            optimizer.StepSize(),
+			},
 		}
 
-		if err := stream.Send(iteration); err != nil {
-			return err
+		if err := send(iteration); err != nil {
+			return nil, err
 		}
 
-		log.Printf("📊 VQE iter %d: E=%.6f Ha, |∇|=%.4f, status=%s",
-			iter, energy, gradNorm, status)
+		log.Printf("📊 VQE iter %d: E=%.6f Ha, |∇|=%.4f, evals=%d, status=%s",
+			iter, iteration.Energy, gradNorm, obj.Evaluations, status)
 
+		last = iteration
 		if converged {
 			break
 		}
-
-		// Update parameters (simplified COBYLA-like update)
-		for i := range params {
-			params[i] -= 0.1 * s.rng.NormFloat64() * gradNorm
-		}
 		prevEnergy = energy
-
-		// Small delay for realistic timing
-		time.Sleep(50 * time.Millisecond)
+		prevParams = append(prevParams[:0], step.Params...)
 	}
 
-	return nil
+	return last, nil
 }
 
 // ------------------------------------------------------------------
-// EvaluateExpectation - Single expectation value calculation
+// ScanBondLength - Energy vs. distance for a diatomic
+// Each geometry warm-starts from the previous point's converged parameters,
+// so the optimizer follows one smooth potential energy surface
 // ------------------------------------------------------------------
 
-func (s *VQEServer) EvaluateExpectation(ctx context.Context, req *ExpectationRequest) (*ExpectationResult, error) {
-	energy, variance := s.evaluateEnergy(req.Hamiltonian, req.AnsatzParameters, req.Ansatz, int(req.Shots))
+const maxScanPoints = 200
 
-	return &ExpectationResult{
-		ExpectationValue: energy,
-		Variance:         variance,
-		TotalShots:       req.Shots,
-	}, nil
+func (s *VQEServer) ScanBondLength(req *pb.BondScanRequest, stream pb.VQESolver_ScanBondLengthServer) error {
+	preset, ok := moleculeLibrary[req.PresetId]
+	if !ok {
+		return fmt.Errorf("unknown preset %q", req.PresetId)
+	}
+	if len(preset.Config.Atoms) != 2 {
+		return fmt.Errorf("preset %q is not a diatomic", req.PresetId)
+	}
+	if req.StartDistance <= 0 || req.EndDistance <= 0 {
+		return fmt.Errorf("distances must be positive")
+	}
+	points := int(req.NumPoints)
+	if points <= 0 {
+		points = 10
+	}
+	if points < 2 || points > maxScanPoints {
+		return fmt.Errorf("num_points must be 2-%d", maxScanPoints)
+	}
+
+	settings := req.Vqe
+	if settings == nil {
+		settings = &pb.VQERequest{Optimizer: pb.OptimizerType_OPTIMIZER_LBFGS}
+	}
+	log.Printf("📈 Scanning %s from %.3f to %.3f Å (%d points, optimizer=%s)",
+		preset.Formula, req.StartDistance, req.EndDistance, points, optimizerNames[settings.Optimizer])
+
+	params := settings.InitialParameters
+	for i := 0; i < points; i++ {
+		d := req.StartDistance + (req.EndDistance-req.StartDistance)*float64(i)/float64(points-1)
+		config := stretchDiatomic(preset.Config, d)
+
+		hamiltonian, err := s.BuildHamiltonian(stream.Context(), config)
+		if err != nil {
+			return err
+		}
+		reference := BuildAnsatz(hamiltonian, settings.Ansatz).ReferenceState()
+		hartreeFock := MeasureEnergy(hamiltonian, reference, 0, nil).Energy
+
+		final, err := s.runVQE(hamiltonian, settings, params, func(*pb.VQEIteration) error {
+			return stream.Context().Err()
+		})
+		if err != nil {
+			return err
+		}
+		params = final.Parameters
+
+		point := &pb.BondScanPoint{
+			Distance:          d,
+			Energy:            final.Energy,
+			HartreeFockEnergy: hartreeFock,
+			CorrelationEnergy: final.Energy - hartreeFock,
+			Iterations:        final.Iteration,
+			Converged:         final.Converged,
+			Parameters:        final.Parameters,
+		}
+		if err := stream.Send(point); err != nil {
+			return err
+		}
+		log.Printf("📈 %s at %.3f Å: E=%.6f Ha (HF %.6f, %d iterations)",
+			preset.Formula, d, point.Energy, hartreeFock, point.Iterations)
+	}
+	return nil
 }
 
 // ------------------------------------------------------------------
-// Helper Functions
+// FindExcitedStates - Variational quantum deflation
+// State k minimizes E(θ) + Σ_j β|⟨ψ_j|ψ(θ)⟩|² over the states found
+// before it; with β above the energy gaps its minimum is the k-th
+// eigenstate the ansatz can reach
 // ------------------------------------------------------------------
 
-func (s *VQEServer) getNumParams(numQubits int, ansatz AnsatzType) int {
-	switch ansatz {
-	case AnsatzUCCSD:
-		return numQubits * 2 // Simplified
-	case AnsatzHardwareEfficient:
-		return numQubits * 3 // RY-RZ-CNOT layers
-	case AnsatzRY:
-		return numQubits
-	default:
-		return numQubits
-	}
-}
+const maxExcitedStates = 16
 
-func (s *VQEServer) evaluateEnergy(h *Hamiltonian, params []float64, ansatz AnsatzType, shots int) (float64, float64) {
-	// Simulate VQE energy evaluation
-	// In real implementation, this would:
-	// 1. Build ansatz circuit with params
-	// 2. Measure each Pauli term
-	// 3. Sum weighted contributions
+func (s *VQEServer) FindExcitedStates(ctx context.Context, req *pb.ExcitedStatesRequest) (*pb.ExcitedStatesResult, error) {
+	settings := req.Vqe
+	if settings == nil {
+		settings = &pb.VQERequest{Optimizer: pb.OptimizerType_OPTIMIZER_LBFGS}
+	}
+	numStates := int(req.NumStates)
+	if numStates <= 0 {
+		numStates = 3
+	}
+	if numStates > maxExcitedStates {
+		return nil, fmt.Errorf("num_states must be at most %d", maxExcitedStates)
+	}
+	if req.OverlapWeight < 0 {
+		return nil, fmt.Errorf("overlap_weight must be positive")
+	}
 
-	// For demo, simulate convergence toward ground state
-	exactEnergy := -1.1372838 // H2 ground state
-	noise := 0.1 / (1 + math.Sqrt(float64(shots)/100))
+	hamiltonian, err := s.resolveHamiltonian(settings)
+	if err != nil {
+		return nil, err
+	}
+	if numStates > 1<<hamiltonian.NumQubits {
+		return nil, fmt.Errorf("%d qubits have only %d states", hamiltonian.NumQubits, 1<<hamiltonian.NumQubits)
+	}
 
-	// Energy approaches ground state as params optimize
-	paramEffect := 0.0
-	for _, p := range params {
-		paramEffect += math.Cos(p) * 0.01
+	// Twice the summed coefficients bounds the spectral width, so the
+	// default penalty outweighs every energy gap
+	weight := req.OverlapWeight
+	if weight == 0 {
+		for _, term := range hamiltonian.Terms {
+			if !isIdentity(term) {
+				weight += 2 * math.Abs(term.Coefficient)
+			}
+		}
 	}
+	log.Printf("🌈 Finding %d states of %s (β=%.3f, optimizer=%s)",
+		numStates, hamiltonian.MoleculeName, weight, optimizerNames[settings.Optimizer])
+
+	circuit := BuildAnsatz(hamiltonian, settings.Ansatz)
+	result := &pb.ExcitedStatesResult{OverlapWeight: weight}
+	var found []deflatedState
+	for k := 0; k < numStates; k++ {
+		initial := settings.InitialParameters
+		if k > 0 {
+			initial = nil // Random start: the ground state's optimum is a penalty maximum
+		}
+		final, err := s.runVQE(hamiltonian, settings, initial, func(*pb.VQEIteration) error {
+			return ctx.Err()
+		}, found...)
+		if err != nil {
+			return nil, err
+		}
 
-	energy := exactEnergy + 0.5*s.rng.Float64()*noise + paramEffect
-	variance := noise * noise
+		sv, err := circuit.Run(final.Parameters)
+		if err != nil {
+			return nil, err
+		}
+		state := &pb.ExcitedState{
+			Index:          int32(k),
+			Energy:         final.Energy,
+			EnergyVariance: final.EnergyVariance,
+			Parameters:     final.Parameters,
+			Iterations:     final.Iteration,
+			Converged:      final.Converged,
+		}
+		if k > 0 {
+			state.ExcitationEnergy = final.Energy - result.States[0].Energy
+		}
+		for _, prev := range found {
+			state.Overlaps = append(state.Overlaps, sv.Overlap(prev.state))
+		}
+		result.States = append(result.States, state)
+		found = append(found, deflatedState{state: sv, weight: weight})
 
-	return energy + h.NuclearRepulsion, variance
+		log.Printf("🌈 State %d: E=%.6f Ha (ΔE=%.6f, %d iterations)",
+			k, state.Energy, state.ExcitationEnergy, state.Iterations)
+	}
+	return result, nil
 }
 
-func (s *VQEServer) computeGradientNorm(h *Hamiltonian, params []float64, ansatz AnsatzType, shots int) float64 {
-	// Simplified gradient computation
-	// Real implementation uses parameter shift rule
-	gradSqSum := 0.0
-	for range params {
-		gradSqSum += math.Pow(s.rng.NormFloat64()*0.1, 2)
+// ------------------------------------------------------------------
+// EvaluateExpectation - Single expectation value calculation
+// ------------------------------------------------------------------
+
+func (s *VQEServer) EvaluateExpectation(ctx context.Context, req *pb.ExpectationRequest) (*pb.ExpectationResult, error) {
+	if req.Hamiltonian == nil {
+		return nil, fmt.Errorf("hamiltonian is required")
+	}
+	if err := validateHamiltonian(req.Hamiltonian); err != nil {
+		return nil, err
+	}
+	hamiltonian := withGroups(req.Hamiltonian)
+
+	rng := s.requestRand()
+
+	// A noise model (or mitigation) switches to noisy trajectories
+	var estimate *EnergyEstimate
+	var mitigation *pb.MitigationResult
+	var err error
+	if req.Noise != nil || req.Mitigation != nil {
+		noise := req.Noise
+		if noise == nil {
+			noise = &pb.NoiseModel{}
+		}
+		circuit := BuildAnsatz(hamiltonian, req.Ansatz)
+		estimate, mitigation, err = mitigatedExpectation(hamiltonian, circuit, req.AnsatzParameters,
+			noise, req.Mitigation, int(req.Shots), rng)
+	} else {
+		estimate, err = evaluateEnergy(hamiltonian, req.AnsatzParameters, req.Ansatz, int(req.Shots), rng)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return math.Sqrt(gradSqSum)
+
+	return &pb.ExpectationResult{
+		ExpectationValue:  estimate.Energy,
+		Variance:          estimate.Variance,
+		TotalShots:        int32(estimate.Shots),
+		TermContributions: estimate.Contributions,
+		MeasurementGroups: int32(len(hamiltonian.Groups)),
+		Terms:             termExpectations(hamiltonian, estimate),
+		Mitigation:        mitigation,
+	}, nil
 }
 
 // ------------------------------------------------------------------
-// Types (would be generated from protobuf)
+// EvaluateExpectationBatch - Many parameter vectors and observables
+// Each parameter vector's state is prepared once and every observable is
+// measured on it; states are simulated concurrently, one worker per CPU
 // ------------------------------------------------------------------
 
-type Empty struct{}
+const (
+	maxBatchParameterSets = 4096
+	maxBatchObservables   = 64
+)
 
-type MoleculeConfig struct {
-	Name         string  `json:"name"`
-	Atoms        []*Atom `json:"atoms"`
-	Charge       int32   `json:"charge"`
-	Multiplicity int32   `json:"multiplicity"`
-	BasisSet     string  `json:"basis_set"`
-}
+func (s *VQEServer) EvaluateExpectationBatch(ctx context.Context, req *pb.ExpectationBatchRequest) (*pb.ExpectationBatchResult, error) {
+	if req.Hamiltonian == nil {
+		return nil, fmt.Errorf("hamiltonian is required")
+	}
+	if len(req.ParameterSets) == 0 || len(req.ParameterSets) > maxBatchParameterSets {
+		return nil, fmt.Errorf("parameter_sets must have 1-%d entries", maxBatchParameterSets)
+	}
+	if len(req.Observables) > maxBatchObservables {
+		return nil, fmt.Errorf("at most %d observables per batch", maxBatchObservables)
+	}
+	if err := validateHamiltonian(req.Hamiltonian); err != nil {
+		return nil, err
+	}
 
-type Atom struct {
-	Element string  `json:"element"`
-	X       float64 `json:"x"`
-	Y       float64 `json:"y"`
-	Z       float64 `json:"z"`
-}
+	// The Hamiltonian fixes the ansatz; observables default to it
+	circuit := BuildAnsatz(req.Hamiltonian, req.Ansatz)
+	sources := req.Observables
+	if len(sources) == 0 {
+		sources = []*pb.Hamiltonian{req.Hamiltonian}
+	}
+	observables := make([]*pb.Hamiltonian, len(sources))
+	for i, obs := range sources {
+		if obs == nil {
+			return nil, fmt.Errorf("observable %d is empty", i)
+		}
+		if err := validateHamiltonian(obs); err != nil {
+			return nil, fmt.Errorf("observable %d: %w", i, err)
+		}
+		if obs.NumQubits != req.Hamiltonian.NumQubits {
+			return nil, fmt.Errorf("observable %d has %d qubits, the circuit has %d", i, obs.NumQubits, req.Hamiltonian.NumQubits)
+		}
+		observables[i] = withGroups(obs)
+	}
+	for i, set := range req.ParameterSets {
+		if set == nil || len(set.Values) != circuit.NumParams {
+			return nil, fmt.Errorf("parameter set %d: ansatz takes %d parameters", i, circuit.NumParams)
+		}
+	}
 
-type Hamiltonian struct {
-	MoleculeName     string       `json:"molecule_name"`
-	NumQubits        int32        `json:"num_qubits"`
-	Terms            []*PauliTerm `json:"terms"`
-	NuclearRepulsion float64      `json:"nuclear_repulsion"`
-}
+	// Seeds are drawn in order so a seeded server stays reproducible
+	rng := s.requestRand()
+	seeds := make([]int64, len(req.ParameterSets))
+	for i := range seeds {
+		seeds[i] = rng.Int63()
+	}
 
-type PauliTerm struct {
-	Coefficient float64          `json:"coefficient"`
-	Operators   []*PauliOperator `json:"operators"`
-}
+	results := make([]*pb.BatchExpectation, len(req.ParameterSets)*len(observables))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				rng := rand.New(rand.NewSource(seeds[i]))
+				sv, _ := circuit.Run(req.ParameterSets[i].Values) // Lengths checked above
+				for j, obs := range observables {
+					est := MeasureEnergy(obs, sv, int(req.Shots), rng)
+					results[i*len(observables)+j] = &pb.BatchExpectation{
+						ParameterIndex:   int32(i),
+						ObservableIndex:  int32(j),
+						ExpectationValue: est.Energy,
+						Variance:         est.Variance,
+						TotalShots:       int32(est.Shots),
+					}
+				}
+			}
+		}()
+	}
+	for i := range req.ParameterSets {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-type PauliOperator struct {
-	Qubit int32     `json:"qubit"`
-	Type  PauliType `json:"type"`
+	log.Printf("📦 Batch expectation: %d parameter sets × %d observables", len(req.ParameterSets), len(observables))
+	return &pb.ExpectationBatchResult{Results: results}, nil
 }
 
-type PauliType int32
+// ------------------------------------------------------------------
+// SimulateTimeEvolution - Trotterized dynamics
+// Evolves an initial product state under the Hamiltonian and streams the
+// energy, per-qubit magnetization and requested observables over time
+// ------------------------------------------------------------------
 
 const (
-	PauliI PauliType = 0
-	PauliX PauliType = 1
-	PauliY PauliType = 2
-	PauliZ PauliType = 3
+	defaultTrotterSteps = 100
+	maxTrotterSteps     = 10000
 )
 
-type AnsatzType int32
+func (s *VQEServer) SimulateTimeEvolution(req *pb.TimeEvolutionRequest, stream pb.VQESolver_SimulateTimeEvolutionServer) error {
+	h := req.Hamiltonian
+	if h == nil {
+		return status.Error(codes.InvalidArgument, "hamiltonian is required")
+	}
+	if err := validateHamiltonian(h); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.TotalTime <= 0 || math.IsNaN(req.TotalTime) || math.IsInf(req.TotalTime, 0) {
+		return status.Error(codes.InvalidArgument, "total_time must be positive and finite")
+	}
+	steps := int(req.TrotterSteps)
+	if steps == 0 {
+		steps = defaultTrotterSteps
+	}
+	if steps < 1 || steps > maxTrotterSteps {
+		return status.Errorf(codes.InvalidArgument, "trotter_steps must be 1-%d", maxTrotterSteps)
+	}
+	dt := req.TotalTime / float64(steps)
+	if dt <= 0 || math.IsNaN(dt) || math.IsInf(dt, 0) {
+		return status.Error(codes.InvalidArgument, "total_time is too small to split into trotter_steps")
+	}
+	order := int(req.TrotterOrder)
+	if order == 0 {
+		order = 1
+	}
+	if order != 1 && order != 2 {
+		return status.Error(codes.InvalidArgument, "trotter_order must be 1 or 2")
+	}
+	every := int(req.ReportEvery)
+	if every <= 0 {
+		every = 1
+	}
+	for i, obs := range req.Observables {
+		if obs == nil {
+			return status.Errorf(codes.InvalidArgument, "observable %d is empty", i)
+		}
+		if err := validateHamiltonian(obs); err != nil {
+			return status.Errorf(codes.InvalidArgument, "observable %d: %v", i, err)
+		}
+		if obs.NumQubits != h.NumQubits {
+			return status.Errorf(codes.InvalidArgument, "observable %d has %d qubits, the Hamiltonian has %d", i, obs.NumQubits, h.NumQubits)
+		}
+	}
 
-const (
-	AnsatzUCCSD             AnsatzType = 0
-	AnsatzHardwareEfficient AnsatzType = 1
-	AnsatzRY                AnsatzType = 2
-)
+	// Default start: the lowest-energy basis state, as the ansatz uses
+	var sv *Statevector
+	if req.InitialState == "" {
+		reference := &AnsatzCircuit{NumQubits: int(h.NumQubits), Reference: referenceState(h)}
+		sv = reference.ReferenceState()
+	} else {
+		var err error
+		if sv, err = PrepareProductState(req.InitialState, int(h.NumQubits)); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	initial := sv.Clone()
 
-type OptimizerType int32
-
-type VQERequest struct {
-	Molecule             *MoleculeConfig
-	Hamiltonian          *Hamiltonian
-	Ansatz               AnsatzType
-	Optimizer            OptimizerType
-	MaxIterations        int32
-	ConvergenceThreshold float64
-	InitialParameters    []float64
-	ShotsPerEvaluation   int32
-}
+	step := TrotterStep(h, dt, order)
+	log.Printf("⏱️ Time evolution: %d qubits, t=%.3f in %d steps (order %d, %d gates/step)",
+		h.NumQubits, req.TotalTime, steps, order, len(step.Gates))
 
-func (r *VQERequest) GetMolecule() *MoleculeConfig { return r.Molecule }
-func (r *VQERequest) GetHamiltonian() *Hamiltonian { return r.Hamiltonian }
-
-type VQEIteration struct {
-	Iteration      int32
-	Energy         float64
-	EnergyVariance float64
-	Parameters     []float64
-	GradientNorm   float64
-	Converged      bool
-	Status         string
+	for k := 0; k <= steps; k++ {
+		if k > 0 {
+			sv.Evolve(step)
+		}
+		if k%every != 0 && k != steps {
+			continue
+		}
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		frame := &pb.TimeEvolutionFrame{
+			Step:              int32(k),
+			Time:              float64(k) * dt,
+			Energy:            MeasureEnergy(h, sv, 0, nil).Energy,
+			ReturnProbability: sv.Overlap(initial),
+			Magnetization:     sv.magnetization(),
+			GatesPerStep:      int32(len(step.Gates)),
+		}
+		for _, obs := range req.Observables {
+			frame.Observables = append(frame.Observables, MeasureEnergy(obs, sv, 0, nil).Energy)
+		}
+		if err := stream.Send(frame); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-type VQESolver_FindGroundStateServer interface {
-	Send(*VQEIteration) error
+// ------------------------------------------------------------------
+// Helper Functions
+// ------------------------------------------------------------------
+
+// stretchDiatomic moves the second atom along the bond axis to distance d
+func stretchDiatomic(config *pb.MoleculeConfig, d float64) *pb.MoleculeConfig {
+	a, b := config.Atoms[0], config.Atoms[1]
+	axis := []float64{b.X - a.X, b.Y - a.Y, b.Z - a.Z}
+	length := vectorNorm(axis)
+	if length == 0 {
+		axis, length = []float64{0, 0, 1}, 1
+	}
+
+	stretched := proto.Clone(config).(*pb.MoleculeConfig)
+	stretched.Atoms = []*pb.Atom{
+		{Element: a.Element, X: a.X, Y: a.Y, Z: a.Z},
+		{Element: b.Element, X: a.X + d*axis[0]/length, Y: a.Y + d*axis[1]/length, Z: a.Z + d*axis[2]/length},
+	}
+	return stretched
 }
 
-type ExpectationRequest struct {
-	Hamiltonian      *Hamiltonian
-	AnsatzParameters []float64
-	Ansatz           AnsatzType
-	Shots            int32
+// termExpectations lists every term's estimate, largest contribution first
+func termExpectations(h *pb.Hamiltonian, est *EnergyEstimate) []*pb.TermExpectation {
+	group := make([]int32, len(h.Terms))
+	for i := range group {
+		group[i] = -1
+	}
+	for g, mg := range h.Groups {
+		for _, idx := range mg.TermIndices {
+			group[idx] = int32(g)
+		}
+	}
+
+	terms := make([]*pb.TermExpectation, len(h.Terms))
+	for i, term := range h.Terms {
+		terms[i] = &pb.TermExpectation{
+			Index:        int32(i),
+			Label:        pauliLabel(term),
+			Coefficient:  term.Coefficient,
+			Expectation:  est.TermValues[i],
+			Contribution: term.Coefficient * est.TermValues[i],
+			Variance:     est.TermVariances[i],
+			Group:        group[i],
+		}
+	}
+	sort.SliceStable(terms, func(a, b int) bool {
+		return math.Abs(terms[a].Contribution) > math.Abs(terms[b].Contribution)
+	})
+	return terms
 }
 
-type ExpectationResult struct {
-	ExpectationValue  float64
-	Variance          float64
-	TotalShots        int32
-	TermContributions map[string]float64
+// validateHamiltonian checks that a client-supplied Hamiltonian fits the
+// simulator
+func validateHamiltonian(h *pb.Hamiltonian) error {
+	if h.NumQubits <= 0 || h.NumQubits > maxQubits {
+		return fmt.Errorf("hamiltonian has %d qubits; the simulator supports 1-%d", h.NumQubits, maxQubits)
+	}
+	if h.NumElectrons < 0 || h.NumElectrons > h.NumQubits {
+		return fmt.Errorf("num_electrons %d does not fit %d spin orbitals", h.NumElectrons, h.NumQubits)
+	}
+	for i, term := range h.Terms {
+		if term == nil {
+			return fmt.Errorf("term %d is empty", i)
+		}
+		if math.IsNaN(term.Coefficient) || math.IsInf(term.Coefficient, 0) {
+			return fmt.Errorf("term %d has a non-finite coefficient", i)
+		}
+		seen := make(map[int32]bool)
+		for _, op := range term.Operators {
+			if op == nil || op.Qubit < 0 || op.Qubit >= h.NumQubits {
+				return fmt.Errorf("term %d acts outside qubits 0-%d", i, h.NumQubits-1)
+			}
+			if op.Type < pb.PauliType_PAULI_I || op.Type > pb.PauliType_PAULI_Z {
+				return fmt.Errorf("term %d has unknown Pauli type %d", i, op.Type)
+			}
+			if seen[op.Qubit] {
+				return fmt.Errorf("term %d acts twice on qubit %d", i, op.Qubit)
+			}
+			seen[op.Qubit] = true
+		}
+	}
+	return nil
 }
 
-type MoleculeLibrary struct {
-	Presets []*MoleculePreset
+func toInt32(v []int) []int32 {
+	out := make([]int32, len(v))
+	for i, x := range v {
+		out[i] = int32(x)
+	}
+	return out
 }
 
-type MoleculePreset struct {
-	ID              string          `json:"id"`
-	Name            string          `json:"name"`
-	Formula         string          `json:"formula"`
-	Config          *MoleculeConfig `json:"config"`
-	ReferenceEnergy float64         `json:"reference_energy"`
-	Description     string          `json:"description"`
+func (s *VQEServer) getNumParams(h *pb.Hamiltonian, ansatz pb.AnsatzType) int {
+	return BuildAnsatz(h, ansatz).NumParams
 }
 
-func (p *MoleculePreset) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]interface{}{
-		"id":               p.ID,
-		"name":             p.Name,
-		"formula":          p.Formula,
-		"config":           p.Config,
-		"reference_energy": p.ReferenceEnergy,
-		"description":      p.Description,
-	})
+// evaluateEnergy prepares the ansatz state for params and measures the
+// Hamiltonian on it (exactly when shots = 0)
+func evaluateEnergy(h *pb.Hamiltonian, params []float64, ansatz pb.AnsatzType, shots int, rng *rand.Rand) (*EnergyEstimate, error) {
+	sv, err := BuildAnsatz(h, ansatz).Run(params)
+	if err != nil {
+		return nil, err
+	}
+	return MeasureEnergy(h, sv, shots, rng), nil
 }
 
 // ------------------------------------------------------------------
@@ -472,15 +1026,13 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	// RegisterVQESolverServer(grpcServer, server)
+	pb.RegisterVQESolverServer(grpcServer, server)
 
 	log.Printf("⚛️ VQE Solver starting on port %d", *port)
-	log.Printf("   Available molecules: H2, HeH+, LiH")
+	log.Printf("   Available molecules: %s", availableMolecules())
 	log.Printf("   Ansätze: UCCSD, Hardware-Efficient, RY")
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-
-	_ = server
 }
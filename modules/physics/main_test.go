@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+// dialVQE serves a VQEServer over an in-memory listener and returns a
+// client connected to it
+func dialVQE(t *testing.T) pb.VQESolverClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterVQESolverServer(srv, NewVQEServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewVQESolverClient(conn)
+}
+
+// recvAll drains a server stream
+func recvAll[T any](t *testing.T, recv func() (*T, error)) []*T {
+	t.Helper()
+	var out []*T
+	for {
+		msg, err := recv()
+		if errors.Is(err, io.EOF) {
+			return out
+		}
+		if err != nil {
+			t.Fatalf("recv: %v", err)
+		}
+		out = append(out, msg)
+	}
+}
+
+func TestFindGroundStateOverGRPC(t *testing.T) {
+	client := dialVQE(t)
+	ctx := context.Background()
+
+	library, err := client.GetMoleculeLibrary(ctx, &pb.Empty{})
+	if err != nil {
+		t.Fatalf("GetMoleculeLibrary: %v", err)
+	}
+	var h2 *pb.MoleculePreset
+	for _, p := range library.Presets {
+		if p.Id == "H2_equilibrium" {
+			h2 = p
+		}
+	}
+	if h2 == nil {
+		t.Fatalf("library has no H2_equilibrium preset")
+	}
+
+	stream, err := client.FindGroundState(ctx, &pb.VQERequest{
+		Target:        &pb.VQERequest_Molecule{Molecule: h2.Config},
+		Ansatz:        pb.AnsatzType_ANSATZ_UCCSD,
+		Optimizer:     pb.OptimizerType_OPTIMIZER_LBFGS,
+		MaxIterations: 50,
+	})
+	if err != nil {
+		t.Fatalf("FindGroundState: %v", err)
+	}
+	iterations := recvAll(t, stream.Recv)
+	if len(iterations) == 0 {
+		t.Fatalf("no iterations streamed")
+	}
+	last := iterations[len(iterations)-1]
+	if !last.Converged {
+		t.Errorf("status %q after %d iterations, want converged", last.Status, last.Iteration)
+	}
+	if math.Abs(last.Energy-h2.ReferenceEnergy) > 1e-4 {
+		t.Errorf("energy %.6f Ha, want %.6f", last.Energy, h2.ReferenceEnergy)
+	}
+}
+
+func TestScanBondLengthOverGRPC(t *testing.T) {
+	client := dialVQE(t)
+	stream, err := client.ScanBondLength(context.Background(), &pb.BondScanRequest{
+		PresetId:      "H2_equilibrium",
+		StartDistance: 0.5,
+		EndDistance:   2.0,
+		NumPoints:     4,
+	})
+	if err != nil {
+		t.Fatalf("ScanBondLength: %v", err)
+	}
+	points := recvAll(t, stream.Recv)
+	if len(points) != 4 {
+		t.Fatalf("got %d points, want 4", len(points))
+	}
+	for i, p := range points {
+		if !p.Converged {
+			t.Errorf("point %d at %.2f Å did not converge", i, p.Distance)
+		}
+		if p.CorrelationEnergy > 1e-9 {
+			t.Errorf("point %d: VQE energy %.6f is above Hartree-Fock %.6f", i, p.Energy, p.HartreeFockEnergy)
+		}
+		// Stretching the bond makes H2 more strongly correlated
+		if i > 0 && p.CorrelationEnergy >= points[i-1].CorrelationEnergy {
+			t.Errorf("correlation energy %.6f at %.2f Å is not below %.6f at %.2f Å",
+				p.CorrelationEnergy, p.Distance, points[i-1].CorrelationEnergy, points[i-1].Distance)
+		}
+	}
+
+	// Stream errors arrive on the first Recv
+	stream, err = client.ScanBondLength(context.Background(), &pb.BondScanRequest{
+		PresetId:      "H2O",
+		StartDistance: 1,
+		EndDistance:   2,
+	})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if err == nil {
+		t.Errorf("scanning a triatomic succeeded")
+	}
+}
+
+func TestFindExcitedStatesOverGRPC(t *testing.T) {
+	client := dialVQE(t)
+	// Two-site transverse-field Ising chain, J = h = 1: H = -Z0 Z1 - X0 - X1
+	result, err := client.FindExcitedStates(context.Background(), &pb.ExcitedStatesRequest{
+		Vqe: &pb.VQERequest{
+			Target: &pb.VQERequest_SpinModel{SpinModel: &pb.SpinModelConfig{
+				Model:    pb.SpinModelType_SPIN_MODEL_TRANSVERSE_ISING,
+				NumSites: 2,
+				Coupling: 1,
+				Field:    1,
+			}},
+			Ansatz:        pb.AnsatzType_ANSATZ_HARDWARE_EFFICIENT,
+			Optimizer:     pb.OptimizerType_OPTIMIZER_LBFGS,
+			MaxIterations: 200,
+		},
+		NumStates: 2,
+	})
+	if err != nil {
+		t.Fatalf("FindExcitedStates: %v", err)
+	}
+	if len(result.States) != 2 {
+		t.Fatalf("got %d states, want 2", len(result.States))
+	}
+	// The spectrum is -√5, -1, 1, √5. The excited state starts at random,
+	// so only bound it.
+	ground, excited := result.States[0], result.States[1]
+	if math.Abs(ground.Energy+math.Sqrt(5)) > 1e-3 {
+		t.Errorf("ground energy %.6f, want %.6f", ground.Energy, -math.Sqrt(5))
+	}
+	if excited.Energy < ground.Energy-1e-6 || excited.Energy > math.Sqrt(5) {
+		t.Errorf("excited energy %.6f is outside [%.6f, %.6f]", excited.Energy, ground.Energy, math.Sqrt(5))
+	}
+	if len(excited.Overlaps) != 1 || excited.Overlaps[0] > 1e-2 {
+		t.Errorf("excited state overlaps the ground state: %v", excited.Overlaps)
+	}
+}
+
+func TestImportHamiltonianOverGRPC(t *testing.T) {
+	client := dialVQE(t)
+	ctx := context.Background()
+
+	// The two-site Ising chain again, as text and as OpenFermion JSON
+	text, err := client.ImportHamiltonian(ctx, &pb.ImportHamiltonianRequest{
+		Name:     "ising",
+		Operator: "-1 Z0 Z1\n-1 X0\n-1 X1",
+	})
+	if err != nil {
+		t.Fatalf("ImportHamiltonian(text): %v", err)
+	}
+	fromJSON, err := client.ImportHamiltonian(ctx, &pb.ImportHamiltonianRequest{
+		Name:     "ising",
+		Operator: `{"Z0 Z1": -1, "X0": -1, "X1": -1}`,
+	})
+	if err != nil {
+		t.Fatalf("ImportHamiltonian(json): %v", err)
+	}
+	if text.NumQubits != 2 || len(text.Terms) != 3 || len(text.Groups) == 0 {
+		t.Fatalf("imported %d qubits, %d terms, %d groups; want 2, 3 and some",
+			text.NumQubits, len(text.Terms), len(text.Groups))
+	}
+	if fromJSON.NumQubits != text.NumQubits || len(fromJSON.Terms) != len(text.Terms) {
+		t.Errorf("JSON import has %d qubits and %d terms, text %d and %d",
+			fromJSON.NumQubits, len(fromJSON.Terms), text.NumQubits, len(text.Terms))
+	}
+
+	// The imported operator is a valid target as it came off the wire
+	stream, err := client.FindGroundState(ctx, &pb.VQERequest{
+		Target:        &pb.VQERequest_Hamiltonian{Hamiltonian: text},
+		Ansatz:        pb.AnsatzType_ANSATZ_HARDWARE_EFFICIENT,
+		Optimizer:     pb.OptimizerType_OPTIMIZER_LBFGS,
+		MaxIterations: 200,
+	})
+	if err != nil {
+		t.Fatalf("FindGroundState: %v", err)
+	}
+	iterations := recvAll(t, stream.Recv)
+	if last := iterations[len(iterations)-1]; math.Abs(last.Energy+math.Sqrt(5)) > 1e-3 {
+		t.Errorf("ground energy %.6f, want %.6f", last.Energy, -math.Sqrt(5))
+	}
+
+	if _, err := client.ImportHamiltonian(ctx, &pb.ImportHamiltonianRequest{Operator: "0.5 Q0"}); err == nil {
+		t.Errorf("importing an unknown Pauli succeeded")
+	}
+}
+
+func TestEvaluateExpectationBatchOverGRPC(t *testing.T) {
+	client := dialVQE(t)
+	ctx := context.Background()
+
+	h2, err := client.BuildHamiltonian(ctx, moleculeLibrary["H2_equilibrium"].Config)
+	if err != nil {
+		t.Fatalf("BuildHamiltonian: %v", err)
+	}
+	circuit, err := client.GetAnsatzCircuit(ctx, &pb.AnsatzCircuitRequest{
+		System: &pb.AnsatzCircuitRequest_Hamiltonian{Hamiltonian: h2},
+	})
+	if err != nil {
+		t.Fatalf("GetAnsatzCircuit: %v", err)
+	}
+	z0, err := client.ImportHamiltonian(ctx, &pb.ImportHamiltonianRequest{Operator: "1 Z0", NumQubits: h2.NumQubits})
+	if err != nil {
+		t.Fatalf("ImportHamiltonian: %v", err)
+	}
+
+	sets := make([]*pb.ParameterSet, 3)
+	for i := range sets {
+		sets[i] = &pb.ParameterSet{Values: make([]float64, circuit.NumParams)}
+		for j := range sets[i].Values {
+			sets[i].Values[j] = 0.1 * float64(i+j)
+		}
+	}
+	observables := []*pb.Hamiltonian{h2, z0}
+	batch, err := client.EvaluateExpectationBatch(ctx, &pb.ExpectationBatchRequest{
+		Hamiltonian:   h2,
+		ParameterSets: sets,
+		Observables:   observables,
+	})
+	if err != nil {
+		t.Fatalf("EvaluateExpectationBatch: %v", err)
+	}
+	if len(batch.Results) != len(sets)*len(observables) {
+		t.Fatalf("got %d results, want %d", len(batch.Results), len(sets)*len(observables))
+	}
+
+	// Energies match the single-state RPC, which builds the same ansatz
+	// from the same Hamiltonian; <Z0> is a Pauli expectation
+	for _, r := range batch.Results {
+		if r.ObservableIndex == 1 {
+			if math.Abs(r.ExpectationValue) > 1+1e-9 {
+				t.Errorf("set %d: <Z0> = %.9f", r.ParameterIndex, r.ExpectationValue)
+			}
+			continue
+		}
+		single, err := client.EvaluateExpectation(ctx, &pb.ExpectationRequest{
+			Hamiltonian:      h2,
+			AnsatzParameters: sets[r.ParameterIndex].Values,
+		})
+		if err != nil {
+			t.Fatalf("EvaluateExpectation: %v", err)
+		}
+		if math.Abs(r.ExpectationValue-single.ExpectationValue) > 1e-9 {
+			t.Errorf("set %d: batch energy %.9f, single %.9f",
+				r.ParameterIndex, r.ExpectationValue, single.ExpectationValue)
+		}
+	}
+}
+
+func TestSimulateTimeEvolutionOverGRPC(t *testing.T) {
+	client := dialVQE(t)
+	ctx := context.Background()
+
+	// H = X on one qubit is a single term, so Trotterization is exact:
+	// from |0⟩, ⟨Z⟩ = cos 2t and the return probability is cos² t
+	h, err := client.ImportHamiltonian(ctx, &pb.ImportHamiltonianRequest{Operator: "1 X0"})
+	if err != nil {
+		t.Fatalf("ImportHamiltonian: %v", err)
+	}
+	z0, err := client.ImportHamiltonian(ctx, &pb.ImportHamiltonianRequest{Operator: "1 Z0"})
+	if err != nil {
+		t.Fatalf("ImportHamiltonian: %v", err)
+	}
+	stream, err := client.SimulateTimeEvolution(ctx, &pb.TimeEvolutionRequest{
+		Hamiltonian:  h,
+		InitialState: "0",
+		TotalTime:    1,
+		TrotterSteps: 10,
+		Observables:  []*pb.Hamiltonian{z0},
+		ReportEvery:  3,
+	})
+	if err != nil {
+		t.Fatalf("SimulateTimeEvolution: %v", err)
+	}
+	frames := recvAll(t, stream.Recv)
+
+	// Every third step, plus the last
+	wantSteps := []int32{0, 3, 6, 9, 10}
+	if len(frames) != len(wantSteps) {
+		t.Fatalf("got %d frames, want %d", len(frames), len(wantSteps))
+	}
+	for i, f := range frames {
+		if f.Step != wantSteps[i] {
+			t.Errorf("frame %d is step %d, want %d", i, f.Step, wantSteps[i])
+		}
+		if math.Abs(f.Energy) > 1e-9 {
+			t.Errorf("step %d: energy drifted to %.9f", f.Step, f.Energy)
+		}
+		if want := math.Cos(2 * f.Time); math.Abs(f.Magnetization[0]-want) > 1e-9 || math.Abs(f.Observables[0]-want) > 1e-9 {
+			t.Errorf("step %d: <Z> = %.9f (observable %.9f), want %.9f", f.Step, f.Magnetization[0], f.Observables[0], want)
+		}
+		if want := math.Pow(math.Cos(f.Time), 2); math.Abs(f.ReturnProbability-want) > 1e-9 {
+			t.Errorf("step %d: return probability %.9f, want %.9f", f.Step, f.ReturnProbability, want)
+		}
+	}
+
+	for _, total := range []float64{0, math.NaN(), math.Inf(1), math.SmallestNonzeroFloat64} {
+		stream, err = client.SimulateTimeEvolution(ctx, &pb.TimeEvolutionRequest{Hamiltonian: h, TotalTime: total})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("evolving for time %g: %v, want InvalidArgument", total, err)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+// Noise & Error Mitigation
+// The state-vector simulator is exact, so EvaluateExpectation can apply a
+// noise model to show what hardware would measure:
+//
+//	Gate noise     after every gate, each qubit it touches suffers X, Y or
+//	               Z with total probability p (depolarizing), simulated by
+//	               averaging random Pauli trajectories
+//	Readout noise  each measured bit reads 0 as 1 with probability P01 and
+//	               1 as 0 with probability P10
+//
+// and mitigate it in post-processing:
+//
+//	Zero-noise extrapolation  fold the circuit U → U(U†U)^k, which scales
+//	                          the gate noise by λ = 2k+1, and extrapolate
+//	                          E(λ) back to λ = 0
+//	Readout mitigation        estimate each qubit's confusion matrix from
+//	                          |0…0⟩ and |1…1⟩ calibration circuits and
+//	                          invert it on every measured outcome
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/bits"
+	"math/rand"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+const (
+	defaultTrajectories = 100
+	maxTrajectories     = 10000
+	maxNoiseScale       = 21
+)
+
+var defaultNoiseScales = []int32{1, 3, 5}
+
+// ------------------------------------------------------------------
+// Noisy Circuits
+// ------------------------------------------------------------------
+
+// RunNoisy prepares one Pauli trajectory of the ansatz state with every
+// gate folded to noise scale λ (odd) and depolarizing error p per qubit
+// per gate
+func (c *AnsatzCircuit) RunNoisy(params []float64, scale int, p float64, rng *rand.Rand) (*Statevector, error) {
+	if len(params) != c.NumParams {
+		return nil, fmt.Errorf("ansatz takes %d parameters, got %d", c.NumParams, len(params))
+	}
+	sv := NewStatevector(c.NumQubits)
+	apply := func(g AnsatzGate, theta float64) {
+		sv.applyGate(g, theta)
+		for _, q := range g.Qubits {
+			if rng.Float64() < p {
+				sv.ApplyPauli(q, pb.PauliType(1+rng.Intn(3)))
+			}
+		}
+	}
+
+	for _, g := range c.Gates {
+		apply(g, g.angle(params))
+	}
+	for k := 0; k < (scale-1)/2; k++ {
+		for i := len(c.Gates) - 1; i >= 0; i-- {
+			apply(c.Gates[i], -c.Gates[i].angle(params))
+		}
+		for _, g := range c.Gates {
+			apply(g, g.angle(params))
+		}
+	}
+	return sv, nil
+}
+
+// ApplyPauli applies a single-qubit Pauli
+func (sv *Statevector) ApplyPauli(q int, p pb.PauliType) {
+	mask := 1 << q
+	switch p {
+	case pb.PauliType_PAULI_X:
+		sv.ApplyX(q)
+	case pb.PauliType_PAULI_Y:
+		for i := range sv.Amplitudes {
+			if i&mask == 0 {
+				a0, a1 := sv.Amplitudes[i], sv.Amplitudes[i|mask]
+				sv.Amplitudes[i], sv.Amplitudes[i|mask] = complex(0, -1)*a1, complex(0, 1)*a0
+			}
+		}
+	case pb.PauliType_PAULI_Z:
+		for i := range sv.Amplitudes {
+			if i&mask != 0 {
+				sv.Amplitudes[i] = -sv.Amplitudes[i]
+			}
+		}
+	}
+}
+
+// ------------------------------------------------------------------
+// Readout
+// ------------------------------------------------------------------
+
+// readoutModel flips measured bits of qubit q with probability P01[q]
+// (0 read as 1) or P10[q]. A calibrated model replaces each ±1 outcome z
+// by the unbiased (z − Offset)/Scale, which is the inverse of the qubit's
+// confusion matrix; a nil model is an ideal readout.
+type readoutModel struct {
+	P01, P10      []float64
+	Offset, Scale []float64 // Calibration; nil = raw outcomes
+}
+
+func newReadoutModel(numQubits int, p01, p10 float64) *readoutModel {
+	r := &readoutModel{P01: make([]float64, numQubits), P10: make([]float64, numQubits)}
+	for q := range r.P01 {
+		r.P01[q], r.P10[q] = p01, p10
+	}
+	return r
+}
+
+// corrupt applies readout errors to the measured qubits of an outcome
+func (r *readoutModel) corrupt(outcome, support int, rng *rand.Rand) int {
+	if r == nil {
+		return outcome
+	}
+	for q := range r.P01 {
+		mask := 1 << q
+		if support&mask == 0 {
+			continue
+		}
+		flip := r.P01[q]
+		if outcome&mask != 0 {
+			flip = r.P10[q]
+		}
+		if rng.Float64() < flip {
+			outcome ^= mask
+		}
+	}
+	return outcome
+}
+
+// estimate is a term's value for one measured outcome
+func (r *readoutModel) estimate(outcome, mask int) float64 {
+	if r == nil || r.Scale == nil {
+		if bits.OnesCount(uint(outcome&mask))%2 == 1 {
+			return -1
+		}
+		return 1
+	}
+	value := 1.0
+	for q := range r.Scale {
+		if mask&(1<<q) == 0 {
+			continue
+		}
+		z := 1.0
+		if outcome&(1<<q) != 0 {
+			z = -1
+		}
+		value *= (z - r.Offset[q]) / r.Scale[q]
+	}
+	return value
+}
+
+// expected is the mean of estimate over readout errors for a true outcome
+func (r *readoutModel) expected(outcome, mask int) float64 {
+	if r == nil {
+		return r.estimate(outcome, mask)
+	}
+	value := 1.0
+	for q := range r.P01 {
+		if mask&(1<<q) == 0 {
+			continue
+		}
+		z := 1 - 2*r.P01[q] // E[z] when the qubit is 0
+		if outcome&(1<<q) != 0 {
+			z = -(1 - 2*r.P10[q])
+		}
+		if r.Scale != nil {
+			z = (z - r.Offset[q]) / r.Scale[q]
+		}
+		value *= z
+	}
+	return value
+}
+
+// calibrate measures |0…0⟩ and |1…1⟩ `shots` times each (exactly when
+// shots = 0) and returns a copy of the model that inverts the estimated
+// per-qubit confusion matrices
+func (r *readoutModel) calibrate(shots int, rng *rand.Rand) (*readoutModel, error) {
+	n := len(r.P01)
+	cal := &readoutModel{P01: r.P01, P10: r.P10, Offset: make([]float64, n), Scale: make([]float64, n)}
+	all := 1<<n - 1
+	estimated01, estimated10 := append([]float64(nil), r.P01...), append([]float64(nil), r.P10...)
+	if shots > 0 {
+		ones, zeros := make([]int, n), make([]int, n)
+		for s := 0; s < shots; s++ {
+			fromZero := r.corrupt(0, all, rng)
+			fromOne := r.corrupt(all, all, rng)
+			for q := 0; q < n; q++ {
+				if fromZero&(1<<q) != 0 {
+					ones[q]++
+				}
+				if fromOne&(1<<q) == 0 {
+					zeros[q]++
+				}
+			}
+		}
+		for q := 0; q < n; q++ {
+			estimated01[q] = float64(ones[q]) / float64(shots)
+			estimated10[q] = float64(zeros[q]) / float64(shots)
+		}
+	}
+
+	// A qubit reads ⟨z⟩_measured = Offset + Scale·z
+	for q := 0; q < n; q++ {
+		cal.Offset[q] = estimated10[q] - estimated01[q]
+		cal.Scale[q] = 1 - estimated01[q] - estimated10[q]
+		if cal.Scale[q] < 0.1 {
+			return nil, fmt.Errorf("qubit %d readout is too noisy to invert (P01=%.3f, P10=%.3f)",
+				q, estimated01[q], estimated10[q])
+		}
+	}
+	return cal, nil
+}
+
+// calibration reports the estimated error rates of a calibrated model
+func (r *readoutModel) calibration() []*pb.ReadoutCalibration {
+	var out []*pb.ReadoutCalibration
+	for q := range r.Scale {
+		// Offset = P10 − P01 and Scale = 1 − P01 − P10
+		p01 := (1 - r.Scale[q] - r.Offset[q]) / 2
+		out = append(out, &pb.ReadoutCalibration{Qubit: int32(q), Error_01: p01, Error_10: p01 + r.Offset[q]})
+	}
+	return out
+}
+
+// ------------------------------------------------------------------
+// Noisy Energy Estimation
+// ------------------------------------------------------------------
+
+// noisyEnergy averages the measured energy over Pauli trajectories of the
+// folded circuit, splitting the shots between trajectories. Its variance
+// is the spread of the trajectory energies, which includes shot noise.
+func noisyEnergy(h *pb.Hamiltonian, circuit *AnsatzCircuit, params []float64, noise *pb.NoiseModel,
+	scale, shots int, readout *readoutModel, rng *rand.Rand) (*EnergyEstimate, error) {
+	trajectories := 1
+	if noise.GateError > 0 {
+		trajectories = int(noise.Trajectories)
+		if trajectories <= 0 {
+			trajectories = defaultTrajectories
+		}
+	}
+	perTrajectory := 0
+	if shots > 0 {
+		perTrajectory = (shots + trajectories - 1) / trajectories
+	}
+
+	avg := &EnergyEstimate{
+		Contributions: make(map[string]float64),
+		TermValues:    make([]float64, len(h.Terms)),
+		TermVariances: make([]float64, len(h.Terms)),
+	}
+	var energySquares float64
+	termSquares := make([]float64, len(h.Terms))
+	for t := 0; t < trajectories; t++ {
+		sv, err := circuit.RunNoisy(params, scale, noise.GateError, rng)
+		if err != nil {
+			return nil, err
+		}
+		est := measureEnergy(h, sv, perTrajectory, rng, readout)
+		avg.Energy += est.Energy / float64(trajectories)
+		energySquares += est.Energy * est.Energy
+		avg.Shots += est.Shots
+		for label, v := range est.Contributions {
+			avg.Contributions[label] += v / float64(trajectories)
+		}
+		for i, v := range est.TermValues {
+			avg.TermValues[i] += v / float64(trajectories)
+			termSquares[i] += v * v
+		}
+		if trajectories == 1 {
+			avg.Variance, avg.TermVariances = est.Variance, est.TermVariances
+		}
+	}
+
+	// Variance of the mean from the spread over trajectories
+	if trajectories > 1 {
+		spread := func(sumSquares, mean float64) float64 {
+			return math.Max(0, sumSquares-float64(trajectories)*mean*mean) / float64(trajectories*(trajectories-1))
+		}
+		avg.Variance = spread(energySquares, avg.Energy)
+		for i, term := range h.Terms {
+			avg.TermVariances[i] = term.Coefficient * term.Coefficient * spread(termSquares[i], avg.TermValues[i])
+		}
+	}
+	return avg, nil
+}
+
+// extrapolateToZero fits E(λ) and evaluates it at λ = 0: "richardson"
+// passes a polynomial through every point, "linear" is a least-squares line
+func extrapolateToZero(scales []int32, energies []float64, method string) (float64, error) {
+	switch strings.ToLower(method) {
+	case "", "richardson":
+		zero := 0.0
+		for i, e := range energies {
+			weight := 1.0
+			for j, s := range scales {
+				if j != i {
+					weight *= float64(s) / float64(s-scales[i])
+				}
+			}
+			zero += weight * e
+		}
+		return zero, nil
+	case "linear":
+		var sx, sy, sxx, sxy float64
+		n := float64(len(scales))
+		for i, s := range scales {
+			x := float64(s)
+			sx, sy, sxx, sxy = sx+x, sy+energies[i], sxx+x*x, sxy+x*energies[i]
+		}
+		slope := (n*sxy - sx*sy) / (n*sxx - sx*sx)
+		return (sy - slope*sx) / n, nil
+	}
+	return 0, fmt.Errorf("unknown extrapolation %q (use richardson or linear)", method)
+}
+
+// validateNoise checks the noise model and mitigation options and returns
+// the noise scales to run
+func validateNoise(noise *pb.NoiseModel, mitigation *pb.MitigationOptions) ([]int32, error) {
+	switch {
+	case noise.GateError < 0 || noise.GateError >= 1:
+		return nil, errors.New("gate_error must be in [0, 1)")
+	case noise.ReadoutError_01 < 0 || noise.ReadoutError_01 >= 0.5 || noise.ReadoutError_10 < 0 || noise.ReadoutError_10 >= 0.5:
+		return nil, errors.New("readout errors must be in [0, 0.5)")
+	case noise.Trajectories < 0 || noise.Trajectories > maxTrajectories:
+		return nil, fmt.Errorf("trajectories must be at most %d", maxTrajectories)
+	}
+	if mitigation == nil || !mitigation.ZeroNoiseExtrapolation {
+		return []int32{1}, nil
+	}
+	if m := strings.ToLower(mitigation.Extrapolation); m != "" && m != "richardson" && m != "linear" {
+		return nil, fmt.Errorf("unknown extrapolation %q (use richardson or linear)", mitigation.Extrapolation)
+	}
+
+	scales := mitigation.NoiseScales
+	if len(scales) == 0 {
+		scales = defaultNoiseScales
+	}
+	if len(scales) < 2 {
+		return nil, errors.New("zero-noise extrapolation needs at least two noise scales")
+	}
+	seen := make(map[int32]bool)
+	for _, s := range scales {
+		if s < 1 || s%2 == 0 || s > maxNoiseScale {
+			return nil, fmt.Errorf("noise scale %d must be odd and 1-%d (folding U(U†U)^k)", s, maxNoiseScale)
+		}
+		if seen[s] {
+			return nil, fmt.Errorf("noise scale %d is repeated", s)
+		}
+		seen[s] = true
+	}
+	return scales, nil
+}
+
+// mitigatedExpectation measures the raw noisy energy and, as requested,
+// the readout-corrected energies at each noise scale and their
+// zero-noise extrapolation
+func mitigatedExpectation(h *pb.Hamiltonian, circuit *AnsatzCircuit, params []float64, noise *pb.NoiseModel,
+	mitigation *pb.MitigationOptions, shots int, rng *rand.Rand) (*EnergyEstimate, *pb.MitigationResult, error) {
+	scales, err := validateNoise(noise, mitigation)
+	if err != nil {
+		return nil, nil, err
+	}
+	var readout *readoutModel
+	if noise.ReadoutError_01 > 0 || noise.ReadoutError_10 > 0 {
+		readout = newReadoutModel(circuit.NumQubits, noise.ReadoutError_01, noise.ReadoutError_10)
+	}
+
+	raw, err := noisyEnergy(h, circuit, params, noise, 1, shots, readout, rng)
+	if err != nil || mitigation == nil {
+		return raw, nil, err
+	}
+
+	result := &pb.MitigationResult{RawEnergy: raw.Energy, NoiseScales: scales}
+	corrected := readout
+	if mitigation.ReadoutMitigation {
+		if readout == nil {
+			readout = newReadoutModel(circuit.NumQubits, 0, 0)
+		}
+		if corrected, err = readout.calibrate(shots, rng); err != nil {
+			return nil, nil, err
+		}
+		result.ReadoutCalibration = corrected.calibration()
+	}
+
+	for _, scale := range scales {
+		est := raw
+		if scale != 1 || corrected != readout {
+			if est, err = noisyEnergy(h, circuit, params, noise, int(scale), shots, corrected, rng); err != nil {
+				return nil, nil, err
+			}
+		}
+		result.ScaledEnergies = append(result.ScaledEnergies, est.Energy)
+	}
+
+	result.MitigatedEnergy = result.ScaledEnergies[0]
+	if len(scales) > 1 {
+		if result.MitigatedEnergy, err = extrapolateToZero(scales, result.ScaledEnergies, mitigation.Extrapolation); err != nil {
+			return nil, nil, err
+		}
+	}
+	log.Printf("🧹 Mitigation: raw E=%.6f Ha → mitigated E=%.6f Ha (scales %v)",
+		result.RawEnergy, result.MitigatedEnergy, scales)
+	return raw, result, nil
+}
@@ -0,0 +1,730 @@
+// Classical Optimizers for VQE
+// Each optimizer advances one iteration per Step, so FindGroundState can
+// stream progress and diagnostics after every update.
+//
+//	COBYLA            linear-interpolation trust region (gradient-free)
+//	SPSA              simultaneous perturbation stochastic approximation
+//	Nelder-Mead       downhill simplex (gradient-free)
+//	Adam              adaptive moment estimation
+//	Gradient descent  fixed learning rate
+//	L-BFGS            limited-memory quasi-Newton with backtracking search
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+var optimizerNames = map[pb.OptimizerType]string{
+	pb.OptimizerType_OPTIMIZER_COBYLA:           "COBYLA",
+	pb.OptimizerType_OPTIMIZER_SPSA:             "SPSA",
+	pb.OptimizerType_OPTIMIZER_ADAM:             "Adam",
+	pb.OptimizerType_OPTIMIZER_GRADIENT_DESCENT: "gradient descent",
+	pb.OptimizerType_OPTIMIZER_NELDER_MEAD:      "Nelder-Mead",
+	pb.OptimizerType_OPTIMIZER_LBFGS:            "L-BFGS",
+}
+
+// ------------------------------------------------------------------
+// Objective
+// ------------------------------------------------------------------
+
+// Objective is the energy landscape of one ansatz and Hamiltonian. It
+// counts circuit evaluations and caches estimates per parameter vector, so
+// optimizers that revisit a point (or the final report) do not re-measure it.
+type Objective struct {
+	circuit     *AnsatzCircuit
+	hamiltonian *pb.Hamiltonian
+	shots       int
+	rng         *rand.Rand
+	cache       map[string]*EnergyEstimate
+	deflation   []deflatedState
+	Evaluations int
+}
+
+// deflatedState is a previously found eigenstate that variational quantum
+// deflation pushes the search away from
+type deflatedState struct {
+	state  *Statevector
+	weight float64
+}
+
+func NewObjective(circuit *AnsatzCircuit, h *pb.Hamiltonian, shots int, rng *rand.Rand) *Objective {
+	return &Objective{
+		circuit:     circuit,
+		hamiltonian: h,
+		shots:       shots,
+		rng:         rng,
+		cache:       make(map[string]*EnergyEstimate),
+	}
+}
+
+// Deflate adds the penalty weight·|⟨state|ψ(θ)⟩|² to the objective, so its
+// minimum moves to a state orthogonal to state. Call it before evaluating.
+func (o *Objective) Deflate(state *Statevector, weight float64) {
+	o.deflation = append(o.deflation, deflatedState{state: state, weight: weight})
+}
+
+// Estimate returns the full energy estimate at params
+func (o *Objective) Estimate(params []float64) *EnergyEstimate {
+	return o.EstimateBatch([][]float64{params})[0]
+}
+
+func (o *Objective) Energy(params []float64) float64 {
+	return o.Estimate(params).Energy
+}
+
+// EstimateBatch evaluates every uncached point concurrently. Each circuit
+// gets its own shot-noise source, drawn in order so results stay
+// reproducible for a seeded rng.
+func (o *Objective) EstimateBatch(points [][]float64) []*EnergyEstimate {
+	results := make([]*EnergyEstimate, len(points))
+	pending := make(map[string][]int)
+	var order []string
+	for i, p := range points {
+		key := fmt.Sprint(p)
+		if est, ok := o.cache[key]; ok {
+			results[i] = est
+			continue
+		}
+		if _, ok := pending[key]; !ok {
+			order = append(order, key)
+		}
+		pending[key] = append(pending[key], i)
+	}
+
+	estimates := make([]*EnergyEstimate, len(order))
+	var wg sync.WaitGroup
+	for k, key := range order {
+		params := points[pending[key][0]]
+		rng := rand.New(rand.NewSource(o.rng.Int63()))
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			sv, _ := o.circuit.Run(params) // Optimizers never change the parameter count
+			est := MeasureEnergy(o.hamiltonian, sv, o.shots, rng)
+			for _, d := range o.deflation {
+				est.Penalty += d.weight * sv.Overlap(d.state) // Exact: a swap test on hardware
+			}
+			est.Energy += est.Penalty
+			estimates[k] = est
+		}(k)
+	}
+	wg.Wait()
+
+	for k, key := range order {
+		o.cache[key] = estimates[k]
+		for _, i := range pending[key] {
+			results[i] = estimates[k]
+		}
+	}
+	o.Evaluations += len(order)
+	return results
+}
+
+// ------------------------------------------------------------------
+// Optimizer Interface
+// ------------------------------------------------------------------
+
+// StepResult is the optimizer's current point after one iteration
+type StepResult struct {
+	Params   []float64
+	Energy   float64
+	Gradient []float64 // Gradient (or estimate) used for the step, if any
+}
+
+type Optimizer interface {
+	// Step runs one iteration and returns the best point so far
+	Step(obj *Objective) StepResult
+	// StepSize is the optimizer's current scale: trust radius, simplex
+	// size, learning rate, gain or line-search step
+	StepSize() float64
+	// Converged applies the optimizer's stopping rule
+	Converged(energyChange, threshold float64) bool
+}
+
+// NewOptimizer creates the requested optimizer starting from x0, reading
+// its hyperparameters from the request (zero values take the defaults)
+func NewOptimizer(req *pb.VQERequest, x0 []float64, rng *rand.Rand) (Optimizer, error) {
+	x := append([]float64(nil), x0...)
+	switch req.Optimizer {
+	case pb.OptimizerType_OPTIMIZER_COBYLA:
+		o := req.Cobyla
+		if o == nil {
+			o = &pb.CobylaOptions{}
+		}
+		return &cobyla{
+			x:      x,
+			rho:    orDefault(o.InitialTrustRadius, 0.5),
+			rhoEnd: orDefault(o.FinalTrustRadius, 1e-4),
+		}, nil
+	case pb.OptimizerType_OPTIMIZER_SPSA:
+		o := req.Spsa
+		if o == nil {
+			o = &pb.SPSAOptions{}
+		}
+		return &spsa{
+			x:         x,
+			a:         orDefault(o.A, 0.2),
+			c:         orDefault(o.C, 0.1),
+			alpha:     orDefault(o.Alpha, 0.602),
+			gamma:     orDefault(o.Gamma, 0.101),
+			stability: orDefault(o.Stability, 10),
+			rng:       rng,
+		}, nil
+	case pb.OptimizerType_OPTIMIZER_ADAM:
+		o := req.Adam
+		if o == nil {
+			o = &pb.AdamOptions{}
+		}
+		return &adam{
+			x:       x,
+			lr:      orDefault(o.LearningRate, 0.05),
+			beta1:   orDefault(o.Beta1, 0.9),
+			beta2:   orDefault(o.Beta2, 0.999),
+			epsilon: orDefault(o.Epsilon, 1e-8),
+			m:       make([]float64, len(x)),
+			v:       make([]float64, len(x)),
+		}, nil
+	case pb.OptimizerType_OPTIMIZER_GRADIENT_DESCENT:
+		o := req.GradientDescent
+		if o == nil {
+			o = &pb.GradientDescentOptions{}
+		}
+		return &gradientDescent{x: x, lr: orDefault(o.LearningRate, 0.1)}, nil
+	case pb.OptimizerType_OPTIMIZER_NELDER_MEAD:
+		o := req.NelderMead
+		if o == nil {
+			o = &pb.NelderMeadOptions{}
+		}
+		return &nelderMead{
+			x0:          x,
+			initial:     orDefault(o.InitialSimplex, 0.5),
+			reflection:  orDefault(o.Reflection, 1),
+			expansion:   orDefault(o.Expansion, 2),
+			contraction: orDefault(o.Contraction, 0.5),
+			shrink:      orDefault(o.Shrink, 0.5),
+		}, nil
+	case pb.OptimizerType_OPTIMIZER_LBFGS:
+		o := req.Lbfgs
+		if o == nil {
+			o = &pb.LBFGSOptions{}
+		}
+		memory := int(o.Memory)
+		if memory <= 0 {
+			memory = 10
+		}
+		return &lbfgs{x: x, memory: memory, armijo: orDefault(o.Armijo, 1e-4)}, nil
+	}
+	return nil, fmt.Errorf("unknown optimizer %d", req.Optimizer)
+}
+
+func orDefault(v, def float64) float64 {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+// ------------------------------------------------------------------
+// Gradient Descent & Adam
+// ------------------------------------------------------------------
+
+type gradientDescent struct {
+	x  []float64
+	lr float64
+}
+
+func (o *gradientDescent) Step(obj *Objective) StepResult {
+	g := obj.Gradient(o.x)
+	for i := range o.x {
+		o.x[i] -= o.lr * g[i]
+	}
+	return StepResult{Params: o.x, Energy: obj.Energy(o.x), Gradient: g}
+}
+
+func (o *gradientDescent) StepSize() float64 { return o.lr }
+
+func (o *gradientDescent) Converged(change, threshold float64) bool { return change < threshold }
+
+type adam struct {
+	x, m, v                   []float64
+	lr, beta1, beta2, epsilon float64
+	t                         int
+}
+
+func (o *adam) Step(obj *Objective) StepResult {
+	g := obj.Gradient(o.x)
+	o.t++
+	for i := range o.x {
+		o.m[i] = o.beta1*o.m[i] + (1-o.beta1)*g[i]
+		o.v[i] = o.beta2*o.v[i] + (1-o.beta2)*g[i]*g[i]
+		mHat := o.m[i] / (1 - math.Pow(o.beta1, float64(o.t)))
+		vHat := o.v[i] / (1 - math.Pow(o.beta2, float64(o.t)))
+		o.x[i] -= o.lr * mHat / (math.Sqrt(vHat) + o.epsilon)
+	}
+	return StepResult{Params: o.x, Energy: obj.Energy(o.x), Gradient: g}
+}
+
+func (o *adam) StepSize() float64 { return o.lr }
+
+func (o *adam) Converged(change, threshold float64) bool { return change < threshold }
+
+// ------------------------------------------------------------------
+// SPSA
+// ------------------------------------------------------------------
+
+// spsa estimates the gradient from two evaluations along a random ±1
+// direction, with gains a_k = a/(k+1+A)^α and c_k = c/(k+1)^γ
+type spsa struct {
+	x                             []float64
+	a, c, alpha, gamma, stability float64
+	k                             int
+	calm                          int // Consecutive iterations below threshold
+	rng                           *rand.Rand
+}
+
+// spsaCalmIterations guards against one lucky small change ending a
+// stochastic search
+const spsaCalmIterations = 5
+
+func (o *spsa) Step(obj *Objective) StepResult {
+	ak := o.StepSize()
+	ck := o.c / math.Pow(float64(o.k+1), o.gamma)
+	o.k++
+
+	delta := make([]float64, len(o.x))
+	plus := make([]float64, len(o.x))
+	minus := make([]float64, len(o.x))
+	for i := range o.x {
+		delta[i] = 1
+		if o.rng.Intn(2) == 0 {
+			delta[i] = -1
+		}
+		plus[i] = o.x[i] + ck*delta[i]
+		minus[i] = o.x[i] - ck*delta[i]
+	}
+	diff := obj.Energy(plus) - obj.Energy(minus)
+
+	g := make([]float64, len(o.x))
+	for i := range o.x {
+		g[i] = diff / (2 * ck * delta[i])
+		o.x[i] -= ak * g[i]
+	}
+	return StepResult{Params: o.x, Energy: obj.Energy(o.x), Gradient: g}
+}
+
+func (o *spsa) StepSize() float64 {
+	return o.a / math.Pow(float64(o.k+1)+o.stability, o.alpha)
+}
+
+func (o *spsa) Converged(change, threshold float64) bool {
+	if change < threshold {
+		o.calm++
+	} else {
+		o.calm = 0
+	}
+	return o.calm >= spsaCalmIterations
+}
+
+// ------------------------------------------------------------------
+// Nelder-Mead
+// ------------------------------------------------------------------
+
+type nelderMead struct {
+	x0                                                  []float64
+	initial, reflection, expansion, contraction, shrink float64
+	simplex                                             [][]float64
+	values                                              []float64
+}
+
+func (o *nelderMead) Step(obj *Objective) StepResult {
+	n := len(o.x0)
+	if o.simplex == nil {
+		o.simplex = [][]float64{o.x0}
+		for i := 0; i < n; i++ {
+			vertex := append([]float64(nil), o.x0...)
+			vertex[i] += o.initial
+			o.simplex = append(o.simplex, vertex)
+		}
+		for _, vertex := range o.simplex {
+			o.values = append(o.values, obj.Energy(vertex))
+		}
+	}
+	o.order()
+
+	// Centroid of every vertex but the worst
+	centroid := make([]float64, n)
+	for _, vertex := range o.simplex[:n] {
+		for i := range centroid {
+			centroid[i] += vertex[i] / float64(n)
+		}
+	}
+	along := func(from []float64, scale float64) []float64 {
+		p := make([]float64, n)
+		for i := range p {
+			p[i] = centroid[i] + scale*(from[i]-centroid[i])
+		}
+		return p
+	}
+
+	worst := o.simplex[n]
+	reflected := along(worst, -o.reflection)
+	fr := obj.Energy(reflected)
+
+	switch {
+	case fr < o.values[0]:
+		expanded := along(worst, -o.reflection*o.expansion)
+		if fe := obj.Energy(expanded); fe < fr {
+			o.replaceWorst(expanded, fe)
+		} else {
+			o.replaceWorst(reflected, fr)
+		}
+	case fr < o.values[n-1]:
+		o.replaceWorst(reflected, fr)
+	default:
+		var contracted []float64
+		var limit float64
+		if fr < o.values[n] {
+			contracted, limit = along(worst, -o.reflection*o.contraction), fr // Outside
+		} else {
+			contracted, limit = along(worst, o.contraction), o.values[n] // Inside
+		}
+		if fc := obj.Energy(contracted); fc < limit {
+			o.replaceWorst(contracted, fc)
+		} else {
+			best := o.simplex[0]
+			for v := 1; v <= n; v++ {
+				for i := range o.simplex[v] {
+					o.simplex[v][i] = best[i] + o.shrink*(o.simplex[v][i]-best[i])
+				}
+				o.values[v] = obj.Energy(o.simplex[v])
+			}
+		}
+	}
+
+	o.order()
+	return StepResult{Params: append([]float64(nil), o.simplex[0]...), Energy: o.values[0]}
+}
+
+func (o *nelderMead) replaceWorst(vertex []float64, value float64) {
+	n := len(o.simplex) - 1
+	o.simplex[n], o.values[n] = vertex, value
+}
+
+// order sorts vertices from best to worst
+func (o *nelderMead) order() {
+	idx := make([]int, len(o.simplex))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return o.values[idx[a]] < o.values[idx[b]] })
+	simplex := make([][]float64, len(idx))
+	values := make([]float64, len(idx))
+	for i, j := range idx {
+		simplex[i], values[i] = o.simplex[j], o.values[j]
+	}
+	o.simplex, o.values = simplex, values
+}
+
+// StepSize is the largest distance from the best vertex
+func (o *nelderMead) StepSize() float64 {
+	if o.simplex == nil {
+		return o.initial
+	}
+	size := 0.0
+	for _, vertex := range o.simplex[1:] {
+		size = math.Max(size, distance(vertex, o.simplex[0]))
+	}
+	return size
+}
+
+// Converged once the simplex values agree to within threshold
+func (o *nelderMead) Converged(change, threshold float64) bool {
+	return o.values != nil && o.values[len(o.values)-1]-o.values[0] < threshold
+}
+
+// ------------------------------------------------------------------
+// COBYLA
+// ------------------------------------------------------------------
+
+// cobyla is Powell's Constrained Optimization BY Linear Approximation for
+// the unconstrained case VQE needs: a linear model interpolates n+1 points,
+// the step minimizes it within trust radius rho, and rho halves whenever a
+// step fails on a well-shaped simplex, until it reaches rhoEnd
+type cobyla struct {
+	x           []float64
+	rho, rhoEnd float64
+	points      [][]float64
+	values      []float64
+	done        bool
+}
+
+func (o *cobyla) Step(obj *Objective) StepResult {
+	if o.points == nil {
+		o.rebuild(obj, o.x)
+	}
+	b := o.best()
+	if o.done {
+		return StepResult{Params: o.points[b], Energy: o.values[b]}
+	}
+
+	g, ok := o.model(b)
+	norm := vectorNorm(g)
+	if !ok || norm == 0 {
+		o.shrinkRadius(obj, b)
+		b = o.best()
+		return StepResult{Params: o.points[b], Energy: o.values[b]}
+	}
+
+	trial := make([]float64, len(o.x))
+	for i := range trial {
+		trial[i] = o.points[b][i] - o.rho*g[i]/norm
+	}
+	ft := obj.Energy(trial)
+
+	if ft < o.values[b] {
+		// Keep the simplex local: the trial replaces the point farthest from it
+		far, farDist := -1, -1.0
+		for i, p := range o.points {
+			if d := distance(p, trial); i != b && d > farDist {
+				far, farDist = i, d
+			}
+		}
+		o.points[far], o.values[far] = trial, ft
+	} else if o.diameter(b) > 2*o.rho {
+		o.rebuild(obj, o.points[b]) // Stale geometry, not a bad model
+	} else {
+		o.shrinkRadius(obj, b)
+	}
+
+	b = o.best()
+	return StepResult{Params: o.points[b], Energy: o.values[b]}
+}
+
+// model fits g with (p_i − p_b)·g = f_i − f_b for every other point
+func (o *cobyla) model(b int) ([]float64, bool) {
+	var rows [][]float64
+	var rhs []float64
+	for i, p := range o.points {
+		if i == b {
+			continue
+		}
+		row := make([]float64, len(p))
+		for j := range p {
+			row[j] = p[j] - o.points[b][j]
+		}
+		rows = append(rows, row)
+		rhs = append(rhs, o.values[i]-o.values[b])
+	}
+	return solveLinear(rows, rhs)
+}
+
+// shrinkRadius halves rho (finishing at rhoEnd) and re-samples around the best point
+func (o *cobyla) shrinkRadius(obj *Objective, b int) {
+	if o.rho <= o.rhoEnd {
+		o.done = true
+		return
+	}
+	o.rho = math.Max(o.rho/2, o.rhoEnd)
+	o.rebuild(obj, o.points[b])
+}
+
+// rebuild places the interpolation points at center + rho·e_i
+func (o *cobyla) rebuild(obj *Objective, center []float64) {
+	center = append([]float64(nil), center...)
+	o.points = [][]float64{center}
+	o.values = []float64{obj.Energy(center)}
+	for i := range center {
+		p := append([]float64(nil), center...)
+		p[i] += o.rho
+		o.points = append(o.points, p)
+		o.values = append(o.values, obj.Energy(p))
+	}
+}
+
+func (o *cobyla) best() int {
+	b := 0
+	for i, v := range o.values {
+		if v < o.values[b] {
+			b = i
+		}
+	}
+	return b
+}
+
+func (o *cobyla) diameter(b int) float64 {
+	d := 0.0
+	for _, p := range o.points {
+		d = math.Max(d, distance(p, o.points[b]))
+	}
+	return d
+}
+
+func (o *cobyla) StepSize() float64 { return o.rho }
+
+func (o *cobyla) Converged(change, threshold float64) bool { return o.done }
+
+// ------------------------------------------------------------------
+// L-BFGS
+// ------------------------------------------------------------------
+
+// lbfgs approximates the inverse Hessian from the last `memory` curvature
+// pairs (two-loop recursion) and backtracks until the Armijo condition holds
+type lbfgs struct {
+	x, g         []float64
+	f            float64
+	memory       int
+	armijo       float64
+	sHist, yHist [][]float64
+	step         float64
+}
+
+const minLineSearchStep = 1e-10
+
+func (o *lbfgs) Step(obj *Objective) StepResult {
+	if o.g == nil {
+		o.f, o.g = obj.Energy(o.x), obj.Gradient(o.x)
+	}
+
+	d := o.direction()
+	slope := dot(d, o.g)
+	if slope >= 0 { // Not a descent direction: forget the curvature history
+		o.sHist, o.yHist = nil, nil
+		d = o.direction()
+		slope = dot(d, o.g)
+	}
+
+	t := 1.0
+	if len(o.sHist) == 0 {
+		t = math.Min(1, 1/math.Max(vectorNorm(o.g), 1e-12))
+	}
+	next := make([]float64, len(o.x))
+	for ; t >= minLineSearchStep; t /= 2 {
+		for i := range next {
+			next[i] = o.x[i] + t*d[i]
+		}
+		if obj.Energy(next) <= o.f+o.armijo*t*slope {
+			break
+		}
+	}
+	if t < minLineSearchStep {
+		o.step = 0
+		return StepResult{Params: o.x, Energy: o.f, Gradient: o.g}
+	}
+
+	gNext := obj.Gradient(next)
+	s := make([]float64, len(o.x))
+	y := make([]float64, len(o.x))
+	for i := range s {
+		s[i], y[i] = next[i]-o.x[i], gNext[i]-o.g[i]
+	}
+	if dot(s, y) > 1e-12 {
+		o.sHist, o.yHist = append(o.sHist, s), append(o.yHist, y)
+		if len(o.sHist) > o.memory {
+			o.sHist, o.yHist = o.sHist[1:], o.yHist[1:]
+		}
+	}
+
+	o.step = t * vectorNorm(d)
+	o.x, o.f, o.g = next, obj.Energy(next), gNext
+	return StepResult{Params: o.x, Energy: o.f, Gradient: o.g}
+}
+
+// direction returns −H·g by the two-loop recursion
+func (o *lbfgs) direction() []float64 {
+	q := make([]float64, len(o.g))
+	for i := range q {
+		q[i] = -o.g[i]
+	}
+	alphas := make([]float64, len(o.sHist))
+	for k := len(o.sHist) - 1; k >= 0; k-- {
+		alphas[k] = dot(o.sHist[k], q) / dot(o.yHist[k], o.sHist[k])
+		for i := range q {
+			q[i] -= alphas[k] * o.yHist[k][i]
+		}
+	}
+	if k := len(o.sHist) - 1; k >= 0 {
+		gamma := dot(o.sHist[k], o.yHist[k]) / dot(o.yHist[k], o.yHist[k])
+		for i := range q {
+			q[i] *= gamma
+		}
+	}
+	for k := range o.sHist {
+		beta := dot(o.yHist[k], q) / dot(o.yHist[k], o.sHist[k])
+		for i := range q {
+			q[i] += (alphas[k] - beta) * o.sHist[k][i]
+		}
+	}
+	return q
+}
+
+func (o *lbfgs) StepSize() float64 { return o.step }
+
+func (o *lbfgs) Converged(change, threshold float64) bool { return change < threshold }
+
+// ------------------------------------------------------------------
+// Linear Algebra Helpers
+// ------------------------------------------------------------------
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+func vectorNorm(v []float64) float64 {
+	return math.Sqrt(dot(v, v))
+}
+
+func distance(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(sum)
+}
+
+// solveLinear solves A·x = b by Gaussian elimination with partial
+// pivoting; ok is false when A is (numerically) singular
+func solveLinear(a [][]float64, b []float64) ([]float64, bool) {
+	n := len(b)
+	m := make([][]float64, n)
+	for i := range a {
+		m[i] = append(append([]float64(nil), a[i]...), b[i])
+	}
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(m[r][col]) > math.Abs(m[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-12 {
+			return nil, false
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		for r := col + 1; r < n; r++ {
+			f := m[r][col] / m[col][col]
+			for c := col; c <= n; c++ {
+				m[r][c] -= f * m[col][c]
+			}
+		}
+	}
+	x := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := m[r][n]
+		for c := r + 1; c < n; c++ {
+			sum -= m[r][c] * x[c]
+		}
+		x[r] = sum / m[r][r]
+	}
+	return x, true
+}
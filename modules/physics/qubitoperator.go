@@ -0,0 +1,295 @@
+// Qubit Operator Import
+// Hamiltonians already mapped to qubits elsewhere (OpenFermion, Qiskit or
+// hand-written spin models) are parsed into the internal Pauli-sum form:
+//
+//	JSON  OpenFermion QubitOperator.terms, as an object keyed by term or a
+//	      list of [term, coefficient] pairs, optionally under "terms":
+//	        {"X0 Y1": 0.5, "": -0.1}
+//	        {"((0, 'X'), (1, 'Y'))": 0.5}
+//	        [[[[0, "X"], [1, "Y"]], 0.5], [[], -0.1]]
+//	      Coefficients are numbers, [re, im] pairs or {"real", "imag"}.
+//	Text  one term per line, "0.5 Z0 Z1", or the str(QubitOperator) form
+//	      "(0.5+0j) [Z0 Z1] +"; an empty term is the identity and "#"
+//	      starts a comment.
+//
+// Repeated terms are summed; imaginary parts must cancel, since only
+// Hermitian operators have real energies.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+// pauliFactor matches "X3" and OpenFermion's tuple form "(3, 'X')"
+var (
+	pauliFactor = regexp.MustCompile(`^([IXYZ])(\d+)$`)
+	tupleFactor = regexp.MustCompile(`\(\s*(\d+)\s*,\s*['"]([IXYZ])['"]\s*\)`)
+)
+
+// parsedTerm is one imported term before qubits are counted
+type parsedTerm struct {
+	Coefficient complex128
+	Factors     map[int]pb.PauliType
+}
+
+// ParseQubitOperator reads a Pauli sum in "json", "text" or "" (detect)
+// format and returns its terms
+func ParseQubitOperator(data, format string) ([]parsedTerm, error) {
+	trimmed := strings.TrimSpace(data)
+	if trimmed == "" {
+		return nil, errors.New("operator is empty")
+	}
+	switch strings.ToLower(format) {
+	case "":
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			return parseOperatorJSON([]byte(trimmed))
+		}
+		return parseOperatorText(trimmed)
+	case "json", "openfermion":
+		return parseOperatorJSON([]byte(trimmed))
+	case "text":
+		return parseOperatorText(trimmed)
+	}
+	return nil, fmt.Errorf("unknown operator format %q (use json or text)", format)
+}
+
+func parseOperatorJSON(data []byte) ([]parsedTerm, error) {
+	var root interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid operator JSON: %w", err)
+	}
+	if obj, ok := root.(map[string]interface{}); ok {
+		if inner, ok := obj["terms"]; ok && len(obj) == 1 {
+			root = inner
+		}
+	}
+
+	var terms []parsedTerm
+	switch v := root.(type) {
+	case map[string]interface{}:
+		for label, raw := range v {
+			factors, err := parseTermLabel(label)
+			if err != nil {
+				return nil, err
+			}
+			c, err := parseJSONCoefficient(raw)
+			if err != nil {
+				return nil, fmt.Errorf("term %q: %w", label, err)
+			}
+			terms = append(terms, parsedTerm{Coefficient: c, Factors: factors})
+		}
+	case []interface{}:
+		for i, item := range v {
+			pair, ok := item.([]interface{})
+			if !ok || len(pair) != 2 {
+				return nil, fmt.Errorf("term %d: want [term, coefficient]", i)
+			}
+			factors, err := parseJSONTerm(pair[0])
+			if err != nil {
+				return nil, fmt.Errorf("term %d: %w", i, err)
+			}
+			c, err := parseJSONCoefficient(pair[1])
+			if err != nil {
+				return nil, fmt.Errorf("term %d: %w", i, err)
+			}
+			terms = append(terms, parsedTerm{Coefficient: c, Factors: factors})
+		}
+	default:
+		return nil, errors.New("operator JSON must be an object or a list of [term, coefficient] pairs")
+	}
+	return terms, nil
+}
+
+// parseJSONTerm reads a term given as a label or a list of [qubit, "P"]
+func parseJSONTerm(raw interface{}) (map[int]pb.PauliType, error) {
+	switch v := raw.(type) {
+	case string:
+		return parseTermLabel(v)
+	case []interface{}:
+		factors := make(map[int]pb.PauliType)
+		for _, f := range v {
+			pair, ok := f.([]interface{})
+			if !ok || len(pair) != 2 {
+				return nil, errors.New("factors must be [qubit, \"X\"|\"Y\"|\"Z\"]")
+			}
+			q, ok := pair[0].(float64)
+			p, ok2 := pair[1].(string)
+			if !ok || !ok2 || q < 0 || q != float64(int(q)) {
+				return nil, errors.New("factors must be [qubit, \"X\"|\"Y\"|\"Z\"]")
+			}
+			if err := addFactor(factors, int(q), p); err != nil {
+				return nil, err
+			}
+		}
+		return factors, nil
+	}
+	return nil, errors.New("term must be a string or a list of factors")
+}
+
+func parseJSONCoefficient(raw interface{}) (complex128, error) {
+	switch v := raw.(type) {
+	case float64:
+		return complex(v, 0), nil
+	case []interface{}:
+		if len(v) == 2 {
+			re, ok := v[0].(float64)
+			im, ok2 := v[1].(float64)
+			if ok && ok2 {
+				return complex(re, im), nil
+			}
+		}
+	case map[string]interface{}:
+		re, _ := v["real"].(float64)
+		im, _ := v["imag"].(float64)
+		return complex(re, im), nil
+	case string:
+		return parseCoefficient(v)
+	}
+	return 0, errors.New("coefficient must be a number, [re, im] or {\"real\", \"imag\"}")
+}
+
+func parseOperatorText(text string) ([]parsedTerm, error) {
+	var terms []parsedTerm
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	line := 0
+	for scanner.Scan() {
+		line++
+		s := scanner.Text()
+		if i := strings.Index(s, "#"); i >= 0 {
+			s = s[:i]
+		}
+		s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "+"))
+		if s == "" {
+			continue
+		}
+
+		coeffText, label := s, ""
+		if i := strings.IndexAny(s, " \t["); i >= 0 {
+			coeffText, label = s[:i], s[i:]
+		}
+		c, err := parseCoefficient(coeffText)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		factors, err := parseTermLabel(label)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		terms = append(terms, parsedTerm{Coefficient: c, Factors: factors})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, errors.New("operator has no terms")
+	}
+	return terms, nil
+}
+
+// parseCoefficient accepts real numbers and Python complex literals
+// such as "(0.5+0j)"
+func parseCoefficient(s string) (complex128, error) {
+	c, err := strconv.ParseComplex(strings.ReplaceAll(strings.TrimSpace(s), "j", "i"), 128)
+	if err != nil {
+		return 0, fmt.Errorf("invalid coefficient %q", s)
+	}
+	return c, nil
+}
+
+// parseTermLabel reads "X0 Y1", "[X0 Y1]", "((0, 'X'), (1, 'Y'))" or ""
+func parseTermLabel(label string) (map[int]pb.PauliType, error) {
+	factors := make(map[int]pb.PauliType)
+	if strings.Contains(label, "(") {
+		for _, m := range tupleFactor.FindAllStringSubmatch(label, -1) {
+			q, _ := strconv.Atoi(m[1])
+			if err := addFactor(factors, q, m[2]); err != nil {
+				return nil, err
+			}
+		}
+		if rest := tupleFactor.ReplaceAllString(label, ""); strings.Trim(rest, "(), ") != "" {
+			return nil, fmt.Errorf("unrecognized term %q", label)
+		}
+		return factors, nil
+	}
+
+	for _, f := range strings.Fields(strings.Trim(strings.TrimSpace(label), "[]")) {
+		if strings.EqualFold(f, "I") {
+			continue // Bare identity
+		}
+		m := pauliFactor.FindStringSubmatch(strings.ToUpper(f))
+		if m == nil {
+			return nil, fmt.Errorf("unrecognized Pauli factor %q (want e.g. X0)", f)
+		}
+		q, _ := strconv.Atoi(m[2])
+		if err := addFactor(factors, q, m[1]); err != nil {
+			return nil, err
+		}
+	}
+	return factors, nil
+}
+
+func addFactor(factors map[int]pb.PauliType, qubit int, pauli string) error {
+	types := map[string]pb.PauliType{"I": pb.PauliType_PAULI_I, "X": pb.PauliType_PAULI_X, "Y": pb.PauliType_PAULI_Y, "Z": pb.PauliType_PAULI_Z}
+	p, ok := types[strings.ToUpper(pauli)]
+	if !ok {
+		return fmt.Errorf("unknown Pauli %q", pauli)
+	}
+	if qubit >= maxQubits {
+		return fmt.Errorf("qubit %d exceeds the simulator's %d qubits", qubit, maxQubits)
+	}
+	if _, dup := factors[qubit]; dup {
+		return fmt.Errorf("qubit %d appears twice in one term", qubit)
+	}
+	if p != pb.PauliType_PAULI_I {
+		factors[qubit] = p
+	}
+	return nil
+}
+
+// HamiltonianFromTerms sums imported terms on numQubits qubits (0 = as
+// many as the highest index needs)
+func HamiltonianFromTerms(name string, terms []parsedTerm, numQubits int) (*pb.Hamiltonian, error) {
+	highest := -1
+	for _, t := range terms {
+		for q := range t.Factors {
+			if q > highest {
+				highest = q
+			}
+		}
+	}
+	if numQubits == 0 {
+		numQubits = highest + 1
+	}
+	if numQubits <= 0 {
+		numQubits = 1 // A pure constant still needs a register
+	}
+	if highest >= numQubits {
+		return nil, fmt.Errorf("operator acts on qubit %d but num_qubits is %d", highest, numQubits)
+	}
+	if numQubits > maxQubits {
+		return nil, fmt.Errorf("%d qubits exceed the simulator's %d", numQubits, maxQubits)
+	}
+
+	op := make(qubitOperator)
+	for _, t := range terms {
+		str := []byte(strings.Repeat("I", numQubits))
+		for q, p := range t.Factors {
+			str[q] = "IXYZ"[p]
+		}
+		op[string(str)] += t.Coefficient
+	}
+	pauli, err := op.pauliTerms()
+	if err != nil {
+		return nil, fmt.Errorf("operator is not Hermitian: %w", err)
+	}
+	return &pb.Hamiltonian{MoleculeName: name, NumQubits: int32(numQubits), Terms: pauli}, nil
+}
@@ -0,0 +1,107 @@
+// Spin-Chain Hamiltonians
+// Condensed-matter models act on one qubit per site (Z_q = 2·S^z_q), so
+// they need no fermion mapping:
+//
+//	Transverse-field Ising  H = −J Σ Z_i Z_{i+1} − h Σ X_i
+//	Heisenberg (XXZ)        H = Σ J(X_i X_{i+1} + Y_i Y_{i+1}) + J_z Z_i Z_{i+1} − h Σ Z_i
+//	XY                      H = J Σ [(1+γ)/2 X_i X_{i+1} + (1−γ)/2 Y_i Y_{i+1}] − h Σ Z_i
+//
+// Bonds run between neighbours i, i+1, plus (N−1, 0) on a periodic ring.
+// J > 0 is ferromagnetic for the Ising chain and antiferromagnetic for the
+// Heisenberg and XY chains.
+
+package main
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+var spinModelNames = map[pb.SpinModelType]string{
+	pb.SpinModelType_SPIN_MODEL_TRANSVERSE_ISING: "TFIM",
+	pb.SpinModelType_SPIN_MODEL_HEISENBERG:       "Heisenberg",
+	pb.SpinModelType_SPIN_MODEL_XY:               "XY",
+}
+
+// BuildSpinModel returns the chain's Hamiltonian with measurement groups
+func BuildSpinModel(config *pb.SpinModelConfig) (*pb.Hamiltonian, error) {
+	name, ok := spinModelNames[config.Model]
+	if !ok {
+		return nil, fmt.Errorf("unknown spin model %d", config.Model)
+	}
+	n := int(config.NumSites)
+	if n < 2 || n > maxQubits {
+		return nil, fmt.Errorf("num_sites must be 2-%d", maxQubits)
+	}
+	if config.Periodic && n < 3 {
+		return nil, fmt.Errorf("a periodic chain needs at least 3 sites")
+	}
+	for _, v := range []float64{config.Coupling, config.CouplingZ, config.Anisotropy, config.Field} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("couplings and field must be finite")
+		}
+	}
+	if math.Abs(config.Anisotropy) > 1 {
+		return nil, fmt.Errorf("anisotropy must be in [-1, 1]")
+	}
+
+	j := config.Coupling
+	if j == 0 {
+		j = 1
+	}
+	jz := config.CouplingZ
+	if jz == 0 {
+		jz = j // Isotropic; the XY model is the J_z = 0 case
+	}
+
+	var terms []parsedTerm
+	bond := func(c float64, p pb.PauliType, a, b int) {
+		if c != 0 {
+			terms = append(terms, parsedTerm{Coefficient: complex(c, 0), Factors: map[int]pb.PauliType{a: p, b: p}})
+		}
+	}
+	site := func(c float64, p pb.PauliType, q int) {
+		if c != 0 {
+			terms = append(terms, parsedTerm{Coefficient: complex(c, 0), Factors: map[int]pb.PauliType{q: p}})
+		}
+	}
+
+	bonds := n - 1
+	if config.Periodic {
+		bonds = n
+	}
+	for i := 0; i < bonds; i++ {
+		a, b := i, (i+1)%n
+		switch config.Model {
+		case pb.SpinModelType_SPIN_MODEL_TRANSVERSE_ISING:
+			bond(-j, pb.PauliType_PAULI_Z, a, b)
+		case pb.SpinModelType_SPIN_MODEL_HEISENBERG:
+			bond(j, pb.PauliType_PAULI_X, a, b)
+			bond(j, pb.PauliType_PAULI_Y, a, b)
+			bond(jz, pb.PauliType_PAULI_Z, a, b)
+		case pb.SpinModelType_SPIN_MODEL_XY:
+			bond(j*(1+config.Anisotropy)/2, pb.PauliType_PAULI_X, a, b)
+			bond(j*(1-config.Anisotropy)/2, pb.PauliType_PAULI_Y, a, b)
+		}
+	}
+	field := pb.PauliType_PAULI_Z
+	if config.Model == pb.SpinModelType_SPIN_MODEL_TRANSVERSE_ISING {
+		field = pb.PauliType_PAULI_X
+	}
+	for q := 0; q < n; q++ {
+		site(-config.Field, field, q)
+	}
+
+	boundary := "open"
+	if config.Periodic {
+		boundary = "periodic"
+	}
+	h, err := HamiltonianFromTerms(fmt.Sprintf("%s-%d (%s)", name, n, boundary), terms, n)
+	if err != nil {
+		return nil, err
+	}
+	h.Groups = GroupCommutingTerms(h)
+	return h, nil
+}
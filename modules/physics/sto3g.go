@@ -0,0 +1,327 @@
+// STO-3G Basis & Molecular Integrals
+// Each minimal-basis Slater orbital is a contraction of three Gaussians
+// (Hehre, Stewart & Pople 1969), scaled by the element's Slater exponent ζ.
+// Overlap, kinetic, nuclear-attraction and electron-repulsion integrals over
+// Cartesian Gaussians use the McMurchie-Davidson scheme: products of
+// Gaussians are expanded in Hermite Gaussians (E coefficients) whose
+// Coulomb integrals follow from the Boys function (R integrals).
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	pb "github.com/perclft/QubitEngine/modules/physics/generated"
+)
+
+const angstromToBohr = 1 / 0.529177210903
+
+// STO-3G expansions of unit-exponent Slater orbitals
+var (
+	sto3g1sExponents = [3]float64{2.227660584, 0.4057711562, 0.1098175104}
+	sto3g1sCoeffs    = [3]float64{0.1543289673, 0.5353281423, 0.4446345422}
+	sto3g2spExponent = [3]float64{0.9942027296, 0.2310313333, 0.07513856000}
+	sto3g2sCoeffs    = [3]float64{-0.09996722919, 0.3995128261, 0.7001154689}
+	sto3g2pCoeffs    = [3]float64{0.1559162750, 0.6076837186, 0.3919573931}
+)
+
+// element is an atom's nuclear charge and Slater exponents (ζ2sp = 0 for
+// first-row atoms, which carry only a 1s shell)
+type element struct {
+	Z       int
+	Zeta1s  float64
+	Zeta2sp float64
+}
+
+var elements = map[string]element{
+	"H":  {Z: 1, Zeta1s: 1.24},
+	"He": {Z: 2, Zeta1s: 1.69},
+	"Li": {Z: 3, Zeta1s: 2.69, Zeta2sp: 0.80},
+	"Be": {Z: 4, Zeta1s: 3.68, Zeta2sp: 1.15},
+	"B":  {Z: 5, Zeta1s: 4.68, Zeta2sp: 1.50},
+	"C":  {Z: 6, Zeta1s: 5.67, Zeta2sp: 1.72},
+	"N":  {Z: 7, Zeta1s: 6.67, Zeta2sp: 1.95},
+	"O":  {Z: 8, Zeta1s: 7.66, Zeta2sp: 2.25},
+	"F":  {Z: 9, Zeta1s: 8.65, Zeta2sp: 2.55},
+	"Ne": {Z: 10, Zeta1s: 9.64, Zeta2sp: 2.88},
+}
+
+// lookupElement accepts element symbols in any case ("he", "HE")
+func lookupElement(symbol string) (element, string, bool) {
+	s := strings.TrimSpace(symbol)
+	if len(s) > 0 {
+		s = strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+	}
+	e, ok := elements[s]
+	return e, s, ok
+}
+
+// basisFunction is a normalized contracted Cartesian Gaussian
+// Σ c_k x^l y^m z^n exp(−α_k r²) centered on an atom
+type basisFunction struct {
+	Center    [3]float64 // Bohr
+	Shell     [3]int     // Angular exponents l, m, n
+	Exponents []float64
+	Coeffs    []float64 // Include primitive normalization
+	Label     string    // e.g. "Li 2px"
+}
+
+// nucleus is a point charge in Bohr
+type nucleus struct {
+	Charge float64
+	Pos    [3]float64
+}
+
+// sto3gBasis builds the basis functions and nuclei of a molecule
+func sto3gBasis(atoms []*pb.Atom) ([]*basisFunction, []nucleus, error) {
+	var basis []*basisFunction
+	var nuclei []nucleus
+	for _, a := range atoms {
+		el, symbol, ok := lookupElement(a.Element)
+		if !ok {
+			return nil, nil, fmt.Errorf("element %q has no STO-3G basis (supported: H-Ne)", a.Element)
+		}
+		pos := [3]float64{a.X * angstromToBohr, a.Y * angstromToBohr, a.Z * angstromToBohr}
+		nuclei = append(nuclei, nucleus{Charge: float64(el.Z), Pos: pos})
+
+		basis = append(basis, contracted(pos, [3]int{}, el.Zeta1s, sto3g1sExponents, sto3g1sCoeffs, symbol+" 1s"))
+		if el.Zeta2sp > 0 {
+			basis = append(basis, contracted(pos, [3]int{}, el.Zeta2sp, sto3g2spExponent, sto3g2sCoeffs, symbol+" 2s"))
+			for axis, name := range []string{"x", "y", "z"} {
+				var shell [3]int
+				shell[axis] = 1
+				basis = append(basis, contracted(pos, shell, el.Zeta2sp, sto3g2spExponent, sto3g2pCoeffs, symbol+" 2p"+name))
+			}
+		}
+	}
+	return basis, nuclei, nil
+}
+
+// contracted scales the unit expansion by ζ² and normalizes
+func contracted(center [3]float64, shell [3]int, zeta float64, exps, coeffs [3]float64, label string) *basisFunction {
+	bf := &basisFunction{Center: center, Shell: shell, Label: label}
+	for k := range exps {
+		alpha := exps[k] * zeta * zeta
+		bf.Exponents = append(bf.Exponents, alpha)
+		bf.Coeffs = append(bf.Coeffs, coeffs[k]*primitiveNorm(alpha, shell))
+	}
+	norm := 1 / math.Sqrt(overlapIntegral(bf, bf))
+	for k := range bf.Coeffs {
+		bf.Coeffs[k] *= norm
+	}
+	return bf
+}
+
+func primitiveNorm(alpha float64, shell [3]int) float64 {
+	l := shell[0] + shell[1] + shell[2]
+	denom := doubleFactorial(2*shell[0]-1) * doubleFactorial(2*shell[1]-1) * doubleFactorial(2*shell[2]-1)
+	return math.Pow(2*alpha/math.Pi, 0.75) * math.Pow(4*alpha, float64(l)/2) / math.Sqrt(denom)
+}
+
+func doubleFactorial(n int) float64 {
+	result := 1.0
+	for ; n > 1; n -= 2 {
+		result *= float64(n)
+	}
+	return result
+}
+
+// ------------------------------------------------------------------
+// McMurchie-Davidson
+// ------------------------------------------------------------------
+
+// hermiteE is the coefficient of Hermite Gaussian t in the product of 1D
+// Gaussians of orders i, j with exponents a, b separated by qx = A − B
+func hermiteE(i, j, t int, qx, a, b float64) float64 {
+	p := a + b
+	q := a * b / p
+	switch {
+	case t < 0 || t > i+j:
+		return 0
+	case i == 0 && j == 0 && t == 0:
+		return math.Exp(-q * qx * qx)
+	case j == 0:
+		return hermiteE(i-1, j, t-1, qx, a, b)/(2*p) -
+			q*qx/a*hermiteE(i-1, j, t, qx, a, b) +
+			float64(t+1)*hermiteE(i-1, j, t+1, qx, a, b)
+	default:
+		return hermiteE(i, j-1, t-1, qx, a, b)/(2*p) +
+			q*qx/b*hermiteE(i, j-1, t, qx, a, b) +
+			float64(t+1)*hermiteE(i, j-1, t+1, qx, a, b)
+	}
+}
+
+// hermiteR is the Coulomb integral of Hermite Gaussian (t, u, v) with
+// exponent p at distance pc from the charge
+func hermiteR(t, u, v, n int, p float64, pc [3]float64) float64 {
+	switch {
+	case t < 0 || u < 0 || v < 0:
+		return 0
+	case t == 0 && u == 0 && v == 0:
+		r2 := pc[0]*pc[0] + pc[1]*pc[1] + pc[2]*pc[2]
+		return math.Pow(-2*p, float64(n)) * boys(n, p*r2)
+	case t == 0 && u == 0:
+		return float64(v-1)*hermiteR(t, u, v-2, n+1, p, pc) + pc[2]*hermiteR(t, u, v-1, n+1, p, pc)
+	case t == 0:
+		return float64(u-1)*hermiteR(t, u-2, v, n+1, p, pc) + pc[1]*hermiteR(t, u-1, v, n+1, p, pc)
+	default:
+		return float64(t-1)*hermiteR(t-2, u, v, n+1, p, pc) + pc[0]*hermiteR(t-1, u, v, n+1, p, pc)
+	}
+}
+
+// boys computes F_n(x) = ∫₀¹ t²ⁿ exp(−x t²) dt: a power series for small
+// x and the asymptotic form (error ~ e^-x) for large x
+func boys(n int, x float64) float64 {
+	switch {
+	case x < 1e-10:
+		return 1/float64(2*n+1) - x/float64(2*n+3)
+	case x > 40:
+		return doubleFactorial(2*n-1) / math.Pow(2, float64(n+1)) * math.Sqrt(math.Pi/math.Pow(x, float64(2*n+1)))
+	}
+	term := 1 / float64(2*n+1)
+	sum := term
+	for k := 1; term > 1e-17*sum; k++ {
+		term *= 2 * x / float64(2*n+2*k+1)
+		sum += term
+	}
+	return math.Exp(-x) * sum
+}
+
+// primitiveOverlap is ⟨a|b⟩ for unnormalized primitives
+func primitiveOverlap(a float64, la [3]int, ca [3]float64, b float64, lb [3]int, cb [3]float64) float64 {
+	s := math.Pow(math.Pi/(a+b), 1.5)
+	for x := 0; x < 3; x++ {
+		s *= hermiteE(la[x], lb[x], 0, ca[x]-cb[x], a, b)
+	}
+	return s
+}
+
+// contract sums a primitive integral over both contractions
+func contract(f, g *basisFunction, prim func(a, b float64) float64) float64 {
+	sum := 0.0
+	for i, a := range f.Exponents {
+		for j, b := range g.Exponents {
+			sum += f.Coeffs[i] * g.Coeffs[j] * prim(a, b)
+		}
+	}
+	return sum
+}
+
+func overlapIntegral(f, g *basisFunction) float64 {
+	return contract(f, g, func(a, b float64) float64 {
+		return primitiveOverlap(a, f.Shell, f.Center, b, g.Shell, g.Center)
+	})
+}
+
+// kineticIntegral is ⟨f|−½∇²|g⟩, written as overlaps with g's shell
+// raised and lowered
+func kineticIntegral(f, g *basisFunction) float64 {
+	return contract(f, g, func(a, b float64) float64 {
+		shifted := func(axis, delta int) float64 {
+			lb := g.Shell
+			lb[axis] += delta
+			if lb[axis] < 0 {
+				return 0
+			}
+			return primitiveOverlap(a, f.Shell, f.Center, b, lb, g.Center)
+		}
+		l := g.Shell[0] + g.Shell[1] + g.Shell[2]
+		t := b * float64(2*l+3) * primitiveOverlap(a, f.Shell, f.Center, b, g.Shell, g.Center)
+		for axis := 0; axis < 3; axis++ {
+			t -= 2 * b * b * shifted(axis, 2)
+			t -= 0.5 * float64(g.Shell[axis]*(g.Shell[axis]-1)) * shifted(axis, -2)
+		}
+		return t
+	})
+}
+
+// nuclearIntegral is ⟨f|−Σ Z_C/r_C|g⟩
+func nuclearIntegral(f, g *basisFunction, nuclei []nucleus) float64 {
+	return contract(f, g, func(a, b float64) float64 {
+		p := a + b
+		var center [3]float64
+		for x := range center {
+			center[x] = (a*f.Center[x] + b*g.Center[x]) / p
+		}
+		total := 0.0
+		for _, nuc := range nuclei {
+			pc := [3]float64{center[0] - nuc.Pos[0], center[1] - nuc.Pos[1], center[2] - nuc.Pos[2]}
+			v := 0.0
+			for t := 0; t <= f.Shell[0]+g.Shell[0]; t++ {
+				et := hermiteE(f.Shell[0], g.Shell[0], t, f.Center[0]-g.Center[0], a, b)
+				for u := 0; u <= f.Shell[1]+g.Shell[1]; u++ {
+					eu := hermiteE(f.Shell[1], g.Shell[1], u, f.Center[1]-g.Center[1], a, b)
+					for w := 0; w <= f.Shell[2]+g.Shell[2]; w++ {
+						ew := hermiteE(f.Shell[2], g.Shell[2], w, f.Center[2]-g.Center[2], a, b)
+						v += et * eu * ew * hermiteR(t, u, w, 0, p, pc)
+					}
+				}
+			}
+			total -= nuc.Charge * 2 * math.Pi / p * v
+		}
+		return total
+	})
+}
+
+// repulsionIntegral is the chemist-notation (fg|hk)
+func repulsionIntegral(f, g, h, k *basisFunction) float64 {
+	sum := 0.0
+	for i, a := range f.Exponents {
+		for j, b := range g.Exponents {
+			p := a + b
+			ep := hermiteTable(f, g, a, b)
+			var pc [3]float64
+			for x := range pc {
+				pc[x] = (a*f.Center[x] + b*g.Center[x]) / p
+			}
+			for m, c := range h.Exponents {
+				for n, d := range k.Exponents {
+					q := c + d
+					eq := hermiteTable(h, k, c, d)
+					var qc [3]float64
+					for x := range qc {
+						qc[x] = (c*h.Center[x] + d*k.Center[x]) / q
+					}
+					alpha := p * q / (p + q)
+					pq := [3]float64{pc[0] - qc[0], pc[1] - qc[1], pc[2] - qc[2]}
+
+					v := 0.0
+					for t, et := range ep[0] {
+						for u, eu := range ep[1] {
+							for w, ew := range ep[2] {
+								for tau, ft := range eq[0] {
+									for nu, fu := range eq[1] {
+										for phi, fw := range eq[2] {
+											sign := 1.0
+											if (tau+nu+phi)%2 == 1 {
+												sign = -1
+											}
+											v += et * eu * ew * ft * fu * fw * sign *
+												hermiteR(t+tau, u+nu, w+phi, 0, alpha, pq)
+										}
+									}
+								}
+							}
+						}
+					}
+					prefactor := 2 * math.Pow(math.Pi, 2.5) / (p * q * math.Sqrt(p+q))
+					sum += f.Coeffs[i] * g.Coeffs[j] * h.Coeffs[m] * k.Coeffs[n] * prefactor * v
+				}
+			}
+		}
+	}
+	return sum
+}
+
+// hermiteTable lists E^{ij}_t for t = 0…i+j along each axis
+func hermiteTable(f, g *basisFunction, a, b float64) [3][]float64 {
+	var table [3][]float64
+	for x := 0; x < 3; x++ {
+		for t := 0; t <= f.Shell[x]+g.Shell[x]; t++ {
+			table[x] = append(table[x], hermiteE(f.Shell[x], g.Shell[x], t, f.Center[x]-g.Center[x], a, b))
+		}
+	}
+	return table
+}